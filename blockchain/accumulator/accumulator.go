@@ -0,0 +1,257 @@
+package accumulator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// The header accumulator is a Merkle Mountain Range (MMR) of the canonical header hashes,
+// the header at height H being the leaf H. It is a list of perfect binary trees (peaks)
+// whose sizes are the powers of two of the binary representation of the number of leaves.
+// A node is identified by its height (0 for leaves) and its index among the nodes of the same height,
+// so the node (height, index) commits to the leaves [index*2^height, (index+1)*2^height).
+// The accumulator root commits to the number of leaves and to all the peaks.
+
+var (
+	ErrLeafNotFound = errors.New("leaf is not in the accumulator")
+	ErrNodeNotFound = errors.New("accumulator node not found")
+)
+
+// NodeReader reads the accumulator node
+type NodeReader func(height uint8, index uint64) (types.Hash, bool)
+
+// NodeWriter writes the accumulator node
+type NodeWriter func(height uint8, index uint64, hash types.Hash)
+
+// Writer appends the leaves to the accumulator.
+// The nodes written by the writer are readable by it before they are persisted
+type Writer struct {
+	read    NodeReader
+	write   NodeWriter
+	pending map[nodeKey]types.Hash
+}
+
+type nodeKey struct {
+	height uint8
+	index  uint64
+}
+
+// NewWriter creates the accumulator writer on top of the given node storage
+func NewWriter(read NodeReader, write NodeWriter) *Writer {
+	return &Writer{
+		read:    read,
+		write:   write,
+		pending: make(map[nodeKey]types.Hash),
+	}
+}
+
+// Leaf returns the leaf at the given index
+func (w *Writer) Leaf(index uint64) (types.Hash, bool) {
+	return w.node(0, index)
+}
+
+// Append sets the leaf at the given index and updates all the nodes it completes.
+// The leaves before the index must already be in the accumulator and the ones after it are discarded,
+// so setting the leaf of an already accumulated height rewrites the accumulator from that leaf (e.g. on reorg)
+func (w *Writer) Append(index uint64, leaf types.Hash) error {
+	w.set(0, index, leaf)
+
+	hash := leaf
+
+	// the node is the right child of its parent if its index is odd
+	for height := uint8(0); index%2 == 1; height++ {
+		left, ok := w.node(height, index-1)
+		if !ok {
+			return fmt.Errorf("%w: height %d, index %d", ErrNodeNotFound, height, index-1)
+		}
+
+		hash = hashNodes(left, hash)
+		index /= 2
+
+		w.set(height+1, index, hash)
+	}
+
+	return nil
+}
+
+func (w *Writer) set(height uint8, index uint64, hash types.Hash) {
+	w.pending[nodeKey{height: height, index: index}] = hash
+	w.write(height, index, hash)
+}
+
+func (w *Writer) node(height uint8, index uint64) (types.Hash, bool) {
+	if hash, ok := w.pending[nodeKey{height: height, index: index}]; ok {
+		return hash, true
+	}
+
+	return w.read(height, index)
+}
+
+// Proof is the proof that the leaf is in the accumulator of the given number of leaves
+type Proof struct {
+	// LeafIndex is the index of the proven leaf
+	LeafIndex uint64
+
+	// NumLeaves is the number of leaves of the accumulator
+	NumLeaves uint64
+
+	// Siblings are the sibling nodes on the path from the leaf to its peak, starting at the leaf
+	Siblings []types.Hash
+
+	// Peaks are all the peaks of the accumulator, starting with the highest one
+	Peaks []types.Hash
+}
+
+// Root returns the root of the accumulator with the given number of leaves
+func Root(read NodeReader, numLeaves uint64) (types.Hash, error) {
+	peaks, err := readPeaks(read, numLeaves)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return root(numLeaves, peaks), nil
+}
+
+// Prove creates the proof that the leaf is in the accumulator with the given number of leaves
+func Prove(read NodeReader, leafIndex, numLeaves uint64) (*Proof, error) {
+	if leafIndex >= numLeaves {
+		return nil, ErrLeafNotFound
+	}
+
+	peaks, err := readPeaks(read, numLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	height, _ := peakOf(leafIndex, numLeaves)
+	siblings := make([]types.Hash, height)
+
+	for h := uint8(0); h < height; h++ {
+		index := (leafIndex >> h) ^ 1
+
+		sibling, ok := read(h, index)
+		if !ok {
+			return nil, fmt.Errorf("%w: height %d, index %d", ErrNodeNotFound, h, index)
+		}
+
+		siblings[h] = sibling
+	}
+
+	return &Proof{
+		LeafIndex: leafIndex,
+		NumLeaves: numLeaves,
+		Siblings:  siblings,
+		Peaks:     peaks,
+	}, nil
+}
+
+// Verify returns true if the proof proves that the leaf is in the accumulator with the given root
+func (p *Proof) Verify(leaf types.Hash, accumulatorRoot types.Hash) bool {
+	if p.LeafIndex >= p.NumLeaves || len(p.Peaks) != bits.OnesCount64(p.NumLeaves) {
+		return false
+	}
+
+	height, peakIdx := peakOf(p.LeafIndex, p.NumLeaves)
+	if len(p.Siblings) != int(height) {
+		return false
+	}
+
+	hash := leaf
+
+	for h, sibling := range p.Siblings {
+		if (p.LeafIndex>>h)%2 == 0 {
+			hash = hashNodes(hash, sibling)
+		} else {
+			hash = hashNodes(sibling, hash)
+		}
+	}
+
+	if p.Peaks[peakIdx] != hash {
+		return false
+	}
+
+	return root(p.NumLeaves, p.Peaks) == accumulatorRoot
+}
+
+// peakOf returns the height of the peak containing the leaf and the position of the peak among the peaks
+func peakOf(leafIndex, numLeaves uint64) (uint8, int) {
+	var (
+		firstLeaf uint64
+		peakIdx   int
+	)
+
+	for height := 63; height >= 0; height-- {
+		size := uint64(1) << height
+		if numLeaves&size == 0 {
+			continue
+		}
+
+		if leafIndex < firstLeaf+size {
+			return uint8(height), peakIdx
+		}
+
+		firstLeaf += size
+		peakIdx++
+	}
+
+	// unreachable for leafIndex < numLeaves
+	return 0, peakIdx
+}
+
+// readPeaks reads the peaks of the accumulator with the given number of leaves, starting with the highest one
+func readPeaks(read NodeReader, numLeaves uint64) ([]types.Hash, error) {
+	peaks := make([]types.Hash, 0, bits.OnesCount64(numLeaves))
+
+	var firstLeaf uint64
+
+	for height := 63; height >= 0; height-- {
+		size := uint64(1) << height
+		if numLeaves&size == 0 {
+			continue
+		}
+
+		index := firstLeaf >> height
+
+		peak, ok := read(uint8(height), index)
+		if !ok {
+			return nil, fmt.Errorf("%w: height %d, index %d", ErrNodeNotFound, height, index)
+		}
+
+		peaks = append(peaks, peak)
+		firstLeaf += size
+	}
+
+	return peaks, nil
+}
+
+// root commits to the number of leaves and the peaks, folding the peaks from the lowest one
+func root(numLeaves uint64, peaks []types.Hash) types.Hash {
+	var bagged types.Hash
+
+	for i := len(peaks) - 1; i >= 0; i-- {
+		if i == len(peaks)-1 {
+			bagged = peaks[i]
+		} else {
+			bagged = hashNodes(peaks[i], bagged)
+		}
+	}
+
+	buf := make([]byte, 8+types.HashLength)
+	binary.BigEndian.PutUint64(buf, numLeaves)
+	copy(buf[8:], bagged.Bytes())
+
+	return types.BytesToHash(keccak.Keccak256(nil, buf))
+}
+
+func hashNodes(left, right types.Hash) types.Hash {
+	buf := make([]byte, 0, 2*types.HashLength)
+	buf = append(buf, left.Bytes()...)
+	buf = append(buf, right.Bytes()...)
+
+	return types.BytesToHash(keccak.Keccak256(nil, buf))
+}
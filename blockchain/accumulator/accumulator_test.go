@@ -0,0 +1,174 @@
+package accumulator
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+type nodeStore map[nodeKey]types.Hash
+
+func (s nodeStore) read(height uint8, index uint64) (types.Hash, bool) {
+	hash, ok := s[nodeKey{height: height, index: index}]
+
+	return hash, ok
+}
+
+func (s nodeStore) write(height uint8, index uint64, hash types.Hash) {
+	s[nodeKey{height: height, index: index}] = hash
+}
+
+func newLeaves(n int, seed byte) []types.Hash {
+	leaves := make([]types.Hash, n)
+	for i := range leaves {
+		leaves[i] = types.BytesToHash([]byte{seed, byte(i + 1)})
+	}
+
+	return leaves
+}
+
+func TestAccumulator_ProveAndVerify(t *testing.T) {
+	t.Parallel()
+
+	const numLeaves = 40
+
+	store := nodeStore{}
+	writer := NewWriter(store.read, store.write)
+	leaves := newLeaves(numLeaves, 1)
+
+	for n := uint64(1); n <= numLeaves; n++ {
+		require.NoError(t, writer.Append(n-1, leaves[n-1]))
+
+		root, err := Root(store.read, n)
+		require.NoError(t, err)
+
+		for i := uint64(0); i < n; i++ {
+			proof, err := Prove(store.read, i, n)
+			require.NoError(t, err)
+
+			require.True(t, proof.Verify(leaves[i], root), "leaf %d of %d", i, n)
+
+			// the proof doesn't hold for the other leaves
+			require.False(t, proof.Verify(leaves[(i+1)%numLeaves], root))
+		}
+	}
+
+	_, err := Prove(store.read, numLeaves, numLeaves)
+	require.ErrorIs(t, err, ErrLeafNotFound)
+
+	_, err = Root(store.read, numLeaves+1)
+	require.ErrorIs(t, err, ErrNodeNotFound)
+}
+
+func TestAccumulator_TamperedProof(t *testing.T) {
+	t.Parallel()
+
+	store := nodeStore{}
+	writer := NewWriter(store.read, store.write)
+	leaves := newLeaves(13, 1)
+
+	for i, leaf := range leaves {
+		require.NoError(t, writer.Append(uint64(i), leaf))
+	}
+
+	root, err := Root(store.read, 13)
+	require.NoError(t, err)
+
+	proof, err := Prove(store.read, 5, 13)
+	require.NoError(t, err)
+	require.True(t, proof.Verify(leaves[5], root))
+
+	// different root
+	require.False(t, proof.Verify(leaves[5], types.StringToHash("1")))
+
+	// different leaf index
+	proof.LeafIndex = 4
+	require.False(t, proof.Verify(leaves[5], root))
+
+	proof.LeafIndex = 5
+
+	// different number of leaves
+	proof.NumLeaves = 14
+	require.False(t, proof.Verify(leaves[5], root))
+
+	proof.NumLeaves = 5
+	require.False(t, proof.Verify(leaves[5], root))
+
+	proof.NumLeaves = 13
+
+	// tampered sibling
+	proof.Siblings[0] = types.StringToHash("2")
+	require.False(t, proof.Verify(leaves[5], root))
+}
+
+func TestAccumulator_Rewrite(t *testing.T) {
+	t.Parallel()
+
+	oldLeaves := newLeaves(20, 1)
+	newLeaves := append(append([]types.Hash{}, oldLeaves[:7]...), newLeaves(10, 2)...)
+
+	store := nodeStore{}
+	writer := NewWriter(store.read, store.write)
+
+	for i, leaf := range oldLeaves {
+		require.NoError(t, writer.Append(uint64(i), leaf))
+	}
+
+	// rewrite the accumulator from the leaf 7 in a new writer, like a reorg does
+	writer = NewWriter(store.read, store.write)
+
+	for i := 7; i < len(newLeaves); i++ {
+		require.NoError(t, writer.Append(uint64(i), newLeaves[i]))
+	}
+
+	// the accumulator is the same as the one built from the new leaves only
+	expectedStore := nodeStore{}
+	expectedWriter := NewWriter(expectedStore.read, expectedStore.write)
+
+	for i, leaf := range newLeaves {
+		require.NoError(t, expectedWriter.Append(uint64(i), leaf))
+	}
+
+	numLeaves := uint64(len(newLeaves))
+
+	root, err := Root(store.read, numLeaves)
+	require.NoError(t, err)
+
+	expectedRoot, err := Root(expectedStore.read, numLeaves)
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, root)
+
+	for i, leaf := range newLeaves {
+		proof, err := Prove(store.read, uint64(i), numLeaves)
+		require.NoError(t, err)
+		require.True(t, proof.Verify(leaf, root))
+	}
+}
+
+func TestAccumulator_PendingNodes(t *testing.T) {
+	t.Parallel()
+
+	// nodes written through the writer are not readable from the storage until they are persisted
+	store := nodeStore{}
+	pending := nodeStore{}
+	writer := NewWriter(store.read, pending.write)
+
+	for i, leaf := range newLeaves(8, 1) {
+		require.NoError(t, writer.Append(uint64(i), leaf))
+
+		found, ok := writer.Leaf(uint64(i))
+		require.True(t, ok)
+		require.Equal(t, leaf, found)
+	}
+
+	require.Empty(t, store)
+	require.Len(t, pending, 15)
+
+	_, err := Root(pending.read, 8)
+	require.NoError(t, err)
+
+	// the left sibling is missing
+	writer = NewWriter(store.read, store.write)
+	require.ErrorIs(t, writer.Append(1, types.StringToHash("1")), ErrNodeNotFound)
+}
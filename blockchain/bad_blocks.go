@@ -0,0 +1,273 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+)
+
+const (
+	// BadBlocksDirName is the name of the data dir subdirectory holding the bad blocks
+	BadBlocksDirName = "badblocks"
+
+	// defaultBadBlocksLimit is the maximum number of the bad blocks kept on the disk
+	defaultBadBlocksLimit = 100
+
+	badBlockFileExt = ".json"
+)
+
+// BadBlockAccount is the change of a single account attempted by the bad block execution
+type BadBlockAccount struct {
+	Address  types.Address     `json:"address"`
+	Deleted  bool              `json:"deleted,omitempty"`
+	Balance  *big.Int          `json:"balance,omitempty"`
+	Nonce    uint64            `json:"nonce"`
+	CodeHash types.Hash        `json:"codeHash"`
+	Code     string            `json:"code,omitempty"`
+	Storage  map[string]string `json:"storage,omitempty"`
+}
+
+// BadBlockResult is the result of the local execution of the bad block
+type BadBlockResult struct {
+	StateRoot    types.Hash         `json:"stateRoot"`
+	ReceiptsRoot types.Hash         `json:"receiptsRoot"`
+	GasUsed      uint64             `json:"gasUsed"`
+	Receipts     int                `json:"receipts"`
+	StateDiff    []*BadBlockAccount `json:"stateDiff"`
+}
+
+// BadBlock is the block which failed the verification or the execution, with the rejection reason
+type BadBlock struct {
+	Hash   types.Hash `json:"hash"`
+	Number uint64     `json:"number"`
+	Reason string     `json:"reason"`
+	Time   time.Time  `json:"time"`
+	// RLP is the hex encoded RLP of the full block
+	RLP string `json:"rlp"`
+	// Result is the local execution result, nil if the block was rejected before or during the execution
+	Result *BadBlockResult `json:"result,omitempty"`
+}
+
+// Block decodes the full bad block
+func (b *BadBlock) Block() (*types.Block, error) {
+	raw, err := hex.DecodeHex(b.RLP)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(raw); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// newBadBlockResult creates the bad block result from the local execution result
+func newBadBlockResult(result *BlockResult) *BadBlockResult {
+	res := &BadBlockResult{
+		StateRoot:    result.Root,
+		ReceiptsRoot: buildroot.CalculateReceiptsRoot(result.Receipts),
+		GasUsed:      result.TotalGas,
+		Receipts:     len(result.Receipts),
+		StateDiff:    make([]*BadBlockAccount, len(result.Objects)),
+	}
+
+	for i, obj := range result.Objects {
+		account := &BadBlockAccount{
+			Address:  obj.Address,
+			Deleted:  obj.Deleted,
+			Balance:  obj.Balance,
+			Nonce:    obj.Nonce,
+			CodeHash: obj.CodeHash,
+		}
+
+		if obj.DirtyCode {
+			account.Code = hex.EncodeToHex(obj.Code)
+		}
+
+		if len(obj.Storage) > 0 {
+			account.Storage = make(map[string]string, len(obj.Storage))
+
+			for _, entry := range obj.Storage {
+				account.Storage[hex.EncodeToHex(entry.Key)] = hex.EncodeToHex(entry.Val)
+			}
+		}
+
+		res.StateDiff[i] = account
+	}
+
+	return res
+}
+
+// badBlockStore persists the bad blocks into the directory, one JSON file per block
+type badBlockStore struct {
+	dir   string
+	limit int
+	lock  sync.Mutex
+}
+
+// newBadBlockStore creates the bad block store in the given directory
+func newBadBlockStore(dir string, limit int) (*badBlockStore, error) {
+	if err := common.CreateDirSafe(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create bad blocks directory: %w", err)
+	}
+
+	return &badBlockStore{
+		dir:   dir,
+		limit: limit,
+	}, nil
+}
+
+// write persists the bad block, unless it is already persisted,
+// and removes the oldest bad blocks over the limit
+func (s *badBlockStore) write(badBlock *BadBlock) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%d-%s%s", badBlock.Number, badBlock.Hash, badBlockFileExt))
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	raw, err := json.MarshalIndent(badBlock, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := common.SaveFileSafe(path, raw, 0640); err != nil {
+		return err
+	}
+
+	badBlocks, err := s.readAll()
+	if err != nil {
+		return err
+	}
+
+	for i := s.limit; i < len(badBlocks); i++ {
+		if err := os.Remove(badBlocks[i].path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// list returns the persisted bad blocks, from the newest to the oldest one
+func (s *badBlockStore) list() ([]*BadBlock, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	badBlocks, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*BadBlock, len(badBlocks))
+	for i, b := range badBlocks {
+		res[i] = b.BadBlock
+	}
+
+	return res, nil
+}
+
+type badBlockFile struct {
+	*BadBlock
+	path string
+}
+
+// readAll reads all the persisted bad blocks, sorted from the newest to the oldest one
+func (s *badBlockStore) readAll() ([]*badBlockFile, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	badBlocks := make([]*badBlockFile, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), badBlockFileExt) {
+			continue
+		}
+
+		path := filepath.Join(s.dir, entry.Name())
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		badBlock := &BadBlock{}
+		if err := json.Unmarshal(raw, badBlock); err != nil {
+			return nil, fmt.Errorf("failed to decode bad block %s: %w", entry.Name(), err)
+		}
+
+		badBlocks = append(badBlocks, &badBlockFile{BadBlock: badBlock, path: path})
+	}
+
+	sort.SliceStable(badBlocks, func(i, j int) bool {
+		return badBlocks[i].Time.After(badBlocks[j].Time)
+	})
+
+	return badBlocks, nil
+}
+
+// EnableBadBlocksCapture enables persisting the blocks which fail the verification into the directory
+func (b *Blockchain) EnableBadBlocksCapture(dir string) error {
+	store, err := newBadBlockStore(dir, defaultBadBlocksLimit)
+	if err != nil {
+		return err
+	}
+
+	b.badBlocks = store
+
+	return nil
+}
+
+// GetBadBlocks returns the captured bad blocks, from the newest to the oldest one
+func (b *Blockchain) GetBadBlocks() ([]*BadBlock, error) {
+	if b.badBlocks == nil {
+		return []*BadBlock{}, nil
+	}
+
+	return b.badBlocks.list()
+}
+
+// writeBadBlock captures the block which failed the verification, if the capture is enabled.
+// The result is the local execution result of the block, if the block was executed
+func (b *Blockchain) writeBadBlock(block *types.Block, result *BlockResult, reason error) {
+	if b.badBlocks == nil || block == nil || block.Header == nil {
+		return
+	}
+
+	badBlock := &BadBlock{
+		Hash:   block.Hash(),
+		Number: block.Number(),
+		Reason: reason.Error(),
+		Time:   time.Now().UTC(),
+		RLP:    hex.EncodeToHex(block.MarshalRLP()),
+	}
+
+	if result != nil {
+		badBlock.Result = newBadBlockResult(result)
+	}
+
+	if err := b.badBlocks.write(badBlock); err != nil {
+		b.logger.Error("failed to capture bad block", "number", badBlock.Number, "hash", badBlock.Hash, "err", err)
+
+		return
+	}
+
+	b.logger.Warn("bad block captured", "number", badBlock.Number, "hash", badBlock.Hash, "reason", badBlock.Reason)
+}
@@ -0,0 +1,160 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_BadBlocks_InvalidTxRoot(t *testing.T) {
+	t.Parallel()
+
+	blockchain, err := NewMockBlockchain(nil)
+	require.NoError(t, err)
+
+	// the capture is disabled by default
+	block := &types.Block{Header: &types.Header{Number: 5, Sha3Uncles: types.EmptyUncleHash}}
+	block.Header.ComputeHash()
+
+	_, err = blockchain.verifyBlockBody(context.Background(), block)
+	require.ErrorIs(t, err, ErrInvalidTxRoot)
+
+	badBlocks, err := blockchain.GetBadBlocks()
+	require.NoError(t, err)
+	require.Empty(t, badBlocks)
+
+	dir := filepath.Join(t.TempDir(), BadBlocksDirName)
+	require.NoError(t, blockchain.EnableBadBlocksCapture(dir))
+
+	// the same bad block is captured only once
+	for i := 0; i < 2; i++ {
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
+		require.ErrorIs(t, err, ErrInvalidTxRoot)
+	}
+
+	badBlocks, err = blockchain.GetBadBlocks()
+	require.NoError(t, err)
+	require.Len(t, badBlocks, 1)
+	require.Equal(t, block.Hash(), badBlocks[0].Hash)
+	require.Equal(t, uint64(5), badBlocks[0].Number)
+	require.Equal(t, ErrInvalidTxRoot.Error(), badBlocks[0].Reason)
+	require.Nil(t, badBlocks[0].Result)
+
+	decoded, err := badBlocks[0].Block()
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), decoded.Header.Hash)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestBlockchain_BadBlocks_InvalidStateRoot(t *testing.T) {
+	t.Parallel()
+
+	var (
+		parent = &types.Header{Number: 0, StateRoot: types.EmptyRootHash}
+		addr   = types.StringToAddress("1")
+	)
+
+	executor := state.NewExecutor(
+		&chain.Params{
+			Forks:        chain.AllForksEnabled,
+			BurnContract: map[uint64]types.Address{0: types.ZeroAddress},
+		},
+		itrie.NewState(itrie.NewMemoryStorage()),
+		hclog.NewNullLogger(),
+	)
+	executor.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash { return types.ZeroHash }
+	}
+
+	blockchain, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+		StorageCallback: func(storage *storage.MockStorage) {
+			storage.HookReadHeader(func(hash types.Hash) (*types.Header, error) {
+				return parent, nil
+			})
+		},
+		ExecutorCallback: func(mock *mockExecutor) {
+			mock.HookProcessBlock(func(root types.Hash, block *types.Block, _ types.Address) (*state.Transition, error) {
+				txn, err := executor.BeginTxn(root, block.Header, types.ZeroAddress)
+				if err != nil {
+					return nil, err
+				}
+
+				// the local execution changes the state, which is not reflected in the block
+				txn.Txn().AddBalance(addr, big.NewInt(100))
+				txn.Txn().SetState(addr, types.StringToHash("1"), types.StringToHash("2"))
+
+				return txn, nil
+			})
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, blockchain.EnableBadBlocksCapture(filepath.Join(t.TempDir(), BadBlocksDirName)))
+
+	block := &types.Block{Header: &types.Header{
+		Number:     1,
+		Sha3Uncles: types.EmptyUncleHash,
+		TxRoot:     types.EmptyRootHash,
+		StateRoot:  types.EmptyRootHash,
+	}}
+	block.Header.ComputeHash()
+
+	_, err = blockchain.verifyBlockBody(context.Background(), block)
+	require.ErrorIs(t, err, ErrInvalidStateRoot)
+
+	badBlocks, err := blockchain.GetBadBlocks()
+	require.NoError(t, err)
+	require.Len(t, badBlocks, 1)
+	require.Contains(t, badBlocks[0].Reason, ErrInvalidStateRoot.Error())
+
+	// the attempted state diff is captured
+	result := badBlocks[0].Result
+	require.NotNil(t, result)
+	require.NotEqual(t, types.EmptyRootHash, result.StateRoot)
+	require.Len(t, result.StateDiff, 1)
+	require.Equal(t, addr, result.StateDiff[0].Address)
+	require.Equal(t, big.NewInt(100), result.StateDiff[0].Balance)
+	require.Equal(t, map[string]string{
+		types.StringToHash("1").String(): hex.EncodeToHex(types.StringToHash("2").Bytes()),
+	}, result.StateDiff[0].Storage)
+}
+
+func TestBlockchain_BadBlocks_Limit(t *testing.T) {
+	t.Parallel()
+
+	store, err := newBadBlockStore(t.TempDir(), 2)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+
+	for i := uint64(1); i <= 3; i++ {
+		require.NoError(t, store.write(&BadBlock{
+			Hash:   types.BytesToHash([]byte{byte(i)}),
+			Number: i,
+			Reason: errors.New("bad block").Error(),
+			Time:   now.Add(time.Duration(i) * time.Second),
+		}))
+	}
+
+	// the newest bad blocks are kept
+	badBlocks, err := store.list()
+	require.NoError(t, err)
+	require.Len(t, badBlocks, 2)
+	require.Equal(t, uint64(3), badBlocks[0].Number)
+	require.Equal(t, uint64(2), badBlocks[1].Number)
+}
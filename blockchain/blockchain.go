@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -10,12 +11,15 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/types/buildroot"
 
 	"github.com/hashicorp/go-hclog"
 	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -27,8 +31,14 @@ const (
 
 	// defaultCacheSize is the default size for Blockchain LRU cache structures
 	defaultCacheSize int = 100
+
+	// maxTxHistoryPruneBlocks is the maximum number of the blocks whose transaction history is pruned at once
+	maxTxHistoryPruneBlocks uint64 = 1000
 )
 
+// tracer is the tracer of the block verification and import spans
+var tracer = tracing.Tracer("blockchain")
+
 var (
 	ErrNoBlock              = errors.New("no block data passed in")
 	ErrParentNotFound       = errors.New("parent block not found")
@@ -72,6 +82,16 @@ type Blockchain struct {
 	currentHeader     atomic.Pointer[types.Header] // The current header
 	currentDifficulty atomic.Pointer[big.Int]      // The current difficulty of the chain (total difficulty)
 
+	blockGasTarget atomic.Pointer[uint64] // The block gas target set at runtime (overrides the chain config one)
+
+	senderTxLookup         bool // Flag indicating if the transaction lookups by sender are written
+	contractCreationLookup bool // Flag indicating if the contract creations are indexed
+
+	txHistoryLimit uint64        // The number of the latest blocks whose tx lookups and receipts are kept (0 keeps all)
+	txIndexTail    atomic.Uint64 // The number of the oldest block whose tx lookups and receipts are kept
+
+	badBlocks *badBlockStore // Store of the blocks which failed the verification (nil if capture disabled)
+
 	stream *eventStream // Event subscriptions
 
 	gpAverage *gasPriceAverage // A reference to the average gas price
@@ -107,6 +127,7 @@ type BlockResult struct {
 	Root     types.Hash
 	Receipts []*types.Receipt
 	TotalGas uint64
+	Objects  []*state.Object
 }
 
 // updateGasPriceAvg updates the rolling average value of the gas price
@@ -278,6 +299,10 @@ func (b *Blockchain) ComputeGenesis() error {
 		)
 
 		b.setCurrentHeader(header, diff)
+
+		if err := b.backfillHeaderAccumulator(header); err != nil {
+			return fmt.Errorf("failed to build the header accumulator: %w", err)
+		}
 	} else {
 		// empty storage, write the genesis
 		if err := b.writeGenesis(b.config.Genesis); err != nil {
@@ -355,11 +380,56 @@ func (b *Blockchain) CalculateGasLimit(number uint64) (uint64, error) {
 	return b.calculateGasLimit(parent.GasLimit), nil
 }
 
+// BlockGasTarget returns the block gas target the node is moving the gas limit towards.
+// The value set at runtime takes precedence over the one from the chain config
+func (b *Blockchain) BlockGasTarget() uint64 {
+	if target := b.blockGasTarget.Load(); target != nil {
+		return *target
+	}
+
+	return b.Config().BlockGasTarget
+}
+
+// SetBlockGasTarget sets the block gas target at runtime.
+// Gas limit of the following blocks moves towards the new target,
+// bounded by the maximum gas limit delta allowed per block.
+// Zero value signals that the parent gas limit should be applied
+func (b *Blockchain) SetBlockGasTarget(target uint64) {
+	b.blockGasTarget.Store(&target)
+
+	b.logger.Info("block gas target updated", "target", target)
+}
+
+// EnableSenderTxLookup enables writing of the transaction lookups by sender for the imported blocks.
+// It must be called before the blockchain starts importing blocks
+func (b *Blockchain) EnableSenderTxLookup() {
+	b.senderTxLookup = true
+}
+
+// SenderTxLookupEnabled returns true if the transaction lookups by sender are written
+func (b *Blockchain) SenderTxLookupEnabled() bool {
+	return b.senderTxLookup
+}
+
+// SetTxHistoryLimit sets the number of the latest blocks whose transaction lookups and receipts are kept,
+// the older ones are pruned as the new blocks are imported (0 keeps all).
+// It must be called before the blockchain starts importing blocks
+func (b *Blockchain) SetTxHistoryLimit(limit uint64) {
+	b.txHistoryLimit = limit
+	tail, _ := b.db.ReadTxIndexTail()
+	b.txIndexTail.Store(tail)
+}
+
+// TxIndexTail returns the number of the oldest block whose transaction lookups and receipts are kept
+func (b *Blockchain) TxIndexTail() uint64 {
+	return b.txIndexTail.Load()
+}
+
 // calculateGasLimit calculates gas limit in reference to the block gas target
 func (b *Blockchain) calculateGasLimit(parentGasLimit uint64) uint64 {
 	// The gas limit cannot move more than 1/1024 * parentGasLimit
 	// in either direction per block
-	blockGasTarget := b.Config().BlockGasTarget
+	blockGasTarget := b.BlockGasTarget()
 
 	// Check if the gas limit target has been set
 	if blockGasTarget == 0 {
@@ -406,6 +476,10 @@ func (b *Blockchain) writeGenesisImpl(header *types.Header) error {
 
 	batchWriter.PutCanonicalHeader(header, newTD)
 
+	if err := b.appendHeaderAccumulator(batchWriter, header); err != nil {
+		return err
+	}
+
 	if err := b.writeBatchAndUpdate(batchWriter, header, newTD, true); err != nil {
 		return err
 	}
@@ -596,23 +670,32 @@ func (b *Blockchain) WriteHeadersWithBodies(headers []*types.Header) error {
 // VerifyPotentialBlock does the minimal block verification without consulting the
 // consensus layer. Should only be used if consensus checks are done
 // outside the method call
-func (b *Blockchain) VerifyPotentialBlock(block *types.Block) error {
+func (b *Blockchain) VerifyPotentialBlock(block *types.Block) (err error) {
+	ctx, span := tracer.Start(context.Background(), "blockchain.VerifyPotentialBlock", blockSpanAttributes(block))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// Do just the initial block verification
-	_, err := b.verifyBlock(block)
+	_, err = b.verifyBlock(ctx, block)
 
 	return err
 }
 
 // VerifyFinalizedBlock verifies that the block is valid by performing a series of checks.
 // It is assumed that the block status is sealed (committed)
-func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (*types.FullBlock, error) {
+func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (_ *types.FullBlock, err error) {
+	ctx, span := tracer.Start(context.Background(), "blockchain.VerifyFinalizedBlock", blockSpanAttributes(block))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// Make sure the consensus layer verifies this block header
 	if err := b.consensus.VerifyHeader(block.Header); err != nil {
-		return nil, fmt.Errorf("failed to verify the header: %w", err)
+		err = fmt.Errorf("failed to verify the header: %w", err)
+		b.writeBadBlock(block, nil, err)
+
+		return nil, err
 	}
 
 	// Do the initial block verification
-	receipts, err := b.verifyBlock(block)
+	receipts, err := b.verifyBlock(ctx, block)
 	if err != nil {
 		return nil, err
 	}
@@ -620,9 +703,22 @@ func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (*types.FullBlock,
 	return &types.FullBlock{Block: block, Receipts: receipts}, nil
 }
 
+// blockSpanAttributes returns the span option setting the attributes identifying the block
+func blockSpanAttributes(block *types.Block) trace.SpanStartOption {
+	if block == nil {
+		return trace.WithAttributes()
+	}
+
+	return trace.WithAttributes(
+		attribute.Int64("block.number", int64(block.Number())),
+		attribute.String("block.hash", block.Hash().String()),
+		attribute.Int("block.txs", len(block.Transactions)),
+	)
+}
+
 // verifyBlock does the base (common) block verification steps by
 // verifying the block body as well as the parent information
-func (b *Blockchain) verifyBlock(block *types.Block) ([]*types.Receipt, error) {
+func (b *Blockchain) verifyBlock(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
 	// Make sure the block is present
 	if block == nil {
 		return nil, ErrNoBlock
@@ -634,7 +730,7 @@ func (b *Blockchain) verifyBlock(block *types.Block) ([]*types.Receipt, error) {
 	}
 
 	// Make sure the block body data is valid
-	return b.verifyBlockBody(block)
+	return b.verifyBlockBody(ctx, block)
 }
 
 // verifyBlockParent makes sure that the child block is in line
@@ -692,7 +788,7 @@ func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
 // - The trie roots match up (state, transactions, receipts, uncles)
 // - The receipts match up
 // - The execution result matches up
-func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, error) {
+func (b *Blockchain) verifyBlockBody(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
 	// Make sure the Uncles root matches up
 	if hash := buildroot.CalculateUncleRoot(block.Uncles); hash != block.Header.Sha3Uncles {
 		b.logger.Error(fmt.Sprintf(
@@ -701,6 +797,8 @@ func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, erro
 			block.Header.Sha3Uncles,
 		))
 
+		b.writeBadBlock(block, nil, ErrInvalidSha3Uncles)
+
 		return nil, ErrInvalidSha3Uncles
 	}
 
@@ -712,18 +810,26 @@ func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, erro
 			block.Header.TxRoot,
 		))
 
+		b.writeBadBlock(block, nil, ErrInvalidTxRoot)
+
 		return nil, ErrInvalidTxRoot
 	}
 
 	// Execute the transactions in the block and grab the result
-	blockResult, executeErr := b.executeBlockTransactions(block)
+	blockResult, executeErr := b.executeBlockTransactions(ctx, block)
 	if executeErr != nil {
-		return nil, fmt.Errorf("unable to execute block transactions, %w", executeErr)
+		err := fmt.Errorf("unable to execute block transactions, %w", executeErr)
+		b.writeBadBlock(block, nil, err)
+
+		return nil, err
 	}
 
 	// Verify the local execution result with the proposed block data
 	if err := blockResult.verifyBlockResult(block); err != nil {
-		return nil, fmt.Errorf("unable to verify block execution result, %w", err)
+		err = fmt.Errorf("unable to verify block execution result, %w", err)
+		b.writeBadBlock(block, blockResult, err)
+
+		return nil, err
 	}
 
 	return blockResult.Receipts, nil
@@ -758,7 +864,10 @@ func (br *BlockResult) verifyBlockResult(referenceBlock *types.Block) error {
 
 // executeBlockTransactions executes the transactions in the block locally,
 // and reports back the block execution result
-func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult, error) {
+func (b *Blockchain) executeBlockTransactions(ctx context.Context, block *types.Block) (_ *BlockResult, err error) {
+	_, span := tracer.Start(ctx, "blockchain.executeBlockTransactions", blockSpanAttributes(block))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	header := block.Header
 
 	parent, ok := b.readHeader(header.ParentHash)
@@ -780,7 +889,7 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 		return nil, err
 	}
 
-	_, root, err := txn.Commit()
+	_, root, objects, err := txn.CommitObjects()
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit the state changes: %w", err)
 	}
@@ -792,6 +901,7 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 		Root:     root,
 		Receipts: txn.Receipts(),
 		TotalGas: txn.TotalGas(),
+		Objects:  objects,
 	}, nil
 }
 
@@ -799,7 +909,10 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 // It doesn't do any kind of verification, only commits the block to the DB
 // This function is a copy of WriteBlock but with a full block which does not
 // require to compute again the Receipts.
-func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) error {
+func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) (err error) {
+	_, span := tracer.Start(context.Background(), "blockchain.WriteFullBlock", blockSpanAttributes(fblock.Block))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	b.writeLock.Lock()
 	defer b.writeLock.Unlock()
 
@@ -832,6 +945,10 @@ func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) erro
 	// but before it is written into the storage
 	batchWriter.PutReceipts(block.Hash(), fblock.Receipts)
 
+	if b.contractCreationLookup {
+		b.writeContractCreations(batchWriter, block, fblock.Receipts)
+	}
+
 	// update snapshot
 	if err := b.consensus.ProcessHeaders([]*types.Header{header}); err != nil {
 		return err
@@ -866,7 +983,10 @@ func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) erro
 
 // WriteBlock writes a single block to the local blockchain.
 // It doesn't do any kind of verification, only commits the block to the DB
-func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
+func (b *Blockchain) WriteBlock(block *types.Block, source string) (err error) {
+	_, span := tracer.Start(context.Background(), "blockchain.WriteBlock", blockSpanAttributes(block))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	b.writeLock.Lock()
 	defer b.writeLock.Unlock()
 
@@ -903,6 +1023,10 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	// but before it is written into the storage
 	batchWriter.PutReceipts(block.Hash(), blockReceipts)
 
+	if b.contractCreationLookup {
+		b.writeContractCreations(batchWriter, block, blockReceipts)
+	}
+
 	// update snapshot
 	if err := b.consensus.ProcessHeaders([]*types.Header{header}); err != nil {
 		return err
@@ -957,7 +1081,7 @@ func (b *Blockchain) extractBlockReceipts(block *types.Block) ([]*types.Receipt,
 	if !ok {
 		// No receipts found in the cache, execute the transactions from the block
 		// and fetch them
-		blockResult, err := b.executeBlockTransactions(block)
+		blockResult, err := b.executeBlockTransactions(context.Background(), block)
 		if err != nil {
 			return nil, err
 		}
@@ -996,7 +1120,7 @@ func (b *Blockchain) writeBody(batchWriter *storage.BatchWriter, block *types.Bl
 	// Recover 'from' field in tx before saving
 	// Because the block passed from the consensus layer doesn't have from field in tx,
 	// due to missing encoding in RLP
-	if err := b.recoverFromFieldsInBlock(block); err != nil {
+	if err := b.RecoverFromFieldsInBlock(block); err != nil {
 		return err
 	}
 
@@ -1008,9 +1132,21 @@ func (b *Blockchain) writeBody(batchWriter *storage.BatchWriter, block *types.Bl
 		batchWriter.PutTxLookup(txn.Hash, block.Hash())
 	}
 
+	if b.senderTxLookup {
+		b.writeSenderTxLookups(batchWriter, block)
+	}
+
 	return nil
 }
 
+// writeSenderTxLookups writes the hashes of the block transactions keyed by the sender,
+// the block number and the position in the block (sender, blockNumber, txIndex -> txHash)
+func (b *Blockchain) writeSenderTxLookups(batchWriter *storage.BatchWriter, block *types.Block) {
+	for i, txn := range block.Transactions {
+		batchWriter.PutSenderTxLookup(txn.From, block.Number(), uint64(i), txn.Hash)
+	}
+}
+
 // ReadTxLookup returns the block hash using the transaction hash
 func (b *Blockchain) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	v, ok := b.db.ReadTxLookup(hash)
@@ -1018,9 +1154,21 @@ func (b *Blockchain) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	return v, ok
 }
 
-// recoverFromFieldsInBlock recovers 'from' fields in the transactions of the given block
-// return error if the invalid signature found
-func (b *Blockchain) recoverFromFieldsInBlock(block *types.Block) error {
+// ReadSenderTxLookups returns the hashes of the transactions sent by the sender in the given block range,
+// ordered by the block number and the position in the block, skipping the first skip transactions
+// and returning at most limit hashes
+func (b *Blockchain) ReadSenderTxLookups(
+	sender types.Address,
+	fromBlock, toBlock uint64,
+	skip, limit uint64,
+) ([]types.Hash, error) {
+	return b.db.ReadSenderTxLookups(sender, fromBlock, toBlock, skip, limit)
+}
+
+// RecoverFromFieldsInBlock recovers 'from' fields in the transactions of the given block
+// return error if the invalid signature found. It doesn't depend on the chain state,
+// so the senders can be recovered concurrently, ahead of the block import
+func (b *Blockchain) RecoverFromFieldsInBlock(block *types.Block) error {
 	for _, tx := range block.Transactions {
 		if tx.From != types.ZeroAddress || tx.Type == types.StateTx {
 			continue
@@ -1135,6 +1283,10 @@ func (b *Blockchain) GetHashByNumber(blockNumber uint64) types.Hash {
 
 // dispatchEvent pushes a new event to the stream
 func (b *Blockchain) dispatchEvent(evnt *Event) {
+	if evnt.Reorg != nil {
+		b.finalizeReorgEvent(evnt)
+	}
+
 	b.stream.push(evnt)
 }
 
@@ -1160,6 +1312,10 @@ func (b *Blockchain) writeHeaderImpl(
 	if header.ParentHash == currentHeader.Hash {
 		batchWriter.PutCanonicalHeader(header, incomingTD)
 
+		if err := b.appendHeaderAccumulator(batchWriter, header); err != nil {
+			return false, nil, err
+		}
+
 		evnt.Type = EventHead
 		evnt.AddNewHeader(header)
 		evnt.SetDifficulty(incomingTD)
@@ -1180,6 +1336,10 @@ func (b *Blockchain) writeHeaderImpl(
 
 		batchWriter.PutCanonicalHeader(header, incomingTD)
 
+		if err := b.appendHeaderAccumulator(batchWriter, header); err != nil {
+			return false, nil, err
+		}
+
 		return true, incomingTD, nil
 	}
 
@@ -1297,6 +1457,7 @@ func (b *Blockchain) handleReorg(
 	// Set the event type and difficulty
 	evnt.Type = EventReorg
 	evnt.SetDifficulty(newTD)
+	evnt.Reorg = newReorgEvent(oldChainHead, newChainHead, len(evnt.OldChain))
 
 	return nil
 }
@@ -1349,8 +1510,11 @@ func (b *Blockchain) GetBlockByNumber(blockNumber uint64, full bool) (*types.Blo
 	return b.GetBlockByHash(blockHash, full)
 }
 
-// Close closes the DB connection
+// Close closes the DB connection, waiting for the in-flight block write to finish
 func (b *Blockchain) Close() error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
 	return b.db.Close()
 }
 
@@ -1393,10 +1557,17 @@ func (b *Blockchain) writeBatchAndUpdate(
 	header *types.Header,
 	newTD *big.Int,
 	isCanonnical bool) error {
+	txIndexTail := b.txIndexTail.Load()
+	if isCanonnical {
+		txIndexTail = b.pruneTxHistory(batchWriter, txIndexTail, header.Number)
+	}
+
 	if err := batchWriter.WriteBatch(); err != nil {
 		return err
 	}
 
+	b.txIndexTail.Store(txIndexTail)
+
 	if isCanonnical {
 		b.headersCache.Add(header.Hash, header)
 		b.setCurrentHeader(header, newTD) // Update the blockchain reference
@@ -1404,3 +1575,54 @@ func (b *Blockchain) writeBatchAndUpdate(
 
 	return nil
 }
+
+// pruneTxHistory deletes the transaction lookups and the receipts of the canonical blocks
+// preceding the history limit once the given block is the head, and returns the new tail.
+// Up to maxTxHistoryPruneBlocks are pruned at once, so the catch-up of a long chain is spread across the blocks
+func (b *Blockchain) pruneTxHistory(batchWriter *storage.BatchWriter, tail, head uint64) uint64 {
+	if b.txHistoryLimit == 0 || head < b.txHistoryLimit || tail > head-b.txHistoryLimit {
+		return tail
+	}
+
+	last := common.Min(head-b.txHistoryLimit, tail+maxTxHistoryPruneBlocks-1)
+
+	for number := tail; number <= last; number++ {
+		hash, ok := b.db.ReadCanonicalHash(number)
+		if !ok {
+			continue
+		}
+
+		body, err := b.db.ReadBody(hash)
+		if err != nil {
+			b.logger.Warn("failed to read the body of the pruned block", "number", number, "err", err)
+
+			continue
+		}
+
+		b.deleteTxHistory(batchWriter, number, hash, body)
+	}
+
+	batchWriter.PutTxIndexTail(last + 1)
+
+	return last + 1
+}
+
+// deleteTxHistory deletes the transaction lookups, the contract creations and the receipts of the given block
+func (b *Blockchain) deleteTxHistory(
+	batchWriter *storage.BatchWriter,
+	number uint64,
+	hash types.Hash,
+	body *types.Body,
+) {
+	for i, txn := range body.Transactions {
+		batchWriter.DeleteTxLookup(txn.Hash)
+
+		if b.senderTxLookup {
+			batchWriter.DeleteSenderTxLookup(txn.From, number, uint64(i))
+		}
+	}
+
+	// the creations are deleted even if the lookup is disabled now, since it may have been enabled before
+	b.deleteContractCreations(batchWriter, hash)
+	batchWriter.DeleteReceipts(hash)
+}
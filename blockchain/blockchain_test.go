@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -482,6 +483,14 @@ func TestInsertHeaders(t *testing.T) {
 						t.Fatal("bad diff in event")
 					}
 				}
+
+				if evnt.Type == EventReorg {
+					require.NotNil(t, evnt.Reorg)
+					assert.Equal(t, headers[0].Hash, evnt.Reorg.NewHead.Hash)
+					assert.Equal(t, uint64(len(evnt.OldChain)), evnt.Reorg.Depth)
+				} else {
+					assert.Nil(t, evnt.Reorg)
+				}
 			}
 
 			head := b.Header()
@@ -722,7 +731,7 @@ func Test_recoverFromFieldsInBlock(t *testing.T) {
 
 		assert.NoError(
 			t,
-			chain.recoverFromFieldsInBlock(block),
+			chain.RecoverFromFieldsInBlock(block),
 		)
 	})
 
@@ -756,7 +765,7 @@ func Test_recoverFromFieldsInBlock(t *testing.T) {
 
 		assert.ErrorIs(
 			t,
-			chain.recoverFromFieldsInBlock(block),
+			chain.RecoverFromFieldsInBlock(block),
 			errRecoveryAddressFailed,
 		)
 
@@ -991,6 +1000,51 @@ func TestCalculateGasLimit(t *testing.T) {
 	}
 }
 
+func TestSetBlockGasTarget(t *testing.T) {
+	t.Parallel()
+
+	const parentGasLimit = 25000000
+
+	storageCallback := func(storage *storage.MockStorage) {
+		storage.HookReadHeader(func(hash types.Hash) (*types.Header, error) {
+			return &types.Header{
+				GasLimit: parentGasLimit,
+			}, nil
+		})
+	}
+
+	b, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+		StorageCallback: storageCallback,
+	})
+	require.NoError(t, err)
+
+	b.config.Params = &chain.Params{
+		BlockGasTarget: parentGasLimit,
+	}
+
+	// block gas target from the chain config is used
+	require.Equal(t, uint64(parentGasLimit), b.BlockGasTarget())
+
+	nextGas, err := b.CalculateGasLimit(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(parentGasLimit), nextGas)
+
+	// block gas target set at runtime takes precedence, but gas limit change is bounded
+	b.SetBlockGasTarget(2 * parentGasLimit)
+	require.Equal(t, uint64(2*parentGasLimit), b.BlockGasTarget())
+
+	nextGas, err = b.CalculateGasLimit(1)
+	require.NoError(t, err)
+	require.Equal(t, parentGasLimit+parentGasLimit/blockGasTargetDivisor, nextGas)
+
+	// zero block gas target keeps the parent gas limit
+	b.SetBlockGasTarget(0)
+
+	nextGas, err = b.CalculateGasLimit(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(parentGasLimit), nextGas)
+}
+
 // TestGasPriceAverage tests the average gas price of the
 // blockchain
 func TestGasPriceAverage(t *testing.T) {
@@ -1227,7 +1281,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, ErrInvalidSha3Uncles)
 	})
 
@@ -1245,7 +1299,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, ErrInvalidTxRoot)
 	})
 
@@ -1273,7 +1327,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, ErrParentNotFound)
 	})
 
@@ -1313,7 +1367,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, errBlockCreatorNotFound)
 	})
 
@@ -1356,7 +1410,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, errUnableToExecute)
 	})
 }
@@ -1479,6 +1533,15 @@ func TestBlockchain_WriteFullBlock(t *testing.T) {
 	bc.currentDifficulty.Store(existingTD)
 
 	header.ParentHash = existingHeader.Hash
+
+	storageMock.HookReadHeaderAccumulatorNode(func(height uint8, index uint64) (types.Hash, bool) {
+		if height == 0 && index == existingHeader.Number {
+			return existingHeader.Hash, true
+		}
+
+		return types.ZeroHash, false
+	})
+
 	bc.txSigner = &mockSigner{
 		txFromByTxHash: map[types.Hash]types.Address{
 			tx.Hash: {1, 2},
@@ -1508,7 +1571,7 @@ func TestBlockchain_WriteFullBlock(t *testing.T) {
 	}, "polybft")
 
 	require.NoError(t, err)
-	require.Equal(t, 8, len(db))
+	require.Equal(t, 9, len(db))
 	require.Equal(t, uint64(2), bc.currentHeader.Load().Number)
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.BODY, header.Hash.Bytes()))])
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.TX_LOOKUP_PREFIX, tx.Hash.Bytes()))])
@@ -1518,4 +1581,113 @@ func TestBlockchain_WriteFullBlock(t *testing.T) {
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.DIFFICULTY, header.Hash.Bytes()))])
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.CANONICAL, common.EncodeUint64ToBytes(header.Number)))])
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.RECEIPTS, header.Hash.Bytes()))])
+	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.HEADER_ACCUMULATOR_PREFIX,
+		append([]byte{0}, common.EncodeUint64ToBytes(header.Number)...)))])
+}
+
+func TestBlockchain_WriteSenderTxLookups(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	bc := &Blockchain{
+		logger:         hclog.NewNullLogger(),
+		db:             db,
+		senderTxLookup: true,
+	}
+
+	sender1, sender2 := types.StringToAddress("1"), types.StringToAddress("2")
+
+	txs := make([]*types.Transaction, 3)
+	for i, from := range []types.Address{sender1, sender2, sender1} {
+		txs[i] = &types.Transaction{Nonce: uint64(i), From: from, Value: big.NewInt(1)}
+		txs[i].ComputeHash(1)
+	}
+
+	header := &types.Header{Number: 1}
+	header.ComputeHash()
+
+	block := &types.Block{Header: header, Transactions: txs}
+
+	batchWriter := storage.NewBatchWriter(db)
+	require.NoError(t, bc.writeBody(batchWriter, block))
+	require.NoError(t, batchWriter.WriteBatch())
+
+	txHashes, err := bc.ReadSenderTxLookups(sender1, 0, 1, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Hash{txs[0].Hash, txs[2].Hash}, txHashes)
+
+	txHashes, err = bc.ReadSenderTxLookups(sender2, 0, 1, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Hash{txs[1].Hash}, txHashes)
+
+	txHashes, err = bc.ReadSenderTxLookups(types.StringToAddress("3"), 0, 1, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, txHashes)
+}
+
+func TestBlockchain_PruneTxHistory(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	bc := &Blockchain{
+		logger:         hclog.NewNullLogger(),
+		db:             db,
+		senderTxLookup: true,
+	}
+
+	bc.SetTxHistoryLimit(3)
+
+	sender := types.StringToAddress("1")
+	blocks := make([]*types.Block, 10)
+
+	for i := range blocks {
+		txn := &types.Transaction{Nonce: uint64(i), From: sender, Value: big.NewInt(1)}
+		txn.ComputeHash(1)
+
+		header := &types.Header{Number: uint64(i)}
+		header.ComputeHash()
+
+		blocks[i] = &types.Block{Header: header, Transactions: []*types.Transaction{txn}}
+
+		batchWriter := storage.NewBatchWriter(db)
+		require.NoError(t, bc.writeBody(batchWriter, blocks[i]))
+		batchWriter.PutCanonicalHeader(header, big.NewInt(int64(i)))
+		batchWriter.PutReceipts(header.Hash, []*types.Receipt{{TxHash: txn.Hash}})
+
+		tail := bc.pruneTxHistory(batchWriter, bc.TxIndexTail(), header.Number)
+
+		require.NoError(t, batchWriter.WriteBatch())
+		bc.txIndexTail.Store(tail)
+	}
+
+	// the transaction history of the latest 3 blocks is kept
+	require.Equal(t, uint64(7), bc.TxIndexTail())
+
+	for i, block := range blocks {
+		kept := i >= 7
+		txHash := block.Transactions[0].Hash
+
+		_, ok := bc.ReadTxLookup(txHash)
+		assert.Equal(t, kept, ok, "block %d", i)
+
+		txHashes, err := bc.ReadSenderTxLookups(sender, block.Number(), block.Number(), 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, kept, len(txHashes) == 1, "block %d", i)
+
+		_, err = db.ReadReceipts(block.Hash())
+		assert.Equal(t, kept, err == nil, "block %d", i)
+
+		// the bodies are kept
+		_, err = db.ReadBody(block.Hash())
+		assert.NoError(t, err)
+	}
+
+	// the tail is persisted
+	tail, ok := db.ReadTxIndexTail()
+	require.True(t, ok)
+	require.Equal(t, uint64(7), tail)
 }
@@ -0,0 +1,78 @@
+package blockchain
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// EnableContractCreationLookup enables indexing of the contracts created by the imported blocks.
+// It must be called before the blockchain starts importing blocks
+func (b *Blockchain) EnableContractCreationLookup() {
+	b.contractCreationLookup = true
+}
+
+// ContractCreationLookupEnabled returns true if the contract creations are indexed
+func (b *Blockchain) ContractCreationLookupEnabled() bool {
+	return b.contractCreationLookup
+}
+
+// writeContractCreations indexes the contracts created by the block transactions (address -> creation).
+// The contracts created by the other contracts are indexed as well.
+// The addresses of the created contracts are kept by the block, so the creations can be deleted with the block
+func (b *Blockchain) writeContractCreations(
+	batchWriter *storage.BatchWriter,
+	block *types.Block,
+	receipts []*types.Receipt,
+) {
+	var addrs []types.Address
+
+	for _, receipt := range receipts {
+		for _, creation := range receipt.ContractCreations {
+			batchWriter.PutContractCreation(&types.ContractCreation{
+				Address:     creation.Address,
+				Creator:     creation.Creator,
+				TxHash:      receipt.TxHash,
+				BlockHash:   block.Hash(),
+				BlockNumber: block.Number(),
+			})
+
+			addrs = append(addrs, creation.Address)
+		}
+	}
+
+	if len(addrs) > 0 {
+		batchWriter.PutBlockContractCreations(block.Hash(), addrs)
+	}
+}
+
+// deleteContractCreations deletes the contract creations indexed by the given block.
+// The creations indexed by the other blocks (the contract is created again) are kept
+func (b *Blockchain) deleteContractCreations(batchWriter *storage.BatchWriter, hash types.Hash) {
+	addrs, ok := b.db.ReadBlockContractCreations(hash)
+	if !ok {
+		return
+	}
+
+	for _, addr := range addrs {
+		if creation, ok := b.db.ReadContractCreation(addr); ok && creation.BlockHash == hash {
+			batchWriter.DeleteContractCreation(addr)
+		}
+	}
+
+	batchWriter.DeleteBlockContractCreations(hash)
+}
+
+// GetContractCreation returns the last creation of the contract at the given address.
+// The creations indexed by the blocks which are no longer canonical are not returned
+func (b *Blockchain) GetContractCreation(addr types.Address) (*types.ContractCreation, bool) {
+	creation, ok := b.db.ReadContractCreation(addr)
+	if !ok {
+		return nil, false
+	}
+
+	if hash, ok := b.db.ReadCanonicalHash(creation.BlockNumber); !ok || hash != creation.BlockHash {
+		return nil, false
+	}
+
+	return creation, true
+}
@@ -0,0 +1,125 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_ContractCreations(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	bc := &Blockchain{
+		logger: hclog.NewNullLogger(),
+		db:     db,
+	}
+
+	bc.EnableContractCreationLookup()
+
+	var (
+		creator  = types.StringToAddress("1")
+		contract = types.StringToAddress("2")
+		child    = types.StringToAddress("3")
+		txHash   = types.StringToHash("1")
+	)
+
+	header := &types.Header{Number: 1}
+	header.ComputeHash()
+
+	receipts := []*types.Receipt{{
+		TxHash: txHash,
+		ContractCreations: []*types.ContractCreation{
+			{Address: contract, Creator: creator},
+			{Address: child, Creator: contract},
+		},
+	}}
+
+	batchWriter := storage.NewBatchWriter(db)
+	bc.writeContractCreations(batchWriter, &types.Block{Header: header}, receipts)
+	batchWriter.PutCanonicalHeader(header, big.NewInt(1))
+	require.NoError(t, batchWriter.WriteBatch())
+
+	creation, ok := bc.GetContractCreation(child)
+	require.True(t, ok)
+	require.Equal(t, &types.ContractCreation{
+		Address:     child,
+		Creator:     contract,
+		TxHash:      txHash,
+		BlockHash:   header.Hash,
+		BlockNumber: 1,
+	}, creation)
+
+	_, ok = bc.GetContractCreation(creator)
+	require.False(t, ok)
+
+	// the creations of the blocks which are no longer canonical are not returned
+	fork := &types.Header{Number: 1, ExtraData: []byte{0x1}}
+	fork.ComputeHash()
+
+	batchWriter = storage.NewBatchWriter(db)
+	batchWriter.PutCanonicalHeader(fork, big.NewInt(1))
+	require.NoError(t, batchWriter.WriteBatch())
+
+	_, ok = bc.GetContractCreation(contract)
+	require.False(t, ok)
+}
+
+func TestBlockchain_ContractCreations_Rewind(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(10)
+	b := NewTestBlockchain(t, headers)
+	b.EnableContractCreationLookup()
+
+	var (
+		contract   = types.StringToAddress("2")
+		redeployed = types.StringToAddress("3")
+	)
+
+	deploy := func(header *types.Header, addrs ...types.Address) {
+		t.Helper()
+
+		receipt := &types.Receipt{TxHash: types.StringToHash("1")}
+		for _, addr := range addrs {
+			receipt.ContractCreations = append(receipt.ContractCreations, &types.ContractCreation{Address: addr})
+		}
+
+		batchWriter := storage.NewBatchWriter(b.db)
+		batchWriter.PutBody(header.Hash, &types.Body{})
+		b.writeContractCreations(batchWriter, &types.Block{Header: header}, []*types.Receipt{receipt})
+		require.NoError(t, batchWriter.WriteBatch())
+	}
+
+	deploy(headers[3], redeployed)
+	deploy(headers[7], contract, redeployed)
+
+	_, ok := b.GetContractCreation(contract)
+	require.True(t, ok)
+
+	require.NoError(t, b.Rewind(headers[5].Hash))
+
+	// the creations of the discarded block are deleted from the index
+	_, ok = b.GetContractCreation(contract)
+	require.False(t, ok)
+
+	_, ok = b.db.ReadContractCreation(contract)
+	require.False(t, ok)
+
+	_, ok = b.db.ReadContractCreation(redeployed)
+	require.False(t, ok)
+
+	_, ok = b.db.ReadBlockContractCreations(headers[7].Hash)
+	require.False(t, ok)
+
+	// the creations of the kept blocks are not affected
+	_, ok = b.db.ReadBlockContractCreations(headers[3].Hash)
+	require.True(t, ok)
+}
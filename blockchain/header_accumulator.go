@@ -0,0 +1,145 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/accumulator"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// accumulatorBackfillBatchSize is the number of headers added to the header accumulator per batch
+// while the accumulator of an existing chain is built
+const accumulatorBackfillBatchSize = 1000
+
+var ErrHeaderNotCanonical = errors.New("header is not in the canonical chain")
+
+// HeaderAccumulatorProof is the proof that the header is an ancestor of the current head
+type HeaderAccumulatorProof struct {
+	// Head is the current head the proof is created for
+	Head *types.Header
+
+	// Root is the root of the header accumulator at the current head
+	Root types.Hash
+
+	// Proof is the proof that the header hash is in the accumulator
+	Proof *accumulator.Proof
+}
+
+// appendHeaderAccumulator adds the new canonical head to the header accumulator.
+// The canonical ancestors which are not in the accumulator yet (e.g. on reorg) are added as well
+func (b *Blockchain) appendHeaderAccumulator(batchWriter *storage.BatchWriter, header *types.Header) error {
+	writer := accumulator.NewWriter(b.db.ReadHeaderAccumulatorNode, batchWriter.PutHeaderAccumulatorNode)
+
+	headers := []*types.Header{header}
+
+	for h := header; h.Number > 0; {
+		if leaf, ok := writer.Leaf(h.Number - 1); ok && leaf == h.ParentHash {
+			break
+		}
+
+		parent, ok := b.readHeader(h.ParentHash)
+		if !ok {
+			return fmt.Errorf("header '%s' not found", h.ParentHash.String())
+		}
+
+		headers = append(headers, parent)
+		h = parent
+	}
+
+	for i := len(headers) - 1; i >= 0; i-- {
+		if err := writer.Append(headers[i].Number, headers[i].Hash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillHeaderAccumulator adds the canonical headers written before the header accumulator
+// was introduced to the accumulator
+func (b *Blockchain) backfillHeaderAccumulator(head *types.Header) error {
+	// find the first canonical header missing in the accumulator
+	from := head.Number + 1
+
+	for from > 0 {
+		hash, ok := b.db.ReadCanonicalHash(from - 1)
+		if !ok {
+			return fmt.Errorf("canonical hash of the block %d not found", from-1)
+		}
+
+		if leaf, ok := b.db.ReadHeaderAccumulatorNode(0, from-1); ok && leaf == hash {
+			break
+		}
+
+		from--
+	}
+
+	if from > head.Number {
+		return nil
+	}
+
+	b.logger.Info("Building header accumulator", "from", from, "to", head.Number)
+
+	for from <= head.Number {
+		batchWriter := storage.NewBatchWriter(b.db)
+		writer := accumulator.NewWriter(b.db.ReadHeaderAccumulatorNode, batchWriter.PutHeaderAccumulatorNode)
+
+		to := from + accumulatorBackfillBatchSize - 1
+		if to > head.Number {
+			to = head.Number
+		}
+
+		for i := from; i <= to; i++ {
+			hash, ok := b.db.ReadCanonicalHash(i)
+			if !ok {
+				return fmt.Errorf("canonical hash of the block %d not found", i)
+			}
+
+			if err := writer.Append(i, hash); err != nil {
+				return err
+			}
+		}
+
+		if err := batchWriter.WriteBatch(); err != nil {
+			return err
+		}
+
+		from = to + 1
+	}
+
+	return nil
+}
+
+// HeaderAccumulatorRoot returns the root of the header accumulator at the current head
+func (b *Blockchain) HeaderAccumulatorRoot() (types.Hash, error) {
+	return accumulator.Root(b.db.ReadHeaderAccumulatorNode, b.Header().Number+1)
+}
+
+// GetHeaderAccumulatorProof returns the proof that the canonical header with the given number
+// is an ancestor of the current head
+func (b *Blockchain) GetHeaderAccumulatorProof(number uint64) (*HeaderAccumulatorProof, error) {
+	head := b.Header()
+	if number > head.Number {
+		return nil, ErrHeaderNotCanonical
+	}
+
+	numLeaves := head.Number + 1
+
+	root, err := accumulator.Root(b.db.ReadHeaderAccumulatorNode, numLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := accumulator.Prove(b.db.ReadHeaderAccumulatorNode, number, numLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HeaderAccumulatorProof{
+		Head:  head,
+		Root:  root,
+		Proof: proof,
+	}, nil
+}
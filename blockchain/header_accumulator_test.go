@@ -0,0 +1,111 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderAccumulator_Proof(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(20)
+	b := NewTestBlockchain(t, headers)
+
+	root, err := b.HeaderAccumulatorRoot()
+	require.NoError(t, err)
+
+	for _, header := range headers {
+		proof, err := b.GetHeaderAccumulatorProof(header.Number)
+		require.NoError(t, err)
+
+		require.Equal(t, root, proof.Root)
+		require.Equal(t, headers[len(headers)-1].Hash, proof.Head.Hash)
+		require.True(t, proof.Proof.Verify(header.Hash, root))
+	}
+
+	_, err = b.GetHeaderAccumulatorProof(uint64(len(headers)))
+	require.ErrorIs(t, err, ErrHeaderNotCanonical)
+}
+
+func TestHeaderAccumulator_Reorg(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(10)
+	b := NewTestBlockchain(t, headers)
+
+	// the fork has higher difficulty, so it becomes canonical
+	forkHeaders := AppendNewTestheadersWithSeed(headers[:5], 10, 1)
+	require.NoError(t, b.WriteHeadersWithBodies(forkHeaders[5:]))
+	require.Equal(t, forkHeaders[len(forkHeaders)-1].Hash, b.Header().Hash)
+
+	root, err := b.HeaderAccumulatorRoot()
+	require.NoError(t, err)
+
+	for _, header := range forkHeaders {
+		proof, err := b.GetHeaderAccumulatorProof(header.Number)
+		require.NoError(t, err)
+		require.True(t, proof.Proof.Verify(header.Hash, root))
+	}
+
+	// the accumulator is the same as if the fork was written from the start
+	expectedRoot, err := NewTestBlockchain(t, forkHeaders).HeaderAccumulatorRoot()
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, root)
+
+	// the headers of the old chain are not in the accumulator
+	proof, err := b.GetHeaderAccumulatorProof(headers[7].Number)
+	require.NoError(t, err)
+	require.False(t, proof.Proof.Verify(headers[7].Hash, root))
+}
+
+func TestHeaderAccumulator_Backfill(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(accumulatorBackfillBatchSize + 10)
+	b := NewTestBlockchain(t, nil)
+
+	// write the chain without the header accumulator
+	batchWriter := storage.NewBatchWriter(b.db)
+
+	for _, header := range headers {
+		batchWriter.PutCanonicalHeader(header, new(big.Int).SetUint64(header.Difficulty))
+	}
+
+	head := headers[len(headers)-1]
+	require.NoError(t, b.writeBatchAndUpdate(batchWriter, head, new(big.Int).SetUint64(head.Difficulty), true))
+
+	_, err := b.HeaderAccumulatorRoot()
+	require.Error(t, err)
+
+	require.NoError(t, b.backfillHeaderAccumulator(head))
+
+	root, err := b.HeaderAccumulatorRoot()
+	require.NoError(t, err)
+
+	for _, number := range []uint64{0, 1, accumulatorBackfillBatchSize, head.Number} {
+		proof, err := b.GetHeaderAccumulatorProof(number)
+		require.NoError(t, err)
+		require.True(t, proof.Proof.Verify(headers[number].Hash, root))
+	}
+
+	// the following blocks are appended to the built accumulator
+	headers = AppendNewTestHeaders(headers, 3)
+	require.NoError(t, b.WriteHeadersWithBodies(headers[len(headers)-3:]))
+
+	root, err = b.HeaderAccumulatorRoot()
+	require.NoError(t, err)
+
+	proof, err := b.GetHeaderAccumulatorProof(5)
+	require.NoError(t, err)
+	require.True(t, proof.Proof.Verify(headers[5].Hash, root))
+
+	// nothing to backfill
+	require.NoError(t, b.backfillHeaderAccumulator(b.Header()))
+
+	sameRoot, err := b.HeaderAccumulatorRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, sameRoot)
+}
@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+// blockchainMetrics is the prefix of the blockchain metrics
+const blockchainMetrics = "blockchain"
+
+// ReorgEvent holds the details of a chain reorganization
+type ReorgEvent struct {
+	// OldHead is the head of the chain before the reorganization
+	OldHead *types.Header
+
+	// NewHead is the head of the chain after the reorganization
+	NewHead *types.Header
+
+	// Depth is the number of blocks removed from the canonical chain
+	Depth uint64
+
+	// DroppedTxs are the hashes of the transactions included in the removed blocks,
+	// which are not included in the new canonical chain
+	DroppedTxs []types.Hash
+}
+
+// newReorgEvent creates a reorg event for the given old and new chain heads.
+// The dropped transactions are resolved once the new chain is written, see finalizeReorgEvent
+func newReorgEvent(oldHead, newHead *types.Header, depth int) *ReorgEvent {
+	return &ReorgEvent{
+		OldHead: oldHead.Copy(),
+		NewHead: newHead.Copy(),
+		Depth:   uint64(depth),
+	}
+}
+
+// finalizeReorgEvent resolves the transactions dropped by the reorganization and records the reorg metrics.
+// It must be called after the new chain is written to the storage. The event is finalized only once
+func (b *Blockchain) finalizeReorgEvent(evnt *Event) {
+	reorg := evnt.Reorg
+	if reorg.DroppedTxs != nil {
+		// already finalized
+		return
+	}
+
+	included := make(map[types.Hash]struct{})
+
+	for _, header := range evnt.NewChain {
+		if header.Number == 0 {
+			// the chain rewound to the genesis, which has no body
+			continue
+		}
+
+		if body, ok := b.readBody(header.Hash); ok {
+			for _, tx := range body.Transactions {
+				included[tx.Hash] = struct{}{}
+			}
+		}
+	}
+
+	reorg.DroppedTxs = []types.Hash{}
+
+	for _, header := range evnt.OldChain {
+		body, ok := b.readBody(header.Hash)
+		if !ok {
+			continue
+		}
+
+		for _, tx := range body.Transactions {
+			if _, ok := included[tx.Hash]; !ok {
+				reorg.DroppedTxs = append(reorg.DroppedTxs, tx.Hash)
+			}
+		}
+	}
+
+	metrics.IncrCounter([]string{blockchainMetrics, "reorgs"}, 1)
+	metrics.AddSample([]string{blockchainMetrics, "reorg_depth"}, float32(reorg.Depth))
+
+	b.logger.Warn(
+		"chain reorganization",
+		"oldHead", reorg.OldHead.Hash,
+		"oldNumber", reorg.OldHead.Number,
+		"newHead", reorg.NewHead.Hash,
+		"newNumber", reorg.NewHead.Number,
+		"depth", reorg.Depth,
+		"droppedTxs", len(reorg.DroppedTxs),
+	)
+}
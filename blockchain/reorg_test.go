@@ -0,0 +1,44 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_FinalizeReorgEvent(t *testing.T) {
+	t.Parallel()
+
+	b := NewTestBlockchain(t, nil)
+
+	tx1 := (&types.Transaction{Nonce: 1}).ComputeHash(1)
+	tx2 := (&types.Transaction{Nonce: 2}).ComputeHash(1)
+	tx3 := (&types.Transaction{Nonce: 3}).ComputeHash(1)
+
+	oldHead := &types.Header{Number: 1, Hash: types.StringToHash("0xa")}
+	newHead := &types.Header{Number: 1, Hash: types.StringToHash("0xb")}
+
+	batchWriter := storage.NewBatchWriter(b.db)
+	batchWriter.PutHeader(oldHead)
+	batchWriter.PutHeader(newHead)
+	batchWriter.PutBody(oldHead.Hash, &types.Body{Transactions: []*types.Transaction{tx1, tx2}})
+	batchWriter.PutBody(newHead.Hash, &types.Body{Transactions: []*types.Transaction{tx2, tx3}})
+	require.NoError(t, batchWriter.WriteBatch())
+
+	evnt := &Event{
+		Type:     EventReorg,
+		OldChain: []*types.Header{oldHead},
+		NewChain: []*types.Header{newHead},
+		Reorg:    newReorgEvent(oldHead, newHead, 1),
+	}
+
+	b.finalizeReorgEvent(evnt)
+
+	assert.Equal(t, oldHead.Hash, evnt.Reorg.OldHead.Hash)
+	assert.Equal(t, newHead.Hash, evnt.Reorg.NewHead.Hash)
+	assert.Equal(t, uint64(1), evnt.Reorg.Depth)
+	assert.Equal(t, []types.Hash{tx1.Hash}, evnt.Reorg.DroppedTxs)
+}
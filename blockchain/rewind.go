@@ -0,0 +1,83 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// rewindSource is the source of the events dispatched on the chain rewind
+const rewindSource = "rewind"
+
+var ErrRewindNotCanonical = errors.New("the rewind target is not a canonical block")
+
+// Rewind sets the head of the chain to the given canonical block and discards the blocks after it.
+// The state of the discarded blocks stays in the storage, since it is addressed by the state roots.
+// The discarded blocks are dispatched as a chain reorganization. Intended for the dev networks only
+func (b *Blockchain) Rewind(hash types.Hash) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	header, ok := b.readHeader(hash)
+	if !ok {
+		return fmt.Errorf("header '%s' not found", hash)
+	}
+
+	if canonical, ok := b.db.ReadCanonicalHash(header.Number); !ok || canonical != hash {
+		return ErrRewindNotCanonical
+	}
+
+	current := b.Header()
+	if header.Number >= current.Number {
+		return nil
+	}
+
+	td, ok := b.readTotalDifficulty(hash)
+	if !ok {
+		return errors.New("failed to get header difficulty")
+	}
+
+	batchWriter := storage.NewBatchWriter(b.db)
+	evnt := &Event{Source: rewindSource}
+
+	for discarded := current; discarded.Number > header.Number; {
+		batchWriter.DeleteCanonicalHash(discarded.Number)
+
+		if body, ok := b.readBody(discarded.Hash); ok {
+			b.deleteTxHistory(batchWriter, discarded.Number, discarded.Hash, body)
+		}
+
+		evnt.AddOldHeader(discarded)
+
+		parent, ok := b.readHeader(discarded.ParentHash)
+		if !ok {
+			return fmt.Errorf("header '%s' not found", discarded.ParentHash)
+		}
+
+		discarded = parent
+	}
+
+	batchWriter.PutHeadHash(header.Hash)
+	batchWriter.PutHeadNumber(header.Number)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		return err
+	}
+
+	b.setCurrentHeader(header, td)
+
+	evnt.Type = EventReorg
+	evnt.AddNewHeader(header)
+	evnt.SetDifficulty(td)
+	evnt.Reorg = newReorgEvent(current, header, len(evnt.OldChain))
+
+	// the discarded blocks keep their bodies, so the dropped transactions are resolved from them
+	b.finalizeReorgEvent(evnt)
+	b.dispatchEvent(evnt)
+
+	b.logger.Warn("chain rewound", "number", header.Number, "hash", header.Hash, "discarded", len(evnt.OldChain))
+
+	return nil
+}
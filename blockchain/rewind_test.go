@@ -0,0 +1,73 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_Rewind(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(10)
+	b := NewTestBlockchain(t, headers)
+
+	target := headers[5]
+
+	require.NoError(t, b.Rewind(target.Hash))
+	require.Equal(t, target.Hash, b.Header().Hash)
+
+	// the discarded blocks are not canonical anymore
+	_, ok := b.GetHeaderByNumber(target.Number + 1)
+	require.False(t, ok)
+
+	// the chain is extended from the new head
+	forkHeaders := AppendNewTestheadersWithSeed(headers[:6], 6, 1)
+	require.NoError(t, b.WriteHeadersWithBodies(forkHeaders[6:]))
+	require.Equal(t, forkHeaders[len(forkHeaders)-1].Hash, b.Header().Hash)
+
+	root, err := b.HeaderAccumulatorRoot()
+	require.NoError(t, err)
+
+	expectedRoot, err := NewTestBlockchain(t, forkHeaders).HeaderAccumulatorRoot()
+	require.NoError(t, err)
+	require.Equal(t, expectedRoot, root)
+
+	// the discarded blocks can't be the rewind target
+	require.ErrorIs(t, b.Rewind(headers[8].Hash), ErrRewindNotCanonical)
+
+	// rewinding to the head is a no-op
+	require.NoError(t, b.Rewind(b.Header().Hash))
+	require.Equal(t, forkHeaders[len(forkHeaders)-1].Hash, b.Header().Hash)
+
+	require.Error(t, b.Rewind(types.StringToHash("0xff")))
+}
+
+func TestBlockchain_Rewind_Event(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(10)
+	b := NewTestBlockchain(t, headers)
+
+	// the transaction of the discarded block is dropped by the rewind
+	tx := (&types.Transaction{Nonce: 1, From: types.StringToAddress("1")}).ComputeHash(1)
+
+	batchWriter := storage.NewBatchWriter(b.db)
+	batchWriter.PutBody(headers[7].Hash, &types.Body{Transactions: []*types.Transaction{tx}})
+	require.NoError(t, batchWriter.WriteBatch())
+
+	sub := b.SubscribeEvents()
+	defer sub.Close()
+
+	require.NoError(t, b.Rewind(headers[5].Hash))
+
+	evnt := sub.GetEvent()
+	require.Equal(t, EventReorg, evnt.Type)
+	require.Equal(t, rewindSource, evnt.Source)
+	require.Len(t, evnt.OldChain, 4)
+	require.Equal(t, uint64(4), evnt.Reorg.Depth)
+	require.Equal(t, []types.Hash{tx.Hash}, evnt.Reorg.DroppedTxs)
+	require.Equal(t, headers[5].Hash, evnt.Header().Hash)
+}
@@ -41,16 +41,69 @@ func (b *BatchWriter) PutTxLookup(hash types.Hash, blockHash types.Hash) {
 	b.putWithPrefix(TX_LOOKUP_PREFIX, hash.Bytes(), vr)
 }
 
+func (b *BatchWriter) DeleteTxLookup(hash types.Hash) {
+	b.deleteWithPrefix(TX_LOOKUP_PREFIX, hash.Bytes())
+}
+
+func (b *BatchWriter) PutSenderTxLookup(sender types.Address, blockNumber, txIndex uint64, txHash types.Hash) {
+	b.putWithPrefix(SENDER_TX_LOOKUP_PREFIX, senderTxLookupKey(sender, blockNumber, txIndex), txHash.Bytes())
+}
+
+func (b *BatchWriter) DeleteSenderTxLookup(sender types.Address, blockNumber, txIndex uint64) {
+	b.deleteWithPrefix(SENDER_TX_LOOKUP_PREFIX, senderTxLookupKey(sender, blockNumber, txIndex))
+}
+
+func (b *BatchWriter) PutContractCreation(creation *types.ContractCreation) {
+	ar := &fastrlp.Arena{}
+	vv := ar.NewArray()
+
+	vv.Set(ar.NewBytes(creation.Creator.Bytes()))
+	vv.Set(ar.NewBytes(creation.TxHash.Bytes()))
+	vv.Set(ar.NewBytes(creation.BlockHash.Bytes()))
+	vv.Set(ar.NewUint(creation.BlockNumber))
+
+	b.putWithPrefix(CONTRACT_CREATION_PREFIX, creation.Address.Bytes(), vv.MarshalTo(nil))
+}
+
+func (b *BatchWriter) DeleteContractCreation(addr types.Address) {
+	b.deleteWithPrefix(CONTRACT_CREATION_PREFIX, addr.Bytes())
+}
+
+func (b *BatchWriter) PutBlockContractCreations(hash types.Hash, addrs []types.Address) {
+	data := make([]byte, 0, len(addrs)*types.AddressLength)
+	for _, addr := range addrs {
+		data = append(data, addr.Bytes()...)
+	}
+
+	b.putWithPrefix(BLOCK_CONTRACT_CREATIONS_PREFIX, hash.Bytes(), data)
+}
+
+func (b *BatchWriter) DeleteBlockContractCreations(hash types.Hash) {
+	b.deleteWithPrefix(BLOCK_CONTRACT_CREATIONS_PREFIX, hash.Bytes())
+}
+
+func (b *BatchWriter) PutHeaderAccumulatorNode(height uint8, index uint64, hash types.Hash) {
+	b.putWithPrefix(HEADER_ACCUMULATOR_PREFIX, headerAccumulatorNodeKey(height, index), hash.Bytes())
+}
+
 func (b *BatchWriter) PutHeadNumber(n uint64) {
 	b.putWithPrefix(HEAD, NUMBER, common.EncodeUint64ToBytes(n))
 }
 
+func (b *BatchWriter) PutTxIndexTail(n uint64) {
+	b.putWithPrefix(HEAD, TXTAIL, common.EncodeUint64ToBytes(n))
+}
+
 func (b *BatchWriter) PutReceipts(hash types.Hash, receipts []*types.Receipt) {
 	rr := types.Receipts(receipts)
 
 	b.putRlp(RECEIPTS, hash.Bytes(), &rr)
 }
 
+func (b *BatchWriter) DeleteReceipts(hash types.Hash) {
+	b.deleteWithPrefix(RECEIPTS, hash.Bytes())
+}
+
 func (b *BatchWriter) PutCanonicalHeader(h *types.Header, diff *big.Int) {
 	b.PutHeader(h)
 	b.PutHeadHash(h.Hash)
@@ -63,6 +116,10 @@ func (b *BatchWriter) PutCanonicalHash(n uint64, hash types.Hash) {
 	b.putWithPrefix(CANONICAL, common.EncodeUint64ToBytes(n), hash.Bytes())
 }
 
+func (b *BatchWriter) DeleteCanonicalHash(n uint64) {
+	b.deleteWithPrefix(CANONICAL, common.EncodeUint64ToBytes(n))
+}
+
 func (b *BatchWriter) PutTotalDifficulty(hash types.Hash, diff *big.Int) {
 	b.putWithPrefix(DIFFICULTY, hash.Bytes(), diff.Bytes())
 }
@@ -91,6 +148,12 @@ func (b *BatchWriter) putWithPrefix(p, k, data []byte) {
 	b.batch.Put(fullKey, data)
 }
 
+func (b *BatchWriter) deleteWithPrefix(p, k []byte) {
+	fullKey := append(append(make([]byte, 0, len(p)+len(k)), p...), k...)
+
+	b.batch.Delete(fullKey)
+}
+
 func (b *BatchWriter) WriteBatch() error {
 	return b.batch.Write()
 }
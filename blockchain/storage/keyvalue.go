@@ -39,6 +39,18 @@ var (
 
 	// TX_LOOKUP_PREFIX is the prefix for transaction lookups
 	TX_LOOKUP_PREFIX = []byte("l")
+
+	// SENDER_TX_LOOKUP_PREFIX is the prefix for transaction lookups by sender
+	SENDER_TX_LOOKUP_PREFIX = []byte("x")
+
+	// HEADER_ACCUMULATOR_PREFIX is the prefix for the nodes of the header accumulator
+	HEADER_ACCUMULATOR_PREFIX = []byte("a")
+
+	// CONTRACT_CREATION_PREFIX is the prefix for the contract creations
+	CONTRACT_CREATION_PREFIX = []byte("e")
+
+	// BLOCK_CONTRACT_CREATIONS_PREFIX is the prefix for the addresses of the contracts created by the block
+	BLOCK_CONTRACT_CREATIONS_PREFIX = []byte("k")
 )
 
 // Sub-prefixes
@@ -46,6 +58,7 @@ var (
 	HASH   = []byte("hash")
 	NUMBER = []byte("number")
 	EMPTY  = []byte("empty")
+	TXTAIL = []byte("txtail")
 )
 
 // KV is a key value storage interface.
@@ -55,6 +68,11 @@ type KV interface {
 	Close() error
 	Get(p []byte) ([]byte, bool, error)
 	NewBatch() Batch
+
+	// Iterate calls fn with the key-value pairs whose keys have the given prefix, in the ascending order
+	// of the keys starting from the start key, until fn returns false.
+	// The key and the value passed to fn must not be retained after it returns
+	Iterate(prefix, start []byte, fn func(key, value []byte) bool) error
 }
 
 // KeyValueStorage is a generic storage for kv databases
@@ -106,6 +124,20 @@ func (s *KeyValueStorage) ReadHeadNumber() (uint64, bool) {
 	return common.EncodeBytesToUint64(data), true
 }
 
+// ReadTxIndexTail returns the number of the oldest block whose transaction lookups and receipts are kept
+func (s *KeyValueStorage) ReadTxIndexTail() (uint64, bool) {
+	data, ok := s.get(HEAD, TXTAIL)
+	if !ok {
+		return 0, false
+	}
+
+	if len(data) != 8 {
+		return 0, false
+	}
+
+	return common.EncodeBytesToUint64(data), true
+}
+
 // FORK //
 
 // ReadForks read the current forks
@@ -191,6 +223,104 @@ func (s *KeyValueStorage) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	return types.BytesToHash(blockHash), true
 }
 
+// ReadSenderTxLookups reads the hashes of the transactions sent by the sender in the given block range,
+// ordered by the block number and the position in the block. The first skip transactions are skipped,
+// and at most limit hashes are returned
+func (s *KeyValueStorage) ReadSenderTxLookups(
+	sender types.Address,
+	fromBlock, toBlock uint64,
+	skip, limit uint64,
+) ([]types.Hash, error) {
+	txHashes := make([]types.Hash, 0)
+	if limit == 0 {
+		return txHashes, nil
+	}
+
+	prefix := append(append(make([]byte, 0, len(SENDER_TX_LOOKUP_PREFIX)+types.AddressLength),
+		SENDER_TX_LOOKUP_PREFIX...), sender.Bytes()...)
+	start := append(append(make([]byte, 0, len(prefix)+8), prefix...), common.EncodeUint64ToBytes(fromBlock)...)
+
+	err := s.db.Iterate(prefix, start, func(key, value []byte) bool {
+		if common.EncodeBytesToUint64(key[len(prefix):len(prefix)+8]) > toBlock {
+			return false
+		}
+
+		if skip > 0 {
+			skip--
+
+			return true
+		}
+
+		txHashes = append(txHashes, types.BytesToHash(value))
+
+		return uint64(len(txHashes)) < limit
+	})
+
+	return txHashes, err
+}
+
+// senderTxLookupKey returns the key of the transaction lookup of the sender,
+// ordering the transactions of the sender by the block number and the position in the block
+func senderTxLookupKey(sender types.Address, blockNumber, txIndex uint64) []byte {
+	key := make([]byte, 0, types.AddressLength+16)
+	key = append(key, sender.Bytes()...)
+	key = append(key, common.EncodeUint64ToBytes(blockNumber)...)
+
+	return append(key, common.EncodeUint64ToBytes(txIndex)...)
+}
+
+// ReadContractCreation reads the last creation of the contract at the given address
+func (s *KeyValueStorage) ReadContractCreation(addr types.Address) (*types.ContractCreation, bool) {
+	parser := &fastrlp.Parser{}
+
+	v := s.read2(CONTRACT_CREATION_PREFIX, addr.Bytes(), parser)
+	if v == nil {
+		return nil, false
+	}
+
+	elems, err := v.GetElems()
+	if err != nil || len(elems) != 4 {
+		return nil, false
+	}
+
+	creation := &types.ContractCreation{Address: addr}
+
+	if err := elems[0].GetAddr(creation.Creator[:]); err != nil {
+		return nil, false
+	}
+
+	if err := elems[1].GetHash(creation.TxHash[:]); err != nil {
+		return nil, false
+	}
+
+	if err := elems[2].GetHash(creation.BlockHash[:]); err != nil {
+		return nil, false
+	}
+
+	if creation.BlockNumber, err = elems[3].GetUint64(); err != nil {
+		return nil, false
+	}
+
+	return creation, true
+}
+
+// HEADER ACCUMULATOR //
+
+// ReadHeaderAccumulatorNode reads the node of the header accumulator
+func (s *KeyValueStorage) ReadHeaderAccumulatorNode(height uint8, index uint64) (types.Hash, bool) {
+	data, ok := s.get(HEADER_ACCUMULATOR_PREFIX, headerAccumulatorNodeKey(height, index))
+	if !ok {
+		return types.Hash{}, false
+	}
+
+	return types.BytesToHash(data), true
+}
+
+// headerAccumulatorNodeKey returns the key of the header accumulator node
+func headerAccumulatorNodeKey(height uint8, index uint64) []byte {
+	return append([]byte{height}, common.EncodeUint64ToBytes(index)...)
+}
+
 var ErrNotFound = fmt.Errorf("not found")
 
 func (s *KeyValueStorage) readRLP(p, k []byte, raw types.RLPUnmarshaler) error {
@@ -220,6 +350,22 @@ func (s *KeyValueStorage) readRLP(p, k []byte, raw types.RLPUnmarshaler) error {
 	return nil
 }
 
+// ReadBlockContractCreations reads the addresses of the contracts created by the block
+func (s *KeyValueStorage) ReadBlockContractCreations(hash types.Hash) ([]types.Address, bool) {
+	data, ok := s.get(BLOCK_CONTRACT_CREATIONS_PREFIX, hash.Bytes())
+	if !ok || len(data)%types.AddressLength != 0 {
+		return nil, false
+	}
+
+	addrs := make([]types.Address, 0, len(data)/types.AddressLength)
+
+	for i := 0; i < len(data); i += types.AddressLength {
+		addrs = append(addrs, types.BytesToAddress(data[i:i+types.AddressLength]))
+	}
+
+	return addrs, true
+}
+
 func (s *KeyValueStorage) read2(p, k []byte, parser *fastrlp.Parser) *fastrlp.Value {
 	data, ok := s.get(p, k)
 	if !ok {
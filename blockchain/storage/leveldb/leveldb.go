@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const (
@@ -77,6 +78,20 @@ func (l *levelDBKV) Get(p []byte) ([]byte, bool, error) {
 	return data, true, nil
 }
 
+// Iterate iterates over the key-value pairs with the given prefix in leveldb storage, starting from the start key
+func (l *levelDBKV) Iterate(prefix, start []byte, fn func(key, value []byte) bool) error {
+	iter := l.db.NewIterator(&util.Range{Start: start, Limit: util.BytesPrefix(prefix).Limit}, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if !fn(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+
+	return iter.Error()
+}
+
 // Close closes the leveldb storage instance
 func (l *levelDBKV) Close() error {
 	return l.db.Close()
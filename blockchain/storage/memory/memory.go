@@ -1,6 +1,9 @@
 package memory
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/hashicorp/go-hclog"
@@ -33,6 +36,33 @@ func (m *memoryKV) Get(p []byte) ([]byte, bool, error) {
 	return v, true, nil
 }
 
+func (m *memoryKV) Iterate(prefix, start []byte, fn func(key, value []byte) bool) error {
+	keys := make([][]byte, 0)
+
+	for k := range m.db {
+		key, err := hex.DecodeHex(k)
+		if err != nil {
+			return err
+		}
+
+		if bytes.HasPrefix(key, prefix) && bytes.Compare(key, start) >= 0 {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	for _, key := range keys {
+		if !fn(key, m.db[hex.EncodeToHex(key)]) {
+			break
+		}
+	}
+
+	return nil
+}
+
 func (m *memoryKV) Close() error {
 	return nil
 }
@@ -13,6 +13,7 @@ type Storage interface {
 
 	ReadHeadHash() (types.Hash, bool)
 	ReadHeadNumber() (uint64, bool)
+	ReadTxIndexTail() (uint64, bool)
 
 	ReadForks() ([]types.Hash, error)
 
@@ -26,6 +27,13 @@ type Storage interface {
 
 	ReadTxLookup(hash types.Hash) (types.Hash, bool)
 
+	ReadSenderTxLookups(sender types.Address, fromBlock, toBlock uint64, skip, limit uint64) ([]types.Hash, error)
+
+	ReadContractCreation(addr types.Address) (*types.ContractCreation, bool)
+	ReadBlockContractCreations(hash types.Hash) ([]types.Address, bool)
+
+	ReadHeaderAccumulatorNode(height uint8, index uint64) (types.Hash, bool)
+
 	NewBatch() Batch
 
 	Close() error
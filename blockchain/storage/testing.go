@@ -49,6 +49,18 @@ func TestStorage(t *testing.T, m PlaceholderStorage) {
 	t.Run("testReceipts", func(t *testing.T) {
 		testReceipts(t, m)
 	})
+	t.Run("testSenderTxLookup", func(t *testing.T) {
+		testSenderTxLookup(t, m)
+	})
+	t.Run("testContractCreation", func(t *testing.T) {
+		testContractCreation(t, m)
+	})
+	t.Run("testHeaderAccumulatorNode", func(t *testing.T) {
+		testHeaderAccumulatorNode(t, m)
+	})
+	t.Run("testDeleteTxIndex", func(t *testing.T) {
+		testDeleteTxIndex(t, m)
+	})
 }
 
 func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
@@ -394,6 +406,166 @@ func testReceipts(t *testing.T, m PlaceholderStorage) {
 	assert.True(t, reflect.DeepEqual(receipts, found))
 }
 
+func testSenderTxLookup(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	txHashes := []types.Hash{
+		types.StringToHash("11"), types.StringToHash("22"), types.StringToHash("33"), types.StringToHash("44"),
+	}
+
+	batch := NewBatchWriter(s)
+
+	// written out of order, read by the block number and the position in the block
+	batch.PutSenderTxLookup(addr1, 256, 0, txHashes[3])
+	batch.PutSenderTxLookup(addr1, 1, 2, txHashes[1])
+	batch.PutSenderTxLookup(addr1, 1, 0, txHashes[0])
+	batch.PutSenderTxLookup(addr1, 2, 1, txHashes[2])
+	batch.PutSenderTxLookup(addr2, 1, 1, types.StringToHash("55"))
+
+	require.NoError(t, batch.WriteBatch())
+
+	found, err := s.ReadSenderTxLookups(addr1, 0, 1000, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, txHashes, found)
+
+	found, err = s.ReadSenderTxLookups(addr1, 2, 2, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, txHashes[2:3], found)
+
+	found, err = s.ReadSenderTxLookups(addr1, 0, 1000, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, txHashes[1:3], found)
+
+	found, err = s.ReadSenderTxLookups(addr1, 3, 255, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	found, err = s.ReadSenderTxLookups(types.StringToAddress("3"), 0, 1000, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func testContractCreation(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	creation := &types.ContractCreation{
+		Address:     addr1,
+		Creator:     addr2,
+		TxHash:      types.StringToHash("11"),
+		BlockHash:   hash1,
+		BlockNumber: 5,
+	}
+
+	batch := NewBatchWriter(s)
+
+	batch.PutContractCreation(creation)
+	batch.PutBlockContractCreations(hash1, []types.Address{addr1, addr2})
+
+	require.NoError(t, batch.WriteBatch())
+
+	found, ok := s.ReadContractCreation(addr1)
+	assert.True(t, ok)
+	assert.Equal(t, creation, found)
+
+	_, ok = s.ReadContractCreation(addr2)
+	assert.False(t, ok)
+
+	addrs, ok := s.ReadBlockContractCreations(hash1)
+	assert.True(t, ok)
+	assert.Equal(t, []types.Address{addr1, addr2}, addrs)
+
+	batch = NewBatchWriter(s)
+
+	batch.DeleteContractCreation(addr1)
+	batch.DeleteBlockContractCreations(hash1)
+
+	require.NoError(t, batch.WriteBatch())
+
+	_, ok = s.ReadContractCreation(addr1)
+	assert.False(t, ok)
+
+	_, ok = s.ReadBlockContractCreations(hash1)
+	assert.False(t, ok)
+}
+
+func testDeleteTxIndex(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	txHash := types.StringToHash("11")
+	receipts := []*types.Receipt{{TxHash: txHash, GasUsed: 10}}
+
+	_, ok := s.ReadTxIndexTail()
+	assert.False(t, ok)
+
+	batch := NewBatchWriter(s)
+
+	batch.PutTxLookup(txHash, hash1)
+	batch.PutSenderTxLookup(addr1, 1, 0, txHash)
+	batch.PutReceipts(hash1, receipts)
+
+	require.NoError(t, batch.WriteBatch())
+
+	batch = NewBatchWriter(s)
+
+	batch.DeleteTxLookup(txHash)
+	batch.DeleteSenderTxLookup(addr1, 1, 0)
+	batch.DeleteReceipts(hash1)
+	batch.PutTxIndexTail(5)
+
+	require.NoError(t, batch.WriteBatch())
+
+	_, ok = s.ReadTxLookup(txHash)
+	assert.False(t, ok)
+
+	txHashes, err := s.ReadSenderTxLookups(addr1, 0, 1, 0, 1)
+	require.NoError(t, err)
+	assert.Empty(t, txHashes)
+
+	_, err = s.ReadReceipts(hash1)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	tail, ok := s.ReadTxIndexTail()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(5), tail)
+}
+
+func testHeaderAccumulatorNode(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	batch := NewBatchWriter(s)
+
+	batch.PutHeaderAccumulatorNode(0, 1, hash1)
+	batch.PutHeaderAccumulatorNode(1, 0, hash2)
+
+	require.NoError(t, batch.WriteBatch())
+
+	found, ok := s.ReadHeaderAccumulatorNode(0, 1)
+	assert.True(t, ok)
+	assert.Equal(t, hash1, found)
+
+	found, ok = s.ReadHeaderAccumulatorNode(1, 0)
+	assert.True(t, ok)
+	assert.Equal(t, hash2, found)
+
+	_, ok = s.ReadHeaderAccumulatorNode(0, 0)
+	assert.False(t, ok)
+
+	_, ok = s.ReadHeaderAccumulatorNode(1, 1)
+	assert.False(t, ok)
+}
+
 func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 	t.Helper()
 
@@ -453,6 +625,7 @@ func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 type readCanonicalHashDelegate func(uint64) (types.Hash, bool)
 type readHeadHashDelegate func() (types.Hash, bool)
 type readHeadNumberDelegate func() (uint64, bool)
+type readTxIndexTailDelegate func() (uint64, bool)
 type readForksDelegate func() ([]types.Hash, error)
 type readTotalDifficultyDelegate func(types.Hash) (*big.Int, bool)
 type readHeaderDelegate func(types.Hash) (*types.Header, error)
@@ -460,21 +633,30 @@ type readBodyDelegate func(types.Hash) (*types.Body, error)
 type readSnapshotDelegate func(types.Hash) ([]byte, bool)
 type readReceiptsDelegate func(types.Hash) ([]*types.Receipt, error)
 type readTxLookupDelegate func(types.Hash) (types.Hash, bool)
+type readSenderTxLookupsDelegate func(types.Address, uint64, uint64, uint64, uint64) ([]types.Hash, error)
+type readContractCreationDelegate func(types.Address) (*types.ContractCreation, bool)
+type readBlockContractCreationsDelegate func(types.Hash) ([]types.Address, bool)
+type readHeaderAccumulatorNodeDelegate func(uint8, uint64) (types.Hash, bool)
 type closeDelegate func() error
 type newBatchDelegate func() Batch
 
 type MockStorage struct {
-	readCanonicalHashFn   readCanonicalHashDelegate
-	readHeadHashFn        readHeadHashDelegate
-	readHeadNumberFn      readHeadNumberDelegate
-	readForksFn           readForksDelegate
-	readTotalDifficultyFn readTotalDifficultyDelegate
-	readHeaderFn          readHeaderDelegate
-	readBodyFn            readBodyDelegate
-	readReceiptsFn        readReceiptsDelegate
-	readTxLookupFn        readTxLookupDelegate
-	closeFn               closeDelegate
-	newBatchFn            newBatchDelegate
+	readCanonicalHashFn          readCanonicalHashDelegate
+	readHeadHashFn               readHeadHashDelegate
+	readHeadNumberFn             readHeadNumberDelegate
+	readTxIndexTailFn            readTxIndexTailDelegate
+	readForksFn                  readForksDelegate
+	readTotalDifficultyFn        readTotalDifficultyDelegate
+	readHeaderFn                 readHeaderDelegate
+	readBodyFn                   readBodyDelegate
+	readReceiptsFn               readReceiptsDelegate
+	readTxLookupFn               readTxLookupDelegate
+	readSenderTxLookupsFn        readSenderTxLookupsDelegate
+	readContractCreationFn       readContractCreationDelegate
+	readBlockContractCreationsFn readBlockContractCreationsDelegate
+	readHeaderAccumulatorNodeFn  readHeaderAccumulatorNodeDelegate
+	closeFn                      closeDelegate
+	newBatchFn                   newBatchDelegate
 }
 
 func NewMockStorage() *MockStorage {
@@ -517,6 +699,18 @@ func (m *MockStorage) HookReadHeadNumber(fn readHeadNumberDelegate) {
 	m.readHeadNumberFn = fn
 }
 
+func (m *MockStorage) ReadTxIndexTail() (uint64, bool) {
+	if m.readTxIndexTailFn != nil {
+		return m.readTxIndexTailFn()
+	}
+
+	return 0, false
+}
+
+func (m *MockStorage) HookReadTxIndexTail(fn readTxIndexTailDelegate) {
+	m.readTxIndexTailFn = fn
+}
+
 func (m *MockStorage) ReadForks() ([]types.Hash, error) {
 	if m.readForksFn != nil {
 		return m.readForksFn()
@@ -589,6 +783,58 @@ func (m *MockStorage) HookReadTxLookup(fn readTxLookupDelegate) {
 	m.readTxLookupFn = fn
 }
 
+func (m *MockStorage) ReadSenderTxLookups(
+	sender types.Address,
+	fromBlock, toBlock uint64,
+	skip, limit uint64,
+) ([]types.Hash, error) {
+	if m.readSenderTxLookupsFn != nil {
+		return m.readSenderTxLookupsFn(sender, fromBlock, toBlock, skip, limit)
+	}
+
+	return []types.Hash{}, nil
+}
+
+func (m *MockStorage) HookReadSenderTxLookups(fn readSenderTxLookupsDelegate) {
+	m.readSenderTxLookupsFn = fn
+}
+
+func (m *MockStorage) ReadContractCreation(addr types.Address) (*types.ContractCreation, bool) {
+	if m.readContractCreationFn != nil {
+		return m.readContractCreationFn(addr)
+	}
+
+	return nil, false
+}
+
+func (m *MockStorage) HookReadContractCreation(fn readContractCreationDelegate) {
+	m.readContractCreationFn = fn
+}
+
+func (m *MockStorage) ReadBlockContractCreations(hash types.Hash) ([]types.Address, bool) {
+	if m.readBlockContractCreationsFn != nil {
+		return m.readBlockContractCreationsFn(hash)
+	}
+
+	return nil, false
+}
+
+func (m *MockStorage) HookReadBlockContractCreations(fn readBlockContractCreationsDelegate) {
+	m.readBlockContractCreationsFn = fn
+}
+
+func (m *MockStorage) ReadHeaderAccumulatorNode(height uint8, index uint64) (types.Hash, bool) {
+	if m.readHeaderAccumulatorNodeFn != nil {
+		return m.readHeaderAccumulatorNodeFn(height, index)
+	}
+
+	return types.Hash{}, false
+}
+
+func (m *MockStorage) HookReadHeaderAccumulatorNode(fn readHeaderAccumulatorNodeDelegate) {
+	m.readHeaderAccumulatorNodeFn = fn
+}
+
 func (m *MockStorage) Close() error {
 	if m.closeFn != nil {
 		return m.closeFn()
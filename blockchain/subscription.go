@@ -86,6 +86,9 @@ type Event struct {
 	// Source is the source that generated the blocks for the event
 	// right now it can be either the Sealer or the Syncer
 	Source string
+
+	// Reorg holds the details of the chain reorganization, set only for the EventReorg events
+	Reorg *ReorgEvent
 }
 
 // Header returns the latest block header for the event
@@ -1,7 +1,9 @@
 package chain
 
 import (
+	"bytes"
 	"errors"
+	"math/big"
 	"sort"
 
 	"github.com/0xPolygon/polygon-edge/forkmanager"
@@ -13,13 +15,26 @@ var (
 	ErrBurnContractAddressMissing = errors.New("burn contract address missing")
 )
 
+const (
+	// DefaultMaxCodeSize is the max size of the deployed contract code (EIP-170)
+	DefaultMaxCodeSize = 24576
+
+	// DefaultMaxInitCodeSize is the max size of the contract creation code (EIP-3860)
+	DefaultMaxInitCodeSize = 2 * DefaultMaxCodeSize
+)
+
 // Params are all the set of params for the chain
 type Params struct {
 	Forks          *Forks                 `json:"forks"`
 	ChainID        int64                  `json:"chainID"`
+	NetworkID      uint64                 `json:"networkID,omitempty"`
 	Engine         map[string]interface{} `json:"engine"`
 	BlockGasTarget uint64                 `json:"blockGasTarget"`
 
+	// Contract size limits, the EIP-170 and EIP-3860 defaults are used if not set
+	MaxCodeSize     uint64 `json:"maxCodeSize,omitempty"`
+	MaxInitCodeSize uint64 `json:"maxInitCodeSize,omitempty"`
+
 	// Access control configuration
 	ContractDeployerAllowList *AddressListConfig `json:"contractDeployerAllowList,omitempty"`
 	ContractDeployerBlockList *AddressListConfig `json:"contractDeployerBlockList,omitempty"`
@@ -28,6 +43,12 @@ type Params struct {
 	BridgeAllowList           *AddressListConfig `json:"bridgeAllowList,omitempty"`
 	BridgeBlockList           *AddressListConfig `json:"bridgeBlockList,omitempty"`
 
+	// Storage rent configuration, applied once the storageRent fork is enabled
+	StorageRent *StorageRentConfig `json:"storageRent,omitempty"`
+
+	// Address ranges reserved for the system contracts
+	ReservedAddresses *ReservedAddressesConfig `json:"reservedAddresses,omitempty"`
+
 	// Governance contract where the token will be sent to and burn in london fork
 	BurnContract map[uint64]types.Address `json:"burnContract"`
 	// Destination address to initialize default burn contract with
@@ -42,6 +63,48 @@ type AddressListConfig struct {
 	EnabledAddresses []types.Address `json:"enabledAddresses,omitempty"`
 }
 
+// StorageRentConfig is the configuration of the experimental contract storage rent.
+// Every written storage slot is paid for ExpiryEpochs epochs ahead. Once the rent runs out,
+// the slot becomes unreadable, and its value can be evicted from the state until the slot is revived
+// with the evicted value. Rent payments and revivals are made by the contracts owning the slots,
+// or by system transactions
+type StorageRentConfig struct {
+	// EpochSize is the number of blocks in a single rent epoch
+	EpochSize uint64 `json:"epochSize"`
+
+	// SlotRentPerEpoch is the amount charged from the contract balance per storage slot and epoch
+	SlotRentPerEpoch *big.Int `json:"slotRentPerEpoch"`
+
+	// ExpiryEpochs is the number of epochs a newly written or revived slot stays readable without paying the rent
+	ExpiryEpochs uint64 `json:"expiryEpochs"`
+
+	// ExemptContracts is the list of contracts whose storage never expires (e.g. the system contracts)
+	ExemptContracts []types.Address `json:"exemptContracts,omitempty"`
+}
+
+// ReservedAddressesConfig is the configuration of the address ranges reserved for the system contracts,
+// including the ones the future protocol upgrades intend to use. The user transactions can't call
+// the reserved addresses directly, except the entry points, and the contracts can't be deployed to them.
+// The reservation is enforced once the reservedAddresses fork is enabled
+type ReservedAddressesConfig struct {
+	// Ranges is the list of the reserved address ranges
+	Ranges []*AddressRange `json:"ranges"`
+
+	// EntryPoints is the list of the reserved addresses the user transactions can call directly
+	EntryPoints []types.Address `json:"entryPoints,omitempty"`
+}
+
+// AddressRange is the range of the addresses, both ends included
+type AddressRange struct {
+	From types.Address `json:"from"`
+	To   types.Address `json:"to"`
+}
+
+// Contains returns true if the address is within the range
+func (r *AddressRange) Contains(addr types.Address) bool {
+	return bytes.Compare(addr.Bytes(), r.From.Bytes()) >= 0 && bytes.Compare(addr.Bytes(), r.To.Bytes()) <= 0
+}
+
 // CalculateBurnContract calculates burn contract address for the given block number
 func (p *Params) CalculateBurnContract(block uint64) (types.Address, error) {
 	blocks := make([]uint64, 0, len(p.BurnContract))
@@ -67,6 +130,34 @@ func (p *Params) CalculateBurnContract(block uint64) (types.Address, error) {
 	return p.BurnContract[blocks[len(blocks)-1]], nil
 }
 
+// GetNetworkID returns the network ID reported by net_version.
+// It defaults to the chain ID, unless the chain historically diverged these values
+func (p *Params) GetNetworkID() uint64 {
+	if p.NetworkID != 0 {
+		return p.NetworkID
+	}
+
+	return uint64(p.ChainID)
+}
+
+// GetMaxCodeSize returns the max size of the deployed contract code
+func (p *Params) GetMaxCodeSize() uint64 {
+	if p.MaxCodeSize != 0 {
+		return p.MaxCodeSize
+	}
+
+	return DefaultMaxCodeSize
+}
+
+// GetMaxInitCodeSize returns the max size of the contract creation code
+func (p *Params) GetMaxInitCodeSize() uint64 {
+	if p.MaxInitCodeSize != 0 {
+		return p.MaxInitCodeSize
+	}
+
+	return DefaultMaxInitCodeSize
+}
+
 func (p *Params) GetEngine() string {
 	// We know there is already one
 	for k := range p.Engine {
@@ -83,12 +174,19 @@ const (
 	Constantinople      = "constantinople"
 	Petersburg          = "petersburg"
 	Istanbul            = "istanbul"
+	Berlin              = "berlin"
 	London              = "london"
 	EIP150              = "EIP150"
 	EIP158              = "EIP158"
 	EIP155              = "EIP155"
+	EIP6780             = "EIP6780"
 	QuorumCalcAlignment = "quorumcalcalignment"
 	TxHashWithType      = "txHashWithType"
+	StorageRent         = "storageRent"
+	MessageBridge       = "messageBridge"
+	SponsoredGas        = "sponsoredGas"
+	ValidatorSeats      = "validatorSeats"
+	ReservedAddresses   = "reservedAddresses"
 )
 
 // Forks is map which contains all forks and their starting blocks from genesis
@@ -118,12 +216,19 @@ func (f *Forks) At(block uint64) ForksInTime {
 		Constantinople:      f.IsActive(Constantinople, block),
 		Petersburg:          f.IsActive(Petersburg, block),
 		Istanbul:            f.IsActive(Istanbul, block),
+		Berlin:              f.IsActive(Berlin, block),
 		London:              f.IsActive(London, block),
 		EIP150:              f.IsActive(EIP150, block),
 		EIP158:              f.IsActive(EIP158, block),
 		EIP155:              f.IsActive(EIP155, block),
+		EIP6780:             f.IsActive(EIP6780, block),
 		QuorumCalcAlignment: f.IsActive(QuorumCalcAlignment, block),
 		TxHashWithType:      f.IsActive(TxHashWithType, block),
+		StorageRent:         f.IsActive(StorageRent, block),
+		MessageBridge:       f.IsActive(MessageBridge, block),
+		SponsoredGas:        f.IsActive(SponsoredGas, block),
+		ValidatorSeats:      f.IsActive(ValidatorSeats, block),
+		ReservedAddresses:   f.IsActive(ReservedAddresses, block),
 	}
 }
 
@@ -147,12 +252,19 @@ type ForksInTime struct {
 	Constantinople,
 	Petersburg,
 	Istanbul,
+	Berlin,
 	London,
 	EIP150,
 	EIP158,
 	EIP155,
+	EIP6780,
 	QuorumCalcAlignment,
-	TxHashWithType bool
+	TxHashWithType,
+	StorageRent,
+	MessageBridge,
+	SponsoredGas,
+	ValidatorSeats,
+	ReservedAddresses bool
 }
 
 // AllForksEnabled should contain all supported forks by current edge version
@@ -165,7 +277,12 @@ var AllForksEnabled = &Forks{
 	Constantinople:      NewFork(0),
 	Petersburg:          NewFork(0),
 	Istanbul:            NewFork(0),
+	Berlin:              NewFork(0),
 	London:              NewFork(0),
 	QuorumCalcAlignment: NewFork(0),
 	TxHashWithType:      NewFork(0),
+	MessageBridge:       NewFork(0),
+	SponsoredGas:        NewFork(0),
+	ValidatorSeats:      NewFork(0),
+	ReservedAddresses:   NewFork(0),
 }
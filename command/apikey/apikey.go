@@ -0,0 +1,34 @@
+package apikey
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/apikey/list"
+	"github.com/0xPolygon/polygon-edge/command/apikey/remove"
+	"github.com/0xPolygon/polygon-edge/command/apikey/set"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	apiKeyCmd := &cobra.Command{
+		Use:   "api-key",
+		Short: "Top level command for managing the JSON-RPC API keys of the node. Only accepts subcommands.",
+	}
+
+	helper.RegisterGRPCAddressFlag(apiKeyCmd)
+	helper.RegisterGRPCCredentialsFlags(apiKeyCmd)
+
+	registerSubcommands(apiKeyCmd)
+
+	return apiKeyCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// api-key list
+		list.GetCommand(),
+		// api-key set
+		set.GetCommand(),
+		// api-key remove
+		remove.GetCommand(),
+	)
+}
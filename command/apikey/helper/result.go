@@ -0,0 +1,118 @@
+package helper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+type APIKeyResult struct {
+	Key       string   `json:"key"`
+	Name      string   `json:"name"`
+	Methods   []string `json:"methods"`
+	RateLimit uint64   `json:"rateLimit"`
+	Requests  uint64   `json:"requests"`
+	Rejected  uint64   `json:"rejected"`
+}
+
+func NewAPIKeyResult(key *proto.ApiKey) *APIKeyResult {
+	return &APIKeyResult{
+		Key:       key.Key,
+		Name:      key.Name,
+		Methods:   key.Methods,
+		RateLimit: key.RateLimit,
+		Requests:  key.Requests,
+		Rejected:  key.Rejected,
+	}
+}
+
+func (r *APIKeyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[API KEY]\n")
+	buffer.WriteString(r.format())
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+func (r *APIKeyResult) format() string {
+	methods := "all"
+	if len(r.Methods) > 0 {
+		methods = strings.Join(r.Methods, ", ")
+	}
+
+	rateLimit := "unlimited"
+	if r.RateLimit != 0 {
+		rateLimit = fmt.Sprintf("%d/s", r.RateLimit)
+	}
+
+	return helper.FormatKV([]string{
+		fmt.Sprintf("Name|%s", r.Name),
+		fmt.Sprintf("Key|%s", r.Key),
+		fmt.Sprintf("Methods|%s", methods),
+		fmt.Sprintf("Rate limit|%s", rateLimit),
+		fmt.Sprintf("Requests|%d", r.Requests),
+		fmt.Sprintf("Rejected|%d", r.Rejected),
+	})
+}
+
+type APIKeyListResult struct {
+	Keys []*APIKeyResult `json:"keys"`
+}
+
+func NewAPIKeyListResult(resp *proto.ApiKeyListResponse) *APIKeyListResult {
+	keys := make([]*APIKeyResult, len(resp.Keys))
+	for i, key := range resp.Keys {
+		keys[i] = NewAPIKeyResult(key)
+	}
+
+	return &APIKeyListResult{
+		Keys: keys,
+	}
+}
+
+func (r *APIKeyListResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[API KEYS]\n")
+
+	if len(r.Keys) == 0 {
+		buffer.WriteString("No API keys found")
+	}
+
+	for i, key := range r.Keys {
+		if i > 0 {
+			buffer.WriteString("\n\n")
+		}
+
+		buffer.WriteString(key.format())
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+type APIKeyRemoveResult struct {
+	Removed bool `json:"removed"`
+}
+
+func (r *APIKeyRemoveResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[API KEY REMOVE]\n")
+
+	if r.Removed {
+		buffer.WriteString("The API key is removed")
+	} else {
+		buffer.WriteString("The API key doesn't exist")
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
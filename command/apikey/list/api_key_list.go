@@ -0,0 +1,46 @@
+package list
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apiKeyHelper "github.com/0xPolygon/polygon-edge/command/apikey/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/spf13/cobra"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "Returns the JSON-RPC API keys along with the number of the served and rejected requests",
+		Args:  cobra.NoArgs,
+		Run:   runCommand,
+	}
+
+	return listCmd
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	resp, err := getAPIKeys(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(apiKeyHelper.NewAPIKeyListResult(resp))
+}
+
+func getAPIKeys(grpcAddress string) (*proto.ApiKeyListResponse, error) {
+	client, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ApiKeyList(context.Background(), &empty.Empty{})
+}
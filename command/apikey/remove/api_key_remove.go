@@ -0,0 +1,49 @@
+package remove
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	removeCmd := &cobra.Command{
+		Use: "remove",
+		Short: "Removes the JSON-RPC API key at runtime. The keys of the config file are added back " +
+			"on the node restart",
+		Run: runCommand,
+	}
+
+	setFlags(removeCmd)
+	helper.SetRequiredFlags(removeCmd, params.getRequiredFlags())
+
+	return removeCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.key,
+		keyFlag,
+		"",
+		"the API key to remove",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initSystemClient(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.removeAPIKey(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
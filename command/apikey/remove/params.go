@@ -0,0 +1,65 @@
+package remove
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apiKeyHelper "github.com/0xPolygon/polygon-edge/command/apikey/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+const (
+	keyFlag = "key"
+)
+
+var (
+	params = &removeParams{}
+)
+
+type removeParams struct {
+	key string
+
+	systemClient proto.SystemClient
+
+	removed bool
+}
+
+func (p *removeParams) getRequiredFlags() []string {
+	return []string{
+		keyFlag,
+	}
+}
+
+func (p *removeParams) initSystemClient(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	p.systemClient = systemClient
+
+	return nil
+}
+
+func (p *removeParams) removeAPIKey() error {
+	resp, err := p.systemClient.ApiKeyRemove(
+		context.Background(),
+		&proto.ApiKeyRemoveRequest{
+			Key: p.key,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.removed = resp.Removed
+
+	return nil
+}
+
+func (p *removeParams) getResult() command.CommandResult {
+	return &apiKeyHelper.APIKeyRemoveResult{
+		Removed: p.removed,
+	}
+}
@@ -0,0 +1,71 @@
+package set
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	setCmd := &cobra.Command{
+		Use: "set",
+		Short: "Adds the JSON-RPC API key or updates its permissions and rate limit at runtime. " +
+			"The keys set at runtime are kept until the node restart, unless they are added to the config file",
+		Run: runCommand,
+	}
+
+	setFlags(setCmd)
+	helper.SetRequiredFlags(setCmd, params.getRequiredFlags())
+
+	return setCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.key,
+		keyFlag,
+		"",
+		"the secret the clients present in the X-Api-Key header",
+	)
+
+	cmd.Flags().StringVar(
+		&params.name,
+		nameFlag,
+		"",
+		"the name of the key in the logs and the metrics",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.methods,
+		methodsFlag,
+		[]string{},
+		"the allowed methods (e.g. eth_call), namespaces (e.g. eth_*) or * for all of them. "+
+			"If omitted, all of the methods are allowed",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rateLimit,
+		rateLimitFlag,
+		0,
+		"the max number of requests per second, value of 0 disables it",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initSystemClient(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.setAPIKey(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
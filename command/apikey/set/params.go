@@ -0,0 +1,73 @@
+package set
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	apiKeyHelper "github.com/0xPolygon/polygon-edge/command/apikey/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+const (
+	keyFlag       = "key"
+	nameFlag      = "name"
+	methodsFlag   = "methods"
+	rateLimitFlag = "rate-limit"
+)
+
+var (
+	params = &setParams{}
+)
+
+type setParams struct {
+	key       string
+	name      string
+	methods   []string
+	rateLimit uint64
+
+	systemClient proto.SystemClient
+
+	response *proto.ApiKey
+}
+
+func (p *setParams) getRequiredFlags() []string {
+	return []string{
+		keyFlag,
+		nameFlag,
+	}
+}
+
+func (p *setParams) initSystemClient(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	p.systemClient = systemClient
+
+	return nil
+}
+
+func (p *setParams) setAPIKey() error {
+	resp, err := p.systemClient.ApiKeySet(
+		context.Background(),
+		&proto.ApiKey{
+			Key:       p.key,
+			Name:      p.name,
+			Methods:   p.methods,
+			RateLimit: p.rateLimit,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.response = resp
+
+	return nil
+}
+
+func (p *setParams) getResult() command.CommandResult {
+	return apiKeyHelper.NewAPIKeyResult(p.response)
+}
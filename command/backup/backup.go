@@ -16,6 +16,7 @@ func GetCommand() *cobra.Command {
 	}
 
 	helper.RegisterGRPCAddressFlag(backupCmd)
+	helper.RegisterGRPCCredentialsFlags(backupCmd)
 
 	setFlags(backupCmd)
 	helper.SetRequiredFlags(backupCmd, params.getRequiredFlags())
@@ -0,0 +1,31 @@
+package blockgastarget
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/blockgastarget/get"
+	"github.com/0xPolygon/polygon-edge/command/blockgastarget/set"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	blockGasTargetCmd := &cobra.Command{
+		Use:   "block-gas-target",
+		Short: "Top level command for interacting with the block gas target of the node. Only accepts subcommands.",
+	}
+
+	helper.RegisterGRPCAddressFlag(blockGasTargetCmd)
+	helper.RegisterGRPCCredentialsFlags(blockGasTargetCmd)
+
+	registerSubcommands(blockGasTargetCmd)
+
+	return blockGasTargetCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// block-gas-target get
+		get.GetCommand(),
+		// block-gas-target set
+		set.GetCommand(),
+	)
+}
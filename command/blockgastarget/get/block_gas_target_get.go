@@ -0,0 +1,46 @@
+package get
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	gasTargetHelper "github.com/0xPolygon/polygon-edge/command/blockgastarget/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/spf13/cobra"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Returns the block gas target the node proposes blocks with",
+		Args:  cobra.NoArgs,
+		Run:   runCommand,
+	}
+
+	return getCmd
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	resp, err := getBlockGasTarget(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(gasTargetHelper.NewBlockGasTargetResult(resp))
+}
+
+func getBlockGasTarget(grpcAddress string) (*proto.BlockGasTargetResponse, error) {
+	client, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.BlockGasTargetGet(context.Background(), &empty.Empty{})
+}
@@ -0,0 +1,39 @@
+package helper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+type BlockGasTargetResult struct {
+	Target   uint64 `json:"target"`
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+func NewBlockGasTargetResult(resp *proto.BlockGasTargetResponse) *BlockGasTargetResult {
+	return &BlockGasTargetResult{
+		Target:   resp.Target,
+		GasLimit: resp.GasLimit,
+	}
+}
+
+func (r *BlockGasTargetResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	target := fmt.Sprintf("%d", r.Target)
+	if r.Target == 0 {
+		target = "not set (parent gas limit is applied)"
+	}
+
+	buffer.WriteString("\n[BLOCK GAS TARGET]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Block gas target|%s", target),
+		fmt.Sprintf("Latest block gas limit|%d", r.GasLimit),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,54 @@
+package set
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	setCmd := &cobra.Command{
+		Use: "set",
+		Short: "Sets the block gas target the node proposes blocks with. The gas limit of the following blocks " +
+			"moves towards the target, bounded by the maximum gas limit change allowed per block",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(setCmd)
+	helper.SetRequiredFlags(setCmd, params.getRequiredFlags())
+
+	return setCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.targetRaw,
+		targetFlag,
+		"",
+		"the new block gas target. Value 0 signals that the parent gas limit should be applied",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.initRawParams()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initSystemClient(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.setBlockGasTarget(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,76 @@
+package set
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	gasTargetHelper "github.com/0xPolygon/polygon-edge/command/blockgastarget/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	targetFlag = "target"
+)
+
+var (
+	params = &setParams{}
+)
+
+type setParams struct {
+	targetRaw string
+	target    uint64
+
+	systemClient proto.SystemClient
+
+	response *proto.BlockGasTargetResponse
+}
+
+func (p *setParams) getRequiredFlags() []string {
+	return []string{
+		targetFlag,
+	}
+}
+
+func (p *setParams) initRawParams() error {
+	target, err := types.ParseUint64orHex(&p.targetRaw)
+	if err != nil {
+		return err
+	}
+
+	p.target = target
+
+	return nil
+}
+
+func (p *setParams) initSystemClient(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	p.systemClient = systemClient
+
+	return nil
+}
+
+func (p *setParams) setBlockGasTarget() error {
+	resp, err := p.systemClient.BlockGasTargetSet(
+		context.Background(),
+		&proto.BlockGasTargetSetRequest{
+			Target: p.target,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.response = resp
+
+	return nil
+}
+
+func (p *setParams) getResult() command.CommandResult {
+	return gasTargetHelper.NewBlockGasTargetResult(p.response)
+}
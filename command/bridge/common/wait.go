@@ -0,0 +1,171 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/contracts"
+)
+
+const (
+	WaitFlag         = "wait"
+	WaitTimeoutFlag  = "wait-timeout"
+	ChildJSONRPCFlag = "child-json-rpc"
+
+	// DefaultWaitTimeout is the default time to wait for the bridge events to be executed on the destination chain
+	DefaultWaitTimeout = 10 * time.Minute
+
+	waitPollInterval = 2 * time.Second
+)
+
+var errChildJSONRPCRequired = fmt.Errorf("%s flag is required when waiting for the execution", ChildJSONRPCFlag)
+
+// WaitParams are the parameters of waiting for the bridge events execution on the destination chain
+type WaitParams struct {
+	Wait         bool
+	WaitTimeout  time.Duration
+	ChildJSONRPC string
+}
+
+// RegisterWaitFlags registers the flags of waiting for the bridge events execution to a given command
+func (p *WaitParams) RegisterWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(
+		&p.Wait,
+		WaitFlag,
+		false,
+		"wait until the bridge events are executed on the destination chain",
+	)
+
+	cmd.Flags().DurationVar(
+		&p.WaitTimeout,
+		WaitTimeoutFlag,
+		DefaultWaitTimeout,
+		"the maximum time to wait for the bridge events execution",
+	)
+
+	cmd.Flags().StringVar(
+		&p.ChildJSONRPC,
+		ChildJSONRPCFlag,
+		"",
+		"the JSON RPC endpoint of the destination (child) chain, required when waiting for the execution",
+	)
+}
+
+// ValidateWaitFlags validates the flags of waiting for the bridge events execution
+func (p *WaitParams) ValidateWaitFlags() error {
+	if p.Wait && p.ChildJSONRPC == "" {
+		return errChildJSONRPCRequired
+	}
+
+	return nil
+}
+
+// ExtractStateSyncID tries to extract state sync event id from provided receipt
+func ExtractStateSyncID(receipt *ethgo.Receipt) (*big.Int, error) {
+	var stateSyncedEvent contractsapi.StateSyncedEvent
+	for _, log := range receipt.Logs {
+		doesMatch, err := stateSyncedEvent.ParseLog(log)
+		if err != nil {
+			return nil, err
+		}
+
+		if !doesMatch {
+			continue
+		}
+
+		return stateSyncedEvent.ID, nil
+	}
+
+	return nil, errors.New("failed to find state sync event log")
+}
+
+// WaitForStateSyncs blocks until the state sync events with the given ids are executed on the child chain.
+// The execution results are searched from the given child chain block onwards.
+// It fails if any of the state sync events is executed unsuccessfully
+func WaitForStateSyncs(ctx context.Context, client *jsonrpc.Client, fromBlock uint64, ids []*big.Int) error {
+	pending := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		pending[id.String()] = struct{}{}
+	}
+
+	ticker := time.NewTicker(waitPollInterval)
+	defer ticker.Stop()
+
+	for len(pending) > 0 {
+		head, err := client.Eth().BlockNumber()
+		if err != nil {
+			return fmt.Errorf("failed to get the child chain block number: %w", err)
+		}
+
+		if head >= fromBlock {
+			if err := processStateSyncResults(client, fromBlock, head, pending); err != nil {
+				return err
+			}
+
+			fromBlock = head + 1
+		}
+
+		if len(pending) == 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d state sync events are not executed: %w", len(pending), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+// processStateSyncResults removes the state sync events executed in the given child chain block range
+// from the pending ones
+func processStateSyncResults(client *jsonrpc.Client, from, to uint64, pending map[string]struct{}) error {
+	var stateSyncResult contractsapi.StateSyncResultEvent
+
+	sig := stateSyncResult.Sig()
+	filter := &ethgo.LogFilter{
+		Address: []ethgo.Address{ethgo.Address(contracts.StateReceiverContract)},
+		Topics:  [][]*ethgo.Hash{{&sig}},
+	}
+	filter.SetFromUint64(from)
+	filter.SetToUint64(to)
+
+	logs, err := client.Eth().GetLogs(filter)
+	if err != nil {
+		return fmt.Errorf("failed to get the state sync results: %w", err)
+	}
+
+	for _, log := range logs {
+		doesMatch, err := stateSyncResult.ParseLog(log)
+		if err != nil {
+			return err
+		}
+
+		if !doesMatch {
+			continue
+		}
+
+		id := stateSyncResult.Counter.String()
+		if _, ok := pending[id]; !ok {
+			continue
+		}
+
+		if !stateSyncResult.Status {
+			return fmt.Errorf("state sync event %s failed to execute on the child chain", id)
+		}
+
+		delete(pending, id)
+	}
+
+	return nil
+}
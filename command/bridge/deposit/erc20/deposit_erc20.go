@@ -1,11 +1,15 @@
 package erc20
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/0xPolygon/polygon-edge/command"
@@ -16,14 +20,53 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
+const (
+	permitFlag             = "permit"
+	permitDeadlineFlag     = "permit-deadline"
+	permitSubmitterKeyFlag = "permit-submitter-key"
+
+	defaultPermitDeadline = time.Hour
+)
+
+var errWaitChildChainMintable = fmt.Errorf("%s flag is not supported for the child chain mintable tokens, "+
+	"whose deposits are finalized by the exit command", common.WaitFlag)
+
 type depositERC20Params struct {
 	*common.ERC20BridgeParams
+	*common.WaitParams
 	minterKey string
+
+	permit             bool
+	permitDeadline     time.Duration
+	permitSubmitterKey string
+}
+
+func (dp *depositERC20Params) validateFlags() error {
+	if err := dp.Validate(); err != nil {
+		return err
+	}
+
+	if err := dp.ValidateWaitFlags(); err != nil {
+		return err
+	}
+
+	if dp.Wait && dp.ChildChainMintable {
+		return errWaitChildChainMintable
+	}
+
+	if dp.permit && dp.permitDeadline <= 0 {
+		return errors.New("permit deadline must be positive")
+	}
+
+	return nil
 }
 
 var (
 	// depositParams is abstraction for provided bridge parameter values
-	dp *depositERC20Params = &depositERC20Params{ERC20BridgeParams: common.NewERC20BridgeParams()}
+	dp *depositERC20Params = &depositERC20Params{
+		ERC20BridgeParams: common.NewERC20BridgeParams(),
+		WaitParams:        &common.WaitParams{},
+	}
 )
 
 // GetCommand returns the bridge deposit command
@@ -65,6 +108,31 @@ func GetCommand() *cobra.Command {
 		common.MinterKeyFlagDesc,
 	)
 
+	depositCmd.Flags().BoolVar(
+		&dp.permit,
+		permitFlag,
+		false,
+		"approve the root predicate by the EIP-2612 permit signed by the sender instead of the approve transaction "+
+			"(the root token must support EIP-2612)",
+	)
+
+	depositCmd.Flags().DurationVar(
+		&dp.permitDeadline,
+		permitDeadlineFlag,
+		defaultPermitDeadline,
+		"the validity period of the permit signature",
+	)
+
+	depositCmd.Flags().StringVar(
+		&dp.permitSubmitterKey,
+		permitSubmitterKeyFlag,
+		"",
+		"hex encoded private key of the account which submits the signed permit to the root token "+
+			"(the sender submits the permit if not provided)",
+	)
+
+	dp.RegisterWaitFlags(depositCmd)
+
 	_ = depositCmd.MarkFlagRequired(common.ReceiversFlag)
 	_ = depositCmd.MarkFlagRequired(common.AmountsFlag)
 	_ = depositCmd.MarkFlagRequired(common.RootTokenFlag)
@@ -74,7 +142,7 @@ func GetCommand() *cobra.Command {
 }
 
 func preRunCommand(cmd *cobra.Command, _ []string) error {
-	return dp.Validate()
+	return dp.validateFlags()
 }
 
 func runCommand(cmd *cobra.Command, _ []string) {
@@ -143,19 +211,16 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		}
 	}
 
-	// approve erc20 predicate
-	approveTxn, err := helper.CreateApproveERC20Txn(aggregateAmount,
-		types.StringToAddress(dp.PredicateAddr),
-		types.StringToAddress(dp.TokenAddr))
-	if err != nil {
-		outputter.SetError(fmt.Errorf("failed to create root erc 20 approve transaction: %w", err))
+	var receipt *ethgo.Receipt
 
-		return
+	if dp.permit {
+		receipt, err = sendPermit(txRelayer, depositorKey, aggregateAmount)
+	} else {
+		receipt, err = sendApprove(txRelayer, depositorKey, aggregateAmount)
 	}
 
-	receipt, err := txRelayer.SendTransaction(approveTxn, depositorKey)
 	if err != nil {
-		outputter.SetError(fmt.Errorf("failed to send root erc 20 approve transaction"))
+		outputter.SetError(err)
 
 		return
 	}
@@ -166,8 +231,23 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	var (
+		childClient     *jsonrpc.Client
+		childStartBlock uint64
+	)
+
+	if dp.Wait {
+		// the deposits can't be executed on the child chain before they are sent
+		if childClient, childStartBlock, err = connectChildChain(dp.ChildJSONRPC); err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+	}
+
 	type bridgeTxData struct {
 		exitEventID    *big.Int
+		stateSyncID    *big.Int
 		blockNumber    uint64
 		childTokenAddr *types.Address
 	}
@@ -201,12 +281,16 @@ func runCommand(cmd *cobra.Command, _ []string) {
 					return fmt.Errorf("receiver: %s, amount: %s", receiver, amount)
 				}
 
-				var exitEventID *big.Int
+				var exitEventID, stateSyncID *big.Int
 
 				if dp.ChildChainMintable {
 					if exitEventID, err = common.ExtractExitEventID(receipt); err != nil {
 						return fmt.Errorf("failed to extract exit event: %w", err)
 					}
+				} else if dp.Wait {
+					if stateSyncID, err = common.ExtractStateSyncID(receipt); err != nil {
+						return fmt.Errorf("failed to extract state sync event: %w", err)
+					}
 				}
 
 				// populate child token address if a token is mapped alongside with deposit
@@ -219,6 +303,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 				bridgeTxCh <- bridgeTxData{
 					blockNumber:    receipt.BlockNumber,
 					exitEventID:    exitEventID,
+					stateSyncID:    stateSyncID,
 					childTokenAddr: childToken,
 				}
 
@@ -237,11 +322,17 @@ func runCommand(cmd *cobra.Command, _ []string) {
 
 	var childToken *types.Address
 
+	stateSyncIDs := make([]*big.Int, 0, len(dp.Receivers))
+
 	for x := range bridgeTxCh {
 		if x.exitEventID != nil {
 			exitEventIDs = append(exitEventIDs, x.exitEventID)
 		}
 
+		if x.stateSyncID != nil {
+			stateSyncIDs = append(stateSyncIDs, x.stateSyncID)
+		}
+
 		blockNumbers = append(blockNumbers, x.blockNumber)
 
 		if x.childTokenAddr != nil {
@@ -249,6 +340,17 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		}
 	}
 
+	if dp.Wait {
+		ctx, cancel := context.WithTimeout(cmd.Context(), dp.WaitTimeout)
+		defer cancel()
+
+		if err := common.WaitForStateSyncs(ctx, childClient, childStartBlock, stateSyncIDs); err != nil {
+			outputter.SetError(fmt.Errorf("waiting for the deposits execution failed: %w", err))
+
+			return
+		}
+	}
+
 	outputter.SetCommandResult(
 		&common.BridgeTxResult{
 			Sender:         depositorAddr.String(),
@@ -261,6 +363,71 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		})
 }
 
+// sendApprove approves the root predicate to spend the deposited tokens of the depositor
+func sendApprove(txRelayer txrelayer.TxRelayer, depositorKey ethgo.Key, amount *big.Int) (*ethgo.Receipt, error) {
+	approveTxn, err := helper.CreateApproveERC20Txn(amount,
+		types.StringToAddress(dp.PredicateAddr),
+		types.StringToAddress(dp.TokenAddr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create root erc 20 approve transaction: %w", err)
+	}
+
+	receipt, err := txRelayer.SendTransaction(approveTxn, depositorKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send root erc 20 approve transaction: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// sendPermit approves the root predicate to spend the deposited tokens of the depositor
+// by the permit signed by the depositor, which is submitted by the permit submitter
+func sendPermit(txRelayer txrelayer.TxRelayer, depositorKey ethgo.Key, amount *big.Int) (*ethgo.Receipt, error) {
+	submitterKey := depositorKey
+
+	if dp.permitSubmitterKey != "" {
+		key, err := helper.DecodePrivateKey(dp.permitSubmitterKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid permit submitter key provided: %w", err)
+		}
+
+		submitterKey = key
+	}
+
+	permitTxn, err := createPermitTxn(txRelayer, depositorKey, ethgo.Address(types.StringToAddress(dp.TokenAddr)),
+		&permit{
+			owner:    depositorKey.Address(),
+			spender:  ethgo.Address(types.StringToAddress(dp.PredicateAddr)),
+			value:    amount,
+			deadline: big.NewInt(time.Now().UTC().Add(dp.permitDeadline).Unix()),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := txRelayer.SendTransaction(permitTxn, submitterKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send root erc 20 permit transaction: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// connectChildChain connects to the child chain and returns its current block number
+func connectChildChain(addr string) (*jsonrpc.Client, uint64, error) {
+	client, err := jsonrpc.NewClient(addr)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to connect to the child chain: %w", err)
+	}
+
+	blockNumber, err := client.Eth().BlockNumber()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get the child chain block number: %w", err)
+	}
+
+	return client, blockNumber, nil
+}
+
 // createDepositTxn encodes parameters for deposit function on rootchain predicate contract
 func createDepositTxn(sender, receiver types.Address, amount *big.Int) (*ethgo.Transaction, error) {
 	depositToFn := &contractsapi.DepositToRootERC20PredicateFn{
@@ -0,0 +1,122 @@
+package erc20
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var errPermitNotSupported = errors.New("root token doesn't support EIP-2612 permit")
+
+var (
+	// permitTypeHash is the EIP-2612 permit type hash
+	permitTypeHash = ethgo.Keccak256(
+		[]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+
+	permitStructType = abi.MustNewType(
+		"tuple(bytes32 typeHash, address owner, address spender, uint256 value, uint256 nonce, uint256 deadline)")
+
+	// EIP-2612 functions of the root token
+	domainSeparatorABIMethod = abi.MustNewMethod("function DOMAIN_SEPARATOR() view returns (bytes32)")
+	noncesABIMethod          = abi.MustNewMethod("function nonces(address owner) view returns (uint256)")
+	permitABIMethod          = abi.MustNewMethod("function permit(address owner, address spender, uint256 value, " +
+		"uint256 deadline, uint8 v, bytes32 r, bytes32 s)")
+)
+
+// permit is the EIP-2612 approval of the spender, signed by the token owner
+type permit struct {
+	owner    ethgo.Address
+	spender  ethgo.Address
+	value    *big.Int
+	nonce    *big.Int
+	deadline *big.Int
+}
+
+// digest returns the EIP-712 digest of the permit for the given token domain separator
+func (p *permit) digest(domainSeparator types.Hash) ([]byte, error) {
+	encoded, err := permitStructType.Encode(map[string]interface{}{
+		"typeHash": types.BytesToHash(permitTypeHash),
+		"owner":    p.owner,
+		"spender":  p.spender,
+		"value":    p.value,
+		"nonce":    p.nonce,
+		"deadline": p.deadline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, 0, 2+2*types.HashLength)
+	raw = append(raw, 0x19, 0x01)
+	raw = append(raw, domainSeparator.Bytes()...)
+	raw = append(raw, ethgo.Keccak256(encoded)...)
+
+	return ethgo.Keccak256(raw), nil
+}
+
+// createPermitTxn signs the permit of the root predicate by the depositor and encodes it
+// into the transaction to the root token, which can be sent by any account
+func createPermitTxn(txRelayer txrelayer.TxRelayer, depositorKey ethgo.Key,
+	token ethgo.Address, p *permit) (*ethgo.Transaction, error) {
+	domainSeparatorRaw, err := callToken(txRelayer, token, domainSeparatorABIMethod)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errPermitNotSupported, err.Error())
+	}
+
+	domainSeparator := types.StringToHash(domainSeparatorRaw)
+	if domainSeparator == types.ZeroHash {
+		return nil, errPermitNotSupported
+	}
+
+	nonce, err := callToken(txRelayer, token, noncesABIMethod, p.owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the permit nonce: %w", err)
+	}
+
+	p.nonce = new(big.Int).SetBytes(types.StringToBytes(nonce))
+
+	digest, err := p.digest(domainSeparator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate the permit digest: %w", err)
+	}
+
+	signature, err := depositorKey.Sign(digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the permit: %w", err)
+	}
+
+	input, err := permitABIMethod.Encode([]interface{}{
+		p.owner,
+		p.spender,
+		p.value,
+		p.deadline,
+		signature[64] + 27,
+		types.BytesToHash(signature[:32]),
+		types.BytesToHash(signature[32:64]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the permit: %w", err)
+	}
+
+	return &ethgo.Transaction{
+		To:    &token,
+		Input: input,
+	}, nil
+}
+
+// callToken calls the view function of the root token and returns the raw result
+func callToken(txRelayer txrelayer.TxRelayer, token ethgo.Address,
+	method *abi.Method, args ...interface{}) (string, error) {
+	input, err := method.Encode(args)
+	if err != nil {
+		return "", err
+	}
+
+	return txRelayer.Call(ethgo.ZeroAddress, token, input)
+}
@@ -0,0 +1,78 @@
+package erc20
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+	"github.com/umbracle/ethgo/signing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestPermit_Digest(t *testing.T) {
+	t.Parallel()
+
+	var (
+		token   = ethgo.HexToAddress("0x1000")
+		owner   = ethgo.HexToAddress("0x2000")
+		spender = ethgo.HexToAddress("0x3000")
+		chainID = big.NewInt(100)
+	)
+
+	p := &permit{
+		owner:    owner,
+		spender:  spender,
+		value:    big.NewInt(1000),
+		nonce:    big.NewInt(2),
+		deadline: big.NewInt(1700000000),
+	}
+
+	// domain separator of the token, as returned by its DOMAIN_SEPARATOR function
+	domainType := abi.MustNewType("tuple(bytes32 typeHash, bytes32 name, bytes32 version, " +
+		"uint256 chainId, address verifyingContract)")
+	encodedDomain, err := domainType.Encode(map[string]interface{}{
+		"typeHash": types.BytesToHash(ethgo.Keccak256(
+			[]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))),
+		"name":              types.BytesToHash(ethgo.Keccak256([]byte("Token"))),
+		"version":           types.BytesToHash(ethgo.Keccak256([]byte("1"))),
+		"chainId":           chainID,
+		"verifyingContract": token,
+	})
+	require.NoError(t, err)
+
+	digest, err := p.digest(types.BytesToHash(ethgo.Keccak256(encodedDomain)))
+	require.NoError(t, err)
+
+	typedData := &signing.EIP712TypedData{
+		Types: map[string][]*signing.EIP712Type{
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain: &signing.EIP712Domain{
+			Name:              "Token",
+			Version:           "1",
+			ChainId:           chainID,
+			VerifyingContract: token.String(),
+		},
+		Message: map[string]interface{}{
+			"owner":    owner,
+			"spender":  spender,
+			"value":    p.value,
+			"nonce":    p.nonce,
+			"deadline": p.deadline,
+		},
+	}
+
+	expected, err := typedData.Hash()
+	require.NoError(t, err)
+	require.Equal(t, expected, digest)
+}
@@ -0,0 +1,140 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/server"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+	ldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	dataDirFlag   = "data-dir"
+	chainFlag     = "chain"
+	fromFlag      = "from"
+	toFlag        = "to"
+	reExecuteFlag = "re-execute"
+)
+
+var (
+	params = &verifyParams{}
+)
+
+var (
+	errInvalidRange      = errors.New(`invalid "to" value; must be >= "from"`)
+	errChainRequired     = fmt.Errorf("the %s flag is required to re-execute the blocks", chainFlag)
+	errUnsupportedEngine = errors.New("re-executing the blocks is supported for the polybft chains only")
+	errHeadNotFound      = errors.New("failed to read the head of the chain")
+)
+
+type verifyParams struct {
+	dataDir     string
+	genesisPath string
+	from        uint64
+	to          uint64
+	reExecute   bool
+
+	chainParams *chain.Params
+
+	blockchainStorage storage.Storage
+	trieDB            *ldb.DB
+
+	result *VerifyResult
+}
+
+func (p *verifyParams) getRequiredFlags() []string {
+	return []string{
+		dataDirFlag,
+	}
+}
+
+func (p *verifyParams) validateFlags() error {
+	if p.to != 0 && p.from > p.to {
+		return errInvalidRange
+	}
+
+	if !p.reExecute {
+		return nil
+	}
+
+	if p.genesisPath == "" {
+		return errChainRequired
+	}
+
+	chainConfig, err := chain.ImportFromFile(p.genesisPath)
+	if err != nil {
+		return fmt.Errorf("failed to load the chain config %s: %w", p.genesisPath, err)
+	}
+
+	// the block creator is taken from the miner field of the header, which is polybft specific
+	if server.ConsensusType(chainConfig.Params.GetEngine()) != server.PolyBFTConsensus {
+		return errUnsupportedEngine
+	}
+
+	p.chainParams = chainConfig.Params
+
+	return nil
+}
+
+func (p *verifyParams) openStorages() error {
+	blockchainStorage, err := leveldb.NewLevelDBStorage(filepath.Join(p.dataDir, "blockchain"), hclog.NewNullLogger())
+	if err != nil {
+		return fmt.Errorf("failed to open the blockchain storage: %w", err)
+	}
+
+	trieDB, err := ldb.OpenFile(filepath.Join(p.dataDir, "trie"), &opt.Options{ReadOnly: true})
+	if err != nil {
+		_ = blockchainStorage.Close()
+
+		return fmt.Errorf("failed to open the trie storage: %w", err)
+	}
+
+	p.blockchainStorage = blockchainStorage
+	p.trieDB = trieDB
+
+	return nil
+}
+
+func (p *verifyParams) closeStorages() {
+	if p.blockchainStorage != nil {
+		_ = p.blockchainStorage.Close()
+	}
+
+	if p.trieDB != nil {
+		_ = p.trieDB.Close()
+	}
+}
+
+func (p *verifyParams) verify() error {
+	to := p.to
+	if to == 0 {
+		head, ok := p.blockchainStorage.ReadHeadNumber()
+		if !ok {
+			return errHeadNotFound
+		}
+
+		to = head
+	}
+
+	if p.from > to {
+		return errInvalidRange
+	}
+
+	v := NewVerifier(p.blockchainStorage, itrie.NewKV(p.trieDB), p.chainParams)
+
+	p.result = v.verifyRange(p.from, to)
+
+	return nil
+}
+
+func (p *verifyParams) getResult() command.CommandResult {
+	return p.result
+}
@@ -0,0 +1,81 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Mismatch is the block data which doesn't match the root committed in the block header
+type Mismatch struct {
+	Block    uint64 `json:"block"`
+	Field    string `json:"field"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func newMismatch(number uint64, field string, expected, actual types.Hash) *Mismatch {
+	return &Mismatch{
+		Block:    number,
+		Field:    field,
+		Expected: expected.String(),
+		Actual:   actual.String(),
+	}
+}
+
+func newErrorMismatch(number uint64, field string, err error) *Mismatch {
+	return &Mismatch{
+		Block: number,
+		Field: field,
+		Error: err.Error(),
+	}
+}
+
+type VerifyResult struct {
+	From       uint64      `json:"from"`
+	To         uint64      `json:"to"`
+	ReExecuted bool        `json:"re_executed"`
+	Mismatches []*Mismatch `json:"mismatches"`
+}
+
+func (r *VerifyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	if len(r.Mismatches) == 0 {
+		buffer.WriteString("\n[CHAIN VERIFY SUCCESS]\n")
+	} else {
+		buffer.WriteString("\n[CHAIN VERIFY FAILED]\n")
+	}
+
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("From|%d", r.From),
+		fmt.Sprintf("To|%d", r.To),
+		fmt.Sprintf("Re-executed|%t", r.ReExecuted),
+		fmt.Sprintf("Mismatches|%d", len(r.Mismatches)),
+	}))
+	buffer.WriteString("\n")
+
+	if len(r.Mismatches) == 0 {
+		return buffer.String()
+	}
+
+	rows := make([]string, 0, len(r.Mismatches)+1)
+	rows = append(rows, "Block|Field|Expected|Actual")
+
+	for _, m := range r.Mismatches {
+		if m.Error != "" {
+			rows = append(rows, fmt.Sprintf("%d|%s|%s|", m.Block, m.Field, m.Error))
+		} else {
+			rows = append(rows, fmt.Sprintf("%d|%s|%s|%s", m.Block, m.Field, m.Expected, m.Actual))
+		}
+	}
+
+	buffer.WriteString("\n[MISMATCHES]\n")
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,243 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	fieldBlock                = "block"
+	fieldParentHash           = "parentHash"
+	fieldTransactionsRoot     = "transactionsRoot"
+	fieldReceiptsRoot         = "receiptsRoot"
+	fieldStateRoot            = "stateRoot"
+	fieldExecutedReceiptsRoot = "executedReceiptsRoot"
+	fieldExecutedStateRoot    = "executedStateRoot"
+)
+
+// Verifier recomputes the roots of the stored blocks and compares them with the roots committed in the headers.
+// If the chain params are set, the blocks are also re-executed on top of the stored parent state
+type Verifier struct {
+	storage     storage.Storage
+	trie        itrie.Storage
+	chainParams *chain.Params
+
+	// lastStateRoot is the last verified state root, the consecutive blocks often share it
+	lastStateRoot types.Hash
+}
+
+// NewVerifier creates the verifier of the stored blocks, the blocks are re-executed if the chain params are set
+func NewVerifier(storage storage.Storage, trie itrie.Storage, chainParams *chain.Params) *Verifier {
+	return &Verifier{
+		storage:     storage,
+		trie:        trie,
+		chainParams: chainParams,
+	}
+}
+
+// verifyRange verifies the canonical blocks in the given (inclusive) range.
+// All the blocks are verified, even if some of them don't match
+func (v *Verifier) verifyRange(from, to uint64) *VerifyResult {
+	result := &VerifyResult{
+		From:       from,
+		To:         to,
+		ReExecuted: v.chainParams != nil,
+		Mismatches: []*Mismatch{},
+	}
+
+	for number := from; number <= to; number++ {
+		result.Mismatches = append(result.Mismatches, v.VerifyBlock(number)...)
+
+		if number == to {
+			// prevents the overflow of the max uint64 range
+			break
+		}
+	}
+
+	return result
+}
+
+// VerifyBlock verifies the canonical block with the given number
+func (v *Verifier) VerifyBlock(number uint64) []*Mismatch {
+	header, body, mismatches := v.VerifyBlockData(number)
+	if header == nil {
+		return mismatches
+	}
+
+	if mismatch := v.VerifyState(header); mismatch != nil {
+		mismatches = append(mismatches, mismatch)
+	}
+
+	if v.chainParams != nil && number > 0 && body != nil {
+		mismatches = append(mismatches, v.reExecute(header, body)...)
+	}
+
+	return mismatches
+}
+
+// VerifyBlockData verifies the header, the body and the receipts of the canonical block with the given number,
+// without the state. It returns the header and the body which could be read
+func (v *Verifier) VerifyBlockData(number uint64) (*types.Header, *types.Body, []*Mismatch) {
+	hash, ok := v.storage.ReadCanonicalHash(number)
+	if !ok {
+		return nil, nil, []*Mismatch{newErrorMismatch(number, fieldBlock, errors.New("canonical hash not found"))}
+	}
+
+	header, err := v.storage.ReadHeader(hash)
+	if err != nil {
+		return nil, nil, []*Mismatch{
+			newErrorMismatch(number, fieldBlock, fmt.Errorf("failed to read header: %w", err)),
+		}
+	}
+
+	var mismatches []*Mismatch
+
+	if number > 0 {
+		if parentHash, ok := v.storage.ReadCanonicalHash(number - 1); ok && parentHash != header.ParentHash {
+			mismatches = append(mismatches, newMismatch(number, fieldParentHash, header.ParentHash, parentHash))
+		}
+	}
+
+	body, err := v.storage.ReadBody(hash)
+	if err != nil {
+		mismatches = append(mismatches,
+			newErrorMismatch(number, fieldTransactionsRoot, fmt.Errorf("failed to read body: %w", err)))
+		body = nil
+	} else if txRoot := buildroot.CalculateTransactionsRoot(body.Transactions, number); txRoot != header.TxRoot {
+		mismatches = append(mismatches, newMismatch(number, fieldTransactionsRoot, header.TxRoot, txRoot))
+	}
+
+	receipts, err := v.readReceipts(hash, body)
+	if err != nil {
+		mismatches = append(mismatches,
+			newErrorMismatch(number, fieldReceiptsRoot, fmt.Errorf("failed to read receipts: %w", err)))
+	} else if receiptsRoot := buildroot.CalculateReceiptsRoot(receipts); receiptsRoot != header.ReceiptsRoot {
+		mismatches = append(mismatches, newMismatch(number, fieldReceiptsRoot, header.ReceiptsRoot, receiptsRoot))
+	}
+
+	return header, body, mismatches
+}
+
+// readReceipts reads the stored receipts of the block. The receipts of the blocks
+// without transactions (e.g. genesis) might not be stored at all
+func (v *Verifier) readReceipts(hash types.Hash, body *types.Body) ([]*types.Receipt, error) {
+	receipts, err := v.storage.ReadReceipts(hash)
+	if errors.Is(err, storage.ErrNotFound) && body != nil && len(body.Transactions) == 0 {
+		return nil, nil
+	}
+
+	return receipts, err
+}
+
+// VerifyState recomputes the root of the stored state trie of the block
+func (v *Verifier) VerifyState(header *types.Header) *Mismatch {
+	if header.StateRoot == v.lastStateRoot {
+		// state root is the same as in the previous block
+		return nil
+	}
+
+	if header.StateRoot != types.EmptyRootHash {
+		if _, ok := v.trie.Get(header.StateRoot.Bytes()); !ok {
+			return newErrorMismatch(header.Number, fieldStateRoot, errors.New("state root node not found"))
+		}
+	}
+
+	root, err := itrie.HashChecker(header.StateRoot.Bytes(), v.trie)
+	if err != nil {
+		return newErrorMismatch(header.Number, fieldStateRoot, err)
+	}
+
+	if root != header.StateRoot {
+		return newMismatch(header.Number, fieldStateRoot, header.StateRoot, root)
+	}
+
+	v.lastStateRoot = header.StateRoot
+
+	return nil
+}
+
+// reExecute executes the block on top of the stored parent state and compares the results with the header.
+// The state changes are kept in memory, so the verified data is never modified
+func (v *Verifier) reExecute(header *types.Header, body *types.Body) []*Mismatch {
+	parent, err := v.storage.ReadHeader(header.ParentHash)
+	if err != nil {
+		return []*Mismatch{newErrorMismatch(header.Number, fieldExecutedStateRoot,
+			fmt.Errorf("failed to read parent header: %w", err))}
+	}
+
+	executor := state.NewExecutor(v.chainParams, itrie.NewState(newOverlayStorage(v.trie)), hclog.NewNullLogger())
+	executor.GetHash = v.getHashHelper
+
+	block := &types.Block{Header: header, Transactions: body.Transactions, Uncles: body.Uncles}
+
+	txn, err := executor.ProcessBlock(parent.StateRoot, block, types.BytesToAddress(header.Miner))
+	if err != nil {
+		return []*Mismatch{newErrorMismatch(header.Number, fieldExecutedStateRoot, err)}
+	}
+
+	_, root, err := txn.Commit()
+	if err != nil {
+		return []*Mismatch{newErrorMismatch(header.Number, fieldExecutedStateRoot, err)}
+	}
+
+	var mismatches []*Mismatch
+
+	if receiptsRoot := buildroot.CalculateReceiptsRoot(txn.Receipts()); receiptsRoot != header.ReceiptsRoot {
+		mismatches = append(mismatches,
+			newMismatch(header.Number, fieldExecutedReceiptsRoot, header.ReceiptsRoot, receiptsRoot))
+	}
+
+	if root != header.StateRoot {
+		mismatches = append(mismatches, newMismatch(header.Number, fieldExecutedStateRoot, header.StateRoot, root))
+	}
+
+	return mismatches
+}
+
+// getHashHelper returns the canonical block hashes for the BLOCKHASH opcode
+func (v *Verifier) getHashHelper(_ *types.Header) state.GetHashByNumber {
+	return func(i uint64) types.Hash {
+		hash, _ := v.storage.ReadCanonicalHash(i)
+
+		return hash
+	}
+}
+
+// overlayStorage is the trie storage which reads through to the base storage,
+// while all the writes are kept in memory
+type overlayStorage struct {
+	itrie.Storage
+
+	base itrie.Storage
+}
+
+func newOverlayStorage(base itrie.Storage) *overlayStorage {
+	return &overlayStorage{
+		Storage: itrie.NewMemoryStorage(),
+		base:    base,
+	}
+}
+
+func (o *overlayStorage) Get(k []byte) ([]byte, bool) {
+	if v, ok := o.Storage.Get(k); ok {
+		return v, true
+	}
+
+	return o.base.Get(k)
+}
+
+func (o *overlayStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	if code, ok := o.Storage.GetCode(hash); ok {
+		return code, true
+	}
+
+	return o.base.GetCode(hash)
+}
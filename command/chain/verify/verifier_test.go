@@ -0,0 +1,157 @@
+package verify
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type testChain struct {
+	t       *testing.T
+	storage storage.Storage
+	trie    itrie.Storage
+	root    types.Hash
+	parent  *types.Header
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+
+	st, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	trie := itrie.NewMemoryStorage()
+
+	_, root := itrie.NewState(trie).NewSnapshot().Commit([]*state.Object{
+		{Address: types.StringToAddress("1"), Balance: big.NewInt(100), Nonce: 1},
+	})
+
+	return &testChain{t: t, storage: st, trie: trie, root: types.BytesToHash(root)}
+}
+
+// addBlock writes the block with the given transactions on top of the chain,
+// the header is modified by the given function before the block is written
+func (c *testChain) addBlock(txs []*types.Transaction, modify func(*types.Header)) {
+	c.t.Helper()
+
+	number, parentHash := uint64(0), types.ZeroHash
+	if c.parent != nil {
+		number, parentHash = c.parent.Number+1, c.parent.Hash
+	}
+
+	receipts := make([]*types.Receipt, len(txs))
+
+	for i := range txs {
+		receipts[i] = &types.Receipt{CumulativeGasUsed: uint64(i+1) * 21000}
+		receipts[i].SetStatus(types.ReceiptSuccess)
+	}
+
+	header := &types.Header{
+		Number:       number,
+		ParentHash:   parentHash,
+		StateRoot:    c.root,
+		TxRoot:       buildroot.CalculateTransactionsRoot(txs, number),
+		ReceiptsRoot: buildroot.CalculateReceiptsRoot(receipts),
+		GasLimit:     10_000_000,
+	}
+
+	if modify != nil {
+		modify(header)
+	}
+
+	header.ComputeHash()
+
+	batchWriter := storage.NewBatchWriter(c.storage)
+	batchWriter.PutHeader(header)
+	batchWriter.PutBody(header.Hash, &types.Body{Transactions: txs})
+	batchWriter.PutReceipts(header.Hash, receipts)
+	batchWriter.PutCanonicalHash(number, header.Hash)
+	batchWriter.PutHeadNumber(number)
+	require.NoError(c.t, batchWriter.WriteBatch())
+
+	c.parent = header
+}
+
+func newTestTransaction(nonce uint64) *types.Transaction {
+	to := types.StringToAddress("2")
+
+	return &types.Transaction{
+		Nonce:    nonce,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+		To:       &to,
+		Value:    big.NewInt(1),
+		V:        big.NewInt(27),
+		R:        big.NewInt(1),
+		S:        big.NewInt(1),
+	}
+}
+
+func TestVerifier_VerifyRange(t *testing.T) {
+	t.Parallel()
+
+	c := newTestChain(t)
+	c.addBlock(nil, nil)
+	c.addBlock([]*types.Transaction{newTestTransaction(0), newTestTransaction(1)}, nil)
+	c.addBlock([]*types.Transaction{newTestTransaction(2)}, func(h *types.Header) {
+		h.ReceiptsRoot = types.StringToHash("1")
+	})
+	c.addBlock(nil, func(h *types.Header) {
+		h.TxRoot = types.StringToHash("2")
+		h.StateRoot = types.StringToHash("3")
+	})
+
+	result := NewVerifier(c.storage, c.trie, nil).verifyRange(0, 3)
+	require.False(t, result.ReExecuted)
+	require.Len(t, result.Mismatches, 3)
+
+	require.Equal(t, uint64(2), result.Mismatches[0].Block)
+	require.Equal(t, fieldReceiptsRoot, result.Mismatches[0].Field)
+	require.Equal(t, types.StringToHash("1").String(), result.Mismatches[0].Expected)
+
+	require.Equal(t, uint64(3), result.Mismatches[1].Block)
+	require.Equal(t, fieldTransactionsRoot, result.Mismatches[1].Field)
+
+	require.Equal(t, uint64(3), result.Mismatches[2].Block)
+	require.Equal(t, fieldStateRoot, result.Mismatches[2].Field)
+	require.Equal(t, "state root node not found", result.Mismatches[2].Error)
+
+	// the valid sub range
+	result = NewVerifier(c.storage, c.trie, nil).verifyRange(0, 1)
+	require.Empty(t, result.Mismatches)
+
+	// the missing blocks
+	result = NewVerifier(c.storage, c.trie, nil).verifyRange(4, 4)
+	require.Len(t, result.Mismatches, 1)
+	require.Equal(t, fieldBlock, result.Mismatches[0].Field)
+}
+
+func TestVerifier_ReExecute(t *testing.T) {
+	t.Parallel()
+
+	c := newTestChain(t)
+	c.addBlock(nil, nil)
+	c.addBlock(nil, nil)
+	c.addBlock(nil, func(h *types.Header) {
+		h.ReceiptsRoot = types.StringToHash("1")
+	})
+
+	forks := chain.Forks{chain.Homestead: chain.NewFork(0), chain.EIP158: chain.NewFork(0)}
+	v := NewVerifier(c.storage, c.trie, &chain.Params{Forks: &forks, ChainID: 100})
+
+	result := v.verifyRange(0, 2)
+	require.True(t, result.ReExecuted)
+	require.Len(t, result.Mismatches, 2)
+	require.Equal(t, fieldReceiptsRoot, result.Mismatches[0].Field)
+	require.Equal(t, fieldExecutedReceiptsRoot, result.Mismatches[1].Field)
+	require.Equal(t, types.EmptyRootHash.String(), result.Mismatches[1].Actual)
+}
@@ -0,0 +1,93 @@
+package verify
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use: "verify",
+		Short: "Recomputes the transactions, receipts and state roots of the stored blocks and reports " +
+			"the blocks whose data doesn't match the headers. The node must be stopped",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(verifyCmd)
+	helper.SetRequiredFlags(verifyCmd, params.getRequiredFlags())
+
+	return verifyCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().StringVar(
+		&params.genesisPath,
+		chainFlag,
+		"",
+		"the genesis file of the chain, required for re-executing the blocks",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.from,
+		fromFlag,
+		0,
+		"the first block of the verified range",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.to,
+		toFlag,
+		0,
+		"the last block of the verified range (default is head)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.reExecute,
+		reExecuteFlag,
+		false,
+		"re-execute the blocks on top of the stored parent state and compare the resulting roots",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.openStorages(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+	defer params.closeStorages()
+
+	if err := params.verify(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if mismatches := len(params.result.Mismatches); mismatches > 0 {
+		// report the mismatches and exit with the error code
+		outputter.WriteCommandResult(params.getResult())
+		outputter.SetError(fmt.Errorf("found %d mismatches", mismatches))
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -29,6 +29,15 @@ const (
 	JSONRPCFlag     = "jsonrpc"
 )
 
+// Flags of the credentials the GRPC clients connect with
+const (
+	GRPCTLSCAFlag         = "grpc-tls-ca"
+	GRPCTLSCertFlag       = "grpc-tls-cert"
+	GRPCTLSKeyFlag        = "grpc-tls-key"
+	GRPCTLSServerNameFlag = "grpc-tls-server-name"
+	GRPCTokenFileFlag     = "grpc-token-file" //nolint:gosec
+)
+
 // GRPCAddressFlagLEGACY Legacy flag that needs to be present to preserve backwards
 // compatibility with running clients
 const (
@@ -0,0 +1,103 @@
+package externalsigner
+
+import (
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/hashicorp/go-hclog"
+	"github.com/spf13/cobra"
+)
+
+var params externalSignerParams
+
+func GetCommand() *cobra.Command {
+	externalSignerCmd := &cobra.Command{
+		Use: "external-signer",
+		Short: "Runs the external signer, which holds the validator keys and signs the requests of the node " +
+			"(started with the --external-signer flag) allowed by the policy rules",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(externalSignerCmd)
+
+	return externalSignerCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.endpoint,
+		endpointFlag,
+		"",
+		"the endpoint the sign requests are served on (the unix socket path or tcp://host:port)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rulesPath,
+		rulesFlag,
+		"",
+		"the path of the JSON file with the policy rules (the allowed \"kinds\" of the signatures and "+
+			"the allowed hex encoded \"bls_domains\"), all the requests are approved if not set",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountConfigFlag, polybftsecrets.AccountDirFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(_ *cobra.Command, _ []string) error {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:   "external-signer",
+		Output: os.Stderr,
+	})
+
+	account, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig)
+	if err != nil {
+		return err
+	}
+
+	rules, err := params.readRules()
+	if err != nil {
+		return err
+	}
+
+	listener, err := wallet.ListenExternalSigner(params.endpoint)
+	if err != nil {
+		return err
+	}
+
+	service := wallet.NewExternalSignerService(account, rules, logger)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- service.Serve(listener)
+	}()
+
+	logger.Info("serving the sign requests", "endpoint", params.endpoint, "address", account.Address())
+
+	select {
+	case <-common.GetTerminationSignalCh():
+		return listener.Close()
+	case err := <-errCh:
+		return err
+	}
+}
@@ -0,0 +1,53 @@
+package externalsigner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+)
+
+const (
+	endpointFlag = "endpoint"
+	rulesFlag    = "rules"
+)
+
+var errEndpointMissing = errors.New("the external signer endpoint is not set")
+
+type externalSignerParams struct {
+	accountDir    string
+	accountConfig string
+	endpoint      string
+	rulesPath     string
+}
+
+func (p *externalSignerParams) validateFlags() error {
+	if p.endpoint == "" {
+		return errEndpointMissing
+	}
+
+	return sidechainHelper.ValidateSecretFlags(p.accountDir, p.accountConfig)
+}
+
+// readRules reads the policy rules from the JSON file, any request is approved if the file isn't set
+func (p *externalSignerParams) readRules() (*wallet.ExternalSignerRules, error) {
+	rules := &wallet.ExternalSignerRules{}
+
+	if p.rulesPath == "" {
+		return rules, nil
+	}
+
+	raw, err := os.ReadFile(p.rulesPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the rules file: %w", err)
+	}
+
+	if err := json.Unmarshal(raw, rules); err != nil {
+		return nil, fmt.Errorf("failed to parse the rules file: %w", err)
+	}
+
+	return rules, nil
+}
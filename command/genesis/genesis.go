@@ -3,6 +3,7 @@ package genesis
 import (
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/genesis/predeploy"
 	"github.com/0xPolygon/polygon-edge/command/helper"
@@ -46,6 +47,27 @@ func setFlags(cmd *cobra.Command) {
 		"the ID of the chain (only used for IBFT consensus)",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.networkID,
+		networkIDFlag,
+		0,
+		"the network ID reported by net_version, if it has to differ from the chain ID (defaults to the chain ID)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.maxCodeSize,
+		maxCodeSizeFlag,
+		0,
+		fmt.Sprintf("the max size of the deployed contract code in bytes (defaults to %d)", chain.DefaultMaxCodeSize),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.maxInitCodeSize,
+		maxInitCodeSizeFlag,
+		0,
+		fmt.Sprintf("the max size of the contract creation code in bytes (defaults to %d)", chain.DefaultMaxInitCodeSize),
+	)
+
 	cmd.Flags().StringVar(
 		&params.name,
 		nameFlag,
@@ -63,6 +85,22 @@ func setFlags(cmd *cobra.Command) {
 		),
 	)
 
+	cmd.Flags().StringVar(
+		&params.premineFile,
+		premineFileFlag,
+		"",
+		"the path to the CSV or JSON file with the premined accounts "+
+			"(CSV columns: address,balance[,code[,storage as key=value;key=value]], "+
+			"JSON: array of {address, balance, code, storage} objects)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.premineTotal,
+		premineTotalFlag,
+		"",
+		"the expected total balance of the premined accounts, generation fails if the total doesn't match",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.blockGasLimit,
 		blockGasLimitFlag,
@@ -235,6 +273,14 @@ func setFlags(cmd *cobra.Command) {
 			defaultBlockTimeDrift,
 			"configuration for block time drift value (in seconds)",
 		)
+
+		cmd.Flags().Uint64Var(
+			&params.maxBlockTimestampSkew,
+			maxBlockTimestampSkewFlag,
+			0,
+			"the maximum number of seconds the timestamp of the proposed block can be ahead of the wall clock "+
+				"(the check is disabled if zero)",
+		)
 	}
 
 	// Access Control Lists
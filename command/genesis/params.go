@@ -28,7 +28,12 @@ const (
 	dirFlag               = "dir"
 	nameFlag              = "name"
 	premineFlag           = "premine"
+	premineFileFlag       = "premine-file"
+	premineTotalFlag      = "premine-total"
 	chainIDFlag           = "chain-id"
+	networkIDFlag         = "network-id"
+	maxCodeSizeFlag       = "max-code-size"
+	maxInitCodeSizeFlag   = "max-init-code-size"
 	epochSizeFlag         = "epoch-size"
 	epochRewardFlag       = "epoch-reward"
 	blockGasLimitFlag     = "block-gas-limit"
@@ -71,14 +76,20 @@ type genesisParams struct {
 	consensusRaw        string
 	validatorPrefixPath string
 	premine             []string
+	premineFile         string
+	premineTotal        string
 	bootnodes           []string
 	ibftValidators      validators.Validators
 
 	ibftValidatorsRaw []string
 
 	chainID   uint64
+	networkID uint64
 	epochSize uint64
 
+	maxCodeSize     uint64
+	maxInitCodeSize uint64
+
 	blockGasLimit uint64
 	isPos         bool
 
@@ -98,13 +109,14 @@ type genesisParams struct {
 	genesisConfig *chain.Chain
 
 	// PolyBFT
-	validatorsPath       string
-	validatorsPrefixPath string
-	validators           []string
-	sprintSize           uint64
-	blockTime            time.Duration
-	epochReward          uint64
-	blockTimeDrift       uint64
+	validatorsPath        string
+	validatorsPrefixPath  string
+	validators            []string
+	sprintSize            uint64
+	blockTime             time.Duration
+	epochReward           uint64
+	blockTimeDrift        uint64
+	maxBlockTimestampSkew uint64
 
 	initialStateRoot string
 
@@ -397,9 +409,12 @@ func (p *genesisParams) initGenesisConfig() error {
 			GasUsed:    command.DefaultGenesisGasUsed,
 		},
 		Params: &chain.Params{
-			ChainID: int64(p.chainID),
-			Forks:   enabledForks,
-			Engine:  p.consensusEngineConfig,
+			ChainID:         int64(p.chainID),
+			NetworkID:       p.networkID,
+			Forks:           enabledForks,
+			Engine:          p.consensusEngineConfig,
+			MaxCodeSize:     p.maxCodeSize,
+			MaxInitCodeSize: p.maxInitCodeSize,
 		},
 		Bootnodes: p.bootnodes,
 	}
@@ -430,9 +445,7 @@ func (p *genesisParams) initGenesisConfig() error {
 	}
 
 	for _, premineInfo := range p.premineInfos {
-		chainConfig.Genesis.Alloc[premineInfo.address] = &chain.GenesisAccount{
-			Balance: premineInfo.amount,
-		}
+		chainConfig.Genesis.Alloc[premineInfo.address] = premineInfo.toGenesisAccount()
 	}
 
 	p.genesisConfig = chainConfig
@@ -495,6 +508,19 @@ func (p *genesisParams) parsePremineInfo() error {
 		p.premineInfos = append(p.premineInfos, premineInfo)
 	}
 
+	if p.premineFile != "" {
+		premineInfos, err := appendPremineFileInfos(p.premineInfos, p.premineFile)
+		if err != nil {
+			return err
+		}
+
+		p.premineInfos = premineInfos
+	}
+
+	if p.premineTotal != "" {
+		return validatePremineTotal(p.premineInfos, p.premineTotal)
+	}
+
 	return nil
 }
 
@@ -605,7 +631,13 @@ func (p *genesisParams) extractNativeTokenMetadata() error {
 }
 
 func (p *genesisParams) getResult() command.CommandResult {
-	return &GenesisResult{
+	result := &GenesisResult{
 		Message: fmt.Sprintf("\nGenesis written to %s\n", p.genesisPath),
 	}
+
+	if p.genesisConfig != nil {
+		result.AllocHash = genesisAllocHash(p.genesisConfig.Genesis.Alloc).String()
+	}
+
+	return result
 }
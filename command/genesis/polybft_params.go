@@ -35,7 +35,8 @@ const (
 	blockTimeFlag  = "block-time"
 	trieRootFlag   = "trieroot"
 
-	blockTimeDriftFlag = "block-time-drift"
+	blockTimeDriftFlag        = "block-time-drift"
+	maxBlockTimestampSkewFlag = "max-block-timestamp-skew"
 
 	defaultEpochSize        = uint64(10)
 	defaultSprintSize       = uint64(5)
@@ -148,7 +149,8 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 			WalletAddress: walletPremineInfo.address,
 			WalletAmount:  walletPremineInfo.amount,
 		},
-		BlockTimeDrift: p.blockTimeDrift,
+		BlockTimeDrift:        p.blockTimeDrift,
+		MaxBlockTimestampSkew: p.maxBlockTimestampSkew,
 	}
 
 	// Disable london hardfork if burn contract address is not provided
@@ -160,8 +162,11 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 	chainConfig := &chain.Chain{
 		Name: p.name,
 		Params: &chain.Params{
-			ChainID: int64(p.chainID),
-			Forks:   enabledForks,
+			ChainID:         int64(p.chainID),
+			NetworkID:       p.networkID,
+			Forks:           enabledForks,
+			MaxCodeSize:     p.maxCodeSize,
+			MaxInitCodeSize: p.maxInitCodeSize,
 			Engine: map[string]interface{}{
 				string(server.PolyBFTConsensus): polyBftConfig,
 			},
@@ -203,9 +208,7 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 			continue
 		}
 
-		allocs[premine.address] = &chain.GenesisAccount{
-			Balance: premine.amount,
-		}
+		allocs[premine.address] = premine.toGenesisAccount()
 	}
 
 	validatorMetadata := make([]*validator.ValidatorMetadata, len(initialValidators))
@@ -301,6 +304,8 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 		chainConfig.Genesis.BaseFeeEM = command.DefaultGenesisBaseFeeEM
 	}
 
+	p.genesisConfig = chainConfig
+
 	return helper.WriteGenesisConfigToDisk(chainConfig, params.genesisPath)
 }
 
@@ -471,6 +476,13 @@ func (p *genesisParams) deployContracts(
 		}
 	}
 
+	// the message dispatcher is a precompile, its placeholder code (INVALID opcode) lets the state receiver
+	// recognize it as the state sync receiver, while the code itself is never executed
+	allocations[contracts.MessageDispatcherPrecompile] = &chain.GenesisAccount{
+		Balance: big.NewInt(0),
+		Code:    []byte{0xfe},
+	}
+
 	if rewardTokenByteCode != nil {
 		// if reward token is provided in genesis then, add it to allocations
 		// to RewardTokenContract address and update Polybft config
@@ -11,7 +11,7 @@ import (
 func GetCommand() *cobra.Command {
 	genesisPredeployCmd := &cobra.Command{
 		Use:     "predeploy",
-		Short:   "Specifies the contract to be predeployed on chain start",
+		Short:   "Predeploys the contract on chain start, by executing its constructor on top of the genesis allocations",
 		PreRunE: runPreRun,
 		Run:     runCommand,
 	}
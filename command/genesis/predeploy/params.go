@@ -1,9 +1,11 @@
 package predeploy
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command"
@@ -50,6 +52,9 @@ type predeployParams struct {
 	constructorArgs []string
 
 	genesisConfig *chain.Chain
+
+	// modifiedAccounts are the already allocated accounts modified by the constructor
+	modifiedAccounts []types.Address
 }
 
 func (p *predeployParams) getRequiredFlags() []string {
@@ -138,7 +143,10 @@ func (p *predeployParams) updateGenesisConfig() error {
 		return errAddressTaken
 	}
 
-	predeployAccount, err := predeployment.GenerateGenesisAccountFromFile(
+	// the constructor is executed on top of the genesis allocations,
+	// and can modify the already allocated accounts
+	accounts, err := predeployment.GenerateGenesisAccounts(
+		p.genesisConfig,
 		p.artifactsPath,
 		p.constructorArgs,
 		p.address,
@@ -147,7 +155,23 @@ func (p *predeployParams) updateGenesisConfig() error {
 		return err
 	}
 
-	p.genesisConfig.Genesis.Alloc[p.address] = predeployAccount
+	if p.genesisConfig.Genesis.Alloc == nil {
+		p.genesisConfig.Genesis.Alloc = make(map[types.Address]*chain.GenesisAccount, len(accounts))
+	}
+
+	p.modifiedAccounts = make([]types.Address, 0, len(accounts))
+
+	for address, account := range accounts {
+		p.genesisConfig.Genesis.Alloc[address] = account
+
+		if address != p.address {
+			p.modifiedAccounts = append(p.modifiedAccounts, address)
+		}
+	}
+
+	sort.Slice(p.modifiedAccounts, func(i, j int) bool {
+		return bytes.Compare(p.modifiedAccounts[i].Bytes(), p.modifiedAccounts[j].Bytes()) < 0
+	})
 
 	return nil
 }
@@ -170,7 +194,13 @@ func (p *predeployParams) overrideGenesisConfig() error {
 }
 
 func (p *predeployParams) getResult() command.CommandResult {
+	modifiedAccounts := make([]string, len(p.modifiedAccounts))
+	for i, address := range p.modifiedAccounts {
+		modifiedAccounts[i] = address.String()
+	}
+
 	return &GenesisPredeployResult{
-		Address: p.address.String(),
+		Address:          p.address.String(),
+		ModifiedAccounts: modifiedAccounts,
 	}
 }
@@ -3,12 +3,14 @@ package predeploy
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
 )
 
 type GenesisPredeployResult struct {
-	Address string `json:"address"`
+	Address          string   `json:"address"`
+	ModifiedAccounts []string `json:"modifiedAccounts,omitempty"`
 }
 
 func (r *GenesisPredeployResult) GetOutput() string {
@@ -20,6 +22,10 @@ func (r *GenesisPredeployResult) GetOutput() string {
 		fmt.Sprintf("Address|%s", r.Address),
 	}
 
+	if len(r.ModifiedAccounts) > 0 {
+		outputs = append(outputs, fmt.Sprintf("Modified accounts|%s", strings.Join(r.ModifiedAccounts, ", ")))
+	}
+
 	buffer.WriteString(helper.FormatKV(outputs))
 	buffer.WriteString("\n")
 
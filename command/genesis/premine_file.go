@@ -0,0 +1,290 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	premineFileCSV  = ".csv"
+	premineFileJSON = ".json"
+
+	// csvStorageSeparator separates the storage slots in the storage column of the CSV premine file
+	csvStorageSeparator = ";"
+	// csvSlotSeparator separates the key and the value of the storage slot
+	csvSlotSeparator = "="
+)
+
+var (
+	errUnsupportedPremineFile = errors.New("premine file must have .csv or .json extension")
+	errDuplicatePremine       = errors.New("account is premined more than once")
+	errPremineTotalMismatch   = errors.New("total premined balance doesn't match the expected one")
+)
+
+// premineFileEntry is a single allocation of the JSON premine file
+type premineFileEntry struct {
+	Address types.Address             `json:"address"`
+	Balance string                    `json:"balance"`
+	Code    string                    `json:"code,omitempty"`
+	Storage map[types.Hash]types.Hash `json:"storage,omitempty"`
+}
+
+// readPremineFile reads the allocations from the premine file.
+// JSON file holds an array of {address, balance, code, storage} objects, and CSV file
+// holds address,balance[,code[,storage]] rows, where storage is in the key=value;key=value format
+func readPremineFile(path string) ([]*premineInfo, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read premine file: %w", err)
+	}
+
+	var entries []*premineFileEntry
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case premineFileJSON:
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			return nil, fmt.Errorf("failed to parse premine file: %w", err)
+		}
+	case premineFileCSV:
+		if entries, err = parsePremineCSV(raw); err != nil {
+			return nil, fmt.Errorf("failed to parse premine file: %w", err)
+		}
+	default:
+		return nil, errUnsupportedPremineFile
+	}
+
+	premineInfos := make([]*premineInfo, len(entries))
+
+	for i, entry := range entries {
+		if premineInfos[i], err = entry.toPremineInfo(); err != nil {
+			return nil, fmt.Errorf("invalid premine file entry %d (%s): %w", i, entry.Address, err)
+		}
+	}
+
+	return premineInfos, nil
+}
+
+// parsePremineCSV parses the rows of the CSV premine file. The header row is optional
+func parsePremineCSV(raw []byte) ([]*premineFileEntry, error) {
+	reader := csv.NewReader(bytes.NewReader(raw))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var entries []*premineFileEntry
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(entries) == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			// header row
+			continue
+		}
+
+		if len(record) < 2 || len(record) > 4 {
+			return nil, fmt.Errorf("expected 2 to 4 columns, got %d in the row %v", len(record), record)
+		}
+
+		addressRaw := strings.TrimSpace(record[0])
+		if err := types.IsValidAddress(addressRaw); err != nil {
+			return nil, fmt.Errorf("invalid address %s: %w", addressRaw, err)
+		}
+
+		entry := &premineFileEntry{
+			Address: types.StringToAddress(addressRaw),
+			Balance: strings.TrimSpace(record[1]),
+		}
+
+		if len(record) > 2 {
+			entry.Code = strings.TrimSpace(record[2])
+		}
+
+		if len(record) > 3 {
+			if entry.Storage, err = parseCSVStorage(record[3]); err != nil {
+				return nil, fmt.Errorf("invalid storage of %s: %w", entry.Address, err)
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+}
+
+// parseCSVStorage parses the storage in the key=value;key=value format
+func parseCSVStorage(raw string) (map[types.Hash]types.Hash, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	storage := map[types.Hash]types.Hash{}
+
+	for _, slot := range strings.Split(raw, csvStorageSeparator) {
+		kv := strings.Split(strings.TrimSpace(slot), csvSlotSeparator)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid storage slot %s", slot)
+		}
+
+		key, err := hex.DecodeHex(strings.TrimSpace(kv[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid storage key %s: %w", kv[0], err)
+		}
+
+		value, err := hex.DecodeHex(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid storage value %s: %w", kv[1], err)
+		}
+
+		storage[types.BytesToHash(key)] = types.BytesToHash(value)
+	}
+
+	return storage, nil
+}
+
+func (e *premineFileEntry) toPremineInfo() (*premineInfo, error) {
+	info := &premineInfo{
+		address: e.Address,
+		amount:  command.DefaultPremineBalance,
+		storage: e.Storage,
+	}
+
+	if e.Balance != "" {
+		amount, err := types.ParseUint256orHex(&e.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse amount %s: %w", e.Balance, err)
+		}
+
+		info.amount = amount
+	}
+
+	if e.Code != "" {
+		code, err := hex.DecodeHex(e.Code)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse code: %w", err)
+		}
+
+		info.code = code
+	}
+
+	return info, nil
+}
+
+// appendPremineFileInfos appends the allocations of the premine file to the premine infos.
+// Accounts of the premine file must not be premined more than once
+func appendPremineFileInfos(premineInfos []*premineInfo, path string) ([]*premineInfo, error) {
+	fileInfos, err := readPremineFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	premined := make(map[types.Address]struct{}, len(premineInfos)+len(fileInfos))
+	for _, info := range premineInfos {
+		premined[info.address] = struct{}{}
+	}
+
+	for _, info := range fileInfos {
+		if _, ok := premined[info.address]; ok {
+			return nil, fmt.Errorf("%w: %s", errDuplicatePremine, info.address)
+		}
+
+		premined[info.address] = struct{}{}
+	}
+
+	return append(premineInfos, fileInfos...), nil
+}
+
+// validatePremineTotal checks that the total premined balance matches the expected one
+func validatePremineTotal(premineInfos []*premineInfo, expectedTotalRaw string) error {
+	expectedTotal, err := types.ParseUint256orHex(&expectedTotalRaw)
+	if err != nil {
+		return fmt.Errorf("failed to parse expected premine total %s: %w", expectedTotalRaw, err)
+	}
+
+	// the last premine of the account is the one written to the genesis
+	balances := make(map[types.Address]*big.Int, len(premineInfos))
+	for _, info := range premineInfos {
+		balances[info.address] = info.amount
+	}
+
+	total := new(big.Int)
+	for _, balance := range balances {
+		total.Add(total, balance)
+	}
+
+	if total.Cmp(expectedTotal) != 0 {
+		return fmt.Errorf("%w: expected %s, got %s", errPremineTotalMismatch, expectedTotal, total)
+	}
+
+	return nil
+}
+
+// genesisAllocHash returns the digest of the genesis allocations, independent of the allocations order.
+// Genesis files generated from the same allocations have the same digest
+func genesisAllocHash(alloc map[types.Address]*chain.GenesisAccount) types.Hash {
+	addresses := make([]types.Address, 0, len(alloc))
+	for address := range alloc {
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	hasher := keccak.NewKeccak256()
+
+	for _, address := range addresses {
+		account := alloc[address]
+
+		var balance []byte
+		if account.Balance != nil {
+			balance = account.Balance.Bytes()
+		}
+
+		nonce := make([]byte, 8)
+		binary.BigEndian.PutUint64(nonce, account.Nonce)
+
+		_, _ = hasher.Write(address.Bytes())
+		_, _ = hasher.Write(common.PadLeftOrTrim(balance, types.HashLength))
+		_, _ = hasher.Write(nonce)
+		_, _ = hasher.Write(keccak.Keccak256(nil, account.Code))
+
+		keys := make([]types.Hash, 0, len(account.Storage))
+		for key := range account.Storage {
+			keys = append(keys, key)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+		})
+
+		for _, key := range keys {
+			_, _ = hasher.Write(key.Bytes())
+			_, _ = hasher.Write(account.Storage[key].Bytes())
+		}
+	}
+
+	return types.BytesToHash(hasher.Sum(nil))
+}
@@ -0,0 +1,142 @@
+package genesis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func writePremineFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func Test_readPremineFile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr1 = types.StringToAddress("1")
+		addr2 = types.StringToAddress("2")
+		addr3 = types.StringToAddress("3")
+
+		expected = []*premineInfo{
+			{address: addr1, amount: ethgo.Ether(10)},
+			{address: addr2, amount: command.DefaultPremineBalance},
+			{
+				address: addr3,
+				amount:  ethgo.Ether(0),
+				code:    []byte{0x60, 0x80},
+				storage: map[types.Hash]types.Hash{
+					types.StringToHash("1"): types.StringToHash("2"),
+					types.StringToHash("3"): types.StringToHash("4"),
+				},
+			},
+		}
+	)
+
+	csvPath := writePremineFile(t, "premine.csv", `address,balance,code,storage
+# comment
+`+addr1.String()+`,10000000000000000000
+`+addr2.String()+`,
+`+addr3.String()+`,0x0,0x6080,0x01=0x02;0x03=0x04
+`)
+
+	jsonPath := writePremineFile(t, "premine.json", `[
+		{"address": "`+addr1.String()+`", "balance": "0x8ac7230489e80000"},
+		{"address": "`+addr2.String()+`"},
+		{
+			"address": "`+addr3.String()+`",
+			"balance": "0",
+			"code": "0x6080",
+			"storage": {
+				"`+types.StringToHash("1").String()+`": "`+types.StringToHash("2").String()+`",
+				"`+types.StringToHash("3").String()+`": "`+types.StringToHash("4").String()+`"
+			}
+		}
+	]`)
+
+	for _, path := range []string{csvPath, jsonPath} {
+		premineInfos, err := readPremineFile(path)
+		require.NoError(t, err)
+		require.Equal(t, expected, premineInfos)
+	}
+
+	_, err := readPremineFile(writePremineFile(t, "premine.txt", ""))
+	require.ErrorIs(t, err, errUnsupportedPremineFile)
+
+	_, err = readPremineFile(writePremineFile(t, "invalid.csv", "0x123,1\n"))
+	require.ErrorContains(t, err, "invalid address")
+
+	_, err = readPremineFile(writePremineFile(t, "invalid_balance.csv", addr1.String()+",lorem\n"))
+	require.ErrorContains(t, err, "failed to parse amount")
+
+	_, err = readPremineFile(writePremineFile(t, "invalid_storage.csv", addr1.String()+",1,,0x01\n"))
+	require.ErrorContains(t, err, "invalid storage slot")
+}
+
+func Test_parsePremineInfo_PremineFile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr1 = types.StringToAddress("1")
+		addr2 = types.StringToAddress("2")
+	)
+
+	path := writePremineFile(t, "premine.csv", addr1.String()+",100\n"+addr2.String()+",200\n")
+
+	p := &genesisParams{
+		premine:      []string{types.ZeroAddress.String() + ":1", types.ZeroAddress.String() + ":10"},
+		premineFile:  path,
+		premineTotal: "310",
+	}
+	require.NoError(t, p.parsePremineInfo())
+	require.Len(t, p.premineInfos, 4)
+	require.NoError(t, p.validatePremineInfo())
+
+	p.premineTotal = "311"
+	require.ErrorIs(t, p.parsePremineInfo(), errPremineTotalMismatch)
+
+	// the account from the file is already premined by the flag
+	p = &genesisParams{
+		premine:     []string{addr2.String()},
+		premineFile: path,
+	}
+	require.ErrorIs(t, p.parsePremineInfo(), errDuplicatePremine)
+
+	path = writePremineFile(t, "duplicate.csv", addr1.String()+",100\n"+addr1.String()+",200\n")
+	p = &genesisParams{premineFile: path}
+	require.ErrorIs(t, p.parsePremineInfo(), errDuplicatePremine)
+}
+
+func Test_genesisAllocHash(t *testing.T) {
+	t.Parallel()
+
+	alloc := map[types.Address]*chain.GenesisAccount{
+		types.StringToAddress("1"): {Balance: ethgo.Ether(1)},
+		types.StringToAddress("2"): {
+			Balance: ethgo.Ether(2),
+			Nonce:   1,
+			Code:    []byte{0x1},
+			Storage: map[types.Hash]types.Hash{types.StringToHash("1"): types.StringToHash("2")},
+		},
+	}
+
+	hash := genesisAllocHash(alloc)
+	for i := 0; i < 10; i++ {
+		require.Equal(t, hash, genesisAllocHash(alloc))
+	}
+
+	alloc[types.StringToAddress("2")].Storage[types.StringToHash("1")] = types.StringToHash("3")
+	require.NotEqual(t, hash, genesisAllocHash(alloc))
+}
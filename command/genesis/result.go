@@ -2,10 +2,12 @@ package genesis
 
 import (
 	"bytes"
+	"fmt"
 )
 
 type GenesisResult struct {
-	Message string `json:"message"`
+	Message   string `json:"message"`
+	AllocHash string `json:"allocHash,omitempty"`
 }
 
 func (r *GenesisResult) GetOutput() string {
@@ -14,5 +16,9 @@ func (r *GenesisResult) GetOutput() string {
 	buffer.WriteString("\n[GENESIS SUCCESS]\n")
 	buffer.WriteString(r.Message)
 
+	if r.AllocHash != "" {
+		buffer.WriteString(fmt.Sprintf("Genesis allocation hash: %s\n", r.AllocHash))
+	}
+
 	return buffer.String()
 }
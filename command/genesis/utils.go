@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
@@ -67,6 +68,17 @@ func verifyGenesisExistence(genesisPath string) *GenesisGenError {
 type premineInfo struct {
 	address types.Address
 	amount  *big.Int
+	code    []byte
+	storage map[types.Hash]types.Hash
+}
+
+// toGenesisAccount returns the genesis allocation of the premined account
+func (p *premineInfo) toGenesisAccount() *chain.GenesisAccount {
+	return &chain.GenesisAccount{
+		Balance: p.amount,
+		Code:    p.code,
+		Storage: p.storage,
+	}
 }
 
 // parsePremineInfo parses provided premine information and returns premine address and amount
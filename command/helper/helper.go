@@ -7,21 +7,32 @@ import (
 	"math/big"
 	"net"
 	"net/url"
+	"os"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command"
 	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	polybftOp "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/server/grpcauth"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/ryanuber/columnize"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
+// grpcCredentials are the credentials the GRPC clients connect with, set by the GRPC credentials flags
+var grpcCredentials struct {
+	grpcauth.ClientConfig
+
+	tokenFile string
+}
+
 type ClientCloseResult struct {
 	Message string `json:"message"`
 }
@@ -41,11 +52,19 @@ const (
 // Like stop, error, etc.
 func HandleSignals(
 	closeFn func(),
+	reloadFn func(),
 	outputter command.OutputFormatter,
 ) error {
 	signalCh := common.GetTerminationSignalCh()
 	sig := <-signalCh
 
+	// if set, SIGHUP calls the reload callback instead of shutting down the client
+	for reloadFn != nil && sig == syscall.SIGHUP {
+		reloadFn()
+
+		sig = <-signalCh
+	}
+
 	closeMessage := fmt.Sprintf("\n[SIGNAL] Caught signal: %v\n", sig)
 	closeMessage += "Gracefully shutting down client...\n"
 
@@ -137,9 +156,38 @@ func GetIBFTOperatorClientConnection(address string) (
 	return ibftOp.NewIbftOperatorClient(conn), nil
 }
 
-// GetGRPCConnection returns a grpc client connection
+// GetPolybftOperatorClientConnection returns the Polybft operator client connection
+func GetPolybftOperatorClientConnection(address string) (
+	polybftOp.PolybftOperatorClient,
+	error,
+) {
+	conn, err := GetGRPCConnection(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return polybftOp.NewPolybftOperatorClient(conn), nil
+}
+
+// GetGRPCConnection returns a grpc client connection, using the credentials set by the GRPC credentials flags
 func GetGRPCConnection(address string) (*grpc.ClientConn, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	credentials := grpcCredentials.ClientConfig
+
+	if grpcCredentials.tokenFile != "" {
+		token, err := os.ReadFile(grpcCredentials.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the token file: %w", err)
+		}
+
+		credentials.Token = strings.TrimSpace(string(token))
+	}
+
+	opts, err := grpcauth.DialOptions(&credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.Dial(address, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to server: %w", err)
 	}
@@ -185,6 +233,45 @@ func RegisterGRPCAddressFlag(cmd *cobra.Command) {
 	)
 }
 
+// RegisterGRPCCredentialsFlags registers the flags of the credentials the GRPC clients connect with
+func RegisterGRPCCredentialsFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(
+		&grpcCredentials.CAFile,
+		command.GRPCTLSCAFlag,
+		"",
+		"the PEM encoded CA bundle the GRPC server certificate is verified against, "+
+			"the connection uses TLS if any of the TLS flags is set",
+	)
+
+	cmd.PersistentFlags().StringVar(
+		&grpcCredentials.CertFile,
+		command.GRPCTLSCertFlag,
+		"",
+		"the PEM encoded client certificate, for the GRPC servers requiring mutual TLS",
+	)
+
+	cmd.PersistentFlags().StringVar(
+		&grpcCredentials.KeyFile,
+		command.GRPCTLSKeyFlag,
+		"",
+		"the PEM encoded private key of the client certificate",
+	)
+
+	cmd.PersistentFlags().StringVar(
+		&grpcCredentials.ServerName,
+		command.GRPCTLSServerNameFlag,
+		"",
+		"the name the GRPC server certificate is verified for, the host of the GRPC address by default",
+	)
+
+	cmd.PersistentFlags().StringVar(
+		&grpcCredentials.tokenFile,
+		command.GRPCTokenFileFlag,
+		"",
+		"the file holding the bearer token sent to the GRPC server",
+	)
+}
+
 // RegisterLegacyGRPCAddressFlag registers the legacy GRPC address flag for all child commands
 func RegisterLegacyGRPCAddressFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(
@@ -2,12 +2,13 @@ package propose
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 )
 
 type IBFTProposeResult struct {
-	Address string `json:"-"`
-	Vote    string `json:"-"`
+	Address string `json:"address"`
+	Vote    string `json:"vote"`
 }
 
 func (r *IBFTProposeResult) GetOutput() string {
@@ -35,5 +36,13 @@ func (r *IBFTProposeResult) Message() string {
 }
 
 func (r *IBFTProposeResult) MarshalJSON() ([]byte, error) {
-	return []byte(fmt.Sprintf(`{"message": "%s"}`, r.Message())), nil
+	type result IBFTProposeResult
+
+	return json.Marshal(&struct {
+		*result
+		Message string `json:"message"`
+	}{
+		result:  (*result)(r),
+		Message: r.Message(),
+	})
 }
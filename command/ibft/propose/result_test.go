@@ -0,0 +1,29 @@
+package propose
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBFTProposeResult_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	result := &IBFTProposeResult{
+		Address: "0x1",
+		Vote:    authVote,
+	}
+
+	raw, err := json.Marshal(result)
+	require.NoError(t, err)
+
+	var decoded map[string]string
+
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	require.Equal(t, map[string]string{
+		"address": "0x1",
+		"vote":    authVote,
+		"message": result.Message(),
+	}, decoded)
+}
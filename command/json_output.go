@@ -6,7 +6,9 @@ import (
 	"os"
 )
 
-// cliOutput implements OutputFormatter interface by printing the output into std out in JSON format
+// jsonOutput implements OutputFormatter interface by printing the output into std out in JSON format.
+// Only the JSON documents are written to std out, so the output is parsed reliably by the scripts,
+// while the progress messages written in between go to std err
 type jsonOutput struct {
 	commonOutputFormatter
 }
@@ -21,6 +23,11 @@ func (jo *jsonOutput) WriteOutput() {
 	if jo.errorOutput != nil {
 		_, _ = fmt.Fprintln(os.Stderr, jo.getErrorOutput())
 
+		// return proper error exit code for json error output
+		os.Exit(1)
+	}
+
+	if jo.commandOutput == nil {
 		return
 	}
 
@@ -32,9 +39,10 @@ func (jo *jsonOutput) WriteCommandResult(result CommandResult) {
 	_, _ = fmt.Fprintln(os.Stdout, marshalJSONToString(result))
 }
 
-// WriteOutput implements OutputFormatter plus io.Writer interfaces
+// Write implements OutputFormatter plus io.Writer interfaces,
+// the progress messages are written to std err so they don't interleave with the JSON output
 func (jo *jsonOutput) Write(p []byte) (n int, err error) {
-	return os.Stdout.Write(p)
+	return os.Stderr.Write(p)
 }
 
 func (jo *jsonOutput) getErrorOutput() string {
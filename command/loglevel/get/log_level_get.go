@@ -0,0 +1,46 @@
+package get
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	logLevelHelper "github.com/0xPolygon/polygon-edge/command/loglevel/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/spf13/cobra"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Returns the default log level and the log levels of the modules overriding it",
+		Args:  cobra.NoArgs,
+		Run:   runCommand,
+	}
+
+	return getCmd
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	resp, err := getLogLevel(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(logLevelHelper.NewLogLevelResult(resp))
+}
+
+func getLogLevel(grpcAddress string) (*proto.LogLevelResponse, error) {
+	client, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.LogLevelGet(context.Background(), &empty.Empty{})
+}
@@ -0,0 +1,44 @@
+package helper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+type LogLevelResult struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+}
+
+func NewLogLevelResult(resp *proto.LogLevelResponse) *LogLevelResult {
+	return &LogLevelResult{
+		Level:   resp.Level,
+		Modules: resp.Modules,
+	}
+}
+
+func (r *LogLevelResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	modules := make([]string, 0, len(r.Modules))
+	for module := range r.Modules {
+		modules = append(modules, module)
+	}
+
+	sort.Strings(modules)
+
+	rows := []string{fmt.Sprintf("Default|%s", r.Level)}
+	for _, module := range modules {
+		rows = append(rows, fmt.Sprintf("%s|%s", module, r.Modules[module]))
+	}
+
+	buffer.WriteString("\n[LOG LEVELS]\n")
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,31 @@
+package loglevel
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/loglevel/get"
+	"github.com/0xPolygon/polygon-edge/command/loglevel/set"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	logLevelCmd := &cobra.Command{
+		Use:   "log-level",
+		Short: "Top level command for interacting with the log levels of the node. Only accepts subcommands.",
+	}
+
+	helper.RegisterGRPCAddressFlag(logLevelCmd)
+	helper.RegisterGRPCCredentialsFlags(logLevelCmd)
+
+	registerSubcommands(logLevelCmd)
+
+	return logLevelCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// log-level get
+		get.GetCommand(),
+		// log-level set
+		set.GetCommand(),
+	)
+}
@@ -0,0 +1,56 @@
+package set
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	setCmd := &cobra.Command{
+		Use: "set",
+		Short: "Sets the default log level or the log level of a module (e.g. network) at runtime. " +
+			"The log levels are persisted to the data dir, so they are kept after the node restart",
+		Run: runCommand,
+	}
+
+	setFlags(setCmd)
+
+	return setCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.module,
+		moduleFlag,
+		"",
+		"the module (logger name) to set the log level for. If omitted, the default log level is set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.level,
+		levelFlag,
+		"",
+		"the log level (trace, debug, info, warn or error). "+
+			"If omitted, the module is reset to the default log level",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initSystemClient(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.setLogLevel(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
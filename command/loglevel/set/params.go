@@ -0,0 +1,60 @@
+package set
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	logLevelHelper "github.com/0xPolygon/polygon-edge/command/loglevel/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+const (
+	moduleFlag = "module"
+	levelFlag  = "level"
+)
+
+var (
+	params = &setParams{}
+)
+
+type setParams struct {
+	module string
+	level  string
+
+	systemClient proto.SystemClient
+
+	response *proto.LogLevelResponse
+}
+
+func (p *setParams) initSystemClient(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	p.systemClient = systemClient
+
+	return nil
+}
+
+func (p *setParams) setLogLevel() error {
+	resp, err := p.systemClient.LogLevelSet(
+		context.Background(),
+		&proto.LogLevelSetRequest{
+			Module: p.module,
+			Level:  p.level,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.response = resp
+
+	return nil
+}
+
+func (p *setParams) getResult() command.CommandResult {
+	return logLevelHelper.NewLogLevelResult(p.response)
+}
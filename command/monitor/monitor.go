@@ -23,6 +23,7 @@ func GetCommand() *cobra.Command {
 	}
 
 	helper.RegisterGRPCAddressFlag(monitorCmd)
+	helper.RegisterGRPCCredentialsFlags(monitorCmd)
 
 	return monitorCmd
 }
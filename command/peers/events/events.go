@@ -0,0 +1,90 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/spf13/cobra"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	peersEventsCmd := &cobra.Command{
+		Use:   "events",
+		Short: "Starts logging peer connect / disconnect / ban / dial failure events",
+		Run:   runCommand,
+	}
+
+	return peersEventsCmd
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	stream, err := getPeerEventsStream(ctx, helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	runSubscribeLoop(stream, outputter)
+}
+
+func getPeerEventsStream(
+	ctx context.Context,
+	grpcAddress string,
+) (proto.System_SubscribePeerEventsClient, error) {
+	client, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SubscribePeerEvents(ctx, &empty.Empty{})
+}
+
+func runSubscribeLoop(
+	stream proto.System_SubscribePeerEventsClient,
+	outputter command.OutputFormatter,
+) {
+	doneCh := make(chan struct{}, 1)
+
+	go func() {
+		defer func() {
+			doneCh <- struct{}{}
+		}()
+
+		for {
+			streamEvent, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				outputter.SetError(fmt.Errorf("failed to read event: %w", err))
+				outputter.WriteOutput()
+
+				return
+			}
+
+			outputter.SetCommandResult(newPeerEventResult(streamEvent))
+			outputter.SetError(nil)
+			outputter.WriteOutput()
+		}
+	}()
+
+	select {
+	case <-common.GetTerminationSignalCh():
+	case <-doneCh:
+	}
+}
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+type PeerEventResult struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func newPeerEventResult(e *proto.PeerEvent) *PeerEventResult {
+	return &PeerEventResult{
+		ID:     e.Id,
+		Type:   e.Type.String(),
+		Reason: e.Reason,
+	}
+}
+
+func (r *PeerEventResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PEER EVENT]\n")
+
+	rows := []string{
+		fmt.Sprintf("Event Type|%s", r.Type),
+		fmt.Sprintf("Peer ID|%s", r.ID),
+	}
+
+	if r.Reason != "" {
+		rows = append(rows, fmt.Sprintf("Reason|%s", r.Reason))
+	}
+
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
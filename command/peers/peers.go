@@ -3,6 +3,7 @@ package peers
 import (
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/peers/add"
+	"github.com/0xPolygon/polygon-edge/command/peers/events"
 	"github.com/0xPolygon/polygon-edge/command/peers/list"
 	"github.com/0xPolygon/polygon-edge/command/peers/status"
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ func GetCommand() *cobra.Command {
 	}
 
 	helper.RegisterGRPCAddressFlag(peersCmd)
+	helper.RegisterGRPCCredentialsFlags(peersCmd)
 
 	registerSubcommands(peersCmd)
 
@@ -29,5 +31,7 @@ func registerSubcommands(baseCmd *cobra.Command) {
 		list.GetCommand(),
 		// peers add
 		add.GetCommand(),
+		// peers events
+		events.GetCommand(),
 	)
 }
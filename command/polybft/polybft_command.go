@@ -1,6 +1,8 @@
 package polybft
 
 import (
+	"github.com/0xPolygon/polygon-edge/command/polybft/stats"
+	"github.com/0xPolygon/polygon-edge/command/polybft/vectors"
 	"github.com/0xPolygon/polygon-edge/command/rootchain/registration"
 	"github.com/0xPolygon/polygon-edge/command/rootchain/staking"
 	"github.com/0xPolygon/polygon-edge/command/rootchain/supernet"
@@ -9,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/rootchain/whitelist"
 	"github.com/0xPolygon/polygon-edge/command/rootchain/withdraw"
 	"github.com/0xPolygon/polygon-edge/command/sidechain/rewards"
+	"github.com/0xPolygon/polygon-edge/command/sidechain/unjail"
 	"github.com/0xPolygon/polygon-edge/command/sidechain/unstaking"
 	sidechainWithdraw "github.com/0xPolygon/polygon-edge/command/sidechain/withdraw"
 	"github.com/spf13/cobra"
@@ -27,6 +30,8 @@ func GetCommand() *cobra.Command {
 		sidechainWithdraw.GetCommand(),
 		// sidechain (reward pool) command to withdraw pending rewards
 		rewards.GetCommand(),
+		// sidechain command to unjail the validator once its jail period has passed
+		unjail.GetCommand(),
 		// rootchain (stake manager) command to withdraw stake
 		withdraw.GetCommand(),
 		// rootchain (supernet manager) command that queries validator info
@@ -42,6 +47,10 @@ func GetCommand() *cobra.Command {
 		supernet.GetCommand(),
 		// rootchain command for deploying stake manager
 		stakemanager.GetCommand(),
+		// operator command that queries validator performance statistics
+		stats.GetCommand(),
+		// command that generates and verifies the IBFT messages conformance vectors
+		vectors.GetCommand(),
 	)
 
 	return polybftCmd
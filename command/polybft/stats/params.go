@@ -0,0 +1,41 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	polybftOp "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
+)
+
+const (
+	epochsFlag = "epochs"
+)
+
+var (
+	params = &statsParams{}
+)
+
+type statsParams struct {
+	epochs uint64
+
+	stats *polybftOp.ValidatorStatsResponse
+}
+
+func (p *statsParams) initStats(grpcAddress string) error {
+	client, err := helper.GetPolybftOperatorClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	stats, err := client.ValidatorStats(
+		context.Background(),
+		&polybftOp.ValidatorStatsRequest{Epochs: p.epochs},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.stats = stats
+
+	return nil
+}
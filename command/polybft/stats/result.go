@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	polybftOp "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
+)
+
+type ValidatorStats struct {
+	Address            string  `json:"address"`
+	BlocksProposed     uint64  `json:"blocks_proposed"`
+	BlocksMissed       uint64  `json:"blocks_missed"`
+	SignaturesIncluded uint64  `json:"signatures_included"`
+	AverageRound       float64 `json:"average_round"`
+	Reward             string  `json:"reward"`
+}
+
+type EpochStats struct {
+	Epoch      uint64           `json:"epoch"`
+	FirstBlock uint64           `json:"first_block"`
+	LastBlock  uint64           `json:"last_block"`
+	Validators []ValidatorStats `json:"validators"`
+}
+
+type ValidatorStatsResult struct {
+	Epochs []EpochStats `json:"epochs"`
+}
+
+func newValidatorStatsResult(resp *polybftOp.ValidatorStatsResponse) *ValidatorStatsResult {
+	res := &ValidatorStatsResult{
+		Epochs: make([]EpochStats, len(resp.Epochs)),
+	}
+
+	for i, e := range resp.Epochs {
+		res.Epochs[i] = EpochStats{
+			Epoch:      e.Epoch,
+			FirstBlock: e.FirstBlock,
+			LastBlock:  e.LastBlock,
+			Validators: make([]ValidatorStats, len(e.Validators)),
+		}
+
+		for j, v := range e.Validators {
+			res.Epochs[i].Validators[j] = ValidatorStats{
+				Address:            v.Address,
+				BlocksProposed:     v.BlocksProposed,
+				BlocksMissed:       v.BlocksMissed,
+				SignaturesIncluded: v.SignaturesIncluded,
+				AverageRound:       v.AverageRound,
+				Reward:             v.Reward,
+			}
+		}
+	}
+
+	return res
+}
+
+func (r *ValidatorStatsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[VALIDATOR STATS]\n")
+
+	if len(r.Epochs) == 0 {
+		buffer.WriteString("No statistics found\n")
+
+		return buffer.String()
+	}
+
+	for _, e := range r.Epochs {
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Epoch|%d", e.Epoch),
+			fmt.Sprintf("Blocks|%d - %d", e.FirstBlock, e.LastBlock),
+		}))
+		buffer.WriteString("\n")
+
+		rows := make([]string, len(e.Validators)+1)
+		rows[0] = "ADDRESS|PROPOSED|MISSED|SIGNATURES|AVG ROUND|REWARD"
+
+		for i, v := range e.Validators {
+			rows[i+1] = fmt.Sprintf("%s|%d|%d|%d|%.2f|%s",
+				v.Address, v.BlocksProposed, v.BlocksMissed, v.SignaturesIncluded, v.AverageRound, v.Reward)
+		}
+
+		buffer.WriteString(helper.FormatList(rows))
+		buffer.WriteString("\n\n")
+	}
+
+	return buffer.String()
+}
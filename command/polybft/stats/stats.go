@@ -0,0 +1,43 @@
+package stats
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	statsCmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Returns the per-validator performance statistics over the last epochs",
+		Run:   runCommand,
+	}
+
+	helper.RegisterGRPCAddressFlag(statsCmd)
+	helper.RegisterGRPCCredentialsFlags(statsCmd)
+	setFlags(statsCmd)
+
+	return statsCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(
+		&params.epochs,
+		epochsFlag,
+		1,
+		"the number of the last epochs to return the statistics for",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initStats(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(newValidatorStatsResult(params.stats))
+}
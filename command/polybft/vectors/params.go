@@ -0,0 +1,64 @@
+package vectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+)
+
+const (
+	chainIDFlag = "chain-id"
+	outputFlag  = "output"
+	inputFlag   = "input"
+
+	defaultVectorsPath = "ibft-vectors.json"
+)
+
+var (
+	params = &vectorsParams{}
+)
+
+type vectorsParams struct {
+	chainID    uint64
+	outputPath string
+	inputPath  string
+}
+
+func (p *vectorsParams) generateVectors() (*polybft.IBFTVectors, error) {
+	vectors, err := polybft.GenerateIBFTVectors(p.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(vectors, "", "    ")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal vectors: %w", err)
+	}
+
+	if err := common.SaveFileSafe(p.outputPath, data, 0660); err != nil {
+		return nil, fmt.Errorf("failed to write vectors file: %w", err)
+	}
+
+	return vectors, nil
+}
+
+func (p *vectorsParams) verifyVectors() (*polybft.IBFTVectors, error) {
+	data, err := os.ReadFile(p.inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vectors file: %w", err)
+	}
+
+	vectors := &polybft.IBFTVectors{}
+	if err := json.Unmarshal(data, vectors); err != nil {
+		return nil, fmt.Errorf("could not unmarshal vectors: %w", err)
+	}
+
+	if err := polybft.VerifyIBFTVectors(vectors); err != nil {
+		return nil, err
+	}
+
+	return vectors, nil
+}
@@ -0,0 +1,51 @@
+package vectors
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+)
+
+type VectorsResult struct {
+	Action  string   `json:"action"`
+	Path    string   `json:"path"`
+	ChainID uint64   `json:"chain_id"`
+	Vectors []string `json:"vectors"`
+}
+
+func newVectorsResult(action, path string, vectors *polybft.IBFTVectors) *VectorsResult {
+	res := &VectorsResult{
+		Action:  action,
+		Path:    path,
+		ChainID: vectors.ChainID,
+		Vectors: make([]string, len(vectors.Vectors)),
+	}
+
+	for i, v := range vectors.Vectors {
+		res.Vectors[i] = fmt.Sprintf("%s|%s|%d|%d|%s", v.Name, v.Type, v.Height, v.Round, v.Signer)
+	}
+
+	return res
+}
+
+func (r *VectorsResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("\n[IBFT VECTORS %s]\n", r.Action))
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Path|%s", r.Path),
+		fmt.Sprintf("Chain ID|%d", r.ChainID),
+	}))
+	buffer.WriteString("\n\n")
+
+	rows := make([]string, len(r.Vectors)+1)
+	rows[0] = "NAME|TYPE|HEIGHT|ROUND|SIGNER"
+	copy(rows[1:], r.Vectors)
+
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,89 @@
+package vectors
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	vectorsCmd := &cobra.Command{
+		Use:   "ibft-vectors",
+		Short: "Generates and verifies the conformance vectors of the IBFT consensus messages wire format",
+	}
+
+	vectorsCmd.AddCommand(
+		getGenerateCommand(),
+		getVerifyCommand(),
+	)
+
+	return vectorsCmd
+}
+
+func getGenerateCommand() *cobra.Command {
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Writes the canonical signed IBFT messages along with the signing keys and the expected hashes",
+		Run:   runGenerateCommand,
+	}
+
+	generateCmd.Flags().Uint64Var(
+		&params.chainID,
+		chainIDFlag,
+		command.DefaultChainID,
+		"the chain ID the proposal hashes are calculated for",
+	)
+
+	generateCmd.Flags().StringVar(
+		&params.outputPath,
+		outputFlag,
+		defaultVectorsPath,
+		"the path of the generated vectors file",
+	)
+
+	return generateCmd
+}
+
+func getVerifyCommand() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verifies the encoding, hashes and signatures of the IBFT messages in the vectors file",
+		Run:   runVerifyCommand,
+	}
+
+	verifyCmd.Flags().StringVar(
+		&params.inputPath,
+		inputFlag,
+		defaultVectorsPath,
+		"the path of the vectors file to verify",
+	)
+
+	return verifyCmd
+}
+
+func runGenerateCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	vectors, err := params.generateVectors()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(newVectorsResult("GENERATED", params.outputPath, vectors))
+}
+
+func runVerifyCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	vectors, err := params.verifyVectors()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(newVectorsResult("VERIFIED", params.inputPath, vectors))
+}
@@ -17,6 +17,7 @@ const (
 	accountFlag            = "account"
 	privateKeyFlag         = "private"
 	insecureLocalStoreFlag = "insecure"
+	encryptFlag            = "encrypt"
 	networkFlag            = "network"
 	numFlag                = "num"
 	outputFlag             = "output"
@@ -38,6 +39,8 @@ type initParams struct {
 
 	insecureLocalStore bool
 
+	encrypt bool
+
 	output bool
 }
 
@@ -110,6 +113,17 @@ func (ip *initParams) setFlags(cmd *cobra.Command) {
 		"the flag indicating should the secrets stored on the local storage be encrypted",
 	)
 
+	cmd.Flags().BoolVar(
+		&ip.encrypt,
+		encryptFlag,
+		false,
+		fmt.Sprintf("the flag indicating whether the secrets stored on the local storage are encrypted by "+
+			"the passphrase, which is read from %s or prompted for", helper.PassphraseEnvVar),
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, insecureLocalStoreFlag)
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, AccountConfigFlag)
+
 	cmd.Flags().BoolVar(
 		&ip.output,
 		outputFlag,
@@ -121,6 +135,17 @@ func (ip *initParams) setFlags(cmd *cobra.Command) {
 func (ip *initParams) Execute() (Results, error) {
 	results := make(Results, ip.numberOfSecrets)
 
+	// the passphrase is read once for all the encrypted local secrets
+	var passphrase string
+
+	if ip.encrypt {
+		var err error
+
+		if passphrase, err = helper.ReadPassphrase(helper.PassphraseEnvVar, "Secrets passphrase: ", true); err != nil {
+			return results, err
+		}
+	}
+
 	for i := 0; i < ip.numberOfSecrets; i++ {
 		configDir, dataDir := ip.accountConfig, ip.accountDir
 
@@ -132,7 +157,17 @@ func (ip *initParams) Execute() (Results, error) {
 			configDir = fmt.Sprintf("%s%d", ip.accountConfig, i+1)
 		}
 
-		secretManager, err := GetSecretsManager(dataDir, configDir, ip.insecureLocalStore)
+		var (
+			secretManager secrets.SecretsManager
+			err           error
+		)
+
+		if ip.encrypt {
+			secretManager, err = helper.SetupEncryptedLocalSecretsManager(dataDir, passphrase)
+		} else {
+			secretManager, err = GetSecretsManager(dataDir, configDir, ip.insecureLocalStore)
+		}
+
 		if err != nil {
 			return results, err
 		}
@@ -27,9 +27,9 @@ var (
 	ErrInvalidParams                  = errors.New("no config file or data directory passed in")
 	ErrUnsupportedType                = errors.New("unsupported secrets manager")
 	ErrSecureLocalStoreNotImplemented = errors.New(
-		"use a secrets backend, or supply an --insecure flag " +
-			"to store the private keys locally on the filesystem, " +
-			"avoid doing so in production")
+		"use a secrets backend, or supply an --encrypt flag " +
+			"to store the passphrase encrypted private keys locally on the filesystem, " +
+			"or an --insecure flag to store them unencrypted, avoid doing so in production")
 )
 
 // GetSecretsManager function resolves secrets manager instance based on provided data or config paths.
@@ -57,3 +57,14 @@ func GetSecretsManager(dataPath, configPath string, insecureLocalStore bool) (se
 
 	return helper.SetupLocalSecretsManager(dataPath)
 }
+
+// GetEncryptedSecretsManager resolves the local secrets manager, which stores the secrets encrypted by
+// the passphrase read from the environment variable or prompted for
+func GetEncryptedSecretsManager(dataPath string) (secrets.SecretsManager, error) {
+	passphrase, err := helper.ReadPassphrase(helper.PassphraseEnvVar, "Secrets passphrase: ", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.SetupEncryptedLocalSecretsManager(dataPath, passphrase)
+}
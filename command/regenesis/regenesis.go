@@ -42,18 +42,18 @@ func RegenesisCMD() *cobra.Command {
 		"block state root of old chain",
 	)
 
-	outputter := command.InitializeOutputter(genesisCmd)
-	defer outputter.WriteOutput()
-
-	genesisCmd.PreRun = func(cmd *cobra.Command, args []string) {
+	genesisCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
 		if params.SnapshotTrieDBPath == "" || params.TrieDBPath == "" || params.TrieRoot == "" {
-			outputter.SetError(fmt.Errorf("not enough arguments"))
-
-			return
+			return fmt.Errorf("not enough arguments")
 		}
+
+		return nil
 	}
 
 	genesisCmd.Run = func(cmd *cobra.Command, args []string) {
+		outputter := command.InitializeOutputter(cmd)
+		defer outputter.WriteOutput()
+
 		trieDB, err := leveldb.OpenFile(params.TrieDBPath, &opt.Options{ReadOnly: true})
 		if err != nil {
 			outputter.SetError(fmt.Errorf("open trie trieDB error:%w", err))
@@ -92,7 +92,7 @@ func RegenesisCMD() *cobra.Command {
 			return
 		}
 
-		outputter.WriteCommandResult(&ReGenesisResult{})
+		outputter.SetCommandResult(&ReGenesisResult{})
 	}
 
 	return genesisCmd
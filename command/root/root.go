@@ -6,12 +6,17 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/0xPolygon/polygon-edge/command/apikey"
 	"github.com/0xPolygon/polygon-edge/command/backup"
+	"github.com/0xPolygon/polygon-edge/command/blockgastarget"
 	"github.com/0xPolygon/polygon-edge/command/bridge"
+	"github.com/0xPolygon/polygon-edge/command/chain"
+	"github.com/0xPolygon/polygon-edge/command/externalsigner"
 	"github.com/0xPolygon/polygon-edge/command/genesis"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/ibft"
 	"github.com/0xPolygon/polygon-edge/command/license"
+	"github.com/0xPolygon/polygon-edge/command/loglevel"
 	"github.com/0xPolygon/polygon-edge/command/monitor"
 	"github.com/0xPolygon/polygon-edge/command/peers"
 	"github.com/0xPolygon/polygon-edge/command/polybft"
@@ -21,6 +26,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/secrets"
 	"github.com/0xPolygon/polygon-edge/command/server"
 	"github.com/0xPolygon/polygon-edge/command/status"
+	"github.com/0xPolygon/polygon-edge/command/storage"
 	"github.com/0xPolygon/polygon-edge/command/txpool"
 	"github.com/0xPolygon/polygon-edge/command/version"
 )
@@ -61,6 +67,12 @@ func (rc *RootCommand) registerSubCommands() {
 		polybft.GetCommand(),
 		bridge.GetCommand(),
 		regenesis.GetCommand(),
+		blockgastarget.GetCommand(),
+		loglevel.GetCommand(),
+		apikey.GetCommand(),
+		chain.GetCommand(),
+		externalsigner.GetCommand(),
+		storage.GetCommand(),
 	)
 }
 
@@ -8,20 +8,20 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/jsonrpc"
-	"github.com/umbracle/ethgo/testutil"
 
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/rootchain/helper"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/helper/tests/rootchain"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
 func TestDeployContracts_NoPanics(t *testing.T) {
 	t.Parallel()
 
-	server := testutil.DeployTestServer(t, nil)
+	server := rootchain.NewTestRootchain(t, nil)
 	t.Cleanup(func() {
 		if err := os.RemoveAll(params.genesisPath); err != nil {
 			t.Fatal(err)
@@ -26,8 +26,8 @@ func (rp *registerParams) validateFlags() error {
 }
 
 type registerResult struct {
-	validatorAddress string
-	koskSignature    string
+	ValidatorAddress string `json:"validatorAddress"`
+	KoskSignature    string `json:"koskSignature"`
 }
 
 func (rr registerResult) GetOutput() string {
@@ -36,8 +36,8 @@ func (rr registerResult) GetOutput() string {
 	buffer.WriteString("\n[VALIDATOR REGISTRATION]\n")
 
 	vals := make([]string, 0, 2)
-	vals = append(vals, fmt.Sprintf("Validator Address|%s", rr.validatorAddress))
-	vals = append(vals, fmt.Sprintf("KOSK Signature|%s", rr.koskSignature))
+	vals = append(vals, fmt.Sprintf("Validator Address|%s", rr.ValidatorAddress))
+	vals = append(vals, fmt.Sprintf("KOSK Signature|%s", rr.KoskSignature))
 	buffer.WriteString(helper.FormatKV(vals))
 	buffer.WriteString("\n")
 
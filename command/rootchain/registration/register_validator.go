@@ -124,8 +124,8 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 			return err
 		}
 
-		result.koskSignature = hex.EncodeToString(koskSignatureRaw)
-		result.validatorAddress = validatorRegisteredEvent.Validator.String()
+		result.KoskSignature = hex.EncodeToString(koskSignatureRaw)
+		result.ValidatorAddress = validatorRegisteredEvent.Validator.String()
 
 		foundLog = true
 
@@ -144,7 +144,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	}
 
 	result := &stakeResult{
-		validatorAddress: validatorAccount.Ecdsa.Address().String(),
+		ValidatorAddress: validatorAccount.Ecdsa.Address().String(),
 	}
 
 	var (
@@ -163,8 +163,8 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 			continue
 		}
 
-		result.amount = stakeAddedEvent.Amount
-		result.validatorAddress = stakeAddedEvent.Validator.String()
+		result.Amount = stakeAddedEvent.Amount
+		result.ValidatorAddress = stakeAddedEvent.Validator.String()
 		foundLog = true
 
 		break
@@ -0,0 +1,50 @@
+package export
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+)
+
+const (
+	keystoreFlag = "keystore"
+)
+
+var (
+	errKeystorePathMissing = errors.New("the keystore file path is not set")
+)
+
+type exportParams struct {
+	accountDir    string
+	accountConfig string
+	keystorePath  string
+}
+
+func (ep *exportParams) validateFlags() error {
+	if ep.keystorePath == "" {
+		return errKeystorePathMissing
+	}
+
+	return sidechainHelper.ValidateSecretFlags(ep.accountDir, ep.accountConfig)
+}
+
+type exportResult struct {
+	Address  string `json:"address"`
+	Keystore string `json:"keystore"`
+}
+
+func (er *exportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SECRETS EXPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Address|%s", er.Address),
+		fmt.Sprintf("Keystore|%s", er.Keystore),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,110 @@
+package export
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/spf13/cobra"
+)
+
+var params exportParams
+
+func GetCommand() *cobra.Command {
+	secretsExportCmd := &cobra.Command{
+		Use: "export",
+		Short: "Exports the validator ECDSA key from the provided Secrets Manager " +
+			"to the passphrase encrypted Ethereum keystore v3 file",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(secretsExportCmd)
+
+	return secretsExportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.keystorePath,
+		keystoreFlag,
+		"",
+		fmt.Sprintf("the path of the keystore file the key is exported to, encrypted by the passphrase "+
+			"which is read from %s or prompted for", secretsHelper.KeystorePassphraseEnvVar),
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	// the existing keystore is never overwritten
+	if _, err := os.Stat(params.keystorePath); err == nil {
+		return fmt.Errorf("keystore file %s already exists", params.keystorePath)
+	}
+
+	secretsManager, err := polybftsecrets.GetSecretsManager(params.accountDir, params.accountConfig, true)
+	if err != nil {
+		return err
+	}
+
+	key, err := wallet.GetEcdsaFromSecret(secretsManager)
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := key.MarshallPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := secretsHelper.ReadPassphrase(
+		secretsHelper.KeystorePassphraseEnvVar, "Keystore passphrase: ", true)
+	if err != nil {
+		return err
+	}
+
+	address := types.Address(key.Address())
+
+	content, err := keystore.EncryptV3(privateKey, address, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := common.SaveFileSafe(params.keystorePath, content, 0600); err != nil {
+		return fmt.Errorf("unable to write the keystore file: %w", err)
+	}
+
+	outputter.SetCommandResult(&exportResult{
+		Address:  address.String(),
+		Keystore: params.keystorePath,
+	})
+
+	return nil
+}
@@ -30,8 +30,8 @@ const (
 
 var (
 	errUnsupportedType = fmt.Errorf(
-		"unsupported service manager type; only %s, %s, %s and %s are supported for now",
-		secrets.Local, secrets.HashicorpVault, secrets.AWSSSM, secrets.GCPSSM)
+		"unsupported service manager type; only %s, %s, %s, %s and %s are supported for now",
+		secrets.Local, secrets.HashicorpVault, secrets.AWSSSM, secrets.GCPSSM, secrets.PKCS11)
 )
 
 type generateParams struct {
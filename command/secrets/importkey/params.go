@@ -0,0 +1,54 @@
+package importkey
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+)
+
+const (
+	keystoreFlag           = "keystore"
+	insecureLocalStoreFlag = "insecure"
+	encryptFlag            = "encrypt"
+)
+
+var (
+	errKeystorePathMissing = errors.New("the keystore file path is not set")
+)
+
+type importParams struct {
+	accountDir         string
+	accountConfig      string
+	keystorePath       string
+	insecureLocalStore bool
+	encrypt            bool
+}
+
+func (ip *importParams) validateFlags() error {
+	if ip.keystorePath == "" {
+		return errKeystorePathMissing
+	}
+
+	return sidechainHelper.ValidateSecretFlags(ip.accountDir, ip.accountConfig)
+}
+
+type importResult struct {
+	Address      string `json:"address"`
+	BLSGenerated bool   `json:"bls_generated"`
+}
+
+func (ir *importResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SECRETS IMPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Address|%s", ir.Address),
+		fmt.Sprintf("BLS key generated|%t", ir.BLSGenerated),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
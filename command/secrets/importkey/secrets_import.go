@@ -0,0 +1,163 @@
+package importkey
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/spf13/cobra"
+	"github.com/umbracle/ethgo/wallet"
+)
+
+var params importParams
+
+func GetCommand() *cobra.Command {
+	secretsImportCmd := &cobra.Command{
+		Use: "import",
+		Short: "Imports the validator ECDSA key from the Ethereum keystore v3 file to the provided Secrets Manager. " +
+			"The BLS key is generated, unless it is present already",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(secretsImportCmd)
+
+	return secretsImportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.keystorePath,
+		keystoreFlag,
+		"",
+		fmt.Sprintf("the path of the keystore file the key is imported from, decrypted by the passphrase "+
+			"which is read from %s or prompted for", secretsHelper.KeystorePassphraseEnvVar),
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecureLocalStore,
+		insecureLocalStoreFlag,
+		false,
+		"the flag indicating whether the key is stored on the local storage unencrypted",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.encrypt,
+		encryptFlag,
+		false,
+		fmt.Sprintf("the flag indicating whether the key is stored on the local storage encrypted by "+
+			"the passphrase, which is read from %s or prompted for", secretsHelper.PassphraseEnvVar),
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, insecureLocalStoreFlag)
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	content, err := os.ReadFile(params.keystorePath)
+	if err != nil {
+		return fmt.Errorf("unable to read the keystore file: %w", err)
+	}
+
+	passphrase, err := secretsHelper.ReadPassphrase(
+		secretsHelper.KeystorePassphraseEnvVar, "Keystore passphrase: ", false)
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := keystore.DecryptV3(content, passphrase, func(privateKey []byte) (types.Address, error) {
+		key, err := wallet.NewWalletFromPrivKey(privateKey)
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+
+		return types.Address(key.Address()), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	key, err := wallet.NewWalletFromPrivKey(privateKey)
+	if err != nil {
+		return fmt.Errorf("invalid keystore private key: %w", err)
+	}
+
+	secretsManager, err := getSecretsManager()
+	if err != nil {
+		return err
+	}
+
+	if secretsManager.HasSecret(secrets.ValidatorKey) {
+		return fmt.Errorf(`secrets "%s" has been already initialized`, secrets.ValidatorKey)
+	}
+
+	if err := secretsManager.SetSecret(secrets.ValidatorKey, []byte(hex.EncodeToString(privateKey))); err != nil {
+		return err
+	}
+
+	result := &importResult{Address: types.Address(key.Address()).String()}
+
+	// the validator account needs the BLS key too
+	if !secretsManager.HasSecret(secrets.ValidatorBLSKey) {
+		if err := generateBLSKey(secretsManager); err != nil {
+			return err
+		}
+
+		result.BLSGenerated = true
+	}
+
+	outputter.SetCommandResult(result)
+
+	return nil
+}
+
+func getSecretsManager() (secrets.SecretsManager, error) {
+	if params.encrypt {
+		return polybftsecrets.GetEncryptedSecretsManager(params.accountDir)
+	}
+
+	return polybftsecrets.GetSecretsManager(params.accountDir, params.accountConfig, params.insecureLocalStore)
+}
+
+func generateBLSKey(secretsManager secrets.SecretsManager) error {
+	blsKey, err := bls.GenerateBlsKey()
+	if err != nil {
+		return err
+	}
+
+	blsRaw, err := blsKey.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return secretsManager.SetSecret(secrets.ValidatorBLSKey, blsRaw)
+}
@@ -16,6 +16,7 @@ const (
 	networkFlag            = "network"
 	numFlag                = "num"
 	insecureLocalStoreFlag = "insecure"
+	encryptFlag            = "encrypt"
 )
 
 var (
@@ -23,9 +24,9 @@ var (
 	errInvalidParams                  = errors.New("no config file or data directory passed in")
 	errUnsupportedType                = errors.New("unsupported secrets manager")
 	errSecureLocalStoreNotImplemented = errors.New(
-		"use a secrets backend, or supply an --insecure flag " +
-			"to store the private keys locally on the filesystem, " +
-			"avoid doing so in production")
+		"use a secrets backend, or supply an --encrypt flag " +
+			"to store the passphrase encrypted private keys locally on the filesystem, " +
+			"or an --insecure flag to store them unencrypted, avoid doing so in production")
 )
 
 type initParams struct {
@@ -35,6 +36,8 @@ type initParams struct {
 	generatesBLS       bool
 	generatesNetwork   bool
 	insecureLocalStore bool
+	encrypt            bool
+	passphrase         string
 
 	secretsManager secrets.SecretsManager
 	secretsConfig  *secrets.SecretsManagerConfig
@@ -95,6 +98,17 @@ func (ip *initParams) parseConfig() error {
 }
 
 func (ip *initParams) initLocalSecretsManager() error {
+	if ip.encrypt {
+		local, err := helper.SetupEncryptedLocalSecretsManager(ip.dataDir, ip.passphrase)
+		if err != nil {
+			return err
+		}
+
+		ip.secretsManager = local
+
+		return nil
+	}
+
 	if !ip.insecureLocalStore {
 		//Storing secrets on a local file system should only be allowed with --insecure flag,
 		//to raise awareness that it should be only used in development/testing environments.
@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
 )
 
 const (
@@ -91,6 +92,17 @@ func setFlags(cmd *cobra.Command) {
 		false,
 		"the flag indicating should the secrets stored on the local storage be encrypted",
 	)
+
+	cmd.Flags().BoolVar(
+		&basicParams.encrypt,
+		encryptFlag,
+		false,
+		fmt.Sprintf("the flag indicating whether the secrets stored on the local storage are encrypted by "+
+			"the passphrase, which is read from %s or prompted for", helper.PassphraseEnvVar),
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, insecureLocalStoreFlag)
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, configFlag)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
@@ -98,7 +110,21 @@ func runPreRun(_ *cobra.Command, _ []string) error {
 		return errInvalidNum
 	}
 
-	return basicParams.validateFlags()
+	if err := basicParams.validateFlags(); err != nil {
+		return err
+	}
+
+	// the passphrase is read once for all the encrypted local secrets
+	if basicParams.encrypt {
+		passphrase, err := helper.ReadPassphrase(helper.PassphraseEnvVar, "Secrets passphrase: ", true)
+		if err != nil {
+			return err
+		}
+
+		basicParams.passphrase = passphrase
+	}
+
+	return nil
 }
 
 func runCommand(cmd *cobra.Command, _ []string) {
@@ -144,6 +170,8 @@ func getParamsList() []initParams {
 			generatesBLS:       basicParams.generatesBLS,
 			generatesNetwork:   basicParams.generatesNetwork,
 			insecureLocalStore: basicParams.insecureLocalStore,
+			encrypt:            basicParams.encrypt,
+			passphrase:         basicParams.passphrase,
 		}
 	}
 
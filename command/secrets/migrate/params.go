@@ -0,0 +1,219 @@
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+)
+
+const (
+	fromFlag        = "from"
+	toFlag          = "to"
+	fromDataDirFlag = "from-data-dir"
+	fromConfigFlag  = "from-config"
+	toDataDirFlag   = "to-data-dir"
+	toConfigFlag    = "to-config"
+	insecureFlag    = "insecure"
+	encryptFlag     = "encrypt"
+)
+
+// migratedSecrets are the secrets of the node identity, which are migrated
+var migratedSecrets = []string{
+	secrets.ValidatorKey,
+	secrets.ValidatorBLSKey,
+	secrets.NetworkKey,
+}
+
+// The statuses of the migrated secrets
+const (
+	statusMigrated = "migrated"
+	statusPresent  = "already present"
+	statusMissing  = "not found"
+)
+
+var (
+	errSameSecretsManager = errors.New("source and destination secrets managers are the same")
+	errNoSecrets          = errors.New("no secrets found in the source secrets manager")
+	errLocalDestination   = errors.New(
+		"supply an --encrypt flag to store the migrated private keys encrypted by the passphrase, " +
+			"or an --insecure flag to store them unencrypted, avoid doing so in production")
+)
+
+type migrateParams struct {
+	from        string
+	to          string
+	fromDataDir string
+	fromConfig  string
+	toDataDir   string
+	toConfig    string
+	insecure    bool
+	encrypt     bool
+}
+
+func (mp *migrateParams) validateFlags() error {
+	if err := validateSide(fromFlag, mp.from, mp.fromDataDir, mp.fromConfig); err != nil {
+		return err
+	}
+
+	if err := validateSide(toFlag, mp.to, mp.toDataDir, mp.toConfig); err != nil {
+		return err
+	}
+
+	if mp.from == mp.to && mp.fromDataDir == mp.toDataDir && mp.fromConfig == mp.toConfig {
+		return errSameSecretsManager
+	}
+
+	if secrets.SecretsManagerType(mp.to) == secrets.Local {
+		if !mp.insecure && !mp.encrypt {
+			return errLocalDestination
+		}
+	} else if mp.insecure || mp.encrypt {
+		return fmt.Errorf("--%s and --%s flags are supported by the local destination only", insecureFlag, encryptFlag)
+	}
+
+	return nil
+}
+
+// validateSide validates the type and the location of the source or the destination secrets manager,
+// the local secrets manager is located by the data directory, the others by the config file
+func validateSide(side, managerType, dataDir, configPath string) error {
+	if !secrets.SupportedServiceManager(secrets.SecretsManagerType(managerType)) {
+		return fmt.Errorf("unsupported --%s secrets manager type '%s'", side, managerType)
+	}
+
+	if secrets.SecretsManagerType(managerType) == secrets.Local {
+		if dataDir == "" || configPath != "" {
+			return fmt.Errorf("the local --%s secrets manager requires --%s-data-dir only", side, side)
+		}
+
+		return nil
+	}
+
+	if configPath == "" || dataDir != "" {
+		return fmt.Errorf("the %s --%s secrets manager requires --%s-config only", managerType, side, side)
+	}
+
+	return nil
+}
+
+// initSource initializes the source secrets manager, the encrypted local secrets are decrypted
+// by the passphrase read from the environment variable or prompted for
+func (mp *migrateParams) initSource() (secrets.SecretsManager, error) {
+	if secrets.SecretsManagerType(mp.from) == secrets.Local {
+		return helper.SetupLocalSecretsManager(mp.fromDataDir)
+	}
+
+	return initConfiguredSecretsManager(mp.from, mp.fromConfig)
+}
+
+// initDestination initializes the destination secrets manager
+func (mp *migrateParams) initDestination() (secrets.SecretsManager, error) {
+	if secrets.SecretsManagerType(mp.to) == secrets.Local {
+		if mp.encrypt {
+			return polybftsecrets.GetEncryptedSecretsManager(mp.toDataDir)
+		}
+
+		return helper.SetupLocalSecretsManager(mp.toDataDir)
+	}
+
+	return initConfiguredSecretsManager(mp.to, mp.toConfig)
+}
+
+// initConfiguredSecretsManager initializes the secrets manager of the config file,
+// which must be of the given type
+func initConfiguredSecretsManager(managerType, configPath string) (secrets.SecretsManager, error) {
+	secretsConfig, err := secrets.ReadConfig(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secrets configuration %s: %w", configPath, err)
+	}
+
+	if secretsConfig.Type != secrets.SecretsManagerType(managerType) {
+		return nil, fmt.Errorf("secrets configuration %s is of type %s, not %s",
+			configPath, secretsConfig.Type, managerType)
+	}
+
+	return helper.InitCloudSecretsManager(secretsConfig)
+}
+
+// migrateSecrets copies the node identity secrets from the source to the destination secrets manager,
+// verifying each copied secret is read back unchanged. The secrets already present in the destination
+// are never overwritten, the migration fails if they differ from the source ones
+func migrateSecrets(source, destination secrets.SecretsManager) (map[string]string, error) {
+	statuses := make(map[string]string, len(migratedSecrets))
+	found := false
+
+	for _, name := range migratedSecrets {
+		value, err := readSecret(source, name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read secret %s from the source: %w", name, err)
+		}
+
+		if value == nil {
+			statuses[name] = statusMissing
+
+			continue
+		}
+
+		found = true
+
+		if destination.HasSecret(name) {
+			existing, err := destination.GetSecret(name)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read secret %s from the destination: %w", name, err)
+			}
+
+			if !bytes.Equal(existing, value) {
+				return nil, fmt.Errorf("secret %s already exists in the destination with a different value", name)
+			}
+
+			statuses[name] = statusPresent
+
+			continue
+		}
+
+		if err := destination.SetSecret(name, value); err != nil {
+			return nil, fmt.Errorf("unable to store secret %s in the destination: %w", name, err)
+		}
+
+		stored, err := destination.GetSecret(name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify secret %s in the destination: %w", name, err)
+		}
+
+		if !bytes.Equal(stored, value) {
+			return nil, fmt.Errorf("secret %s stored in the destination doesn't match the source", name)
+		}
+
+		statuses[name] = statusMigrated
+	}
+
+	if !found {
+		return nil, errNoSecrets
+	}
+
+	return statuses, nil
+}
+
+// readSecret reads the secret, returning nil if it isn't stored by the secrets manager
+func readSecret(manager secrets.SecretsManager, name string) ([]byte, error) {
+	value, err := manager.GetSecret(name)
+	if err == nil {
+		return value, nil
+	}
+
+	// the encrypted local secret which can't be decrypted is stored, but not readable
+	if errors.Is(err, local.ErrInvalidPassphrase) || errors.Is(err, local.ErrPassphraseRequired) {
+		return nil, err
+	}
+
+	if !manager.HasSecret(name) {
+		return nil, nil
+	}
+
+	return nil, err
+}
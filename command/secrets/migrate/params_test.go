@@ -0,0 +1,110 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateSecrets(t *testing.T) {
+	t.Parallel()
+
+	source, err := helper.SetupLocalSecretsManager(t.TempDir())
+	require.NoError(t, err)
+
+	address, err := helper.InitECDSAValidatorKey(source)
+	require.NoError(t, err)
+
+	_, err = helper.InitNetworkingPrivateKey(source)
+	require.NoError(t, err)
+
+	destination, err := helper.SetupLocalSecretsManager(t.TempDir())
+	require.NoError(t, err)
+
+	statuses, err := migrateSecrets(source, destination)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		secrets.ValidatorKey:    statusMigrated,
+		secrets.ValidatorBLSKey: statusMissing,
+		secrets.NetworkKey:      statusMigrated,
+	}, statuses)
+
+	migratedAddress, err := helper.LoadValidatorAddress(destination)
+	require.NoError(t, err)
+	assert.Equal(t, address, migratedAddress)
+
+	// the migration can be repeated
+	statuses, err = migrateSecrets(source, destination)
+	require.NoError(t, err)
+	assert.Equal(t, statusPresent, statuses[secrets.ValidatorKey])
+
+	// the different keys aren't overwritten
+	other, err := helper.SetupLocalSecretsManager(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = helper.InitECDSAValidatorKey(other)
+	require.NoError(t, err)
+
+	_, err = migrateSecrets(other, destination)
+	require.ErrorContains(t, err, "different value")
+
+	// the empty source isn't migrated
+	empty, err := helper.SetupLocalSecretsManager(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = migrateSecrets(empty, destination)
+	require.ErrorIs(t, err, errNoSecrets)
+}
+
+func TestMigrateParams_ValidateFlags(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		params migrateParams
+		valid  bool
+	}{
+		{
+			"Local to Vault",
+			migrateParams{from: "local", fromDataDir: "data", to: "hashicorp-vault", toConfig: "vault.json"},
+			true,
+		},
+		{
+			"Vault to the encrypted local",
+			migrateParams{from: "hashicorp-vault", fromConfig: "vault.json", to: "local", toDataDir: "data", encrypt: true},
+			true,
+		},
+		{
+			"Vault to the local without the encryption choice",
+			migrateParams{from: "hashicorp-vault", fromConfig: "vault.json", to: "local", toDataDir: "data"},
+			false,
+		},
+		{
+			"Local to the same local",
+			migrateParams{from: "local", fromDataDir: "data", to: "local", toDataDir: "data", insecure: true},
+			false,
+		},
+		{
+			"Unsupported type",
+			migrateParams{from: "local", fromDataDir: "data", to: "vault", toConfig: "vault.json"},
+			false,
+		},
+		{
+			"Vault without the config",
+			migrateParams{from: "local", fromDataDir: "data", to: "hashicorp-vault", toDataDir: "vault"},
+			false,
+		},
+		{
+			"Encryption of the Vault destination",
+			migrateParams{from: "local", fromDataDir: "data", to: "hashicorp-vault", toConfig: "vault.json", encrypt: true},
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		assert.Equal(t, testCase.valid, testCase.params.validateFlags() == nil, testCase.name)
+	}
+}
@@ -0,0 +1,47 @@
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type migrateResult struct {
+	From      string            `json:"from"`
+	To        string            `json:"to"`
+	Secrets   map[string]string `json:"secrets"`
+	Address   types.Address     `json:"address"`
+	BLSPubkey string            `json:"bls_pubkey,omitempty"`
+	NodeID    string            `json:"node_id,omitempty"`
+}
+
+func (r *migrateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("From|%s", r.From),
+		fmt.Sprintf("To|%s", r.To),
+	}
+
+	for _, name := range migratedSecrets {
+		vals = append(vals, fmt.Sprintf("%s|%s", name, r.Secrets[name]))
+	}
+
+	vals = append(vals, fmt.Sprintf("Public key (address)|%s", r.Address.String()))
+
+	if r.BLSPubkey != "" {
+		vals = append(vals, fmt.Sprintf("BLS Public key|%s", r.BLSPubkey))
+	}
+
+	if r.NodeID != "" {
+		vals = append(vals, fmt.Sprintf("Node ID|%s", r.NodeID))
+	}
+
+	buffer.WriteString("\n[SECRETS MIGRATE]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
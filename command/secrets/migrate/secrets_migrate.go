@@ -0,0 +1,135 @@
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/spf13/cobra"
+)
+
+var params migrateParams
+
+func GetCommand() *cobra.Command {
+	secretsMigrateCmd := &cobra.Command{
+		Use: "migrate",
+		Short: "Copies the validator and the network keys between the secrets managers, verifying the copies, " +
+			"so the node identity is kept without the key regeneration",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(secretsMigrateCmd)
+
+	return secretsMigrateCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.from,
+		fromFlag,
+		string(secrets.Local),
+		"the type of the secrets manager the keys are copied from",
+	)
+
+	cmd.Flags().StringVar(
+		&params.to,
+		toFlag,
+		"",
+		"the type of the secrets manager the keys are copied to",
+	)
+
+	cmd.Flags().StringVar(
+		&params.fromDataDir,
+		fromDataDirFlag,
+		"",
+		"the directory of the Polygon Edge data if the keys are copied from the local FS",
+	)
+
+	cmd.Flags().StringVar(
+		&params.fromConfig,
+		fromConfigFlag,
+		"",
+		"the path to the SecretsManager config file of the secrets manager the keys are copied from",
+	)
+
+	cmd.Flags().StringVar(
+		&params.toDataDir,
+		toDataDirFlag,
+		"",
+		"the directory of the Polygon Edge data if the keys are copied to the local FS",
+	)
+
+	cmd.Flags().StringVar(
+		&params.toConfig,
+		toConfigFlag,
+		"",
+		"the path to the SecretsManager config file of the secrets manager the keys are copied to",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecure,
+		insecureFlag,
+		false,
+		"the flag indicating that the keys copied to the local FS are stored unencrypted",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.encrypt,
+		encryptFlag,
+		false,
+		"the flag indicating that the keys copied to the local FS are stored encrypted by the passphrase",
+	)
+
+	_ = cmd.MarkFlagRequired(toFlag)
+
+	cmd.MarkFlagsMutuallyExclusive(insecureFlag, encryptFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	source, err := params.initSource()
+	if err != nil {
+		return fmt.Errorf("unable to initialize the source secrets manager: %w", err)
+	}
+
+	destination, err := params.initDestination()
+	if err != nil {
+		return fmt.Errorf("unable to initialize the destination secrets manager: %w", err)
+	}
+
+	statuses, err := migrateSecrets(source, destination)
+	if err != nil {
+		return err
+	}
+
+	result := &migrateResult{
+		From:    params.from,
+		To:      params.to,
+		Secrets: statuses,
+	}
+
+	// the identity is read back from the destination
+	if result.Address, err = helper.LoadValidatorAddress(destination); err != nil {
+		return err
+	}
+
+	if result.BLSPubkey, err = helper.LoadBLSPublicKey(destination); err != nil {
+		return err
+	}
+
+	if result.NodeID, err = helper.LoadNodeID(destination); err != nil {
+		return err
+	}
+
+	outputter.SetCommandResult(result)
+
+	return nil
+}
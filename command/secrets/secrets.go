@@ -2,8 +2,11 @@ package secrets
 
 import (
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/secrets/export"
 	"github.com/0xPolygon/polygon-edge/command/secrets/generate"
+	"github.com/0xPolygon/polygon-edge/command/secrets/importkey"
 	initCmd "github.com/0xPolygon/polygon-edge/command/secrets/init"
+	"github.com/0xPolygon/polygon-edge/command/secrets/migrate"
 	"github.com/0xPolygon/polygon-edge/command/secrets/output"
 	"github.com/spf13/cobra"
 )
@@ -29,5 +32,11 @@ func registerSubcommands(baseCmd *cobra.Command) {
 		generate.GetCommand(),
 		// secrets output public data
 		output.GetCommand(),
+		// secrets export
+		export.GetCommand(),
+		// secrets import
+		importkey.GetCommand(),
+		// secrets migrate
+		migrate.GetCommand(),
 	)
 }
@@ -6,7 +6,17 @@ import (
 	"os"
 	"strings"
 
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgealert"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/server/faucet"
+	"github.com/0xPolygon/polygon-edge/server/health"
+	"github.com/0xPolygon/polygon-edge/server/metatx"
+	"github.com/0xPolygon/polygon-edge/server/telemetry"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/hashicorp/hcl"
 	"gopkg.in/yaml.v3"
 )
@@ -18,6 +28,7 @@ type Config struct {
 	DataDir                  string     `json:"data_dir" yaml:"data_dir"`
 	BlockGasTarget           string     `json:"block_gas_target" yaml:"block_gas_target"`
 	GRPCAddr                 string     `json:"grpc_addr" yaml:"grpc_addr"`
+	GRPCAuth                 *GRPCAuth  `json:"grpc_auth" yaml:"grpc_auth"`
 	JSONRPCAddr              string     `json:"jsonrpc_addr" yaml:"jsonrpc_addr"`
 	Telemetry                *Telemetry `json:"telemetry" yaml:"telemetry"`
 	Network                  *Network   `json:"network" yaml:"network"`
@@ -29,34 +40,172 @@ type Config struct {
 	LogFilePath              string     `json:"log_to" yaml:"log_to"`
 	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
 	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+	JSONRPCCallCacheSize     uint64     `json:"json_rpc_call_cache_size" yaml:"json_rpc_call_cache_size"`
+	JSONRPCFilterTimeout     uint64     `json:"json_rpc_filter_timeout" yaml:"json_rpc_filter_timeout"`
+	JSONRPCFilterRetention   uint64     `json:"json_rpc_filter_retention" yaml:"json_rpc_filter_retention"`
+	JSONRPCLogsResultLimit   uint64     `json:"json_rpc_logs_result_limit" yaml:"json_rpc_logs_result_limit"`
+	JSONRPCAPIKeys           *APIKeys   `json:"json_rpc_api_keys" yaml:"json_rpc_api_keys"`
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
 	CorsAllowedOrigins       []string   `json:"cors_allowed_origins" yaml:"cors_allowed_origins"`
+	JSONRPCAllowedHosts      []string   `json:"json_rpc_allowed_hosts" yaml:"json_rpc_allowed_hosts"`
+	TxLookupBySender         bool       `json:"txlookup_by_sender" yaml:"txlookup_by_sender"`
+	ContractCreationLookup   bool       `json:"txlookup_contract_creations" yaml:"txlookup_contract_creations"`
+	TxHistory                uint64     `json:"history_transactions" yaml:"history_transactions"`
+	FlatState                bool       `json:"flat_state" yaml:"flat_state"`
+	MaxDirtyStateSize        uint64     `json:"max_dirty_state_size" yaml:"max_dirty_state_size"`
+	OverrideFile             string     `json:"override_file" yaml:"override_file"`
+	Health                   *Health    `json:"health" yaml:"health"`
+	MetaTx                   *MetaTx    `json:"meta_tx" yaml:"meta_tx"`
+	Faucet                   *Faucet    `json:"faucet" yaml:"faucet"`
 
 	Relayer               bool   `json:"relayer" yaml:"relayer"`
 	NumBlockConfirmations uint64 `json:"num_block_confirmations" yaml:"num_block_confirmations"`
+
+	SyncStallTimeout uint64 `json:"sync_stall_timeout" yaml:"sync_stall_timeout"`
+
+	SyncHeaderWorkers  uint64 `json:"sync_header_workers" yaml:"sync_header_workers"`
+	SyncSealWorkers    uint64 `json:"sync_seal_workers" yaml:"sync_seal_workers"`
+	SyncPipelineBuffer uint64 `json:"sync_pipeline_buffer" yaml:"sync_pipeline_buffer"`
+
+	ShutdownTimeout uint64 `json:"shutdown_timeout" yaml:"shutdown_timeout"`
+
+	RootchainJSONRPCEndpoints []string `json:"rootchain_json_rpc_endpoints" yaml:"rootchain_json_rpc_endpoints"`
+
+	Plugins []string `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+
+	ExternalSigner string `json:"external_signer,omitempty" yaml:"external_signer,omitempty"`
+
+	Web3SignerURL       string `json:"web3signer_url,omitempty" yaml:"web3signer_url,omitempty"`
+	Web3SignerPublicKey string `json:"web3signer_public_key,omitempty" yaml:"web3signer_public_key,omitempty"`
+
+	BridgeAlert *BridgeAlert `json:"bridge_alert" yaml:"bridge_alert"`
+
+	RootchainFees *RootchainFees `json:"rootchain_fees" yaml:"rootchain_fees"`
 }
 
 // Telemetry holds the config details for metric services.
 type Telemetry struct {
-	PrometheusAddr string `json:"prometheus_addr" yaml:"prometheus_addr"`
+	PrometheusAddr    string  `json:"prometheus_addr" yaml:"prometheus_addr"`
+	TracingEndpoint   string  `json:"tracing_endpoint" yaml:"tracing_endpoint"`
+	TracingSampleRate float64 `json:"tracing_sample_rate" yaml:"tracing_sample_rate"`
+	ReportEndpoint    string  `json:"report_endpoint" yaml:"report_endpoint"`
+	ReportInterval    uint64  `json:"report_interval" yaml:"report_interval"`
+}
+
+// GRPCAuth holds the config details for the authentication of the operator gRPC server
+type GRPCAuth struct {
+	TLSCert     string `json:"tls_cert" yaml:"tls_cert"`
+	TLSKey      string `json:"tls_key" yaml:"tls_key"`
+	TLSClientCA string `json:"tls_client_ca" yaml:"tls_client_ca"`
+	TokenFile   string `json:"token_file" yaml:"token_file"`
+}
+
+// Health holds the config details for the health and readiness endpoints
+type Health struct {
+	Addr            string `json:"addr" yaml:"addr"`
+	MinPeers        uint64 `json:"min_peers" yaml:"min_peers"`
+	MaxBlocksBehind uint64 `json:"max_blocks_behind" yaml:"max_blocks_behind"`
+	MaxBlockAge     uint64 `json:"max_block_age" yaml:"max_block_age"`
+}
+
+// MetaTx holds the config details for the meta-transaction relayer
+type MetaTx struct {
+	Forwarder      string   `json:"forwarder" yaml:"forwarder"`
+	SponsorKey     string   `json:"sponsor_key" yaml:"sponsor_key"`
+	AllowedSenders []string `json:"allowed_senders,omitempty" yaml:"allowed_senders,omitempty"`
+	AllowedTargets []string `json:"allowed_targets,omitempty" yaml:"allowed_targets,omitempty"`
+	MaxGas         uint64   `json:"max_gas" yaml:"max_gas"`
+	GasPrice       uint64   `json:"gas_price" yaml:"gas_price"`
+}
+
+// Faucet holds the config details for the faucet of the test networks
+type Faucet struct {
+	Addr             string   `json:"addr" yaml:"addr"`
+	Key              string   `json:"key" yaml:"key"`
+	Amount           string   `json:"amount" yaml:"amount"`
+	Cooldown         uint64   `json:"cooldown" yaml:"cooldown"`
+	APIKeys          []string `json:"api_keys,omitempty" yaml:"api_keys,omitempty"`
+	CaptchaSecret    string   `json:"captcha_secret" yaml:"captcha_secret"`
+	CaptchaVerifyURL string   `json:"captcha_verify_url" yaml:"captcha_verify_url"`
+	GasPrice         uint64   `json:"gas_price" yaml:"gas_price"`
+}
+
+// APIKeys holds the API keys authorizing the JSON-RPC requests
+type APIKeys struct {
+	Required bool              `json:"required" yaml:"required"`
+	Keys     []*jsonrpc.APIKey `json:"keys,omitempty" yaml:"keys,omitempty"`
+}
+
+// BridgeAlert holds the config details for the alerts of the stuck or anomalous bridge messages
+type BridgeAlert struct {
+	WebhookURL              string `json:"webhook_url" yaml:"webhook_url"`
+	PendingThreshold        uint64 `json:"pending_threshold" yaml:"pending_threshold"`
+	SignatureStallThreshold uint64 `json:"signature_stall_threshold" yaml:"signature_stall_threshold"`
+	MaxDemotions            uint64 `json:"max_demotions" yaml:"max_demotions"`
+	CheckInterval           uint64 `json:"check_interval" yaml:"check_interval"`
+}
+
+// RootchainFees holds the config details for the fee management of the transactions sent to the rootchain
+type RootchainFees struct {
+	BumpBlocks   uint64 `json:"bump_blocks" yaml:"bump_blocks"`
+	BumpPercent  uint64 `json:"bump_percent" yaml:"bump_percent"`
+	MaxFeePerGas uint64 `json:"max_fee_per_gas" yaml:"max_fee_per_gas"`
 }
 
 // Network defines the network configuration params
 type Network struct {
 	NoDiscover       bool   `json:"no_discover" yaml:"no_discover"`
+	NoNATPortMap     bool   `json:"no_nat_port_map" yaml:"no_nat_port_map"`
+	NoObservedAddrs  bool   `json:"no_observed_addrs" yaml:"no_observed_addrs"`
+	QUIC             bool   `json:"quic" yaml:"quic"`
 	Libp2pAddr       string `json:"libp2p_addr" yaml:"libp2p_addr"`
 	NatAddr          string `json:"nat_addr" yaml:"nat_addr"`
 	DNSAddr          string `json:"dns_addr" yaml:"dns_addr"`
 	MaxPeers         int64  `json:"max_peers,omitempty" yaml:"max_peers,omitempty"`
 	MaxOutboundPeers int64  `json:"max_outbound_peers,omitempty" yaml:"max_outbound_peers,omitempty"`
 	MaxInboundPeers  int64  `json:"max_inbound_peers,omitempty" yaml:"max_inbound_peers,omitempty"`
+
+	GossipCompression bool `json:"gossip_compression" yaml:"gossip_compression"`
+
+	AllowList []string `json:"allow_list,omitempty" yaml:"allow_list,omitempty"`
+
+	PeerAllowlist []string `json:"peer_allowlist,omitempty" yaml:"peer_allowlist,omitempty"`
+	PeerDenylist  []string `json:"peer_denylist,omitempty" yaml:"peer_denylist,omitempty"`
+
+	KeepAliveInterval uint64 `json:"keep_alive_interval" yaml:"keep_alive_interval"`
+	IdleTimeout       uint64 `json:"idle_timeout" yaml:"idle_timeout"`
+	PingInterval      uint64 `json:"ping_interval" yaml:"ping_interval"`
+	PingTimeout       uint64 `json:"ping_timeout" yaml:"ping_timeout"`
 }
 
 // TxPool defines the TxPool configuration params
 type TxPool struct {
-	PriceLimit         uint64 `json:"price_limit" yaml:"price_limit"`
+	PriceLimit         uint64   `json:"price_limit" yaml:"price_limit"`
+	MaxSlots           uint64   `json:"max_slots" yaml:"max_slots"`
+	MaxAccountEnqueued uint64   `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	DeniedSenders      []string `json:"denied_senders,omitempty" yaml:"denied_senders,omitempty"`
+	DeniedRecipients   []string `json:"denied_recipients,omitempty" yaml:"denied_recipients,omitempty"`
+	DeniedSelectors    []string `json:"denied_selectors,omitempty" yaml:"denied_selectors,omitempty"`
+
+	AdmissionRateLimit      uint64  `json:"admission_rate_limit" yaml:"admission_rate_limit"`
+	AdmissionMinProbability float64 `json:"admission_min_probability" yaml:"admission_min_probability"`
+
+	AutoTune *TxPoolAutoTune `json:"auto_tune,omitempty" yaml:"auto_tune,omitempty"`
+
+	PriorityRecipients []string `json:"priority_recipients,omitempty" yaml:"priority_recipients,omitempty"`
+	PrioritySlots      uint64   `json:"priority_slots" yaml:"priority_slots"`
+
+	FutureTxLifetime uint64 `json:"future_tx_lifetime" yaml:"future_tx_lifetime"`
+}
+
+// TxPoolAutoTune holds the bounds within which the txpool limits are auto-tuned
+type TxPoolAutoTune struct {
+	MinSlots           uint64 `json:"min_slots" yaml:"min_slots"`
 	MaxSlots           uint64 `json:"max_slots" yaml:"max_slots"`
+	MinAccountEnqueued uint64 `json:"min_account_enqueued" yaml:"min_account_enqueued"`
 	MaxAccountEnqueued uint64 `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	MinPriceLimit      uint64 `json:"min_price_limit" yaml:"min_price_limit"`
+	MaxPriceLimit      uint64 `json:"max_price_limit" yaml:"max_price_limit"`
 }
 
 // Headers defines the HTTP response headers required to enable CORS.
@@ -76,9 +225,28 @@ const (
 	// requests with fromBlock/toBlock values (e.g. eth_getLogs)
 	DefaultJSONRPCBlockRangeLimit uint64 = 1000
 
+	// DefaultJSONRPCCallCacheSize is the number of eth_call and eth_estimateGas responses
+	// cached since the latest block
+	DefaultJSONRPCCallCacheSize uint64 = 1024
+
+	// DefaultJSONRPCFilterTimeout is the number of seconds the polling filter is kept without being polled
+	DefaultJSONRPCFilterTimeout uint64 = 60
+
+	// DefaultJSONRPCLogsResultLimit maximum number of logs returned by a single eth_getLogs request
+	DefaultJSONRPCLogsResultLimit uint64 = 10000
+
+	// MiB is the unit of the max dirty state size
+	MiB uint64 = 1024 * 1024
+
 	// DefaultNumBlockConfirmations minimal number of child blocks required for the parent block to be considered final
 	// on ethereum epoch lasts for 32 blocks. more details: https://www.alchemy.com/overviews/ethereum-commitment-levels
 	DefaultNumBlockConfirmations uint64 = 64
+
+	// DefaultTracingSampleRate is the fraction of the traces exported to the tracing endpoint
+	DefaultTracingSampleRate float64 = 1
+
+	// DefaultShutdownTimeout is the number of seconds the shutdown waits for the in-flight block sealing
+	DefaultShutdownTimeout uint64 = 30
 )
 
 // DefaultConfig returns the default server configuration
@@ -98,24 +266,70 @@ func DefaultConfig() *Config {
 				defaultNetworkConfig.Addr.IP,
 				defaultNetworkConfig.Addr.Port,
 			),
+			KeepAliveInterval: uint64(defaultNetworkConfig.KeepAliveInterval.Seconds()),
+			IdleTimeout:       uint64(defaultNetworkConfig.IdleTimeout.Seconds()),
+			PingInterval:      uint64(defaultNetworkConfig.PingInterval.Seconds()),
+			PingTimeout:       uint64(defaultNetworkConfig.PingTimeout.Seconds()),
+		},
+		Telemetry: &Telemetry{
+			TracingSampleRate: DefaultTracingSampleRate,
+			ReportInterval:    uint64(telemetry.DefaultReportInterval.Seconds()),
+		},
+		GRPCAuth: &GRPCAuth{},
+		Health: &Health{
+			MinPeers:        health.DefaultMinPeers,
+			MaxBlocksBehind: health.DefaultMaxBlocksBehind,
+			MaxBlockAge:     uint64(health.DefaultMaxBlockAge.Seconds()),
+		},
+		MetaTx: &MetaTx{
+			MaxGas: metatx.DefaultMaxGas,
+		},
+		Faucet: &Faucet{
+			Amount:           faucet.DefaultAmount.String(),
+			Cooldown:         uint64(faucet.DefaultCooldown.Seconds()),
+			CaptchaVerifyURL: faucet.DefaultCaptchaVerifyURL,
+		},
+		JSONRPCAPIKeys: &APIKeys{},
+		BridgeAlert: &BridgeAlert{
+			CheckInterval: uint64(bridgealert.DefaultCheckInterval.Seconds()),
+		},
+		RootchainFees: &RootchainFees{
+			BumpPercent: txrelayer.DefaultBumpPercent,
 		},
-		Telemetry:  &Telemetry{},
 		ShouldSeal: true,
 		TxPool: &TxPool{
 			PriceLimit:         0,
 			MaxSlots:           4096,
 			MaxAccountEnqueued: 128,
+
+			AdmissionMinProbability: txpool.DefaultAdmissionMinProbability,
+
+			// the staking and the bridge state receiver system contracts
+			PriorityRecipients: []string{
+				contracts.ValidatorSetContract.String(),
+				contracts.StateReceiverContract.String(),
+			},
+			PrioritySlots: 512,
+
+			FutureTxLifetime: 3 * 60 * 60, // 3 hours
 		},
 		LogLevel:    "INFO",
 		RestoreFile: "",
 		Headers: &Headers{
 			AccessControlAllowOrigins: []string{"*"},
 		},
+		// the requests to the IP addresses are always allowed
+		JSONRPCAllowedHosts:      []string{"localhost"},
 		LogFilePath:              "",
 		JSONRPCBatchRequestLimit: DefaultJSONRPCBatchRequestLimit,
+		MaxDirtyStateSize:        state.DefaultDirtyStateLimit / MiB,
 		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
+		JSONRPCCallCacheSize:     DefaultJSONRPCCallCacheSize,
+		JSONRPCFilterTimeout:     DefaultJSONRPCFilterTimeout,
+		JSONRPCLogsResultLimit:   DefaultJSONRPCLogsResultLimit,
 		Relayer:                  false,
 		NumBlockConfirmations:    DefaultNumBlockConfirmations,
+		ShutdownTimeout:          DefaultShutdownTimeout,
 	}
 }
 
@@ -143,10 +357,15 @@ func ReadConfigFile(path string) (*Config, error) {
 	}
 
 	config := DefaultConfig()
+	defaultNetwork := config.Network
 	config.Network = new(Network)
 	config.Network.MaxPeers = -1
 	config.Network.MaxInboundPeers = -1
 	config.Network.MaxOutboundPeers = -1
+	config.Network.KeepAliveInterval = defaultNetwork.KeepAliveInterval
+	config.Network.IdleTimeout = defaultNetwork.IdleTimeout
+	config.Network.PingInterval = defaultNetwork.PingInterval
+	config.Network.PingTimeout = defaultNetwork.PingTimeout
 
 	if err := unmarshalFunc(data, config); err != nil {
 		return nil, err
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+)
+
+const (
+	devChainName = "polygon-edge-dev"
+
+	// devAccountsSeed is the seed the keys of the dev accounts are derived from,
+	// so the same accounts are funded on every start
+	devAccountsSeed = "polygon-edge dev account"
+
+	defaultDevAccounts = 10
+)
+
+var defaultDevBalance = ethgo.Ether(1000)
+
+// devAccount is an account funded in the genesis of the dev mode
+type devAccount struct {
+	address types.Address
+	key     *ecdsa.PrivateKey
+}
+
+// generateDevAccounts derives the keys of the given number of dev accounts from the dev accounts seed
+func generateDevAccounts(count uint64) ([]*devAccount, error) {
+	accounts := make([]*devAccount, count)
+
+	for i := uint64(0); i < count; i++ {
+		index := make([]byte, 8)
+		binary.BigEndian.PutUint64(index, i)
+
+		key, err := crypto.ParseECDSAPrivateKey(crypto.Keccak256([]byte(devAccountsSeed), index))
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive dev account %d: %w", i, err)
+		}
+
+		accounts[i] = &devAccount{
+			address: crypto.PubKeyToAddress(&key.PublicKey),
+			key:     key,
+		}
+	}
+
+	return accounts, nil
+}
+
+// newDevChain returns the chain of a single node dev network,
+// used if the dev mode is started without the genesis file
+func newDevChain() *chain.Chain {
+	return &chain.Chain{
+		Name: devChainName,
+		Genesis: &chain.Genesis{
+			GasLimit:   command.DefaultGenesisGasLimit,
+			Difficulty: 1,
+			Alloc:      map[types.Address]*chain.GenesisAccount{},
+			GasUsed:    command.DefaultGenesisGasUsed,
+			BaseFee:    command.DefaultGenesisBaseFee,
+			BaseFeeEM:  command.DefaultGenesisBaseFeeEM,
+		},
+		Params: &chain.Params{
+			ChainID: command.DefaultChainID,
+			Forks:   chain.AllForksEnabled,
+			Engine: map[string]interface{}{
+				string(server.DevConsensus): map[string]interface{}{},
+			},
+			// the base fee is burnt
+			BurnContract: map[uint64]types.Address{0: types.ZeroAddress},
+		},
+		Bootnodes: []string{},
+	}
+}
+
+// isGenesisFileMissing returns true if the genesis file does not exist
+func (p *serverParams) isGenesisFileMissing() bool {
+	_, err := os.Stat(p.rawConfig.GenesisPath)
+
+	return os.IsNotExist(err)
+}
+
+// initDevDataDir creates a temporary data directory if the dev mode is started without one
+func (p *serverParams) initDevDataDir() error {
+	dataDir, err := os.MkdirTemp("", devChainName)
+	if err != nil {
+		return fmt.Errorf("failed to create the dev data directory: %w", err)
+	}
+
+	p.rawConfig.DataDir = dataDir
+
+	return nil
+}
+
+// initDevAccounts generates the dev accounts and funds them in the genesis
+func (p *serverParams) initDevAccounts() error {
+	balance, err := helper.ParseAmount(p.devBalanceRaw)
+	if err != nil {
+		return fmt.Errorf("invalid dev balance: %w", err)
+	}
+
+	if p.devAccounts, err = generateDevAccounts(p.devAccountsCount); err != nil {
+		return err
+	}
+
+	p.devBalance = balance
+
+	alloc := p.genesisConfig.Genesis.Alloc
+	if alloc == nil {
+		alloc = make(map[types.Address]*chain.GenesisAccount, len(p.devAccounts))
+		p.genesisConfig.Genesis.Alloc = alloc
+	}
+
+	for _, account := range p.devAccounts {
+		if _, ok := alloc[account.address]; ok {
+			// the premine of the genesis file takes precedence
+			continue
+		}
+
+		alloc[account.address] = &chain.GenesisAccount{
+			Balance: new(big.Int).Set(balance),
+		}
+	}
+
+	return nil
+}
+
+// devAccountKeys returns the keys of the dev accounts, which are unlocked for the json-rpc signing endpoints
+func (p *serverParams) devAccountKeys() []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, len(p.devAccounts))
+
+	for i, account := range p.devAccounts {
+		keys[i] = account.key
+	}
+
+	return keys
+}
+
+// printDevAccounts writes the funded dev accounts and their private keys
+func printDevAccounts(w io.Writer, accounts []*devAccount, balance *big.Int) error {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DEV ACCOUNTS]\n")
+	buffer.WriteString(fmt.Sprintf("Each account is funded with %s wei. ", balance))
+	buffer.WriteString("The keys are public, never use them outside of the local testing!\n\n")
+
+	rows := make([]string, len(accounts)+1)
+	rows[0] = "# | Address | Private Key"
+
+	for i, account := range accounts {
+		key, err := crypto.MarshalECDSAPrivateKey(account.key)
+		if err != nil {
+			return err
+		}
+
+		rows[i+1] = fmt.Sprintf("%d|%s|%s", i, account.address, hex.EncodeToHex(key))
+	}
+
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n\n")
+
+	_, err := w.Write(buffer.Bytes())
+
+	return err
+}
@@ -1,10 +1,14 @@
 package server
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"net"
+	"os"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 
@@ -12,14 +16,23 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/server/faucet"
+	"github.com/0xPolygon/polygon-edge/server/metatx"
+	"github.com/0xPolygon/polygon-edge/server/telemetry"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
 var (
 	errDataDirectoryUndefined = errors.New("data directory not defined")
+	errInvalidPingTimeout     = errors.New("ping timeout must be greater than 0 when peer pings are enabled")
+
+	errInvalidAdmissionMinProbability = errors.New("admission min probability must be greater than 0 and at most 1")
 )
 
 func (p *serverParams) initConfigFromFile() error {
@@ -50,10 +63,80 @@ func (p *serverParams) initRawParams() error {
 	}
 
 	if p.isDevMode {
-		p.initDevMode()
+		if err := p.initDevMode(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.initTxPoolDenyList(); err != nil {
+		return err
+	}
+
+	if err := p.initTxPoolPriorityLane(); err != nil {
+		return err
+	}
+
+	if err := p.initMetaTxConfig(); err != nil {
+		return err
+	}
+
+	if err := p.initFaucetConfig(); err != nil {
+		return err
+	}
+
+	if err := p.grpcAuthConfig().Validate(); err != nil {
+		return err
 	}
 
 	p.initPeerLimits()
+
+	if p.rawConfig.Network.PingInterval > 0 && p.rawConfig.Network.PingTimeout == 0 {
+		return errInvalidPingTimeout
+	}
+
+	if rate := p.rawConfig.Telemetry.TracingSampleRate; rate < 0 || rate > 1 {
+		return tracing.ErrInvalidSampleRate
+	}
+
+	if err := (&telemetry.Config{
+		Endpoint: p.rawConfig.Telemetry.ReportEndpoint,
+		Interval: time.Duration(p.rawConfig.Telemetry.ReportInterval) * time.Second,
+	}).Validate(); err != nil {
+		return err
+	}
+
+	if err := p.bridgeAlertConfig().Validate(); err != nil {
+		return err
+	}
+
+	if p.rawConfig.TxPool.AdmissionRateLimit > 0 {
+		if prob := p.rawConfig.TxPool.AdmissionMinProbability; prob <= 0 || prob > 1 {
+			return errInvalidAdmissionMinProbability
+		}
+	}
+
+	if autoTune := p.txPoolAutoTuneConfig(); autoTune != nil {
+		if err := autoTune.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.signerConfig().Validate(); err != nil {
+		return err
+	}
+
+	if feeBump := p.rootchainFeeBumpConfig(); feeBump != nil {
+		if err := feeBump.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, endpoint := range p.rawConfig.RootchainJSONRPCEndpoints {
+		if _, err := helper.ParseJSONRPCAddress(endpoint); err != nil {
+			return fmt.Errorf("invalid rootchain JSON-RPC endpoint %s: %w", endpoint, err)
+		}
+	}
+
 	p.initLogFileLocation()
 
 	p.relayer = p.rawConfig.Relayer
@@ -63,6 +146,10 @@ func (p *serverParams) initRawParams() error {
 
 func (p *serverParams) initDataDirLocation() error {
 	if p.rawConfig.DataDir == "" {
+		if p.isDevMode {
+			return p.initDevDataDir()
+		}
+
 		return errDataDirectoryUndefined
 	}
 
@@ -87,6 +174,156 @@ func (p *serverParams) initBlockGasTarget() error {
 	return nil
 }
 
+func (p *serverParams) initTxPoolDenyList() error {
+	denyList := &txpool.DenyList{
+		Senders:    make([]types.Address, len(p.rawConfig.TxPool.DeniedSenders)),
+		Recipients: make([]types.Address, len(p.rawConfig.TxPool.DeniedRecipients)),
+		Selectors:  make([]txpool.Selector, len(p.rawConfig.TxPool.DeniedSelectors)),
+	}
+
+	for i, sender := range p.rawConfig.TxPool.DeniedSenders {
+		if err := denyList.Senders[i].UnmarshalText([]byte(sender)); err != nil {
+			return fmt.Errorf("invalid denied sender %s: %w", sender, err)
+		}
+	}
+
+	for i, recipient := range p.rawConfig.TxPool.DeniedRecipients {
+		if err := denyList.Recipients[i].UnmarshalText([]byte(recipient)); err != nil {
+			return fmt.Errorf("invalid denied recipient %s: %w", recipient, err)
+		}
+	}
+
+	for i, rawSelector := range p.rawConfig.TxPool.DeniedSelectors {
+		selector, err := txpool.ParseSelector(rawSelector)
+		if err != nil {
+			return err
+		}
+
+		denyList.Selectors[i] = selector
+	}
+
+	p.txPoolDenyList = denyList
+
+	return nil
+}
+
+func (p *serverParams) initTxPoolPriorityLane() error {
+	recipients := p.rawConfig.TxPool.PriorityRecipients
+	if len(recipients) == 0 || p.rawConfig.TxPool.PrioritySlots == 0 {
+		return nil
+	}
+
+	priorityLane := &txpool.PriorityLaneConfig{
+		Recipients: make([]types.Address, len(recipients)),
+		MaxSlots:   p.rawConfig.TxPool.PrioritySlots,
+	}
+
+	for i, recipient := range recipients {
+		if err := priorityLane.Recipients[i].UnmarshalText([]byte(recipient)); err != nil {
+			return fmt.Errorf("invalid priority recipient %s: %w", recipient, err)
+		}
+	}
+
+	p.txPoolPriorityLane = priorityLane
+
+	return nil
+}
+
+func (p *serverParams) initMetaTxConfig() error {
+	raw := p.rawConfig.MetaTx
+	if raw == nil || raw.Forwarder == "" {
+		return nil
+	}
+
+	metaTxConfig := &metatx.Config{
+		AllowedSenders: make([]types.Address, len(raw.AllowedSenders)),
+		AllowedTargets: make([]types.Address, len(raw.AllowedTargets)),
+		MaxGas:         raw.MaxGas,
+		GasPrice:       new(big.Int).SetUint64(raw.GasPrice),
+	}
+
+	if err := metaTxConfig.Forwarder.UnmarshalText([]byte(raw.Forwarder)); err != nil {
+		return fmt.Errorf("invalid meta-transaction forwarder %s: %w", raw.Forwarder, err)
+	}
+
+	for i, sender := range raw.AllowedSenders {
+		if err := metaTxConfig.AllowedSenders[i].UnmarshalText([]byte(sender)); err != nil {
+			return fmt.Errorf("invalid meta-transaction allowed sender %s: %w", sender, err)
+		}
+	}
+
+	for i, target := range raw.AllowedTargets {
+		if err := metaTxConfig.AllowedTargets[i].UnmarshalText([]byte(target)); err != nil {
+			return fmt.Errorf("invalid meta-transaction allowed target %s: %w", target, err)
+		}
+	}
+
+	if raw.SponsorKey != "" {
+		encodedKey, err := os.ReadFile(raw.SponsorKey)
+		if err != nil {
+			return fmt.Errorf("failed to read meta-transaction sponsor key: %w", err)
+		}
+
+		if metaTxConfig.SponsorKey, err = crypto.BytesToECDSAPrivateKey(
+			bytes.TrimSpace(encodedKey),
+		); err != nil {
+			return fmt.Errorf("invalid meta-transaction sponsor key: %w", err)
+		}
+	}
+
+	if err := metaTxConfig.Validate(); err != nil {
+		return err
+	}
+
+	p.metaTxConfig = metaTxConfig
+
+	return nil
+}
+
+func (p *serverParams) initFaucetConfig() error {
+	raw := p.rawConfig.Faucet
+	if raw == nil || raw.Addr == "" {
+		return nil
+	}
+
+	faucetConfig := &faucet.Config{
+		Cooldown:         time.Duration(raw.Cooldown) * time.Second,
+		APIKeys:          raw.APIKeys,
+		CaptchaSecret:    raw.CaptchaSecret,
+		CaptchaVerifyURL: raw.CaptchaVerifyURL,
+		GasPrice:         new(big.Int).SetUint64(raw.GasPrice),
+	}
+
+	var err error
+
+	if faucetConfig.Addr, err = helper.ResolveAddr(raw.Addr, helper.AllInterfacesBinding); err != nil {
+		return fmt.Errorf("invalid faucet address %s: %w", raw.Addr, err)
+	}
+
+	if faucetConfig.Amount, err = types.ParseUint256orHex(&raw.Amount); err != nil {
+		return fmt.Errorf("invalid faucet amount %s: %w", raw.Amount, err)
+	}
+
+	if raw.Key != "" {
+		encodedKey, err := os.ReadFile(raw.Key)
+		if err != nil {
+			return fmt.Errorf("failed to read faucet key: %w", err)
+		}
+
+		if faucetConfig.Key, err = crypto.BytesToECDSAPrivateKey(bytes.TrimSpace(encodedKey)); err != nil {
+			return fmt.Errorf("invalid faucet key: %w", err)
+		}
+	}
+
+	if err := faucetConfig.Validate(); err != nil {
+		return err
+	}
+
+	p.faucetConfig = faucetConfig
+
+	return nil
+}
+
 func (p *serverParams) initSecretsConfig() error {
 	if !p.isSecretsConfigPathSet() {
 		return nil
@@ -106,7 +343,10 @@ func (p *serverParams) initSecretsConfig() error {
 func (p *serverParams) initGenesisConfig() error {
 	var parseErr error
 
-	if p.genesisConfig, parseErr = chain.Import(
+	if p.isDevMode && p.isGenesisFileMissing() {
+		// the dev mode doesn't require the genesis file
+		p.genesisConfig = newDevChain()
+	} else if p.genesisConfig, parseErr = chain.Import(
 		p.rawConfig.GenesisPath,
 	); parseErr != nil {
 		return parseErr
@@ -120,14 +360,20 @@ func (p *serverParams) initGenesisConfig() error {
 	return nil
 }
 
-func (p *serverParams) initDevMode() {
+func (p *serverParams) initDevMode() error {
 	// Dev mode:
-	// - disables peer discovery
+	// - disables peer discovery and the peer connections
 	// - enables all forks
+	// - funds the dev accounts
 	p.rawConfig.Network.NoDiscover = true
+	p.rawConfig.Network.MaxPeers = 0
+	p.rawConfig.Network.MaxInboundPeers = 0
+	p.rawConfig.Network.MaxOutboundPeers = 0
 	p.genesisConfig.Params.Forks = chain.AllForksEnabled
 
 	p.initDevConsensusConfig()
+
+	return p.initDevAccounts()
 }
 
 func (p *serverParams) initDevConsensusConfig() {
@@ -204,6 +450,10 @@ func (p *serverParams) initAddresses() error {
 		return err
 	}
 
+	if err := p.initHealthAddress(); err != nil {
+		return err
+	}
+
 	if err := p.initLibp2pAddress(); err != nil {
 		return err
 	}
@@ -216,6 +466,14 @@ func (p *serverParams) initAddresses() error {
 		return err
 	}
 
+	if err := p.initAllowList(); err != nil {
+		return err
+	}
+
+	if err := p.initPeerFilter(); err != nil {
+		return err
+	}
+
 	if err := p.initJSONRPCAddress(); err != nil {
 		return err
 	}
@@ -240,6 +498,23 @@ func (p *serverParams) initPrometheusAddress() error {
 	return nil
 }
 
+func (p *serverParams) initHealthAddress() error {
+	if !p.isHealthAddressSet() {
+		return nil
+	}
+
+	var parseErr error
+
+	if p.healthAddress, parseErr = helper.ResolveAddr(
+		p.rawConfig.Health.Addr,
+		helper.AllInterfacesBinding,
+	); parseErr != nil {
+		return parseErr
+	}
+
+	return nil
+}
+
 func (p *serverParams) initLibp2pAddress() error {
 	var parseErr error
 
@@ -283,6 +558,33 @@ func (p *serverParams) initDNSAddress() error {
 	return nil
 }
 
+func (p *serverParams) initAllowList() error {
+	if len(p.rawConfig.Network.AllowList) == 0 {
+		return nil
+	}
+
+	var parseErr error
+
+	if p.allowList, parseErr = network.ParseAllowList(p.rawConfig.Network.AllowList); parseErr != nil {
+		return parseErr
+	}
+
+	return nil
+}
+
+func (p *serverParams) initPeerFilter() error {
+	var parseErr error
+
+	if p.peerFilter, parseErr = network.ParsePeerFilter(
+		p.rawConfig.Network.PeerAllowlist,
+		p.rawConfig.Network.PeerDenylist,
+	); parseErr != nil {
+		return parseErr
+	}
+
+	return nil
+}
+
 func (p *serverParams) initJSONRPCAddress() error {
 	var parseErr error
 
@@ -2,13 +2,25 @@ package server
 
 import (
 	"errors"
+	"math/big"
 	"net"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgealert"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/server/faucet"
+	"github.com/0xPolygon/polygon-edge/server/grpcauth"
+	"github.com/0xPolygon/polygon-edge/server/health"
+	"github.com/0xPolygon/polygon-edge/server/metatx"
+	"github.com/0xPolygon/polygon-edge/server/telemetry"
+	"github.com/0xPolygon/polygon-edge/syncer"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/hashicorp/go-hclog"
 	"github.com/multiformats/go-multiaddr"
 )
@@ -19,27 +31,103 @@ const (
 	dataDirFlag                  = "data-dir"
 	libp2pAddressFlag            = "libp2p"
 	prometheusAddressFlag        = "prometheus"
+	tracingEndpointFlag          = "tracing-endpoint"
+	tracingSampleRateFlag        = "tracing-sample-rate"
+	telemetryEndpointFlag        = "telemetry-endpoint"
+	telemetryIntervalFlag        = "telemetry-interval"
+	grpcTLSCertFlag              = "grpc-tls-cert"
+	grpcTLSKeyFlag               = "grpc-tls-key"
+	grpcTLSClientCAFlag          = "grpc-tls-client-ca"
+	grpcTokenFileFlag            = "grpc-token-file" //nolint:gosec
+	healthAddressFlag            = "health"
+	healthMinPeersFlag           = "health-min-peers"
+	healthMaxBlocksBehindFlag    = "health-max-blocks-behind"
+	healthMaxBlockAgeFlag        = "health-max-block-age"
 	natFlag                      = "nat"
+	noNATPortMapFlag             = "no-nat-port-map"
+	noObservedAddrsFlag          = "no-observed-addrs"
+	quicFlag                     = "network.quic"
+	gossipCompressionFlag        = "network.gossip-compression"
 	dnsFlag                      = "dns"
+	allowListFlag                = "allow-list"
+	peerAllowlistFlag            = "network.allowlist"
+	peerDenylistFlag             = "network.denylist"
 	sealFlag                     = "seal"
 	maxPeersFlag                 = "max-peers"
 	maxInboundPeersFlag          = "max-inbound-peers"
 	maxOutboundPeersFlag         = "max-outbound-peers"
+	keepAliveIntervalFlag        = "keep-alive-interval"
+	idleTimeoutFlag              = "idle-timeout"
+	pingIntervalFlag             = "ping-interval"
+	pingTimeoutFlag              = "ping-timeout"
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCCallCacheSizeFlag     = "json-rpc-call-cache-size"
+	jsonRPCFilterTimeoutFlag     = "json-rpc-filter-timeout"
+	jsonRPCFilterRetentionFlag   = "json-rpc-filter-retention"
+	jsonRPCLogsResultLimitFlag   = "json-rpc-logs-result-limit"
+	jsonRPCAPIKeysRequiredFlag   = "json-rpc-api-keys-required"
+	jsonRPCAllowedHostsFlag      = "json-rpc-allowed-hosts"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
+	admissionRateLimitFlag       = "admission-rate-limit"
+	admissionMinProbabilityFlag  = "admission-min-probability"
+	priorityRecipientsFlag       = "priority-recipients"
+	prioritySlotsFlag            = "priority-slots"
+	futureTxLifetimeFlag         = "future-tx-lifetime"
 	blockGasTargetFlag           = "block-gas-target"
 	secretsConfigFlag            = "secrets-config"
 	restoreFlag                  = "restore"
 	devIntervalFlag              = "dev-interval"
 	devFlag                      = "dev"
+	devAccountsFlag              = "dev-accounts"
+	devBalanceFlag               = "dev-balance"
 	corsOriginFlag               = "access-control-allow-origins"
 	logFileLocationFlag          = "log-to"
+	txLookupBySenderFlag         = "txlookup.by-sender"
+	contractCreationLookupFlag   = "txlookup.contract-creations"
+	txHistoryFlag                = "history.transactions"
+	flatStateFlag                = "flat-state"
+	maxDirtyStateSizeFlag        = "max-dirty-state-size"
+	overrideFileFlag             = "override-file"
+	metaTxForwarderFlag          = "meta-tx-forwarder"
+	metaTxSponsorKeyFlag         = "meta-tx-sponsor-key"
+	metaTxAllowedSendersFlag     = "meta-tx-allowed-senders"
+	metaTxAllowedTargetsFlag     = "meta-tx-allowed-targets"
+	metaTxMaxGasFlag             = "meta-tx-max-gas"
+	metaTxGasPriceFlag           = "meta-tx-gas-price"
+	faucetAddressFlag            = "faucet"
+	faucetKeyFlag                = "faucet-key"
+	faucetAmountFlag             = "faucet-amount"
+	faucetCooldownFlag           = "faucet-cooldown"
+	faucetAPIKeysFlag            = "faucet-api-keys"
+	faucetCaptchaSecretFlag      = "faucet-captcha-secret" //nolint:gosec
+	faucetCaptchaVerifyURLFlag   = "faucet-captcha-verify-url"
+	faucetGasPriceFlag           = "faucet-gas-price"
+
+	bridgeAlertWebhookFlag                 = "bridge-alert-webhook"
+	bridgeAlertPendingThresholdFlag        = "bridge-alert-pending-threshold"
+	bridgeAlertSignatureStallThresholdFlag = "bridge-alert-signature-stall-threshold"
+	bridgeAlertMaxDemotionsFlag            = "bridge-alert-max-demotions"
+	bridgeAlertCheckIntervalFlag           = "bridge-alert-check-interval"
 
 	relayerFlag               = "relayer"
 	numBlockConfirmationsFlag = "num-block-confirmations"
+	syncStallTimeoutFlag      = "sync-stall-timeout"
+	syncHeaderWorkersFlag     = "sync-header-workers"
+	syncSealWorkersFlag       = "sync-seal-workers"
+	syncPipelineBufferFlag    = "sync-pipeline-buffer"
+	shutdownTimeoutFlag       = "shutdown-timeout"
+	rootchainJSONRPCFlag      = "rootchain-json-rpc"
+	pluginFlag                = "plugin"
+	externalSignerFlag        = "external-signer"
+	web3SignerURLFlag         = "web3signer-url"
+	web3SignerPublicKeyFlag   = "web3signer-public-key"
+
+	rootchainFeeBumpBlocksFlag  = "rootchain-fee-bump-blocks"
+	rootchainFeeBumpPercentFlag = "rootchain-fee-bump-percent"
+	rootchainMaxFeePerGasFlag   = "rootchain-max-fee-per-gas"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -56,8 +144,16 @@ var (
 	params = &serverParams{
 		rawConfig: &config.Config{
 			Telemetry: &config.Telemetry{},
+			GRPCAuth:  &config.GRPCAuth{},
+			Health:    &config.Health{},
+			MetaTx:    &config.MetaTx{},
+			Faucet:    &config.Faucet{},
 			Network:   &config.Network{},
 			TxPool:    &config.TxPool{},
+
+			BridgeAlert:    &config.BridgeAlert{},
+			RootchainFees:  &config.RootchainFees{},
+			JSONRPCAPIKeys: &config.APIKeys{},
 		},
 	}
 )
@@ -72,8 +168,11 @@ type serverParams struct {
 
 	libp2pAddress     *net.TCPAddr
 	prometheusAddress *net.TCPAddr
+	healthAddress     *net.TCPAddr
 	natAddress        net.IP
 	dnsAddress        multiaddr.Multiaddr
+	allowList         *network.AllowList
+	peerFilter        *network.PeerFilter
 	grpcAddress       *net.TCPAddr
 	jsonRPCAddress    *net.TCPAddr
 
@@ -81,16 +180,93 @@ type serverParams struct {
 	devInterval    uint64
 	isDevMode      bool
 
+	devAccountsCount uint64
+	devBalanceRaw    string
+	devAccounts      []*devAccount
+	devBalance       *big.Int
+
 	ibftBaseTimeoutLegacy uint64
 
 	genesisConfig *chain.Chain
 	secretsConfig *secrets.SecretsManagerConfig
 
 	logFileLocation string
+	logLevelFlagSet bool
+
+	txPoolDenyList     *txpool.DenyList
+	txPoolPriorityLane *txpool.PriorityLaneConfig
+
+	metaTxConfig *metatx.Config
+	faucetConfig *faucet.Config
 
 	relayer bool
 }
 
+// bridgeAlertConfig returns the configuration of the bridge alerts
+// grpcAuthConfig returns the authentication config of the operator gRPC server
+func (p *serverParams) grpcAuthConfig() *grpcauth.Config {
+	return &grpcauth.Config{
+		CertFile:     p.rawConfig.GRPCAuth.TLSCert,
+		KeyFile:      p.rawConfig.GRPCAuth.TLSKey,
+		ClientCAFile: p.rawConfig.GRPCAuth.TLSClientCA,
+		TokenFile:    p.rawConfig.GRPCAuth.TokenFile,
+	}
+}
+
+func (p *serverParams) bridgeAlertConfig() *bridgealert.Config {
+	return &bridgealert.Config{
+		WebhookURL:              p.rawConfig.BridgeAlert.WebhookURL,
+		PendingThreshold:        time.Duration(p.rawConfig.BridgeAlert.PendingThreshold) * time.Second,
+		SignatureStallThreshold: time.Duration(p.rawConfig.BridgeAlert.SignatureStallThreshold) * time.Second,
+		MaxDemotions:            p.rawConfig.BridgeAlert.MaxDemotions,
+		CheckInterval:           time.Duration(p.rawConfig.BridgeAlert.CheckInterval) * time.Second,
+	}
+}
+
+// rootchainFeeBumpConfig returns the fee management config of the rootchain transactions, nil if disabled
+func (p *serverParams) rootchainFeeBumpConfig() *txrelayer.FeeBumpConfig {
+	if p.rawConfig.RootchainFees.BumpBlocks == 0 {
+		return nil
+	}
+
+	config := &txrelayer.FeeBumpConfig{
+		BumpBlocks:  p.rawConfig.RootchainFees.BumpBlocks,
+		BumpPercent: p.rawConfig.RootchainFees.BumpPercent,
+	}
+
+	if p.rawConfig.RootchainFees.MaxFeePerGas > 0 {
+		config.MaxFeePerGas = new(big.Int).SetUint64(p.rawConfig.RootchainFees.MaxFeePerGas)
+	}
+
+	return config
+}
+
+// signerConfig returns the configuration of the external signer or Web3Signer the validator signing is delegated to
+func (p *serverParams) signerConfig() wallet.SignerConfig {
+	return wallet.SignerConfig{
+		ExternalSigner:      p.rawConfig.ExternalSigner,
+		Web3SignerURL:       p.rawConfig.Web3SignerURL,
+		Web3SignerPublicKey: p.rawConfig.Web3SignerPublicKey,
+	}
+}
+
+// txPoolAutoTuneConfig returns the bounds of the txpool limits auto-tuning, nil if disabled
+func (p *serverParams) txPoolAutoTuneConfig() *txpool.AutoTuneConfig {
+	autoTune := p.rawConfig.TxPool.AutoTune
+	if autoTune == nil {
+		return nil
+	}
+
+	return &txpool.AutoTuneConfig{
+		MinSlots:           autoTune.MinSlots,
+		MaxSlots:           autoTune.MaxSlots,
+		MinAccountEnqueued: autoTune.MinAccountEnqueued,
+		MaxAccountEnqueued: autoTune.MaxAccountEnqueued,
+		MinPriceLimit:      autoTune.MinPriceLimit,
+		MaxPriceLimit:      autoTune.MaxPriceLimit,
+	}
+}
+
 func (p *serverParams) isMaxPeersSet() bool {
 	return p.rawConfig.Network.MaxPeers != unsetPeersValue
 }
@@ -108,6 +284,10 @@ func (p *serverParams) isPrometheusAddressSet() bool {
 	return p.rawConfig.Telemetry.PrometheusAddr != ""
 }
 
+func (p *serverParams) isHealthAddressSet() bool {
+	return p.rawConfig.Health.Addr != ""
+}
+
 func (p *serverParams) isNATAddressSet() bool {
 	return p.rawConfig.Network.NatAddr != ""
 }
@@ -150,37 +330,99 @@ func (p *serverParams) generateConfig() *server.Config {
 		JSONRPC: &server.JSONRPC{
 			JSONRPCAddr:              p.jsonRPCAddress,
 			AccessControlAllowOrigin: p.rawConfig.CorsAllowedOrigins,
+			AllowedHosts:             p.rawConfig.JSONRPCAllowedHosts,
 			BatchLengthLimit:         p.rawConfig.JSONRPCBatchRequestLimit,
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
+			CallCacheSize:            p.rawConfig.JSONRPCCallCacheSize,
+			FilterTimeout:            time.Duration(p.rawConfig.JSONRPCFilterTimeout) * time.Second,
+			FilterRetention:          time.Duration(p.rawConfig.JSONRPCFilterRetention) * time.Second,
+			LogsResultLimit:          p.rawConfig.JSONRPCLogsResultLimit,
+			APIKeysRequired:          p.rawConfig.JSONRPCAPIKeys.Required,
+			APIKeys:                  p.rawConfig.JSONRPCAPIKeys.Keys,
+			SigningKeys:              p.devAccountKeys(),
 		},
 		GRPCAddr:   p.grpcAddress,
+		GRPCAuth:   p.grpcAuthConfig(),
 		LibP2PAddr: p.libp2pAddress,
 		Telemetry: &server.Telemetry{
-			PrometheusAddr: p.prometheusAddress,
+			PrometheusAddr:    p.prometheusAddress,
+			TracingEndpoint:   p.rawConfig.Telemetry.TracingEndpoint,
+			TracingSampleRate: p.rawConfig.Telemetry.TracingSampleRate,
+			Report: &telemetry.Config{
+				Endpoint: p.rawConfig.Telemetry.ReportEndpoint,
+				Interval: time.Duration(p.rawConfig.Telemetry.ReportInterval) * time.Second,
+			},
+		},
+		Health: &health.Config{
+			Addr:            p.healthAddress,
+			MinPeers:        p.rawConfig.Health.MinPeers,
+			MaxBlocksBehind: p.rawConfig.Health.MaxBlocksBehind,
+			MaxBlockAge:     time.Duration(p.rawConfig.Health.MaxBlockAge) * time.Second,
 		},
 		Network: &network.Config{
 			NoDiscover:       p.rawConfig.Network.NoDiscover,
+			NoNATPortMap:     p.rawConfig.Network.NoNATPortMap,
+			NoObservedAddrs:  p.rawConfig.Network.NoObservedAddrs,
+			QUIC:             p.rawConfig.Network.QUIC,
 			Addr:             p.libp2pAddress,
 			NatAddr:          p.natAddress,
 			DNS:              p.dnsAddress,
+			AllowList:        p.allowList,
+			PeerFilter:       p.peerFilter,
 			DataDir:          p.rawConfig.DataDir,
 			MaxPeers:         p.rawConfig.Network.MaxPeers,
 			MaxInboundPeers:  p.rawConfig.Network.MaxInboundPeers,
 			MaxOutboundPeers: p.rawConfig.Network.MaxOutboundPeers,
 			Chain:            p.genesisConfig,
+
+			GossipCompression: p.rawConfig.Network.GossipCompression,
+			KeepAliveInterval: time.Duration(p.rawConfig.Network.KeepAliveInterval) * time.Second,
+			IdleTimeout:       time.Duration(p.rawConfig.Network.IdleTimeout) * time.Second,
+			PingInterval:      time.Duration(p.rawConfig.Network.PingInterval) * time.Second,
+			PingTimeout:       time.Duration(p.rawConfig.Network.PingTimeout) * time.Second,
 		},
-		DataDir:            p.rawConfig.DataDir,
-		Seal:               p.rawConfig.ShouldSeal,
-		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
-		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
-		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
-		SecretsManager:     p.secretsConfig,
-		RestoreFile:        p.getRestoreFilePath(),
-		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
-		JSONLogFormat:      p.rawConfig.JSONLogFormat,
-		LogFilePath:        p.logFileLocation,
+		DataDir:                p.rawConfig.DataDir,
+		Seal:                   p.rawConfig.ShouldSeal,
+		PriceLimit:             p.rawConfig.TxPool.PriceLimit,
+		MaxSlots:               p.rawConfig.TxPool.MaxSlots,
+		MaxAccountEnqueued:     p.rawConfig.TxPool.MaxAccountEnqueued,
+		TxPoolDenyList:         p.txPoolDenyList,
+		SecretsManager:         p.secretsConfig,
+		RestoreFile:            p.getRestoreFilePath(),
+		TxLookupBySender:       p.rawConfig.TxLookupBySender,
+		ContractCreationLookup: p.rawConfig.ContractCreationLookup,
+		TxHistory:              p.rawConfig.TxHistory,
+		FlatState:              p.rawConfig.FlatState,
+		DirtyStateLimit:        p.rawConfig.MaxDirtyStateSize * config.MiB,
+		BridgeAlert:            p.bridgeAlertConfig(),
+		OverrideFile:           p.rawConfig.OverrideFile,
+		MetaTx:                 p.metaTxConfig,
+		Faucet:                 p.faucetConfig,
+		LogLevel:               hclog.LevelFromString(p.rawConfig.LogLevel),
+		LogLevelFlagSet:        p.logLevelFlagSet,
+		JSONLogFormat:          p.rawConfig.JSONLogFormat,
+		LogFilePath:            p.logFileLocation,
 
 		Relayer:               p.relayer,
 		NumBlockConfirmations: p.rawConfig.NumBlockConfirmations,
+		SyncStallTimeout:      time.Duration(p.rawConfig.SyncStallTimeout) * time.Second,
+		ShutdownTimeout:       time.Duration(p.rawConfig.ShutdownTimeout) * time.Second,
+		SyncPipeline: syncer.PipelineConfig{
+			HeaderWorkers: int(p.rawConfig.SyncHeaderWorkers),
+			SealWorkers:   int(p.rawConfig.SyncSealWorkers),
+			BufferSize:    int(p.rawConfig.SyncPipelineBuffer),
+		},
+
+		RootchainJSONRPCEndpoints: p.rawConfig.RootchainJSONRPCEndpoints,
+		Plugins:                   p.rawConfig.Plugins,
+		Signer:                    p.signerConfig(),
+		RootchainFeeBump:          p.rootchainFeeBumpConfig(),
+
+		TxPoolAdmissionRateLimit:      p.rawConfig.TxPool.AdmissionRateLimit,
+		TxPoolAdmissionMinProbability: p.rawConfig.TxPool.AdmissionMinProbability,
+		TxPoolAutoTune:                p.txPoolAutoTuneConfig(),
+		TxPoolPriorityLane:            p.txPoolPriorityLane,
+
+		TxPoolFutureTxLifetime: time.Duration(p.rawConfig.TxPool.FutureTxLifetime) * time.Second,
 	}
 }
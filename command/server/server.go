@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 	"github.com/0xPolygon/polygon-edge/command/server/export"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/spf13/cobra"
 )
 
@@ -82,6 +83,95 @@ func setFlags(cmd *cobra.Command) {
 			"If only port is defined (:port) it will bind to 0.0.0.0:port",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.Telemetry.TracingEndpoint,
+		tracingEndpointFlag,
+		"",
+		"the address and port of the OpenTelemetry collector (OTLP gRPC) the traces are exported to (address:port). "+
+			"Tracing is disabled if not set",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.Telemetry.TracingSampleRate,
+		tracingSampleRateFlag,
+		defaultConfig.Telemetry.TracingSampleRate,
+		"the fraction (0 to 1) of the traces exported to the tracing endpoint",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Telemetry.ReportEndpoint,
+		telemetryEndpointFlag,
+		"",
+		"the URL the anonymized node health reports (chain id, version, head height, peer count, OS/arch) "+
+			"are pushed to. Reporting is disabled if not set",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Telemetry.ReportInterval,
+		telemetryIntervalFlag,
+		defaultConfig.Telemetry.ReportInterval,
+		"the interval in seconds between two node health reports",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.GRPCAuth.TLSCert,
+		grpcTLSCertFlag,
+		defaultConfig.GRPCAuth.TLSCert,
+		"the PEM encoded TLS certificate the GRPC server is served with. The certificate and the key are "+
+			"read again once modified, so they are rotated without a restart",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.GRPCAuth.TLSKey,
+		grpcTLSKeyFlag,
+		defaultConfig.GRPCAuth.TLSKey,
+		"the PEM encoded private key of the GRPC server TLS certificate",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.GRPCAuth.TLSClientCA,
+		grpcTLSClientCAFlag,
+		defaultConfig.GRPCAuth.TLSClientCA,
+		"the PEM encoded CA bundle the GRPC client certificates are verified against, "+
+			"the clients are required to present a valid certificate (mutual TLS) if set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.GRPCAuth.TokenFile,
+		grpcTokenFileFlag,
+		defaultConfig.GRPCAuth.TokenFile,
+		"the file holding the bearer token the GRPC clients are required to present, read again once modified",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Health.Addr,
+		healthAddressFlag,
+		"",
+		"the address and port for the health and readiness endpoints (address:port). "+
+			"If only port is defined (:port) it will bind to 0.0.0.0:port",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.MinPeers,
+		healthMinPeersFlag,
+		defaultConfig.Health.MinPeers,
+		"the minimum number of connected peers for the node to be ready",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.MaxBlocksBehind,
+		healthMaxBlocksBehindFlag,
+		defaultConfig.Health.MaxBlocksBehind,
+		"the maximum number of blocks the node can be behind the highest known block to be ready",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.MaxBlockAge,
+		healthMaxBlockAgeFlag,
+		defaultConfig.Health.MaxBlockAge,
+		"the maximum age in seconds of the head block for the node to be ready, value of 0 disables the check",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.Network.NatAddr,
 		natFlag,
@@ -132,6 +222,63 @@ func setFlags(cmd *cobra.Command) {
 		"prevent the client from discovering other peers",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.QUIC,
+		quicFlag,
+		defaultConfig.Network.QUIC,
+		"enable the QUIC transport alongside TCP, served on the libp2p port over UDP. "+
+			"The QUIC addresses are advertised to the peers, which dial them if they enable QUIC too",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.GossipCompression,
+		gossipCompressionFlag,
+		defaultConfig.Network.GossipCompression,
+		"publish the large gossip messages snappy compressed. The compressed messages are always accepted, "+
+			"but should be published only once all the nodes are upgraded to decode them",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.NoNATPortMap,
+		noNATPortMapFlag,
+		defaultConfig.Network.NoNATPortMap,
+		"prevent the client from mapping the libp2p port on the router (UPnP, NAT-PMP). "+
+			"The port is not mapped if the NAT or DNS address is set",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.NoObservedAddrs,
+		noObservedAddrsFlag,
+		defaultConfig.Network.NoObservedAddrs,
+		"prevent the client from advertising its external addresses observed by the peers, "+
+			"only the local and the router mapped addresses are advertised",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.Network.AllowList,
+		allowListFlag,
+		defaultConfig.Network.AllowList,
+		"the only peers the client dials and accepts the connections from, either as the multiaddrs "+
+			"with the peer ID (dialed on start) or as the bare peer IDs (accepted from any host). "+
+			"Peer discovery is disabled if set",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.Network.PeerAllowlist,
+		peerAllowlistFlag,
+		defaultConfig.Network.PeerAllowlist,
+		"the CIDR ranges, IPs and peer IDs the client connects to, while the peers are still discovered. "+
+			"If the ranges are set, only the peers within them are connected, and if the peer IDs are set, "+
+			"only these peers are connected",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.Network.PeerDenylist,
+		peerDenylistFlag,
+		defaultConfig.Network.PeerDenylist,
+		"the CIDR ranges, IPs and peer IDs the client never connects to, it takes precedence over the allowlist",
+	)
+
 	cmd.Flags().Int64Var(
 		&params.rawConfig.Network.MaxPeers,
 		maxPeersFlag,
@@ -161,6 +308,35 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flag(maxOutboundPeersFlag).DefValue = fmt.Sprintf("%d", defaultConfig.Network.MaxOutboundPeers)
 	cmd.MarkFlagsMutuallyExclusive(maxPeersFlag, maxOutboundPeersFlag)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Network.KeepAliveInterval,
+		keepAliveIntervalFlag,
+		defaultConfig.Network.KeepAliveInterval,
+		"the interval (in seconds) of the connection keep-alive pings, value of 0 uses the libp2p default",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Network.IdleTimeout,
+		idleTimeoutFlag,
+		defaultConfig.Network.IdleTimeout,
+		"the time (in seconds) to wait for the keep-alive ping response before closing the connection, "+
+			"value of 0 uses the libp2p default",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Network.PingInterval,
+		pingIntervalFlag,
+		defaultConfig.Network.PingInterval,
+		"the interval (in seconds) of the pings used to detect and disconnect dead peers, value of 0 disables them",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Network.PingTimeout,
+		pingTimeoutFlag,
+		defaultConfig.Network.PingTimeout,
+		"the time (in seconds) to wait for the peer ping response",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.rawConfig.TxPool.PriceLimit,
 		priceLimitFlag,
@@ -185,11 +361,59 @@ func setFlags(cmd *cobra.Command) {
 		"maximum number of enqueued transactions per account",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.AdmissionRateLimit,
+		admissionRateLimitFlag,
+		defaultConfig.TxPool.AdmissionRateLimit,
+		"the number of incoming transactions per second above which they are sampled "+
+			"before the admission to the pool, value of 0 disables the sampling",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.TxPool.AdmissionMinProbability,
+		admissionMinProbabilityFlag,
+		defaultConfig.TxPool.AdmissionMinProbability,
+		"the minimum admission probability (0 to 1] of each sender's transactions while sampling",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.TxPool.PriorityRecipients,
+		priorityRecipientsFlag,
+		defaultConfig.TxPool.PriorityRecipients,
+		"the recipients (system contracts) whose transactions are admitted to the txpool priority lane, "+
+			"bypassing the price limit and the pool capacity, and executed ahead of the other transactions",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.PrioritySlots,
+		prioritySlotsFlag,
+		defaultConfig.TxPool.PrioritySlots,
+		"maximum slots of the txpool priority lane, accounted separately from the pool capacity, "+
+			"value of 0 disables the priority lane",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.FutureTxLifetime,
+		futureTxLifetimeFlag,
+		defaultConfig.TxPool.FutureTxLifetime,
+		"maximum time (in seconds) a transaction preceded by a nonce gap is kept in the pool, "+
+			"value of 0 keeps it until evicted due to the pool pressure",
+	)
+
 	cmd.Flags().StringArrayVar(
 		&params.rawConfig.CorsAllowedOrigins,
 		corsOriginFlag,
 		defaultConfig.Headers.AccessControlAllowOrigins,
-		"the CORS header indicating whether any JSON-RPC response can be shared with the specified origin",
+		"the CORS header indicating whether any JSON-RPC response can be shared with the specified origin. "+
+			"The WS connections are accepted from the specified origins only",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.JSONRPCAllowedHosts,
+		jsonRPCAllowedHostsFlag,
+		defaultConfig.JSONRPCAllowedHosts,
+		"the host names accepted in the Host header of the JSON-RPC requests (* allows any host), "+
+			"the requests to the IP addresses are always accepted",
 	)
 
 	cmd.Flags().Uint64Var(
@@ -207,6 +431,44 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCCallCacheSize,
+		jsonRPCCallCacheSizeFlag,
+		defaultConfig.JSONRPCCallCacheSize,
+		"max number of eth_call and eth_estimateGas responses cached for the latest block, value of 0 disables it",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCFilterTimeout,
+		jsonRPCFilterTimeoutFlag,
+		defaultConfig.JSONRPCFilterTimeout,
+		"the number of seconds the polling filter (e.g. eth_newFilter) is kept without being polled or refreshed",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCFilterRetention,
+		jsonRPCFilterRetentionFlag,
+		defaultConfig.JSONRPCFilterRetention,
+		"the number of seconds the timed out polling filter is kept to be restored with the missed changes "+
+			"on the next poll, value of 0 disables it",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCLogsResultLimit,
+		jsonRPCLogsResultLimitFlag,
+		defaultConfig.JSONRPCLogsResultLimit,
+		"max number of logs returned by a single eth_getLogs request, larger results have to be paginated "+
+			"with the limit and cursor of the query, value of 0 disables it",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCAPIKeys.Required,
+		jsonRPCAPIKeysRequiredFlag,
+		defaultConfig.JSONRPCAPIKeys.Required,
+		"reject the json-rpc requests without the api key (X-Api-Key header). The api keys are set "+
+			"in the config file or provisioned at runtime with the api-key command",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -214,6 +476,194 @@ func setFlags(cmd *cobra.Command) {
 		"write all logs to the file at specified location instead of writing them to console",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.TxLookupBySender,
+		txLookupBySenderFlag,
+		defaultConfig.TxLookupBySender,
+		"maintain the index of the transactions by sender, required by edge_getTransactionsBySender. "+
+			"Only the blocks imported while the flag is set are indexed",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.ContractCreationLookup,
+		contractCreationLookupFlag,
+		defaultConfig.ContractCreationLookup,
+		"maintain the index of the contract creations, required by edge_getContractCreation. "+
+			"Only the blocks imported while the flag is set are indexed",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxHistory,
+		txHistoryFlag,
+		defaultConfig.TxHistory,
+		"number of the latest blocks whose transaction lookups and receipts are kept, the older ones are "+
+			"pruned as the new blocks are imported, value of 0 keeps the entire history",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.FlatState,
+		flatStateFlag,
+		defaultConfig.FlatState,
+		"maintain the flat account and storage state alongside the trie, so the state reads don't traverse "+
+			"the trie. The flat state is generated in the background on the first start",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.MaxDirtyStateSize,
+		maxDirtyStateSizeFlag,
+		defaultConfig.MaxDirtyStateSize,
+		"estimated size (in MiB) of the state modified by the block being executed, after which "+
+			"the modified state is flushed to the trie before the next transaction, value of 0 disables it",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.OverrideFile,
+		overrideFileFlag,
+		defaultConfig.OverrideFile,
+		"the path to the JSON or YAML file overriding the txpool and JSON-RPC limits at runtime, "+
+			"reloaded when the node receives SIGHUP",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.MetaTx.Forwarder,
+		metaTxForwarderFlag,
+		defaultConfig.MetaTx.Forwarder,
+		"the address of the trusted EIP-2771 forwarder contract. "+
+			"The meta-transaction relayer (relay_sendMetaTransaction) is enabled if set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.MetaTx.SponsorKey,
+		metaTxSponsorKeyFlag,
+		defaultConfig.MetaTx.SponsorKey,
+		"the path of the file holding the hex encoded private key of the account paying for the meta-transactions",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.MetaTx.AllowedSenders,
+		metaTxAllowedSendersFlag,
+		defaultConfig.MetaTx.AllowedSenders,
+		"the senders whose meta-transactions are relayed (all if not set)",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.MetaTx.AllowedTargets,
+		metaTxAllowedTargetsFlag,
+		defaultConfig.MetaTx.AllowedTargets,
+		"the contracts the relayed meta-transactions can call (all if not set)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.MetaTx.MaxGas,
+		metaTxMaxGasFlag,
+		defaultConfig.MetaTx.MaxGas,
+		"the maximum gas of a single relayed meta-transaction",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.MetaTx.GasPrice,
+		metaTxGasPriceFlag,
+		defaultConfig.MetaTx.GasPrice,
+		"the gas price (the priority fee after London) paid by the sponsor for the relayed meta-transactions",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Faucet.Addr,
+		faucetAddressFlag,
+		defaultConfig.Faucet.Addr,
+		"the address and port the faucet endpoint (POST /drip) is served on, intended for the test networks. "+
+			"The faucet is enabled if set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Faucet.Key,
+		faucetKeyFlag,
+		defaultConfig.Faucet.Key,
+		"the path of the file holding the hex encoded private key of the account the faucet sends the tokens from",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Faucet.Amount,
+		faucetAmountFlag,
+		defaultConfig.Faucet.Amount,
+		"the amount of the native tokens (in wei) sent by a single faucet request",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Faucet.Cooldown,
+		faucetCooldownFlag,
+		defaultConfig.Faucet.Cooldown,
+		"the minimum time (in seconds) between two faucet requests for the same address",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Faucet.APIKeys,
+		faucetAPIKeysFlag,
+		defaultConfig.Faucet.APIKeys,
+		"the API keys (sent in the X-API-Key header) authorizing the faucet requests, "+
+			"the requests without the API key must solve the captcha if the captcha secret is set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Faucet.CaptchaSecret,
+		faucetCaptchaSecretFlag,
+		defaultConfig.Faucet.CaptchaSecret,
+		"the secret of the captcha solved by the faucet clients, the captcha is not required if not set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Faucet.CaptchaVerifyURL,
+		faucetCaptchaVerifyURLFlag,
+		defaultConfig.Faucet.CaptchaVerifyURL,
+		"the captcha verification endpoint, compatible with hCaptcha and reCAPTCHA",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Faucet.GasPrice,
+		faucetGasPriceFlag,
+		defaultConfig.Faucet.GasPrice,
+		"the gas price (the priority fee after London) of the faucet transactions",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.BridgeAlert.WebhookURL,
+		bridgeAlertWebhookFlag,
+		"",
+		"the URL the bridge alerts are posted to as JSON. The alerts are logged and counted "+
+			"in the bridge_alerts metric regardless",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.BridgeAlert.PendingThreshold,
+		bridgeAlertPendingThresholdFlag,
+		defaultConfig.BridgeAlert.PendingThreshold,
+		"the time in seconds a bridge message can wait for its commitment before the alert fires, "+
+			"value of 0 disables the rule",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.BridgeAlert.SignatureStallThreshold,
+		bridgeAlertSignatureStallThresholdFlag,
+		defaultConfig.BridgeAlert.SignatureStallThreshold,
+		"the time in seconds the commitment of a bridge message can go without a new signature "+
+			"before reaching the quorum, before the alert fires, value of 0 disables the rule",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.BridgeAlert.MaxDemotions,
+		bridgeAlertMaxDemotionsFlag,
+		defaultConfig.BridgeAlert.MaxDemotions,
+		"the number of times the commitment of a bridge message can be discarded before the alert fires, "+
+			"value of 0 disables the rule",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.BridgeAlert.CheckInterval,
+		bridgeAlertCheckIntervalFlag,
+		defaultConfig.BridgeAlert.CheckInterval,
+		"the interval in seconds between two evaluations of the bridge alert rules",
+	)
+
 	cmd.Flags().BoolVar(
 		&params.rawConfig.Relayer,
 		relayerFlag,
@@ -228,6 +678,107 @@ func setFlags(cmd *cobra.Command) {
 		"minimal number of child blocks required for the parent block to be considered final",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.SyncStallTimeout,
+		syncStallTimeoutFlag,
+		defaultConfig.SyncStallTimeout,
+		"the time (in seconds) the chain head may not advance while the peers report higher heights, "+
+			"after which the sync peer is rotated and the node resyncs. Value of 0 disables the stall detection",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.SyncHeaderWorkers,
+		syncHeaderWorkersFlag,
+		defaultConfig.SyncHeaderWorkers,
+		"the number of the workers verifying the block headers while bulk syncing. Value of 0 uses a worker per CPU",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.SyncSealWorkers,
+		syncSealWorkersFlag,
+		defaultConfig.SyncSealWorkers,
+		"the number of the workers verifying the transaction signatures while bulk syncing. "+
+			"Value of 0 uses a worker per CPU",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.SyncPipelineBuffer,
+		syncPipelineBufferFlag,
+		defaultConfig.SyncPipelineBuffer,
+		"the number of the blocks buffered by each stage of the bulk sync import pipeline",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.ShutdownTimeout,
+		shutdownTimeoutFlag,
+		defaultConfig.ShutdownTimeout,
+		"the time (in seconds) the shutdown waits for the in-flight block sealing to finish. "+
+			"Value of 0 waits without the limit",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.RootchainJSONRPCEndpoints,
+		rootchainJSONRPCFlag,
+		defaultConfig.RootchainJSONRPCEndpoints,
+		"the rootchain JSON-RPC endpoints used for tracking the rootchain events, in the order of preference. "+
+			"The tracker fails over to the next healthy endpoint if the current one fails (PolyBFT only)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.RootchainFees.BumpBlocks,
+		rootchainFeeBumpBlocksFlag,
+		defaultConfig.RootchainFees.BumpBlocks,
+		"the number of rootchain blocks a relayed transaction can stay pending before it is resubmitted "+
+			"with the bumped EIP-1559 fees, value of 0 disables the fee bumping (PolyBFT only)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.RootchainFees.BumpPercent,
+		rootchainFeeBumpPercentFlag,
+		defaultConfig.RootchainFees.BumpPercent,
+		fmt.Sprintf("the percentage the fees of the stuck rootchain transaction are bumped by, at least %d",
+			txrelayer.MinBumpPercent),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.RootchainFees.MaxFeePerGas,
+		rootchainMaxFeePerGasFlag,
+		defaultConfig.RootchainFees.MaxFeePerGas,
+		"the cap of the fee per gas (in wei) of the rootchain transactions, the alert fires once "+
+			"a stuck transaction reaches it. Value of 0 means no cap",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Plugins,
+		pluginFlag,
+		defaultConfig.Plugins,
+		"the path of the Go plugin (.so) with the out-of-tree extension, can be repeated to load multiple plugins",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.ExternalSigner,
+		externalSignerFlag,
+		defaultConfig.ExternalSigner,
+		"the endpoint of the external signer (the unix socket path or tcp://host:port) all the validator "+
+			"signing is delegated to, instead of reading the validator keys from the secrets manager (PolyBFT only)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Web3SignerURL,
+		web3SignerURLFlag,
+		defaultConfig.Web3SignerURL,
+		"the URL of Web3Signer the validator ECDSA signing is delegated to, the BLS key is still read from "+
+			"the secrets manager. Transactions can't be signed by Web3Signer, so the bridge and the relayer "+
+			"aren't supported (PolyBFT only)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Web3SignerPublicKey,
+		web3SignerPublicKeyFlag,
+		defaultConfig.Web3SignerPublicKey,
+		"the hex encoded secp256k1 public key identifying the validator key held by Web3Signer",
+	)
+
 	setLegacyFlags(cmd)
 
 	setDevFlags(cmd)
@@ -252,19 +803,30 @@ func setDevFlags(cmd *cobra.Command) {
 		&params.isDevMode,
 		devFlag,
 		false,
-		"should the client start in dev mode (default false)",
+		"should the client start as a single node dev network, sealing the transactions without the peers. "+
+			"The genesis file is not required in the dev mode",
 	)
 
-	_ = cmd.Flags().MarkHidden(devFlag)
-
 	cmd.Flags().Uint64Var(
 		&params.devInterval,
 		devIntervalFlag,
 		0,
-		"the client's dev notification interval in seconds (default 1)",
+		"the dev mode block sealing interval in seconds, each transaction is sealed in its own block if not set",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.devAccountsCount,
+		devAccountsFlag,
+		defaultDevAccounts,
+		"the number of the accounts funded in the dev mode",
 	)
 
-	_ = cmd.Flags().MarkHidden(devIntervalFlag)
+	cmd.Flags().StringVar(
+		&params.devBalanceRaw,
+		devBalanceFlag,
+		defaultDevBalance.String(),
+		"the balance (in wei) of each account funded in the dev mode",
+	)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -273,6 +835,7 @@ func runPreRun(cmd *cobra.Command, _ []string) error {
 	params.setRawGRPCAddress(helper.GetGRPCAddress(cmd))
 	params.setRawJSONRPCAddress(helper.GetJSONRPCAddress(cmd))
 	params.setJSONLogFormat(helper.GetJSONLogFormat(cmd))
+	params.logLevelFlagSet = cmd.Flags().Changed(command.LogLevelFlag)
 
 	// Check if the config file has been specified
 	// Config file settings will override JSON-RPC and GRPC address values
@@ -296,6 +859,15 @@ func isConfigFileSpecified(cmd *cobra.Command) bool {
 func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 
+	if params.isDevMode {
+		if err := printDevAccounts(cmd.OutOrStdout(), params.devAccounts, params.devBalance); err != nil {
+			outputter.SetError(err)
+			outputter.WriteOutput()
+
+			return
+		}
+	}
+
 	if err := runServerLoop(params.generateConfig(), outputter); err != nil {
 		outputter.SetError(err)
 		outputter.WriteOutput()
@@ -313,5 +885,8 @@ func runServerLoop(
 		return err
 	}
 
-	return helper.HandleSignals(serverInstance.Close, outputter)
+	defer serverInstance.RecoverPanic()
+
+	// SIGHUP reloads the log levels and the override file instead of shutting down the node
+	return helper.HandleSignals(serverInstance.Close, serverInstance.Reload, outputter)
 }
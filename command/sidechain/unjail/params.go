@@ -0,0 +1,39 @@
+package unjail
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+)
+
+type unjailParams struct {
+	accountDir    string
+	accountConfig string
+	jsonRPC       string
+}
+
+func (u *unjailParams) validateFlags() error {
+	return sidechainHelper.ValidateSecretFlags(u.accountDir, u.accountConfig)
+}
+
+type unjailResult struct {
+	ValidatorAddress string `json:"validatorAddress"`
+	BlockNumber      uint64 `json:"blockNumber"`
+}
+
+func (ur unjailResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[UNJAIL]\n")
+
+	vals := make([]string, 0, 2)
+	vals = append(vals, fmt.Sprintf("Validator Address|%s", ur.ValidatorAddress))
+	vals = append(vals, fmt.Sprintf("Block Number|%d", ur.BlockNumber))
+
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,95 @@
+package unjail
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/spf13/cobra"
+	"github.com/umbracle/ethgo"
+)
+
+var params unjailParams
+
+func GetCommand() *cobra.Command {
+	unjailCmd := &cobra.Command{
+		Use: "unjail",
+		Short: "Sends the unjail transaction on child chain for given validator. " +
+			"The validator is unjailed only if its jail period has passed",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	helper.RegisterJSONRPCFlag(unjailCmd)
+	setFlags(unjailCmd)
+
+	return unjailCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	validatorAccount, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig)
+	if err != nil {
+		return err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond))
+	if err != nil {
+		return err
+	}
+
+	unjailAddr := ethgo.Address(contracts.UnjailAddr)
+	txn := &ethgo.Transaction{
+		From: validatorAccount.Ecdsa.Address(),
+		To:   &unjailAddr,
+	}
+
+	receipt, err := txRelayer.SendTransaction(txn, validatorAccount.Ecdsa)
+	if err != nil {
+		return err
+	}
+
+	if receipt.Status != uint64(types.ReceiptSuccess) {
+		return fmt.Errorf("unjail transaction failed on block: %d", receipt.BlockNumber)
+	}
+
+	outputter.WriteCommandResult(&unjailResult{
+		ValidatorAddress: validatorAccount.Ecdsa.Address().String(),
+		BlockNumber:      receipt.BlockNumber,
+	})
+
+	return nil
+}
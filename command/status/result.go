@@ -3,8 +3,10 @@ package status
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
 )
 
 type StatusResult struct {
@@ -12,6 +14,51 @@ type StatusResult struct {
 	CurrentBlockNumber int64  `json:"current_block_number"`
 	CurrentBlockHash   string `json:"current_block_hash"`
 	LibP2PAddress      string `json:"libp2p_address"`
+
+	// Sync is the progress of the ongoing sync, nil if the node isn't syncing
+	Sync *SyncResult `json:"sync,omitempty"`
+}
+
+type SyncResult struct {
+	Type            string           `json:"type"`
+	Phase           string           `json:"phase,omitempty"`
+	StartingBlock   uint64           `json:"starting_block"`
+	CurrentBlock    uint64           `json:"current_block"`
+	HighestBlock    uint64           `json:"highest_block"`
+	BlocksPerSecond float64          `json:"blocks_per_second"`
+	ETA             time.Duration    `json:"eta"`
+	DownloadedBytes uint64           `json:"downloaded_bytes"`
+	Peers           []SyncPeerResult `json:"peers"`
+}
+
+type SyncPeerResult struct {
+	ID     string `json:"id"`
+	Number uint64 `json:"number"`
+}
+
+// newSyncResult returns the sync result of the sync progress, nil if the node isn't syncing
+func newSyncResult(resp *proto.SyncProgressResponse) *SyncResult {
+	if !resp.Syncing {
+		return nil
+	}
+
+	result := &SyncResult{
+		Type:            resp.Type,
+		Phase:           resp.Phase,
+		StartingBlock:   resp.StartingBlock,
+		CurrentBlock:    resp.CurrentBlock,
+		HighestBlock:    resp.HighestBlock,
+		BlocksPerSecond: resp.BlocksPerSecond,
+		ETA:             time.Duration(resp.EtaSeconds) * time.Second,
+		DownloadedBytes: resp.DownloadedBytes,
+		Peers:           make([]SyncPeerResult, 0, len(resp.Peers)),
+	}
+
+	for _, syncPeer := range resp.Peers {
+		result.Peers = append(result.Peers, SyncPeerResult{ID: syncPeer.Id, Number: syncPeer.Number})
+	}
+
+	return result
 }
 
 func (r *StatusResult) GetOutput() string {
@@ -25,5 +72,41 @@ func (r *StatusResult) GetOutput() string {
 		fmt.Sprintf("Libp2p Address|%s", r.LibP2PAddress),
 	}))
 
+	if r.Sync == nil {
+		return buffer.String()
+	}
+
+	phase := r.Sync.Phase
+	if phase == "" {
+		phase = "-"
+	}
+
+	eta := "unknown"
+	if r.Sync.ETA > 0 {
+		eta = r.Sync.ETA.String()
+	}
+
+	buffer.WriteString("\n\n[SYNC PROGRESS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Type|%s", r.Sync.Type),
+		fmt.Sprintf("Phase|%s", phase),
+		fmt.Sprintf("Starting Block|%d", r.Sync.StartingBlock),
+		fmt.Sprintf("Current Block|%d", r.Sync.CurrentBlock),
+		fmt.Sprintf("Highest Block|%d", r.Sync.HighestBlock),
+		fmt.Sprintf("Blocks Per Second|%.2f", r.Sync.BlocksPerSecond),
+		fmt.Sprintf("ETA|%s", eta),
+		fmt.Sprintf("Downloaded Bytes|%d", r.Sync.DownloadedBytes),
+	}))
+
+	if len(r.Sync.Peers) > 0 {
+		peers := make([]string, 0, len(r.Sync.Peers))
+		for _, syncPeer := range r.Sync.Peers {
+			peers = append(peers, fmt.Sprintf("%s|%d", syncPeer.ID, syncPeer.Number))
+		}
+
+		buffer.WriteString("\n\n[SYNC PEERS]\n")
+		buffer.WriteString(helper.FormatList(append([]string{"ID|Number"}, peers...)))
+	}
+
 	return buffer.String()
 }
@@ -20,6 +20,7 @@ func GetCommand() *cobra.Command {
 	}
 
 	helper.RegisterGRPCAddressFlag(statusCmd)
+	helper.RegisterGRPCCredentialsFlags(statusCmd)
 
 	return statusCmd
 }
@@ -28,7 +29,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	statusResponse, err := getSystemStatus(helper.GetGRPCAddress(cmd))
+	statusResponse, syncResponse, err := getSystemStatus(helper.GetGRPCAddress(cmd))
 	if err != nil {
 		outputter.SetError(err)
 
@@ -40,16 +41,27 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		CurrentBlockNumber: statusResponse.Current.Number,
 		CurrentBlockHash:   statusResponse.Current.Hash,
 		LibP2PAddress:      statusResponse.P2PAddr,
+		Sync:               newSyncResult(syncResponse),
 	})
 }
 
-func getSystemStatus(grpcAddress string) (*proto.ServerStatus, error) {
+func getSystemStatus(grpcAddress string) (*proto.ServerStatus, *proto.SyncProgressResponse, error) {
 	client, err := helper.GetSystemClientConnection(
 		grpcAddress,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return client.GetStatus(context.Background(), &empty.Empty{})
+	status, err := client.GetStatus(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	syncProgress, err := client.SyncProgress(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return status, syncProgress, nil
 }
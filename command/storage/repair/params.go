@@ -0,0 +1,85 @@
+package repair
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/chain/verify"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+	ldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	dataDirFlag = "data-dir"
+	fromFlag    = "from"
+	dryRunFlag  = "dry-run"
+)
+
+var (
+	params = &repairParams{}
+)
+
+type repairParams struct {
+	dataDir string
+	from    uint64
+	dryRun  bool
+
+	blockchainStorage storage.Storage
+	trieDB            *ldb.DB
+
+	result *RepairResult
+}
+
+func (p *repairParams) getRequiredFlags() []string {
+	return []string{
+		dataDirFlag,
+	}
+}
+
+// openStorages opens the blockchain storage for writing, while the state is only read
+func (p *repairParams) openStorages() error {
+	blockchainStorage, err := leveldb.NewLevelDBStorage(filepath.Join(p.dataDir, "blockchain"), hclog.NewNullLogger())
+	if err != nil {
+		return fmt.Errorf("failed to open the blockchain storage: %w", err)
+	}
+
+	trieDB, err := ldb.OpenFile(filepath.Join(p.dataDir, "trie"), &opt.Options{ReadOnly: true})
+	if err != nil {
+		_ = blockchainStorage.Close()
+
+		return fmt.Errorf("failed to open the trie storage: %w", err)
+	}
+
+	p.blockchainStorage = blockchainStorage
+	p.trieDB = trieDB
+
+	return nil
+}
+
+func (p *repairParams) closeStorages() {
+	if p.blockchainStorage != nil {
+		_ = p.blockchainStorage.Close()
+	}
+
+	if p.trieDB != nil {
+		_ = p.trieDB.Close()
+	}
+}
+
+func (p *repairParams) repair() error {
+	r := newRepairer(p.blockchainStorage, verify.NewVerifier(p.blockchainStorage, itrie.NewKV(p.trieDB), nil))
+
+	result, err := r.repair(p.from, p.dryRun)
+	p.result = result
+
+	return err
+}
+
+func (p *repairParams) getResult() command.CommandResult {
+	return p.result
+}
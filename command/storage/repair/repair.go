@@ -0,0 +1,70 @@
+package repair
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	repairCmd := &cobra.Command{
+		Use: "repair",
+		Short: "Validates the header, body, receipts and state consistency of the stored blocks after " +
+			"the unclean shutdown, truncates the chain to the last fully consistent block and rebuilds " +
+			"the transaction lookups. The node must be stopped",
+		Run: runCommand,
+	}
+
+	setFlags(repairCmd)
+	helper.SetRequiredFlags(repairCmd, params.getRequiredFlags())
+
+	return repairCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.from,
+		fromFlag,
+		0,
+		"the first block whose consistency is validated, the older blocks are considered consistent",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.dryRun,
+		dryRunFlag,
+		false,
+		"report the repair without modifying the storage",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.openStorages(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+	defer params.closeStorages()
+
+	if err := params.repair(); err != nil {
+		if params.result != nil {
+			// report the found inconsistencies along with the error
+			outputter.WriteCommandResult(params.getResult())
+		}
+
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
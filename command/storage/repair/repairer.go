@@ -0,0 +1,276 @@
+package repair
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/command/chain/verify"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	fieldTotalDifficulty = "totalDifficulty"
+
+	// txLookupBatchBlocks is the number of blocks whose transaction lookups are rebuilt in a single batch
+	txLookupBatchBlocks = 1000
+)
+
+var (
+	errGenesisNotFound   = errors.New("genesis block not found, the data directory is not initialized")
+	errNoConsistentBlock = errors.New("no consistent block found, the chain can't be repaired")
+	errInvalidFrom       = errors.New(`invalid "from" value; must be <= head`)
+)
+
+// repairer truncates the stored chain to the last fully consistent block
+// and rebuilds the transaction lookups of the kept blocks
+type repairer struct {
+	storage  storage.Storage
+	verifier *verify.Verifier
+}
+
+func newRepairer(storage storage.Storage, verifier *verify.Verifier) *repairer {
+	return &repairer{
+		storage:  storage,
+		verifier: verifier,
+	}
+}
+
+// repair truncates the chain to the last fully consistent block at or above the from block,
+// and rebuilds the transaction lookups of the kept blocks. In the dry run, nothing is written
+func (r *repairer) repair(from uint64, dryRun bool) (*RepairResult, error) {
+	top, err := r.findTop()
+	if err != nil {
+		return nil, err
+	}
+
+	if from > top {
+		return nil, errInvalidFrom
+	}
+
+	head, mismatches, err := r.findConsistentHead(from, top)
+	if err != nil {
+		return &RepairResult{Head: top, Mismatches: mismatches, DryRun: dryRun}, err
+	}
+
+	result := &RepairResult{
+		Head:           top,
+		ConsistentHead: head,
+		Discarded:      top - head,
+		Mismatches:     mismatches,
+		DryRun:         dryRun,
+	}
+
+	if result.Mismatches == nil {
+		result.Mismatches = []*verify.Mismatch{}
+	}
+
+	if !dryRun {
+		if result.Discarded, err = r.truncate(head, top); err != nil {
+			return result, err
+		}
+	}
+
+	if from > head {
+		from = head
+	}
+
+	if result.RebuiltTxLookups, err = r.rebuildTxLookups(from, head, dryRun); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// findTop returns the number of the highest canonical block. The canonical blocks above the stored head
+// are included, since the head is written last, and might be missing after the unclean shutdown
+func (r *repairer) findTop() (uint64, error) {
+	if _, ok := r.storage.ReadCanonicalHash(0); !ok {
+		return 0, errGenesisNotFound
+	}
+
+	top, _ := r.storage.ReadHeadNumber()
+
+	for top > 0 {
+		if _, ok := r.storage.ReadCanonicalHash(top); ok {
+			break
+		}
+
+		top--
+	}
+
+	for {
+		if _, ok := r.storage.ReadCanonicalHash(top + 1); !ok {
+			return top, nil
+		}
+
+		top++
+	}
+}
+
+// findConsistentHead returns the number of the last fully consistent block in the given (inclusive) range,
+// along with the mismatches of the discarded blocks. The header, the body and the receipts of all the blocks
+// up to it must match, and the block must have its total difficulty and its complete state
+func (r *repairer) findConsistentHead(from, top uint64) (uint64, []*verify.Mismatch, error) {
+	var (
+		head       = top
+		mismatches []*verify.Mismatch
+	)
+
+	for number := from; number <= top; number++ {
+		if _, _, blockMismatches := r.verifier.VerifyBlockData(number); len(blockMismatches) > 0 {
+			if number == 0 {
+				return 0, blockMismatches, errNoConsistentBlock
+			}
+
+			head = number - 1
+			mismatches = blockMismatches
+
+			break
+		}
+
+		if number == top {
+			// prevents the overflow of the max uint64 range
+			break
+		}
+	}
+
+	for {
+		mismatch, err := r.verifyHead(head)
+		if err != nil {
+			return 0, mismatches, err
+		}
+
+		if mismatch == nil {
+			return head, mismatches, nil
+		}
+
+		mismatches = append(mismatches, mismatch)
+
+		if head == 0 {
+			return 0, mismatches, errNoConsistentBlock
+		}
+
+		head--
+	}
+}
+
+// verifyHead verifies the block can be the head of the chain, i.e. it has its total difficulty and its state
+func (r *repairer) verifyHead(number uint64) (*verify.Mismatch, error) {
+	header, err := r.readCanonicalHeader(number)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := r.storage.ReadTotalDifficulty(header.Hash); !ok {
+		return &verify.Mismatch{Block: number, Field: fieldTotalDifficulty, Error: "total difficulty not found"}, nil
+	}
+
+	return r.verifier.VerifyState(header), nil
+}
+
+// truncate discards the canonical blocks above the head, along with their transaction lookups and receipts,
+// and sets the head. It returns the number of the discarded blocks
+func (r *repairer) truncate(head, top uint64) (uint64, error) {
+	header, err := r.readCanonicalHeader(head)
+	if err != nil {
+		return 0, err
+	}
+
+	batchWriter := storage.NewBatchWriter(r.storage)
+
+	for number := top; number > head; number-- {
+		if hash, ok := r.storage.ReadCanonicalHash(number); ok {
+			if body, err := r.storage.ReadBody(hash); err == nil {
+				for _, txn := range body.Transactions {
+					batchWriter.DeleteTxLookup(txn.Hash)
+				}
+			}
+
+			batchWriter.DeleteReceipts(hash)
+		}
+
+		batchWriter.DeleteCanonicalHash(number)
+	}
+
+	batchWriter.PutHeadHash(header.Hash)
+	batchWriter.PutHeadNumber(header.Number)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		return 0, fmt.Errorf("failed to truncate the chain: %w", err)
+	}
+
+	return top - head, nil
+}
+
+// rebuildTxLookups rewrites the missing or stale transaction lookups of the blocks in the given (inclusive) range.
+// The blocks below the transaction index tail are skipped, as their lookups are pruned.
+// It returns the number of the rewritten lookups
+func (r *repairer) rebuildTxLookups(from, to uint64, dryRun bool) (uint64, error) {
+	if tail, ok := r.storage.ReadTxIndexTail(); ok && tail > from {
+		from = tail
+	}
+
+	var rebuilt uint64
+
+	for batchFrom := from; batchFrom <= to; batchFrom += txLookupBatchBlocks {
+		batchTo := batchFrom + txLookupBatchBlocks - 1
+		if batchTo > to || batchTo < batchFrom {
+			batchTo = to
+		}
+
+		batchWriter := storage.NewBatchWriter(r.storage)
+
+		for number := batchFrom; number <= batchTo; number++ {
+			hash, ok := r.storage.ReadCanonicalHash(number)
+			if !ok {
+				return rebuilt, fmt.Errorf("canonical hash of the block %d not found", number)
+			}
+
+			body, err := r.storage.ReadBody(hash)
+			if err != nil {
+				return rebuilt, fmt.Errorf("failed to read the body of the block %d: %w", number, err)
+			}
+
+			for _, txn := range body.Transactions {
+				if blockHash, ok := r.storage.ReadTxLookup(txn.Hash); ok && blockHash == hash {
+					continue
+				}
+
+				batchWriter.PutTxLookup(txn.Hash, hash)
+				rebuilt++
+			}
+
+			if number == batchTo {
+				break
+			}
+		}
+
+		if !dryRun {
+			if err := batchWriter.WriteBatch(); err != nil {
+				return rebuilt, fmt.Errorf("failed to write the transaction lookups: %w", err)
+			}
+		}
+
+		if batchTo == to {
+			break
+		}
+	}
+
+	return rebuilt, nil
+}
+
+// readCanonicalHeader reads the header of the canonical block with the given number
+func (r *repairer) readCanonicalHeader(number uint64) (*types.Header, error) {
+	hash, ok := r.storage.ReadCanonicalHash(number)
+	if !ok {
+		return nil, fmt.Errorf("canonical hash of the block %d not found", number)
+	}
+
+	header, err := r.storage.ReadHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header of the block %d: %w", number, err)
+	}
+
+	return header, nil
+}
@@ -0,0 +1,263 @@
+package repair
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/command/chain/verify"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type testChain struct {
+	t       *testing.T
+	storage storage.Storage
+	trie    itrie.Storage
+	root    types.Hash
+	parent  *types.Header
+}
+
+func newTestChain(t *testing.T) *testChain {
+	t.Helper()
+
+	st, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	trie := itrie.NewMemoryStorage()
+
+	_, root := itrie.NewState(trie).NewSnapshot().Commit([]*state.Object{
+		{Address: types.StringToAddress("1"), Balance: big.NewInt(100), Nonce: 1},
+	})
+
+	return &testChain{t: t, storage: st, trie: trie, root: types.BytesToHash(root)}
+}
+
+// addBlock writes the block with the given number of transactions and their lookups on top of the chain,
+// the header is modified by the given function before the block is written
+func (c *testChain) addBlock(txCount int, modify func(*types.Header)) *types.Header {
+	c.t.Helper()
+
+	number, parentHash := uint64(0), types.ZeroHash
+	if c.parent != nil {
+		number, parentHash = c.parent.Number+1, c.parent.Hash
+	}
+
+	txs := make([]*types.Transaction, txCount)
+	receipts := make([]*types.Receipt, txCount)
+
+	for i := range txs {
+		txs[i] = &types.Transaction{Nonce: number*10 + uint64(i), Gas: 21000, V: big.NewInt(27)}
+		txs[i].ComputeHash(number)
+
+		receipts[i] = &types.Receipt{CumulativeGasUsed: uint64(i+1) * 21000}
+		receipts[i].SetStatus(types.ReceiptSuccess)
+	}
+
+	header := &types.Header{
+		Number:       number,
+		ParentHash:   parentHash,
+		StateRoot:    c.root,
+		TxRoot:       buildroot.CalculateTransactionsRoot(txs, number),
+		ReceiptsRoot: buildroot.CalculateReceiptsRoot(receipts),
+		GasLimit:     10_000_000,
+	}
+
+	if modify != nil {
+		modify(header)
+	}
+
+	header.ComputeHash()
+
+	batchWriter := storage.NewBatchWriter(c.storage)
+	batchWriter.PutHeader(header)
+	batchWriter.PutBody(header.Hash, &types.Body{Transactions: txs})
+	batchWriter.PutReceipts(header.Hash, receipts)
+	batchWriter.PutCanonicalHash(number, header.Hash)
+	batchWriter.PutTotalDifficulty(header.Hash, big.NewInt(int64(number+1)))
+	batchWriter.PutHeadHash(header.Hash)
+	batchWriter.PutHeadNumber(number)
+
+	for _, txn := range txs {
+		batchWriter.PutTxLookup(txn.Hash, header.Hash)
+	}
+
+	require.NoError(c.t, batchWriter.WriteBatch())
+
+	c.parent = header
+
+	return header
+}
+
+func (c *testChain) repairer() *repairer {
+	return newRepairer(c.storage, verify.NewVerifier(c.storage, c.trie, nil))
+}
+
+func (c *testChain) requireHead(expected *types.Header) {
+	c.t.Helper()
+
+	hash, ok := c.storage.ReadHeadHash()
+	require.True(c.t, ok)
+	require.Equal(c.t, expected.Hash, hash)
+
+	number, ok := c.storage.ReadHeadNumber()
+	require.True(c.t, ok)
+	require.Equal(c.t, expected.Number, number)
+
+	_, ok = c.storage.ReadCanonicalHash(expected.Number + 1)
+	require.False(c.t, ok)
+}
+
+func TestRepairer_Consistent(t *testing.T) {
+	t.Parallel()
+
+	c := newTestChain(t)
+	c.addBlock(0, nil)
+	c.addBlock(2, nil)
+	head := c.addBlock(1, nil)
+
+	// the lookup lost by the unclean shutdown is rebuilt
+	body, err := c.storage.ReadBody(head.Hash)
+	require.NoError(t, err)
+
+	batchWriter := storage.NewBatchWriter(c.storage)
+	batchWriter.DeleteTxLookup(body.Transactions[0].Hash)
+	require.NoError(t, batchWriter.WriteBatch())
+
+	result, err := c.repairer().repair(0, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), result.Head)
+	require.Equal(t, uint64(2), result.ConsistentHead)
+	require.Zero(t, result.Discarded)
+	require.Equal(t, uint64(1), result.RebuiltTxLookups)
+	require.Empty(t, result.Mismatches)
+
+	blockHash, ok := c.storage.ReadTxLookup(body.Transactions[0].Hash)
+	require.True(t, ok)
+	require.Equal(t, head.Hash, blockHash)
+
+	c.requireHead(head)
+}
+
+func TestRepairer_TruncateInconsistentBlocks(t *testing.T) {
+	t.Parallel()
+
+	c := newTestChain(t)
+	c.addBlock(0, nil)
+	consistent := c.addBlock(1, nil)
+	c.addBlock(1, func(h *types.Header) {
+		h.ReceiptsRoot = types.StringToHash("1")
+	})
+	discarded := c.addBlock(1, nil)
+
+	body, err := c.storage.ReadBody(discarded.Hash)
+	require.NoError(t, err)
+
+	result, err := c.repairer().repair(0, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), result.Head)
+	require.Equal(t, uint64(1), result.ConsistentHead)
+	require.Equal(t, uint64(2), result.Discarded)
+	require.Len(t, result.Mismatches, 1)
+	require.Equal(t, uint64(2), result.Mismatches[0].Block)
+
+	c.requireHead(consistent)
+
+	// the lookups and the receipts of the discarded blocks are deleted
+	_, ok := c.storage.ReadTxLookup(body.Transactions[0].Hash)
+	require.False(t, ok)
+
+	_, err = c.storage.ReadReceipts(discarded.Hash)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestRepairer_TruncateMissingHeadState(t *testing.T) {
+	t.Parallel()
+
+	c := newTestChain(t)
+	c.addBlock(0, nil)
+	consistent := c.addBlock(0, nil)
+	c.addBlock(0, func(h *types.Header) {
+		h.StateRoot = types.StringToHash("2")
+	})
+
+	result, err := c.repairer().repair(0, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), result.ConsistentHead)
+	require.Equal(t, uint64(1), result.Discarded)
+	require.Len(t, result.Mismatches, 1)
+	require.Equal(t, "stateRoot", result.Mismatches[0].Field)
+
+	c.requireHead(consistent)
+}
+
+func TestRepairer_HeadNotWritten(t *testing.T) {
+	t.Parallel()
+
+	c := newTestChain(t)
+	c.addBlock(0, nil)
+	previous := c.addBlock(0, nil)
+	head := c.addBlock(0, nil)
+
+	// the head of the last block was lost by the unclean shutdown
+	batchWriter := storage.NewBatchWriter(c.storage)
+	batchWriter.PutHeadHash(previous.Hash)
+	batchWriter.PutHeadNumber(previous.Number)
+	require.NoError(t, batchWriter.WriteBatch())
+
+	result, err := c.repairer().repair(0, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), result.Head)
+	require.Equal(t, uint64(2), result.ConsistentHead)
+	require.Zero(t, result.Discarded)
+
+	c.requireHead(head)
+}
+
+func TestRepairer_DryRun(t *testing.T) {
+	t.Parallel()
+
+	c := newTestChain(t)
+	c.addBlock(0, nil)
+	head := c.addBlock(1, func(h *types.Header) {
+		h.TxRoot = types.StringToHash("1")
+	})
+
+	result, err := c.repairer().repair(0, true)
+	require.NoError(t, err)
+	require.True(t, result.DryRun)
+	require.Equal(t, uint64(0), result.ConsistentHead)
+	require.Equal(t, uint64(1), result.Discarded)
+
+	// nothing is modified
+	c.requireHead(head)
+}
+
+func TestRepairer_Errors(t *testing.T) {
+	t.Parallel()
+
+	// no genesis
+	_, err := newTestChain(t).repairer().repair(0, false)
+	require.ErrorIs(t, err, errGenesisNotFound)
+
+	// from above the head
+	c := newTestChain(t)
+	c.addBlock(0, nil)
+
+	_, err = c.repairer().repair(1, false)
+	require.ErrorIs(t, err, errInvalidFrom)
+
+	// inconsistent genesis
+	c = newTestChain(t)
+	c.addBlock(0, func(h *types.Header) {
+		h.ReceiptsRoot = types.StringToHash("1")
+	})
+
+	_, err = c.repairer().repair(0, false)
+	require.ErrorIs(t, err, errNoConsistentBlock)
+}
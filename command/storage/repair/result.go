@@ -0,0 +1,60 @@
+package repair
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/chain/verify"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type RepairResult struct {
+	Head             uint64             `json:"head"`
+	ConsistentHead   uint64             `json:"consistent_head"`
+	Discarded        uint64             `json:"discarded"`
+	RebuiltTxLookups uint64             `json:"rebuilt_tx_lookups"`
+	DryRun           bool               `json:"dry_run"`
+	Mismatches       []*verify.Mismatch `json:"mismatches"`
+}
+
+func (r *RepairResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	switch {
+	case r.DryRun:
+		buffer.WriteString("\n[STORAGE REPAIR DRY RUN]\n")
+	case r.Discarded == 0 && r.RebuiltTxLookups == 0:
+		buffer.WriteString("\n[STORAGE CONSISTENT]\n")
+	default:
+		buffer.WriteString("\n[STORAGE REPAIRED]\n")
+	}
+
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Head|%d", r.Head),
+		fmt.Sprintf("Consistent head|%d", r.ConsistentHead),
+		fmt.Sprintf("Discarded blocks|%d", r.Discarded),
+		fmt.Sprintf("Rebuilt transaction lookups|%d", r.RebuiltTxLookups),
+	}))
+	buffer.WriteString("\n")
+
+	if len(r.Mismatches) == 0 {
+		return buffer.String()
+	}
+
+	rows := make([]string, 0, len(r.Mismatches)+1)
+	rows = append(rows, "Block|Field|Expected|Actual")
+
+	for _, m := range r.Mismatches {
+		if m.Error != "" {
+			rows = append(rows, fmt.Sprintf("%d|%s|%s|", m.Block, m.Field, m.Error))
+		} else {
+			rows = append(rows, fmt.Sprintf("%d|%s|%s|%s", m.Block, m.Field, m.Expected, m.Actual))
+		}
+	}
+
+	buffer.WriteString("\n[INCONSISTENCIES]\n")
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
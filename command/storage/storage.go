@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/storage/repair"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	storageCmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Top level command for maintaining the local storage of a stopped node. Only accepts subcommands.",
+	}
+
+	registerSubcommands(storageCmd)
+
+	return storageCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// storage repair
+		repair.GetCommand(),
+	)
+}
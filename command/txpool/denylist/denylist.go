@@ -0,0 +1,28 @@
+package denylist
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/txpool/denylist/get"
+	"github.com/0xPolygon/polygon-edge/command/txpool/denylist/set"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	denyListCmd := &cobra.Command{
+		Use: "deny-list",
+		Short: "Top level command for interacting with the transaction pool deny list " +
+			"(denied senders, recipients and function selectors). Only accepts subcommands.",
+	}
+
+	registerSubcommands(denyListCmd)
+
+	return denyListCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// txpool deny-list get
+		get.GetCommand(),
+		// txpool deny-list set
+		set.GetCommand(),
+	)
+}
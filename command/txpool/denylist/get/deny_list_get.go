@@ -0,0 +1,46 @@
+package get
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	denyListHelper "github.com/0xPolygon/polygon-edge/command/txpool/denylist/helper"
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/spf13/cobra"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "Returns the denied transaction senders, recipients and function selectors",
+		Args:  cobra.NoArgs,
+		Run:   runCommand,
+	}
+
+	return getCmd
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	denyList, err := getDenyList(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(denyListHelper.NewDenyListResult(denyList))
+}
+
+func getDenyList(grpcAddress string) (*txpoolOp.DenyList, error) {
+	client, err := helper.GetTxPoolClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DenyListGet(context.Background(), &empty.Empty{})
+}
@@ -0,0 +1,47 @@
+package helper
+
+import (
+	"bytes"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+type DenyListResult struct {
+	Senders    []string `json:"senders"`
+	Recipients []string `json:"recipients"`
+	Selectors  []string `json:"selectors"`
+}
+
+func NewDenyListResult(resp *proto.DenyList) *DenyListResult {
+	return &DenyListResult{
+		Senders:    resp.Senders,
+		Recipients: resp.Recipients,
+		Selectors:  resp.Selectors,
+	}
+}
+
+func (r *DenyListResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL DENY LIST]\n")
+
+	writeList(&buffer, "DENIED SENDERS", r.Senders)
+	writeList(&buffer, "DENIED RECIPIENTS", r.Recipients)
+	writeList(&buffer, "DENIED FUNCTION SELECTORS", r.Selectors)
+
+	return buffer.String()
+}
+
+func writeList(buffer *bytes.Buffer, title string, items []string) {
+	buffer.WriteString("\n[" + title + "]\n")
+
+	if len(items) == 0 {
+		buffer.WriteString("No entries found\n")
+
+		return
+	}
+
+	buffer.WriteString(helper.FormatList(items))
+	buffer.WriteString("\n")
+}
@@ -0,0 +1,63 @@
+package set
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	setCmd := &cobra.Command{
+		Use: "set",
+		Short: "Replaces the denied transaction senders, recipients and function selectors. " +
+			"Omitting all the flags clears the deny list",
+		Args: cobra.NoArgs,
+		Run:  runCommand,
+	}
+
+	setFlags(setCmd)
+
+	return setCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(
+		&params.senders,
+		senderFlag,
+		[]string{},
+		"the address of the denied transaction sender",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.recipients,
+		recipientFlag,
+		[]string{},
+		"the address of the denied transaction recipient",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.selectors,
+		selectorFlag,
+		[]string{},
+		"the denied function selector (hex encoded first 4 bytes of the calldata)",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initTxPoolClient(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.setDenyList(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,63 @@
+package set
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	denyListHelper "github.com/0xPolygon/polygon-edge/command/txpool/denylist/helper"
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+const (
+	senderFlag    = "sender"
+	recipientFlag = "recipient"
+	selectorFlag  = "selector"
+)
+
+var (
+	params = &setParams{}
+)
+
+type setParams struct {
+	senders    []string
+	recipients []string
+	selectors  []string
+
+	txPoolClient txpoolOp.TxnPoolOperatorClient
+
+	denyList *txpoolOp.DenyList
+}
+
+func (p *setParams) initTxPoolClient(grpcAddress string) error {
+	txPoolClient, err := helper.GetTxPoolClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	p.txPoolClient = txPoolClient
+
+	return nil
+}
+
+func (p *setParams) setDenyList() error {
+	denyList, err := p.txPoolClient.DenyListSet(
+		context.Background(),
+		&txpoolOp.DenyList{
+			Senders:    p.senders,
+			Recipients: p.recipients,
+			Selectors:  p.selectors,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.denyList = denyList
+
+	return nil
+}
+
+func (p *setParams) getResult() command.CommandResult {
+	return denyListHelper.NewDenyListResult(p.denyList)
+}
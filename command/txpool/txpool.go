@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/txpool/denylist"
 	"github.com/0xPolygon/polygon-edge/command/txpool/status"
 	"github.com/0xPolygon/polygon-edge/command/txpool/subscribe"
 	"github.com/spf13/cobra"
@@ -14,6 +15,7 @@ func GetCommand() *cobra.Command {
 	}
 
 	helper.RegisterGRPCAddressFlag(txPoolCmd)
+	helper.RegisterGRPCCredentialsFlags(txPoolCmd)
 
 	registerSubcommands(txPoolCmd)
 
@@ -26,5 +28,7 @@ func registerSubcommands(baseCmd *cobra.Command) {
 		status.GetCommand(),
 		// txpool subscribe
 		subscribe.GetCommand(),
+		// txpool deny-list
+		denylist.GetCommand(),
 	)
 }
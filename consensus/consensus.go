@@ -3,14 +3,20 @@ package consensus
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgealert"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"google.golang.org/grpc"
@@ -78,6 +84,30 @@ type Params struct {
 	BlockTime      uint64
 
 	NumBlockConfirmations uint64
+
+	// SyncStallTimeout is the timeout of the chain head not advancing while the peers are ahead,
+	// after which the sync peer is rotated and the node resyncs, 0 disables the stall detection
+	SyncStallTimeout time.Duration
+
+	// SyncPipeline configures the block import pipeline of the bulk sync
+	SyncPipeline syncer.PipelineConfig
+
+	// RootchainJSONRPCEndpoints are the rootchain JSON-RPC endpoints used for tracking the rootchain events,
+	// in the order of preference. If empty, the endpoint from the bridge config is used
+	RootchainJSONRPCEndpoints []string
+
+	// BridgeAlert is the configuration of the alerts for the stuck or anomalous bridge messages
+	BridgeAlert *bridgealert.Config
+
+	// Extensions are the extensions loaded into the node, nil if there are none
+	Extensions *extension.Registry
+
+	// RootchainFeeBump is the fee management config of the rootchain transactions, nil if disabled
+	RootchainFeeBump *txrelayer.FeeBumpConfig
+
+	// Signer is the configuration of the external signer or Web3Signer the validator signing is delegated to,
+	// the validator keys are read from the secrets manager if neither is set
+	Signer wallet.SignerConfig
 }
 
 // Factory is the factory function to create a discovery consensus
@@ -90,4 +120,43 @@ type BridgeDataProvider interface {
 
 	// GetStateSyncProof retrieves the StateSync proof
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
+
+	// GetStateSyncTimeline retrieves the times the StateSync reached each of the bridge stages
+	GetStateSyncTimeline(stateSyncID uint64) (*types.BridgeMessageTimeline, error)
+}
+
+// FeeRecipientProvider is implemented by the consensus engines
+// which credit the fees of the blocks built by the node to a known address
+type FeeRecipientProvider interface {
+	// FeeRecipient returns the address credited with the fees of the blocks built by the node
+	FeeRecipient() (types.Address, error)
+}
+
+// TimeTravelProvider is implemented by the consensus engines of the dev networks,
+// which let the clients rewind the chain and control the block production
+type TimeTravelProvider interface {
+	// Snapshot saves the current chain head and returns the snapshot id
+	Snapshot() (uint64, error)
+
+	// Revert rewinds the chain to the given snapshot, returns false if the snapshot doesn't exist
+	Revert(id uint64) (bool, error)
+
+	// Mine seals a block immediately, at the given timestamp if it is set
+	Mine(timestamp uint64) error
+
+	// IncreaseTime moves the timestamps of the next blocks forward, returns the total time offset in seconds
+	IncreaseTime(seconds uint64) int64
+}
+
+// EpochValidatorsProvider is implemented by the consensus engines which elect the validator set per epoch
+type EpochValidatorsProvider interface {
+	// GetValidatorsByEpoch returns the validator set of the ended epoch, with the proofs of its membership
+	GetValidatorsByEpoch(epoch uint64) (*types.EpochValidators, error)
+}
+
+// ValidatorQueueProvider is implemented by the consensus engines which limit the validator set size
+// and keep the candidates which didn't get a slot queued by stake
+type ValidatorQueueProvider interface {
+	// GetValidatorQueue returns the candidates waiting for a slot in the validator set
+	GetValidatorQueue() (*types.ValidatorQueue, error)
 }
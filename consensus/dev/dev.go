@@ -2,6 +2,7 @@ package dev
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
@@ -17,7 +18,8 @@ const (
 	devConsensus = "dev-consensus"
 )
 
-// Dev consensus protocol seals any new transaction immediately
+// Dev consensus protocol seals any new transaction immediately in its own block,
+// or the pending transactions on every interval if the interval is set
 type Dev struct {
 	logger hclog.Logger
 
@@ -27,6 +29,14 @@ type Dev struct {
 	interval uint64
 	txpool   *txpool.TxPool
 
+	// sealLock serializes the block sealing, the chain rewinds and the time travel
+	sealLock sync.Mutex
+	// timeOffset is the offset (in seconds) of the block timestamps from the current time
+	timeOffset int64
+
+	snapshots      map[uint64]snapshot
+	lastSnapshotID uint64
+
 	blockchain *blockchain.Blockchain
 	executor   *state.Executor
 }
@@ -44,6 +54,7 @@ func Factory(
 		blockchain: params.Blockchain,
 		executor:   params.Executor,
 		txpool:     params.TxPool,
+		snapshots:  make(map[uint64]snapshot),
 	}
 
 	rawInterval, ok := params.Config.Config["interval"]
@@ -74,10 +85,6 @@ func (d *Dev) Start() error {
 }
 
 func (d *Dev) nextNotify() chan struct{} {
-	if d.interval == 0 {
-		d.interval = 1
-	}
-
 	go func() {
 		<-time.After(time.Duration(d.interval) * time.Second)
 		d.notifyCh <- struct{}{}
@@ -87,7 +94,13 @@ func (d *Dev) nextNotify() chan struct{} {
 }
 
 func (d *Dev) run() {
-	d.logger.Info("consensus started")
+	if d.isInstant() {
+		d.runInstant()
+
+		return
+	}
+
+	d.logger.Info("consensus started", "interval", d.interval)
 
 	for {
 		// wait until there is a new txn
@@ -98,30 +111,78 @@ func (d *Dev) run() {
 		}
 
 		// There are new transactions in the pool, try to seal them
-		header := d.blockchain.Header()
-		if err := d.writeNewBlock(header); err != nil {
+		if _, err := d.sealNextBlock(); err != nil {
 			d.logger.Error("failed to mine block", "err", err)
 		}
 	}
 }
 
+// sealNextBlock seals the block on top of the current head
+func (d *Dev) sealNextBlock() (bool, error) {
+	d.sealLock.Lock()
+	defer d.sealLock.Unlock()
+
+	return d.writeNewBlock(d.blockchain.Header(), false)
+}
+
+// isInstant returns true if every transaction is sealed in its own block as soon as it is promoted
+func (d *Dev) isInstant() bool {
+	return d.interval == 0
+}
+
+// runInstant seals a block per promoted transaction, until the pool is drained
+func (d *Dev) runInstant() {
+	d.logger.Info("consensus started", "sealing", "instant")
+
+	promotedCh, cancel := d.txpool.SubscribePromoted()
+	defer cancel()
+
+	for {
+		select {
+		case <-promotedCh:
+		case <-d.closeCh:
+			return
+		}
+
+		for {
+			sealed, err := d.sealNextBlock()
+			if err != nil {
+				d.logger.Error("failed to mine block", "err", err)
+
+				break
+			}
+
+			if !sealed {
+				break
+			}
+		}
+	}
+}
+
 type transitionInterface interface {
 	Write(txn *types.Transaction) error
 }
 
-func (d *Dev) writeTransactions(baseFee, gasLimit uint64, transition transitionInterface) []*types.Transaction {
+func (d *Dev) writeTransactions(
+	baseFee,
+	gasLimit uint64,
+	header *types.Header,
+	transition transitionInterface,
+	single bool,
+) []*types.Transaction {
 	var successful []*types.Transaction
 
-	d.txpool.Prepare(baseFee)
+	txPool := consensus.NewTxSelector(d.txpool, header, d.logger)
+	txPool.Prepare(baseFee)
 
 	for {
-		tx := d.txpool.Peek()
+		tx := txPool.Peek()
 		if tx == nil {
 			break
 		}
 
 		if tx.Gas > gasLimit {
-			d.txpool.Drop(tx)
+			txPool.Drop(tx)
 
 			continue
 		}
@@ -130,18 +191,22 @@ func (d *Dev) writeTransactions(baseFee, gasLimit uint64, transition transitionI
 			if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
 				break
 			} else if appErr, ok := err.(*state.TransitionApplicationError); ok && appErr.IsRecoverable { //nolint:errorlint
-				d.txpool.Demote(tx)
+				txPool.Demote(tx)
 			} else {
-				d.txpool.Drop(tx)
+				txPool.Drop(tx)
 			}
 
 			continue
 		}
 
 		// no errors, pop the tx from the pool
-		d.txpool.Pop(tx)
+		txPool.Pop(tx)
 
 		successful = append(successful, tx)
+
+		if single {
+			break
+		}
 	}
 
 	d.logger.Info("picked out txns from pool", "num", len(successful), "remaining", d.txpool.Length())
@@ -150,21 +215,22 @@ func (d *Dev) writeTransactions(baseFee, gasLimit uint64, transition transitionI
 }
 
 // writeNewBLock generates a new block based on transactions from the pool,
-// and writes them to the blockchain
-func (d *Dev) writeNewBlock(parent *types.Header) error {
+// and writes them to the blockchain. The empty blocks are not written in the instant sealing mode,
+// unless the block is mined on request. Returns true if the block was written
+func (d *Dev) writeNewBlock(parent *types.Header, mined bool) (bool, error) {
 	// Generate the base block
 	num := parent.Number
 	header := &types.Header{
 		ParentHash: parent.Hash,
 		Number:     num + 1,
 		GasLimit:   parent.GasLimit, // Inherit from parent for now, will need to adjust dynamically later.
-		Timestamp:  uint64(time.Now().UTC().Unix()),
+		Timestamp:  d.nextTimestamp(),
 	}
 
 	// calculate gas limit based on parent header
 	gasLimit, err := d.blockchain.CalculateGasLimit(header.Number)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	baseFee := d.blockchain.CalculateBaseFee(parent)
@@ -174,21 +240,27 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 
 	miner, err := d.GetBlockCreator(header)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	transition, err := d.executor.BeginTxn(parent.StateRoot, header, miner)
 
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	txns := d.writeTransactions(baseFee, gasLimit, transition)
+	// a single transaction per block in the instant sealing mode
+	instant := d.isInstant() && !mined
+
+	txns := d.writeTransactions(baseFee, gasLimit, header, transition, instant)
+	if len(txns) == 0 && instant {
+		return false, nil
+	}
 
 	// Commit the changes
 	_, root, err := transition.Commit()
 	if err != nil {
-		return fmt.Errorf("failed to commit the state changes: %w", err)
+		return false, fmt.Errorf("failed to commit the state changes: %w", err)
 	}
 
 	// Update the header
@@ -204,19 +276,19 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 	})
 
 	if _, err := d.blockchain.VerifyFinalizedBlock(block); err != nil {
-		return err
+		return false, err
 	}
 
 	// Write the block to the blockchain
 	if err := d.blockchain.WriteBlock(block, devConsensus); err != nil {
-		return err
+		return false, err
 	}
 
 	// after the block has been written we reset the txpool so that
 	// the old transactions are removed
 	d.txpool.ResetWithHeaders(block.Header)
 
-	return nil
+	return true, nil
 }
 
 // REQUIRED BASE INTERFACE METHODS //
@@ -234,6 +306,11 @@ func (d *Dev) GetBlockCreator(header *types.Header) (types.Address, error) {
 	return types.BytesToAddress(header.Miner), nil
 }
 
+// FeeRecipient returns the zero address, as the blocks built by the dev consensus have no miner
+func (d *Dev) FeeRecipient() (types.Address, error) {
+	return types.ZeroAddress, nil
+}
+
 // PreCommitState a hook to be called before finalizing state transition on inserting block
 func (d *Dev) PreCommitState(_ *types.Block, _ *state.Transition) error {
 	return nil
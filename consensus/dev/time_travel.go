@@ -0,0 +1,88 @@
+package dev
+
+import (
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// snapshot is the chain head and the time offset saved to be reverted to
+type snapshot struct {
+	head       types.Hash
+	timeOffset int64
+}
+
+// Snapshot saves the current chain head and time offset, and returns the snapshot id
+func (d *Dev) Snapshot() (uint64, error) {
+	d.sealLock.Lock()
+	defer d.sealLock.Unlock()
+
+	d.lastSnapshotID++
+	d.snapshots[d.lastSnapshotID] = snapshot{
+		head:       d.blockchain.Header().Hash,
+		timeOffset: d.timeOffset,
+	}
+
+	return d.lastSnapshotID, nil
+}
+
+// Revert rewinds the chain and the time offset to the given snapshot.
+// The snapshot and the ones taken after it are deleted. Returns false if the snapshot doesn't exist
+func (d *Dev) Revert(id uint64) (bool, error) {
+	d.sealLock.Lock()
+	defer d.sealLock.Unlock()
+
+	snap, ok := d.snapshots[id]
+	if !ok {
+		return false, nil
+	}
+
+	if err := d.blockchain.Rewind(snap.head); err != nil {
+		return false, err
+	}
+
+	// the pending transactions of the accounts ahead of the reverted state are dropped
+	d.txpool.Rewind()
+
+	d.timeOffset = snap.timeOffset
+
+	for snapID := range d.snapshots {
+		if snapID >= id {
+			delete(d.snapshots, snapID)
+		}
+	}
+
+	return true, nil
+}
+
+// Mine seals a block with the pending transactions immediately, even if there are none.
+// If the timestamp is set, the block is sealed at the timestamp and the next blocks follow it
+func (d *Dev) Mine(timestamp uint64) error {
+	d.sealLock.Lock()
+	defer d.sealLock.Unlock()
+
+	if timestamp != 0 {
+		d.timeOffset = int64(timestamp) - time.Now().UTC().Unix()
+	}
+
+	_, err := d.writeNewBlock(d.blockchain.Header(), true)
+
+	return err
+}
+
+// IncreaseTime moves the timestamps of the next blocks forward by the given number of seconds.
+// Returns the total time offset
+func (d *Dev) IncreaseTime(seconds uint64) int64 {
+	d.sealLock.Lock()
+	defer d.sealLock.Unlock()
+
+	d.timeOffset += int64(seconds)
+
+	return d.timeOffset
+}
+
+// nextTimestamp returns the timestamp of the next block, shifted by the time offset.
+// The seal lock is expected to be held
+func (d *Dev) nextTimestamp() uint64 {
+	return uint64(time.Now().UTC().Unix() + d.timeOffset)
+}
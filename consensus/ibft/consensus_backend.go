@@ -211,7 +211,7 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 		writeCtx,
 		baseFee,
 		gasLimit,
-		header.Number,
+		header,
 		transition,
 	)
 
@@ -298,13 +298,13 @@ type transitionInterface interface {
 func (i *backendIBFT) writeTransactions(
 	writeCtx context.Context,
 	baseFee,
-	gasLimit,
-	blockNumber uint64,
+	gasLimit uint64,
+	header *types.Header,
 	transition transitionInterface,
 ) (executed []*types.Transaction) {
 	executed = make([]*types.Transaction, 0)
 
-	if !i.currentHooks.ShouldWriteTransactions(blockNumber) {
+	if !i.currentHooks.ShouldWriteTransactions(header.Number) {
 		return
 	}
 
@@ -324,7 +324,8 @@ func (i *backendIBFT) writeTransactions(
 		)
 	}()
 
-	i.txpool.Prepare(baseFee)
+	txPool := consensus.NewTxSelector(i.txpool, header, i.logger)
+	txPool.Prepare(baseFee)
 
 write:
 	for {
@@ -334,7 +335,8 @@ write:
 		default:
 			// execute transactions one by one
 			result, ok := i.writeTransaction(
-				i.txpool.Peek(),
+				txPool,
+				txPool.Peek(),
 				transition,
 				gasLimit,
 			)
@@ -364,6 +366,7 @@ write:
 }
 
 func (i *backendIBFT) writeTransaction(
+	txPool consensus.TxSelectionPool,
 	tx *types.Transaction,
 	transition transitionInterface,
 	gasLimit uint64,
@@ -373,7 +376,7 @@ func (i *backendIBFT) writeTransaction(
 	}
 
 	if tx.Gas > gasLimit {
-		i.txpool.Drop(tx)
+		txPool.Drop(tx)
 
 		// continue processing
 		return &txExeResult{tx, fail}, true
@@ -384,17 +387,17 @@ func (i *backendIBFT) writeTransaction(
 			// stop processing
 			return nil, false
 		} else if appErr, ok := err.(*state.TransitionApplicationError); ok && appErr.IsRecoverable { //nolint:errorlint
-			i.txpool.Demote(tx)
+			txPool.Demote(tx)
 
 			return &txExeResult{tx, skip}, true
 		} else {
-			i.txpool.Drop(tx)
+			txPool.Drop(tx)
 
 			return &txExeResult{tx, fail}, true
 		}
 	}
 
-	i.txpool.Pop(tx)
+	txPool.Pop(tx)
 
 	return &txExeResult{tx, success}, true
 }
@@ -17,8 +17,9 @@ const (
 )
 
 var (
-	ErrUndefinedIBFTConfig = errors.New("IBFT config is not defined")
-	errInvalidBlockTime    = errors.New("invalid block time provided")
+	ErrUndefinedIBFTConfig      = errors.New("IBFT config is not defined")
+	errInvalidBlockTime         = errors.New("invalid block time provided")
+	errInvalidCommittedSealType = errors.New("BLS committed seals require BLS validators")
 )
 
 // IBFT Fork represents setting in params.engine.ibft of genesis.json
@@ -30,6 +31,14 @@ type IBFTFork struct {
 	To            *common.JSONNumber       `json:"to,omitempty"`
 	BlockTime     *common.Duration         `json:"blockTime,omitempty"`
 
+	// VersionedSeals enables writing the seal scheme version into IBFT Extra of the sealed headers
+	VersionedSeals bool `json:"versionedSeals,omitempty"`
+
+	// CommittedSealType is the type of the committed seals, the validator type if it's not set.
+	// The BLS validators create the ECDSA committed seals if it's ecdsa, so the following fork
+	// can switch the committed seals to the aggregated BLS signature and the bitmap of the validators
+	CommittedSealType validators.ValidatorType `json:"committedSealType,omitempty"`
+
 	// PoA
 	Validators validators.Validators `json:"validators,omitempty"`
 
@@ -49,6 +58,8 @@ func (f *IBFTFork) UnmarshalJSON(data []byte) error {
 		Validators        interface{}               `json:"validators,omitempty"`
 		MaxValidatorCount *common.JSONNumber        `json:"maxValidatorCount,omitempty"`
 		MinValidatorCount *common.JSONNumber        `json:"minValidatorCount,omitempty"`
+		VersionedSeals    bool                      `json:"versionedSeals,omitempty"`
+		CommittedSealType *string                   `json:"committedSealType,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &raw); err != nil {
@@ -62,12 +73,26 @@ func (f *IBFTFork) UnmarshalJSON(data []byte) error {
 	f.BlockTime = raw.BlockTime
 	f.MaxValidatorCount = raw.MaxValidatorCount
 	f.MinValidatorCount = raw.MinValidatorCount
+	f.VersionedSeals = raw.VersionedSeals
 
 	f.ValidatorType = validators.ECDSAValidatorType
 	if raw.ValidatorType != nil {
 		f.ValidatorType = *raw.ValidatorType
 	}
 
+	if raw.CommittedSealType != nil {
+		sealType, err := validators.ParseValidatorType(*raw.CommittedSealType)
+		if err != nil {
+			return err
+		}
+
+		if sealType == validators.BLSValidatorType && f.ValidatorType != validators.BLSValidatorType {
+			return errInvalidCommittedSealType
+		}
+
+		f.CommittedSealType = sealType
+	}
+
 	if raw.Validators == nil {
 		return nil
 	}
@@ -82,6 +107,15 @@ func (f *IBFTFork) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(validatorsBytes, f.Validators)
 }
 
+// committedSealType returns the type of the committed seals the fork creates
+func (f *IBFTFork) committedSealType() validators.ValidatorType {
+	if f.CommittedSealType == "" {
+		return f.ValidatorType
+	}
+
+	return f.CommittedSealType
+}
+
 // GetIBFTForks returns IBFT fork configurations from chain config
 func GetIBFTForks(ibftConfig map[string]interface{}) (IBFTForks, error) {
 	// no fork, only specifying IBFT type in chain config
@@ -39,6 +39,63 @@ func TestIBFTForkUnmarshalJSON(t *testing.T) {
 				MinValidatorCount: nil,
 			},
 		},
+		{
+			name: "should parse versioned seals",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"versionedSeals": true
+			}`, PoA, 0),
+			expected: &IBFTFork{
+				Type:           PoA,
+				ValidatorType:  validators.ECDSAValidatorType,
+				From:           common.JSONNumber{Value: 0},
+				VersionedSeals: true,
+			},
+		},
+		{
+			name: "should parse committed seal type",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"validator_type": "%s",
+				"from": %d,
+				"committedSealType": "%s"
+			}`, PoA, validators.BLSValidatorType, 0, validators.ECDSAValidatorType),
+			expected: &IBFTFork{
+				Type:              PoA,
+				ValidatorType:     validators.BLSValidatorType,
+				From:              common.JSONNumber{Value: 0},
+				CommittedSealType: validators.ECDSAValidatorType,
+			},
+		},
+		{
+			name: "should return error for invalid committed seal type",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"committedSealType": "rsa"
+			}`, PoA, 0),
+			expected: &IBFTFork{
+				Type:          PoA,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 0},
+			},
+			err: validators.ErrInvalidValidatorType,
+		},
+		{
+			name: "should return error for BLS committed seals of ECDSA validators",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"committedSealType": "%s"
+			}`, PoA, 0, validators.BLSValidatorType),
+			expected: &IBFTFork{
+				Type:          PoA,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 0},
+			},
+			err: errInvalidCommittedSealType,
+		},
 		{
 			name: "should parse without validators",
 			data: fmt.Sprintf(`{
@@ -139,6 +139,8 @@ func (m *ForkManager) GetSigner(height uint64) (signer.Signer, error) {
 		return nil, err
 	}
 
+	// ParentCommittedSeals are verified by the key manager of the parent height,
+	// since the fork may switch the committed seals between the ECDSA and BLS ones
 	var parentKeyManager signer.KeyManager
 
 	if height > 1 {
@@ -147,6 +149,13 @@ func (m *ForkManager) GetSigner(height uint64) (signer.Signer, error) {
 		}
 	}
 
+	if fork := m.forks.getFork(height); fork != nil && fork.VersionedSeals {
+		return signer.NewVersionedSigner(
+			keyManager,
+			parentKeyManager,
+		), nil
+	}
+
 	return signer.NewSigner(
 		keyManager,
 		parentKeyManager,
@@ -218,6 +227,12 @@ func (m *ForkManager) getKeyManager(height uint64) (signer.KeyManager, error) {
 		return nil, ErrKeyManagerNotFound
 	}
 
+	// the BLS validators seal the headers by the ECDSA keys until the fork switches to the BLS committed seals
+	if fork.ValidatorType == validators.BLSValidatorType &&
+		fork.committedSealType() == validators.ECDSAValidatorType {
+		return signer.NewECDSASealKeyManager(keyManager), nil
+	}
+
 	return keyManager, nil
 }
 
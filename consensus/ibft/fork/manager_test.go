@@ -17,6 +17,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/validators/store/snapshot"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockValidatorStore struct {
@@ -469,6 +470,47 @@ func TestForkManagerGetSigner(t *testing.T) {
 			height:         11,
 			expectedSigner: signer.NewSigner(blsKeyManager, ecdsaKeyManager),
 		},
+		{
+			name: "should return the versioned signer if the fork enables versioned seals",
+			forks: IBFTForks{
+				{
+					ValidatorType: validators.ECDSAValidatorType,
+					From:          common.JSONNumber{Value: 0},
+					To:            &common.JSONNumber{Value: 10},
+				},
+				{
+					ValidatorType:  validators.BLSValidatorType,
+					From:           common.JSONNumber{Value: 11},
+					VersionedSeals: true,
+				},
+			},
+			keyManagers: map[validators.ValidatorType]signer.KeyManager{
+				validators.ECDSAValidatorType: ecdsaKeyManager,
+				validators.BLSValidatorType:   blsKeyManager,
+			},
+			height:         11,
+			expectedSigner: signer.NewVersionedSigner(blsKeyManager, ecdsaKeyManager),
+		},
+		{
+			name: "should return the signer with ECDSA committed seals of BLS validators until the fork switches them",
+			forks: IBFTForks{
+				{
+					ValidatorType:     validators.BLSValidatorType,
+					CommittedSealType: validators.ECDSAValidatorType,
+					From:              common.JSONNumber{Value: 0},
+					To:                &common.JSONNumber{Value: 10},
+				},
+				{
+					ValidatorType: validators.BLSValidatorType,
+					From:          common.JSONNumber{Value: 11},
+				},
+			},
+			keyManagers: map[validators.ValidatorType]signer.KeyManager{
+				validators.BLSValidatorType: blsKeyManager,
+			},
+			height:         11,
+			expectedSigner: signer.NewSigner(blsKeyManager, signer.NewECDSASealKeyManager(blsKeyManager)),
+		},
 	}
 
 	for _, test := range tests {
@@ -499,6 +541,143 @@ func TestForkManagerGetSigner(t *testing.T) {
 	}
 }
 
+func TestForkManagerGetSigner_CommittedSealTypeSwitch(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numValidators = 4
+		forkHeight    = 10
+	)
+
+	// the committed seals of BLS validators switch from ECDSA to the aggregated BLS seal at the fork height
+	forks := IBFTForks{
+		{
+			Type:              PoA,
+			ValidatorType:     validators.BLSValidatorType,
+			CommittedSealType: validators.ECDSAValidatorType,
+			From:              common.JSONNumber{Value: 0},
+			To:                &common.JSONNumber{Value: forkHeight - 1},
+		},
+		{
+			Type:          PoA,
+			ValidatorType: validators.BLSValidatorType,
+			From:          common.JSONNumber{Value: forkHeight},
+		},
+	}
+
+	keyManagers := make([]signer.KeyManager, numValidators)
+	vals := validators.NewBLSValidatorSet()
+
+	for i := range keyManagers {
+		ecdsaKey, err := crypto.GenerateECDSAKey()
+		require.NoError(t, err)
+
+		blsKey, err := crypto.GenerateBLSKey()
+		require.NoError(t, err)
+
+		blsPubKey, err := crypto.BLSSecretKeyToPubkeyBytes(blsKey)
+		require.NoError(t, err)
+
+		keyManagers[i] = signer.NewBLSKeyManagerFromKeys(ecdsaKey, blsKey)
+		require.NoError(t, vals.Add(validators.NewBLSValidator(keyManagers[i].Address(), blsPubKey)))
+	}
+
+	getSigner := func(keyManager signer.KeyManager, height uint64) signer.Signer {
+		t.Helper()
+
+		fm := &ForkManager{
+			forks: forks,
+			keyManagers: map[validators.ValidatorType]signer.KeyManager{
+				validators.BLSValidatorType: keyManager,
+			},
+		}
+
+		signer, err := fm.GetSigner(height)
+		require.NoError(t, err)
+
+		return signer
+	}
+
+	// sealHeader creates the header on top of the parent, which is committed by all the validators
+	sealHeader := func(height uint64, parent *types.Header) *types.Header {
+		t.Helper()
+
+		proposer := getSigner(keyManagers[0], height)
+		header := &types.Header{Number: height}
+
+		var parentCommittedSeals signer.Seals
+
+		if parent != nil {
+			header.ParentHash = parent.Hash
+
+			parentExtra, err := getSigner(keyManagers[0], height-1).GetIBFTExtra(parent)
+			require.NoError(t, err)
+
+			parentCommittedSeals = parentExtra.CommittedSeals
+		}
+
+		proposer.InitIBFTExtra(header, vals, parentCommittedSeals)
+
+		hash, err := proposer.CalculateHeaderHash(header)
+		require.NoError(t, err)
+
+		header.Hash = hash
+
+		sealMap := make(map[types.Address][]byte, numValidators)
+
+		for _, keyManager := range keyManagers {
+			seal, err := getSigner(keyManager, height).CreateCommittedSeal(hash.Bytes())
+			require.NoError(t, err)
+			require.NoError(t, proposer.VerifyCommittedSeal(vals, keyManager.Address(), seal, hash.Bytes()))
+
+			sealMap[keyManager.Address()] = seal
+		}
+
+		header, err = proposer.WriteCommittedSeals(header, 0, sealMap)
+		require.NoError(t, err)
+
+		return header
+	}
+
+	var parent *types.Header
+
+	for height := uint64(forkHeight - 2); height <= forkHeight+1; height++ {
+		header := sealHeader(height, parent)
+
+		verifier := getSigner(keyManagers[1], height)
+
+		extra, err := verifier.GetIBFTExtra(header)
+		require.NoError(t, err)
+
+		if height < forkHeight {
+			assert.IsType(t, &signer.SerializedSeal{}, extra.CommittedSeals)
+		} else {
+			assert.IsType(t, &signer.AggregatedSeal{}, extra.CommittedSeals)
+		}
+
+		assert.NoError(t, verifier.VerifyCommittedSeals(header.Hash, extra.CommittedSeals, vals, numValidators))
+
+		if parent != nil {
+			// the parent committed seals are verified by the scheme of the parent height
+			assert.NoError(t, verifier.VerifyParentCommittedSeals(parent.Hash, header, vals, numValidators, true))
+		}
+
+		// the seals aren't valid under the scheme of the other side of the fork
+		otherSide := uint64(forkHeight)
+		if height >= forkHeight {
+			otherSide = forkHeight - 1
+		}
+
+		assert.ErrorIs(
+			t,
+			getSigner(keyManagers[1], otherSide).VerifyCommittedSeals(header.Hash, extra.CommittedSeals, vals, 1),
+			signer.ErrInvalidCommittedSealType,
+		)
+
+		parent = header
+	}
+}
+
 func TestForkManagerGetValidatorStore(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package ibft
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
@@ -90,7 +91,8 @@ type backendIBFT struct {
 	blockTime          time.Duration // Minimum block generation time in seconds
 
 	// Channels
-	closeCh chan struct{} // Channel for closing
+	closeCh     chan struct{}  // Channel for closing
+	consensusWg sync.WaitGroup // Wait group of the consensus loop, waited for on closing
 }
 
 // Factory implements the base consensus Factory method
@@ -148,7 +150,10 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 			params.Logger,
 			params.Network,
 			params.Blockchain,
+			params.TxPool,
 			time.Duration(params.BlockTime)*3*time.Second,
+			params.SyncStallTimeout,
+			params.SyncPipeline,
 		),
 		secretsManager: params.SecretsManager,
 		Grpc:           params.Grpc,
@@ -239,7 +244,13 @@ func (i *backendIBFT) Start() error {
 	go i.startSyncing()
 
 	// Start the actual consensus protocol
-	go i.startConsensus()
+	i.consensusWg.Add(1)
+
+	go func() {
+		defer i.consensusWg.Done()
+
+		i.startConsensus()
+	}()
 
 	return nil
 }
@@ -514,6 +525,9 @@ func (i *backendIBFT) IsLastOfEpoch(number uint64) bool {
 func (i *backendIBFT) Close() error {
 	close(i.closeCh)
 
+	// wait for the in-flight sequence to stop, so the block being committed is written completely
+	i.consensusWg.Wait()
+
 	if i.syncer != nil {
 		if err := i.syncer.Close(); err != nil {
 			return err
@@ -556,6 +570,16 @@ func (i *backendIBFT) FilterExtra(extra []byte) ([]byte, error) {
 	return extra, nil
 }
 
+// FeeRecipient returns the address of the node signer, which is credited with the fees of the blocks built by the node
+func (i *backendIBFT) FeeRecipient() (types.Address, error) {
+	signer, err := i.forkManager.GetSigner(i.blockchain.Header().Number + 1)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return signer.Address(), nil
+}
+
 // updateCurrentModules updates Signer, Hooks, and Validators
 // that are used at specified height
 // by fetching from ForkManager
@@ -50,6 +50,11 @@ func (s *BLSKeyManager) Type() validators.ValidatorType {
 	return validators.BLSValidatorType
 }
 
+// Scheme returns the version of the seal scheme KeyManager uses
+func (s *BLSKeyManager) Scheme() SealSchemeVersion {
+	return SealSchemeBLS
+}
+
 // Address returns the address of KeyManager
 func (s *BLSKeyManager) Address() types.Address {
 	return s.address
@@ -41,6 +41,11 @@ func (s *ECDSAKeyManager) Type() validators.ValidatorType {
 	return validators.ECDSAValidatorType
 }
 
+// Scheme returns the version of the seal scheme KeyManager uses
+func (s *ECDSAKeyManager) Scheme() SealSchemeVersion {
+	return SealSchemeECDSA
+}
+
 // Address returns the address of KeyManager
 func (s *ECDSAKeyManager) Address() types.Address {
 	return s.address
@@ -97,6 +102,11 @@ func (s *ECDSAKeyManager) GenerateCommittedSeals(
 	sealMap map[types.Address][]byte,
 	_ validators.Validators,
 ) (Seals, error) {
+	return generateSerializedSeals(sealMap)
+}
+
+// generateSerializedSeals creates SerializedSeal from the ECDSA committed seals
+func generateSerializedSeals(sealMap map[types.Address][]byte) (Seals, error) {
 	seals := [][]byte{}
 
 	for _, seal := range sealMap {
@@ -141,6 +151,16 @@ func (s *ECDSAKeyManager) verifyCommittedSealsImpl(
 	committedSeal *SerializedSeal,
 	msg []byte,
 	validators validators.Validators,
+) (int, error) {
+	return verifySerializedSeals(committedSeal, msg, validators)
+}
+
+// verifySerializedSeals verifies the ECDSA committed seals in SerializedSeal
+// and returns the number of the seals if all of them are signed by the validators
+func verifySerializedSeals(
+	committedSeal *SerializedSeal,
+	msg []byte,
+	validators validators.Validators,
 ) (int, error) {
 	numSeals := committedSeal.Num()
 	if numSeals == 0 {
@@ -150,7 +170,7 @@ func (s *ECDSAKeyManager) verifyCommittedSealsImpl(
 	visited := make(map[types.Address]bool)
 
 	for _, seal := range *committedSeal {
-		addr, err := s.Ecrecover(seal, msg)
+		addr, err := ecrecover(seal, msg)
 		if err != nil {
 			return 0, err
 		}
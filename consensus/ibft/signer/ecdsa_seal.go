@@ -0,0 +1,88 @@
+package signer
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+)
+
+// ECDSASealKeyManager is a module that wraps KeyManager of BLS validators
+// and creates the committed seals by the ECDSA key instead of the BLS key.
+// It's used by the forks whose validators have BLS keys already, but whose headers
+// are sealed by the ECDSA signatures, until the fork switches them to the aggregated BLS seal
+type ECDSASealKeyManager struct {
+	KeyManager
+}
+
+// NewECDSASealKeyManager initializes ECDSASealKeyManager by the given KeyManager of BLS validators
+func NewECDSASealKeyManager(keyManager KeyManager) KeyManager {
+	return &ECDSASealKeyManager{
+		KeyManager: keyManager,
+	}
+}
+
+// Scheme returns the version of the seal scheme KeyManager uses
+func (s *ECDSASealKeyManager) Scheme() SealSchemeVersion {
+	return SealSchemeECDSA
+}
+
+// NewEmptyCommittedSeals returns empty CommittedSeals ECDSASealKeyManager uses
+func (s *ECDSASealKeyManager) NewEmptyCommittedSeals() Seals {
+	return &SerializedSeal{}
+}
+
+// SignCommittedSeal signs the given message by the ECDSA key for committed seal,
+// the same way as for ProposerSeal
+func (s *ECDSASealKeyManager) SignCommittedSeal(message []byte) ([]byte, error) {
+	return s.KeyManager.SignProposerSeal(message)
+}
+
+// VerifyCommittedSeal verifies a committed seal
+func (s *ECDSASealKeyManager) VerifyCommittedSeal(
+	vals validators.Validators,
+	address types.Address,
+	signature []byte,
+	message []byte,
+) error {
+	if vals.Type() != s.Type() {
+		return ErrInvalidValidators
+	}
+
+	signer, err := s.Ecrecover(signature, message)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if address != signer {
+		return ErrSignerMismatch
+	}
+
+	if !vals.Includes(address) {
+		return ErrNonValidatorCommittedSeal
+	}
+
+	return nil
+}
+
+func (s *ECDSASealKeyManager) GenerateCommittedSeals(
+	sealMap map[types.Address][]byte,
+	_ validators.Validators,
+) (Seals, error) {
+	return generateSerializedSeals(sealMap)
+}
+
+func (s *ECDSASealKeyManager) VerifyCommittedSeals(
+	rawCommittedSeal Seals,
+	digest []byte,
+	vals validators.Validators,
+) (int, error) {
+	committedSeal, ok := rawCommittedSeal.(*SerializedSeal)
+	if !ok {
+		return 0, ErrInvalidCommittedSealType
+	}
+
+	if vals.Type() != s.Type() {
+		return 0, ErrInvalidValidators
+	}
+
+	return verifySerializedSeals(committedSeal, digest, vals)
+}
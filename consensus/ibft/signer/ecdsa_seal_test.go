@@ -0,0 +1,76 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestECDSASealKeyManager(t *testing.T) {
+	t.Parallel()
+
+	blsKeyManager1, ecdsaKey1, blsKey1 := newTestBLSKeyManager(t)
+	blsKeyManager2, _, blsKey2 := newTestBLSKeyManager(t)
+
+	keyManager1 := NewECDSASealKeyManager(blsKeyManager1)
+	keyManager2 := NewECDSASealKeyManager(blsKeyManager2)
+
+	blsPubKey1, err := crypto.BLSSecretKeyToPubkeyBytes(blsKey1)
+	assert.NoError(t, err)
+
+	blsPubKey2, err := crypto.BLSSecretKeyToPubkeyBytes(blsKey2)
+	assert.NoError(t, err)
+
+	vals := validators.NewBLSValidatorSet(
+		validators.NewBLSValidator(keyManager1.Address(), blsPubKey1),
+		validators.NewBLSValidator(keyManager2.Address(), blsPubKey2),
+	)
+
+	assert.Equal(t, validators.BLSValidatorType, keyManager1.Type())
+	assert.Equal(t, SealSchemeECDSA, keyManager1.Scheme())
+	assert.Equal(t, &SerializedSeal{}, keyManager1.NewEmptyCommittedSeals())
+
+	msg := crypto.Keccak256([]byte{0x1})
+
+	// the committed seal is the ECDSA signature
+	seal1, err := keyManager1.SignCommittedSeal(msg)
+	assert.NoError(t, err)
+
+	expectedSeal, err := crypto.Sign(ecdsaKey1, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSeal, seal1)
+
+	seal2, err := keyManager2.SignCommittedSeal(msg)
+	assert.NoError(t, err)
+
+	assert.NoError(t, keyManager1.VerifyCommittedSeal(vals, keyManager2.Address(), seal2, msg))
+	assert.ErrorIs(t, keyManager1.VerifyCommittedSeal(vals, keyManager1.Address(), seal2, msg), ErrSignerMismatch)
+	assert.ErrorIs(
+		t,
+		keyManager1.VerifyCommittedSeal(validators.NewECDSAValidatorSet(), keyManager2.Address(), seal2, msg),
+		ErrInvalidValidators,
+	)
+
+	seals, err := keyManager1.GenerateCommittedSeals(map[types.Address][]byte{
+		keyManager1.Address(): seal1,
+		keyManager2.Address(): seal2,
+	}, vals)
+	assert.NoError(t, err)
+	assert.IsType(t, &SerializedSeal{}, seals)
+
+	numSeals, err := keyManager2.VerifyCommittedSeals(seals, msg, vals)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, numSeals)
+
+	// the seals of the other scheme are rejected
+	_, err = keyManager2.VerifyCommittedSeals(&AggregatedSeal{}, msg, vals)
+	assert.ErrorIs(t, err, ErrInvalidCommittedSealType)
+
+	_, err = keyManager2.VerifyCommittedSeals(seals, msg, validators.NewBLSValidatorSet(
+		validators.NewBLSValidator(keyManager1.Address(), blsPubKey1),
+	))
+	assert.ErrorIs(t, err, ErrNonValidatorCommittedSeal)
+}
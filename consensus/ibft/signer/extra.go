@@ -24,6 +24,8 @@ var (
 	zeroBytes = make([]byte, 32)
 
 	errRoundNumberOverflow = errors.New("round number is out of range for 64bit")
+	errInvalidSealScheme   = errors.New("invalid seal scheme version")
+	errIncompleteEnvelope  = errors.New("incomplete extra envelope")
 )
 
 // IstanbulExtra defines the structure of the extra field for Istanbul
@@ -33,6 +35,16 @@ type IstanbulExtra struct {
 	CommittedSeals       Seals
 	ParentCommittedSeals Seals
 	RoundNumber          *uint64
+	// SealScheme is the version of the scheme CommittedSeals are created with.
+	// It is encoded only if it's set, in order to keep the encoding of the unversioned extras unchanged
+	SealScheme SealSchemeVersion
+	// Version is the version of the envelope, which carries the typed Fields.
+	// They are encoded only if the version is set, in order to keep the encoding of the legacy extras unchanged
+	Version ExtraVersion
+	Fields  []ExtraField
+	// Reserved are the RLP-encoded elements following the envelope, reserved for the future versions.
+	// They are kept, so the extra of the future version is re-encoded (and hashed) unchanged
+	Reserved [][]byte
 }
 
 type Seals interface {
@@ -62,6 +74,25 @@ func parseRound(v *fastrlp.Value) (*uint64, error) {
 	return &round, nil
 }
 
+// parseSealScheme parses RLP-encoded bytes into seal scheme version
+func parseSealScheme(v *fastrlp.Value) (SealSchemeVersion, error) {
+	schemeBytes, err := v.Bytes()
+	if err != nil {
+		return SealSchemeUnversioned, err
+	}
+
+	// the placeholder of the versioned extra
+	if len(schemeBytes) == 0 {
+		return SealSchemeUnversioned, nil
+	}
+
+	if len(schemeBytes) != 1 || schemeBytes[0] == byte(SealSchemeUnversioned) {
+		return SealSchemeUnversioned, errInvalidSealScheme
+	}
+
+	return SealSchemeVersion(schemeBytes[0]), nil
+}
+
 // toRoundBytes converts uint64 round to bytes
 // Round begins with zero and it can be nil for backward compatibility.
 // For that reason, Extra always has 8 bytes space for a round when the round has value.
@@ -109,6 +140,24 @@ func (i *IstanbulExtra) MarshalRLPWith(ar *fastrlp.Arena) *fastrlp.Value {
 		))
 	}
 
+	// SealScheme
+	if i.SealScheme != SealSchemeUnversioned {
+		vv.Set(ar.NewBytes([]byte{byte(i.SealScheme)}))
+	} else if i.Version != ExtraVersionLegacy {
+		// the placeholder for the envelope
+		vv.Set(ar.NewNull())
+	}
+
+	// Envelope
+	if i.Version != ExtraVersionLegacy {
+		vv.Set(ar.NewBytes([]byte{byte(i.Version)}))
+		vv.Set(marshalExtraFieldsWith(ar, i.Fields))
+
+		for _, raw := range i.Reserved {
+			vv.Set(rawValue(ar, raw))
+		}
+	}
+
 	return vv
 }
 
@@ -160,6 +209,37 @@ func (i *IstanbulExtra) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) er
 		i.RoundNumber = roundNumber
 	}
 
+	// The envelope consists of the version and the fields. The extra having only one of them
+	// can't be re-encoded unchanged, so it would be hashed the same as the extra without it
+	if len(elems) == 7 {
+		return errIncompleteEnvelope
+	}
+
+	// SealScheme
+	if len(elems) >= 6 {
+		if i.SealScheme, err = parseSealScheme(elems[5]); err != nil {
+			return err
+		}
+
+		// the placeholder is encoded only in front of the envelope
+		if i.SealScheme == SealSchemeUnversioned && len(elems) < 8 {
+			return errInvalidSealScheme
+		}
+	}
+
+	// Envelope, the elements following it are reserved for the future versions, and kept encoded
+	if len(elems) >= 8 {
+		if i.Version, err = parseExtraVersion(elems[6]); err != nil {
+			return err
+		}
+
+		if i.Fields, err = parseExtraFields(elems[7]); err != nil {
+			return err
+		}
+
+		i.Reserved = rawValues(elems[8:])
+	}
+
 	return nil
 }
 
@@ -283,6 +363,13 @@ func packProposerSealIntoExtra(
 				newArrayValue.Set(oldValues[4])
 			}
 
+			// SealScheme, envelope and the elements of the future versions
+			if len(oldValues) >= 6 {
+				for _, value := range oldValues[5:] {
+					newArrayValue.Set(value)
+				}
+			}
+
 			return nil
 		},
 	)
@@ -325,6 +412,13 @@ func packCommittedSealsAndRoundNumberIntoExtra(
 				))
 			}
 
+			// SealScheme, envelope and the elements of the future versions
+			if len(oldValues) >= 6 {
+				for _, value := range oldValues[5:] {
+					newArrayValue.Set(value)
+				}
+			}
+
 			return nil
 		},
 	)
@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
 	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/fastrlp"
 )
 
 func JSONMarshalHelper(t *testing.T, extra *IstanbulExtra) string {
@@ -93,6 +94,63 @@ func TestIstanbulExtraMarshalAndUnmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "BLSExtra with SealScheme",
+			extra: &IstanbulExtra{
+				Validators: validators.NewBLSValidatorSet(
+					blsValidator1,
+				),
+				ProposerSeal: testProposerSeal,
+				CommittedSeals: &AggregatedSeal{
+					Bitmap:    new(big.Int).SetBytes([]byte{0x8}),
+					Signature: []byte{0x1},
+				},
+				ParentCommittedSeals: &AggregatedSeal{
+					Bitmap:    new(big.Int).SetBytes([]byte{0x9}),
+					Signature: []byte{0x2},
+				},
+				SealScheme: SealSchemeBLS,
+			},
+		},
+		{
+			name: "ECDSAExtra with envelope",
+			extra: &IstanbulExtra{
+				Validators: validators.NewECDSAValidatorSet(
+					ecdsaValidator1,
+				),
+				ProposerSeal: testProposerSeal,
+				CommittedSeals: &SerializedSeal{
+					[]byte{0x1},
+				},
+				Version: ExtraVersion1,
+				Fields: []ExtraField{
+					{Type: 1, Payload: []byte{0x1, 0x2}},
+					{Type: 2, Payload: []byte{}},
+				},
+			},
+		},
+		{
+			name: "BLSExtra with SealScheme and envelope",
+			extra: &IstanbulExtra{
+				Validators: validators.NewBLSValidatorSet(
+					blsValidator1,
+				),
+				ProposerSeal: testProposerSeal,
+				CommittedSeals: &AggregatedSeal{
+					Bitmap:    new(big.Int).SetBytes([]byte{0x8}),
+					Signature: []byte{0x1},
+				},
+				ParentCommittedSeals: &AggregatedSeal{
+					Bitmap:    new(big.Int).SetBytes([]byte{0x9}),
+					Signature: []byte{0x2},
+				},
+				SealScheme: SealSchemeBLS,
+				Version:    ExtraVersion1,
+				Fields: []ExtraField{
+					{Type: 3, Payload: []byte{0x3}},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -118,6 +176,146 @@ func TestIstanbulExtraMarshalAndUnmarshal(t *testing.T) {
 	}
 }
 
+func TestIstanbulExtra_FutureVersion(t *testing.T) {
+	t.Parallel()
+
+	newExtra := func() *IstanbulExtra {
+		return &IstanbulExtra{
+			Validators:     validators.NewECDSAValidatorSet(),
+			ProposerSeal:   []byte{},
+			CommittedSeals: &SerializedSeal{},
+		}
+	}
+
+	// the extra of the future version has an unknown field with an element following its payload,
+	// and the elements following the envelope
+	extraBytes := types.MarshalRLPTo(func(ar *fastrlp.Arena) *fastrlp.Value {
+		vv := newExtra().MarshalRLPWith(ar)
+		vv.Set(ar.NewNull())
+		vv.Set(ar.NewBytes([]byte{0x2}))
+
+		field := ar.NewArray()
+		field.Set(ar.NewBytes([]byte{0x9}))
+		field.Set(ar.NewBytes([]byte{0x9}))
+		field.Set(ar.NewUint(7))
+
+		fields := ar.NewArray()
+		fields.Set(field)
+		vv.Set(fields)
+
+		future := ar.NewArray()
+		future.Set(ar.NewBytes([]byte{0x1}))
+		vv.Set(future)
+		vv.Set(ar.NewBytes([]byte("future element")))
+
+		return vv
+	}, nil)
+
+	extra := newExtra()
+	assert.NoError(t, extra.UnmarshalRLP(extraBytes))
+	assert.Equal(t, ExtraVersion(2), extra.Version)
+
+	payload, ok := extra.Field(9)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x9}, payload)
+
+	// the unknown fields and elements are kept on re-encoding
+	assert.Equal(t, extraBytes, extra.MarshalRLPTo(nil))
+
+	reencoded := newExtra()
+	assert.NoError(t, reencoded.UnmarshalRLP(extra.MarshalRLPTo(nil)))
+	assert.Equal(t, extra.Version, reencoded.Version)
+	assert.Equal(t, extra.Fields, reencoded.Fields)
+	assert.Equal(t, extra.Reserved, reencoded.Reserved)
+
+	// the packing keeps the envelope and the following elements
+	packed := packProposerSealIntoExtra(append(make([]byte, IstanbulExtraVanity), extraBytes...), []byte{0x1})
+	packed = packCommittedSealsAndRoundNumberIntoExtra(packed, &SerializedSeal{}, nil)
+	assert.Equal(t, extraBytes[len(extraBytes)-len("future element")-1:], packed[len(packed)-len("future element")-1:])
+
+	extra = newExtra()
+	assert.NoError(t, extra.UnmarshalRLP(packed[IstanbulExtraVanity:]))
+	assert.Equal(t, []byte{0x1}, extra.ProposerSeal)
+	assert.Equal(t, reencoded.Fields, extra.Fields)
+	assert.Equal(t, reencoded.Reserved, extra.Reserved)
+}
+
+func TestIstanbulExtra_UnmarshalIncompleteEnvelope(t *testing.T) {
+	t.Parallel()
+
+	newExtra := func() *IstanbulExtra {
+		return &IstanbulExtra{
+			Validators:     validators.NewECDSAValidatorSet(),
+			ProposerSeal:   []byte{},
+			CommittedSeals: &SerializedSeal{},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		elements func(ar *fastrlp.Arena, vv *fastrlp.Value)
+		err      error
+	}{
+		{
+			name: "placeholder without envelope",
+			elements: func(ar *fastrlp.Arena, vv *fastrlp.Value) {
+				vv.Set(ar.NewNull())
+			},
+			err: errInvalidSealScheme,
+		},
+		{
+			name: "placeholder and version without fields",
+			elements: func(ar *fastrlp.Arena, vv *fastrlp.Value) {
+				vv.Set(ar.NewNull())
+				vv.Set(ar.NewBytes([]byte{byte(ExtraVersion1)}))
+			},
+			err: errIncompleteEnvelope,
+		},
+		{
+			name: "seal scheme and version without fields",
+			elements: func(ar *fastrlp.Arena, vv *fastrlp.Value) {
+				vv.Set(ar.NewBytes([]byte{byte(SealSchemeECDSA)}))
+				vv.Set(ar.NewBytes([]byte{byte(ExtraVersion1)}))
+			},
+			err: errIncompleteEnvelope,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the elements following the round, which would be dropped on re-encoding
+			extraBytes := types.MarshalRLPTo(func(ar *fastrlp.Arena) *fastrlp.Value {
+				vv := newExtra().MarshalRLPWith(ar)
+				test.elements(ar, vv)
+
+				return vv
+			}, nil)
+
+			assert.ErrorIs(t, newExtra().UnmarshalRLP(extraBytes), test.err)
+		})
+	}
+}
+
+func TestIstanbulExtra_SetField(t *testing.T) {
+	t.Parallel()
+
+	extra := &IstanbulExtra{}
+
+	_, ok := extra.Field(1)
+	assert.False(t, ok)
+
+	extra.SetField(1, []byte{0x1})
+	extra.SetField(2, []byte{0x2})
+	extra.SetField(1, []byte{0x3})
+
+	assert.Equal(t, ExtraVersion1, extra.Version)
+	assert.Equal(t, []ExtraField{{Type: 1, Payload: []byte{0x3}}, {Type: 2, Payload: []byte{0x2}}}, extra.Fields)
+}
+
 func Test_packProposerSealIntoExtra(t *testing.T) {
 	t.Parallel()
 
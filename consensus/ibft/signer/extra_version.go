@@ -0,0 +1,168 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/umbracle/fastrlp"
+)
+
+// ExtraVersion is the version of the envelope of IBFT Extra
+type ExtraVersion uint8
+
+const (
+	// ExtraVersionLegacy is used by IBFT Extras without the envelope
+	ExtraVersionLegacy ExtraVersion = iota
+	// ExtraVersion1 is the envelope which carries the typed fields after the legacy fields
+	ExtraVersion1
+)
+
+// ExtraFieldType identifies the payload of the typed field in IBFT Extra.
+// The types are assigned by the consensus features which add the data to the header
+type ExtraFieldType uint8
+
+// ExtraField is the typed field of the versioned IBFT Extra. The fields are opaque to the nodes
+// which don't know their types, but they are kept, so the extra is re-encoded (and hashed) unchanged
+type ExtraField struct {
+	Type    ExtraFieldType
+	Payload []byte
+	// Reserved are the RLP-encoded elements of the pair following the payload,
+	// reserved for the future versions and kept unchanged
+	Reserved [][]byte
+}
+
+// Field returns the payload of the field of the given type
+func (i *IstanbulExtra) Field(fieldType ExtraFieldType) ([]byte, bool) {
+	for _, field := range i.Fields {
+		if field.Type == fieldType {
+			return field.Payload, true
+		}
+	}
+
+	return nil, false
+}
+
+// SetField sets the payload of the field of the given type, the extra is upgraded to the versioned envelope
+func (i *IstanbulExtra) SetField(fieldType ExtraFieldType, payload []byte) {
+	if i.Version == ExtraVersionLegacy {
+		i.Version = ExtraVersion1
+	}
+
+	for idx, field := range i.Fields {
+		if field.Type == fieldType {
+			i.Fields[idx].Payload = payload
+
+			return
+		}
+	}
+
+	i.Fields = append(i.Fields, ExtraField{Type: fieldType, Payload: payload})
+}
+
+// marshalExtraFieldsWith encodes the typed fields as the list of [type, payload] pairs
+func marshalExtraFieldsWith(ar *fastrlp.Arena, fields []ExtraField) *fastrlp.Value {
+	if len(fields) == 0 {
+		return ar.NewNullArray()
+	}
+
+	vv := ar.NewArray()
+
+	for _, field := range fields {
+		fv := ar.NewArray()
+		fv.Set(ar.NewBytes([]byte{byte(field.Type)}))
+		fv.Set(ar.NewCopyBytes(field.Payload))
+
+		for _, raw := range field.Reserved {
+			fv.Set(rawValue(ar, raw))
+		}
+
+		vv.Set(fv)
+	}
+
+	return vv
+}
+
+// parseExtraVersion parses RLP-encoded bytes into extra version. The versions unknown to this node
+// are accepted, since the envelope is decoded in the same way regardless of the version
+func parseExtraVersion(v *fastrlp.Value) (ExtraVersion, error) {
+	versionBytes, err := v.Bytes()
+	if err != nil {
+		return ExtraVersionLegacy, err
+	}
+
+	if len(versionBytes) != 1 || versionBytes[0] == byte(ExtraVersionLegacy) {
+		return ExtraVersionLegacy, fmt.Errorf("invalid extra version %x", versionBytes)
+	}
+
+	return ExtraVersion(versionBytes[0]), nil
+}
+
+// parseExtraFields parses RLP-encoded list of [type, payload] pairs into typed fields.
+// The elements of the pairs following the payload are reserved for the future use, and kept encoded
+func parseExtraFields(v *fastrlp.Value) ([]ExtraField, error) {
+	elems, err := v.GetElems()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elems) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]ExtraField, len(elems))
+
+	for idx, elem := range elems {
+		pair, err := elem.GetElems()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pair) < 2 {
+			return nil, fmt.Errorf("incorrect number of elements to decode extra field, expected 2 but found %d", len(pair))
+		}
+
+		typeBytes, err := pair[0].Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(typeBytes) != 1 {
+			return nil, fmt.Errorf("invalid extra field type %x", typeBytes)
+		}
+
+		fields[idx].Type = ExtraFieldType(typeBytes[0])
+
+		if fields[idx].Payload, err = pair[1].GetBytes([]byte{}); err != nil {
+			return nil, err
+		}
+
+		fields[idx].Reserved = rawValues(pair[2:])
+	}
+
+	return fields, nil
+}
+
+// rawValues returns the RLP encoding of the values, nil if there are none
+func rawValues(values []*fastrlp.Value) [][]byte {
+	if len(values) == 0 {
+		return nil
+	}
+
+	raws := make([][]byte, len(values))
+	for idx, v := range values {
+		raws[idx] = v.MarshalTo(nil)
+	}
+
+	return raws
+}
+
+// rawValue decodes the RLP-encoded value, so it's encoded back unchanged. The value which isn't
+// valid RLP (it's always valid if it was decoded) is encoded as bytes
+func rawValue(ar *fastrlp.Arena, raw []byte) *fastrlp.Value {
+	// every value needs its own parser, since the parser reuses the values on the next parsing
+	v, err := (&fastrlp.Parser{}).Parse(raw)
+	if err != nil {
+		return ar.NewCopyBytes(raw)
+	}
+
+	return v
+}
@@ -29,6 +29,7 @@ func (m *MockSecretManager) SetSecret(name string, key []byte) error {
 
 type MockKeyManager struct {
 	TypeFunc                   func() validators.ValidatorType
+	SchemeFunc                 func() SealSchemeVersion
 	AddressFunc                func() types.Address
 	NewEmptyValidatorsFunc     func() validators.Validators
 	NewEmptyCommittedSealsFunc func() Seals
@@ -45,6 +46,10 @@ func (m *MockKeyManager) Type() validators.ValidatorType {
 	return m.TypeFunc()
 }
 
+func (m *MockKeyManager) Scheme() SealSchemeVersion {
+	return m.SchemeFunc()
+}
+
 func (m *MockKeyManager) Address() types.Address {
 	return m.AddressFunc()
 }
@@ -0,0 +1,53 @@
+package signer
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+)
+
+// SealSchemeVersion identifies the signature scheme of the seals in IBFT Extra
+type SealSchemeVersion uint8
+
+const (
+	// SealSchemeUnversioned is used by the headers whose IBFT Extra doesn't carry the scheme version,
+	// in that case the scheme is determined by the validator type of the fork
+	SealSchemeUnversioned SealSchemeVersion = iota
+	// SealSchemeECDSA is the scheme in which each validator's committed seal is an ECDSA signature
+	SealSchemeECDSA
+	// SealSchemeBLS is the scheme in which the committed seals are aggregated into a single BLS signature
+	SealSchemeBLS
+)
+
+// String returns the name of the seal scheme
+func (v SealSchemeVersion) String() string {
+	switch v {
+	case SealSchemeUnversioned:
+		return "unversioned"
+	case SealSchemeECDSA:
+		return "ecdsa"
+	case SealSchemeBLS:
+		return "bls"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(v))
+	}
+}
+
+// SealScheme is the signature scheme used to create and verify the seals of the header
+type SealScheme interface {
+	// Scheme returns the version of the scheme written into IBFT Extra
+	Scheme() SealSchemeVersion
+	// NewEmptyCommittedSeals creates empty committed seals the Signer expects
+	NewEmptyCommittedSeals() Seals
+	// SignProposerSeal creates a signature for ProposerSeal
+	SignProposerSeal(hash []byte) ([]byte, error)
+	// SignCommittedSeal creates a signature for committed seal
+	SignCommittedSeal(hash []byte) ([]byte, error)
+	// VerifyCommittedSeal verifies a committed seal
+	VerifyCommittedSeal(vals validators.Validators, signer types.Address, sig, hash []byte) error
+	// GenerateCommittedSeals creates CommittedSeals from committed seals
+	GenerateCommittedSeals(sealsByValidator map[types.Address][]byte, vals validators.Validators) (Seals, error)
+	// VerifyCommittedSeals verifies CommittedSeals
+	VerifyCommittedSeals(seals Seals, hash []byte, vals validators.Validators) (int, error)
+}
@@ -2,6 +2,7 @@ package signer
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -21,6 +22,7 @@ var (
 	ErrInvalidValidators          = errors.New("invalid validators type")
 	ErrInvalidValidator           = errors.New("invalid validator type")
 	ErrInvalidSignature           = errors.New("invalid signature")
+	ErrSealSchemeMismatch         = errors.New("seal scheme in IBFT Extra doesn't match the fork")
 )
 
 // Signer is responsible for signing for blocks and messages in IBFT
@@ -76,6 +78,9 @@ type Signer interface {
 type SignerImpl struct {
 	keyManager       KeyManager
 	parentKeyManager KeyManager
+
+	// versionedSeals is the flag indicating the seal scheme version is written into IBFT Extra
+	versionedSeals bool
 }
 
 // NewSigner is a constructor of SignerImpl
@@ -89,6 +94,19 @@ func NewSigner(
 	}
 }
 
+// NewVersionedSigner is a constructor of SignerImpl which writes the seal scheme version
+// into IBFT Extra of the sealed headers and rejects the headers sealed with a different scheme
+func NewVersionedSigner(
+	keyManager KeyManager,
+	parentKeyManager KeyManager,
+) *SignerImpl {
+	return &SignerImpl{
+		keyManager:       keyManager,
+		parentKeyManager: parentKeyManager,
+		versionedSeals:   true,
+	}
+}
+
 // Type returns that validator type the signer expects
 func (s *SignerImpl) Type() validators.ValidatorType {
 	return s.keyManager.Type()
@@ -110,6 +128,9 @@ func (s *SignerImpl) InitIBFTExtra(
 		header,
 		validators,
 		parentCommittedSeals,
+		ExtraVersionLegacy,
+		nil,
+		nil,
 	)
 }
 
@@ -134,9 +155,28 @@ func (s *SignerImpl) GetIBFTExtra(header *types.Header) (*IstanbulExtra, error)
 		return nil, err
 	}
 
+	if extra.SealScheme != s.sealScheme(header) {
+		return nil, fmt.Errorf(
+			"%w: expected %s but found %s",
+			ErrSealSchemeMismatch,
+			s.sealScheme(header),
+			extra.SealScheme,
+		)
+	}
+
 	return extra, nil
 }
 
+// sealScheme returns the seal scheme version expected in IBFT Extra of the given header.
+// Genesis header is not sealed, so it never carries the version
+func (s *SignerImpl) sealScheme(header *types.Header) SealSchemeVersion {
+	if !s.versionedSeals || header.Number == 0 {
+		return SealSchemeUnversioned
+	}
+
+	return s.keyManager.Scheme()
+}
+
 // WriteProposerSeal signs and set ProposerSeal into IBFT Extra of the header
 func (s *SignerImpl) WriteProposerSeal(header *types.Header) (*types.Header, error) {
 	hash, err := s.CalculateHeaderHash(header)
@@ -282,7 +322,7 @@ func (s *SignerImpl) VerifyParentCommittedSeals(
 		wrapCommitHash(parentHash[:]),
 	)
 
-	numSeals, err := s.keyManager.VerifyCommittedSeals(
+	numSeals, err := s.parentSealScheme().VerifyCommittedSeals(
 		parentCommittedSeals,
 		rawMsg,
 		parentValidators,
@@ -313,12 +353,19 @@ func (s *SignerImpl) initIbftExtra(
 	header *types.Header,
 	validators validators.Validators,
 	parentCommittedSeal Seals,
+	version ExtraVersion,
+	fields []ExtraField,
+	reserved [][]byte,
 ) {
 	putIbftExtra(header, &IstanbulExtra{
 		Validators:           validators,
 		ProposerSeal:         []byte{},
 		CommittedSeals:       s.keyManager.NewEmptyCommittedSeals(),
 		ParentCommittedSeals: parentCommittedSeal,
+		SealScheme:           s.sealScheme(header),
+		Version:              version,
+		Fields:               fields,
+		Reserved:             reserved,
 	})
 }
 
@@ -349,7 +396,7 @@ func (s *SignerImpl) GetParentCommittedSeals(header *types.Header) (Seals, error
 
 	data := header.ExtraData[IstanbulExtraVanity:]
 	extra := &IstanbulExtra{
-		ParentCommittedSeals: s.keyManager.NewEmptyCommittedSeals(),
+		ParentCommittedSeals: s.parentSealScheme().NewEmptyCommittedSeals(),
 	}
 
 	if err := extra.unmarshalRLPForParentCS(data); err != nil {
@@ -359,6 +406,16 @@ func (s *SignerImpl) GetParentCommittedSeals(header *types.Header) (Seals, error
 	return extra.ParentCommittedSeals, nil
 }
 
+// parentSealScheme returns the seal scheme ParentCommittedSeals are created with,
+// which differs from the scheme of the header at the fork switching the committed seals
+func (s *SignerImpl) parentSealScheme() SealScheme {
+	if s.parentKeyManager == nil {
+		return s.keyManager
+	}
+
+	return s.parentKeyManager
+}
+
 // filterHeaderForHash removes unnecessary fields from IBFT Extra of the header
 // for hash calculation
 func (s *SignerImpl) FilterHeaderForHash(header *types.Header) (*types.Header, error) {
@@ -377,8 +434,9 @@ func (s *SignerImpl) FilterHeaderForHash(header *types.Header) (*types.Header, e
 	}
 
 	// This will effectively remove the Seal and CommittedSeals from the IBFT Extra of header,
-	// while keeping proposer vanity, validator set, and ParentCommittedSeals
-	s.initIbftExtra(clone, extra.Validators, parentCommittedSeals)
+	// while keeping proposer vanity, validator set, ParentCommittedSeals, the typed fields
+	// and the elements of the future versions, including the ones unknown to this node
+	s.initIbftExtra(clone, extra.Validators, parentCommittedSeals, extra.Version, extra.Fields, extra.Reserved)
 
 	return clone, nil
 }
@@ -1271,3 +1271,117 @@ func TestSignerSignIBFTMessageAndEcrecoverFromIBFTMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestSignerVersionedSealScheme(t *testing.T) {
+	t.Parallel()
+
+	newKeyManager := func() *MockKeyManager {
+		return &MockKeyManager{
+			NewEmptyValidatorsFunc: func() validators.Validators {
+				return ecdsaValidators
+			},
+			NewEmptyCommittedSealsFunc: func() Seals {
+				return &SerializedSeal{}
+			},
+			SchemeFunc: func() SealSchemeVersion {
+				return SealSchemeECDSA
+			},
+		}
+	}
+
+	versionedSigner := NewVersionedSigner(newKeyManager(), nil)
+	unversionedSigner := NewSigner(newKeyManager(), nil)
+
+	t.Run("should write and read the seal scheme", func(t *testing.T) {
+		t.Parallel()
+
+		header := &types.Header{Number: 1}
+
+		versionedSigner.InitIBFTExtra(header, ecdsaValidators, nil)
+
+		extra, err := versionedSigner.GetIBFTExtra(header)
+
+		assert.NoError(t, err)
+		assert.Equal(t, SealSchemeECDSA, extra.SealScheme)
+	})
+
+	t.Run("should not write the seal scheme into genesis", func(t *testing.T) {
+		t.Parallel()
+
+		header := &types.Header{Number: 0}
+
+		versionedSigner.InitIBFTExtra(header, ecdsaValidators, nil)
+
+		extra, err := unversionedSigner.GetIBFTExtra(header)
+
+		assert.NoError(t, err)
+		assert.Equal(t, SealSchemeUnversioned, extra.SealScheme)
+	})
+
+	t.Run("should return error if the seal scheme is not expected", func(t *testing.T) {
+		t.Parallel()
+
+		header := &types.Header{Number: 1}
+
+		versionedSigner.InitIBFTExtra(header, ecdsaValidators, nil)
+
+		extra, err := unversionedSigner.GetIBFTExtra(header)
+
+		assert.Nil(t, extra)
+		assert.ErrorIs(t, err, ErrSealSchemeMismatch)
+	})
+
+	t.Run("should return error if the seal scheme is missing", func(t *testing.T) {
+		t.Parallel()
+
+		header := &types.Header{Number: 1}
+
+		unversionedSigner.InitIBFTExtra(header, ecdsaValidators, nil)
+
+		extra, err := versionedSigner.GetIBFTExtra(header)
+
+		assert.Nil(t, extra)
+		assert.ErrorIs(t, err, ErrSealSchemeMismatch)
+	})
+}
+
+func TestSignerFilterHeaderForHash_FutureVersion(t *testing.T) {
+	t.Parallel()
+
+	signer := NewSigner(&MockKeyManager{
+		NewEmptyValidatorsFunc: func() validators.Validators {
+			return validators.NewECDSAValidatorSet()
+		},
+		NewEmptyCommittedSealsFunc: func() Seals {
+			return &SerializedSeal{}
+		},
+	}, nil)
+
+	extra := &IstanbulExtra{
+		Validators:           ecdsaValidators,
+		ProposerSeal:         testProposerSeal,
+		CommittedSeals:       testSerializedSeals1,
+		ParentCommittedSeals: testSerializedSeals2,
+		Version:              ExtraVersion(2),
+		Fields: []ExtraField{
+			{Type: 9, Payload: []byte{0x9}, Reserved: [][]byte{{0x7}}},
+		},
+		Reserved: [][]byte{{0xc1, 0x1}, {0x82, 0x1, 0x2}},
+	}
+
+	header := &types.Header{Number: 1}
+	putIbftExtra(header, extra)
+
+	filtered, err := signer.FilterHeaderForHash(header)
+	assert.NoError(t, err)
+
+	filteredExtra, err := signer.GetIBFTExtra(filtered)
+	assert.NoError(t, err)
+
+	// the seals are removed, while the fields and the elements of the future version are kept
+	assert.Equal(t, 0, filteredExtra.CommittedSeals.Num())
+	assert.Empty(t, filteredExtra.ProposerSeal)
+	assert.Equal(t, extra.Version, filteredExtra.Version)
+	assert.Equal(t, extra.Fields, filteredExtra.Fields)
+	assert.Equal(t, extra.Reserved, filteredExtra.Reserved)
+}
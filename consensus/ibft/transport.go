@@ -2,6 +2,7 @@ package ibft
 
 import (
 	"github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -33,6 +34,8 @@ func (i *backendIBFT) setupTransport() error {
 		return err
 	}
 
+	filter := consensus.NewMessageFilter(consensus.MessageHeightWindow, consensus.MessageRoundWindow)
+
 	// Subscribe to the newly created topic
 	if err := topic.Subscribe(
 		func(obj interface{}, _ peer.ID) {
@@ -47,6 +50,11 @@ func (i *backendIBFT) setupTransport() error {
 				return
 			}
 
+			// the replayed and far-future messages are dropped before their signatures are verified
+			if !filter.Accept(msg, i.blockchain.Header().Number) {
+				return
+			}
+
 			i.consensus.AddMessage(msg)
 
 			i.logger.Debug(
@@ -0,0 +1,105 @@
+package consensus
+
+import (
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// messageFilterCacheSize is the maximum number of the message digests kept by the dedup cache
+	messageFilterCacheSize = 8192
+
+	// MessageHeightWindow is the number of the heights following the height in progress
+	// whose messages are accepted
+	MessageHeightWindow = 10
+
+	// MessageRoundWindow is the highest accepted message round. The round timeout doubles with each round,
+	// so even with a one second base timeout the rounds above the window are unreachable in practice
+	MessageRoundWindow = 24
+)
+
+// message drop reasons, reported as the metric labels
+const (
+	dropReasonDuplicate = "duplicate"
+	dropReasonStale     = "stale"
+	dropReasonFuture    = "future"
+	dropReasonMalformed = "malformed"
+)
+
+// MessageFilter drops the gossiped consensus messages which don't need to be processed,
+// before their signatures are verified: the replayed messages, the messages of the finalized heights,
+// and the messages too far ahead of the height in progress
+type MessageFilter struct {
+	seen         *lru.Cache
+	heightWindow uint64
+	roundWindow  uint64
+}
+
+// NewMessageFilter creates a new message filter, with the given height and round windows
+func NewMessageFilter(heightWindow, roundWindow uint64) *MessageFilter {
+	// lru.New only fails for a non-positive size
+	seen, _ := lru.New(messageFilterCacheSize)
+
+	return &MessageFilter{
+		seen:         seen,
+		heightWindow: heightWindow,
+		roundWindow:  roundWindow,
+	}
+}
+
+// Accept returns true if the message should be processed by the consensus,
+// given the number of the latest block (the height in progress is the following one)
+func (f *MessageFilter) Accept(msg *proto.Message, latestHeight uint64) bool {
+	reason := f.dropReason(msg, latestHeight)
+	if reason == "" {
+		return true
+	}
+
+	metrics.IncrCounterWithLabels([]string{"consensus", "dropped_messages"}, 1,
+		[]metrics.Label{{Name: "reason", Value: reason}})
+
+	return false
+}
+
+// dropReason returns the reason the message is dropped for, empty if the message is accepted
+func (f *MessageFilter) dropReason(msg *proto.Message, latestHeight uint64) string {
+	view := msg.GetView()
+	if view == nil {
+		return dropReasonMalformed
+	}
+
+	if view.Height <= latestHeight {
+		return dropReasonStale
+	}
+
+	if view.Height > latestHeight+1+f.heightWindow || view.Round > f.roundWindow {
+		return dropReasonFuture
+	}
+
+	// the windows are checked first, so the digests of the rejected messages don't evict the others
+	digest, err := messageDigest(msg)
+	if err != nil {
+		return dropReasonMalformed
+	}
+
+	if seen, _ := f.seen.ContainsOrAdd(digest, struct{}{}); seen {
+		return dropReasonDuplicate
+	}
+
+	return ""
+}
+
+// messageDigest returns the hash of the whole message, so a modified copy
+// of the message can't prevent the original one from being processed
+func messageDigest(msg *proto.Message) (types.Hash, error) {
+	raw, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return types.BytesToHash(crypto.Keccak256(raw)), nil
+}
@@ -0,0 +1,70 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMessage(height, round uint64, signature byte) *proto.Message {
+	return &proto.Message{
+		View:      &proto.View{Height: height, Round: round},
+		From:      []byte{0x1},
+		Type:      proto.MessageType_PREPARE,
+		Signature: []byte{signature},
+		Payload: &proto.Message_PrepareData{
+			PrepareData: &proto.PrepareMessage{ProposalHash: []byte{0x2}},
+		},
+	}
+}
+
+func TestMessageFilter_Accept(t *testing.T) {
+	t.Parallel()
+
+	const latestHeight = 10
+
+	cases := []struct {
+		name     string
+		msg      *proto.Message
+		accepted bool
+	}{
+		{"height in progress", newTestMessage(11, 0, 1), true},
+		{"last height of the window", newTestMessage(16, 0, 1), true},
+		{"last round of the window", newTestMessage(11, 3, 1), true},
+		{"finalized height", newTestMessage(10, 0, 1), false},
+		{"far-future height", newTestMessage(17, 0, 1), false},
+		{"far-future round", newTestMessage(11, 4, 1), false},
+		{"missing view", &proto.Message{From: []byte{0x1}}, false},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := NewMessageFilter(5, 3)
+
+			require.Equal(t, c.accepted, filter.Accept(c.msg, latestHeight))
+		})
+	}
+}
+
+func TestMessageFilter_Duplicates(t *testing.T) {
+	t.Parallel()
+
+	filter := NewMessageFilter(MessageHeightWindow, MessageRoundWindow)
+
+	require.True(t, filter.Accept(newTestMessage(11, 0, 1), 10))
+
+	// the replayed message is dropped
+	require.False(t, filter.Accept(newTestMessage(11, 0, 1), 10))
+
+	// the modified copy doesn't shadow the original message
+	require.True(t, filter.Accept(newTestMessage(11, 0, 2), 10))
+
+	// the rejected messages are not recorded
+	require.False(t, filter.Accept(newTestMessage(12, 0, 3), 12))
+	require.True(t, filter.Accept(newTestMessage(12, 0, 3), 11))
+}
@@ -158,18 +158,19 @@ func (b *BlockBuilder) WriteTx(tx *types.Transaction) error {
 // Fill fills the block with transactions from the txpool
 func (b *BlockBuilder) Fill() {
 	blockTimer := time.NewTimer(b.params.BlockTime)
+	txPool := consensus.NewTxSelector(b.params.TxPool, b.header, b.params.Logger)
 
-	b.params.TxPool.Prepare(b.params.BaseFee)
+	txPool.Prepare(b.params.BaseFee)
 write:
 	for {
 		select {
 		case <-blockTimer.C:
 			return
 		default:
-			tx := b.params.TxPool.Peek()
+			tx := txPool.Peek()
 
 			// execute transactions one by one
-			finished, err := b.writeTxPoolTransaction(tx)
+			finished, err := b.writeTxPoolTransaction(txPool, tx)
 			if err != nil {
 				b.params.Logger.Debug("Fill transaction error", "hash", tx.Hash, "err", err)
 			}
@@ -189,7 +190,10 @@ func (b *BlockBuilder) Receipts() []*types.Receipt {
 	return b.state.Receipts()
 }
 
-func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, error) {
+func (b *BlockBuilder) writeTxPoolTransaction(
+	txPool consensus.TxSelectionPool,
+	tx *types.Transaction,
+) (bool, error) {
 	if tx == nil {
 		return true, nil
 	}
@@ -199,18 +203,18 @@ func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, erro
 			// stop processing
 			return true, err
 		} else if appErr, ok := err.(*state.TransitionApplicationError); ok && appErr.IsRecoverable { //nolint:errorlint
-			b.params.TxPool.Demote(tx)
+			txPool.Demote(tx)
 
 			return false, err
 		} else {
-			b.params.TxPool.Drop(tx)
+			txPool.Drop(tx)
 
 			return false, err
 		}
 	}
 
 	// remove tx from the pool and add it to the list of all block transactions
-	b.params.TxPool.Pop(tx)
+	txPool.Pop(tx)
 
 	return false, nil
 }
@@ -0,0 +1,409 @@
+package bridgealert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// DefaultCheckInterval is the default interval between two evaluations of the alerting rules
+	DefaultCheckInterval = 30 * time.Second
+
+	// webhookTimeout is the timeout of a single webhook request
+	webhookTimeout = 10 * time.Second
+
+	bridgeMetricsPrefix = "bridge"
+)
+
+// Rule is the name of the alerting rule
+type Rule string
+
+const (
+	// RulePendingTimeout fires when the message is not committed for longer than the pending threshold
+	RulePendingTimeout Rule = "pending_timeout"
+
+	// RuleSignatureStall fires when the commitment of the message doesn't get a new signature
+	// for longer than the signature stall threshold, while it has no quorum
+	RuleSignatureStall Rule = "signature_stall"
+
+	// RuleRepeatedDemotions fires when the commitment of the message is discarded
+	// at least max demotions times, without the message being committed
+	RuleRepeatedDemotions Rule = "repeated_demotions"
+)
+
+var (
+	errInvalidWebhook       = errors.New("bridge alert webhook must be an absolute http(s) URL")
+	errInvalidCheckInterval = errors.New("bridge alert check interval must be greater than zero")
+	errNoRules              = errors.New("at least one bridge alert rule must be enabled")
+)
+
+// Config is the configuration of the bridge alerting rules. A rule is disabled if its threshold is zero
+type Config struct {
+	// WebhookURL is the URL the fired alerts are posted to, the alerts are only logged
+	// and exported as metrics if empty
+	WebhookURL string
+
+	// PendingThreshold is the max time between observing the message and its commitment
+	PendingThreshold time.Duration
+
+	// SignatureStallThreshold is the max time the commitment of the message can go without a new signature
+	SignatureStallThreshold time.Duration
+
+	// MaxDemotions is the number of discarded commitments of the message which fires the alert
+	MaxDemotions uint64
+
+	// CheckInterval is the interval between two evaluations of the rules
+	CheckInterval time.Duration
+}
+
+// Enabled returns true if any of the rules is enabled
+func (c *Config) Enabled() bool {
+	return c != nil && (c.PendingThreshold > 0 || c.SignatureStallThreshold > 0 || c.MaxDemotions > 0)
+}
+
+// Validate validates the alerting configuration
+func (c *Config) Validate() error {
+	if c.WebhookURL != "" {
+		webhook, err := url.Parse(c.WebhookURL)
+		if err != nil || !webhook.IsAbs() || (webhook.Scheme != "http" && webhook.Scheme != "https") {
+			return errInvalidWebhook
+		}
+
+		if !c.Enabled() {
+			return errNoRules
+		}
+	}
+
+	if c.Enabled() && c.CheckInterval <= 0 {
+		return errInvalidCheckInterval
+	}
+
+	return nil
+}
+
+// Message is the bridge message (state sync event) tracked by the monitor
+type Message struct {
+	ID       uint64        `json:"id"`
+	Sender   types.Address `json:"sender"`
+	Receiver types.Address `json:"receiver"`
+}
+
+// Alert is the alert fired for the message violating the rule
+type Alert struct {
+	Rule    Rule     `json:"rule"`
+	Message *Message `json:"message"`
+
+	// PendingFor is the time since the message was observed, in seconds
+	PendingFor uint64 `json:"pendingFor"`
+
+	// Signatures is the number of signatures of the latest commitment of the message
+	Signatures int  `json:"signatures"`
+	Quorum     bool `json:"quorum"`
+
+	Demotions uint64    `json:"demotions"`
+	Time      time.Time `json:"time"`
+}
+
+// WebhookPayload is the body of the webhook request, holding the alerts fired by a single evaluation
+type WebhookPayload struct {
+	Alerts []*Alert `json:"alerts"`
+}
+
+// trackedMessage is the message pending to be committed
+type trackedMessage struct {
+	*Message
+
+	observedAt time.Time
+
+	// inCommitment is true if the message is part of a pending commitment
+	inCommitment bool
+	signatures   int
+	quorum       bool
+	// lastProgressAt is the time the commitment of the message got a new signature
+	lastProgressAt time.Time
+
+	demotions uint64
+
+	// fired holds the rules already fired for the message, so each rule fires once
+	fired map[Rule]struct{}
+}
+
+// Monitor tracks the bridge messages from their observation until their commitment,
+// and fires the alerts for the messages violating the configured rules
+type Monitor struct {
+	config *Config
+	logger hclog.Logger
+	client *http.Client
+
+	lock     sync.Mutex
+	messages map[uint64]*trackedMessage
+	// nextID is the id of the first message which is not yet committed
+	nextID uint64
+
+	now     func() time.Time
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewMonitor creates the bridge alerts monitor
+func NewMonitor(config *Config, logger hclog.Logger) (*Monitor, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Monitor{
+		config:   config,
+		logger:   logger.Named("bridge_alert"),
+		client:   &http.Client{Timeout: webhookTimeout},
+		messages: make(map[uint64]*trackedMessage),
+		now:      time.Now,
+		closeCh:  make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}, nil
+}
+
+// Start starts evaluating the rules periodically
+func (m *Monitor) Start() {
+	m.logger.Info("bridge alerts enabled",
+		"pendingThreshold", m.config.PendingThreshold,
+		"signatureStallThreshold", m.config.SignatureStallThreshold,
+		"maxDemotions", m.config.MaxDemotions)
+
+	go m.run()
+}
+
+// Close stops evaluating the rules
+func (m *Monitor) Close() {
+	close(m.closeCh)
+	<-m.doneCh
+}
+
+func (m *Monitor) run() {
+	defer close(m.doneCh)
+
+	ticker := time.NewTicker(m.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.check()
+		case <-m.closeCh:
+			return
+		}
+	}
+}
+
+// Observed starts tracking the message. [thread-safe]
+func (m *Monitor) Observed(msg *Message) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.messages[msg.ID]; ok || msg.ID < m.nextID {
+		return
+	}
+
+	m.messages[msg.ID] = &trackedMessage{
+		Message:    msg,
+		observedAt: m.now().UTC(),
+		fired:      make(map[Rule]struct{}),
+	}
+}
+
+// Signed updates the signature progress of the messages of the pending commitment. [thread-safe]
+func (m *Monitor) Signed(fromID, toID uint64, signatures int, quorum bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := m.now().UTC()
+
+	// the message can be part of multiple pending commitments,
+	// so its progress is the one of the commitment with the most signatures
+	m.forRange(fromID, toID, func(msg *trackedMessage) {
+		if !msg.inCommitment || signatures > msg.signatures {
+			msg.signatures = signatures
+			msg.lastProgressAt = now
+			// the stalled signing made progress, so the stall can fire again
+			delete(msg.fired, RuleSignatureStall)
+		}
+
+		msg.inCommitment = true
+		msg.quorum = msg.quorum || quorum
+	})
+}
+
+// Demoted records the pending commitment which is discarded without being committed. [thread-safe]
+func (m *Monitor) Demoted(fromID, toID uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.forRange(fromID, toID, func(msg *trackedMessage) {
+		msg.demotions++
+		msg.inCommitment = false
+		msg.signatures = 0
+		msg.quorum = false
+	})
+}
+
+// Committed stops tracking the messages up to the given id, as they are committed. [thread-safe]
+func (m *Monitor) Committed(toID uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if toID >= m.nextID {
+		m.nextID = toID + 1
+	}
+
+	for id := range m.messages {
+		if id <= toID {
+			delete(m.messages, id)
+		}
+	}
+}
+
+// forRange calls the handler for each tracked message in the given id range
+func (m *Monitor) forRange(fromID, toID uint64, handler func(*trackedMessage)) {
+	for id, msg := range m.messages {
+		if id >= fromID && id <= toID {
+			handler(msg)
+		}
+	}
+}
+
+// check evaluates the rules, and notifies about the newly fired alerts
+func (m *Monitor) check() {
+	alerts := m.evaluate()
+
+	for _, alert := range alerts {
+		m.logger.Warn("bridge alert fired",
+			"rule", alert.Rule,
+			"id", alert.Message.ID,
+			"sender", alert.Message.Sender,
+			"receiver", alert.Message.Receiver,
+			"pendingFor", time.Duration(alert.PendingFor)*time.Second,
+			"signatures", alert.Signatures,
+			"quorum", alert.Quorum,
+			"demotions", alert.Demotions,
+		)
+
+		metrics.IncrCounterWithLabels([]string{bridgeMetricsPrefix, "alerts"}, 1,
+			[]metrics.Label{{Name: "rule", Value: string(alert.Rule)}})
+	}
+
+	if len(alerts) == 0 || m.config.WebhookURL == "" {
+		return
+	}
+
+	if err := m.notify(&WebhookPayload{Alerts: alerts}); err != nil {
+		m.logger.Error("failed to notify the bridge alerts webhook", "alerts", len(alerts), "err", err)
+	}
+}
+
+// evaluate returns the alerts fired since the previous evaluation, ordered by the message id
+func (m *Monitor) evaluate() []*Alert {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var (
+		now          = m.now().UTC()
+		alerts       []*Alert
+		oldestMsgAge time.Duration
+	)
+
+	for _, msg := range m.messages {
+		pendingFor := now.Sub(msg.observedAt)
+		if pendingFor > oldestMsgAge {
+			oldestMsgAge = pendingFor
+		}
+
+		fire := func(rule Rule) {
+			if _, fired := msg.fired[rule]; fired {
+				return
+			}
+
+			msg.fired[rule] = struct{}{}
+
+			alerts = append(alerts, &Alert{
+				Rule:       rule,
+				Message:    msg.Message,
+				PendingFor: uint64(pendingFor.Seconds()),
+				Signatures: msg.signatures,
+				Quorum:     msg.quorum,
+				Demotions:  msg.demotions,
+				Time:       now,
+			})
+		}
+
+		if m.config.PendingThreshold > 0 && pendingFor >= m.config.PendingThreshold {
+			fire(RulePendingTimeout)
+		}
+
+		if m.config.SignatureStallThreshold > 0 && msg.inCommitment && !msg.quorum &&
+			now.Sub(msg.lastProgressAt) >= m.config.SignatureStallThreshold {
+			fire(RuleSignatureStall)
+		}
+
+		if m.config.MaxDemotions > 0 && msg.demotions >= m.config.MaxDemotions {
+			fire(RuleRepeatedDemotions)
+		}
+	}
+
+	metrics.SetGauge([]string{bridgeMetricsPrefix, "pending_messages"}, float32(len(m.messages)))
+	metrics.SetGauge([]string{bridgeMetricsPrefix, "oldest_pending_message_age"}, float32(oldestMsgAge.Seconds()))
+
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].Message.ID != alerts[j].Message.ID {
+			return alerts[i].Message.ID < alerts[j].Message.ID
+		}
+
+		return alerts[i].Rule < alerts[j].Rule
+	})
+
+	return alerts
+}
+
+// notify posts the alerts to the webhook as JSON
+func (m *Monitor) notify(payload *WebhookPayload) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.config.WebhookURL, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package bridgealert
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		config *Config
+		err    error
+	}{
+		{"disabled", &Config{}, nil},
+		{"valid", &Config{PendingThreshold: time.Hour, CheckInterval: time.Second}, nil},
+		{"valid webhook", &Config{WebhookURL: "https://alerts.example.com", MaxDemotions: 1, CheckInterval: time.Second},
+			nil},
+		{"relative webhook", &Config{WebhookURL: "alerts.example.com", MaxDemotions: 1, CheckInterval: time.Second},
+			errInvalidWebhook},
+		{"webhook without rules", &Config{WebhookURL: "https://alerts.example.com", CheckInterval: time.Second},
+			errNoRules},
+		{"invalid interval", &Config{PendingThreshold: time.Hour}, errInvalidCheckInterval},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, c.config.Validate(), c.err)
+		})
+	}
+}
+
+func newTestMonitor(t *testing.T, config *Config) (*Monitor, *time.Time) {
+	t.Helper()
+
+	config.CheckInterval = time.Second
+
+	m, err := NewMonitor(config, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	m.now = func() time.Time { return now }
+
+	return m, &now
+}
+
+func alertRules(alerts []*Alert) []Rule {
+	rules := make([]Rule, len(alerts))
+	for i, alert := range alerts {
+		rules[i] = alert.Rule
+	}
+
+	return rules
+}
+
+func alertIDs(alerts []*Alert) []uint64 {
+	ids := make([]uint64, len(alerts))
+	for i, alert := range alerts {
+		ids[i] = alert.Message.ID
+	}
+
+	return ids
+}
+
+func TestMonitor_PendingTimeout(t *testing.T) {
+	t.Parallel()
+
+	m, now := newTestMonitor(t, &Config{PendingThreshold: time.Minute})
+
+	msg := &Message{ID: 1, Sender: types.StringToAddress("1"), Receiver: types.StringToAddress("2")}
+	m.Observed(msg)
+	m.Observed(&Message{ID: 2})
+
+	*now = now.Add(30 * time.Second)
+
+	// the message observed again keeps its observation time
+	m.Observed(&Message{ID: 1})
+	require.Empty(t, m.evaluate())
+
+	*now = now.Add(30 * time.Second)
+
+	alerts := m.evaluate()
+	require.Len(t, alerts, 2)
+	require.Equal(t, RulePendingTimeout, alerts[0].Rule)
+	require.Equal(t, msg, alerts[0].Message)
+	require.Equal(t, uint64(60), alerts[0].PendingFor)
+	require.Equal(t, uint64(2), alerts[1].Message.ID)
+
+	// the alert fires once
+	require.Empty(t, m.evaluate())
+
+	// committed messages are not tracked anymore, even if observed again
+	m.Committed(2)
+	m.Observed(&Message{ID: 2})
+	require.Empty(t, m.messages)
+}
+
+func TestMonitor_SignatureStall(t *testing.T) {
+	t.Parallel()
+
+	m, now := newTestMonitor(t, &Config{SignatureStallThreshold: time.Minute})
+
+	for i := uint64(0); i < 4; i++ {
+		m.Observed(&Message{ID: i})
+	}
+
+	m.Signed(0, 2, 1, false)
+
+	*now = now.Add(time.Minute)
+
+	alerts := m.evaluate()
+	require.Equal(t, []Rule{RuleSignatureStall, RuleSignatureStall, RuleSignatureStall}, alertRules(alerts))
+	require.Equal(t, 1, alerts[0].Signatures)
+
+	// the larger commitment with less signatures doesn't make progress
+	m.Signed(0, 3, 1, false)
+	m.Signed(0, 2, 1, false)
+	require.Empty(t, m.evaluate())
+
+	*now = now.Add(time.Minute)
+
+	require.Equal(t, []uint64{3}, alertIDs(m.evaluate()))
+
+	// the progress resets the stall, and the quorum stops it
+	m.Signed(0, 3, 2, false)
+	m.Signed(0, 1, 3, true)
+
+	*now = now.Add(time.Minute)
+
+	require.Equal(t, []uint64{2, 3}, alertIDs(m.evaluate()))
+}
+
+func TestMonitor_RepeatedDemotions(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newTestMonitor(t, &Config{MaxDemotions: 2, SignatureStallThreshold: time.Minute})
+
+	m.Observed(&Message{ID: 1})
+	m.Observed(&Message{ID: 2})
+
+	m.Signed(1, 2, 1, false)
+	m.Demoted(1, 2)
+	require.Empty(t, m.evaluate())
+
+	m.Demoted(2, 2)
+
+	alerts := m.evaluate()
+	require.Len(t, alerts, 1)
+	require.Equal(t, RuleRepeatedDemotions, alerts[0].Rule)
+	require.Equal(t, uint64(2), alerts[0].Message.ID)
+	require.Equal(t, uint64(2), alerts[0].Demotions)
+	require.Equal(t, 0, alerts[0].Signatures)
+}
+
+func TestMonitor_Webhook(t *testing.T) {
+	t.Parallel()
+
+	payloadCh := make(chan *WebhookPayload, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload *WebhookPayload
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		payloadCh <- payload
+	}))
+	t.Cleanup(srv.Close)
+
+	m, now := newTestMonitor(t, &Config{WebhookURL: srv.URL, PendingThreshold: time.Minute})
+
+	m.Observed(&Message{ID: 5, Sender: types.StringToAddress("1")})
+
+	*now = now.Add(time.Hour)
+
+	m.check()
+
+	payload := <-payloadCh
+	require.Len(t, payload.Alerts, 1)
+	require.Equal(t, RulePendingTimeout, payload.Alerts[0].Rule)
+	require.Equal(t, uint64(5), payload.Alerts[0].Message.ID)
+	require.Equal(t, types.StringToAddress("1"), payload.Alerts[0].Message.Sender)
+	require.Equal(t, uint64(time.Hour.Seconds()), payload.Alerts[0].PendingFor)
+
+	// nothing is posted if no alert fires
+	m.check()
+
+	srv.Close()
+	require.Empty(t, payloadCh)
+	require.Error(t, m.notify(payload))
+}
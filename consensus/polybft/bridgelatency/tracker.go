@@ -0,0 +1,234 @@
+package bridgelatency
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// DefaultCapacity is the default number of the latest messages whose timelines are kept
+	DefaultCapacity = 10000
+
+	bridgeMetricsPrefix = "bridge"
+)
+
+// Stage is the stage of the bridge message on its way from the rootchain to its execution on the childchain
+type Stage string
+
+const (
+	// StageRootchainEvent is the time of the rootchain block which emitted the state sync event
+	StageRootchainEvent Stage = "rootchain_event"
+
+	// StageObserved is the time the event tracker injected the finalized state sync event
+	StageObserved Stage = "observed"
+
+	// StageQuorum is the time the pending commitment of the message reached the quorum of signatures
+	StageQuorum Stage = "quorum"
+
+	// StageCommitted is the time of the childchain block which included the commitment of the message
+	StageCommitted Stage = "committed"
+
+	// StageExecuted is the time of the childchain block which executed the message
+	StageExecuted Stage = "executed"
+)
+
+// stageOrder is the order in which the message goes through the stages
+var stageOrder = map[Stage]int{
+	StageRootchainEvent: 0,
+	StageObserved:       1,
+	StageQuorum:         2,
+	StageCommitted:      3,
+	StageExecuted:       4,
+}
+
+// timeline holds the times the message reached the stages
+type timeline struct {
+	stages  map[Stage]time.Time
+	success *bool
+}
+
+// Tracker records the time each bridge message reaches the bridge stages, and reports the latency
+// of each stage (the time since the previous stage of the message) as the metrics.
+// The timelines of the latest messages are kept in memory, so the timeline of the message can be queried
+type Tracker struct {
+	capacity int
+
+	lock      sync.Mutex
+	timelines map[uint64]*timeline
+	// order holds the ids of the tracked messages in the order they were added, so the oldest are evicted first
+	order []uint64
+
+	now func() time.Time
+}
+
+// NewTracker creates the bridge latency tracker which keeps the timelines of up to capacity messages
+func NewTracker(capacity int) *Tracker {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+
+	return &Tracker{
+		capacity:  capacity,
+		timelines: make(map[uint64]*timeline),
+		now:       time.Now,
+	}
+}
+
+// Record records the time the message reached the stage, the current time is used if at is zero.
+// Each stage of the message is recorded once. [thread-safe]
+func (t *Tracker) Record(id uint64, stage Stage, at time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.record(id, stage, t.timeOrNow(at))
+}
+
+// RecordRange records the time the messages in the given id range reached the stage. [thread-safe]
+func (t *Tracker) RecordRange(fromID, toID uint64, stage Stage, at time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	at = t.timeOrNow(at)
+
+	for id := fromID; id <= toID; id++ {
+		t.record(id, stage, at)
+	}
+}
+
+// Executed records the execution of the message, along with its result. [thread-safe]
+func (t *Tracker) Executed(id uint64, success bool, at time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if tl := t.record(id, StageExecuted, t.timeOrNow(at)); tl != nil {
+		tl.success = &success
+	}
+}
+
+// Timeline returns the timeline of the message, ordered by the stages. [thread-safe]
+func (t *Tracker) Timeline(id uint64) (*types.BridgeMessageTimeline, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	tl, ok := t.timelines[id]
+	if !ok {
+		return nil, false
+	}
+
+	stages := tl.sortedStages()
+	result := &types.BridgeMessageTimeline{
+		ID:     id,
+		Stages: make([]*types.BridgeStageTime, len(stages)),
+	}
+
+	if tl.success != nil {
+		success := *tl.success
+		result.Success = &success
+	}
+
+	for i, stage := range stages {
+		result.Stages[i] = &types.BridgeStageTime{
+			Stage: string(stage),
+			Time:  tl.stages[stage],
+		}
+
+		if i > 0 {
+			result.Stages[i].Latency = uint64(latency(tl.stages[stages[i-1]], tl.stages[stage]).Milliseconds())
+		}
+	}
+
+	return result, true
+}
+
+// record records the stage of the message, and reports its latency. It returns nil if the stage
+// is already recorded. It must be called while holding the lock
+func (t *Tracker) record(id uint64, stage Stage, at time.Time) *timeline {
+	tl, ok := t.timelines[id]
+	if !ok {
+		tl = &timeline{stages: make(map[Stage]time.Time, len(stageOrder))}
+		t.timelines[id] = tl
+		t.order = append(t.order, id)
+		t.evict()
+	}
+
+	if _, recorded := tl.stages[stage]; recorded {
+		return nil
+	}
+
+	tl.stages[stage] = at
+
+	// the latency of the stage is the time since the latest of the preceding stages recorded for the message,
+	// the stages can be missing if the node didn't see them (e.g. the messages committed while syncing)
+	var (
+		previous      time.Time
+		previousOrder = -1
+		first         time.Time
+	)
+
+	for s, sAt := range tl.stages {
+		order := stageOrder[s]
+		if order < stageOrder[stage] && order > previousOrder {
+			previous, previousOrder = sAt, order
+		}
+
+		if first.IsZero() || sAt.Before(first) {
+			first = sAt
+		}
+	}
+
+	if previousOrder >= 0 {
+		metrics.AddSampleWithLabels([]string{bridgeMetricsPrefix, "stage_latency"},
+			float32(latency(previous, at).Seconds()),
+			[]metrics.Label{{Name: "stage", Value: string(stage)}})
+	}
+
+	if stage == StageExecuted && len(tl.stages) > 1 {
+		metrics.AddSample([]string{bridgeMetricsPrefix, "end_to_end_latency"}, float32(latency(first, at).Seconds()))
+	}
+
+	return tl
+}
+
+// evict removes the oldest timelines exceeding the capacity. It must be called while holding the lock
+func (t *Tracker) evict() {
+	for len(t.timelines) > t.capacity && len(t.order) > 0 {
+		delete(t.timelines, t.order[0])
+		t.order = t.order[1:]
+	}
+}
+
+func (t *Tracker) timeOrNow(at time.Time) time.Time {
+	if at.IsZero() {
+		return t.now().UTC()
+	}
+
+	return at.UTC()
+}
+
+// sortedStages returns the recorded stages in the order the message goes through them
+func (tl *timeline) sortedStages() []Stage {
+	stages := make([]Stage, 0, len(tl.stages))
+	for stage := range tl.stages {
+		stages = append(stages, stage)
+	}
+
+	sort.Slice(stages, func(i, j int) bool {
+		return stageOrder[stages[i]] < stageOrder[stages[j]]
+	})
+
+	return stages
+}
+
+// latency returns the time between the two stages, the clocks of the rootchain, the childchain
+// and the node aren't synchronized, so the negative latency is reported as zero
+func latency(from, to time.Time) time.Duration {
+	if to.Before(from) {
+		return 0
+	}
+
+	return to.Sub(from)
+}
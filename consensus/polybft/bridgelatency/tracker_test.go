@@ -0,0 +1,106 @@
+package bridgelatency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTracker(capacity int) (*Tracker, *time.Time) {
+	tracker := NewTracker(capacity)
+
+	now := time.Unix(1000, 0).UTC()
+	tracker.now = func() time.Time { return now }
+
+	return tracker, &now
+}
+
+func stageNames(tracker *Tracker, id uint64) []string {
+	timeline, ok := tracker.Timeline(id)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, len(timeline.Stages))
+	for i, stage := range timeline.Stages {
+		names[i] = stage.Stage
+	}
+
+	return names
+}
+
+func TestTracker_Timeline(t *testing.T) {
+	t.Parallel()
+
+	tracker, now := newTestTracker(DefaultCapacity)
+
+	_, ok := tracker.Timeline(1)
+	require.False(t, ok)
+
+	rootchainTime := now.Add(-30 * time.Second)
+
+	tracker.Record(1, StageObserved, time.Time{})
+	tracker.Record(1, StageRootchainEvent, rootchainTime)
+
+	// the stage is recorded once
+	*now = now.Add(time.Minute)
+
+	tracker.Record(1, StageObserved, time.Time{})
+
+	tracker.RecordRange(0, 2, StageQuorum, time.Time{})
+	tracker.RecordRange(0, 2, StageCommitted, now.Add(2*time.Second))
+	tracker.Executed(1, false, now.Add(5*time.Second))
+
+	timeline, ok := tracker.Timeline(1)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), timeline.ID)
+	require.Equal(t, []string{"rootchain_event", "observed", "quorum", "committed", "executed"}, stageNames(tracker, 1))
+	require.Equal(t, rootchainTime, timeline.Stages[0].Time)
+
+	latencies := make([]uint64, len(timeline.Stages))
+	for i, stage := range timeline.Stages {
+		latencies[i] = stage.Latency
+	}
+
+	require.Equal(t, []uint64{0, 30000, 60000, 2000, 3000}, latencies)
+	require.NotNil(t, timeline.Success)
+	require.False(t, *timeline.Success)
+
+	// the messages which weren't observed by the node have the timelines from the first recorded stage
+	require.Equal(t, []string{"quorum", "committed"}, stageNames(tracker, 2))
+
+	timeline, ok = tracker.Timeline(2)
+	require.True(t, ok)
+	require.Nil(t, timeline.Success)
+}
+
+func TestTracker_NegativeLatency(t *testing.T) {
+	t.Parallel()
+
+	tracker, now := newTestTracker(DefaultCapacity)
+
+	// the rootchain clock is ahead of the node clock
+	tracker.Record(1, StageRootchainEvent, now.Add(time.Second))
+	tracker.Record(1, StageObserved, time.Time{})
+
+	timeline, ok := tracker.Timeline(1)
+	require.True(t, ok)
+	require.Equal(t, uint64(0), timeline.Stages[1].Latency)
+}
+
+func TestTracker_Capacity(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := newTestTracker(2)
+
+	tracker.Record(1, StageObserved, time.Time{})
+	tracker.Record(2, StageObserved, time.Time{})
+	tracker.Record(1, StageQuorum, time.Time{})
+	tracker.Record(3, StageObserved, time.Time{})
+
+	// the oldest message is evicted
+	require.Nil(t, stageNames(tracker, 1))
+	require.Equal(t, []string{"observed"}, stageNames(tracker, 2))
+	require.Equal(t, []string{"observed"}, stageNames(tracker, 3))
+}
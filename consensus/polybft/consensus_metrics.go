@@ -22,6 +22,10 @@ func updateBlockMetrics(currentBlock *types.Block, parentHeader *types.Header) e
 		metrics.SetGauge([]string{consensusMetricsPrefix, "block_interval"}, float32(headerTime.Sub(parentTime).Seconds()))
 	}
 
+	// update the block timestamp drift metric, positive if the block timestamp is ahead of the wall clock
+	metrics.SetGauge([]string{consensusMetricsPrefix, "block_timestamp_drift"},
+		float32(blockTimestampDrift(currentBlock.Header, time.Now().UTC()).Seconds()))
+
 	// update the number of transactions in the block metric
 	metrics.SetGauge([]string{consensusMetricsPrefix, "num_txs"}, float32(len(currentBlock.Transactions)))
 
@@ -53,3 +57,9 @@ func updateBlockExecutionMetric(start time.Time) {
 	metrics.SetGauge([]string{consensusMetricsPrefix, "block_execution_time"},
 		float32(time.Now().UTC().Sub(start).Seconds()))
 }
+
+// blockTimestampDrift returns how far the block timestamp is ahead of the given wall clock time
+// (negative if the block timestamp is behind it)
+func blockTimestampDrift(header *types.Header, now time.Time) time.Duration {
+	return time.Unix(int64(header.Timestamp), 0).Sub(now)
+}
@@ -8,12 +8,16 @@ import (
 	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgealert"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgelatency"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
 
@@ -80,6 +84,17 @@ type runtimeConfig struct {
 	txPool                txPoolInterface
 	bridgeTopic           topic
 	numBlockConfirmations uint64
+	bridgeAlert           *bridgealert.Config
+
+	// rootchainJSONRPCEndpoints overrides the rootchain JSON-RPC endpoint from the bridge config
+	// for tracking the rootchain events
+	rootchainJSONRPCEndpoints []string
+
+	// extensions are notified when a new epoch begins, nil if there are none
+	extensions *extension.Registry
+
+	// rootchainFeeBump is the fee management config of the checkpoint transactions, nil if disabled
+	rootchainFeeBump *txrelayer.FeeBumpConfig
 }
 
 // consensusRuntime is a struct that provides consensus runtime features like epoch, state and event management
@@ -117,6 +132,12 @@ type consensusRuntime struct {
 	// manager for handling validator stake change and updating validator set
 	stakeManager StakeManager
 
+	// manager for collecting the validator performance statistics
+	validatorStatsManager *validatorStatsManager
+
+	// manager for jailing the offline validators, nil if the jailing is disabled
+	jailManager *jailManager
+
 	// logger instance
 	logger hcf.Logger
 }
@@ -136,6 +157,21 @@ func newConsensusRuntime(log hcf.Logger, config *runtimeConfig) (*consensusRunti
 		logger:             log.Named("consensus_runtime"),
 	}
 
+	runtime.validatorStatsManager = newValidatorStatsManager(
+		config.State,
+		config.blockchain,
+		log.Named("validator_stats_manager"),
+	)
+
+	if jailConfig := config.PolyBFTConfig.Jail; jailConfig != nil && jailConfig.MissedBlocksThreshold > 0 {
+		runtime.jailManager = newJailManager(
+			config.State,
+			jailConfig,
+			config.PolyBFTConfig.MinValidatorSetSize,
+			log.Named("jail_manager"),
+		)
+	}
+
 	if err := runtime.initStateSyncManager(log); err != nil {
 		return nil, err
 	}
@@ -166,6 +202,17 @@ func (c *consensusRuntime) close() {
 // if bridge is not enabled, then a dummy state sync manager will be used
 func (c *consensusRuntime) initStateSyncManager(logger hcf.Logger) error {
 	if c.IsBridgeEnabled() {
+		var alerts *bridgealert.Monitor
+
+		if c.config.bridgeAlert.Enabled() {
+			monitor, err := bridgealert.NewMonitor(c.config.bridgeAlert, logger)
+			if err != nil {
+				return fmt.Errorf("failed to create bridge alerts monitor: %w", err)
+			}
+
+			alerts = monitor
+		}
+
 		stateSenderAddr := c.config.PolyBFTConfig.Bridge.StateSenderAddr
 		stateSyncManager := newStateSyncManager(
 			logger.Named("state-sync-manager"),
@@ -174,11 +221,13 @@ func (c *consensusRuntime) initStateSyncManager(logger hcf.Logger) error {
 				key:                   c.config.Key,
 				stateSenderAddr:       stateSenderAddr,
 				stateSenderStartBlock: c.config.PolyBFTConfig.Bridge.EventTrackerStartBlocks[stateSenderAddr],
-				jsonrpcAddr:           c.config.PolyBFTConfig.Bridge.JSONRPCEndpoint,
+				jsonrpcAddrs:          c.rootchainJSONRPCEndpoints(),
 				dataDir:               c.config.DataDir,
 				topic:                 c.config.bridgeTopic,
 				maxCommitmentSize:     maxCommitmentSize,
 				numBlockConfirmations: c.config.numBlockConfirmations,
+				alerts:                alerts,
+				latency:               bridgelatency.NewTracker(bridgelatency.DefaultCapacity),
 			},
 			c,
 		)
@@ -198,7 +247,9 @@ func (c *consensusRuntime) initCheckpointManager(logger hcf.Logger) error {
 		// enable checkpoint manager
 		txRelayer, err := txrelayer.NewTxRelayer(
 			txrelayer.WithIPAddress(c.config.PolyBFTConfig.Bridge.JSONRPCEndpoint),
-			txrelayer.WithWriter(logger.StandardWriter(&hcf.StandardLoggerOptions{})))
+			txrelayer.WithWriter(logger.StandardWriter(&hcf.StandardLoggerOptions{})),
+			txrelayer.WithLogger(logger.Named("checkpoint_relayer")),
+			txrelayer.WithFeeBumping(c.config.rootchainFeeBump))
 		if err != nil {
 			return err
 		}
@@ -261,6 +312,16 @@ func (c *consensusRuntime) getGuardedData() (guardedDataDTO, error) {
 	}, nil
 }
 
+// rootchainJSONRPCEndpoints returns the rootchain JSON-RPC endpoints the rootchain events are tracked from,
+// which are the configured ones if any, or the endpoint from the bridge config otherwise
+func (c *consensusRuntime) rootchainJSONRPCEndpoints() []string {
+	if len(c.config.rootchainJSONRPCEndpoints) > 0 {
+		return c.config.rootchainJSONRPCEndpoints
+	}
+
+	return []string{c.config.PolyBFTConfig.Bridge.JSONRPCEndpoint}
+}
+
 func (c *consensusRuntime) IsBridgeEnabled() bool {
 	return c.config.PolyBFTConfig.IsBridgeEnabled()
 }
@@ -281,6 +342,14 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 		c.logger.Error("failed to update block metrics", "error", err)
 	}
 
+	if maxSkew := c.config.PolyBFTConfig.MaxBlockTimestampSkew; maxSkew > 0 {
+		drift := blockTimestampDrift(fullBlock.Block.Header, time.Now().UTC())
+		if drift > time.Duration(maxSkew)*time.Second {
+			c.logger.Warn("inserted block timestamp is ahead of the wall clock, check the clocks of the validators",
+				"block", fullBlock.Block.Number(), "drift", drift, "maxSkew", maxSkew)
+		}
+	}
+
 	// after the block has been written we reset the txpool so that the old transactions are removed
 	c.config.txPool.ResetWithHeaders(fullBlock.Block.Header)
 
@@ -304,6 +373,22 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 		c.logger.Error("failed to post block in checkpoint manager", "err", err)
 	}
 
+	// update validator statistics, before the proposer priorities are moved to the next block
+	if c.validatorStatsManager != nil {
+		proposerSnapshot, _ := c.proposerCalculator.GetSnapshot()
+
+		if err := c.validatorStatsManager.PostBlock(postBlock, epoch.Validators, proposerSnapshot); err != nil {
+			c.logger.Error("failed to post block in validator stats manager", "err", err)
+		}
+	}
+
+	// update missed blocks of the validators and jail the offline ones
+	if c.jailManager != nil {
+		if err := c.postBlockJailManager(postBlock); err != nil {
+			c.logger.Error("failed to post block in jail manager", "err", err)
+		}
+	}
+
 	// update proposer priorities
 	if err := c.proposerCalculator.PostBlock(postBlock); err != nil {
 		c.logger.Error("Could not update proposer calculator", "err", err)
@@ -327,6 +412,24 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 	c.lastBuiltBlock = fullBlock.Block.Header
 }
 
+// postBlockJailManager passes the block to the jail manager, along with the validators
+// which signed its parent, since the missed blocks are counted from the parent signatures
+func (c *consensusRuntime) postBlockJailManager(postBlock *PostBlockRequest) error {
+	var parentValidators validator.AccountSet
+
+	// genesis block does not have any signatures
+	if number := postBlock.FullBlock.Block.Number(); number > 1 {
+		validators, err := c.config.polybftBackend.GetValidators(number-2, nil)
+		if err != nil {
+			return err
+		}
+
+		parentValidators = validators
+	}
+
+	return c.jailManager.PostBlock(postBlock, parentValidators)
+}
+
 // FSM creates a new instance of fsm
 func (c *consensusRuntime) FSM() error {
 	sharedData, err := c.getGuardedData()
@@ -394,7 +497,8 @@ func (c *consensusRuntime) FSM() error {
 			return fmt.Errorf("cannot calculate commit epoch info: %w", err)
 		}
 
-		ff.newValidatorsDelta, err = c.stakeManager.UpdateValidatorSet(epoch.Number, epoch.Validators.Copy())
+		ff.newValidatorsDelta, err = c.stakeManager.UpdateValidatorSet(epoch.Number, pendingBlockNumber,
+			epoch.Validators.Copy())
 		if err != nil {
 			return fmt.Errorf("cannot update validator set on epoch ending: %w", err)
 		}
@@ -483,6 +587,12 @@ func (c *consensusRuntime) restartEpoch(header *types.Header) (*epochMetadata, e
 		return nil, err
 	}
 
+	c.config.extensions.OnEpoch(&extension.Epoch{
+		Number:     epochNumber,
+		FirstBlock: firstBlockInEpoch,
+		Validators: validatorSet.GetAddresses(),
+	})
+
 	return &epochMetadata{
 		Number:            epochNumber,
 		Validators:        validatorSet,
@@ -601,6 +711,11 @@ func (c *consensusRuntime) GetStateSyncProof(stateSyncID uint64) (types.Proof, e
 	return c.stateSyncManager.GetStateSyncProof(stateSyncID)
 }
 
+// GetStateSyncTimeline returns the times the state sync reached each of the bridge stages
+func (c *consensusRuntime) GetStateSyncTimeline(stateSyncID uint64) (*types.BridgeMessageTimeline, error) {
+	return c.stateSyncManager.GetStateSyncTimeline(stateSyncID)
+}
+
 // setIsActiveValidator updates the activeValidatorFlag field
 func (c *consensusRuntime) setIsActiveValidator(isActiveValidator bool) {
 	c.activeValidatorFlag.Store(isActiveValidator)
@@ -0,0 +1,126 @@
+package polybft
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/merkle-tree"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errInvalidEpoch  = errors.New("epoch must be greater than zero")
+	errEpochNotEnded = errors.New("epoch has not ended yet")
+)
+
+// GetValidatorsByEpoch returns the validator set of the ended epoch, bound to the header of its epoch ending block.
+// The checkpoint in the header extra data commits to the hash of the ABI encoded validator set
+// (as the current validators hash), so the verifier recomputes it from the returned validators.
+// Each validator comes with the proof of its membership in the merkle tree of the ABI encoded validators,
+// whose root is derived from the same validator set
+func (p *Polybft) GetValidatorsByEpoch(epoch uint64) (*types.EpochValidators, error) {
+	if epoch == 0 {
+		return nil, errInvalidEpoch
+	}
+
+	// epochs have the fixed size, and the first one starts with the block following the genesis
+	endingBlock := epoch * p.consensusConfig.EpochSize
+
+	header, extra, err := getBlockData(endingBlock, p.blockchain)
+	if err != nil {
+		if errors.Is(err, blockchain.ErrNoBlock) {
+			return nil, errEpochNotEnded
+		}
+
+		return nil, err
+	}
+
+	isEndingBlock, err := isEpochEndingBlock(endingBlock, extra, p.blockchain)
+	if err != nil {
+		if errors.Is(err, blockchain.ErrNoBlock) {
+			return nil, errEpochNotEnded
+		}
+
+		return nil, err
+	}
+
+	if !isEndingBlock || extra.Checkpoint.EpochNumber != epoch {
+		return nil, fmt.Errorf("block %d is not the ending block of epoch %d", endingBlock, epoch)
+	}
+
+	// the validators of the epoch are the snapshot preceding the epoch ending block,
+	// since the snapshot of the epoch ending block is the validator set of the next epoch
+	validators, err := p.GetValidators(endingBlock-1, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsHash, err := validators.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	if validatorsHash != extra.Checkpoint.CurrentValidatorsHash {
+		return nil, fmt.Errorf("validators of epoch %d don't match the validators hash of block %d",
+			epoch, endingBlock)
+	}
+
+	cleanExtra, err := GetIbftExtraClean(header.ExtraData)
+	if err != nil {
+		return nil, err
+	}
+
+	cleanHeader := header.Copy()
+	cleanHeader.ExtraData = cleanExtra
+
+	return createEpochValidators(epoch, cleanHeader, validatorsHash, validators)
+}
+
+// createEpochValidators creates the merkle tree of the validators, and the membership proofs of each validator
+func createEpochValidators(epoch uint64, header *types.Header, validatorsHash types.Hash,
+	validators validator.AccountSet) (*types.EpochValidators, error) {
+	apiValidators := validators.ToAPIBinding()
+	leaves := make([][]byte, len(apiValidators))
+
+	for i, v := range apiValidators {
+		leaf, err := v.EncodeAbi()
+		if err != nil {
+			return nil, err
+		}
+
+		leaves[i] = leaf
+	}
+
+	tree, err := merkle.NewMerkleTree(leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.EpochValidators{
+		Epoch:          epoch,
+		Header:         header,
+		ValidatorsHash: validatorsHash,
+		Root:           tree.Hash(),
+		Validators:     make([]*types.EpochValidator, len(validators)),
+	}
+
+	for i, v := range validators {
+		proof, err := tree.GenerateProof(leaves[i])
+		if err != nil {
+			return nil, err
+		}
+
+		result.Validators[i] = &types.EpochValidator{
+			Address:     v.Address,
+			BlsKey:      v.BlsKey.Marshal(),
+			VotingPower: new(big.Int).Set(v.VotingPower),
+			Leaf:        leaves[i],
+			Proof:       proof,
+		}
+	}
+
+	return result, nil
+}
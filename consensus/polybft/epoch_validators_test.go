@@ -0,0 +1,113 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/merkle-tree"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolybft_GetValidatorsByEpoch(t *testing.T) {
+	t.Parallel()
+
+	const epochSize = uint64(5)
+
+	allValidators := validator.NewTestValidators(t, 6).GetPublicIdentities()
+	epochOneValidators := allValidators[:4]
+	epochTwoValidators := allValidators[2:]
+
+	epochOneHash, err := epochOneValidators.Hash()
+	require.NoError(t, err)
+
+	epochTwoHash, err := epochTwoValidators.Hash()
+	require.NoError(t, err)
+
+	headersMap := &testHeadersMap{headersByNumber: make(map[uint64]*types.Header)}
+
+	addHeader := func(number, epoch uint64, oldValidators, newValidators validator.AccountSet,
+		currentHash types.Hash) {
+		delta, err := validator.CreateValidatorSetDelta(oldValidators, newValidators)
+		require.NoError(t, err)
+
+		extra := &Extra{
+			Validators: delta,
+			Checkpoint: &CheckpointData{EpochNumber: epoch, CurrentValidatorsHash: currentHash},
+			Committed:  &Signature{AggregatedSignature: []byte{1, 2, 3}},
+		}
+
+		headersMap.addHeader(&types.Header{Number: number, ExtraData: extra.MarshalRLPTo(nil)})
+	}
+
+	addHeader(0, 0, nil, epochOneValidators, types.ZeroHash)
+
+	for i := uint64(1); i < epochSize; i++ {
+		addHeader(i, 1, nil, nil, epochOneHash)
+	}
+
+	addHeader(epochSize, 1, epochOneValidators, epochTwoValidators, epochOneHash)
+
+	for i := epochSize + 1; i < 2*epochSize; i++ {
+		addHeader(i, 2, nil, nil, epochTwoHash)
+	}
+
+	// the validator set doesn't change at the end of the second epoch
+	addHeader(2*epochSize, 2, epochTwoValidators, epochTwoValidators, epochTwoHash)
+	addHeader(2*epochSize+1, 3, nil, nil, epochTwoHash)
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+
+	polybft := &Polybft{
+		blockchain:      blockchainMock,
+		consensusConfig: &PolyBFTConfig{EpochSize: epochSize},
+		validatorsCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock, epochSize),
+	}
+
+	cases := []struct {
+		epoch      uint64
+		validators validator.AccountSet
+		hash       types.Hash
+	}{
+		{1, epochOneValidators, epochOneHash},
+		{2, epochTwoValidators, epochTwoHash},
+	}
+
+	for _, c := range cases {
+		result, err := polybft.GetValidatorsByEpoch(c.epoch)
+		require.NoError(t, err)
+
+		require.Equal(t, c.epoch, result.Epoch)
+		require.Equal(t, c.epoch*epochSize, result.Header.Number)
+		require.Equal(t, c.hash, result.ValidatorsHash)
+		require.Len(t, result.Validators, len(c.validators))
+
+		// the header is stripped of the seals
+		extra, err := GetIbftExtra(result.Header.ExtraData)
+		require.NoError(t, err)
+		require.Empty(t, extra.Committed.AggregatedSignature)
+		require.Equal(t, c.hash, extra.Checkpoint.CurrentValidatorsHash)
+
+		for i, v := range result.Validators {
+			require.Equal(t, c.validators[i].Address, v.Address)
+			require.Equal(t, c.validators[i].BlsKey.Marshal(), v.BlsKey)
+			require.Equal(t, c.validators[i].VotingPower, v.VotingPower)
+			require.NoError(t, merkle.VerifyProof(uint64(i), v.Leaf, v.Proof, result.Root))
+		}
+	}
+
+	_, err = polybft.GetValidatorsByEpoch(0)
+	require.ErrorIs(t, err, errInvalidEpoch)
+
+	_, err = polybft.GetValidatorsByEpoch(3)
+	require.ErrorIs(t, err, errEpochNotEnded)
+
+	// the committed validators hash doesn't match the validator set
+	addHeader(2*epochSize, 2, epochTwoValidators, epochTwoValidators, epochOneHash)
+
+	_, err = polybft.GetValidatorsByEpoch(2)
+	require.ErrorContains(t, err, "don't match the validators hash")
+}
@@ -121,6 +121,13 @@ func (f *fsm) BuildProposal(currentRound uint64) ([]byte, error) {
 	// for non-epoch ending blocks, currentValidatorsHash is the same as the nextValidatorsHash
 	nextValidators := f.validators.Accounts()
 
+	if err := validateProposalTimestamp(parent, f.config.BlockTime.Duration,
+		f.config.MaxBlockTimestampSkew, time.Now().UTC()); err != nil {
+		metrics.IncrCounter([]string{consensusMetricsPrefix, "proposal_timestamp_skew_refused"}, 1)
+
+		return nil, err
+	}
+
 	if err := f.blockBuilder.Reset(); err != nil {
 		return nil, fmt.Errorf("failed to initialize block builder: %w", err)
 	}
@@ -653,6 +660,26 @@ func verifyBridgeCommitmentTx(blockNumber uint64, txHash types.Hash,
 	return nil
 }
 
+// validateProposalTimestamp checks that the timestamp of the block built on top of the given parent
+// is not more than maxSkew seconds ahead of the wall clock, so the clock drift of the previous proposers
+// doesn't propagate into the new headers. The check is disabled if maxSkew is zero
+func validateProposalTimestamp(parent *types.Header, blockTime time.Duration, maxSkew uint64, now time.Time) error {
+	if maxSkew == 0 {
+		return nil
+	}
+
+	// the block builder never sets the timestamp before the wall clock,
+	// so it is ahead of it only if the parent timestamp is too far in the future
+	headerTime := time.Unix(int64(parent.Timestamp), 0).Add(blockTime)
+	if skew := headerTime.Sub(now); skew > time.Duration(maxSkew)*time.Second {
+		return fmt.Errorf("refusing to build block %d with timestamp %s, it is %s ahead of the wall clock "+
+			"(max block timestamp skew %d seconds)",
+			parent.Number+1, headerTime.UTC().Format(time.RFC3339), skew, maxSkew)
+	}
+
+	return nil
+}
+
 func validateHeaderFields(parent *types.Header, header *types.Header, blockTimeDrift uint64) error {
 	// header extra data must be higher or equal to ExtraVanity = 32 in order to be compliant with Ethereum blocks
 	if len(header.ExtraData) < ExtraVanity {
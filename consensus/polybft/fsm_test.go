@@ -276,6 +276,7 @@ func TestFSM_BuildProposal_EpochEndingBlock_FailedToApplyStateTx(t *testing.T) {
 	validatorSet := validator.NewValidatorSet(validators.GetPublicIdentities(), hclog.NewNullLogger())
 
 	fsm := &fsm{parent: parent, blockBuilder: mBlockBuilder, backend: &blockchainMock{},
+		config:            &PolyBFTConfig{},
 		isEndOfEpoch:      true,
 		validators:        validatorSet,
 		commitEpochInput:  createTestCommitEpochInput(t, 0, 10),
@@ -949,6 +950,27 @@ func TestFSM_Validate_EpochEndingBlock_UpdatingValidatorSetInNonEpochEndingBlock
 	blockchainMock.AssertExpectations(t)
 }
 
+func TestFSM_ValidateProposalTimestamp(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	parent := &types.Header{Number: 5, Timestamp: 1000}
+
+	// disabled check
+	require.NoError(t, validateProposalTimestamp(&types.Header{Timestamp: 5000}, time.Second, 0, now))
+
+	// the block timestamp is 2 seconds ahead of the wall clock
+	require.NoError(t, validateProposalTimestamp(parent, 2*time.Second, 2, now))
+	require.ErrorContains(t, validateProposalTimestamp(parent, 2*time.Second, 1, now),
+		"refusing to build block 6")
+
+	// the parent timestamp is behind the wall clock
+	require.NoError(t, validateProposalTimestamp(parent, 2*time.Second, 1, now.Add(time.Minute)))
+
+	require.Equal(t, 2*time.Second, blockTimestampDrift(&types.Header{Timestamp: 1002}, now))
+	require.Equal(t, -3*time.Second, blockTimestampDrift(&types.Header{Timestamp: 997}, now))
+}
+
 func TestFSM_Validate_IncorrectHeaderParentHash(t *testing.T) {
 	t.Parallel()
 
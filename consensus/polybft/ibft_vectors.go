@@ -0,0 +1,481 @@
+package polybft
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	ethgoWallet "github.com/umbracle/ethgo/wallet"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	// ibftVectorsValidators is the number of the validators signing the conformance vectors
+	ibftVectorsValidators = 4
+	// ibftVectorsHeight is the height of the consensus messages in the conformance vectors
+	ibftVectorsHeight = 1
+)
+
+var errInvalidVectors = errors.New("invalid IBFT conformance vectors")
+
+// ibftVectorMessage is the consensus message of the conformance vectors along with its signer
+type ibftVectorMessage struct {
+	name string
+	key  *wallet.Key
+	msg  *proto.Message
+}
+
+// IBFTVectorKey holds the keys of the validator signing the conformance vectors.
+// The keys are derived deterministically and must never be used outside of the tests
+type IBFTVectorKey struct {
+	Address      types.Address `json:"address"`
+	ECDSAKey     string        `json:"ecdsaKey"`
+	BLSKey       string        `json:"blsKey"`
+	BLSPublicKey string        `json:"blsPublicKey"`
+}
+
+// IBFTVector is a single signed IBFT consensus message in its canonical wire format
+type IBFTVector struct {
+	Name   string        `json:"name"`
+	Type   string        `json:"type"`
+	Height uint64        `json:"height"`
+	Round  uint64        `json:"round"`
+	Signer types.Address `json:"signer"`
+	// Message is the protobuf encoded signed message
+	Message string `json:"message"`
+	// SigningHash is the keccak256 hash of the message encoded without the signature,
+	// which is signed by the ECDSA key of the signer
+	SigningHash types.Hash `json:"signingHash"`
+	// MessageHash is the keccak256 hash of the encoded signed message
+	MessageHash types.Hash `json:"messageHash"`
+	// ProposalHash is the checkpoint hash of the proposal the message refers to
+	ProposalHash types.Hash `json:"proposalHash"`
+}
+
+// IBFTVectors are the canonical test vectors of the IBFT consensus messages,
+// used by the alternative client implementations to check the wire compatibility
+type IBFTVectors struct {
+	ChainID uint64           `json:"chainId"`
+	Keys    []*IBFTVectorKey `json:"keys"`
+	Vectors []*IBFTVector    `json:"vectors"`
+}
+
+// GenerateIBFTVectors generates the conformance vectors for the given chain id.
+// The output is deterministic: the keys are derived from the fixed seeds
+// and both ECDSA (RFC6979) and BLS signatures are deterministic
+func GenerateIBFTVectors(chainID uint64) (*IBFTVectors, error) {
+	setupHeaderHashFunc()
+
+	keys := make([]*wallet.Key, ibftVectorsValidators)
+	vectors := &IBFTVectors{
+		ChainID: chainID,
+		Keys:    make([]*IBFTVectorKey, ibftVectorsValidators),
+	}
+
+	for i := range keys {
+		account, vectorKey, err := ibftVectorAccount(i)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = wallet.NewKey(account)
+		vectors.Keys[i] = vectorKey
+	}
+
+	rawProposal, proposalHash, err := ibftVectorProposal(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &proto.View{Height: ibftVectorsHeight, Round: 0}
+	nextView := &proto.View{Height: ibftVectorsHeight, Round: 1}
+	proposal := &proto.Proposal{RawProposal: rawProposal, Round: view.Round}
+
+	committedSeal, err := keys[2].SignWithDomain(proposalHash.Bytes(), bls.DomainCheckpointManager)
+	if err != nil {
+		return nil, err
+	}
+
+	preprepare := &proto.Message{
+		View: view,
+		From: keys[0].Address().Bytes(),
+		Type: proto.MessageType_PREPREPARE,
+		Payload: &proto.Message_PreprepareData{
+			PreprepareData: &proto.PrePrepareMessage{
+				Proposal:     proposal,
+				ProposalHash: proposalHash.Bytes(),
+			},
+		},
+	}
+
+	prepare := &proto.Message{
+		View: view,
+		From: keys[1].Address().Bytes(),
+		Type: proto.MessageType_PREPARE,
+		Payload: &proto.Message_PrepareData{
+			PrepareData: &proto.PrepareMessage{ProposalHash: proposalHash.Bytes()},
+		},
+	}
+
+	commit := &proto.Message{
+		View: view,
+		From: keys[2].Address().Bytes(),
+		Type: proto.MessageType_COMMIT,
+		Payload: &proto.Message_CommitData{
+			CommitData: &proto.CommitMessage{
+				ProposalHash:  proposalHash.Bytes(),
+				CommittedSeal: committedSeal,
+			},
+		},
+	}
+
+	emptyRoundChange := &proto.Message{
+		View: nextView,
+		From: keys[3].Address().Bytes(),
+		Type: proto.MessageType_ROUND_CHANGE,
+		Payload: &proto.Message_RoundChangeData{
+			RoundChangeData: &proto.RoundChangeMessage{},
+		},
+	}
+
+	// the round change of the validator which has seen the proposal prepared in the previous round
+	preparedRoundChange := &proto.Message{
+		View: nextView,
+		From: keys[1].Address().Bytes(),
+		Type: proto.MessageType_ROUND_CHANGE,
+		Payload: &proto.Message_RoundChangeData{
+			RoundChangeData: &proto.RoundChangeMessage{
+				LastPreparedProposal: proposal,
+				LatestPreparedCertificate: &proto.PreparedCertificate{
+					ProposalMessage: preprepare,
+					PrepareMessages: []*proto.Message{prepare},
+				},
+			},
+		},
+	}
+
+	// messages are signed in order, so the certificate of the last one holds the signed messages
+	messages := []*ibftVectorMessage{
+		{name: "preprepare", key: keys[0], msg: preprepare},
+		{name: "prepare", key: keys[1], msg: prepare},
+		{name: "commit", key: keys[2], msg: commit},
+		{name: "round-change-empty", key: keys[3], msg: emptyRoundChange},
+		{name: "round-change-prepared", key: keys[1], msg: preparedRoundChange},
+	}
+
+	for _, m := range messages {
+		if _, err := m.key.SignIBFTMessage(m.msg); err != nil {
+			return nil, err
+		}
+	}
+
+	vectors.Vectors = make([]*IBFTVector, len(messages))
+
+	for i, m := range messages {
+		raw, err := protobuf.Marshal(m.msg)
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal %s message: %w", m.name, err)
+		}
+
+		msgNoSig, err := m.msg.PayloadNoSig()
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal %s message: %w", m.name, err)
+		}
+
+		vectors.Vectors[i] = &IBFTVector{
+			Name:         m.name,
+			Type:         m.msg.Type.String(),
+			Height:       m.msg.View.Height,
+			Round:        m.msg.View.Round,
+			Signer:       types.Address(m.key.Address()),
+			Message:      hex.EncodeToHex(raw),
+			SigningHash:  types.BytesToHash(crypto.Keccak256(msgNoSig)),
+			MessageHash:  types.BytesToHash(crypto.Keccak256(raw)),
+			ProposalHash: proposalHash,
+		}
+	}
+
+	return vectors, nil
+}
+
+// VerifyIBFTVectors verifies the conformance vectors, which can be produced by an alternative
+// client implementation. It checks that every message is encoded canonically, that the hashes match,
+// that it is signed by the declared signer and that the proposal and committed seals are valid
+func VerifyIBFTVectors(vectors *IBFTVectors) error {
+	setupHeaderHashFunc()
+
+	if len(vectors.Vectors) == 0 {
+		return fmt.Errorf("%w: no vectors", errInvalidVectors)
+	}
+
+	blsKeys := make(map[types.Address]*bls.PublicKey, len(vectors.Keys))
+
+	for _, key := range vectors.Keys {
+		blsKey, err := verifyIBFTVectorKey(key)
+		if err != nil {
+			return err
+		}
+
+		blsKeys[key.Address] = blsKey
+	}
+
+	for _, vector := range vectors.Vectors {
+		if err := verifyIBFTVector(vectors.ChainID, vector, blsKeys); err != nil {
+			return fmt.Errorf("%w: vector %s: %w", errInvalidVectors, vector.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// verifyIBFTVectorKey checks that the keys match the declared address and BLS public key
+func verifyIBFTVectorKey(key *IBFTVectorKey) (*bls.PublicKey, error) {
+	ecdsaRaw, err := hex.DecodeHex(key.ECDSAKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: key %s: invalid ecdsa key: %w", errInvalidVectors, key.Address, err)
+	}
+
+	ecdsaKey, err := ethgoWallet.NewWalletFromPrivKey(ecdsaRaw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: key %s: invalid ecdsa key: %w", errInvalidVectors, key.Address, err)
+	}
+
+	if types.Address(ecdsaKey.Address()) != key.Address {
+		return nil, fmt.Errorf("%w: key %s: ecdsa key belongs to %s", errInvalidVectors, key.Address, ecdsaKey.Address())
+	}
+
+	blsKey, err := bls.UnmarshalPrivateKey([]byte(key.BLSKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: key %s: invalid bls key: %w", errInvalidVectors, key.Address, err)
+	}
+
+	if hex.EncodeToHex(blsKey.PublicKey().Marshal()) != key.BLSPublicKey {
+		return nil, fmt.Errorf("%w: key %s: bls public key mismatch", errInvalidVectors, key.Address)
+	}
+
+	return blsKey.PublicKey(), nil
+}
+
+// verifyIBFTVector checks a single conformance vector
+func verifyIBFTVector(chainID uint64, vector *IBFTVector, blsKeys map[types.Address]*bls.PublicKey) error {
+	raw, err := hex.DecodeHex(vector.Message)
+	if err != nil {
+		return fmt.Errorf("invalid message encoding: %w", err)
+	}
+
+	msg := &proto.Message{}
+	if err := protobuf.Unmarshal(raw, msg); err != nil {
+		return fmt.Errorf("cannot unmarshal message: %w", err)
+	}
+
+	// the message must be encoded in the same way as it is re-encoded by this client
+	canonical, err := protobuf.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("cannot marshal message: %w", err)
+	}
+
+	if !bytes.Equal(raw, canonical) {
+		return errors.New("message is not canonically encoded")
+	}
+
+	if msg.Type.String() != vector.Type {
+		return fmt.Errorf("message type %s doesn't match the expected %s", msg.Type, vector.Type)
+	}
+
+	if msg.View == nil || msg.View.Height != vector.Height || msg.View.Round != vector.Round {
+		return fmt.Errorf("message view %v doesn't match height %d and round %d", msg.View, vector.Height, vector.Round)
+	}
+
+	if hash := types.BytesToHash(crypto.Keccak256(raw)); hash != vector.MessageHash {
+		return fmt.Errorf("message hash %s doesn't match the expected %s", hash, vector.MessageHash)
+	}
+
+	msgNoSig, err := msg.PayloadNoSig()
+	if err != nil {
+		return fmt.Errorf("cannot marshal message without signature: %w", err)
+	}
+
+	if hash := types.BytesToHash(crypto.Keccak256(msgNoSig)); hash != vector.SigningHash {
+		return fmt.Errorf("signing hash %s doesn't match the expected %s", hash, vector.SigningHash)
+	}
+
+	signer, err := wallet.RecoverAddressFromSignature(msg.Signature, msgNoSig)
+	if err != nil {
+		return err
+	}
+
+	if signer != vector.Signer || !bytes.Equal(msg.From, signer.Bytes()) {
+		return fmt.Errorf("signer %s doesn't match the expected %s and From field", signer, vector.Signer)
+	}
+
+	return verifyIBFTVectorPayload(chainID, msg, vector.ProposalHash, blsKeys)
+}
+
+// verifyIBFTVectorPayload checks the proposal hashes and committed seals of the message payload
+func verifyIBFTVectorPayload(
+	chainID uint64,
+	msg *proto.Message,
+	proposalHash types.Hash,
+	blsKeys map[types.Address]*bls.PublicKey,
+) error {
+	switch payload := msg.Payload.(type) {
+	case *proto.Message_PreprepareData:
+		if payload.PreprepareData.Proposal == nil {
+			return errors.New("proposal is missing")
+		}
+
+		hash, err := ibftVectorProposalHash(chainID, payload.PreprepareData.Proposal.RawProposal)
+		if err != nil {
+			return err
+		}
+
+		if hash != proposalHash || !bytes.Equal(payload.PreprepareData.ProposalHash, hash.Bytes()) {
+			return fmt.Errorf("proposal hash %s doesn't match the expected %s", hash, proposalHash)
+		}
+	case *proto.Message_PrepareData:
+		if !bytes.Equal(payload.PrepareData.ProposalHash, proposalHash.Bytes()) {
+			return errors.New("prepared proposal hash doesn't match")
+		}
+	case *proto.Message_CommitData:
+		if !bytes.Equal(payload.CommitData.ProposalHash, proposalHash.Bytes()) {
+			return errors.New("committed proposal hash doesn't match")
+		}
+
+		blsKey, ok := blsKeys[types.BytesToAddress(msg.From)]
+		if !ok {
+			return fmt.Errorf("bls key of %s is missing", types.BytesToAddress(msg.From))
+		}
+
+		seal, err := bls.UnmarshalSignature(payload.CommitData.CommittedSeal)
+		if err != nil {
+			return fmt.Errorf("cannot unmarshal committed seal: %w", err)
+		}
+
+		if !seal.Verify(blsKey, proposalHash.Bytes(), bls.DomainCheckpointManager) {
+			return errors.New("invalid committed seal")
+		}
+	case *proto.Message_RoundChangeData:
+		certificate := payload.RoundChangeData.LatestPreparedCertificate
+		if certificate == nil {
+			return nil
+		}
+
+		if certificate.ProposalMessage == nil {
+			return errors.New("prepared certificate proposal message is missing")
+		}
+
+		for _, m := range append([]*proto.Message{certificate.ProposalMessage}, certificate.PrepareMessages...) {
+			msgNoSig, err := m.PayloadNoSig()
+			if err != nil {
+				return err
+			}
+
+			signer, err := wallet.RecoverAddressFromSignature(m.Signature, msgNoSig)
+			if err != nil {
+				return fmt.Errorf("invalid prepared certificate: %w", err)
+			}
+
+			if !bytes.Equal(m.From, signer.Bytes()) {
+				return fmt.Errorf("invalid prepared certificate: signer %s doesn't match From field", signer)
+			}
+
+			if err := verifyIBFTVectorPayload(chainID, m, proposalHash, blsKeys); err != nil {
+				return fmt.Errorf("invalid prepared certificate: %w", err)
+			}
+		}
+	default:
+		return fmt.Errorf("unexpected message payload %T", msg.Payload)
+	}
+
+	return nil
+}
+
+// ibftVectorAccount derives the deterministic account of the i-th vectors validator
+func ibftVectorAccount(i int) (*wallet.Account, *IBFTVectorKey, error) {
+	ecdsaRaw := crypto.Keccak256([]byte(fmt.Sprintf("polybft-ibft-vectors-ecdsa-%d", i)))
+
+	ecdsaKey, err := ethgoWallet.NewWalletFromPrivKey(ecdsaRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blsRaw, err := new(big.Int).SetBytes(
+		crypto.Keccak256([]byte(fmt.Sprintf("polybft-ibft-vectors-bls-%d", i))),
+	).MarshalText()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blsKey, err := bls.UnmarshalPrivateKey(blsRaw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &wallet.Account{Ecdsa: ecdsaKey, Bls: blsKey}, &IBFTVectorKey{
+		Address:      types.Address(ecdsaKey.Address()),
+		ECDSAKey:     hex.EncodeToHex(ecdsaRaw),
+		BLSKey:       string(blsRaw),
+		BLSPublicKey: hex.EncodeToHex(blsKey.PublicKey().Marshal()),
+	}, nil
+}
+
+// ibftVectorProposal builds the deterministic proposal of the vectors and returns it with its hash
+func ibftVectorProposal(chainID uint64) ([]byte, types.Hash, error) {
+	extra := &Extra{
+		Checkpoint: &CheckpointData{
+			EpochNumber:           1,
+			CurrentValidatorsHash: types.StringToHash("1"),
+			NextValidatorsHash:    types.StringToHash("1"),
+		},
+	}
+
+	header := &types.Header{
+		ParentHash:   types.StringToHash("2"),
+		Sha3Uncles:   types.EmptyUncleHash,
+		StateRoot:    types.StringToHash("3"),
+		TxRoot:       types.EmptyRootHash,
+		ReceiptsRoot: types.EmptyRootHash,
+		Number:       ibftVectorsHeight,
+		GasLimit:     30000000,
+		Timestamp:    1700000000,
+		ExtraData:    extra.MarshalRLPTo(nil),
+		MixHash:      PolyBFTMixDigest,
+	}
+	header.ComputeHash()
+
+	rawProposal := (&types.Block{Header: header}).MarshalRLP()
+
+	proposalHash, err := ibftVectorProposalHash(chainID, rawProposal)
+	if err != nil {
+		return nil, types.ZeroHash, err
+	}
+
+	return rawProposal, proposalHash, nil
+}
+
+// ibftVectorProposalHash calculates the checkpoint hash of the raw proposal,
+// in the same way as the consensus runtime does
+func ibftVectorProposalHash(chainID uint64, rawProposal []byte) (types.Hash, error) {
+	block := types.Block{}
+	if err := block.UnmarshalRLP(rawProposal); err != nil {
+		return types.ZeroHash, fmt.Errorf("cannot unmarshal proposal: %w", err)
+	}
+
+	extra, err := GetIbftExtra(block.Header.ExtraData)
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("cannot retrieve proposal extra: %w", err)
+	}
+
+	if extra.Checkpoint == nil {
+		return types.ZeroHash, errors.New("proposal checkpoint is missing")
+	}
+
+	return extra.Checkpoint.Hash(chainID, block.Number(), block.Hash())
+}
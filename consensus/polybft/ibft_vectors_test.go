@@ -0,0 +1,156 @@
+package polybft
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestIBFTVectors_GenerateAndVerify(t *testing.T) {
+	t.Parallel()
+
+	vectors, err := GenerateIBFTVectors(100)
+	require.NoError(t, err)
+	require.Len(t, vectors.Keys, ibftVectorsValidators)
+	require.Len(t, vectors.Vectors, 5)
+
+	expectedTypes := []string{"PREPREPARE", "PREPARE", "COMMIT", "ROUND_CHANGE", "ROUND_CHANGE"}
+	for i, vector := range vectors.Vectors {
+		require.Equal(t, expectedTypes[i], vector.Type)
+	}
+
+	require.NoError(t, VerifyIBFTVectors(vectors))
+
+	// vectors survive the JSON round trip
+	raw, err := json.Marshal(vectors)
+	require.NoError(t, err)
+
+	decoded := &IBFTVectors{}
+	require.NoError(t, json.Unmarshal(raw, decoded))
+	require.NoError(t, VerifyIBFTVectors(decoded))
+
+	// vectors are deterministic
+	again, err := GenerateIBFTVectors(100)
+	require.NoError(t, err)
+	require.Equal(t, vectors, again)
+
+	// proposal hash depends on the chain id
+	other, err := GenerateIBFTVectors(101)
+	require.NoError(t, err)
+	require.NotEqual(t, vectors.Vectors[0].ProposalHash, other.Vectors[0].ProposalHash)
+	require.ErrorIs(t, VerifyIBFTVectors(&IBFTVectors{ChainID: 100, Keys: other.Keys, Vectors: other.Vectors}),
+		errInvalidVectors)
+}
+
+func TestIBFTVectors_VerifyInvalid(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		tamper func(*IBFTVectors)
+		err    string
+	}{
+		{
+			name:   "no vectors",
+			tamper: func(v *IBFTVectors) { v.Vectors = nil },
+			err:    "no vectors",
+		},
+		{
+			name:   "wrong key address",
+			tamper: func(v *IBFTVectors) { v.Keys[0].Address = types.StringToAddress("1") },
+			err:    "ecdsa key belongs to",
+		},
+		{
+			name:   "wrong signer",
+			tamper: func(v *IBFTVectors) { v.Vectors[1].Signer = v.Keys[0].Address },
+			err:    "doesn't match the expected",
+		},
+		{
+			name:   "wrong message hash",
+			tamper: func(v *IBFTVectors) { v.Vectors[2].MessageHash = types.StringToHash("1") },
+			err:    "message hash",
+		},
+		{
+			name:   "wrong type",
+			tamper: func(v *IBFTVectors) { v.Vectors[3].Type = "COMMIT" },
+			err:    "message type",
+		},
+		{
+			name: "invalid committed seal",
+			tamper: func(v *IBFTVectors) {
+				// the commit message signed correctly, but with the seal of the other validator
+				account, _, err := ibftVectorAccount(0)
+				require.NoError(t, err)
+
+				msg := decodeVectorMessage(t, v.Vectors[2])
+				commit, ok := msg.Payload.(*proto.Message_CommitData)
+				require.True(t, ok)
+
+				seal, err := account.Bls.Sign(v.Vectors[2].ProposalHash.Bytes(), []byte("other domain"))
+				require.NoError(t, err)
+
+				commit.CommitData.CommittedSeal, err = seal.Marshal()
+				require.NoError(t, err)
+
+				reencodeVectorMessage(t, v.Vectors[2], msg, 2)
+			},
+			err: "invalid committed seal",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			vectors, err := GenerateIBFTVectors(100)
+			require.NoError(t, err)
+
+			c.tamper(vectors)
+
+			err = VerifyIBFTVectors(vectors)
+			require.ErrorIs(t, err, errInvalidVectors)
+			require.ErrorContains(t, err, c.err)
+		})
+	}
+}
+
+func decodeVectorMessage(t *testing.T, vector *IBFTVector) *proto.Message {
+	t.Helper()
+
+	msg := &proto.Message{}
+	require.NoError(t, protobuf.Unmarshal(hex.MustDecodeHex(vector.Message), msg))
+
+	return msg
+}
+
+// reencodeVectorMessage signs the message with the key of the i-th validator and updates the vector
+func reencodeVectorMessage(t *testing.T, vector *IBFTVector, msg *proto.Message, i int) {
+	t.Helper()
+
+	account, _, err := ibftVectorAccount(i)
+	require.NoError(t, err)
+
+	msg.Signature = nil
+
+	msg, err = wallet.NewKey(account).SignIBFTMessage(msg)
+	require.NoError(t, err)
+
+	raw, err := protobuf.Marshal(msg)
+	require.NoError(t, err)
+
+	msgNoSig, err := msg.PayloadNoSig()
+	require.NoError(t, err)
+
+	vector.Message = hex.EncodeToHex(raw)
+	vector.MessageHash = types.BytesToHash(crypto.Keccak256(raw))
+	vector.SigningHash = types.BytesToHash(crypto.Keccak256(msgNoSig))
+}
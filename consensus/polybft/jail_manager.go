@@ -0,0 +1,149 @@
+package polybft
+
+import (
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// jailManager tracks the consecutive blocks whose commit seal doesn't include the validator signature,
+// and jails the validators which missed too many of them, so they are excluded from the next validator sets.
+// The commit seal of the block is collected locally, so the signatures of the parent block included
+// in the block extra are used instead. Those are agreed on by the validators, so all the nodes jail the same validators
+type jailManager struct {
+	state               *State
+	config              *JailConfig
+	minValidatorSetSize uint64
+	logger              hclog.Logger
+}
+
+// newJailManager creates a new instance of jailManager
+func newJailManager(state *State, config *JailConfig, minValidatorSetSize uint64,
+	logger hclog.Logger) *jailManager {
+	return &jailManager{
+		state:               state,
+		config:              config,
+		minValidatorSetSize: minValidatorSetSize,
+		logger:              logger,
+	}
+}
+
+// PostBlock updates the missed blocks of the validators of the parent block, based on the parent signatures
+// of the block, jails the ones which reached the threshold and unjails the ones which sent the unjail transaction
+// after their jail period passed. The parent validators are nil for the first block, as the genesis isn't signed
+func (m *jailManager) PostBlock(req *PostBlockRequest, parentValidators validator.AccountSet) error {
+	header := req.FullBlock.Block.Header
+
+	state, err := m.state.JailStore.getJailState()
+	if err != nil {
+		return err
+	}
+
+	if state.BlockNumber >= header.Number {
+		// block already processed
+		return nil
+	}
+
+	extra, err := GetIbftExtra(header.ExtraData)
+	if err != nil {
+		return err
+	}
+
+	if extra.Parent != nil && parentValidators != nil {
+		signers, err := parentValidators.GetFilteredValidators(extra.Parent.Bitmap)
+		if err != nil {
+			return err
+		}
+
+		m.updateMissed(state, parentValidators, signers.GetAddressesAsSet(), req.Epoch, header.Number)
+	}
+
+	m.unjail(state, req)
+
+	state.BlockNumber = header.Number
+
+	return m.state.JailStore.insertJailState(state)
+}
+
+// updateMissed updates the consecutive missed blocks of the validators and jails the ones which reached the threshold
+func (m *jailManager) updateMissed(state *jailState, validators validator.AccountSet,
+	signers map[types.Address]struct{}, epoch, blockNumber uint64) {
+	// the validators which are not jailed yet, the set must not shrink below its minimum size
+	free := uint64(0)
+
+	for _, v := range validators {
+		if _, jailed := state.Jailed[v.Address]; !jailed {
+			free++
+		}
+	}
+
+	for _, v := range validators {
+		if _, jailed := state.Jailed[v.Address]; jailed {
+			continue
+		}
+
+		if _, signed := signers[v.Address]; signed {
+			delete(state.Missed, v.Address)
+
+			continue
+		}
+
+		state.Missed[v.Address]++
+
+		if state.Missed[v.Address] < m.config.MissedBlocksThreshold {
+			continue
+		}
+
+		if free <= m.minValidatorSetSize {
+			m.logger.Warn("validator not jailed, the validator set would shrink below its minimum size",
+				"address", v.Address, "missed", state.Missed[v.Address])
+
+			continue
+		}
+
+		state.Jailed[v.Address] = &JailRecord{
+			Address:       v.Address,
+			JailedAtBlock: blockNumber,
+			ReleaseEpoch:  epoch + m.config.JailEpochs,
+		}
+
+		delete(state.Missed, v.Address)
+
+		free--
+
+		m.logger.Info("validator jailed", "address", v.Address, "block", blockNumber,
+			"releaseEpoch", epoch+m.config.JailEpochs)
+	}
+}
+
+// unjail releases the jailed validators which sent the successful unjail transaction in the block,
+// once their jail period passed
+func (m *jailManager) unjail(state *jailState, req *PostBlockRequest) {
+	for i, tx := range req.FullBlock.Block.Transactions {
+		if tx.To == nil || *tx.To != contracts.UnjailAddr {
+			continue
+		}
+
+		if i >= len(req.FullBlock.Receipts) || req.FullBlock.Receipts[i].Status == nil ||
+			*req.FullBlock.Receipts[i].Status != types.ReceiptSuccess {
+			continue
+		}
+
+		record, jailed := state.Jailed[tx.From]
+		if !jailed {
+			continue
+		}
+
+		if req.Epoch < record.ReleaseEpoch {
+			m.logger.Debug("unjail transaction sent before the jail period passed",
+				"address", tx.From, "epoch", req.Epoch, "releaseEpoch", record.ReleaseEpoch)
+
+			continue
+		}
+
+		delete(state.Jailed, tx.From)
+
+		m.logger.Info("validator unjailed", "address", tx.From, "block", req.FullBlock.Block.Number())
+	}
+}
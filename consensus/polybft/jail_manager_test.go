@@ -0,0 +1,116 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJailManager_PostBlock(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B", "C", "D"})
+	accounts := validators.GetPublicIdentities()
+	state := newTestState(t)
+
+	manager := newJailManager(state, &JailConfig{MissedBlocksThreshold: 2, JailEpochs: 2}, 3,
+		hclog.NewNullLogger())
+
+	createRequest := func(epoch, number uint64, txs []*types.Transaction, signers ...int) *PostBlockRequest {
+		parent := bitmap.Bitmap{}
+		for _, i := range signers {
+			parent.Set(uint64(i))
+		}
+
+		// the commit seal is collected locally, so it's ignored
+		extra := &Extra{
+			Parent:     &Signature{Bitmap: parent},
+			Committed:  &Signature{Bitmap: bitmap.Bitmap{}},
+			Checkpoint: &CheckpointData{EpochNumber: epoch},
+		}
+
+		receipts := make([]*types.Receipt, len(txs))
+		for i := range txs {
+			receipts[i] = &types.Receipt{}
+			receipts[i].SetStatus(types.ReceiptSuccess)
+		}
+
+		return &PostBlockRequest{
+			Epoch: epoch,
+			FullBlock: &types.FullBlock{
+				Block: &types.Block{
+					Header:       &types.Header{Number: number, ExtraData: extra.MarshalRLPTo(nil)},
+					Transactions: txs,
+				},
+				Receipts: receipts,
+			},
+		}
+	}
+
+	getState := func() *jailState {
+		s, err := state.JailStore.getJailState()
+		require.NoError(t, err)
+
+		return s
+	}
+
+	// the first block doesn't have the parent signatures
+	require.NoError(t, manager.PostBlock(createRequest(1, 1, nil), nil))
+	require.Empty(t, getState().Missed)
+
+	// C and D miss the parent block
+	require.NoError(t, manager.PostBlock(createRequest(1, 2, nil, 0, 1), accounts))
+	require.Empty(t, getState().Jailed)
+	require.Equal(t, uint64(1), getState().Missed[accounts[2].Address])
+
+	// D signs the parent of the third block, so only C is jailed
+	require.NoError(t, manager.PostBlock(createRequest(1, 3, nil, 0, 1, 3), accounts))
+
+	jailed := getState().Jailed
+	require.Len(t, jailed, 1)
+	require.Equal(t, uint64(3), jailed[accounts[2].Address].ReleaseEpoch)
+	require.Empty(t, getState().Missed)
+
+	// already processed block is skipped
+	require.NoError(t, manager.PostBlock(createRequest(1, 3, nil, 0), accounts))
+	require.Empty(t, getState().Missed)
+
+	// D reaches the threshold, but jailing it would shrink the validator set below its minimum size
+	require.NoError(t, manager.PostBlock(createRequest(1, 4, nil, 0, 1), accounts))
+	require.NoError(t, manager.PostBlock(createRequest(1, 5, nil, 0, 1), accounts))
+	require.Len(t, getState().Jailed, 1)
+	require.Equal(t, uint64(2), getState().Missed[accounts[3].Address])
+
+	unjailTx := &types.Transaction{From: accounts[2].Address, To: &contracts.UnjailAddr}
+
+	// unjail transaction sent before the jail period passed is ignored
+	require.NoError(t, manager.PostBlock(createRequest(2, 6, []*types.Transaction{unjailTx}, 0, 1, 3), accounts))
+	require.Len(t, getState().Jailed, 1)
+
+	// C is unjailed once the jail period passed
+	require.NoError(t, manager.PostBlock(createRequest(3, 7, []*types.Transaction{unjailTx}, 0, 1, 3), accounts))
+	require.Empty(t, getState().Jailed)
+}
+
+func TestValidatorStakeMap_Exclude(t *testing.T) {
+	t.Parallel()
+
+	stakes := validatorStakeMap{}
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B", "C"}, []uint64{10, 20, 30})
+
+	for _, v := range validators.GetPublicIdentities() {
+		stakes[v.Address] = v
+	}
+
+	excluded := stakes.exclude(map[types.Address]struct{}{validators.GetValidator("B").Address(): {}})
+	require.Len(t, excluded, 2)
+	require.NotContains(t, excluded, validators.GetValidator("B").Address())
+	require.Len(t, stakes, 3)
+
+	require.Len(t, stakes.exclude(nil), 3)
+}
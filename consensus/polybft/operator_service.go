@@ -0,0 +1,64 @@
+package polybft
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
+)
+
+type operator struct {
+	proto.UnimplementedPolybftOperatorServer
+
+	state *State
+}
+
+// ValidatorStats returns the per-validator statistics over the last requested epochs
+func (o *operator) ValidatorStats(_ context.Context,
+	req *proto.ValidatorStatsRequest) (*proto.ValidatorStatsResponse, error) {
+	epochs := req.Epochs
+	if epochs == 0 {
+		epochs = 1
+	}
+
+	stats, err := o.state.ValidatorStatsStore.getLastEpochsValidatorStats(epochs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ValidatorStatsResponse{
+		Epochs: make([]*proto.ValidatorStatsResponse_EpochStats, len(stats)),
+	}
+
+	for i, epochStats := range stats {
+		validators := make([]*ValidatorStats, 0, len(epochStats.Validators))
+		for _, v := range epochStats.Validators {
+			validators = append(validators, v)
+		}
+
+		sort.Slice(validators, func(i, j int) bool {
+			return bytes.Compare(validators[i].Address[:], validators[j].Address[:]) < 0
+		})
+
+		resp.Epochs[i] = &proto.ValidatorStatsResponse_EpochStats{
+			Epoch:      epochStats.Epoch,
+			FirstBlock: epochStats.FirstBlock,
+			LastBlock:  epochStats.LastBlock,
+			Validators: make([]*proto.ValidatorStatsResponse_ValidatorStats, len(validators)),
+		}
+
+		for j, v := range validators {
+			resp.Epochs[i].Validators[j] = &proto.ValidatorStatsResponse_ValidatorStats{
+				Address:            v.Address.String(),
+				BlocksProposed:     v.BlocksProposed,
+				BlocksMissed:       v.BlocksMissed,
+				SignaturesIncluded: v.SignaturesIncluded,
+				AverageRound:       v.AverageRound(),
+				Reward:             v.Reward.String(),
+			}
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package polybft
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperator_ValidatorStats(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	op := &operator{state: state}
+
+	// no statistics yet
+	resp, err := op.ValidatorStats(context.Background(), &proto.ValidatorStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Epochs)
+
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		stats := newEpochValidatorStats(epoch, epoch*10)
+		stats.LastBlock = epoch*10 + 9
+
+		v := stats.get(types.StringToAddress("2"))
+		v.BlocksProposed = 4
+		v.RoundsSum = 2
+		v.Reward = big.NewInt(int64(epoch))
+		stats.get(types.StringToAddress("1")).BlocksMissed = epoch
+
+		require.NoError(t, state.ValidatorStatsStore.insertEpochValidatorStats(stats))
+	}
+
+	// the last epoch is returned by default
+	resp, err = op.ValidatorStats(context.Background(), &proto.ValidatorStatsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Epochs, 1)
+	require.Equal(t, uint64(3), resp.Epochs[0].Epoch)
+	require.Equal(t, uint64(30), resp.Epochs[0].FirstBlock)
+	require.Equal(t, uint64(39), resp.Epochs[0].LastBlock)
+
+	// validators are sorted by address
+	validators := resp.Epochs[0].Validators
+	require.Len(t, validators, 2)
+	require.Equal(t, types.StringToAddress("1").String(), validators[0].Address)
+	require.Equal(t, uint64(3), validators[0].BlocksMissed)
+	require.Equal(t, types.StringToAddress("2").String(), validators[1].Address)
+	require.Equal(t, uint64(4), validators[1].BlocksProposed)
+	require.Equal(t, 0.5, validators[1].AverageRound)
+	require.Equal(t, "3", validators[1].Reward)
+
+	// the latest epochs first, limited by the number of stored epochs
+	resp, err = op.ValidatorStats(context.Background(), &proto.ValidatorStatsRequest{Epochs: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.Epochs, 3)
+
+	for i, e := range resp.Epochs {
+		require.Equal(t, uint64(3-i), e.Epoch)
+	}
+}
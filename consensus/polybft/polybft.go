@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"math/big"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
@@ -74,6 +76,9 @@ type Polybft struct {
 	// closeCh is used to signal that consensus protocol is stopped
 	closeCh chan struct{}
 
+	// consensusWg is used to wait for the consensus protocol, i.e. the in-flight block sealing, to stop
+	consensusWg sync.WaitGroup
+
 	// ibft is the ibft engine
 	ibft *IBFTConsensusWrapper
 
@@ -405,21 +410,29 @@ func ForkManagerFactory(forks *chain.Forks) error {
 func (p *Polybft) Initialize() error {
 	p.logger.Info("initializing polybft...")
 
-	// read account
-	account, err := wallet.NewAccountFromSecret(p.config.SecretsManager)
+	// read account or connect to the external signer or Web3Signer
+	signer, err := wallet.NewSigner(p.config.Signer, p.config.SecretsManager, p.logger)
 	if err != nil {
 		return fmt.Errorf("failed to read account data. Error: %w", err)
 	}
 
+	// the checkpoints are submitted to the rootchain by the transactions of the validator
+	if _, ok := signer.(*wallet.Web3Signer); ok && p.consensusConfig.IsBridgeEnabled() {
+		return fmt.Errorf("bridge is enabled, but %w", wallet.ErrWeb3SignerTransactionSigning)
+	}
+
 	// set key
-	p.key = wallet.NewKey(account)
+	p.key = wallet.NewKey(signer)
 
 	// create and set syncer
 	p.syncer = syncer.NewSyncer(
 		p.config.Logger.Named("syncer"),
 		p.config.Network,
 		p.config.Blockchain,
+		p.config.TxPool,
 		time.Duration(p.config.BlockTime)*3*time.Second,
+		p.config.SyncStallTimeout,
+		p.config.SyncPipeline,
 	)
 
 	// set blockchain backend
@@ -449,7 +462,17 @@ func (p *Polybft) Initialize() error {
 	}
 
 	p.state = stt
-	p.validatorsCache = newValidatorsSnapshotCache(p.config.Logger, stt, p.blockchain)
+
+	if p.config.Grpc != nil {
+		// register the operator service
+		proto.RegisterPolybftOperatorServer(p.config.Grpc, &operator{state: stt})
+	}
+
+	if err = p.initEpochSnapshots(); err != nil {
+		return err
+	}
+
+	p.validatorsCache = newValidatorsSnapshotCache(p.config.Logger, stt, p.blockchain, p.consensusConfig.EpochSize)
 
 	// create runtime
 	if err := p.initRuntime(); err != nil {
@@ -480,6 +503,25 @@ func ForkManagerInitialParamsFactory(config *chain.Chain) (*forkmanager.ForkPara
 	}, nil
 }
 
+// initEpochSnapshots drops the archived validator snapshots if the fork schedule has changed since they were stored
+func (p *Polybft) initEpochSnapshots() error {
+	version, err := epochSnapshotsVersion(p.config.Config.Params.Forks)
+	if err != nil {
+		return fmt.Errorf("failed to calculate epoch snapshots version: %w", err)
+	}
+
+	dropped, err := p.state.EpochSnapshotStore.ensureVersion(version)
+	if err != nil {
+		return fmt.Errorf("failed to check epoch snapshots version: %w", err)
+	}
+
+	if dropped {
+		p.logger.Info("fork schedule changed, archived validator snapshots dropped", "version", version)
+	}
+
+	return nil
+}
+
 // Start starts the consensus and servers
 func (p *Polybft) Start() error {
 	p.logger.Info("starting polybft consensus", "signer", p.key.String())
@@ -528,6 +570,11 @@ func (p *Polybft) initRuntime() error {
 		txPool:                p.txPool,
 		bridgeTopic:           p.bridgeTopic,
 		numBlockConfirmations: p.config.NumBlockConfirmations,
+		bridgeAlert:           p.config.BridgeAlert,
+
+		rootchainJSONRPCEndpoints: p.config.RootchainJSONRPCEndpoints,
+		extensions:                p.config.Extensions,
+		rootchainFeeBump:          p.config.RootchainFeeBump,
 	}
 
 	runtime, err := newConsensusRuntime(p.logger, runtimeConfig)
@@ -542,7 +589,13 @@ func (p *Polybft) initRuntime() error {
 
 // startRuntime starts consensus runtime
 func (p *Polybft) startRuntime() error {
-	go p.startConsensusProtocol()
+	p.consensusWg.Add(1)
+
+	go func() {
+		defer p.consensusWg.Done()
+
+		p.startConsensusProtocol()
+	}()
 
 	return nil
 }
@@ -656,6 +709,10 @@ func (p *Polybft) Close() error {
 	}
 
 	close(p.closeCh)
+
+	// wait for the in-flight sequence to stop, so the block being committed is written completely
+	p.consensusWg.Wait()
+
 	p.runtime.close()
 
 	return nil
@@ -711,6 +768,11 @@ func (p *Polybft) ProcessHeaders(_ []*types.Header) error {
 	return nil
 }
 
+// FeeRecipient returns the validator address, which is credited with the fees of the blocks built by the node
+func (p *Polybft) FeeRecipient() (types.Address, error) {
+	return types.Address(p.key.Address()), nil
+}
+
 // GetBlockCreator retrieves the block creator (or signer) given the block header
 func (p *Polybft) GetBlockCreator(h *types.Header) (types.Address, error) {
 	return types.BytesToAddress(h.Miner), nil
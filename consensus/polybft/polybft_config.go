@@ -54,6 +54,13 @@ type PolyBFTConfig struct {
 
 	// BlockTimeDrift defines the time slot in which a new block can be created
 	BlockTimeDrift uint64 `json:"blockTimeDrift"`
+
+	// MaxBlockTimestampSkew defines how many seconds the timestamp of the proposed block can be ahead
+	// of the proposer wall clock, the proposer refuses to build the block otherwise (disabled if zero)
+	MaxBlockTimestampSkew uint64 `json:"maxBlockTimestampSkew,omitempty"`
+
+	// Jail defines the jailing of the offline validators, validators are never jailed if it is not set
+	Jail *JailConfig `json:"jail,omitempty"`
 }
 
 // LoadPolyBFTConfig loads chain config from provided path and unmarshals PolyBFTConfig
@@ -174,6 +181,17 @@ type TokenConfig struct {
 	Owner      types.Address `json:"owner"`
 }
 
+// JailConfig defines when the validators which repeatedly miss to sign the blocks are jailed,
+// and for how long they are excluded from the validator set
+type JailConfig struct {
+	// MissedBlocksThreshold is the number of the consecutive blocks whose commit seal
+	// doesn't include the validator signature, after which the validator is jailed
+	MissedBlocksThreshold uint64 `json:"missedBlocksThreshold"`
+
+	// JailEpochs is the number of the epochs which have to pass before the jailed validator can be unjailed
+	JailEpochs uint64 `json:"jailEpochs"`
+}
+
 type RewardsConfig struct {
 	// TokenAddress is the address of reward token on child chain
 	TokenAddress types.Address
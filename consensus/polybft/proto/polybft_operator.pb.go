@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: consensus/polybft/proto/polybft_operator.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidatorStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of the last epochs to return, the last epoch only if zero
+	Epochs uint64 `protobuf:"varint,1,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (x *ValidatorStatsRequest) Reset() {
+	*x = ValidatorStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatsRequest) ProtoMessage() {}
+
+func (x *ValidatorStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatsRequest.ProtoReflect.Descriptor instead.
+func (*ValidatorStatsRequest) Descriptor() ([]byte, []int) {
+	return file_consensus_polybft_proto_polybft_operator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorStatsRequest) GetEpochs() uint64 {
+	if x != nil {
+		return x.Epochs
+	}
+	return 0
+}
+
+type ValidatorStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs []*ValidatorStatsResponse_EpochStats `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (x *ValidatorStatsResponse) Reset() {
+	*x = ValidatorStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatsResponse) ProtoMessage() {}
+
+func (x *ValidatorStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatsResponse.ProtoReflect.Descriptor instead.
+func (*ValidatorStatsResponse) Descriptor() ([]byte, []int) {
+	return file_consensus_polybft_proto_polybft_operator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorStatsResponse) GetEpochs() []*ValidatorStatsResponse_EpochStats {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+type ValidatorStatsResponse_EpochStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      uint64                                   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	FirstBlock uint64                                   `protobuf:"varint,2,opt,name=first_block,json=firstBlock,proto3" json:"first_block,omitempty"`
+	LastBlock  uint64                                   `protobuf:"varint,3,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+	Validators []*ValidatorStatsResponse_ValidatorStats `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *ValidatorStatsResponse_EpochStats) Reset() {
+	*x = ValidatorStatsResponse_EpochStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStatsResponse_EpochStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatsResponse_EpochStats) ProtoMessage() {}
+
+func (x *ValidatorStatsResponse_EpochStats) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatsResponse_EpochStats.ProtoReflect.Descriptor instead.
+func (*ValidatorStatsResponse_EpochStats) Descriptor() ([]byte, []int) {
+	return file_consensus_polybft_proto_polybft_operator_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ValidatorStatsResponse_EpochStats) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ValidatorStatsResponse_EpochStats) GetFirstBlock() uint64 {
+	if x != nil {
+		return x.FirstBlock
+	}
+	return 0
+}
+
+func (x *ValidatorStatsResponse_EpochStats) GetLastBlock() uint64 {
+	if x != nil {
+		return x.LastBlock
+	}
+	return 0
+}
+
+func (x *ValidatorStatsResponse_EpochStats) GetValidators() []*ValidatorStatsResponse_ValidatorStats {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type ValidatorStatsResponse_ValidatorStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address            string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	BlocksProposed     uint64  `protobuf:"varint,2,opt,name=blocks_proposed,json=blocksProposed,proto3" json:"blocks_proposed,omitempty"`
+	BlocksMissed       uint64  `protobuf:"varint,3,opt,name=blocks_missed,json=blocksMissed,proto3" json:"blocks_missed,omitempty"`
+	SignaturesIncluded uint64  `protobuf:"varint,4,opt,name=signatures_included,json=signaturesIncluded,proto3" json:"signatures_included,omitempty"`
+	AverageRound       float64 `protobuf:"fixed64,5,opt,name=average_round,json=averageRound,proto3" json:"average_round,omitempty"`
+	// reward earned in the epoch, decimal string
+	Reward string `protobuf:"bytes,6,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) Reset() {
+	*x = ValidatorStatsResponse_ValidatorStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorStatsResponse_ValidatorStats) ProtoMessage() {}
+
+func (x *ValidatorStatsResponse_ValidatorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_consensus_polybft_proto_polybft_operator_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorStatsResponse_ValidatorStats.ProtoReflect.Descriptor instead.
+func (*ValidatorStatsResponse_ValidatorStats) Descriptor() ([]byte, []int) {
+	return file_consensus_polybft_proto_polybft_operator_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) GetBlocksProposed() uint64 {
+	if x != nil {
+		return x.BlocksProposed
+	}
+	return 0
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) GetBlocksMissed() uint64 {
+	if x != nil {
+		return x.BlocksMissed
+	}
+	return 0
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) GetSignaturesIncluded() uint64 {
+	if x != nil {
+		return x.SignaturesIncluded
+	}
+	return 0
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) GetAverageRound() float64 {
+	if x != nil {
+		return x.AverageRound
+	}
+	return 0
+}
+
+func (x *ValidatorStatsResponse_ValidatorStats) GetReward() string {
+	if x != nil {
+		return x.Reward
+	}
+	return ""
+}
+
+var File_consensus_polybft_proto_polybft_operator_proto protoreflect.FileDescriptor
+
+var file_consensus_polybft_proto_polybft_operator_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x70, 0x6f, 0x6c, 0x79,
+	0x62, 0x66, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x6c, 0x79, 0x62, 0x66,
+	0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x76, 0x31, 0x22, 0x2f, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xf0, 0x03, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x1a,
+	0xad, 0x01, 0x0a, 0x0a, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x1a,
+	0xe6, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x32, 0x5a, 0x0a, 0x0f, 0x50, 0x6f, 0x6c, 0x79,
+	0x62, 0x66, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x0e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1a, 0x5a, 0x18, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x2f, 0x70, 0x6f, 0x6c, 0x79, 0x62, 0x66, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_consensus_polybft_proto_polybft_operator_proto_rawDescOnce sync.Once
+	file_consensus_polybft_proto_polybft_operator_proto_rawDescData = file_consensus_polybft_proto_polybft_operator_proto_rawDesc
+)
+
+func file_consensus_polybft_proto_polybft_operator_proto_rawDescGZIP() []byte {
+	file_consensus_polybft_proto_polybft_operator_proto_rawDescOnce.Do(func() {
+		file_consensus_polybft_proto_polybft_operator_proto_rawDescData = protoimpl.X.CompressGZIP(file_consensus_polybft_proto_polybft_operator_proto_rawDescData)
+	})
+	return file_consensus_polybft_proto_polybft_operator_proto_rawDescData
+}
+
+var file_consensus_polybft_proto_polybft_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_consensus_polybft_proto_polybft_operator_proto_goTypes = []interface{}{
+	(*ValidatorStatsRequest)(nil),                 // 0: v1.ValidatorStatsRequest
+	(*ValidatorStatsResponse)(nil),                // 1: v1.ValidatorStatsResponse
+	(*ValidatorStatsResponse_EpochStats)(nil),     // 2: v1.ValidatorStatsResponse.EpochStats
+	(*ValidatorStatsResponse_ValidatorStats)(nil), // 3: v1.ValidatorStatsResponse.ValidatorStats
+}
+var file_consensus_polybft_proto_polybft_operator_proto_depIdxs = []int32{
+	2, // 0: v1.ValidatorStatsResponse.epochs:type_name -> v1.ValidatorStatsResponse.EpochStats
+	3, // 1: v1.ValidatorStatsResponse.EpochStats.validators:type_name -> v1.ValidatorStatsResponse.ValidatorStats
+	0, // 2: v1.PolybftOperator.ValidatorStats:input_type -> v1.ValidatorStatsRequest
+	1, // 3: v1.PolybftOperator.ValidatorStats:output_type -> v1.ValidatorStatsResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_consensus_polybft_proto_polybft_operator_proto_init() }
+func file_consensus_polybft_proto_polybft_operator_proto_init() {
+	if File_consensus_polybft_proto_polybft_operator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_consensus_polybft_proto_polybft_operator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_polybft_proto_polybft_operator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_polybft_proto_polybft_operator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStatsResponse_EpochStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_consensus_polybft_proto_polybft_operator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorStatsResponse_ValidatorStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_consensus_polybft_proto_polybft_operator_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_consensus_polybft_proto_polybft_operator_proto_goTypes,
+		DependencyIndexes: file_consensus_polybft_proto_polybft_operator_proto_depIdxs,
+		MessageInfos:      file_consensus_polybft_proto_polybft_operator_proto_msgTypes,
+	}.Build()
+	File_consensus_polybft_proto_polybft_operator_proto = out.File
+	file_consensus_polybft_proto_polybft_operator_proto_rawDesc = nil
+	file_consensus_polybft_proto_polybft_operator_proto_goTypes = nil
+	file_consensus_polybft_proto_polybft_operator_proto_depIdxs = nil
+}
@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: consensus/polybft/proto/polybft_operator.proto
+
+package proto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ValidatorStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidatorStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidatorStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidatorStatsRequestMultiError, or nil if none found.
+func (m *ValidatorStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidatorStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Epochs
+
+	if len(errors) > 0 {
+		return ValidatorStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidatorStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidatorStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidatorStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidatorStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidatorStatsRequestMultiError) AllErrors() []error { return m }
+
+// ValidatorStatsRequestValidationError is the validation error returned by
+// ValidatorStatsRequest.Validate if the designated constraints aren't met.
+type ValidatorStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidatorStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidatorStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidatorStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidatorStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidatorStatsRequestValidationError) ErrorName() string {
+	return "ValidatorStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidatorStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidatorStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidatorStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidatorStatsRequestValidationError{}
+
+// Validate checks the field values on ValidatorStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidatorStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidatorStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidatorStatsResponseMultiError, or nil if none found.
+func (m *ValidatorStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidatorStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEpochs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidatorStatsResponseValidationError{
+						field:  fmt.Sprintf("Epochs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidatorStatsResponseValidationError{
+						field:  fmt.Sprintf("Epochs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidatorStatsResponseValidationError{
+					field:  fmt.Sprintf("Epochs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidatorStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidatorStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidatorStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ValidatorStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidatorStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidatorStatsResponseMultiError) AllErrors() []error { return m }
+
+// ValidatorStatsResponseValidationError is the validation error returned by
+// ValidatorStatsResponse.Validate if the designated constraints aren't met.
+type ValidatorStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidatorStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidatorStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidatorStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidatorStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidatorStatsResponseValidationError) ErrorName() string {
+	return "ValidatorStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidatorStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidatorStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidatorStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidatorStatsResponseValidationError{}
+
+// Validate checks the field values on ValidatorStatsResponse_EpochStats with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ValidatorStatsResponse_EpochStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidatorStatsResponse_EpochStats
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidatorStatsResponse_EpochStatsMultiError, or nil if none found.
+func (m *ValidatorStatsResponse_EpochStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidatorStatsResponse_EpochStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Epoch
+
+	// no validation rules for FirstBlock
+
+	// no validation rules for LastBlock
+
+	for idx, item := range m.GetValidators() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidatorStatsResponse_EpochStatsValidationError{
+						field:  fmt.Sprintf("Validators[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidatorStatsResponse_EpochStatsValidationError{
+						field:  fmt.Sprintf("Validators[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidatorStatsResponse_EpochStatsValidationError{
+					field:  fmt.Sprintf("Validators[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ValidatorStatsResponse_EpochStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidatorStatsResponse_EpochStatsMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidatorStatsResponse_EpochStats.ValidateAll() if the designated
+// constraints aren't met.
+type ValidatorStatsResponse_EpochStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidatorStatsResponse_EpochStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidatorStatsResponse_EpochStatsMultiError) AllErrors() []error { return m }
+
+// ValidatorStatsResponse_EpochStatsValidationError is the validation error
+// returned by ValidatorStatsResponse_EpochStats.Validate if the designated
+// constraints aren't met.
+type ValidatorStatsResponse_EpochStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidatorStatsResponse_EpochStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidatorStatsResponse_EpochStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidatorStatsResponse_EpochStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidatorStatsResponse_EpochStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidatorStatsResponse_EpochStatsValidationError) ErrorName() string {
+	return "ValidatorStatsResponse_EpochStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidatorStatsResponse_EpochStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidatorStatsResponse_EpochStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidatorStatsResponse_EpochStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidatorStatsResponse_EpochStatsValidationError{}
+
+// Validate checks the field values on ValidatorStatsResponse_ValidatorStats
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ValidatorStatsResponse_ValidatorStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidatorStatsResponse_ValidatorStats
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidatorStatsResponse_ValidatorStatsMultiError, or nil if none found.
+func (m *ValidatorStatsResponse_ValidatorStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidatorStatsResponse_ValidatorStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for BlocksProposed
+
+	// no validation rules for BlocksMissed
+
+	// no validation rules for SignaturesIncluded
+
+	// no validation rules for AverageRound
+
+	// no validation rules for Reward
+
+	if len(errors) > 0 {
+		return ValidatorStatsResponse_ValidatorStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidatorStatsResponse_ValidatorStatsMultiError is an error wrapping
+// multiple validation errors returned by
+// ValidatorStatsResponse_ValidatorStats.ValidateAll() if the designated
+// constraints aren't met.
+type ValidatorStatsResponse_ValidatorStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidatorStatsResponse_ValidatorStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidatorStatsResponse_ValidatorStatsMultiError) AllErrors() []error { return m }
+
+// ValidatorStatsResponse_ValidatorStatsValidationError is the validation error
+// returned by ValidatorStatsResponse_ValidatorStats.Validate if the
+// designated constraints aren't met.
+type ValidatorStatsResponse_ValidatorStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidatorStatsResponse_ValidatorStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidatorStatsResponse_ValidatorStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidatorStatsResponse_ValidatorStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidatorStatsResponse_ValidatorStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidatorStatsResponse_ValidatorStatsValidationError) ErrorName() string {
+	return "ValidatorStatsResponse_ValidatorStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidatorStatsResponse_ValidatorStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidatorStatsResponse_ValidatorStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidatorStatsResponse_ValidatorStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidatorStatsResponse_ValidatorStatsValidationError{}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.7
+// source: consensus/polybft/proto/polybft_operator.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PolybftOperatorClient is the client API for PolybftOperator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PolybftOperatorClient interface {
+	// ValidatorStats returns the per-validator statistics over the last epochs
+	ValidatorStats(ctx context.Context, in *ValidatorStatsRequest, opts ...grpc.CallOption) (*ValidatorStatsResponse, error)
+}
+
+type polybftOperatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPolybftOperatorClient(cc grpc.ClientConnInterface) PolybftOperatorClient {
+	return &polybftOperatorClient{cc}
+}
+
+func (c *polybftOperatorClient) ValidatorStats(ctx context.Context, in *ValidatorStatsRequest, opts ...grpc.CallOption) (*ValidatorStatsResponse, error) {
+	out := new(ValidatorStatsResponse)
+	err := c.cc.Invoke(ctx, "/v1.PolybftOperator/ValidatorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PolybftOperatorServer is the server API for PolybftOperator service.
+// All implementations must embed UnimplementedPolybftOperatorServer
+// for forward compatibility
+type PolybftOperatorServer interface {
+	// ValidatorStats returns the per-validator statistics over the last epochs
+	ValidatorStats(context.Context, *ValidatorStatsRequest) (*ValidatorStatsResponse, error)
+	mustEmbedUnimplementedPolybftOperatorServer()
+}
+
+// UnimplementedPolybftOperatorServer must be embedded to have forward compatible implementations.
+type UnimplementedPolybftOperatorServer struct {
+}
+
+func (UnimplementedPolybftOperatorServer) ValidatorStats(context.Context, *ValidatorStatsRequest) (*ValidatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorStats not implemented")
+}
+func (UnimplementedPolybftOperatorServer) mustEmbedUnimplementedPolybftOperatorServer() {}
+
+// UnsafePolybftOperatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PolybftOperatorServer will
+// result in compilation errors.
+type UnsafePolybftOperatorServer interface {
+	mustEmbedUnimplementedPolybftOperatorServer()
+}
+
+func RegisterPolybftOperatorServer(s grpc.ServiceRegistrar, srv PolybftOperatorServer) {
+	s.RegisterService(&PolybftOperator_ServiceDesc, srv)
+}
+
+func _PolybftOperator_ValidatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolybftOperatorServer).ValidatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PolybftOperator/ValidatorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolybftOperatorServer).ValidatorStats(ctx, req.(*ValidatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PolybftOperator_ServiceDesc is the grpc.ServiceDesc for PolybftOperator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PolybftOperator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.PolybftOperator",
+	HandlerType: (*PolybftOperatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidatorStats",
+			Handler:    _PolybftOperator_ValidatorStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "consensus/polybft/proto/polybft_operator.proto",
+}
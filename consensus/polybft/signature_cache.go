@@ -0,0 +1,131 @@
+package polybft
+
+import (
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// signatureCacheSize is the maximum number of verification results kept by the signature cache
+const signatureCacheSize = 4096
+
+// signatureCacheKey uniquely identifies a (hash, signer, signature) triple
+type signatureCacheKey struct {
+	hash      types.Hash
+	signer    types.Address
+	signature types.Hash
+}
+
+// signatureCache memoizes the outcome of BLS vote signature verification,
+// so that each (hash, signer, signature) triple is verified at most once
+type signatureCache struct {
+	cache *lru.Cache
+}
+
+// newSignatureCache creates a new instance of signatureCache
+func newSignatureCache() *signatureCache {
+	// lru.New only fails for a non-positive size
+	cache, _ := lru.New(signatureCacheSize)
+
+	return &signatureCache{cache: cache}
+}
+
+// get returns the cached verification result for the given triple
+// and a flag indicating whether the result was found in the cache
+func (c *signatureCache) get(hash []byte, signer types.Address, signature []byte) (bool, bool) {
+	value, ok := c.cache.Get(newSignatureCacheKey(hash, signer, signature))
+	if !ok {
+		return false, false
+	}
+
+	valid, ok := value.(bool)
+
+	return valid, ok
+}
+
+// add stores the verification result for the given triple
+func (c *signatureCache) add(hash []byte, signer types.Address, signature []byte, valid bool) {
+	c.cache.Add(newSignatureCacheKey(hash, signer, signature), valid)
+}
+
+// purge removes all the cached verification results
+func (c *signatureCache) purge() {
+	c.cache.Purge()
+}
+
+func newSignatureCacheKey(hash []byte, signer types.Address, signature []byte) signatureCacheKey {
+	return signatureCacheKey{
+		hash:      types.BytesToHash(hash),
+		signer:    signer,
+		signature: types.BytesToHash(crypto.Keccak256(signature)),
+	}
+}
+
+// pendingVote is a vote which is saved to the store, but whose signature is not verified yet
+type pendingVote struct {
+	index     uint64 // index of the signer in the validator set
+	signer    types.Address
+	publicKey *bls.PublicKey
+	raw       []byte
+	signature *bls.Signature
+}
+
+// verifyVotes verifies signatures of the given votes for the provided hash and returns the valid and the invalid ones.
+// Verification results are cached. Votes which are not in the cache are at first verified in a batch,
+// by checking the aggregated signature against the aggregated public keys, and only if batch verification fails,
+// the votes are verified one by one, in order to filter out the invalid ones.
+func (c *signatureCache) verifyVotes(hash []byte, votes []*pendingVote) ([]*pendingVote, []*pendingVote) {
+	valid := make([]*pendingVote, 0, len(votes))
+	invalid := make([]*pendingVote, 0)
+	unverified := make([]*pendingVote, 0, len(votes))
+
+	for _, vote := range votes {
+		isValid, found := c.get(hash, vote.signer, vote.raw)
+		if !found {
+			unverified = append(unverified, vote)
+
+			continue
+		}
+
+		if isValid {
+			valid = append(valid, vote)
+		} else {
+			invalid = append(invalid, vote)
+		}
+	}
+
+	if len(unverified) == 0 {
+		return valid, invalid
+	}
+
+	signatures := make(bls.Signatures, len(unverified))
+	publicKeys := make([]*bls.PublicKey, len(unverified))
+
+	for i, vote := range unverified {
+		signatures[i] = vote.signature
+		publicKeys[i] = vote.publicKey
+	}
+
+	if signatures.Aggregate().VerifyAggregated(publicKeys, hash, bls.DomainStateReceiver) {
+		for _, vote := range unverified {
+			c.add(hash, vote.signer, vote.raw, true)
+		}
+
+		return append(valid, unverified...), invalid
+	}
+
+	// batch verification failed, at least one of the signatures is invalid
+	for _, vote := range unverified {
+		isValid := vote.signature.Verify(vote.publicKey, hash, bls.DomainStateReceiver)
+		c.add(hash, vote.signer, vote.raw, isValid)
+
+		if isValid {
+			valid = append(valid, vote)
+		} else {
+			invalid = append(invalid, vote)
+		}
+	}
+
+	return valid, invalid
+}
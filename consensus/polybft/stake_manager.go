@@ -8,10 +8,12 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -30,7 +32,8 @@ var (
 type StakeManager interface {
 	PostBlock(req *PostBlockRequest) error
 	PostEpoch(req *PostEpochRequest) error
-	UpdateValidatorSet(epoch uint64, currentValidatorSet validator.AccountSet) (*validator.ValidatorSetDelta, error)
+	UpdateValidatorSet(epoch, blockNumber uint64,
+		currentValidatorSet validator.AccountSet) (*validator.ValidatorSetDelta, error)
 }
 
 // dummyStakeManager is a dummy implementation of StakeManager interface
@@ -39,7 +42,7 @@ type dummyStakeManager struct{}
 
 func (d *dummyStakeManager) PostBlock(req *PostBlockRequest) error { return nil }
 func (d *dummyStakeManager) PostEpoch(req *PostEpochRequest) error { return nil }
-func (d *dummyStakeManager) UpdateValidatorSet(epoch uint64,
+func (d *dummyStakeManager) UpdateValidatorSet(epoch, blockNumber uint64,
 	currentValidatorSet validator.AccountSet) (*validator.ValidatorSetDelta, error) {
 	return &validator.ValidatorSetDelta{}, nil
 }
@@ -182,10 +185,13 @@ func (s *stakeManager) updateWithReceipts(
 	return nil
 }
 
-// UpdateValidatorSet returns an updated validator set
-// based on stake change (transfer) events from ValidatorSet contract
+// UpdateValidatorSet returns an updated validator set, applied after the given (epoch ending) block,
+// based on stake change (transfer) events from ValidatorSet contract.
+// Once the validator seats fork is enabled, the validators keep their slots as long as they have stake
+// and are not jailed, and the slots left open are taken by the queued candidates with the highest stake.
+// Before that, the validators with the highest stake are selected
 func (s *stakeManager) UpdateValidatorSet(
-	epoch uint64, oldValidatorSet validator.AccountSet) (*validator.ValidatorSetDelta, error) {
+	epoch, blockNumber uint64, oldValidatorSet validator.AccountSet) (*validator.ValidatorSetDelta, error) {
 	s.logger.Info("Calculating validators set update...", "epoch", epoch)
 
 	fullValidatorSet, err := s.state.StakeStore.getFullValidatorSet()
@@ -193,11 +199,19 @@ func (s *stakeManager) UpdateValidatorSet(
 		return nil, fmt.Errorf("failed to get full validators set. Epoch: %d. Error: %w", epoch, err)
 	}
 
-	// stake map that holds stakes for all validators
-	stakeMap := fullValidatorSet.Validators
+	jailState, err := s.state.JailStore.getJailState()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jailed validators. Epoch: %d. Error: %w", epoch, err)
+	}
+
+	// stake map that holds stakes for all validators which are not jailed
+	stakeMap := fullValidatorSet.Validators.exclude(jailState.jailedAddresses())
 
 	// slice of all validator set
 	newValidatorSet := stakeMap.getSorted(s.maxValidatorSetSize)
+	if forkmanager.GetInstance().IsForkEnabled(chain.ValidatorSeats, blockNumber) {
+		newValidatorSet = stakeMap.getNextValidators(oldValidatorSet, s.maxValidatorSetSize)
+	}
 	// set of all addresses that will be in next validator set
 	addressesSet := make(map[types.Address]struct{}, len(newValidatorSet))
 
@@ -354,6 +368,23 @@ func (sc *validatorStakeMap) removeStake(address types.Address, amount *big.Int)
 	stakeData.IsActive = stakeData.VotingPower.Cmp(bigZero) > 0
 }
 
+// exclude returns the stake map without the given validators
+func (sc validatorStakeMap) exclude(addresses map[types.Address]struct{}) validatorStakeMap {
+	if len(addresses) == 0 {
+		return sc
+	}
+
+	result := make(validatorStakeMap, len(sc))
+
+	for addr, v := range sc {
+		if _, excluded := addresses[addr]; !excluded {
+			result[addr] = v
+		}
+	}
+
+	return result
+}
+
 // getSorted returns validators (*ValidatorMetadata) in sorted order
 func (sc validatorStakeMap) getSorted(maxValidatorSetSize int) validator.AccountSet {
 	activeValidators := make(validator.AccountSet, 0, len(sc))
@@ -384,6 +415,56 @@ func (sc validatorStakeMap) getSorted(maxValidatorSetSize int) validator.Account
 	return activeValidators[:maxValidatorSetSize]
 }
 
+// splitByValidatorSet splits the staked validators into the ones which are in the given validator set
+// and the queue of the candidates which are not, both ordered by stake (then by address)
+func (sc validatorStakeMap) splitByValidatorSet(
+	validatorSet validator.AccountSet) (seated validator.AccountSet, queue validator.AccountSet) {
+	members := make(map[types.Address]struct{}, len(validatorSet))
+	for _, v := range validatorSet {
+		members[v.Address] = struct{}{}
+	}
+
+	seated = make(validator.AccountSet, 0, len(validatorSet))
+	queue = validator.AccountSet{}
+
+	for _, v := range sc.getSorted(len(sc)) {
+		if _, exists := members[v.Address]; exists {
+			seated = append(seated, v)
+		} else {
+			queue = append(queue, v)
+		}
+	}
+
+	return seated, queue
+}
+
+// getOpenSlots returns the number of the slots which are taken by the queued candidates
+// when the epoch of the given validator set ends
+func getOpenSlots(seated validator.AccountSet, maxValidatorSetSize int) int {
+	if len(seated) >= maxValidatorSetSize {
+		return 0
+	}
+
+	return maxValidatorSetSize - len(seated)
+}
+
+// getNextValidators returns the validator set following the given one. The validators which still have stake
+// keep their slots (the ones with the least stake lose theirs, if there are more of them than the max size),
+// and the open slots are filled from the head of the queue
+func (sc validatorStakeMap) getNextValidators(validatorSet validator.AccountSet,
+	maxValidatorSetSize int) validator.AccountSet {
+	seated, queue := sc.splitByValidatorSet(validatorSet)
+	if len(seated) >= maxValidatorSetSize {
+		return seated[:maxValidatorSetSize]
+	}
+
+	if openSlots := getOpenSlots(seated, maxValidatorSetSize); len(queue) > openSlots {
+		queue = queue[:openSlots]
+	}
+
+	return append(seated, queue...)
+}
+
 func (sc validatorStakeMap) String() string {
 	var sb strings.Builder
 
@@ -4,9 +4,11 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -336,7 +338,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(fullValidatorSet),
 		}))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch, validators.GetPublicIdentities())
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch, 0, validators.GetPublicIdentities())
 		require.NoError(t, err)
 		require.Len(t, updateDelta.Added, 0)
 		require.Len(t, updateDelta.Updated, 1)
@@ -352,7 +354,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(fullValidatorSet),
 		}))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+1, validators.GetPublicIdentities())
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+1, 0, validators.GetPublicIdentities())
 		require.NoError(t, err)
 		require.Len(t, updateDelta.Added, 0)
 		require.Len(t, updateDelta.Updated, 0)
@@ -366,7 +368,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(validators.GetPublicIdentities()),
 		}))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+2,
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+2, 0,
 			validators.GetPublicIdentities(aliases[1:]...))
 		require.NoError(t, err)
 		require.Len(t, updateDelta.Added, 1)
@@ -383,7 +385,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(fullValidatorSet),
 		}))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+3, validators.GetPublicIdentities())
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+3, 0, validators.GetPublicIdentities())
 		require.NoError(t, err)
 		require.Len(t, updateDelta.Added, 0)
 		require.Len(t, updateDelta.Updated, 1)
@@ -399,7 +401,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(fullValidatorSet),
 		}))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+4, validators.GetPublicIdentities())
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+4, 0, validators.GetPublicIdentities())
 		require.NoError(t, err)
 		require.Len(t, updateDelta.Added, 0)
 		require.Len(t, updateDelta.Updated, 0)
@@ -413,7 +415,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(fullValidatorSet),
 		}))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+5, validators.GetPublicIdentities())
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+5, 0, validators.GetPublicIdentities())
 		require.NoError(t, err)
 		require.Len(t, updateDelta.Added, 0)
 		require.Len(t, updateDelta.Updated, 0)
@@ -432,7 +434,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(fullValidatorSet),
 		}))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+6,
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+6, 0,
 			validators.GetPublicIdentities(aliases[1:]...))
 
 		require.NoError(t, err)
@@ -442,6 +444,103 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 		require.Equal(t, validatorToAdd.Address, updateDelta.Added[0].Address)
 		require.Equal(t, validatorToAdd.VotingPower.Uint64(), updateDelta.Added[0].VotingPower.Uint64())
 	})
+
+	// the validators keep their seats once the fork is enabled
+	const validatorSeatsForkBlock = 100
+
+	fm := forkmanager.GetInstance()
+	fm.RegisterFork(chain.ValidatorSeats, nil)
+	require.NoError(t, fm.ActivateFork(chain.ValidatorSeats, validatorSeatsForkBlock))
+
+	t.Cleanup(func() {
+		require.NoError(t, fm.DeactivateFork(chain.ValidatorSeats))
+	})
+
+	t.Run("UpdateValidatorSet - max validator set size reached after validator seats fork", func(t *testing.T) {
+		// because we now have 5 validators, the new validator is queued even though it has more stake,
+		// since none of the validators in the set left its slot
+		stakeManager.maxValidatorSetSize = 4
+
+		fullValidatorSet := validators.GetPublicIdentities().Copy()
+		validatorToQueue := fullValidatorSet[0]
+		validatorToQueue.VotingPower = big.NewInt(11)
+
+		require.NoError(t, state.StakeStore.insertFullValidatorSet(validatorSetState{
+			Validators: newValidatorStakeMap(fullValidatorSet),
+		}))
+
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+7, validatorSeatsForkBlock,
+			validators.GetPublicIdentities(aliases[1:]...))
+
+		require.NoError(t, err)
+		require.Len(t, updateDelta.Added, 0)
+		require.Len(t, updateDelta.Updated, 0)
+		require.Len(t, updateDelta.Removed, 0)
+	})
+
+	t.Run("UpdateValidatorSet - queued validator promoted to open slot", func(t *testing.T) {
+		stakeManager.maxValidatorSetSize = 4
+
+		// A is queued, and E leaves its slot by unstaking, so A takes it
+		fullValidatorSet := validators.GetPublicIdentities().Copy()
+		fullValidatorSet[0].VotingPower = big.NewInt(11)
+		fullValidatorSet[4].VotingPower = bigZero
+
+		require.NoError(t, state.StakeStore.insertFullValidatorSet(validatorSetState{
+			Validators: newValidatorStakeMap(fullValidatorSet),
+		}))
+
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+8, validatorSeatsForkBlock,
+			validators.GetPublicIdentities(aliases[1:]...))
+
+		require.NoError(t, err)
+		require.Len(t, updateDelta.Added, 1)
+		require.Len(t, updateDelta.Updated, 0)
+		require.Len(t, updateDelta.Removed, 1)
+		require.True(t, updateDelta.Removed.IsSet(3))
+		require.Equal(t, validators.GetValidator("A").Address(), updateDelta.Added[0].Address)
+	})
+}
+
+func TestValidatorStakeMap_GetNextValidators(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidatorsWithAliases(t,
+		[]string{"A", "B", "C", "D", "E"}, []uint64{50, 40, 30, 20, 10})
+	stakeMap := newValidatorStakeMap(validators.GetPublicIdentities())
+
+	t.Run("validators keep their slots", func(t *testing.T) {
+		t.Parallel()
+
+		current := validators.GetPublicIdentities("C", "D", "E")
+
+		seated, queue := stakeMap.splitByValidatorSet(current)
+		require.Equal(t, []types.Address{validators.GetValidator("C").Address(),
+			validators.GetValidator("D").Address(), validators.GetValidator("E").Address()}, seated.GetAddresses())
+		require.Equal(t, []types.Address{validators.GetValidator("A").Address(),
+			validators.GetValidator("B").Address()}, queue.GetAddresses())
+		require.Equal(t, 0, getOpenSlots(seated, 3))
+		require.Equal(t, 1, getOpenSlots(seated, 4))
+
+		require.Equal(t, seated.GetAddresses(), stakeMap.getNextValidators(current, 3).GetAddresses())
+	})
+
+	t.Run("open slots filled from the head of the queue", func(t *testing.T) {
+		t.Parallel()
+
+		next := stakeMap.getNextValidators(validators.GetPublicIdentities("D", "E"), 4)
+		require.Equal(t, []types.Address{validators.GetValidator("D").Address(),
+			validators.GetValidator("E").Address(), validators.GetValidator("A").Address(),
+			validators.GetValidator("B").Address()}, next.GetAddresses())
+	})
+
+	t.Run("validators with least stake lose their slots", func(t *testing.T) {
+		t.Parallel()
+
+		next := stakeMap.getNextValidators(validators.GetPublicIdentities("B", "C", "D", "E"), 2)
+		require.Equal(t, []types.Address{validators.GetValidator("B").Address(),
+			validators.GetValidator("C").Address()}, next.GetAddresses())
+	})
 }
 
 func TestStakeCounter_ShouldBeDeterministic(t *testing.T) {
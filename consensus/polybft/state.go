@@ -37,6 +37,9 @@ type State struct {
 	EpochStore            *EpochStore
 	ProposerSnapshotStore *ProposerSnapshotStore
 	StakeStore            *StakeStore
+	ValidatorStatsStore   *ValidatorStatsStore
+	EpochSnapshotStore    *EpochSnapshotStore
+	JailStore             *JailStore
 }
 
 // newState creates new instance of State
@@ -54,6 +57,9 @@ func newState(path string, logger hclog.Logger, closeCh chan struct{}) (*State,
 		EpochStore:            &EpochStore{db: db},
 		ProposerSnapshotStore: &ProposerSnapshotStore{db: db},
 		StakeStore:            &StakeStore{db: db},
+		ValidatorStatsStore:   &ValidatorStatsStore{db: db},
+		EpochSnapshotStore:    &EpochSnapshotStore{db: db},
+		JailStore:             &JailStore{db: db},
 	}
 
 	if err = s.initStorages(); err != nil {
@@ -80,7 +86,19 @@ func (s *State) initStorages() error {
 			return err
 		}
 
-		return s.StakeStore.initialize(tx)
+		if err := s.StakeStore.initialize(tx); err != nil {
+			return err
+		}
+
+		if err := s.ValidatorStatsStore.initialize(tx); err != nil {
+			return err
+		}
+
+		if err := s.EpochSnapshotStore.initialize(tx); err != nil {
+			return err
+		}
+
+		return s.JailStore.initialize(tx)
 	})
 }
 
@@ -105,6 +105,11 @@ func (s *EpochStore) getLastSnapshot() (*validatorSnapshot, error) {
 	return snapshot, err
 }
 
+// getNearestSnapshot returns the snapshot saved in db of the latest epoch which is not after the given one
+func (s *EpochStore) getNearestSnapshot(epoch uint64) (*validatorSnapshot, error) {
+	return getNearestSnapshot(s.db, validatorSnapshotsBucket, epoch)
+}
+
 // insertEpoch inserts a new epoch to db with its meta data
 func (s *EpochStore) insertEpoch(epoch uint64) error {
 	return s.db.Update(func(tx *bolt.Tx) error {
@@ -0,0 +1,150 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// epochSnapshotInterval defines the number of epochs between two archived validator snapshots
+	epochSnapshotInterval = 32
+	// epochSnapshotFormat is the version of the archived snapshots encoding,
+	// it has to be increased if the encoding or the derivation of the validator snapshots changes
+	epochSnapshotFormat = 1
+)
+
+/*
+Bolt DB schema:
+
+epoch snapshots/
+|--> epochNumber -> *validatorSnapshot (json marshalled)
+
+epoch snapshots meta/
+|--> version -> keccak(format, fork schedule)
+*/
+var (
+	// bucket to store the archived validator snapshots, which are never cleaned up
+	epochSnapshotsBucket = []byte("epochSnapshots")
+	// bucket to store the version of the archived validator snapshots
+	epochSnapshotsMetaBucket = []byte("epochSnapshotsMeta")
+
+	epochSnapshotsVersionKey = []byte("version")
+)
+
+// EpochSnapshotStore archives the validator snapshot of every epochSnapshotInterval-th epoch,
+// so the validator set of the distant epoch is derived from the nearest archived snapshot,
+// instead of applying the validator set deltas of all the epochs since the genesis
+type EpochSnapshotStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *EpochSnapshotStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(epochSnapshotsBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(epochSnapshotsBucket), err)
+	}
+
+	if _, err := tx.CreateBucketIfNotExists(epochSnapshotsMetaBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(epochSnapshotsMetaBucket), err)
+	}
+
+	return nil
+}
+
+// epochSnapshotsVersion returns the version of the archived snapshots for the given fork schedule
+func epochSnapshotsVersion(forks *chain.Forks) (types.Hash, error) {
+	raw, err := json.Marshal(forks)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return types.BytesToHash(crypto.Keccak256(common.EncodeUint64ToBytes(epochSnapshotFormat), raw)), nil
+}
+
+// ensureVersion drops the archived snapshots if they were stored with the other version,
+// since the fork schedule (or the snapshot format) they were derived with has changed.
+// Dropped snapshots are archived again once they are computed. It returns true if the snapshots were dropped
+func (s *EpochSnapshotStore) ensureVersion(version types.Hash) (bool, error) {
+	dropped := false
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(epochSnapshotsMetaBucket)
+
+		stored := meta.Get(epochSnapshotsVersionKey)
+		if bytes.Equal(stored, version.Bytes()) {
+			return nil
+		}
+
+		if stored != nil {
+			if err := tx.DeleteBucket(epochSnapshotsBucket); err != nil {
+				return err
+			}
+
+			if _, err := tx.CreateBucket(epochSnapshotsBucket); err != nil {
+				return err
+			}
+
+			dropped = true
+		}
+
+		return meta.Put(epochSnapshotsVersionKey, version.Bytes())
+	})
+
+	return dropped, err
+}
+
+// insertEpochSnapshot archives the validator snapshot
+func (s *EpochSnapshotStore) insertEpochSnapshot(snapshot *validatorSnapshot) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		raw, err := json.Marshal(snapshot)
+		if err != nil {
+			return err
+		}
+
+		return tx.Bucket(epochSnapshotsBucket).Put(common.EncodeUint64ToBytes(snapshot.Epoch), raw)
+	})
+}
+
+// getNearestEpochSnapshot returns the archived snapshot of the latest epoch which is not after the given one,
+// or nil if there is no such snapshot
+func (s *EpochSnapshotStore) getNearestEpochSnapshot(epoch uint64) (*validatorSnapshot, error) {
+	return getNearestSnapshot(s.db, epochSnapshotsBucket, epoch)
+}
+
+// getNearestSnapshot returns the snapshot of the latest epoch which is not after the given one
+// from the given snapshots bucket, or nil if there is no such snapshot
+func getNearestSnapshot(db *bolt.DB, bucketName []byte, epoch uint64) (*validatorSnapshot, error) {
+	var snapshot *validatorSnapshot
+
+	err := db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketName).Cursor()
+
+		// keys are big endian encoded, so they are ordered by the epoch
+		k, v := c.Seek(common.EncodeUint64ToBytes(epoch))
+		if k == nil {
+			k, v = c.Last()
+		} else if common.EncodeBytesToUint64(k) > epoch {
+			k, v = c.Prev()
+		}
+
+		if k == nil {
+			return nil
+		}
+
+		return json.Unmarshal(v, &snapshot)
+	})
+
+	return snapshot, err
+}
+
+// epochSnapshotsDBStats returns stats of epoch snapshots bucket in db
+func (s *EpochSnapshotStore) epochSnapshotsDBStats() (*bolt.BucketStats, error) {
+	return bucketStats(epochSnapshotsBucket, s.db)
+}
@@ -0,0 +1,93 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_getNearestEpochSnapshot(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	validators := validator.NewTestValidators(t, 3).GetPublicIdentities()
+
+	snapshot, err := state.EpochSnapshotStore.getNearestEpochSnapshot(10)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	for _, epoch := range []uint64{32, 64, 96} {
+		require.NoError(t, state.EpochSnapshotStore.insertEpochSnapshot(&validatorSnapshot{epoch, epoch * 10, validators}))
+	}
+
+	cases := []struct {
+		epoch    uint64
+		expected uint64
+	}{
+		{32, 32},
+		{63, 32},
+		{64, 64},
+		{95, 64},
+		{1000, 96},
+	}
+
+	for _, c := range cases {
+		snapshot, err := state.EpochSnapshotStore.getNearestEpochSnapshot(c.epoch)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, snapshot.Epoch)
+		require.Equal(t, c.expected*10, snapshot.EpochEndingBlock)
+		require.Equal(t, validators, snapshot.Snapshot)
+	}
+
+	snapshot, err = state.EpochSnapshotStore.getNearestEpochSnapshot(31)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
+
+func TestState_EpochSnapshotStore_ensureVersion(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	validators := validator.NewTestValidators(t, 3).GetPublicIdentities()
+
+	forks := chain.AllForksEnabled
+	version, err := epochSnapshotsVersion(forks)
+	require.NoError(t, err)
+
+	// the version is stored on the first start
+	dropped, err := state.EpochSnapshotStore.ensureVersion(version)
+	require.NoError(t, err)
+	require.False(t, dropped)
+
+	require.NoError(t, state.EpochSnapshotStore.insertEpochSnapshot(&validatorSnapshot{32, 320, validators}))
+
+	dropped, err = state.EpochSnapshotStore.ensureVersion(version)
+	require.NoError(t, err)
+	require.False(t, dropped)
+
+	stats, err := state.EpochSnapshotStore.epochSnapshotsDBStats()
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.KeyN)
+
+	// the changed fork schedule drops the archived snapshots
+	changedForks := &chain.Forks{}
+	for name, fork := range *forks {
+		changedForks.SetFork(name, fork)
+	}
+
+	changedForks.SetFork(chain.London, chain.NewFork(100))
+
+	changedVersion, err := epochSnapshotsVersion(changedForks)
+	require.NoError(t, err)
+	require.NotEqual(t, version, changedVersion)
+
+	dropped, err = state.EpochSnapshotStore.ensureVersion(changedVersion)
+	require.NoError(t, err)
+	require.True(t, dropped)
+
+	stats, err = state.EpochSnapshotStore.epochSnapshotsDBStats()
+	require.NoError(t, err)
+	require.Equal(t, 0, stats.KeyN)
+}
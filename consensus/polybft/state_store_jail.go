@@ -0,0 +1,98 @@
+package polybft
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+/*
+Bolt DB schema:
+
+jail/
+|--> jailStateKey -> *jailState (json marshalled)
+*/
+var (
+	// bucket to store the jailing state of the validators
+	jailBucket = []byte("jail")
+	// key of the jailing state in bucket
+	jailStateKey = []byte("jailState")
+)
+
+// JailRecord represents the jailing of the validator which repeatedly missed to sign the blocks
+type JailRecord struct {
+	Address types.Address `json:"address"`
+	// JailedAtBlock is the block whose commit seal was the last one the validator missed to sign
+	JailedAtBlock uint64 `json:"jailedAtBlock"`
+	// ReleaseEpoch is the first epoch in which the validator can be unjailed
+	ReleaseEpoch uint64 `json:"releaseEpoch"`
+}
+
+// jailState represents the consecutive missed blocks of the validators and the jailed validators,
+// up to date with the given block
+type jailState struct {
+	BlockNumber uint64                        `json:"block"`
+	Missed      map[types.Address]uint64      `json:"missed"`
+	Jailed      map[types.Address]*JailRecord `json:"jailed"`
+}
+
+// newJailState creates an empty jailing state
+func newJailState() *jailState {
+	return &jailState{
+		Missed: map[types.Address]uint64{},
+		Jailed: map[types.Address]*JailRecord{},
+	}
+}
+
+// jailedAddresses returns the set of the jailed validators addresses
+func (j *jailState) jailedAddresses() map[types.Address]struct{} {
+	result := make(map[types.Address]struct{}, len(j.Jailed))
+	for addr := range j.Jailed {
+		result[addr] = struct{}{}
+	}
+
+	return result
+}
+
+type JailStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *JailStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(jailBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(jailBucket), err)
+	}
+
+	return nil
+}
+
+// insertJailState inserts the jailing state to its bucket (or updates it if exists)
+func (s *JailStore) insertJailState(state *jailState) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(jailBucket).Put(jailStateKey, raw)
+	})
+}
+
+// getJailState returns the jailing state from its bucket, or an empty one if it doesn't exist
+func (s *JailStore) getJailState() (*jailState, error) {
+	state := newJailState()
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(jailBucket).Get(jailStateKey)
+		if raw == nil {
+			return nil
+		}
+
+		return json.Unmarshal(raw, state)
+	})
+
+	return state, err
+}
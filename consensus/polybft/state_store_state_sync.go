@@ -1,12 +1,14 @@
 package polybft
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -19,6 +21,10 @@ var (
 	stateSyncProofsBucket = []byte("stateSyncProofs")
 	// bucket to store message votes (signatures)
 	messageVotesBucket = []byte("votes")
+	// bucket to store the cursor of the state sync events sequence
+	stateSyncCursorBucket = []byte("stateSyncCursor")
+
+	stateSyncCursorKey = []byte("next")
 
 	// errNotEnoughStateSyncs error message
 	errNotEnoughStateSyncs = errors.New("there is either a gap or not enough sync events")
@@ -26,6 +32,10 @@ var (
 	errCommitmentNotBuilt = errors.New("there is no built commitment to register")
 	// errNoCommitmentForStateSync error message
 	errNoCommitmentForStateSync = errors.New("no commitment found for given state sync event")
+	// errDuplicateStateSync error message
+	errDuplicateStateSync = errors.New("state sync event already received")
+	// errConflictingStateSync error message
+	errConflictingStateSync = errors.New("state sync event conflicts with the already received event with the same id")
 )
 
 /*
@@ -39,6 +49,9 @@ commitments/
 
 stateSyncProofs/
 |--> stateSyncProof.StateSync.Id -> *StateSyncProof (json marshalled)
+
+stateSyncCursor/
+|--> next -> id of the first state sync event which is not yet received (the sequence has no gaps before it)
 */
 
 type StateSyncStore struct {
@@ -59,10 +72,17 @@ func (s *StateSyncStore) initialize(tx *bolt.Tx) error {
 		return fmt.Errorf("failed to create bucket=%s: %w", string(stateSyncProofsBucket), err)
 	}
 
+	if _, err := tx.CreateBucketIfNotExists(stateSyncCursorBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(stateSyncCursorBucket), err)
+	}
+
 	return nil
 }
 
-// insertStateSyncEvent inserts a new state sync event to state event bucket in db
+// insertStateSyncEvent inserts a new state sync event to state event bucket in db,
+// and moves the sequence cursor past the received events without a gap.
+// The event is never overwritten, so the event received again (e.g. after the rootchain reorg)
+// returns errDuplicateStateSync, or errConflictingStateSync if it differs from the received one
 func (s *StateSyncStore) insertStateSyncEvent(event *contractsapi.StateSyncedEvent) error {
 	return s.db.Update(func(tx *bolt.Tx) error {
 		raw, err := json.Marshal(event)
@@ -71,11 +91,59 @@ func (s *StateSyncStore) insertStateSyncEvent(event *contractsapi.StateSyncedEve
 		}
 
 		bucket := tx.Bucket(stateSyncEventsBucket)
+		key := common.EncodeUint64ToBytes(event.ID.Uint64())
+
+		if existing := bucket.Get(key); existing != nil {
+			if bytes.Equal(existing, raw) {
+				return errDuplicateStateSync
+			}
+
+			return errConflictingStateSync
+		}
 
-		return bucket.Put(common.EncodeUint64ToBytes(event.ID.Uint64()), raw)
+		if err := bucket.Put(key, raw); err != nil {
+			return err
+		}
+
+		next := getStateSyncCursorLocked(tx)
+		for bucket.Get(common.EncodeUint64ToBytes(next)) != nil {
+			next++
+		}
+
+		return tx.Bucket(stateSyncCursorBucket).Put(stateSyncCursorKey, common.EncodeUint64ToBytes(next))
 	})
 }
 
+// getStateSyncCursor returns the id of the first state sync event which is not yet received,
+// so all the events before it are received, and the id of the last received event
+func (s *StateSyncStore) getStateSyncCursor() (next uint64, last uint64, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		next = getStateSyncCursorLocked(tx)
+
+		if k, _ := tx.Bucket(stateSyncEventsBucket).Cursor().Last(); k != nil {
+			last = common.EncodeBytesToUint64(k)
+		}
+
+		return nil
+	})
+
+	return next, last, err
+}
+
+// getStateSyncCursorLocked returns the stored sequence cursor. If the cursor is not stored yet
+// (the events were received by the version without the cursor), the sequence starts with the first received event
+func getStateSyncCursorLocked(tx *bolt.Tx) uint64 {
+	if raw := tx.Bucket(stateSyncCursorBucket).Get(stateSyncCursorKey); raw != nil {
+		return common.EncodeBytesToUint64(raw)
+	}
+
+	if k, _ := tx.Bucket(stateSyncEventsBucket).Cursor().First(); k != nil {
+		return common.EncodeBytesToUint64(k)
+	}
+
+	return 0
+}
+
 // list iterates through all events in events bucket in db, un-marshals them, and returns as array
 func (s *StateSyncStore) list() ([]*contractsapi.StateSyncedEvent, error) {
 	events := []*contractsapi.StateSyncedEvent{}
@@ -194,9 +262,11 @@ func (s *StateSyncStore) insertMessageVote(epoch uint64, key []byte, vote *Messa
 			return err
 		}
 
-		// check if the signature has already being included
+		// check if the signature has already being included. The votes are deduplicated by the signer
+		// and the signature, since the signatures are verified lazily, so a forged vote of the signer
+		// doesn't take the place of its valid vote
 		for _, sigs := range signatures {
-			if sigs.From == vote.From {
+			if sigs.From == vote.From && bytes.Equal(sigs.Signature, vote.Signature) {
 				numSignatures = len(signatures)
 
 				return nil
@@ -230,6 +300,51 @@ func (s *StateSyncStore) insertMessageVote(epoch uint64, key []byte, vote *Messa
 	return numSignatures, nil
 }
 
+// removeMessageVotes removes the given votes from signatures bucket of given epoch
+func (s *StateSyncStore) removeMessageVotes(epoch uint64, key []byte, votes []*MessageSignature) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		signatures, err := s.getMessageVotesLocked(tx, epoch, key)
+		if err != nil {
+			return err
+		}
+
+		kept := make([]*MessageSignature, 0, len(signatures))
+
+		for _, sigs := range signatures {
+			removed := false
+
+			for _, vote := range votes {
+				if types.StringToAddress(sigs.From) == types.StringToAddress(vote.From) &&
+					bytes.Equal(sigs.Signature, vote.Signature) {
+					removed = true
+
+					break
+				}
+			}
+
+			if !removed {
+				kept = append(kept, sigs)
+			}
+		}
+
+		if len(kept) == len(signatures) {
+			return nil
+		}
+
+		raw, err := json.Marshal(kept)
+		if err != nil {
+			return err
+		}
+
+		bucket, err := getNestedBucketInEpoch(tx, epoch, messageVotesBucket)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, raw)
+	})
+}
+
 // getMessageVotes gets all signatures from db associated with given epoch and hash
 func (s *StateSyncStore) getMessageVotes(epoch uint64, hash []byte) ([]*MessageSignature, error) {
 	var signatures []*MessageSignature
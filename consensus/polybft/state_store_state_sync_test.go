@@ -33,6 +33,56 @@ func TestState_InsertEvent(t *testing.T) {
 	assert.Len(t, events, 1)
 }
 
+func TestState_insertStateSyncEvent_Sequence(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	next, last, err := state.StateSyncStore.getStateSyncCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), next)
+	require.Equal(t, uint64(0), last)
+
+	events := generateStateSyncEvents(t, 6, 1)
+
+	// the sequence starts with the first received event
+	require.NoError(t, state.StateSyncStore.insertStateSyncEvent(events[0]))
+	require.NoError(t, state.StateSyncStore.insertStateSyncEvent(events[1]))
+
+	next, last, err = state.StateSyncStore.getStateSyncCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+	require.Equal(t, uint64(2), last)
+
+	// the out of order events don't move the cursor
+	require.NoError(t, state.StateSyncStore.insertStateSyncEvent(events[4]))
+	require.NoError(t, state.StateSyncStore.insertStateSyncEvent(events[3]))
+
+	next, last, err = state.StateSyncStore.getStateSyncCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+	require.Equal(t, uint64(5), last)
+
+	// the missing event fills the gap
+	require.NoError(t, state.StateSyncStore.insertStateSyncEvent(events[2]))
+
+	next, last, err = state.StateSyncStore.getStateSyncCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), next)
+	require.Equal(t, uint64(5), last)
+
+	// the received events are never overwritten
+	require.ErrorIs(t, state.StateSyncStore.insertStateSyncEvent(events[1]), errDuplicateStateSync)
+
+	conflicting := *events[1]
+	conflicting.Data = []byte{0x1}
+	require.ErrorIs(t, state.StateSyncStore.insertStateSyncEvent(&conflicting), errConflictingStateSync)
+
+	stored, err := state.StateSyncStore.getStateSyncEventsForCommitment(2, 2)
+	require.NoError(t, err)
+	require.Equal(t, events[1].Data, stored[0].Data)
+}
+
 func TestState_Insert_And_Get_MessageVotes(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +105,38 @@ func TestState_Insert_And_Get_MessageVotes(t *testing.T) {
 	assert.True(t, bytes.Equal([]byte{1, 2}, votes[0].Signature))
 }
 
+func TestState_Insert_And_Remove_MessageVotes(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+	epoch := uint64(1)
+	assert.NoError(t, state.EpochStore.insertEpoch(epoch))
+
+	hash := []byte{1, 2}
+	signer := types.StringToAddress("1").String()
+
+	// the votes of the same signer with the different signatures are kept
+	for _, signature := range [][]byte{{1}, {2}, {1}} {
+		_, err := state.StateSyncStore.insertMessageVote(epoch, hash, &MessageSignature{
+			From:      signer,
+			Signature: signature,
+		})
+		assert.NoError(t, err)
+	}
+
+	votes, err := state.StateSyncStore.getMessageVotes(epoch, hash)
+	assert.NoError(t, err)
+	assert.Len(t, votes, 2)
+
+	assert.NoError(t, state.StateSyncStore.removeMessageVotes(epoch, hash, []*MessageSignature{
+		{From: signer, Signature: []byte{1}},
+	}))
+
+	votes, err = state.StateSyncStore.getMessageVotes(epoch, hash)
+	assert.NoError(t, err)
+	assert.Equal(t, []*MessageSignature{{From: signer, Signature: []byte{2}}}, votes)
+}
+
 func TestState_getStateSyncEventsForCommitment_NotEnoughEvents(t *testing.T) {
 	t.Parallel()
 
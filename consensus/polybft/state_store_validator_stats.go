@@ -0,0 +1,169 @@
+package polybft
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+// numberOfValidatorStatsToLeaveInDB defines a number of epochs whose validator statistics are left in db
+const numberOfValidatorStatsToLeaveInDB = 100
+
+/*
+Bolt DB schema:
+
+validator stats/
+|--> epochNumber -> *EpochValidatorStats (json marshalled)
+*/
+var (
+	// bucket to store per epoch validator statistics
+	validatorStatsBucket = []byte("validatorStats")
+)
+
+// ValidatorStats represents the performance statistics of a single validator in a single epoch
+type ValidatorStats struct {
+	Address types.Address `json:"address"`
+	// BlocksProposed is the number of the blocks proposed by the validator
+	BlocksProposed uint64 `json:"blocksProposed"`
+	// BlocksMissed is the number of the rounds in which the validator was the proposer,
+	// but the block was committed in a later round
+	BlocksMissed uint64 `json:"blocksMissed"`
+	// SignaturesIncluded is the number of the blocks whose commit seal includes the validator signature
+	SignaturesIncluded uint64 `json:"signaturesIncluded"`
+	// RoundsSum is the sum of the round numbers of the blocks proposed by the validator
+	RoundsSum uint64 `json:"roundsSum"`
+	// Reward is the reward earned by the validator in the epoch
+	Reward *big.Int `json:"reward"`
+}
+
+// AverageRound returns the average round number of the blocks proposed by the validator
+func (v *ValidatorStats) AverageRound() float64 {
+	if v.BlocksProposed == 0 {
+		return 0
+	}
+
+	return float64(v.RoundsSum) / float64(v.BlocksProposed)
+}
+
+// EpochValidatorStats represents the statistics of all the validators in a single epoch
+type EpochValidatorStats struct {
+	Epoch      uint64                            `json:"epoch"`
+	FirstBlock uint64                            `json:"firstBlock"`
+	LastBlock  uint64                            `json:"lastBlock"`
+	Validators map[types.Address]*ValidatorStats `json:"validators"`
+}
+
+// newEpochValidatorStats creates empty statistics of the given epoch
+func newEpochValidatorStats(epoch, firstBlock uint64) *EpochValidatorStats {
+	return &EpochValidatorStats{
+		Epoch:      epoch,
+		FirstBlock: firstBlock,
+		Validators: map[types.Address]*ValidatorStats{},
+	}
+}
+
+// get returns the statistics of the given validator, creating them if they don't exist
+func (e *EpochValidatorStats) get(addr types.Address) *ValidatorStats {
+	stats, ok := e.Validators[addr]
+	if !ok {
+		stats = &ValidatorStats{Address: addr, Reward: big.NewInt(0)}
+		e.Validators[addr] = stats
+	}
+
+	return stats
+}
+
+type ValidatorStatsStore struct {
+	db *bolt.DB
+}
+
+// initialize creates necessary buckets in DB if they don't already exist
+func (s *ValidatorStatsStore) initialize(tx *bolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists(validatorStatsBucket); err != nil {
+		return fmt.Errorf("failed to create bucket=%s: %w", string(validatorStatsBucket), err)
+	}
+
+	return nil
+}
+
+// insertEpochValidatorStats inserts (or updates) the statistics of the epoch
+// and removes the statistics of the epochs which are out of the retention limit
+func (s *ValidatorStatsStore) insertEpochValidatorStats(stats *EpochValidatorStats) error {
+	raw, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(validatorStatsBucket)
+
+		if err := bucket.Put(common.EncodeUint64ToBytes(stats.Epoch), raw); err != nil {
+			return err
+		}
+
+		if stats.Epoch <= numberOfValidatorStatsToLeaveInDB {
+			return nil
+		}
+
+		var (
+			limit = common.EncodeUint64ToBytes(stats.Epoch - numberOfValidatorStatsToLeaveInDB)
+			keys  [][]byte
+			c     = bucket.Cursor()
+		)
+
+		for k, _ := c.First(); k != nil && bytes.Compare(k, limit) <= 0; k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+
+		for _, k := range keys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// getEpochValidatorStats returns the statistics of the given epoch, nil if they don't exist
+func (s *ValidatorStatsStore) getEpochValidatorStats(epoch uint64) (*EpochValidatorStats, error) {
+	var stats *EpochValidatorStats
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(validatorStatsBucket).Get(common.EncodeUint64ToBytes(epoch))
+		if value == nil {
+			return nil
+		}
+
+		return json.Unmarshal(value, &stats)
+	})
+
+	return stats, err
+}
+
+// getLastEpochsValidatorStats returns the statistics of the last n epochs, starting from the latest one
+func (s *ValidatorStatsStore) getLastEpochsValidatorStats(n uint64) ([]*EpochValidatorStats, error) {
+	var result []*EpochValidatorStats
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(validatorStatsBucket).Cursor()
+
+		for k, v := c.Last(); k != nil && uint64(len(result)) < n; k, v = c.Prev() {
+			var stats *EpochValidatorStats
+			if err := json.Unmarshal(v, &stats); err != nil {
+				return err
+			}
+
+			result = append(result, stats)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,27 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_insertEpochValidatorStats_Retention(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	for epoch := uint64(1); epoch <= numberOfValidatorStatsToLeaveInDB+5; epoch++ {
+		require.NoError(t, state.ValidatorStatsStore.insertEpochValidatorStats(newEpochValidatorStats(epoch, epoch)))
+	}
+
+	stats, err := state.ValidatorStatsStore.getLastEpochsValidatorStats(2 * numberOfValidatorStatsToLeaveInDB)
+	require.NoError(t, err)
+	require.Len(t, stats, numberOfValidatorStatsToLeaveInDB)
+	require.Equal(t, uint64(numberOfValidatorStatsToLeaveInDB+5), stats[0].Epoch)
+	require.Equal(t, uint64(6), stats[len(stats)-1].Epoch)
+
+	stats5, err := state.ValidatorStatsStore.getEpochValidatorStats(5)
+	require.NoError(t, err)
+	require.Nil(t, stats5)
+}
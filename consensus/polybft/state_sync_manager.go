@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"encoding/json"
@@ -8,21 +9,31 @@ import (
 	"fmt"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgealert"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgelatency"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	polybftProto "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/tracker"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/umbracle/ethgo"
 	"google.golang.org/protobuf/proto"
 )
 
+// stateSyncMetricsPrefix is the prefix of the state sync sequence metrics
+const stateSyncMetricsPrefix = "state_sync"
+
+var errBridgeNotEnabled = errors.New("bridge is not enabled")
+
 type Runtime interface {
 	IsActiveValidator() bool
 }
@@ -38,6 +49,7 @@ type StateSyncManager interface {
 	Close()
 	Commitment(blockNumber uint64) (*CommitmentMessageSigned, error)
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
+	GetStateSyncTimeline(stateSyncID uint64) (*types.BridgeMessageTimeline, error)
 	PostBlock(req *PostBlockRequest) error
 	PostEpoch(req *PostEpochRequest) error
 }
@@ -57,17 +69,26 @@ func (n *dummyStateSyncManager) PostEpoch(req *PostEpochRequest) error { return
 func (n *dummyStateSyncManager) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	return types.Proof{}, nil
 }
+func (n *dummyStateSyncManager) GetStateSyncTimeline(stateSyncID uint64) (*types.BridgeMessageTimeline, error) {
+	return nil, errBridgeNotEnabled
+}
 
 // stateSyncConfig holds the configuration data of state sync manager
 type stateSyncConfig struct {
 	stateSenderAddr       types.Address
 	stateSenderStartBlock uint64
-	jsonrpcAddr           string
+	jsonrpcAddrs          []string
 	dataDir               string
 	topic                 topic
 	key                   *wallet.Key
 	maxCommitmentSize     uint64
 	numBlockConfirmations uint64
+
+	// alerts fires the alerts for the stuck or anomalous state syncs, nil if the bridge alerts are disabled
+	alerts *bridgealert.Monitor
+
+	// latency records the time the state syncs reach each of the bridge stages, nil if not tracked
+	latency *bridgelatency.Tracker
 }
 
 var _ StateSyncManager = (*stateSyncManager)(nil)
@@ -87,6 +108,11 @@ type stateSyncManager struct {
 	validatorSet       validator.ValidatorSet
 	epoch              uint64
 	nextCommittedIndex uint64
+	// lastBlockNumber is the number of the latest finalized block
+	lastBlockNumber uint64
+
+	// signatureCache holds the results of lazy vote signature verification
+	signatureCache *signatureCache
 
 	runtime Runtime
 }
@@ -101,16 +127,25 @@ type topic interface {
 func newStateSyncManager(logger hclog.Logger, state *State, config *stateSyncConfig,
 	runtime Runtime) *stateSyncManager {
 	return &stateSyncManager{
-		logger:  logger,
-		state:   state,
-		config:  config,
-		closeCh: make(chan struct{}),
-		runtime: runtime,
+		logger:         logger,
+		state:          state,
+		config:         config,
+		closeCh:        make(chan struct{}),
+		signatureCache: newSignatureCache(),
+		runtime:        runtime,
 	}
 }
 
 // Init subscribes to bridge topics (getting votes) and start the event tracker routine
 func (s *stateSyncManager) Init() error {
+	// the sequence is resumed from the persisted cursor
+	next, last, err := s.state.StateSyncStore.getStateSyncCursor()
+	if err != nil {
+		return fmt.Errorf("failed to read state sync sequence cursor. Error: %w", err)
+	}
+
+	s.logger.Info("resuming state sync sequence", "nextExpectedID", next, "lastReceivedID", last)
+
 	if err := s.initTracker(); err != nil {
 		return fmt.Errorf("failed to init event tracker. Error: %w", err)
 	}
@@ -119,11 +154,19 @@ func (s *stateSyncManager) Init() error {
 		return fmt.Errorf("failed to initialize state sync transport layer. Error: %w", err)
 	}
 
+	if s.config.alerts != nil {
+		s.config.alerts.Start()
+	}
+
 	return nil
 }
 
 func (s *stateSyncManager) Close() {
 	close(s.closeCh)
+
+	if s.config.alerts != nil {
+		s.config.alerts.Close()
+	}
 }
 
 // initTracker starts a new event tracker (to receive new state sync events)
@@ -132,7 +175,7 @@ func (s *stateSyncManager) initTracker() error {
 
 	evtTracker := tracker.NewEventTracker(
 		path.Join(s.config.dataDir, "/deposit.db"),
-		s.config.jsonrpcAddr,
+		s.config.jsonrpcAddrs,
 		ethgo.Address(s.config.stateSenderAddr),
 		s,
 		s.config.numBlockConfirmations,
@@ -176,7 +219,9 @@ func (s *stateSyncManager) initTransport() error {
 	})
 }
 
-// saveVote saves the gotten vote to boltDb for later quorum check and signature aggregation
+// saveVote saves the gotten vote to boltDb for later quorum check and signature aggregation.
+// Only cheap sanity checks are done here, while the signature itself is verified lazily,
+// once the commitment the vote belongs to reaches the quorum (see getAggSignatureForCommitmentMessage)
 func (s *stateSyncManager) saveVote(msg *TransportMessage) error {
 	s.lock.RLock()
 	epoch := s.epoch
@@ -188,8 +233,8 @@ func (s *stateSyncManager) saveVote(msg *TransportMessage) error {
 		return nil
 	}
 
-	if err := s.verifyVoteSignature(valSet, types.StringToAddress(msg.From), msg.Signature, msg.Hash); err != nil {
-		return fmt.Errorf("error verifying vote signature: %w", err)
+	if err := s.checkVote(valSet, types.StringToAddress(msg.From), msg.Signature); err != nil {
+		return fmt.Errorf("error checking vote: %w", err)
 	}
 
 	msgVote := &MessageSignature{
@@ -202,6 +247,12 @@ func (s *stateSyncManager) saveVote(msg *TransportMessage) error {
 		return fmt.Errorf("error inserting message vote: %w", err)
 	}
 
+	if s.config.alerts != nil || s.config.latency != nil {
+		s.lock.RLock()
+		s.reportSignatures(msg.Hash)
+		s.lock.RUnlock()
+	}
+
 	s.logger.Info(
 		"deliver message",
 		"hash", hex.EncodeToString(msg.Hash),
@@ -212,28 +263,28 @@ func (s *stateSyncManager) saveVote(msg *TransportMessage) error {
 	return nil
 }
 
-// Verifies signature of the message against the public key of the signer and checks if the signer is a validator
-func (s *stateSyncManager) verifyVoteSignature(valSet validator.ValidatorSet, signer types.Address, signature []byte,
-	hash []byte) error {
-	validator := valSet.Accounts().GetValidatorMetadata(signer)
-	if validator == nil {
+// checkVote checks if the signer is a validator and if the signature is well formed.
+// The signature is not verified against the public key of the signer.
+func (s *stateSyncManager) checkVote(valSet validator.ValidatorSet, signer types.Address, signature []byte) error {
+	if valSet.Accounts().GetValidatorMetadata(signer) == nil {
 		return fmt.Errorf("unable to resolve validator %s", signer)
 	}
 
-	unmarshaledSignature, err := bls.UnmarshalSignature(signature)
-	if err != nil {
+	if _, err := bls.UnmarshalSignature(signature); err != nil {
 		return fmt.Errorf("failed to unmarshal signature from signer %s, %w", signer.String(), err)
 	}
 
-	if !unmarshaledSignature.Verify(validator.BlsKey, hash, bls.DomainStateReceiver) {
-		return fmt.Errorf("incorrect signature from %s", signer)
-	}
-
 	return nil
 }
 
 // AddLog saves the received log from event tracker if it matches a state sync event ABI
 func (s *stateSyncManager) AddLog(eventLog *ethgo.Log) error {
+	return s.AddLogWithBlockTime(eventLog, time.Time{})
+}
+
+// AddLogWithBlockTime saves the received log from event tracker if it matches a state sync event ABI.
+// The block time is the time of the rootchain block which emitted the event, zero if unknown
+func (s *stateSyncManager) AddLogWithBlockTime(eventLog *ethgo.Log, blockTime time.Time) error {
 	event := &contractsapi.StateSyncedEvent{}
 
 	doesMatch, err := event.ParseLog(eventLog)
@@ -255,11 +306,49 @@ func (s *stateSyncManager) AddLog(eventLog *ethgo.Log) error {
 	}
 
 	if err := s.state.StateSyncStore.insertStateSyncEvent(event); err != nil {
+		switch {
+		case errors.Is(err, errDuplicateStateSync):
+			// the event tracker delivers the events again if it fails to process the batch,
+			// or if the rootchain reorg re-emits the event, so the event is applied only once
+			metrics.IncrCounter([]string{stateSyncMetricsPrefix, "duplicates"}, 1)
+			s.logger.Debug("ignoring duplicate state sync event", "stateSyncID", event.ID)
+
+			return nil
+		case errors.Is(err, errConflictingStateSync):
+			// the first received event is kept, since it could have been committed already.
+			// The error is not returned, as the event tracker would keep delivering the same event
+			metrics.IncrCounter([]string{stateSyncMetricsPrefix, "conflicts"}, 1)
+			s.logger.Error("rejecting state sync event conflicting with the received one",
+				"stateSyncID", event.ID, "hash", eventLog.TransactionHash)
+
+			return nil
+		}
+
 		s.logger.Error("could not save state sync event to boltDb", "err", err)
 
 		return err
 	}
 
+	if err := s.reportStateSyncSequence(event.ID.Uint64()); err != nil {
+		s.logger.Error("could not read state sync sequence cursor", "err", err)
+	}
+
+	if s.config.alerts != nil {
+		s.config.alerts.Observed(&bridgealert.Message{
+			ID:       event.ID.Uint64(),
+			Sender:   event.Sender,
+			Receiver: event.Receiver,
+		})
+	}
+
+	if s.config.latency != nil {
+		if !blockTime.IsZero() {
+			s.config.latency.Record(event.ID.Uint64(), bridgelatency.StageRootchainEvent, blockTime)
+		}
+
+		s.config.latency.Record(event.ID.Uint64(), bridgelatency.StageObserved, time.Time{})
+	}
+
 	if err := s.buildCommitment(); err != nil {
 		// we don't return an error here. If state sync event is inserted in db,
 		// we will just try to build a commitment on next block or next event arrival
@@ -269,6 +358,31 @@ func (s *stateSyncManager) AddLog(eventLog *ethgo.Log) error {
 	return nil
 }
 
+// reportStateSyncSequence reports the state sync sequence cursor metrics,
+// and warns if the received event is out of order, so there is a gap in the sequence
+func (s *stateSyncManager) reportStateSyncSequence(receivedID uint64) error {
+	next, last, err := s.state.StateSyncStore.getStateSyncCursor()
+	if err != nil {
+		return err
+	}
+
+	gap := uint64(0)
+	if last >= next {
+		gap = last - next + 1
+	}
+
+	metrics.SetGauge([]string{stateSyncMetricsPrefix, "next_expected_id"}, float32(next))
+	metrics.SetGauge([]string{stateSyncMetricsPrefix, "gap"}, float32(gap))
+
+	if receivedID > next {
+		metrics.IncrCounter([]string{stateSyncMetricsPrefix, "out_of_order"}, 1)
+		s.logger.Warn("state sync event received out of order, it is held until the gap is filled",
+			"stateSyncID", receivedID, "expectedID", next)
+	}
+
+	return nil
+}
+
 // Commitment returns a commitment to be submitted if there is a pending commitment with quorum
 func (s *stateSyncManager) Commitment(blockNumber uint64) (*CommitmentMessageSigned, error) {
 	s.lock.RLock()
@@ -330,11 +444,8 @@ func (s *stateSyncManager) getAggSignatureForCommitmentMessage(blockNumber uint6
 		return Signature{}, nil, err
 	}
 
-	var signatures bls.Signatures
-
-	publicKeys := make([][]byte, 0)
-	bmap := bitmap.Bitmap{}
-	signers := make(map[types.Address]struct{}, 0)
+	votesToVerify := make([]*pendingVote, 0, len(votes))
+	signers := make(map[types.Address]struct{}, len(votes))
 
 	for _, vote := range votes {
 		index, exists := validatorAddrToIndex[vote.From]
@@ -347,11 +458,54 @@ func (s *stateSyncManager) getAggSignatureForCommitmentMessage(blockNumber uint6
 			return Signature{}, nil, err
 		}
 
-		bmap.Set(uint64(index))
+		signer := types.StringToAddress(vote.From)
+
+		votesToVerify = append(votesToVerify, &pendingVote{
+			index:     uint64(index),
+			signer:    signer,
+			publicKey: validatorsMetadata[index].BlsKey,
+			raw:       vote.Signature,
+			signature: signature,
+		})
+		signers[signer] = struct{}{}
+	}
+
+	// do not spend time on signature verification, unless there are enough votes to reach the quorum
+	if !validatorSet.HasQuorum(blockNumber, signers) {
+		return Signature{}, nil, errQuorumNotReached
+	}
+
+	valid, invalid := s.signatureCache.verifyVotes(commitmentHash.Bytes(), votesToVerify)
+
+	// the invalid votes are dropped, they are stored along with the valid votes of the same signers
+	if len(invalid) > 0 {
+		invalidVotes := make([]*MessageSignature, len(invalid))
+		for i, vote := range invalid {
+			invalidVotes[i] = &MessageSignature{From: vote.signer.String(), Signature: vote.raw}
+		}
+
+		if err := s.state.StateSyncStore.removeMessageVotes(
+			commitment.Epoch, commitmentHash.Bytes(), invalidVotes); err != nil {
+			s.logger.Warn("failed to remove invalid commitment votes", "err", err)
+		}
+	}
+
+	var signatures bls.Signatures
 
-		signatures = append(signatures, signature)
-		publicKeys = append(publicKeys, validatorsMetadata[index].BlsKey.Marshal())
-		signers[types.StringToAddress(vote.From)] = struct{}{}
+	publicKeys := make([][]byte, 0)
+	bmap := bitmap.Bitmap{}
+	signers = make(map[types.Address]struct{}, len(votesToVerify))
+
+	for _, vote := range valid {
+		if _, exists := signers[vote.signer]; exists {
+			continue // the signature of the signer is aggregated already
+		}
+
+		bmap.Set(vote.index)
+
+		signatures = append(signatures, vote.signature)
+		publicKeys = append(publicKeys, vote.publicKey.Marshal())
+		signers[vote.signer] = struct{}{}
 	}
 
 	if !validatorSet.HasQuorum(blockNumber, signers) {
@@ -376,10 +530,18 @@ func (s *stateSyncManager) getAggSignatureForCommitmentMessage(blockNumber uint6
 func (s *stateSyncManager) PostEpoch(req *PostEpochRequest) error {
 	s.lock.Lock()
 
+	// the pending commitment which is not submitted until the end of the epoch is discarded
+	if s.config.alerts != nil && len(s.pendingCommitments) > 0 {
+		demoted := s.pendingCommitments[len(s.pendingCommitments)-1]
+		s.config.alerts.Demoted(demoted.StartID.Uint64(), demoted.EndID.Uint64())
+	}
+
 	s.pendingCommitments = nil
 	s.validatorSet = req.ValidatorSet
 	s.epoch = req.NewEpochID
 
+	s.signatureCache.purge()
+
 	// build a new commitment at the end of the epoch
 	nextCommittedIndex, err := req.SystemState.GetNextCommittedIndex()
 	if err != nil {
@@ -398,6 +560,17 @@ func (s *stateSyncManager) PostEpoch(req *PostEpochRequest) error {
 // PostBlock notifies state sync manager that a block was finalized,
 // so that it can build state sync proofs if a block has a commitment submission transaction
 func (s *stateSyncManager) PostBlock(req *PostBlockRequest) error {
+	if s.config.latency != nil {
+		s.recordExecutedStateSyncs(req.FullBlock)
+	}
+
+	if s.config.alerts != nil || s.config.latency != nil {
+		// the quorum of the pending commitments depends on the block number
+		s.lock.Lock()
+		s.lastBlockNumber = req.FullBlock.Block.Number()
+		s.lock.Unlock()
+	}
+
 	commitment, err := getCommitmentMessageSignedTx(req.FullBlock.Block.Transactions)
 	if err != nil {
 		return err
@@ -408,6 +581,25 @@ func (s *stateSyncManager) PostBlock(req *PostBlockRequest) error {
 		return nil
 	}
 
+	s.lock.RLock()
+	nextCommittedIndex := s.nextCommittedIndex
+	s.lock.RUnlock()
+
+	startID, endID := commitment.Message.StartID.Uint64(), commitment.Message.EndID.Uint64()
+
+	// the state receiver contract rejects the commitment which doesn't continue the sequence,
+	// so the violation means the node view of the committed sequence is inconsistent
+	if nextCommittedIndex != 0 && startID != nextCommittedIndex {
+		metrics.IncrCounter([]string{stateSyncMetricsPrefix, "sequence_violations"}, 1)
+		s.logger.Error("committed state syncs don't continue the sequence",
+			"from", startID, "to", endID, "expectedFrom", nextCommittedIndex)
+
+		if endID < nextCommittedIndex {
+			// the state syncs are already committed, so they are not applied again
+			return nil
+		}
+	}
+
 	if err := s.state.StateSyncStore.insertCommitmentMessage(commitment); err != nil {
 		return fmt.Errorf("insert commitment message error: %w", err)
 	}
@@ -416,16 +608,71 @@ func (s *stateSyncManager) PostBlock(req *PostBlockRequest) error {
 		return fmt.Errorf("build commitment proofs error: %w", err)
 	}
 
+	if s.config.alerts != nil {
+		s.config.alerts.Committed(endID)
+	}
+
+	if s.config.latency != nil {
+		s.config.latency.RecordRange(startID, endID, bridgelatency.StageCommitted, blockTime(req.FullBlock.Block))
+	}
+
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	// update the nextCommittedIndex since a commitment was submitted
-	s.nextCommittedIndex = commitment.Message.EndID.Uint64() + 1
+	s.nextCommittedIndex = endID + 1
 	// commitment was submitted, so discard what we have in memory, so we can build a new one
 	s.pendingCommitments = nil
 
 	return nil
 }
 
+// GetStateSyncTimeline returns the times the state sync reached each of the bridge stages
+func (s *stateSyncManager) GetStateSyncTimeline(stateSyncID uint64) (*types.BridgeMessageTimeline, error) {
+	if s.config.latency == nil {
+		return nil, errBridgeNotEnabled
+	}
+
+	timeline, ok := s.config.latency.Timeline(stateSyncID)
+	if !ok {
+		return nil, fmt.Errorf("no timeline for StateSync id %d", stateSyncID)
+	}
+
+	return timeline, nil
+}
+
+// recordExecutedStateSyncs records the state syncs executed by the state receiver in the given block
+func (s *stateSyncManager) recordExecutedStateSyncs(block *types.FullBlock) {
+	at := blockTime(block.Block)
+
+	for _, receipt := range block.Receipts {
+		for _, log := range receipt.Logs {
+			if log.Address != contracts.StateReceiverContract {
+				continue
+			}
+
+			var event contractsapi.StateSyncResultEvent
+
+			doesMatch, err := event.ParseLog(convertLog(log))
+			if !doesMatch {
+				continue
+			}
+
+			if err != nil {
+				s.logger.Debug("could not decode state sync result event", "err", err)
+
+				continue
+			}
+
+			s.config.latency.Executed(event.Counter.Uint64(), event.Status, at)
+		}
+	}
+}
+
+// blockTime returns the time of the block
+func blockTime(block *types.Block) time.Time {
+	return time.Unix(int64(block.Header.Timestamp), 0).UTC()
+}
+
 // GetStateSyncProof returns the proof for the state sync
 func (s *stateSyncManager) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	stateSyncProof, err := s.state.StateSyncStore.getStateSyncProof(stateSyncID)
@@ -530,6 +777,13 @@ func (s *stateSyncManager) buildCommitment() error {
 		return nil
 	}
 
+	// the events are committed strictly in sequence, starting with the first one which is not committed yet
+	for i, event := range stateSyncEvents {
+		if expectedID := s.nextCommittedIndex + uint64(i); event.ID.Uint64() != expectedID {
+			return fmt.Errorf("state sync event %d is out of sequence, expected %d", event.ID, expectedID)
+		}
+	}
+
 	if len(s.pendingCommitments) > 0 &&
 		s.pendingCommitments[len(s.pendingCommitments)-1].StartID.Cmp(stateSyncEvents[len(stateSyncEvents)-1].ID) >= 0 {
 		// already built a commitment of this size which is pending to be submitted
@@ -582,9 +836,53 @@ func (s *stateSyncManager) buildCommitment() error {
 
 	s.pendingCommitments = append(s.pendingCommitments, commitment)
 
+	if s.config.alerts != nil || s.config.latency != nil {
+		s.reportSignatures(hashBytes)
+	}
+
 	return nil
 }
 
+// reportSignatures reports the signature progress of the pending commitment with the given hash
+// to the bridge alerts monitor, and records the quorum of its state syncs. It must be called while holding the lock
+func (s *stateSyncManager) reportSignatures(commitmentHash []byte) {
+	if s.validatorSet == nil {
+		return
+	}
+
+	for _, commitment := range s.pendingCommitments {
+		hash, err := commitment.Hash()
+		if err != nil || !bytes.Equal(hash.Bytes(), commitmentHash) {
+			continue
+		}
+
+		votes, err := s.state.StateSyncStore.getMessageVotes(commitment.Epoch, commitmentHash)
+		if err != nil {
+			s.logger.Debug("failed to get commitment votes for bridge signatures progress", "err", err)
+
+			return
+		}
+
+		signers := make(map[types.Address]struct{}, len(votes))
+		for _, vote := range votes {
+			signers[types.StringToAddress(vote.From)] = struct{}{}
+		}
+
+		quorum := s.validatorSet.HasQuorum(s.lastBlockNumber+1, signers)
+
+		if s.config.alerts != nil {
+			s.config.alerts.Signed(commitment.StartID.Uint64(), commitment.EndID.Uint64(), len(signers), quorum)
+		}
+
+		if s.config.latency != nil && quorum {
+			s.config.latency.RecordRange(commitment.StartID.Uint64(), commitment.EndID.Uint64(),
+				bridgelatency.StageQuorum, time.Time{})
+		}
+
+		return
+	}
+}
+
 // multicast publishes given message to the rest of the network
 func (s *stateSyncManager) multicast(msg interface{}) {
 	data, err := json.Marshal(msg)
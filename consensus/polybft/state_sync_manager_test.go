@@ -12,12 +12,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/abi"
-	"github.com/umbracle/ethgo/testutil"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgelatency"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/tests/rootchain"
 	"github.com/0xPolygon/polygon-edge/merkle-tree"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -36,7 +38,7 @@ func newTestStateSyncManager(t *testing.T, key *validator.TestValidator, runtime
 	s := newStateSyncManager(hclog.NewNullLogger(), state,
 		&stateSyncConfig{
 			stateSenderAddr:   types.Address{},
-			jsonrpcAddr:       "",
+			jsonrpcAddrs:      []string{""},
 			dataDir:           tmpDir,
 			topic:             topic,
 			key:               key.Key(),
@@ -178,14 +180,19 @@ func TestStateSyncManager_MessagePool(t *testing.T) {
 		require.NoError(t, err)
 
 		msg.From = vals.GetValidator("1").Address().String()
-		require.Error(t, s.saveVote(msg))
+		// signature verification is deferred, so the vote gets saved
+		require.NoError(t, s.saveVote(msg))
 
 		// non validator signs the msg in behalf of a validator
 		badVal := validator.NewTestValidator(t, "a", 0)
 		msg, err = newMockMsg().sign(badVal, bls.DomainStateReceiver)
 		require.NoError(t, err)
 
-		msg.From = vals.GetValidator("1").Address().String()
+		msg.From = vals.GetValidator("2").Address().String()
+		require.NoError(t, s.saveVote(msg))
+
+		// malformed signature is rejected right away
+		msg.Signature = []byte{0x1, 0x2}
 		require.Error(t, s.saveVote(msg))
 	})
 
@@ -282,6 +289,83 @@ func TestStateSyncManager_BuildCommitment(t *testing.T) {
 	require.NotNil(t, commitment)
 }
 
+func TestStateSyncManager_Commitment_InvalidVotesDiscarded(t *testing.T) {
+	t.Parallel()
+
+	vals := validator.NewTestValidators(t, 5)
+
+	s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
+	s.validatorSet = vals.ToValidatorSet()
+
+	tree, err := merkle.NewMerkleTree([][]byte{{0x1}})
+	require.NoError(t, err)
+
+	s.pendingCommitments = []*PendingCommitment{
+		{
+			MerkleTree: tree,
+			StateSyncCommitment: &contractsapi.StateSyncCommitment{
+				Root:    tree.Hash(),
+				StartID: big.NewInt(0),
+				EndID:   big.NewInt(1),
+			},
+		},
+	}
+
+	hash, err := s.pendingCommitments[0].Hash()
+	require.NoError(t, err)
+
+	msg := newMockMsg().WithHash(hash.Bytes())
+
+	// validators 0, 1 and 2 vote properly
+	for _, alias := range []string{"0", "1", "2"} {
+		signedMsg, err := msg.sign(vals.GetValidator(alias), bls.DomainStateReceiver)
+		require.NoError(t, err)
+		require.NoError(t, s.saveVote(signedMsg))
+	}
+
+	// validator 3 vote is signed by validator 0
+	forgedMsg, err := msg.sign(vals.GetValidator("0"), bls.DomainStateReceiver)
+	require.NoError(t, err)
+
+	forgedMsg.From = vals.GetValidator("3").Address().String()
+	require.NoError(t, s.saveVote(forgedMsg))
+
+	// there are enough votes, but one of them is invalid, so the quorum is not reached
+	commitment, err := s.Commitment(1)
+	require.NoError(t, err)
+	require.Nil(t, commitment)
+
+	// verification results are cached
+	valid, found := s.signatureCache.get(hash.Bytes(), vals.GetValidator("3").Address(), forgedMsg.Signature)
+	require.True(t, found)
+	require.False(t, valid)
+
+	// the invalid vote is dropped
+	votes, err := s.state.StateSyncStore.getMessageVotes(0, hash.Bytes())
+	require.NoError(t, err)
+	require.Len(t, votes, 3)
+
+	// the forged vote is delivered again, but it doesn't take the slot of validator 3, which votes properly
+	require.NoError(t, s.saveVote(forgedMsg))
+
+	signedMsg, err := msg.sign(vals.GetValidator("3"), bls.DomainStateReceiver)
+	require.NoError(t, err)
+	require.NoError(t, s.saveVote(signedMsg))
+
+	votes, err = s.state.StateSyncStore.getMessageVotes(0, hash.Bytes())
+	require.NoError(t, err)
+	require.Len(t, votes, 5)
+
+	commitment, err = s.Commitment(1)
+	require.NoError(t, err)
+	require.NotNil(t, commitment)
+	require.Len(t, commitment.PublicKeys, 4)
+
+	votes, err = s.state.StateSyncStore.getMessageVotes(0, hash.Bytes())
+	require.NoError(t, err)
+	require.Len(t, votes, 4)
+}
+
 func TestStateSyncerManager_BuildProofs(t *testing.T) {
 	vals := validator.NewTestValidators(t, 5)
 
@@ -430,13 +514,190 @@ func TestStateSyncerManager_AddLog_BuildCommitments(t *testing.T) {
 	})
 }
 
+func TestStateSyncerManager_AddLog_Sequence(t *testing.T) {
+	t.Parallel()
+
+	vals := validator.NewTestValidators(t, 5)
+	s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
+
+	var stateSyncedEvent contractsapi.StateSyncedEvent
+
+	data, err := abi.MustNewType("tuple(string a)").Encode([]string{"data"})
+	require.NoError(t, err)
+
+	stateSyncLog := func(id byte, data []byte) *ethgo.Log {
+		return &ethgo.Log{
+			Topics: []ethgo.Hash{
+				stateSyncedEvent.Sig(),
+				ethgo.BytesToHash([]byte{id}),
+				ethgo.ZeroHash,
+				ethgo.ZeroHash,
+			},
+			Data: data,
+		}
+	}
+
+	require.NoError(t, s.AddLog(stateSyncLog(0x0, data)))
+
+	// the event out of order is held, and not committed before the gap is filled
+	require.NoError(t, s.AddLog(stateSyncLog(0x2, data)))
+	require.Len(t, s.pendingCommitments, 1)
+	require.Equal(t, uint64(0), s.pendingCommitments[0].EndID.Uint64())
+
+	require.NoError(t, s.AddLog(stateSyncLog(0x1, data)))
+	require.Len(t, s.pendingCommitments, 2)
+	require.Equal(t, uint64(2), s.pendingCommitments[1].EndID.Uint64())
+
+	// the events received again are not applied twice
+	otherData, err := abi.MustNewType("tuple(string a)").Encode([]string{"other"})
+	require.NoError(t, err)
+
+	require.NoError(t, s.AddLog(stateSyncLog(0x1, data)))
+	require.NoError(t, s.AddLog(stateSyncLog(0x1, otherData)))
+	require.Len(t, s.pendingCommitments, 2)
+
+	next, _, err := s.state.StateSyncStore.getStateSyncCursor()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+}
+
+func TestStateSyncerManager_PostBlock_ReplayedCommitment(t *testing.T) {
+	t.Parallel()
+
+	vals := validator.NewTestValidators(t, 5)
+	s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
+
+	for _, evnt := range generateStateSyncEvents(t, 20, 1) {
+		require.NoError(t, s.state.StateSyncStore.insertStateSyncEvent(evnt))
+	}
+
+	commitmentBlock := func(startID, endID int64) *PostBlockRequest {
+		msg := &CommitmentMessageSigned{
+			Message: &contractsapi.StateSyncCommitment{
+				StartID: big.NewInt(startID),
+				EndID:   big.NewInt(endID),
+			},
+		}
+
+		txData, err := msg.EncodeAbi()
+		require.NoError(t, err)
+
+		return &PostBlockRequest{
+			FullBlock: &types.FullBlock{
+				Block: &types.Block{
+					Transactions: []*types.Transaction{createStateTransactionWithData(1, types.Address{}, txData)},
+				},
+			},
+		}
+	}
+
+	s.nextCommittedIndex = 1
+
+	require.NoError(t, s.PostBlock(commitmentBlock(1, 10)))
+	require.Equal(t, uint64(11), s.nextCommittedIndex)
+
+	// the already committed state syncs are not applied again
+	require.NoError(t, s.PostBlock(commitmentBlock(1, 10)))
+	require.Equal(t, uint64(11), s.nextCommittedIndex)
+}
+
+func TestStateSyncerManager_Timeline(t *testing.T) {
+	t.Parallel()
+
+	vals := validator.NewTestValidators(t, 5)
+	s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
+
+	_, err := s.GetStateSyncTimeline(0)
+	require.ErrorIs(t, err, errBridgeNotEnabled)
+
+	s.config.latency = bridgelatency.NewTracker(bridgelatency.DefaultCapacity)
+
+	var stateSyncedEvent contractsapi.StateSyncedEvent
+
+	data, err := abi.MustNewType("tuple(string a)").Encode([]string{"data"})
+	require.NoError(t, err)
+
+	rootchainTime := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+
+	require.NoError(t, s.AddLogWithBlockTime(&ethgo.Log{
+		Topics: []ethgo.Hash{stateSyncedEvent.Sig(), ethgo.ZeroHash, ethgo.ZeroHash, ethgo.ZeroHash},
+		Data:   data,
+	}, rootchainTime))
+
+	// the commitment of the state sync is included in the block
+	msg := &CommitmentMessageSigned{
+		Message: &contractsapi.StateSyncCommitment{StartID: big.NewInt(0), EndID: big.NewInt(0)},
+	}
+
+	txData, err := msg.EncodeAbi()
+	require.NoError(t, err)
+
+	commitTime := time.Now().UTC().Add(time.Minute).Truncate(time.Second)
+
+	require.NoError(t, s.PostBlock(&PostBlockRequest{
+		FullBlock: &types.FullBlock{
+			Block: &types.Block{
+				Header:       &types.Header{Number: 1, Timestamp: uint64(commitTime.Unix())},
+				Transactions: []*types.Transaction{createStateTransactionWithData(1, types.Address{}, txData)},
+			},
+		},
+	}))
+
+	// the state sync is executed by the state receiver in the next block
+	var stateSyncResultEvent contractsapi.StateSyncResultEvent
+
+	resultData, err := abi.MustNewType("tuple(bytes a)").Encode([]interface{}{[]byte{}})
+	require.NoError(t, err)
+
+	require.NoError(t, s.PostBlock(&PostBlockRequest{
+		FullBlock: &types.FullBlock{
+			Block: &types.Block{
+				Header: &types.Header{Number: 2, Timestamp: uint64(commitTime.Unix()) + 2},
+			},
+			Receipts: []*types.Receipt{{
+				Logs: []*types.Log{{
+					Address: contracts.StateReceiverContract,
+					Topics: []types.Hash{
+						types.Hash(stateSyncResultEvent.Sig()),
+						types.ZeroHash,
+						types.BytesToHash([]byte{1}),
+					},
+					Data: resultData,
+				}},
+			}},
+		},
+	}))
+
+	timeline, err := s.GetStateSyncTimeline(0)
+	require.NoError(t, err)
+	require.Len(t, timeline.Stages, 4)
+
+	for i, stage := range []bridgelatency.Stage{
+		bridgelatency.StageRootchainEvent,
+		bridgelatency.StageObserved,
+		bridgelatency.StageCommitted,
+		bridgelatency.StageExecuted,
+	} {
+		require.Equal(t, string(stage), timeline.Stages[i].Stage)
+	}
+
+	require.Equal(t, rootchainTime, timeline.Stages[0].Time)
+	require.Equal(t, commitTime, timeline.Stages[2].Time)
+	require.Equal(t, uint64(2000), timeline.Stages[3].Latency)
+	require.NotNil(t, timeline.Success)
+	require.True(t, *timeline.Success)
+
+	_, err = s.GetStateSyncTimeline(1)
+	require.Error(t, err)
+}
+
 func TestStateSyncerManager_EventTracker_Sync(t *testing.T) {
 	t.Parallel()
 
 	vals := validator.NewTestValidators(t, 5)
 	s := newTestStateSyncManager(t, vals.GetValidator("0"), &mockRuntime{isActiveValidator: true})
 
-	server := testutil.DeployTestServer(t, nil)
+	server := rootchain.NewTestRootchain(t, nil)
 
 	// Deploy contract
 	contractReceipt, err := server.SendTxn(&ethgo.Transaction{
@@ -462,7 +723,7 @@ func TestStateSyncerManager_EventTracker_Sync(t *testing.T) {
 	}
 
 	s.config.stateSenderAddr = types.Address(contractReceipt.ContractAddress)
-	s.config.jsonrpcAddr = server.HTTPAddr()
+	s.config.jsonrpcAddrs = []string{server.HTTPAddr()}
 
 	require.NoError(t, s.initTracker())
 
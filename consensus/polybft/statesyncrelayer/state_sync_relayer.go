@@ -84,7 +84,7 @@ func NewRelayer(
 func (r *StateSyncRelayer) Start() error {
 	et := tracker.NewEventTracker(
 		path.Join(r.dataDir, "/relayer.db"),
-		r.rpcEndpoint,
+		[]string{r.rpcEndpoint},
 		r.stateReceiverAddr,
 		r,
 		0, // sidechain (Polygon POS) is instant finality, so no need to wait
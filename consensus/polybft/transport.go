@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	ibftProto "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus"
 	polybftProto "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -16,6 +17,8 @@ type BridgeTransport interface {
 
 // subscribeToIbftTopic subscribes to ibft topic
 func (p *Polybft) subscribeToIbftTopic() error {
+	filter := consensus.NewMessageFilter(consensus.MessageHeightWindow, consensus.MessageRoundWindow)
+
 	return p.consensusTopic.Subscribe(func(obj interface{}, _ peer.ID) {
 		if !p.runtime.IsActiveValidator() {
 			return
@@ -28,6 +31,11 @@ func (p *Polybft) subscribeToIbftTopic() error {
 			return
 		}
 
+		// the replayed and far-future messages are dropped before their signatures are verified
+		if !filter.Accept(msg, p.blockchain.CurrentHeader().Number) {
+			return
+		}
+
 		p.ibft.AddMessage(msg)
 
 		p.logger.Debug(
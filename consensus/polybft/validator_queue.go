@@ -0,0 +1,49 @@
+package polybft
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// GetValidatorQueue returns the staked candidates which are not in the current validator set (nor jailed),
+// in the order they are promoted to it. The validators keep their slots as long as they have stake,
+// so the candidates at the head of the queue are promoted at the end of the epoch only if there are open slots
+func (p *Polybft) GetValidatorQueue() (*types.ValidatorQueue, error) {
+	fullValidatorSet, err := p.state.StakeStore.getFullValidatorSet()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get full validators set: %w", err)
+	}
+
+	jailState, err := p.state.JailStore.getJailState()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jailed validators: %w", err)
+	}
+
+	validators, err := p.GetValidators(p.blockchain.CurrentHeader().Number, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	maxValidatorSetSize := int(p.consensusConfig.MaxValidatorSetSize)
+	seated, queue := fullValidatorSet.Validators.exclude(jailState.jailedAddresses()).
+		splitByValidatorSet(validators)
+
+	result := &types.ValidatorQueue{
+		BlockNumber:         fullValidatorSet.BlockNumber,
+		Epoch:               fullValidatorSet.EpochID,
+		MaxValidatorSetSize: p.consensusConfig.MaxValidatorSetSize,
+		Validators:          uint64(len(validators)),
+		OpenSlots:           uint64(getOpenSlots(seated, maxValidatorSetSize)),
+		Queue:               make([]*types.QueuedValidator, len(queue)),
+	}
+
+	for i, v := range queue {
+		result.Queue[i] = &types.QueuedValidator{
+			Address:     v.Address,
+			VotingPower: v.VotingPower,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package polybft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolybft_GetValidatorQueue(t *testing.T) {
+	t.Parallel()
+
+	const epochSize = uint64(10)
+
+	validators := validator.NewTestValidatorsWithAliases(t,
+		[]string{"A", "B", "C", "D", "E"}, []uint64{10, 10, 10, 10, 10})
+	currentValidators := validators.GetPublicIdentities("A", "B", "C")
+
+	headersMap := &testHeadersMap{headersByNumber: make(map[uint64]*types.Header)}
+
+	delta, err := validator.CreateValidatorSetDelta(nil, currentValidators)
+	require.NoError(t, err)
+
+	genesisExtra := &Extra{Validators: delta, Checkpoint: &CheckpointData{}}
+	headersMap.addHeader(&types.Header{Number: 0, ExtraData: genesisExtra.MarshalRLPTo(nil)})
+
+	for i := uint64(1); i <= 2; i++ {
+		extra := &Extra{Checkpoint: &CheckpointData{EpochNumber: 1}}
+		headersMap.addHeader(&types.Header{Number: i, ExtraData: extra.MarshalRLPTo(nil)})
+	}
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+	blockchainMock.On("CurrentHeader").Return(headersMap.getHeader(2))
+
+	// C unstakes, so its slot is open, D and E are queued, D having more stake
+	fullValidatorSet := validators.GetPublicIdentities().Copy()
+	fullValidatorSet[2].VotingPower = bigZero
+	fullValidatorSet[3].VotingPower = big.NewInt(20)
+
+	state := newTestState(t)
+	require.NoError(t, state.StakeStore.insertFullValidatorSet(validatorSetState{
+		BlockNumber: 2,
+		EpochID:     1,
+		Validators:  newValidatorStakeMap(fullValidatorSet),
+	}))
+
+	polybft := &Polybft{
+		state:           state,
+		blockchain:      blockchainMock,
+		consensusConfig: &PolyBFTConfig{EpochSize: epochSize, MaxValidatorSetSize: 3},
+		validatorsCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), state, blockchainMock, epochSize),
+	}
+
+	queue, err := polybft.GetValidatorQueue()
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(2), queue.BlockNumber)
+	require.Equal(t, uint64(1), queue.Epoch)
+	require.Equal(t, uint64(3), queue.MaxValidatorSetSize)
+	require.Equal(t, uint64(3), queue.Validators)
+	require.Equal(t, uint64(1), queue.OpenSlots)
+	require.Len(t, queue.Queue, 2)
+	require.Equal(t, validators.GetValidator("D").Address(), queue.Queue[0].Address)
+	require.Equal(t, big.NewInt(20), queue.Queue[0].VotingPower)
+	require.Equal(t, validators.GetValidator("E").Address(), queue.Queue[1].Address)
+}
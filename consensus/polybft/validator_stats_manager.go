@@ -0,0 +1,165 @@
+package polybft
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/contract"
+)
+
+// validatorStatsManager collects the per-validator performance statistics from the inserted blocks
+// and stores them incrementally, per epoch
+type validatorStatsManager struct {
+	state      *State
+	blockchain blockchainBackend
+	logger     hclog.Logger
+}
+
+// newValidatorStatsManager creates a new instance of validatorStatsManager
+func newValidatorStatsManager(state *State, blockchain blockchainBackend, logger hclog.Logger) *validatorStatsManager {
+	return &validatorStatsManager{
+		state:      state,
+		blockchain: blockchain,
+		logger:     logger,
+	}
+}
+
+// PostBlock updates the statistics of the epoch with the data of the inserted block.
+// The validators are the validator set of the epoch and the proposer snapshot must be the one
+// calculated for the inserted block, so that the proposers of the failed rounds can be determined
+func (m *validatorStatsManager) PostBlock(req *PostBlockRequest,
+	validators validator.AccountSet, proposerSnapshot *ProposerSnapshot) error {
+	header := req.FullBlock.Block.Header
+
+	extra, err := GetIbftExtra(header.ExtraData)
+	if err != nil {
+		return err
+	}
+
+	stats, err := m.state.ValidatorStatsStore.getEpochValidatorStats(req.Epoch)
+	if err != nil {
+		return err
+	}
+
+	if stats == nil {
+		stats = newEpochValidatorStats(req.Epoch, header.Number)
+	} else if stats.LastBlock >= header.Number {
+		// block already processed
+		return nil
+	}
+
+	for _, addr := range validators.GetAddresses() {
+		stats.get(addr)
+	}
+
+	round := extra.Checkpoint.BlockRound
+
+	proposer := stats.get(types.BytesToAddress(header.Miner))
+	proposer.BlocksProposed++
+	proposer.RoundsSum += round
+
+	// proposers of the previous rounds failed to get their block committed
+	if round > 0 {
+		if proposerSnapshot == nil || proposerSnapshot.Height != header.Number {
+			m.logger.Debug("proposer snapshot not available, skipping missed blocks", "block", header.Number)
+		} else {
+			for r := uint64(0); r < round; r++ {
+				addr, err := proposerSnapshot.CalcProposer(r, header.Number)
+				if err != nil {
+					return fmt.Errorf("failed to calculate proposer of round %d: %w", r, err)
+				}
+
+				stats.get(addr).BlocksMissed++
+			}
+		}
+	}
+
+	if extra.Committed != nil {
+		signers, err := validators.GetFilteredValidators(extra.Committed.Bitmap)
+		if err != nil {
+			return err
+		}
+
+		for _, addr := range signers.GetAddresses() {
+			stats.get(addr).SignaturesIncluded++
+		}
+	}
+
+	if req.IsEpochEndingBlock {
+		if err := m.updateRewards(header, stats); err != nil {
+			return fmt.Errorf("failed to update rewards: %w", err)
+		}
+	}
+
+	stats.LastBlock = header.Number
+
+	return m.state.ValidatorStatsStore.insertEpochValidatorStats(stats)
+}
+
+// updateRewards sets the rewards earned in the epoch, which are distributed in the epoch ending block.
+// The reward is the increase of the validator pending rewards in that block
+func (m *validatorStatsManager) updateRewards(header *types.Header, stats *EpochValidatorStats) error {
+	parent, ok := m.blockchain.GetHeaderByNumber(header.Number - 1)
+	if !ok {
+		return fmt.Errorf("header %d not found", header.Number-1)
+	}
+
+	before, err := m.blockchain.GetStateProviderForBlock(parent)
+	if err != nil {
+		return err
+	}
+
+	after, err := m.blockchain.GetStateProviderForBlock(header)
+	if err != nil {
+		return err
+	}
+
+	for addr, validatorStats := range stats.Validators {
+		pendingBefore, err := getPendingRewards(before, addr)
+		if err != nil {
+			return err
+		}
+
+		pendingAfter, err := getPendingRewards(after, addr)
+		if err != nil {
+			return err
+		}
+
+		// pending rewards decrease if the validator withdraws them in the same block
+		reward := new(big.Int).Sub(pendingAfter, pendingBefore)
+		if reward.Sign() < 0 {
+			reward.SetInt64(0)
+		}
+
+		validatorStats.Reward = reward
+	}
+
+	return nil
+}
+
+// getPendingRewards returns the pending rewards of the validator from the reward pool contract
+func getPendingRewards(provider contract.Provider, addr types.Address) (*big.Int, error) {
+	rewardPool := contract.NewContract(
+		ethgo.Address(contracts.RewardPoolContract),
+		contractsapi.RewardPool.Abi,
+		contract.WithProvider(provider),
+	)
+
+	rawResult, err := rewardPool.Call("pendingRewards", ethgo.Latest, ethgo.Address(addr))
+	if err != nil {
+		return nil, err
+	}
+
+	pendingRewards, ok := rawResult["0"].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode pending rewards of %s", addr)
+	}
+
+	return pendingRewards, nil
+}
@@ -0,0 +1,137 @@
+package polybft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/contract"
+)
+
+var _ contract.Provider = (*pendingRewardsProviderMock)(nil)
+
+// pendingRewardsProviderMock returns the pending rewards of the reward pool contract
+type pendingRewardsProviderMock struct {
+	rewards map[types.Address]*big.Int
+}
+
+func (p *pendingRewardsProviderMock) Call(_ ethgo.Address, input []byte, _ *contract.CallOpts) ([]byte, error) {
+	reward, ok := p.rewards[types.BytesToAddress(input[4:36])]
+	if !ok {
+		reward = big.NewInt(0)
+	}
+
+	return types.BytesToHash(reward.Bytes()).Bytes(), nil
+}
+
+func (p *pendingRewardsProviderMock) Txn(ethgo.Address, ethgo.Key, []byte) (contract.Txn, error) {
+	return nil, nil
+}
+
+func TestValidatorStatsManager_PostBlock(t *testing.T) {
+	t.Parallel()
+
+	const epoch = uint64(3)
+
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B", "C"})
+	accounts := validators.GetPublicIdentities()
+	snapshot := NewProposerSnapshot(1, accounts)
+	state := newTestState(t)
+	blockchain := new(blockchainMock)
+
+	manager := newValidatorStatsManager(state, blockchain, hclog.NewNullLogger())
+
+	createBlock := func(number, round uint64, miner types.Address, signers ...int) *types.FullBlock {
+		committed := bitmap.Bitmap{}
+		for _, i := range signers {
+			committed.Set(uint64(i))
+		}
+
+		extra := &Extra{
+			Parent:     &Signature{},
+			Committed:  &Signature{Bitmap: committed},
+			Checkpoint: &CheckpointData{EpochNumber: epoch, BlockRound: round},
+		}
+
+		return &types.FullBlock{Block: &types.Block{Header: &types.Header{
+			Number:    number,
+			Miner:     miner.Bytes(),
+			ExtraData: extra.MarshalRLPTo(nil),
+		}}}
+	}
+
+	// the block 1 is committed in the round 2, so the proposers of the rounds 0 and 1 missed it
+	missed := make([]types.Address, 2)
+
+	for round := range missed {
+		addr, err := snapshot.Copy().CalcProposer(uint64(round), 1)
+		require.NoError(t, err)
+
+		missed[round] = addr
+	}
+
+	proposer, err := snapshot.Copy().CalcProposer(2, 1)
+	require.NoError(t, err)
+
+	block := createBlock(1, 2, proposer, 0, 1)
+	require.NoError(t, manager.PostBlock(&PostBlockRequest{FullBlock: block, Epoch: epoch}, accounts, snapshot.Copy()))
+
+	// the same block is not processed twice
+	require.NoError(t, manager.PostBlock(&PostBlockRequest{FullBlock: block, Epoch: epoch}, accounts, snapshot.Copy()))
+
+	// the block 2 is the epoch ending block, which distributes the rewards
+	rewards := map[types.Address]*big.Int{accounts[0].Address: big.NewInt(100), accounts[1].Address: big.NewInt(50)}
+	parent := block.Block.Header
+	block = createBlock(2, 0, accounts[2].Address, 0, 1, 2)
+
+	blockchain.On("GetHeaderByNumber", uint64(1)).Return(parent)
+	blockchain.On("GetStateProviderForBlock", parent).Return(&pendingRewardsProviderMock{
+		rewards: map[types.Address]*big.Int{accounts[0].Address: big.NewInt(10)},
+	})
+	blockchain.On("GetStateProviderForBlock", block.Block.Header).Return(&pendingRewardsProviderMock{rewards: rewards})
+
+	require.NoError(t, manager.PostBlock(&PostBlockRequest{FullBlock: block, Epoch: epoch, IsEpochEndingBlock: true},
+		accounts, nil))
+
+	stats, err := state.ValidatorStatsStore.getEpochValidatorStats(epoch)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.FirstBlock)
+	require.Equal(t, uint64(2), stats.LastBlock)
+	require.Len(t, stats.Validators, 3)
+
+	var totalProposed, totalMissed uint64
+
+	for _, v := range stats.Validators {
+		totalProposed += v.BlocksProposed
+		totalMissed += v.BlocksMissed
+	}
+
+	require.Equal(t, uint64(2), totalProposed)
+	require.Equal(t, uint64(2), totalMissed)
+
+	for _, addr := range missed {
+		require.NotZero(t, stats.Validators[addr].BlocksMissed)
+	}
+
+	require.Equal(t, uint64(2), stats.Validators[accounts[0].Address].SignaturesIncluded)
+	require.Equal(t, uint64(2), stats.Validators[accounts[1].Address].SignaturesIncluded)
+	require.Equal(t, uint64(1), stats.Validators[accounts[2].Address].SignaturesIncluded)
+
+	require.Equal(t, big.NewInt(90), stats.Validators[accounts[0].Address].Reward)
+	require.Equal(t, big.NewInt(50), stats.Validators[accounts[1].Address].Reward)
+	require.Equal(t, big.NewInt(0), stats.Validators[accounts[2].Address].Reward)
+
+	if proposer == accounts[2].Address {
+		require.Equal(t, float64(1), stats.Validators[proposer].AverageRound())
+	} else {
+		require.Equal(t, float64(2), stats.Validators[proposer].AverageRound())
+		require.Equal(t, float64(0), stats.Validators[accounts[2].Address].AverageRound())
+	}
+
+	blockchain.AssertExpectations(t)
+}
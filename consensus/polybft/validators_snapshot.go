@@ -33,17 +33,22 @@ type validatorsSnapshotCache struct {
 	blockchain blockchainBackend
 	lock       sync.Mutex
 	logger     hclog.Logger
+
+	// epochSize is the fixed number of blocks in the epoch, used to locate the epoch ending blocks
+	// without walking the chain block by block. The chain is walked if it is not set
+	epochSize uint64
 }
 
 // newValidatorsSnapshotCache initializes a new instance of validatorsSnapshotCache
 func newValidatorsSnapshotCache(
-	logger hclog.Logger, state *State, blockchain blockchainBackend,
+	logger hclog.Logger, state *State, blockchain blockchainBackend, epochSize uint64,
 ) *validatorsSnapshotCache {
 	return &validatorsSnapshotCache{
 		snapshots:  map[uint64]*validatorSnapshot{},
 		state:      state,
 		blockchain: blockchain,
 		logger:     logger.Named("validators_snapshot"),
+		epochSize:  epochSize,
 	}
 }
 
@@ -191,6 +196,11 @@ func (v *validatorsSnapshotCache) computeSnapshot(
 		snapshotEpoch = existingSnapshot.Epoch + 1
 	}
 
+	// the epoch ending blocks located by the epoch size must carry the validator set delta of the expected epoch
+	if v.epochSize > 0 && (extra.Validators == nil || extra.Checkpoint.EpochNumber != snapshotEpoch) {
+		return nil, fmt.Errorf("block#%d is not an epoch ending block of epoch %d", header.Number, snapshotEpoch)
+	}
+
 	snapshot, err = snapshot.ApplyDelta(extra.Validators)
 	if err != nil {
 		return nil, fmt.Errorf("failed to apply delta to the validators snapshot, block#%d: %w", header.Number, err)
@@ -217,6 +227,13 @@ func (v *validatorsSnapshotCache) storeSnapshot(snapshot *validatorSnapshot) err
 		return fmt.Errorf("failed to insert validator snapshot for epoch %d to the database: %w", copySnap.Epoch, err)
 	}
 
+	// every epochSnapshotInterval-th snapshot is archived, so it survives the cleanup
+	if copySnap.Epoch%epochSnapshotInterval == 0 {
+		if err := v.state.EpochSnapshotStore.insertEpochSnapshot(copySnap); err != nil {
+			return fmt.Errorf("failed to archive validator snapshot for epoch %d: %w", copySnap.Epoch, err)
+		}
+	}
+
 	v.logger.Trace("Store snapshot", "Snapshots", v.snapshots)
 
 	return nil
@@ -253,7 +270,9 @@ func (v *validatorsSnapshotCache) cleanup() error {
 }
 
 // getLastCachedSnapshot gets the latest snapshot cached
-// If it doesn't have snapshot cached for desired epoch, it will return the latest one it has
+// If it doesn't have snapshot cached for desired epoch, it will return the latest one it has before the desired epoch.
+// Besides the memory cache and the db, the archived snapshots are looked up, so the snapshot of the distant epoch
+// is derived from the nearest archived one, instead of applying the deltas of all the epochs since the genesis
 func (v *validatorsSnapshotCache) getLastCachedSnapshot(currentEpoch uint64) (*validatorSnapshot, error) {
 	cachedSnapshot := v.snapshots[currentEpoch]
 	if cachedSnapshot != nil {
@@ -261,31 +280,33 @@ func (v *validatorsSnapshotCache) getLastCachedSnapshot(currentEpoch uint64) (*v
 	}
 
 	// if we do not have a snapshot in memory for given epoch, we will get the latest one we have
-	for ; currentEpoch >= 0; currentEpoch-- {
-		cachedSnapshot = v.snapshots[currentEpoch]
-		if cachedSnapshot != nil {
-			v.logger.Trace("Found snapshot in memory cache", "Epoch", currentEpoch)
-
-			break
+	for epoch, snapshot := range v.snapshots {
+		if epoch < currentEpoch && (cachedSnapshot == nil || epoch > cachedSnapshot.Epoch) {
+			cachedSnapshot = snapshot
 		}
+	}
 
-		if currentEpoch == 0 { // prevent uint64 underflow
-			break
-		}
+	if cachedSnapshot != nil {
+		v.logger.Trace("Found snapshot in memory cache", "Epoch", cachedSnapshot.Epoch)
+	}
+
+	dbSnapshot, err := v.state.EpochStore.getNearestSnapshot(currentEpoch)
+	if err != nil {
+		return nil, err
 	}
 
-	dbSnapshot, err := v.state.EpochStore.getLastSnapshot()
+	archivedSnapshot, err := v.state.EpochSnapshotStore.getNearestEpochSnapshot(currentEpoch)
 	if err != nil {
 		return nil, err
 	}
 
-	if dbSnapshot != nil {
-		// if we do not have any snapshot in memory, or db snapshot is newer than the one in memory
-		// return the one from db
-		if cachedSnapshot == nil || dbSnapshot.Epoch > cachedSnapshot.Epoch {
-			cachedSnapshot = dbSnapshot
+	for _, storedSnapshot := range []*validatorSnapshot{dbSnapshot, archivedSnapshot} {
+		// if we do not have any snapshot in memory, or stored snapshot is newer than the one in memory
+		// return the stored one
+		if storedSnapshot != nil && (cachedSnapshot == nil || storedSnapshot.Epoch > cachedSnapshot.Epoch) {
+			cachedSnapshot = storedSnapshot
 			// save it in cache as well, since it doesn't exist
-			v.snapshots[dbSnapshot.Epoch] = dbSnapshot.copy()
+			v.snapshots[storedSnapshot.Epoch] = storedSnapshot.copy()
 		}
 	}
 
@@ -295,6 +316,11 @@ func (v *validatorsSnapshotCache) getLastCachedSnapshot(currentEpoch uint64) (*v
 // getNextEpochEndingBlock gets the epoch ending block of a newer epoch
 // It start checking the blocks from the provided epoch ending block of the previous epoch
 func (v *validatorsSnapshotCache) getNextEpochEndingBlock(latestEpochEndingBlock uint64) (uint64, error) {
+	if v.epochSize > 0 {
+		// epochs have the fixed size, so the next epoch ending block is known upfront
+		return latestEpochEndingBlock + v.epochSize, nil
+	}
+
 	blockNumber := latestEpochEndingBlock + 1 // get next block
 
 	_, extra, err := getBlockData(blockNumber, v.blockchain)
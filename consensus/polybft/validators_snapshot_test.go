@@ -62,7 +62,7 @@ func TestValidatorsSnapshotCache_GetSnapshot_Build(t *testing.T) {
 	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
 
 	testValidatorsCache := &testValidatorsCache{
-		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock),
+		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock, 0),
 	}
 
 	for _, c := range cases {
@@ -112,7 +112,7 @@ func TestValidatorsSnapshotCache_GetSnapshot_FetchFromCache(t *testing.T) {
 	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
 
 	testValidatorsCache := &testValidatorsCache{
-		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock),
+		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock, 0),
 	}
 
 	require.NoError(testValidatorsCache.storeSnapshot(&validatorSnapshot{1, 10, epochOneValidators}))
@@ -142,7 +142,7 @@ func TestValidatorsSnapshotCache_Cleanup(t *testing.T) {
 
 	blockchainMock := new(blockchainMock)
 	cache := &testValidatorsCache{
-		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock),
+		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock, 0),
 	}
 	snapshot := validator.NewTestValidators(t, 3).GetPublicIdentities()
 	maxEpoch := uint64(0)
@@ -201,7 +201,7 @@ func TestValidatorsSnapshotCache_ComputeSnapshot_UnknownBlock(t *testing.T) {
 	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
 
 	testValidatorsCache := &testValidatorsCache{
-		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock),
+		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock, 0),
 	}
 
 	snapshot, err := testValidatorsCache.computeSnapshot(nil, 5*epochSize, nil)
@@ -229,7 +229,7 @@ func TestValidatorsSnapshotCache_ComputeSnapshot_IncorrectExtra(t *testing.T) {
 	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
 
 	testValidatorsCache := &testValidatorsCache{
-		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock),
+		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock, 0),
 	}
 
 	snapshot, err := testValidatorsCache.computeSnapshot(nil, 1*epochSize, nil)
@@ -256,7 +256,7 @@ func TestValidatorsSnapshotCache_ComputeSnapshot_ApplyDeltaFail(t *testing.T) {
 	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
 
 	testValidatorsCache := &testValidatorsCache{
-		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock),
+		validatorsSnapshotCache: newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), blockchainMock, 0),
 	}
 
 	snapshot, err := testValidatorsCache.computeSnapshot(&validatorSnapshot{0, 0, allValidators}, 1*epochSize, nil)
@@ -296,3 +296,80 @@ func (c *testValidatorsCache) cleanValidatorsCache() error {
 
 	return c.state.EpochStore.removeAllValidatorSnapshots()
 }
+
+func TestValidatorsSnapshotCache_GetSnapshot_FromArchive(t *testing.T) {
+	t.Parallel()
+
+	const (
+		epochSize = uint64(2)
+		epochs    = uint64(2*epochSnapshotInterval + 5)
+	)
+
+	validators := validator.NewTestValidators(t, 6).GetPublicIdentities()
+	// the validator set of the epoch e is the set of the epoch ending block of the epoch e-1
+	epochValidators := func(epoch uint64) validator.AccountSet {
+		if epoch%2 == 0 {
+			return validators[:3]
+		}
+
+		return validators[3:]
+	}
+
+	headersMap := &testHeadersMap{headersByNumber: make(map[uint64]*types.Header)}
+
+	var previous validator.AccountSet
+
+	for epoch := uint64(0); epoch <= epochs; epoch++ {
+		current := epochValidators(epoch)
+		createHeaders(t, headersMap, epoch*epochSize, (epoch+1)*epochSize-1, epoch+1, previous, current)
+		previous = current
+	}
+
+	chainMock := new(blockchainMock)
+	chainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+
+	cache := &testValidatorsCache{
+		validatorsSnapshotCache: newValidatorsSnapshotCache(
+			hclog.NewNullLogger(), newTestState(t), chainMock, epochSize),
+	}
+
+	// block in the middle of the epoch gets the snapshot of the previous epoch
+	snapshot, err := cache.GetSnapshot(epochs*epochSize+1, nil)
+	require.NoError(t, err)
+	require.Equal(t, epochValidators(epochs), snapshot)
+
+	archived, err := cache.state.EpochSnapshotStore.epochSnapshotsDBStats()
+	require.NoError(t, err)
+	require.Equal(t, 3, archived.KeyN)
+
+	// the snapshot of the epoch newer than the requested one must not be used
+	snapshot, err = cache.GetSnapshot(epochSize, nil)
+	require.NoError(t, err)
+	require.Equal(t, epochValidators(1), snapshot)
+
+	// only the archived snapshots are left
+	require.NoError(t, cache.cleanValidatorsCache())
+
+	deepMock := new(blockchainMock)
+	deepMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+	cache.blockchain = deepMock
+
+	const deepEpoch = epochSnapshotInterval + 5
+
+	snapshot, err = cache.GetSnapshot(deepEpoch*epochSize+1, nil)
+	require.NoError(t, err)
+	require.Equal(t, epochValidators(deepEpoch), snapshot)
+
+	// the snapshot is derived from the nearest archived snapshot, without reading the blocks before it
+	for _, call := range deepMock.Calls {
+		require.GreaterOrEqual(t, call.Arguments.Get(0), epochSnapshotInterval*epochSize)
+	}
+
+	// the header which is not an epoch ending block is rejected
+	require.NoError(t, cache.cleanValidatorsCache())
+
+	cache.epochSize = epochSize + 1
+
+	_, err = cache.GetSnapshot(deepEpoch*epochSize+1, nil)
+	require.ErrorContains(t, err, "is not an epoch ending block")
+}
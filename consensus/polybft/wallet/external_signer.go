@@ -0,0 +1,342 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// externalSignerService is the name of the service served by the external signer
+	externalSignerService = "Signer"
+
+	// externalSignerDialTimeout is the timeout of dialing the external signer
+	externalSignerDialTimeout = 5 * time.Second
+)
+
+var (
+	// ErrSignRequestRejected is returned by the external signer if the request isn't allowed by its rules
+	ErrSignRequestRejected = errors.New("sign request rejected by the external signer rules")
+
+	errInvalidHashLength = errors.New("hash must be 32 bytes long")
+)
+
+// SignECDSARequest is the request of the ECDSA signature sent to the external signer
+type SignECDSARequest struct {
+	Kind SignKind `json:"kind"`
+	Hash []byte   `json:"hash"`
+}
+
+// SignBLSRequest is the request of the BLS signature sent to the external signer
+type SignBLSRequest struct {
+	Digest []byte `json:"digest"`
+	Domain []byte `json:"domain"`
+}
+
+// AddressRequest is the request of the address of the keys held by the external signer
+type AddressRequest struct{}
+
+// ExternalSigner is the signer which delegates the signing to the external signer process
+// (e.g. the isolated signer process or the HSM bridge), served by ExternalSignerService.
+// The connection is redialed if it is lost, so the external signer can be restarted
+type ExternalSigner struct {
+	network string
+	address string
+	logger  hclog.Logger
+
+	lock   sync.Mutex
+	client *rpc.Client
+
+	validator types.Address
+}
+
+var _ Signer = (*ExternalSigner)(nil)
+
+// NewExternalSigner connects to the external signer at the endpoint, which is either the path
+// of the unix socket (optionally prefixed by unix://) or the tcp://host:port address
+func NewExternalSigner(endpoint string, logger hclog.Logger) (*ExternalSigner, error) {
+	network, address := parseExternalSignerEndpoint(endpoint)
+
+	s := &ExternalSigner{
+		network: network,
+		address: address,
+		logger:  logger.Named("external_signer"),
+	}
+
+	if err := s.call("Address", &AddressRequest{}, &s.validator); err != nil {
+		return nil, fmt.Errorf("failed to get the address from the external signer %s: %w", endpoint, err)
+	}
+
+	s.logger.Info("connected to the external signer", "endpoint", endpoint, "address", s.validator)
+
+	return s, nil
+}
+
+// Address returns the address of the validator ECDSA key held by the external signer
+func (s *ExternalSigner) Address() types.Address {
+	return s.validator
+}
+
+// SignECDSA requests the ECDSA signature of the hash from the external signer
+func (s *ExternalSigner) SignECDSA(kind SignKind, hash []byte) ([]byte, error) {
+	var signature []byte
+
+	if err := s.call("SignECDSA", &SignECDSARequest{Kind: kind, Hash: hash}, &signature); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// SignBLS requests the BLS signature of the digest and the domain from the external signer
+func (s *ExternalSigner) SignBLS(digest, domain []byte) ([]byte, error) {
+	var signature []byte
+
+	if err := s.call("SignBLS", &SignBLSRequest{Digest: digest, Domain: domain}, &signature); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+// Close closes the connection to the external signer
+func (s *ExternalSigner) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+
+	err := s.client.Close()
+	s.client = nil
+
+	return err
+}
+
+// call calls the method of the external signer, the request is retried once over the new connection
+// if the connection is lost
+func (s *ExternalSigner) call(method string, req, reply interface{}) error {
+	for attempt := 0; ; attempt++ {
+		client, err := s.connect()
+		if err != nil {
+			return err
+		}
+
+		err = client.Call(externalSignerService+"."+method, req, reply)
+		if err == nil {
+			return nil
+		}
+
+		// the errors returned by the external signer are final
+		var serverErr rpc.ServerError
+		if errors.As(err, &serverErr) {
+			if strings.Contains(err.Error(), ErrSignRequestRejected.Error()) {
+				return fmt.Errorf("%w: %s", ErrSignRequestRejected, method)
+			}
+
+			return err
+		}
+
+		s.disconnect(client)
+
+		if attempt > 0 {
+			return fmt.Errorf("external signer unavailable: %w", err)
+		}
+
+		s.logger.Warn("connection to the external signer lost, redialing", "err", err)
+	}
+}
+
+func (s *ExternalSigner) connect() (*rpc.Client, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.client != nil {
+		return s.client, nil
+	}
+
+	conn, err := net.DialTimeout(s.network, s.address, externalSignerDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial the external signer: %w", err)
+	}
+
+	s.client = jsonrpc.NewClient(conn)
+
+	return s.client, nil
+}
+
+func (s *ExternalSigner) disconnect(client *rpc.Client) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// the client could have been redialed by the concurrent request already
+	if s.client == client {
+		_ = s.client.Close()
+		s.client = nil
+	}
+}
+
+// ExternalSignerRules are the policy rules the external signer approves the sign requests by
+type ExternalSignerRules struct {
+	// Kinds are the allowed kinds of the ECDSA signatures, any kind is allowed if empty
+	Kinds []SignKind `json:"kinds"`
+
+	// BLSDomains are the hex encoded domains of the allowed BLS signatures, any domain is allowed if empty.
+	// The BLS signatures are rejected altogether if the bls kind isn't allowed
+	BLSDomains []string `json:"bls_domains"`
+}
+
+// allowsKind checks whether the rules allow the signature of the kind
+func (r *ExternalSignerRules) allowsKind(kind SignKind) bool {
+	if len(r.Kinds) == 0 {
+		return true
+	}
+
+	for _, allowed := range r.Kinds {
+		if allowed == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsDomain checks whether the rules allow the BLS signature of the domain
+func (r *ExternalSignerRules) allowsDomain(domain []byte) bool {
+	if !r.allowsKind(SignKindBLS) {
+		return false
+	}
+
+	if len(r.BLSDomains) == 0 {
+		return true
+	}
+
+	for _, allowed := range r.BLSDomains {
+		if strings.EqualFold(strings.TrimPrefix(allowed, "0x"), hex.EncodeToString(domain)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ExternalSignerService is the service of the external signer process, which approves the sign requests
+// of the node by the rules and signs them with the validator keys it holds
+type ExternalSignerService struct {
+	signer Signer
+	rules  *ExternalSignerRules
+	logger hclog.Logger
+}
+
+// NewExternalSignerService creates the external signer service signing with the signer (e.g. the account
+// read from the secrets manager) the requests allowed by the rules
+func NewExternalSignerService(signer Signer, rules *ExternalSignerRules, logger hclog.Logger) *ExternalSignerService {
+	if rules == nil {
+		rules = &ExternalSignerRules{}
+	}
+
+	return &ExternalSignerService{
+		signer: signer,
+		rules:  rules,
+		logger: logger,
+	}
+}
+
+// Address returns the address of the validator ECDSA key
+func (s *ExternalSignerService) Address(_ *AddressRequest, reply *types.Address) error {
+	*reply = s.signer.Address()
+
+	return nil
+}
+
+// SignECDSA signs the hash with the validator ECDSA key if the kind is allowed
+func (s *ExternalSignerService) SignECDSA(req *SignECDSARequest, reply *[]byte) error {
+	if len(req.Hash) != types.HashLength {
+		return errInvalidHashLength
+	}
+
+	if !s.rules.allowsKind(req.Kind) {
+		s.logger.Warn("ECDSA sign request rejected", "kind", req.Kind)
+
+		return ErrSignRequestRejected
+	}
+
+	signature, err := s.signer.SignECDSA(req.Kind, req.Hash)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Debug("ECDSA sign request approved", "kind", req.Kind, "hash", types.BytesToHash(req.Hash))
+
+	*reply = signature
+
+	return nil
+}
+
+// SignBLS signs the digest with the validator BLS key if the domain is allowed
+func (s *ExternalSignerService) SignBLS(req *SignBLSRequest, reply *[]byte) error {
+	if !s.rules.allowsDomain(req.Domain) {
+		s.logger.Warn("BLS sign request rejected", "domain", hex.EncodeToString(req.Domain))
+
+		return ErrSignRequestRejected
+	}
+
+	signature, err := s.signer.SignBLS(req.Digest, req.Domain)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Debug("BLS sign request approved", "domain", hex.EncodeToString(req.Domain))
+
+	*reply = signature
+
+	return nil
+}
+
+// Serve serves the sign requests of the connections accepted by the listener, until the listener is closed
+func (s *ExternalSignerService) Serve(listener net.Listener) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(externalSignerService, s); err != nil {
+		return err
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		s.logger.Info("node connected", "remote", conn.RemoteAddr())
+
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
+
+// ListenExternalSigner listens on the external signer endpoint
+// (the unix socket path, unix://path or tcp://host:port)
+func ListenExternalSigner(endpoint string) (net.Listener, error) {
+	return net.Listen(parseExternalSignerEndpoint(endpoint))
+}
+
+// parseExternalSignerEndpoint returns the network and the address of the external signer endpoint
+func parseExternalSignerEndpoint(endpoint string) (string, string) {
+	if address, ok := strings.CutPrefix(endpoint, "tcp://"); ok {
+		return "tcp", address
+	}
+
+	return "unix", strings.TrimPrefix(endpoint, "unix://")
+}
@@ -0,0 +1,110 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startTestExternalSigner(t *testing.T, account *Account, rules *ExternalSignerRules) string {
+	t.Helper()
+
+	listener, err := ListenExternalSigner("tcp://127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		_ = NewExternalSignerService(account, rules, hclog.NewNullLogger()).Serve(listener)
+	}()
+
+	return "tcp://" + listener.Addr().String()
+}
+
+func TestExternalSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	account := generateTestAccount(t)
+
+	signer, err := NewExternalSigner(startTestExternalSigner(t, account, nil), hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = signer.Close() })
+
+	key := NewKey(signer)
+	assert.Equal(t, account.Address().Bytes(), key.Address().Bytes())
+
+	// the IBFT messages are signed by the validator ECDSA key
+	msg, err := key.SignIBFTMessage(&proto.Message{
+		From:    key.Address().Bytes(),
+		Type:    proto.MessageType_COMMIT,
+		Payload: &proto.Message_CommitData{},
+	})
+	require.NoError(t, err)
+
+	payload, err := msg.PayloadNoSig()
+	require.NoError(t, err)
+
+	address, err := RecoverAddressFromSignature(msg.Signature, payload)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address(), address)
+
+	// the BLS signatures are signed by the validator BLS key
+	ser, err := key.SignWithDomain([]byte("message"), bls.DomainCheckpointManager)
+	require.NoError(t, err)
+
+	sig, err := bls.UnmarshalSignature(ser)
+	require.NoError(t, err)
+	assert.True(t, sig.Verify(account.Bls.PublicKey(), []byte("message"), bls.DomainCheckpointManager))
+
+	// the hashes are validated by the external signer
+	_, err = signer.SignECDSA(SignKindTransaction, []byte("short"))
+	require.ErrorContains(t, err, errInvalidHashLength.Error())
+}
+
+func TestExternalSigner_Rules(t *testing.T) {
+	t.Parallel()
+
+	account := generateTestAccount(t)
+	endpoint := startTestExternalSigner(t, account, &ExternalSignerRules{
+		Kinds:      []SignKind{SignKindIBFTMessage, SignKindBLS},
+		BLSDomains: []string{"0x" + hex.EncodeToString(bls.DomainCheckpointManager)},
+	})
+
+	signer, err := NewExternalSigner(endpoint, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = signer.Close() })
+
+	hash := make([]byte, 32)
+
+	_, err = signer.SignECDSA(SignKindIBFTMessage, hash)
+	require.NoError(t, err)
+
+	_, err = signer.SignECDSA(SignKindTransaction, hash)
+	require.ErrorIs(t, err, ErrSignRequestRejected)
+
+	_, err = signer.SignBLS(hash, bls.DomainCheckpointManager)
+	require.NoError(t, err)
+
+	_, err = signer.SignBLS(hash, bls.DomainStateReceiver)
+	require.ErrorIs(t, err, ErrSignRequestRejected)
+}
+
+func TestExternalSigner_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	listener, err := ListenExternalSigner("tcp://127.0.0.1:0")
+	require.NoError(t, err)
+
+	endpoint := "tcp://" + listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	_, err = NewExternalSigner(endpoint, hclog.NewNullLogger())
+	require.Error(t, err)
+}
@@ -11,24 +11,26 @@ import (
 	protobuf "google.golang.org/protobuf/proto"
 )
 
+// Key signs with the validator keys of the signer, which is either the account held by the node
+// or the external signer
 type Key struct {
-	raw *Account
+	signer Signer
 }
 
-func NewKey(raw *Account) *Key {
+func NewKey(signer Signer) *Key {
 	return &Key{
-		raw: raw,
+		signer: signer,
 	}
 }
 
 // String returns hex encoded ECDSA address
 func (k *Key) String() string {
-	return k.raw.Ecdsa.Address().String()
+	return k.Address().String()
 }
 
 // Address returns ECDSA address
 func (k *Key) Address() ethgo.Address {
-	return k.raw.Ecdsa.Address()
+	return ethgo.Address(k.signer.Address())
 }
 
 // Sign signs the provided digest with BLS key
@@ -39,12 +41,7 @@ func (k *Key) Sign(digest []byte) ([]byte, error) {
 
 // SignWithDomain signs the provided digest with BLS key and provided domain
 func (k *Key) SignWithDomain(digest, domain []byte) ([]byte, error) {
-	signature, err := k.raw.Bls.Sign(digest, domain)
-	if err != nil {
-		return nil, err
-	}
-
-	return signature.Marshal()
+	return k.signer.SignBLS(digest, domain)
 }
 
 // SignIBFTMessage signs the IBFT consensus message with ECDSA key
@@ -54,7 +51,14 @@ func (k *Key) SignIBFTMessage(msg *proto.Message) (*proto.Message, error) {
 		return nil, fmt.Errorf("cannot marshal message: %w", err)
 	}
 
-	if msg.Signature, err = k.raw.Ecdsa.Sign(crypto.Keccak256(msgRaw)); err != nil {
+	// the signer hashing the data itself is given the raw message
+	if dataSigner, ok := k.signer.(DataSigner); ok {
+		msg.Signature, err = dataSigner.SignECDSAData(SignKindIBFTMessage, msgRaw)
+	} else {
+		msg.Signature, err = k.signer.SignECDSA(SignKindIBFTMessage, crypto.Keccak256(msgRaw))
+	}
+
+	if err != nil {
 		return nil, fmt.Errorf("cannot create message signature: %w", err)
 	}
 
@@ -82,5 +86,5 @@ func NewEcdsaSigner(ecdsaKey *Key) *ECDSASigner {
 }
 
 func (k *ECDSASigner) Sign(b []byte) ([]byte, error) {
-	return k.raw.Ecdsa.Sign(b)
+	return k.signer.SignECDSA(SignKindTransaction, b)
 }
@@ -46,7 +46,7 @@ func Test_Sign(t *testing.T) {
 		sig, err := bls.UnmarshalSignature(ser)
 		require.NoError(t, err)
 
-		assert.True(t, sig.Verify(key.raw.Bls.PublicKey(), msg, bls.DomainCheckpointManager))
+		assert.True(t, sig.Verify(key.signer.(*Account).Bls.PublicKey(), msg, bls.DomainCheckpointManager))
 	}
 }
 
@@ -0,0 +1,121 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// SignKind is the kind of the signing request, which the external signer approves by its policy rules
+type SignKind string
+
+const (
+	// SignKindIBFTMessage is the ECDSA signature of the IBFT consensus message
+	SignKindIBFTMessage SignKind = "ibft_message"
+	// SignKindTransaction is the ECDSA signature of the transaction sent by the validator
+	SignKindTransaction SignKind = "transaction"
+	// SignKindBLS is the BLS signature (e.g. the commit seal or the checkpoint signature) of the given domain
+	SignKindBLS SignKind = "bls"
+)
+
+// errSignersConflict is returned if both the external signer and Web3Signer are configured
+var errSignersConflict = errors.New("external signer and Web3Signer are mutually exclusive")
+
+// Signer signs with the validator keys, which are either held by the node (Account),
+// by the external signer process (ExternalSigner) or by Web3Signer (Web3Signer)
+type Signer interface {
+	// Address returns the address of the validator ECDSA key
+	Address() types.Address
+
+	// SignECDSA signs the hash with the validator ECDSA key
+	SignECDSA(kind SignKind, hash []byte) ([]byte, error)
+
+	// SignBLS signs the digest with the validator BLS key and the domain, returning the marshaled signature
+	SignBLS(digest, domain []byte) ([]byte, error)
+}
+
+// DataSigner is the Signer which hashes the signed data by keccak256 itself (e.g. Web3Signer),
+// so it is given the data instead of its hash
+type DataSigner interface {
+	Signer
+
+	// SignECDSAData signs the keccak256 hash of the data with the validator ECDSA key
+	SignECDSAData(kind SignKind, data []byte) ([]byte, error)
+}
+
+var _ Signer = (*Account)(nil)
+
+// SignerConfig is the configuration of the validator signer
+type SignerConfig struct {
+	// ExternalSigner is the endpoint of the external signer all the validator signing is delegated to
+	ExternalSigner string
+
+	// Web3SignerURL is the URL of Web3Signer the ECDSA signing is delegated to
+	Web3SignerURL string
+
+	// Web3SignerPublicKey is the public key identifying the validator ECDSA key held by Web3Signer
+	Web3SignerPublicKey string
+}
+
+// IsRemote returns true if the validator signing is delegated to either the external signer or Web3Signer
+func (c SignerConfig) IsRemote() bool {
+	return c.ExternalSigner != "" || c.Web3SignerURL != ""
+}
+
+// Validate validates the signer configuration
+func (c SignerConfig) Validate() error {
+	if c.ExternalSigner != "" && c.Web3SignerURL != "" {
+		return errSignersConflict
+	}
+
+	if c.Web3SignerURL == "" {
+		if c.Web3SignerPublicKey != "" {
+			return errWeb3SignerURLMissing
+		}
+
+		return nil
+	}
+
+	if _, err := parseWeb3SignerURL(c.Web3SignerURL); err != nil {
+		return err
+	}
+
+	_, err := parseWeb3SignerPublicKey(c.Web3SignerPublicKey)
+
+	return err
+}
+
+// NewSigner connects to the external signer or to Web3Signer if configured,
+// otherwise it reads the account from the secrets manager
+func NewSigner(config SignerConfig, secretsManager secrets.SecretsManager, logger hclog.Logger) (Signer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.ExternalSigner != "" {
+		return NewExternalSigner(config.ExternalSigner, logger)
+	}
+
+	if config.Web3SignerURL != "" {
+		return NewWeb3Signer(config.Web3SignerURL, config.Web3SignerPublicKey, secretsManager, logger)
+	}
+
+	return NewAccountFromSecret(secretsManager)
+}
+
+// SignECDSA signs the hash with the ECDSA key of the account
+func (a *Account) SignECDSA(_ SignKind, hash []byte) ([]byte, error) {
+	return a.Ecdsa.Sign(hash)
+}
+
+// SignBLS signs the digest with the BLS key of the account and the domain
+func (a *Account) SignBLS(digest, domain []byte) ([]byte, error) {
+	signature, err := a.Bls.Sign(digest, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature.Marshal()
+}
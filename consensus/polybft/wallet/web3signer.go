@@ -0,0 +1,250 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// web3SignerPublicKeysPath is the Web3Signer API path listing the public keys of the loaded secp256k1 keys
+	web3SignerPublicKeysPath = "/api/v1/eth1/publicKeys"
+
+	// web3SignerSignPath is the Web3Signer API path signing the data by the key of the public key
+	web3SignerSignPath = "/api/v1/eth1/sign/"
+
+	// web3SignerTimeout is the timeout of the Web3Signer API requests
+	web3SignerTimeout = 10 * time.Second
+
+	// web3SignerMaxResponseSize is the limit of the Web3Signer API response size
+	web3SignerMaxResponseSize = 1 << 20
+)
+
+var (
+	// ErrWeb3SignerKeyNotFound is returned if the key of the public key isn't loaded by Web3Signer
+	ErrWeb3SignerKeyNotFound = errors.New("key of the public key is not loaded by Web3Signer")
+
+	// ErrWeb3SignerTransactionSigning is returned on signing the transaction by Web3Signer,
+	// which signs the data it hashes itself, while the transaction is given by its hash only
+	ErrWeb3SignerTransactionSigning = errors.New("transactions can't be signed by Web3Signer")
+
+	errWeb3SignerURLMissing     = errors.New("public key of Web3Signer is set without its URL")
+	errWeb3SignerInvalidKey     = errors.New("public key of Web3Signer must be the hex encoded uncompressed secp256k1 key")
+	errWeb3SignerInvalidSigning = errors.New("signature returned by Web3Signer isn't signed by the key of the public key")
+)
+
+// Web3Signer is the signer which delegates the ECDSA signing to Web3Signer over its HTTP API,
+// the key is identified by its public key. Web3Signer hashes the signed data by keccak256 itself,
+// so it signs the IBFT messages given in raw, but not the transactions given by their hash only.
+// The BLS keys of Web3Signer are the BLS12-381 keys of the Ethereum consensus layer,
+// so the BLS signing is done by the BLS key read from the secrets manager
+type Web3Signer struct {
+	url       string
+	publicKey []byte
+	address   types.Address
+	bls       *bls.PrivateKey
+	client    *http.Client
+	logger    hclog.Logger
+}
+
+var _ DataSigner = (*Web3Signer)(nil)
+
+// NewWeb3Signer connects to Web3Signer at the URL and checks it holds the key of the public key
+func NewWeb3Signer(
+	rawURL, publicKey string,
+	secretsManager secrets.SecretsManager,
+	logger hclog.Logger,
+) (*Web3Signer, error) {
+	signerURL, err := parseWeb3SignerURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	rawPublicKey, err := parseWeb3SignerPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := crypto.ParsePublicKey(append([]byte{0x04}, rawPublicKey...))
+	if err != nil {
+		return nil, errWeb3SignerInvalidKey
+	}
+
+	blsKey, err := GetBlsFromSecret(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Web3Signer{
+		url:       signerURL,
+		publicKey: rawPublicKey,
+		address:   crypto.PubKeyToAddress(pub),
+		bls:       blsKey,
+		client:    &http.Client{Timeout: web3SignerTimeout},
+		logger:    logger.Named("web3signer"),
+	}
+
+	if err := s.checkPublicKey(); err != nil {
+		return nil, fmt.Errorf("failed to check the public key by Web3Signer %s: %w", rawURL, err)
+	}
+
+	s.logger.Info("connected to Web3Signer", "url", signerURL, "address", s.address)
+
+	return s, nil
+}
+
+// Address returns the address of the validator ECDSA key held by Web3Signer
+func (s *Web3Signer) Address() types.Address {
+	return s.address
+}
+
+// SignECDSA always fails, as Web3Signer signs only the data it hashes itself
+func (s *Web3Signer) SignECDSA(kind SignKind, _ []byte) ([]byte, error) {
+	if kind == SignKindTransaction {
+		return nil, ErrWeb3SignerTransactionSigning
+	}
+
+	return nil, fmt.Errorf("only the raw data can be signed by Web3Signer, not the %s hash", kind)
+}
+
+// SignECDSAData requests the ECDSA signature of the keccak256 hash of the data from Web3Signer
+func (s *Web3Signer) SignECDSAData(kind SignKind, data []byte) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{"data": "0x" + hex.EncodeToString(data)})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.request(http.MethodPost, web3SignerSignPath+s.identifier(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign %s by Web3Signer: %w", kind, err)
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(strings.Trim(string(response), "\" \n\r\t"), "0x"))
+	if err != nil || len(signature) != 65 {
+		return nil, fmt.Errorf("invalid Web3Signer signature of %s: %s", kind, response)
+	}
+
+	// Web3Signer returns the recovery ID as the Ethereum V (27 or 28)
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+
+	signer, err := RecoverAddressFromSignature(signature, data)
+	if err != nil || signer != s.address {
+		return nil, errWeb3SignerInvalidSigning
+	}
+
+	return signature, nil
+}
+
+// SignBLS signs the digest with the BLS key read from the secrets manager and the domain
+func (s *Web3Signer) SignBLS(digest, domain []byte) ([]byte, error) {
+	signature, err := s.bls.Sign(digest, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature.Marshal()
+}
+
+// checkPublicKey checks that the key of the public key is loaded by Web3Signer
+func (s *Web3Signer) checkPublicKey() error {
+	response, err := s.request(http.MethodGet, web3SignerPublicKeysPath, nil)
+	if err != nil {
+		return err
+	}
+
+	var publicKeys []string
+	if err := json.Unmarshal(response, &publicKeys); err != nil {
+		return fmt.Errorf("invalid public keys response: %w", err)
+	}
+
+	for _, publicKey := range publicKeys {
+		if raw, err := parseWeb3SignerPublicKey(publicKey); err == nil && bytes.Equal(raw, s.publicKey) {
+			return nil
+		}
+	}
+
+	return ErrWeb3SignerKeyNotFound
+}
+
+// identifier returns the identifier of the key in the Web3Signer API, which is its hex encoded public key
+func (s *Web3Signer) identifier() string {
+	return "0x" + hex.EncodeToString(s.publicKey)
+}
+
+// request sends the request to the Web3Signer API and returns the response body
+func (s *Web3Signer) request(method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, s.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	response, err := io.ReadAll(io.LimitReader(resp.Body, web3SignerMaxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected Web3Signer response %s: %s", resp.Status, strings.TrimSpace(string(response)))
+	}
+
+	return response, nil
+}
+
+// parseWeb3SignerURL parses the Web3Signer URL, which is the http(s) base URL of its API
+func parseWeb3SignerURL(rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid Web3Signer URL: %w", err)
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", fmt.Errorf("invalid Web3Signer URL %s, http(s)://host:port expected", rawURL)
+	}
+
+	return strings.TrimSuffix(parsed.String(), "/"), nil
+}
+
+// parseWeb3SignerPublicKey parses the hex encoded uncompressed secp256k1 public key,
+// either with or without the 0x04 prefix, returning it without the prefix
+func parseWeb3SignerPublicKey(publicKey string) ([]byte, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(publicKey, "0x"))
+	if err != nil {
+		return nil, errWeb3SignerInvalidKey
+	}
+
+	if len(raw) == 65 && raw[0] == 0x04 {
+		raw = raw[1:]
+	}
+
+	if len(raw) != 64 {
+		return nil, errWeb3SignerInvalidKey
+	}
+
+	return raw, nil
+}
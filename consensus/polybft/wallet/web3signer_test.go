@@ -0,0 +1,174 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startTestWeb3Signer starts the server emulating the eth1 signing API of Web3Signer holding the account key
+func startTestWeb3Signer(t *testing.T, account *Account) string {
+	t.Helper()
+
+	privateKey, err := account.GetEcdsaPrivateKey()
+	require.NoError(t, err)
+
+	publicKey := "0x" + hex.EncodeToString(crypto.MarshalPublicKey(&privateKey.PublicKey)[1:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == web3SignerPublicKeysPath:
+			_ = json.NewEncoder(w).Encode([]string{publicKey})
+		case r.Method == http.MethodPost && r.URL.Path == web3SignerSignPath+publicKey:
+			var body struct {
+				Data string `json:"data"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			data, err := hex.DecodeString(strings.TrimPrefix(body.Data, "0x"))
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			signature, err := crypto.Sign(privateKey, crypto.Keccak256(data))
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+
+				return
+			}
+
+			signature[64] += 27
+
+			_, _ = fmt.Fprintf(w, "0x%x", signature)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestWeb3Signer_Sign(t *testing.T) {
+	t.Parallel()
+
+	account := generateTestAccount(t)
+	secretsManager := newSecretsManagerMock()
+	require.NoError(t, account.Save(secretsManager))
+
+	privateKey, err := account.GetEcdsaPrivateKey()
+	require.NoError(t, err)
+
+	config := SignerConfig{
+		Web3SignerURL:       startTestWeb3Signer(t, account),
+		Web3SignerPublicKey: hex.EncodeToString(crypto.MarshalPublicKey(&privateKey.PublicKey)),
+	}
+
+	signer, err := NewSigner(config, secretsManager, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	key := NewKey(signer)
+	assert.Equal(t, account.Address().Bytes(), key.Address().Bytes())
+
+	// the IBFT messages are signed by Web3Signer
+	msg, err := key.SignIBFTMessage(&proto.Message{
+		From:    key.Address().Bytes(),
+		Type:    proto.MessageType_COMMIT,
+		Payload: &proto.Message_CommitData{},
+	})
+	require.NoError(t, err)
+
+	payload, err := msg.PayloadNoSig()
+	require.NoError(t, err)
+
+	address, err := RecoverAddressFromSignature(msg.Signature, payload)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address(), address)
+
+	// the BLS signatures are signed by the BLS key of the secrets manager
+	ser, err := key.SignWithDomain([]byte("message"), bls.DomainCheckpointManager)
+	require.NoError(t, err)
+
+	sig, err := bls.UnmarshalSignature(ser)
+	require.NoError(t, err)
+	assert.True(t, sig.Verify(account.Bls.PublicKey(), []byte("message"), bls.DomainCheckpointManager))
+
+	// the transactions can't be signed by Web3Signer
+	_, err = NewEcdsaSigner(key).Sign(crypto.Keccak256([]byte("transaction")))
+	require.ErrorIs(t, err, ErrWeb3SignerTransactionSigning)
+}
+
+func TestWeb3Signer_UnknownKey(t *testing.T) {
+	t.Parallel()
+
+	account := generateTestAccount(t)
+	secretsManager := newSecretsManagerMock()
+	require.NoError(t, account.Save(secretsManager))
+
+	other, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	_, err = NewWeb3Signer(
+		startTestWeb3Signer(t, account),
+		hex.EncodeToString(crypto.MarshalPublicKey(&other.PublicKey)),
+		secretsManager,
+		hclog.NewNullLogger(),
+	)
+	require.ErrorIs(t, err, ErrWeb3SignerKeyNotFound)
+}
+
+func TestSignerConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	publicKey := "0x" + strings.Repeat("ab", 64)
+
+	testTable := []struct {
+		name   string
+		config SignerConfig
+		valid  bool
+	}{
+		{"Local keys", SignerConfig{}, true},
+		{"External signer", SignerConfig{ExternalSigner: "/tmp/signer.sock"}, true},
+		{"Web3Signer", SignerConfig{Web3SignerURL: "http://localhost:9000", Web3SignerPublicKey: publicKey}, true},
+		{
+			"External signer and Web3Signer",
+			SignerConfig{
+				ExternalSigner:      "/tmp/signer.sock",
+				Web3SignerURL:       "http://localhost:9000",
+				Web3SignerPublicKey: publicKey,
+			},
+			false,
+		},
+		{"Web3Signer without the public key", SignerConfig{Web3SignerURL: "http://localhost:9000"}, false},
+		{"Web3Signer public key without the URL", SignerConfig{Web3SignerPublicKey: publicKey}, false},
+		{"Web3Signer invalid URL", SignerConfig{Web3SignerURL: "localhost:9000", Web3SignerPublicKey: publicKey}, false},
+		{
+			"Web3Signer compressed public key",
+			SignerConfig{Web3SignerURL: "http://localhost:9000", Web3SignerPublicKey: "0x02" + strings.Repeat("ab", 32)},
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		assert.Equal(t, testCase.valid, testCase.config.Validate() == nil, testCase.name)
+	}
+}
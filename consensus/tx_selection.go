@@ -0,0 +1,356 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// DefaultTxSelectionHookBudget is the time a single hook call is allowed to take,
+// if the hook doesn't specify its own budget
+const DefaultTxSelectionHookBudget = 50 * time.Millisecond
+
+var (
+	errEmptyHookName     = errors.New("tx selection hook name is empty")
+	errDuplicateHookName = errors.New("tx selection hook is already registered")
+	errEmptyHook         = errors.New("tx selection hook has neither inject nor order function")
+
+	txSelectionHooksLock sync.RWMutex
+	txSelectionHooks     []*TxSelectionHook
+)
+
+// TxSelectionPool is the part of the txpool used while filling the block with transactions
+type TxSelectionPool interface {
+	Prepare(baseFee uint64)
+	Peek() *types.Transaction
+	Pop(tx *types.Transaction)
+	Drop(tx *types.Transaction)
+	Demote(tx *types.Transaction)
+}
+
+// InjectTxsFunc returns the transactions which are written to the block
+// before any transaction from the txpool.
+// The injected transactions must be signed and valid for the block being built
+type InjectTxsFunc func(ctx context.Context, header *types.Header) []*types.Transaction
+
+// OrderTxsFunc returns the candidates in the order they should be written to the block.
+// Candidates left out of the result are vetoed and stay in the txpool for the next block.
+// The result must not contain transactions which are not among the candidates
+type OrderTxsFunc func(ctx context.Context, header *types.Header, candidates []*types.Transaction) []*types.Transaction
+
+// TxSelectionHook is a hook called by the block builder while selecting the block transactions
+type TxSelectionHook struct {
+	// Name identifies the hook in the logs
+	Name string
+
+	// Budget is the time a single call to the hook is allowed to take.
+	// The result of the call which exceeds it is ignored
+	Budget time.Duration
+
+	// InjectFunc is called once per block, after the txpool is prepared
+	InjectFunc InjectTxsFunc
+
+	// OrderFunc is called with the executable transactions from the txpool
+	OrderFunc OrderTxsFunc
+}
+
+// RegisterTxSelectionHook registers the hook which is used by all the block builders.
+// Hooks are called in the order of registration
+func RegisterTxSelectionHook(hook *TxSelectionHook) error {
+	if hook.Name == "" {
+		return errEmptyHookName
+	}
+
+	if hook.InjectFunc == nil && hook.OrderFunc == nil {
+		return fmt.Errorf("%w: %s", errEmptyHook, hook.Name)
+	}
+
+	txSelectionHooksLock.Lock()
+	defer txSelectionHooksLock.Unlock()
+
+	for _, h := range txSelectionHooks {
+		if h.Name == hook.Name {
+			return fmt.Errorf("%w: %s", errDuplicateHookName, hook.Name)
+		}
+	}
+
+	txSelectionHooks = append(txSelectionHooks, hook)
+
+	return nil
+}
+
+// RegisteredTxSelectionHooks returns the registered tx selection hooks
+func RegisteredTxSelectionHooks() []*TxSelectionHook {
+	txSelectionHooksLock.RLock()
+	defer txSelectionHooksLock.RUnlock()
+
+	hooks := make([]*TxSelectionHook, len(txSelectionHooks))
+	copy(hooks, txSelectionHooks)
+
+	return hooks
+}
+
+// NewPrioritySendersHook creates the hook which moves the transactions
+// of the given senders in front of the other transactions (whitelist-first lane)
+func NewPrioritySendersHook(name string, senders []types.Address) *TxSelectionHook {
+	priority := make(map[types.Address]struct{}, len(senders))
+	for _, sender := range senders {
+		priority[sender] = struct{}{}
+	}
+
+	return &TxSelectionHook{
+		Name: name,
+		OrderFunc: func(_ context.Context, _ *types.Header, candidates []*types.Transaction) []*types.Transaction {
+			ordered := make([]*types.Transaction, 0, len(candidates))
+			others := make([]*types.Transaction, 0, len(candidates))
+
+			for _, tx := range candidates {
+				if _, ok := priority[tx.From]; ok {
+					ordered = append(ordered, tx)
+				} else {
+					others = append(others, tx)
+				}
+			}
+
+			return append(ordered, others...)
+		},
+	}
+}
+
+// TxSelector wraps the txpool and applies the tx selection hooks
+// to the transactions returned to the block builder
+type TxSelector struct {
+	pool   TxSelectionPool
+	header *types.Header
+	hooks  []*TxSelectionHook
+	logger hclog.Logger
+
+	// injected are the transactions returned by the inject hooks, which are not yet peeked
+	injected []*types.Transaction
+	// injectedTxs are all the injected transactions, which are not part of the txpool
+	injectedTxs map[*types.Transaction]struct{}
+	// candidates are the ordered txpool transactions, which are not yet peeked
+	candidates []*types.Transaction
+	// ordering is true if at least one of the hooks orders the transactions
+	ordering bool
+}
+
+// NewTxSelector creates the TxSelector for the block with the given header,
+// using the currently registered tx selection hooks
+func NewTxSelector(pool TxSelectionPool, header *types.Header, logger hclog.Logger) *TxSelector {
+	hooks := RegisteredTxSelectionHooks()
+
+	s := &TxSelector{
+		pool:        pool,
+		header:      header,
+		hooks:       hooks,
+		logger:      logger.Named("tx_selector"),
+		injectedTxs: map[*types.Transaction]struct{}{},
+	}
+
+	for _, hook := range hooks {
+		if hook.OrderFunc != nil {
+			s.ordering = true
+		}
+	}
+
+	return s
+}
+
+// Prepare prepares the txpool for the block and collects the injected transactions
+func (s *TxSelector) Prepare(baseFee uint64) {
+	s.pool.Prepare(baseFee)
+
+	s.injected = nil
+	s.candidates = nil
+
+	for _, hook := range s.hooks {
+		if hook.InjectFunc == nil {
+			continue
+		}
+
+		txs, ok := s.callHook(hook, func(ctx context.Context) []*types.Transaction {
+			return hook.InjectFunc(ctx, s.header)
+		})
+		if !ok {
+			continue
+		}
+
+		for _, tx := range txs {
+			if tx == nil {
+				continue
+			}
+
+			if _, exists := s.injectedTxs[tx]; exists {
+				continue
+			}
+
+			s.injectedTxs[tx] = struct{}{}
+			s.injected = append(s.injected, tx)
+		}
+	}
+}
+
+// Peek returns the next transaction to be written to the block.
+// Injected transactions are returned first, followed by the ordered txpool transactions
+func (s *TxSelector) Peek() *types.Transaction {
+	if len(s.injected) > 0 {
+		tx := s.injected[0]
+		s.injected = s.injected[1:]
+
+		return tx
+	}
+
+	if !s.ordering {
+		return s.pool.Peek()
+	}
+
+	if len(s.candidates) == 0 {
+		s.candidates = s.orderCandidates()
+	}
+
+	if len(s.candidates) == 0 {
+		return nil
+	}
+
+	tx := s.candidates[0]
+	s.candidates = s.candidates[1:]
+
+	return tx
+}
+
+// Pop removes the written transaction from the txpool
+func (s *TxSelector) Pop(tx *types.Transaction) {
+	if !s.isInjected(tx) {
+		s.pool.Pop(tx)
+	}
+}
+
+// Drop removes the failed transaction from the txpool
+func (s *TxSelector) Drop(tx *types.Transaction) {
+	if !s.isInjected(tx) {
+		s.pool.Drop(tx)
+	}
+}
+
+// Demote demotes the transaction which failed with the recoverable error
+func (s *TxSelector) Demote(tx *types.Transaction) {
+	if !s.isInjected(tx) {
+		s.pool.Demote(tx)
+	}
+}
+
+func (s *TxSelector) isInjected(tx *types.Transaction) bool {
+	_, ok := s.injectedTxs[tx]
+
+	return ok
+}
+
+// orderCandidates drains the executable transactions from the txpool and passes them through the order hooks.
+// Vetoed transactions are not returned to the txpool executables, so they are skipped for this block
+func (s *TxSelector) orderCandidates() []*types.Transaction {
+	var candidates []*types.Transaction
+
+	for tx := s.pool.Peek(); tx != nil; tx = s.pool.Peek() {
+		candidates = append(candidates, tx)
+	}
+
+	for _, hook := range s.hooks {
+		if hook.OrderFunc == nil || len(candidates) == 0 {
+			continue
+		}
+
+		input := make([]*types.Transaction, len(candidates))
+		copy(input, candidates)
+
+		ordered, ok := s.callHook(hook, func(ctx context.Context) []*types.Transaction {
+			return hook.OrderFunc(ctx, s.header, input)
+		})
+		if !ok {
+			continue
+		}
+
+		if err := validateOrderedTxs(candidates, ordered); err != nil {
+			s.logger.Warn("ignoring invalid result of the tx selection hook", "hook", hook.Name, "err", err)
+
+			continue
+		}
+
+		if vetoed := len(candidates) - len(ordered); vetoed > 0 {
+			s.logger.Debug("transactions vetoed by the tx selection hook", "hook", hook.Name, "vetoed", vetoed)
+		}
+
+		candidates = ordered
+	}
+
+	return candidates
+}
+
+// callHook calls the hook function within the hook budget.
+// It returns false if the hook panicked or didn't finish in time
+func (s *TxSelector) callHook(
+	hook *TxSelectionHook,
+	fn func(ctx context.Context) []*types.Transaction,
+) ([]*types.Transaction, bool) {
+	budget := hook.Budget
+	if budget <= 0 {
+		budget = DefaultTxSelectionHookBudget
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), budget)
+	defer cancelFn()
+
+	type hookResult struct {
+		txs []*types.Transaction
+		ok  bool
+	}
+
+	resultCh := make(chan hookResult, 1)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				s.logger.Error("tx selection hook panicked", "hook", hook.Name, "err", r)
+				resultCh <- hookResult{}
+			}
+		}()
+
+		resultCh <- hookResult{txs: fn(ctx), ok: true}
+	}()
+
+	select {
+	case res := <-resultCh:
+		return res.txs, res.ok
+	case <-ctx.Done():
+		s.logger.Warn("tx selection hook exceeded its budget", "hook", hook.Name, "budget", budget)
+
+		return nil, false
+	}
+}
+
+// validateOrderedTxs checks that the ordered transactions are a subset of the candidates without duplicates
+func validateOrderedTxs(candidates, ordered []*types.Transaction) error {
+	remaining := make(map[*types.Transaction]struct{}, len(candidates))
+	for _, tx := range candidates {
+		remaining[tx] = struct{}{}
+	}
+
+	for _, tx := range ordered {
+		if tx == nil {
+			return errors.New("nil transaction")
+		}
+
+		if _, ok := remaining[tx]; !ok {
+			return fmt.Errorf("transaction %s is not a candidate or is returned more than once", tx.Hash)
+		}
+
+		delete(remaining, tx)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package consensus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSelectionPool struct {
+	executables []*types.Transaction
+	popped      []*types.Transaction
+	dropped     []*types.Transaction
+	demoted     []*types.Transaction
+}
+
+func (m *mockSelectionPool) Prepare(uint64) {}
+
+func (m *mockSelectionPool) Peek() *types.Transaction {
+	if len(m.executables) == 0 {
+		return nil
+	}
+
+	tx := m.executables[0]
+	m.executables = m.executables[1:]
+
+	return tx
+}
+
+func (m *mockSelectionPool) Pop(tx *types.Transaction) {
+	m.popped = append(m.popped, tx)
+}
+
+func (m *mockSelectionPool) Drop(tx *types.Transaction) {
+	m.dropped = append(m.dropped, tx)
+}
+
+func (m *mockSelectionPool) Demote(tx *types.Transaction) {
+	m.demoted = append(m.demoted, tx)
+}
+
+func newSelectionTx(from string, nonce uint64) *types.Transaction {
+	tx := &types.Transaction{From: types.StringToAddress(from), Nonce: nonce}
+	tx.ComputeHash(1)
+
+	return tx
+}
+
+func newTestTxSelector(pool TxSelectionPool, hooks ...*TxSelectionHook) *TxSelector {
+	s := NewTxSelector(pool, &types.Header{Number: 1}, hclog.NewNullLogger())
+	s.hooks = hooks
+
+	for _, hook := range hooks {
+		if hook.OrderFunc != nil {
+			s.ordering = true
+		}
+	}
+
+	return s
+}
+
+func peekAll(s *TxSelector) []*types.Transaction {
+	var txs []*types.Transaction
+
+	for tx := s.Peek(); tx != nil; tx = s.Peek() {
+		txs = append(txs, tx)
+		s.Pop(tx)
+	}
+
+	return txs
+}
+
+func TestTxSelector_Passthrough(t *testing.T) {
+	t.Parallel()
+
+	txs := []*types.Transaction{newSelectionTx("1", 0), newSelectionTx("2", 0)}
+	pool := &mockSelectionPool{executables: txs}
+	s := newTestTxSelector(pool)
+
+	s.Prepare(0)
+	require.Equal(t, txs, peekAll(s))
+	require.Equal(t, txs, pool.popped)
+}
+
+func TestTxSelector_InjectAndOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		injected = newSelectionTx("10", 0)
+		first    = newSelectionTx("1", 0)
+		second   = newSelectionTx("2", 0)
+		vetoed   = newSelectionTx("3", 0)
+	)
+
+	pool := &mockSelectionPool{executables: []*types.Transaction{first, second, vetoed}}
+	s := newTestTxSelector(pool,
+		&TxSelectionHook{
+			Name: "inject",
+			InjectFunc: func(_ context.Context, header *types.Header) []*types.Transaction {
+				require.Equal(t, uint64(1), header.Number)
+
+				return []*types.Transaction{injected}
+			},
+		},
+		&TxSelectionHook{
+			Name: "veto",
+			OrderFunc: func(_ context.Context, _ *types.Header, candidates []*types.Transaction) []*types.Transaction {
+				return candidates[:2]
+			},
+		},
+		NewPrioritySendersHook("priority", []types.Address{second.From}),
+	)
+
+	s.Prepare(0)
+
+	tx := s.Peek()
+	require.Equal(t, injected, tx)
+
+	// the injected transaction is not part of the txpool
+	s.Drop(tx)
+	s.Demote(tx)
+	s.Pop(tx)
+	require.Empty(t, pool.dropped)
+	require.Empty(t, pool.demoted)
+	require.Empty(t, pool.popped)
+
+	require.Equal(t, []*types.Transaction{second, first}, peekAll(s))
+	require.Equal(t, []*types.Transaction{second, first}, pool.popped)
+}
+
+func TestTxSelector_InvalidHookResults(t *testing.T) {
+	t.Parallel()
+
+	var (
+		first  = newSelectionTx("1", 0)
+		second = newSelectionTx("2", 0)
+		other  = newSelectionTx("3", 0)
+	)
+
+	reverse := func(_ context.Context, _ *types.Header, candidates []*types.Transaction) []*types.Transaction {
+		return []*types.Transaction{candidates[1], candidates[0]}
+	}
+
+	cases := []struct {
+		name string
+		hook *TxSelectionHook
+	}{
+		{
+			name: "unknown transaction",
+			hook: &TxSelectionHook{
+				Name: "unknown",
+				OrderFunc: func(context.Context, *types.Header, []*types.Transaction) []*types.Transaction {
+					return []*types.Transaction{other}
+				},
+			},
+		},
+		{
+			name: "duplicated transaction",
+			hook: &TxSelectionHook{
+				Name: "duplicated",
+				OrderFunc: func(_ context.Context, _ *types.Header, candidates []*types.Transaction) []*types.Transaction {
+					return append(candidates, candidates[0])
+				},
+			},
+		},
+		{
+			name: "budget exceeded",
+			hook: &TxSelectionHook{
+				Name:   "slow",
+				Budget: 10 * time.Millisecond,
+				OrderFunc: func(ctx context.Context, h *types.Header, c []*types.Transaction) []*types.Transaction {
+					<-ctx.Done()
+					time.Sleep(10 * time.Millisecond)
+
+					return reverse(ctx, h, c)
+				},
+			},
+		},
+		{
+			name: "panic",
+			hook: &TxSelectionHook{
+				Name: "panic",
+				OrderFunc: func(context.Context, *types.Header, []*types.Transaction) []*types.Transaction {
+					panic("hook failure") //nolint:gocritic
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool := &mockSelectionPool{executables: []*types.Transaction{first, second}}
+			s := newTestTxSelector(pool, c.hook)
+
+			// the result of the invalid hook is ignored
+			s.Prepare(0)
+			require.Equal(t, []*types.Transaction{first, second}, peekAll(s))
+		})
+	}
+
+	// the valid hook is applied after the ignored one
+	pool := &mockSelectionPool{executables: []*types.Transaction{first, second}}
+	s := newTestTxSelector(pool, cases[0].hook, &TxSelectionHook{Name: "reverse", OrderFunc: reverse})
+
+	s.Prepare(0)
+	require.Equal(t, []*types.Transaction{second, first}, peekAll(s))
+}
+
+func TestRegisterTxSelectionHook(t *testing.T) {
+	t.Parallel()
+
+	order := func(_ context.Context, _ *types.Header, c []*types.Transaction) []*types.Transaction { return c }
+
+	require.ErrorIs(t, RegisterTxSelectionHook(&TxSelectionHook{OrderFunc: order}), errEmptyHookName)
+	require.ErrorIs(t, RegisterTxSelectionHook(&TxSelectionHook{Name: "empty"}), errEmptyHook)
+
+	require.NoError(t, RegisterTxSelectionHook(&TxSelectionHook{Name: "test", OrderFunc: order}))
+	require.ErrorIs(t, RegisterTxSelectionHook(&TxSelectionHook{Name: "test", OrderFunc: order}), errDuplicateHookName)
+
+	hooks := RegisteredTxSelectionHooks()
+	require.Len(t, hooks, 1)
+	require.Equal(t, "test", hooks[0].Name)
+}
@@ -46,6 +46,8 @@ var (
 	NativeTransferPrecompile = types.StringToAddress("0x2020")
 	// BLSAggSigsVerificationPrecompile is an address of BLS aggregated signatures verificatin precompile
 	BLSAggSigsVerificationPrecompile = types.StringToAddress("0x2030")
+	// MessageDispatcherPrecompile is an address of the arbitrary cross-chain messages receiver precompile
+	MessageDispatcherPrecompile = types.StringToAddress("0x2040")
 	// ConsolePrecompile is and address of Hardhat console precompile
 	ConsolePrecompile = types.StringToAddress("0x000000000000000000636F6e736F6c652e6c6f67")
 	// AllowListContractsAddr is the address of the contract deployer allow list
@@ -60,4 +62,8 @@ var (
 	AllowListBridgeAddr = types.StringToAddress("0x0200000000000000000000000000000000000004")
 	// BlockListBridgeAddr is the address of the bridge block list
 	BlockListBridgeAddr = types.StringToAddress("0x0300000000000000000000000000000000000004")
+	// StorageRentAddr is the address of the storage rent contract
+	StorageRentAddr = types.StringToAddress("0x0400000000000000000000000000000000000000")
+	// UnjailAddr is the address the jailed validators send the unjail transactions to
+	UnjailAddr = types.StringToAddress("0x0500000000000000000000000000000000000000")
 )
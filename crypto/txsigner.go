@@ -47,6 +47,23 @@ func NewSigner(forks chain.ForksInTime, chainID uint64) TxSigner {
 	return signer
 }
 
+// TxChainID returns the chain ID the transaction signature is bound to. For the legacy transactions
+// it is derived from the V value (EIP-155), and false is returned if the transaction is not replay protected
+func TxChainID(tx *types.Transaction) (*big.Int, bool) {
+	if tx.Type.HasAccessList() {
+		return tx.ChainID, tx.ChainID != nil
+	}
+
+	// v = CHAIN_ID * 2 + 35 + {0, 1}
+	if tx.V == nil || tx.V.Cmp(big35) < 0 {
+		return nil, false
+	}
+
+	chainID := new(big.Int).Sub(tx.V, big35)
+
+	return chainID.Rsh(chainID, 1), true
+}
+
 // encodeSignature generates a signature value based on the R, S and V value
 func encodeSignature(R, S, V *big.Int, isHomestead bool) ([]byte, error) {
 	if !ValidateSignatureValues(V, R, S, isHomestead) {
@@ -61,14 +78,17 @@ func encodeSignature(R, S, V *big.Int, isHomestead bool) ([]byte, error) {
 	return sig, nil
 }
 
-// calcTxHash calculates the transaction hash (keccak256 hash of the RLP value)
+// calcTxHash calculates the transaction hash (keccak256 hash of the RLP value).
+// The access list is covered by the hash of the typed transactions. The transactions carrying
+// the access list are rejected before the berlin fork, so the hashes signed before it don't change
 func calcTxHash(tx *types.Transaction, chainID uint64) types.Hash {
 	a := signerPool.Get()
-	isDynamicFeeTx := tx.Type == types.DynamicFeeTx
+	isTypedTx := tx.Type.HasAccessList()
+	isDynamicFeeTx := tx.Type.HasDynamicFees()
 
 	v := a.NewArray()
 
-	if isDynamicFeeTx {
+	if isTypedTx {
 		v.Set(a.NewUint(chainID))
 	}
 
@@ -93,8 +113,17 @@ func calcTxHash(tx *types.Transaction, chainID uint64) types.Hash {
 
 	v.Set(a.NewCopyBytes(tx.Input))
 
-	if isDynamicFeeTx {
-		v.Set(a.NewArray())
+	if isTypedTx {
+		v.Set(tx.AccessList.MarshalRLPWith(a))
+
+		// the sender agrees to be sponsored by the given account
+		if tx.Type == types.SponsoredTx {
+			if tx.Sponsor == nil {
+				v.Set(a.NewNull())
+			} else {
+				v.Set(a.NewCopyBytes(tx.Sponsor.Bytes()))
+			}
+		}
 	} else {
 		// EIP155
 		if chainID != 0 {
@@ -105,7 +134,7 @@ func calcTxHash(tx *types.Transaction, chainID uint64) types.Hash {
 	}
 
 	var hash []byte
-	if isDynamicFeeTx {
+	if isTypedTx {
 		hash = keccak.PrefixedKeccak256Rlp([]byte{byte(tx.Type)}, nil, v)
 	} else {
 		hash = keccak.Keccak256Rlp(nil, v)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEIP155Signer_Sender(t *testing.T) {
@@ -135,3 +136,37 @@ func TestEIP155Signer_ChainIDMismatch(t *testing.T) {
 		}
 	}
 }
+
+func TestTxChainID(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateECDSAKey()
+	require.NoError(t, err)
+
+	toAddress := types.StringToAddress("1")
+	txn := &types.Transaction{
+		To:       &toAddress,
+		Value:    big.NewInt(1),
+		GasPrice: big.NewInt(0),
+	}
+
+	// replay protected legacy transaction
+	signedTx, err := NewEIP155Signer(1337, true).SignTx(txn, key)
+	require.NoError(t, err)
+
+	chainID, protected := TxChainID(signedTx)
+	require.True(t, protected)
+	require.Equal(t, uint64(1337), chainID.Uint64())
+
+	// legacy transaction without replay protection
+	signedTx, err = NewFrontierSigner(true).SignTx(txn, key)
+	require.NoError(t, err)
+
+	_, protected = TxChainID(signedTx)
+	require.False(t, protected)
+
+	// dynamic fee transaction
+	chainID, protected = TxChainID(&types.Transaction{Type: types.DynamicFeeTx, ChainID: big.NewInt(7)})
+	require.True(t, protected)
+	require.Equal(t, uint64(7), chainID.Uint64())
+}
@@ -143,3 +143,37 @@ func Test_LondonSigner_Sender(t *testing.T) {
 		})
 	}
 }
+
+func Test_LondonSigner_AccessListTx(t *testing.T) {
+	t.Parallel()
+
+	key, err := GenerateECDSAKey()
+	require.NoError(t, err)
+
+	signer := NewLondonSigner(100, true, NewEIP155Signer(100, true))
+	to := types.StringToAddress("1")
+
+	signedTx, err := signer.SignTx(&types.Transaction{
+		Type:     types.AccessListTx,
+		ChainID:  big.NewInt(100),
+		GasPrice: big.NewInt(10),
+		Gas:      30000,
+		To:       &to,
+		Value:    big.NewInt(1),
+		AccessList: types.TxAccessList{
+			{Address: to, StorageKeys: []types.Hash{types.StringToHash("1")}},
+		},
+	}, key)
+	require.NoError(t, err)
+
+	sender, err := signer.Sender(signedTx)
+	require.NoError(t, err)
+	require.Equal(t, PubKeyToAddress(&key.PublicKey), sender)
+
+	// the access list is covered by the signature
+	signedTx.AccessList[0].StorageKeys = nil
+
+	sender, err = signer.Sender(signedTx)
+	require.NoError(t, err)
+	require.NotEqual(t, PubKeyToAddress(&key.PublicKey), sender)
+}
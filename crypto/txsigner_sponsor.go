@@ -0,0 +1,86 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// ErrNotSponsoredTx is returned if the sponsor is requested for the transaction of another type
+	ErrNotSponsoredTx = errors.New("transaction is not sponsored")
+
+	// ErrInvalidSponsor is returned if the sponsor signature is not made by the sponsor of the transaction
+	ErrInvalidSponsor = errors.New("sponsor signature doesn't match the transaction sponsor")
+)
+
+// sponsorHashPrefix separates the sponsor signatures from the sender signatures of the sponsored transactions
+var sponsorHashPrefix = []byte{byte(types.SponsoredTx), 0x01}
+
+// SponsorHash returns the hash signed by the sponsor of the transaction.
+// It covers the sender and the hash signed by the sender, so the sponsor signature
+// can't be reused for the other transactions. The sender of the transaction must be set
+func SponsorHash(tx *types.Transaction, chainID uint64) types.Hash {
+	a := signerPool.Get()
+	defer signerPool.Put(a)
+
+	v := a.NewArray()
+	v.Set(a.NewCopyBytes(calcTxHash(tx, chainID).Bytes()))
+	v.Set(a.NewCopyBytes(tx.From.Bytes()))
+
+	return types.BytesToHash(keccak.PrefixedKeccak256Rlp(sponsorHashPrefix, nil, v))
+}
+
+// SignSponsor signs the sponsored transaction by the sponsor using the passed in private key.
+// The sender of the transaction must be set
+func SignSponsor(tx *types.Transaction, chainID uint64, pk *ecdsa.PrivateKey) (*types.Transaction, error) {
+	if tx.Type != types.SponsoredTx {
+		return nil, ErrNotSponsoredTx
+	}
+
+	tx = tx.Copy()
+
+	h := SponsorHash(tx, chainID)
+
+	sig, err := Sign(pk, h[:])
+	if err != nil {
+		return nil, err
+	}
+
+	tx.SponsorR = new(big.Int).SetBytes(sig[:32])
+	tx.SponsorS = new(big.Int).SetBytes(sig[32:64])
+	tx.SponsorV = new(big.Int).SetBytes([]byte{sig[64]})
+
+	return tx, nil
+}
+
+// VerifySponsor checks that the sponsored transaction is signed by its sponsor.
+// The sender of the transaction must be set
+func VerifySponsor(tx *types.Transaction, chainID uint64) error {
+	if tx.Type != types.SponsoredTx || tx.Sponsor == nil {
+		return ErrNotSponsoredTx
+	}
+
+	if tx.SponsorV == nil {
+		return ErrInvalidSponsor
+	}
+
+	sig, err := encodeSignature(tx.SponsorR, tx.SponsorS, tx.SponsorV, true)
+	if err != nil {
+		return err
+	}
+
+	pub, err := Ecrecover(SponsorHash(tx, chainID).Bytes(), sig)
+	if err != nil {
+		return err
+	}
+
+	if types.BytesToAddress(Keccak256(pub[1:])[12:]) != *tx.Sponsor {
+		return ErrInvalidSponsor
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestSponsorSigner(t *testing.T) {
+	t.Parallel()
+
+	const chainID = uint64(100)
+
+	senderKey, err := GenerateECDSAKey()
+	require.NoError(t, err)
+
+	sponsorKey, err := GenerateECDSAKey()
+	require.NoError(t, err)
+
+	to := types.StringToAddress("1")
+	sponsor := PubKeyToAddress(&sponsorKey.PublicKey)
+	signer := NewLondonSigner(chainID, true, NewEIP155Signer(chainID, true))
+
+	tx, err := signer.SignTx(&types.Transaction{
+		Type:      types.SponsoredTx,
+		ChainID:   new(big.Int).SetUint64(chainID),
+		To:        &to,
+		Value:     big.NewInt(10),
+		GasFeeCap: big.NewInt(100),
+		GasTipCap: big.NewInt(10),
+		Gas:       21000,
+		Sponsor:   &sponsor,
+	}, senderKey)
+	require.NoError(t, err)
+
+	tx.From, err = signer.Sender(tx)
+	require.NoError(t, err)
+	require.Equal(t, PubKeyToAddress(&senderKey.PublicKey), tx.From)
+
+	// not signed by the sponsor
+	require.ErrorIs(t, VerifySponsor(tx, chainID), ErrInvalidSponsor)
+
+	sponsoredTx, err := SignSponsor(tx, chainID, sponsorKey)
+	require.NoError(t, err)
+	require.NoError(t, VerifySponsor(sponsoredTx, chainID))
+
+	// the sponsor signature is not a part of the sender signature
+	from, err := signer.Sender(sponsoredTx)
+	require.NoError(t, err)
+	require.Equal(t, tx.From, from)
+
+	// the sponsor signature can't be used for another sender
+	otherTx := sponsoredTx.Copy()
+	otherTx.From = types.StringToAddress("2")
+	require.ErrorIs(t, VerifySponsor(otherTx, chainID), ErrInvalidSponsor)
+
+	// the sponsor signature can't be used for another chain
+	require.ErrorIs(t, VerifySponsor(sponsoredTx, chainID+1), ErrInvalidSponsor)
+
+	// the sponsor can't be replaced
+	otherTx = sponsoredTx.Copy()
+	otherTx.Sponsor = &to
+	require.ErrorIs(t, VerifySponsor(otherTx, chainID), ErrInvalidSponsor)
+
+	// only the sponsored transactions are signed by the sponsor
+	tx.Type = types.DynamicFeeTx
+
+	_, err = SignSponsor(tx, chainID, sponsorKey)
+	require.ErrorIs(t, err, ErrNotSponsoredTx)
+}
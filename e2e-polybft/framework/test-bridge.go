@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-hclog"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/0xPolygon/polygon-edge/command"
@@ -21,6 +22,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/rootchain/server"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/helper/tests/rootchain"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -28,6 +30,7 @@ type TestBridge struct {
 	t             *testing.T
 	clusterConfig *TestClusterConfig
 	node          *node
+	rootchain     *rootchain.Rootchain
 }
 
 func NewTestBridge(t *testing.T, clusterConfig *TestClusterConfig) (*TestBridge, error) {
@@ -47,6 +50,10 @@ func NewTestBridge(t *testing.T, clusterConfig *TestClusterConfig) (*TestBridge,
 }
 
 func (t *TestBridge) Start() error {
+	if t.clusterConfig.InProcessRootchain {
+		return t.startInProcess()
+	}
+
 	// Build arguments
 	args := []string{
 		"rootchain",
@@ -70,7 +77,34 @@ func (t *TestBridge) Start() error {
 	return nil
 }
 
+// startInProcess starts the in-process rootchain, which is mining empty blocks with the same period as geth dev node
+func (t *TestBridge) startInProcess() error {
+	config := rootchain.DefaultConfig()
+	config.Addr = fmt.Sprintf("%s:0", hostIP)
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Output: t.clusterConfig.GetStdout("bridge"),
+		Level:  hclog.Info,
+	})
+
+	r, err := rootchain.NewRootchain(logger, config)
+	if err != nil {
+		return err
+	}
+
+	t.rootchain = r
+
+	return nil
+}
+
 func (t *TestBridge) Stop() {
+	if t.rootchain != nil {
+		t.rootchain.Close()
+		t.rootchain = nil
+
+		return
+	}
+
 	if err := t.node.Stop(); err != nil {
 		t.t.Error(err)
 	}
@@ -79,6 +113,10 @@ func (t *TestBridge) Stop() {
 }
 
 func (t *TestBridge) JSONRPCAddr() string {
+	if t != nil && t.rootchain != nil {
+		return t.rootchain.HTTPAddr()
+	}
+
 	return fmt.Sprintf("http://%s:%d", hostIP, 8545)
 }
 
@@ -77,6 +77,7 @@ type TestClusterConfig struct {
 	PremineValidators    []string // address[:amount]
 	StakeAmounts         []*big.Int
 	WithoutBridge        bool
+	InProcessRootchain   bool
 	BootnodeCount        int
 	NonValidatorCount    int
 	WithLogs             bool
@@ -211,6 +212,13 @@ func WithoutBridge() ClusterOption {
 	}
 }
 
+// WithInProcessRootchain runs the rootchain in the test process instead of the geth dev node in docker
+func WithInProcessRootchain() ClusterOption {
+	return func(h *TestClusterConfig) {
+		h.InProcessRootchain = true
+	}
+}
+
 func WithNonValidators(num int) ClusterOption {
 	return func(h *TestClusterConfig) {
 		h.NonValidatorCount = num
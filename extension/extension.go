@@ -0,0 +1,53 @@
+package extension
+
+import (
+	"encoding/json"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// NewSymbol is the name of the function every plugin has to export,
+// with the signature func() extension.Extension
+const NewSymbol = "New"
+
+// Extension is an out-of-tree extension of the node, which registers its hooks into the extension points
+type Extension interface {
+	// Name returns the unique name of the extension
+	Name() string
+	// Init registers the hooks of the extension, it is called once while the node is starting
+	Init(host Host) error
+}
+
+// Host is the set of the extension points exposed to the extensions
+type Host interface {
+	// Logger returns the logger named by the extension
+	Logger() hclog.Logger
+	// RegisterTxValidator adds the validator of the transactions entering the transaction pool
+	RegisterTxValidator(validator TxValidator)
+	// RegisterRPCNamespace adds the JSON-RPC namespace, whose methods are served as <namespace>_<method>
+	RegisterRPCNamespace(namespace string, service interface{}) error
+	// RegisterTracer adds the tracer which can be selected by its name in the debug_trace* calls
+	RegisterTracer(name string, factory TracerFactory) error
+	// RegisterEpochHook adds the hook called once a new epoch begins
+	RegisterEpochHook(hook EpochHook)
+}
+
+// TxValidator validates the transaction entering the transaction pool,
+// the transaction is rejected if the error is returned
+type TxValidator func(tx *types.Transaction) error
+
+// TracerFactory creates a new tracer, configured by the raw tracerConfig of the trace request
+type TracerFactory func(config json.RawMessage) (tracer.Tracer, error)
+
+// Epoch holds the data of the epoch passed to the epoch hooks
+type Epoch struct {
+	Number     uint64
+	FirstBlock uint64
+	Validators []types.Address
+}
+
+// EpochHook is called once a new epoch begins. It must not block, since it is called by the consensus,
+// and the returned error is only logged
+type EpochHook func(epoch *Epoch) error
@@ -0,0 +1,260 @@
+package extension
+
+import (
+	"errors"
+	"fmt"
+	"plugin"
+	"reflect"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/builtin"
+	"github.com/hashicorp/go-hclog"
+)
+
+var (
+	errEmptyName      = errors.New("name cannot be empty")
+	errInvalidService = errors.New("service must be a pointer to struct")
+	errInvalidHook    = errors.New("hook cannot be nil")
+)
+
+// Registry holds the extensions loaded into the node, and the hooks they registered.
+// The nil registry has no extensions
+type Registry struct {
+	lock   sync.RWMutex
+	logger hclog.Logger
+
+	extensions    map[string]struct{}
+	txValidators  []TxValidator
+	rpcNamespaces map[string]interface{}
+	tracers       map[string]TracerFactory
+	epochHooks    []EpochHook
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry(logger hclog.Logger) *Registry {
+	return &Registry{
+		logger:        logger.Named("extension"),
+		extensions:    map[string]struct{}{},
+		rpcNamespaces: map[string]interface{}{},
+		tracers:       map[string]TracerFactory{},
+	}
+}
+
+// Load creates the registry with the extensions from the given Go plugins (.so files).
+// Each plugin has to export the NewSymbol function, and has to be built with the same
+// Go toolchain and dependency versions as the node
+func Load(paths []string, logger hclog.Logger) (*Registry, error) {
+	r := NewRegistry(logger)
+
+	for _, path := range paths {
+		ext, err := open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := r.Register(ext); err != nil {
+			return nil, fmt.Errorf("failed to register extension from plugin %s: %w", path, err)
+		}
+	}
+
+	return r, nil
+}
+
+// open opens the Go plugin, and creates the extension it exports
+func open(path string) (Extension, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin %s: %w", path, err)
+	}
+
+	sym, err := p.Lookup(NewSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup %s in plugin %s: %w", NewSymbol, path, err)
+	}
+
+	newFn, ok := sym.(func() Extension)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s in plugin %s: expected func() extension.Extension, got %T",
+			NewSymbol, path, sym)
+	}
+
+	return newFn(), nil
+}
+
+// Register initializes the extension, which registers its hooks into the registry
+func (r *Registry) Register(ext Extension) error {
+	name := ext.Name()
+	if name == "" {
+		return fmt.Errorf("extension %w", errEmptyName)
+	}
+
+	r.lock.Lock()
+	_, exists := r.extensions[name]
+	r.extensions[name] = struct{}{}
+	r.lock.Unlock()
+
+	if exists {
+		return fmt.Errorf("extension %s is already registered", name)
+	}
+
+	if err := ext.Init(&host{registry: r, logger: r.logger.Named(name)}); err != nil {
+		return fmt.Errorf("failed to init extension %s: %w", name, err)
+	}
+
+	r.logger.Info("extension registered", "name", name)
+
+	return nil
+}
+
+// Extensions returns the number of the registered extensions
+func (r *Registry) Extensions() int {
+	if r == nil {
+		return 0
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return len(r.extensions)
+}
+
+// TxValidators returns the registered transaction validators
+func (r *Registry) TxValidators() []TxValidator {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return append([]TxValidator(nil), r.txValidators...)
+}
+
+// RPCNamespaces returns the registered JSON-RPC namespaces and their services
+func (r *Registry) RPCNamespaces() map[string]interface{} {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	namespaces := make(map[string]interface{}, len(r.rpcNamespaces))
+	for namespace, service := range r.rpcNamespaces {
+		namespaces[namespace] = service
+	}
+
+	return namespaces
+}
+
+// Tracers returns the registered tracer factories by their names
+func (r *Registry) Tracers() map[string]TracerFactory {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	tracers := make(map[string]TracerFactory, len(r.tracers))
+	for name, factory := range r.tracers {
+		tracers[name] = factory
+	}
+
+	return tracers
+}
+
+// OnEpoch calls the registered epoch hooks, the errors of the hooks are logged
+func (r *Registry) OnEpoch(epoch *Epoch) {
+	if r == nil {
+		return
+	}
+
+	r.lock.RLock()
+	hooks := r.epochHooks
+	r.lock.RUnlock()
+
+	for _, hook := range hooks {
+		if err := hook(epoch); err != nil {
+			r.logger.Error("epoch hook failed", "epoch", epoch.Number, "error", err)
+		}
+	}
+}
+
+// host is the Host exposed to a single extension
+type host struct {
+	registry *Registry
+	logger   hclog.Logger
+}
+
+func (h *host) Logger() hclog.Logger {
+	return h.logger
+}
+
+func (h *host) RegisterTxValidator(validator TxValidator) {
+	if validator == nil {
+		return
+	}
+
+	h.registry.lock.Lock()
+	defer h.registry.lock.Unlock()
+
+	h.registry.txValidators = append(h.registry.txValidators, validator)
+}
+
+func (h *host) RegisterRPCNamespace(namespace string, service interface{}) error {
+	if namespace == "" {
+		return fmt.Errorf("namespace %w", errEmptyName)
+	}
+
+	if st := reflect.TypeOf(service); st == nil || st.Kind() != reflect.Ptr || st.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("namespace %s: %w", namespace, errInvalidService)
+	}
+
+	h.registry.lock.Lock()
+	defer h.registry.lock.Unlock()
+
+	if _, exists := h.registry.rpcNamespaces[namespace]; exists {
+		return fmt.Errorf("namespace %s is already registered", namespace)
+	}
+
+	h.registry.rpcNamespaces[namespace] = service
+
+	return nil
+}
+
+func (h *host) RegisterTracer(name string, factory TracerFactory) error {
+	if name == "" {
+		return fmt.Errorf("tracer %w", errEmptyName)
+	}
+
+	if factory == nil {
+		return fmt.Errorf("tracer %s: %w", name, errInvalidHook)
+	}
+
+	h.registry.lock.Lock()
+	defer h.registry.lock.Unlock()
+
+	if _, exists := h.registry.tracers[name]; exists {
+		return fmt.Errorf("tracer %s is already registered", name)
+	}
+
+	if _, isBuiltin := builtin.Get(name); isBuiltin {
+		return fmt.Errorf("tracer %s is a built-in tracer", name)
+	}
+
+	h.registry.tracers[name] = factory
+
+	return nil
+}
+
+func (h *host) RegisterEpochHook(hook EpochHook) {
+	if hook == nil {
+		return
+	}
+
+	h.registry.lock.Lock()
+	defer h.registry.lock.Unlock()
+
+	h.registry.epochHooks = append(h.registry.epochHooks, hook)
+}
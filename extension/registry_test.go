@@ -0,0 +1,133 @@
+package extension
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct{}
+
+func (s *testService) Hello() (interface{}, error) {
+	return "hello", nil
+}
+
+type testExtension struct {
+	name string
+	init func(host Host) error
+}
+
+func (e *testExtension) Name() string {
+	return e.name
+}
+
+func (e *testExtension) Init(host Host) error {
+	if e.init == nil {
+		return nil
+	}
+
+	return e.init(host)
+}
+
+func TestRegistry_Register(t *testing.T) {
+	t.Parallel()
+
+	var epochs []uint64
+
+	r := NewRegistry(hclog.NewNullLogger())
+
+	require.NoError(t, r.Register(&testExtension{
+		name: "test",
+		init: func(host Host) error {
+			host.RegisterTxValidator(func(tx *types.Transaction) error { return nil })
+			host.RegisterEpochHook(func(epoch *Epoch) error {
+				epochs = append(epochs, epoch.Number)
+
+				return errors.New("hook failed")
+			})
+
+			if err := host.RegisterRPCNamespace("test", &testService{}); err != nil {
+				return err
+			}
+
+			return host.RegisterTracer("testTracer", func(json.RawMessage) (tracer.Tracer, error) {
+				return nil, nil
+			})
+		},
+	}))
+
+	require.Equal(t, 1, r.Extensions())
+	require.Len(t, r.TxValidators(), 1)
+	require.Contains(t, r.RPCNamespaces(), "test")
+	require.Contains(t, r.Tracers(), "testTracer")
+
+	// the failing hook doesn't stop the other hooks
+	r.OnEpoch(&Epoch{Number: 1})
+	r.OnEpoch(&Epoch{Number: 2})
+	require.Equal(t, []uint64{1, 2}, epochs)
+
+	// the extension names are unique
+	require.ErrorContains(t, r.Register(&testExtension{name: "test"}), "already registered")
+	require.ErrorIs(t, r.Register(&testExtension{}), errEmptyName)
+
+	// the namespaces and the tracers are unique
+	require.ErrorContains(t, r.Register(&testExtension{
+		name: "other",
+		init: func(host Host) error {
+			return host.RegisterRPCNamespace("test", &testService{})
+		},
+	}), "namespace test is already registered")
+
+	require.ErrorContains(t, r.Register(&testExtension{
+		name: "another",
+		init: func(host Host) error {
+			return host.RegisterTracer("testTracer", func(json.RawMessage) (tracer.Tracer, error) {
+				return nil, nil
+			})
+		},
+	}), "tracer testTracer is already registered")
+
+	require.ErrorContains(t, r.Register(&testExtension{
+		name: "builtin",
+		init: func(host Host) error {
+			return host.RegisterTracer("callTracer", func(json.RawMessage) (tracer.Tracer, error) {
+				return nil, nil
+			})
+		},
+	}), "tracer callTracer is a built-in tracer")
+
+	require.ErrorIs(t, r.Register(&testExtension{
+		name: "invalid",
+		init: func(host Host) error {
+			return host.RegisterRPCNamespace("invalid", testService{})
+		},
+	}), errInvalidService)
+}
+
+func TestRegistry_Nil(t *testing.T) {
+	t.Parallel()
+
+	var r *Registry
+
+	require.Zero(t, r.Extensions())
+	require.Nil(t, r.TxValidators())
+	require.Nil(t, r.RPCNamespaces())
+	require.Nil(t, r.Tracers())
+	r.OnEpoch(&Epoch{Number: 1})
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	r, err := Load(nil, hclog.NewNullLogger())
+	require.NoError(t, err)
+	require.Zero(t, r.Extensions())
+
+	_, err = Load([]string{"/non/existing/plugin.so"}, hclog.NewNullLogger())
+	require.ErrorContains(t, err, "failed to open plugin /non/existing/plugin.so")
+}
@@ -0,0 +1,158 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/google/uuid"
+	"github.com/umbracle/ethgo/keystore"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// The scrypt params of the Ethereum keystore v3 (the "standard" params of the other clients)
+	v3ScryptN     = 1 << 18
+	v3ScryptR     = 8
+	v3ScryptP     = 1
+	v3ScryptDKLen = 32
+
+	v3Version = 3
+	v3Cipher  = "aes-128-ctr"
+	v3KDF     = "scrypt"
+)
+
+// ErrKeystoreAddressMismatch is returned if the decrypted key doesn't match the address of the keystore
+var ErrKeystoreAddressMismatch = errors.New("keystore address doesn't match the decrypted key")
+
+// v3Keystore is the Ethereum keystore v3 JSON (Web3 Secret Storage)
+type v3Keystore struct {
+	Address string   `json:"address"`
+	Crypto  v3Crypto `json:"crypto"`
+	ID      string   `json:"id"`
+	Version int      `json:"version"`
+}
+
+type v3Crypto struct {
+	Cipher       string         `json:"cipher"`
+	CipherText   string         `json:"ciphertext"`
+	CipherParams v3CipherParams `json:"cipherparams"`
+	KDF          string         `json:"kdf"`
+	KDFParams    v3ScryptParams `json:"kdfparams"`
+	MAC          string         `json:"mac"`
+}
+
+type v3CipherParams struct {
+	IV string `json:"iv"`
+}
+
+type v3ScryptParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	P     int    `json:"p"`
+	R     int    `json:"r"`
+	Salt  string `json:"salt"`
+}
+
+// EncryptV3 encrypts the raw private key of the address to the Ethereum keystore v3 JSON,
+// which can be imported by the other Ethereum clients and wallets
+func EncryptV3(privateKey []byte, address types.Address, passphrase string) ([]byte, error) {
+	return encryptV3(privateKey, address, passphrase, v3ScryptN)
+}
+
+func encryptV3(privateKey []byte, address types.Address, passphrase string, scryptN int) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, v3ScryptR, v3ScryptP, v3ScryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText := make([]byte, len(privateKey))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, privateKey)
+
+	mac := keccak.NewKeccak256()
+	_, _ = mac.Write(derivedKey[16:32])
+	_, _ = mac.Write(cipherText)
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&v3Keystore{
+		Address: hex.EncodeToString(address.Bytes()),
+		Crypto: v3Crypto{
+			Cipher:       v3Cipher,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: v3CipherParams{IV: hex.EncodeToString(iv)},
+			KDF:          v3KDF,
+			KDFParams: v3ScryptParams{
+				DKLen: v3ScryptDKLen,
+				N:     scryptN,
+				P:     v3ScryptP,
+				R:     v3ScryptR,
+				Salt:  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(mac.Sum(nil)),
+		},
+		ID:      id.String(),
+		Version: v3Version,
+	})
+}
+
+// DecryptV3 decrypts the raw private key from the Ethereum keystore v3 JSON (both the scrypt and the pbkdf2 ones),
+// the address of the keystore is verified by the given function, if the keystore has the address
+func DecryptV3(
+	content []byte,
+	passphrase string,
+	toAddress func(privateKey []byte) (types.Address, error),
+) ([]byte, error) {
+	var header struct {
+		Address string `json:"address"`
+	}
+
+	if err := json.Unmarshal(content, &header); err != nil {
+		return nil, fmt.Errorf("invalid keystore: %w", err)
+	}
+
+	privateKey, err := keystore.DecryptV3(content, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt keystore: %w", err)
+	}
+
+	if header.Address == "" {
+		return privateKey, nil
+	}
+
+	address, err := toAddress(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(strings.TrimPrefix(header.Address, "0x"), hex.EncodeToString(address.Bytes())) {
+		return nil, ErrKeystoreAddressMismatch
+	}
+
+	return privateKey, nil
+}
@@ -0,0 +1,62 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystoreV3_EncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	privateKey := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16,
+		17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32}
+	address := types.StringToAddress("0x1")
+
+	toAddress := func([]byte) (types.Address, error) { return address, nil }
+
+	content, err := encryptV3(privateKey, address, "passphrase", 1<<10)
+	require.NoError(t, err)
+
+	decrypted, err := DecryptV3(content, "passphrase", toAddress)
+	require.NoError(t, err)
+	assert.Equal(t, privateKey, decrypted)
+
+	_, err = DecryptV3(content, "wrong", toAddress)
+	require.Error(t, err)
+
+	_, err = DecryptV3(content, "passphrase", func([]byte) (types.Address, error) {
+		return types.StringToAddress("0x2"), nil
+	})
+	require.ErrorIs(t, err, ErrKeystoreAddressMismatch)
+}
+
+func TestKeystoreV3_DecryptPBKDF2(t *testing.T) {
+	t.Parallel()
+
+	// the test vector of the Web3 Secret Storage definition
+	content := []byte(`{
+		"crypto": {
+			"cipher": "aes-128-ctr",
+			"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+			"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+			"kdf": "pbkdf2",
+			"kdfparams": {
+				"c": 262144,
+				"dklen": 32,
+				"prf": "hmac-sha256",
+				"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+			},
+			"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+		},
+		"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+		"version": 3
+	}`)
+
+	privateKey, err := DecryptV3(content, "testpassword", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d", hex.EncodeToString(privateKey))
+}
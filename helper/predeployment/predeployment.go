@@ -19,21 +19,21 @@ import (
 )
 
 var (
-	errABINotFound              = errors.New("abi field not found in specified JSON")
-	errBytecodeNotFound         = errors.New("bytecode field not found in specified JSON")
-	errDeployedBytecodeNotFound = errors.New("deployed bytecode field not found in specified JSON")
+	errABINotFound       = errors.New("abi field not found in specified JSON")
+	errBytecodeNotFound  = errors.New("bytecode field not found in specified JSON")
+	errAddressTaken      = errors.New("the predeploy address is already taken")
+	errEmptyDeployedCode = errors.New("the constructor returned empty code")
+	errAccountDestroyed  = errors.New("the constructor destroyed the genesis account")
 )
 
 const (
-	abiValue              = "abi"
-	deployedBytecodeValue = "deployedBytecode"
-	bytecodeValue         = "bytecode"
+	abiValue      = "abi"
+	bytecodeValue = "bytecode"
 )
 
 type contractArtifact struct {
-	ABI              []byte // the ABI of the Smart Contract
-	Bytecode         []byte // the raw bytecode of the Smart Contract
-	DeployedBytecode []byte // the deployed bytecode of the Smart Contract
+	ABI      []byte // the ABI of the Smart Contract
+	Bytecode []byte // the raw bytecode of the Smart Contract
 }
 
 // loadContractArtifact loads contract artifacts based on the
@@ -73,58 +73,72 @@ func loadContractArtifact(filepath string) (*contractArtifact, error) {
 		return nil, fmt.Errorf("unable to decode bytecode, %w", err)
 	}
 
-	// Parse deployed bytecode
-	deployedBytecode, ok := jsonResult[deployedBytecodeValue].(string)
-	if !ok {
-		return nil, errDeployedBytecodeNotFound
-	}
-
-	hexDeployedBytecode, err := hex.DecodeHex(deployedBytecode)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode deployed bytecode, %w", err)
-	}
-
 	return &contractArtifact{
-		ABI:              abiBytes,
-		Bytecode:         hexBytecode,
-		DeployedBytecode: hexDeployedBytecode,
+		ABI:      abiBytes,
+		Bytecode: hexBytecode,
 	}, nil
 }
 
-// getModifiedStorageMap fetches the modified storage map for the specified address
-func getModifiedStorageMap(radix *state.Txn, address types.Address) map[types.Hash]types.Hash {
-	storageMap := make(map[types.Hash]types.Hash)
+// getPredeployAccounts executes the contract creation on top of the genesis allocations
+// and returns the genesis accounts modified by the execution
+func getPredeployAccounts(
+	config *chain.Chain,
+	address types.Address,
+	input []byte,
+) (map[types.Address]*chain.GenesisAccount, error) {
+	alloc := config.Genesis.Alloc
+
+	// Create an instance of the state
+	st := itrie.NewState(itrie.NewMemoryStorage())
+
+	// Create a snapshot
+	snapshot := st.NewSnapshot()
+
+	// Create a radix holding the genesis allocations
+	radix := state.NewTxn(snapshot)
 
-	radix.GetRadix().Root().Walk(func(k []byte, v interface{}) bool {
-		if types.BytesToAddress(k) != address {
-			// Ignore all addresses that are not the one the predeployment
-			// is meant to run for
-			return false
+	for addr, account := range alloc {
+		if account.Balance != nil {
+			radix.AddBalance(addr, account.Balance)
 		}
 
-		obj, _ := v.(*state.StateObject)
-		obj.Txn.Root().Walk(func(k []byte, v interface{}) bool {
-			val, _ := v.([]byte)
-			storageMap[types.BytesToHash(k)] = types.BytesToHash(val)
+		if account.Nonce != 0 {
+			radix.SetNonce(addr, account.Nonce)
+		}
 
-			return false
-		})
+		if len(account.Code) != 0 {
+			radix.SetCode(addr, account.Code)
+		}
 
-		return true
-	})
+		for key, value := range account.Storage {
+			radix.SetState(addr, key, value)
+		}
+	}
 
-	return storageMap
-}
+	if radix.GetNonce(address) != 0 || len(radix.GetCode(address)) != 0 {
+		return nil, fmt.Errorf("%w: %s", errAddressTaken, address)
+	}
 
-func getPredeployAccount(address types.Address, input, deployedBytecode []byte) (*chain.GenesisAccount, error) {
-	// Create an instance of the state
-	st := itrie.NewState(itrie.NewMemoryStorage())
+	// only the changes made by the contract creation are written to the genesis
+	checkpoint := radix.Checkpoint()
 
-	// Create a snapshot
-	snapshot := st.NewSnapshot()
+	forks := config.Params.Forks.At(0)
 
-	// Create a radix
-	radix := state.NewTxn(snapshot)
+	// Create a transition
+	transition := state.NewTransition(forks, snapshot, radix)
+
+	ctx := transition.ContextPtr()
+	ctx.ChainID = config.Params.ChainID
+	ctx.Timestamp = int64(config.Genesis.Timestamp)
+	ctx.GasLimit = int64(config.Genesis.GasLimit)
+	ctx.Coinbase = config.Genesis.Coinbase
+
+	// Create the contract account the same way the contract creation transaction does
+	radix.CreateAccount(address)
+
+	if forks.EIP158 {
+		radix.IncrNonce(address)
+	}
 
 	// Create the contract object for the EVM
 	contract := runtime.NewContractCreation(
@@ -137,42 +151,73 @@ func getPredeployAccount(address types.Address, input, deployedBytecode []byte)
 		input,
 	)
 
-	// Enable all forks
-	config := chain.AllForksEnabled.At(0)
-
-	// Create a transition
-	transition := state.NewTransition(config, snapshot, radix)
-
-	// Run the transition through the EVM
-	res := evm.NewEVM().Run(contract, transition, &config)
+	// Run the constructor through the EVM
+	res := evm.NewEVM().Run(contract, transition, &forks)
 	if res.Err != nil {
+		if revertReason, err := abi.UnpackRevertError(res.ReturnValue); err == nil {
+			return nil, fmt.Errorf("EVM predeployment failed, %w: %s", res.Err, revertReason)
+		}
+
 		return nil, fmt.Errorf("EVM predeployment failed, %w", res.Err)
 	}
 
-	// After the execution finishes,
-	// the state needs to be walked to collect all touched all storage slots
-	storageMap := getModifiedStorageMap(radix, address)
+	if len(res.ReturnValue) == 0 {
+		return nil, errEmptyDeployedCode
+	}
+
+	// the deployed code is the one returned by the constructor,
+	// so the immutable variables are set as well
+	radix.SetCode(address, res.ReturnValue)
 
-	_, _, err := transition.Commit()
-	if err != nil {
-		return nil, fmt.Errorf("failed to commit the state changes: %w", err)
+	accounts := make(map[types.Address]*chain.GenesisAccount)
+
+	for _, diff := range radix.DiffSince(checkpoint) {
+		if diff.After == nil {
+			return nil, fmt.Errorf("%w: %s", errAccountDestroyed, diff.Address)
+		}
+
+		account := &chain.GenesisAccount{
+			Balance: diff.After.Balance,
+			Nonce:   diff.After.Nonce,
+			Code:    diff.After.Code,
+			Storage: make(map[types.Hash]types.Hash),
+		}
+
+		if prev, ok := alloc[diff.Address]; ok {
+			account.PrivateKey = prev.PrivateKey
+
+			for key, value := range prev.Storage {
+				account.Storage[key] = value
+			}
+		}
+
+		for key, value := range diff.After.Storage {
+			if value == types.ZeroHash {
+				delete(account.Storage, key)
+			} else {
+				account.Storage[key] = value
+			}
+		}
+
+		if len(account.Storage) == 0 {
+			account.Storage = nil
+		}
+
+		accounts[diff.Address] = account
 	}
 
-	return &chain.GenesisAccount{
-		Balance: transition.GetBalance(address),
-		Nonce:   transition.GetNonce(address),
-		Code:    deployedBytecode,
-		Storage: storageMap,
-	}, nil
+	return accounts, nil
 }
 
-// GenerateGenesisAccountFromFile generates an account that is going to be directly
-// inserted into state
-func GenerateGenesisAccountFromFile(
+// GenerateGenesisAccounts executes the constructor of the contract from the artifacts file
+// on top of the genesis allocations of the chain. It returns the genesis accounts modified by the constructor,
+// including the predeployed contract with the code and the storage set by the constructor
+func GenerateGenesisAccounts(
+	config *chain.Chain,
 	filepath string,
 	constructorArgs []string,
 	predeployAddress types.Address,
-) (*chain.GenesisAccount, error) {
+) (map[types.Address]*chain.GenesisAccount, error) {
 	// Create the artifact from JSON
 	artifact, err := loadContractArtifact(filepath)
 	if err != nil {
@@ -209,5 +254,5 @@ func GenerateGenesisAccountFromFile(
 		finalBytecode = append(artifact.Bytecode, constructor...)
 	}
 
-	return getPredeployAccount(predeployAddress, finalBytecode, artifact.DeployedBytecode)
+	return getPredeployAccounts(config, predeployAddress, finalBytecode)
 }
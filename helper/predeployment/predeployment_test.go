@@ -0,0 +1,99 @@
+package predeployment
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func writeArtifact(t *testing.T, bytecode []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "artifact.json")
+	content := `{"abi": [], "bytecode": "` + hex.EncodeToHex(bytecode) + `"}`
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestGenerateGenesisAccounts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		premined  = types.StringToAddress("1000")
+		existing  = types.StringToAddress("1001")
+		predeploy = types.StringToAddress("1100")
+
+		// stores 1 into the slot 0
+		existingCode = []byte{0x60, 0x01, 0x60, 0x00, 0x55, 0x00}
+		// returns 42
+		deployedCode = []byte{0x60, 0x2a, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	)
+
+	// stores the balance of the premined account into the slot 0,
+	// calls the existing contract and returns the deployed code
+	bytecode := append([]byte{0x73}, premined.Bytes()...)
+	bytecode = append(bytecode, 0x31, 0x60, 0x00, 0x55)
+	bytecode = append(bytecode, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73)
+	bytecode = append(bytecode, existing.Bytes()...)
+	bytecode = append(bytecode, 0x5a, 0xf1, 0x50)
+	bytecode = append(bytecode, 0x69)
+	bytecode = append(bytecode, deployedCode...)
+	bytecode = append(bytecode, 0x60, 0x00, 0x52, 0x60, byte(len(deployedCode)), 0x60, byte(32-len(deployedCode)), 0xf3)
+
+	config := &chain.Chain{
+		Genesis: &chain.Genesis{
+			GasLimit: 5000000,
+			Alloc: map[types.Address]*chain.GenesisAccount{
+				premined: {Balance: big.NewInt(100)},
+				existing: {
+					Code:    existingCode,
+					Storage: map[types.Hash]types.Hash{types.StringToHash("1"): types.StringToHash("2")},
+				},
+			},
+		},
+		Params: &chain.Params{
+			ChainID: 100,
+			Forks:   chain.AllForksEnabled,
+		},
+	}
+
+	accounts, err := GenerateGenesisAccounts(config, writeArtifact(t, bytecode), nil, predeploy)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	// the code returned by the constructor is deployed
+	account := accounts[predeploy]
+	require.Equal(t, deployedCode, account.Code)
+	require.Equal(t, uint64(1), account.Nonce)
+	require.Zero(t, account.Balance.Sign())
+	require.Equal(t, map[types.Hash]types.Hash{types.ZeroHash: types.BytesToHash(big.NewInt(100).Bytes())}, account.Storage)
+
+	// the already allocated contract is modified by the constructor
+	account = accounts[existing]
+	require.Equal(t, existingCode, account.Code)
+	require.Equal(t, map[types.Hash]types.Hash{
+		types.ZeroHash:          types.StringToHash("1"),
+		types.StringToHash("1"): types.StringToHash("2"),
+	}, account.Storage)
+
+	// the address is already taken
+	_, err = GenerateGenesisAccounts(config, writeArtifact(t, bytecode), nil, existing)
+	require.ErrorIs(t, err, errAddressTaken)
+
+	// the constructor reverts
+	_, err = GenerateGenesisAccounts(config, writeArtifact(t, []byte{0x60, 0x00, 0x60, 0x00, 0xfd}), nil, predeploy)
+	require.ErrorContains(t, err, "EVM predeployment failed")
+
+	// the constructor doesn't return the code
+	_, err = GenerateGenesisAccounts(config, writeArtifact(t, []byte{0x00}), nil, predeploy)
+	require.ErrorIs(t, err, errEmptyDeployedCode)
+}
@@ -2,6 +2,7 @@ package progress
 
 import (
 	"sync"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 )
@@ -13,6 +14,29 @@ const (
 	ChainSyncBulk    ChainSyncType = "bulk-sync"
 )
 
+// SyncPhase is the phase of the ongoing sync
+type SyncPhase string
+
+const (
+	// SyncPhaseHeaders is fetching the headers of the blocks
+	SyncPhaseHeaders SyncPhase = "headers"
+	// SyncPhaseBodies is fetching the bodies of the blocks with the known headers
+	SyncPhaseBodies SyncPhase = "bodies"
+	// SyncPhaseBlocks is fetching the full blocks
+	SyncPhaseBlocks SyncPhase = "blocks"
+	// SyncPhaseState is executing the fetched blocks, building their state
+	SyncPhaseState SyncPhase = "state"
+)
+
+// SyncPeer is the peer the node syncs from
+type SyncPeer struct {
+	// ID is the peer ID
+	ID string
+
+	// Number is the latest block height of the peer
+	Number uint64
+}
+
 // Progression defines the status of the sync
 // progression of the node
 type Progression struct {
@@ -28,6 +52,21 @@ type Progression struct {
 
 	// HighestBlock is the target block in the sync batch
 	HighestBlock uint64
+
+	// Phase is the current phase of the sync, empty if the sync type has no phases
+	Phase SyncPhase
+
+	// BlocksPerSecond is the average number of the written blocks per second since the sync batch started
+	BlocksPerSecond float64
+
+	// ETA is the estimated time left to reach the highest block at the current rate, 0 if unknown
+	ETA time.Duration
+
+	// Peers are the peers the node syncs from, along with their latest block heights
+	Peers []SyncPeer
+
+	// DownloadedBytes is the number of the bytes downloaded since the sync batch started
+	DownloadedBytes uint64
 }
 
 type ProgressionWrapper struct {
@@ -39,6 +78,9 @@ type ProgressionWrapper struct {
 	// in progression tracking
 	stopCh chan struct{}
 
+	// startTime is the time the ongoing batch sync started
+	startTime time.Time
+
 	lock sync.RWMutex
 
 	syncType ChainSyncType
@@ -63,7 +105,9 @@ func (pw *ProgressionWrapper) StartProgression(
 	pw.progression = &Progression{
 		SyncType:      pw.syncType,
 		StartingBlock: startingBlock,
+		CurrentBlock:  startingBlock,
 	}
+	pw.startTime = time.Now()
 
 	go pw.RunUpdateLoop(subscription)
 }
@@ -120,10 +164,37 @@ func (pw *ProgressionWrapper) UpdateHighestProgression(highestBlock uint64) {
 	pw.progression.HighestBlock = highestBlock
 }
 
-// GetProgression returns the latest sync progression
+// UpdatePhase sets the current phase of the sync
+func (pw *ProgressionWrapper) UpdatePhase(phase SyncPhase) {
+	pw.lock.Lock()
+	defer pw.lock.Unlock()
+
+	if pw.progression != nil {
+		pw.progression.Phase = phase
+	}
+}
+
+// GetProgression returns a copy of the latest sync progression, along with the sync rate
+// and the estimated time left. Nil if no batch sync is in progress
 func (pw *ProgressionWrapper) GetProgression() *Progression {
 	pw.lock.RLock()
 	defer pw.lock.RUnlock()
 
-	return pw.progression
+	if pw.progression == nil {
+		return nil
+	}
+
+	progression := *pw.progression
+
+	if elapsed := time.Since(pw.startTime).Seconds(); elapsed > 0 &&
+		progression.CurrentBlock > progression.StartingBlock {
+		progression.BlocksPerSecond = float64(progression.CurrentBlock-progression.StartingBlock) / elapsed
+	}
+
+	if progression.BlocksPerSecond > 0 && progression.HighestBlock > progression.CurrentBlock {
+		remaining := float64(progression.HighestBlock-progression.CurrentBlock) / progression.BlocksPerSecond
+		progression.ETA = time.Duration(remaining * float64(time.Second))
+	}
+
+	return &progression
 }
@@ -0,0 +1,53 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressionWrapper_GetProgression(t *testing.T) {
+	t.Parallel()
+
+	pw := NewProgressionWrapper(ChainSyncBulk)
+	require.Nil(t, pw.GetProgression())
+
+	subscription := blockchain.NewMockSubscription()
+
+	pw.StartProgression(100, subscription)
+	pw.UpdateHighestProgression(300)
+	pw.UpdatePhase(SyncPhaseBodies)
+
+	// no blocks written yet, the rate is unknown
+	progression := pw.GetProgression()
+	assert.Equal(t, uint64(100), progression.CurrentBlock)
+	assert.Zero(t, progression.BlocksPerSecond)
+	assert.Zero(t, progression.ETA)
+
+	// pretend the sync runs for 10 seconds
+	pw.lock.Lock()
+	pw.startTime = time.Now().Add(-10 * time.Second)
+	pw.lock.Unlock()
+
+	subscription.Push(&blockchain.Event{NewChain: []*types.Header{{Number: 150}}})
+
+	require.Eventually(t, func() bool {
+		return pw.GetProgression().CurrentBlock == 150
+	}, 5*time.Second, 10*time.Millisecond)
+
+	progression = pw.GetProgression()
+	assert.Equal(t, SyncPhaseBodies, progression.Phase)
+	assert.InDelta(t, 5, progression.BlocksPerSecond, 0.1)
+	assert.InDelta(t, 30*time.Second, progression.ETA, float64(time.Second))
+
+	// the returned progression is a copy
+	progression.CurrentBlock = 0
+	assert.Equal(t, uint64(150), pw.GetProgression().CurrentBlock)
+
+	pw.StopProgression()
+	assert.Nil(t, pw.GetProgression())
+}
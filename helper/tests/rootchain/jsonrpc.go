@@ -0,0 +1,759 @@
+package rootchain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/umbracle/ethgo"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// JSON-RPC error codes
+const (
+	errCodeParse          = -32700
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+	errCodeExecution      = -32000
+)
+
+var (
+	errExecutionReverted = errors.New("execution reverted")
+	errBlockNotFound     = errors.New("block not found")
+)
+
+type request struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// txnArgs are the transaction arguments of eth_sendTransaction, eth_call and eth_estimateGas
+type txnArgs struct {
+	From     *types.Address `json:"from"`
+	To       *types.Address `json:"to"`
+	Gas      *string        `json:"gas"`
+	GasPrice *string        `json:"gasPrice"`
+	Value    *string        `json:"value"`
+	Input    *string        `json:"input"`
+	Data     *string        `json:"data"`
+	Nonce    *string        `json:"nonce"`
+}
+
+// receipt is the JSON-RPC transaction receipt
+type receipt struct {
+	TransactionHash   types.Hash     `json:"transactionHash"`
+	TransactionIndex  string         `json:"transactionIndex"`
+	BlockHash         types.Hash     `json:"blockHash"`
+	BlockNumber       string         `json:"blockNumber"`
+	From              types.Address  `json:"from"`
+	To                *types.Address `json:"to"`
+	GasUsed           string         `json:"gasUsed"`
+	CumulativeGasUsed string         `json:"cumulativeGasUsed"`
+	ContractAddress   *types.Address `json:"contractAddress"`
+	Logs              []*ethgo.Log   `json:"logs"`
+	LogsBloom         types.Bloom    `json:"logsBloom"`
+	Status            string         `json:"status"`
+}
+
+type method func(params []json.RawMessage) (interface{}, error)
+
+// handle serves the JSON-RPC requests
+func (r *Rootchain) handle(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	resp := &response{JSONRPC: "2.0"}
+
+	var rpcReq request
+	if err := json.NewDecoder(req.Body).Decode(&rpcReq); err != nil {
+		resp.Error = &rpcError{Code: errCodeParse, Message: err.Error()}
+	} else {
+		resp.ID = rpcReq.ID
+		resp.Result, resp.Error = r.call(rpcReq.Method, rpcReq.Params)
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		r.logger.Error("failed to write JSON-RPC response", "err", err)
+	}
+}
+
+// call invokes the JSON-RPC method
+func (r *Rootchain) call(name string, params []json.RawMessage) (json.RawMessage, *rpcError) {
+	methods := map[string]method{
+		"eth_chainId":               r.chainID,
+		"net_version":               r.netVersion,
+		"eth_accounts":              r.ethAccounts,
+		"eth_blockNumber":           r.blockNumber,
+		"eth_gasPrice":              r.gasPrice,
+		"eth_getBalance":            r.getBalance,
+		"eth_getTransactionCount":   r.getTransactionCount,
+		"eth_getCode":               r.getCode,
+		"eth_call":                  r.ethCall,
+		"eth_estimateGas":           r.estimateGas,
+		"eth_sendTransaction":       r.sendTransactionRPC,
+		"eth_sendRawTransaction":    r.sendRawTransaction,
+		"eth_getTransactionByHash":  r.getTransactionByHash,
+		"eth_getTransactionReceipt": r.getTransactionReceipt,
+		"eth_getBlockByNumber":      r.getBlockByNumber,
+		"eth_getBlockByHash":        r.getBlockByHash,
+		"eth_getLogs":               r.getLogs,
+	}
+
+	m, ok := methods[name]
+	if !ok {
+		return nil, &rpcError{Code: errCodeMethodNotFound, Message: fmt.Sprintf("method %s not found", name)}
+	}
+
+	r.lock.Lock()
+	result, err := m(params)
+	r.lock.Unlock()
+
+	if err != nil {
+		return nil, &rpcError{Code: errCodeExecution, Message: err.Error()}
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, &rpcError{Code: errCodeInvalidParams, Message: err.Error()}
+	}
+
+	return raw, nil
+}
+
+// decodeParams decodes the positional params, the missing trailing params are left unchanged
+func decodeParams(params []json.RawMessage, dst ...interface{}) error {
+	for i, raw := range params {
+		if i >= len(dst) {
+			break
+		}
+
+		if err := json.Unmarshal(raw, dst[i]); err != nil {
+			return fmt.Errorf("invalid param %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Rootchain) chainID(_ []json.RawMessage) (interface{}, error) {
+	return hex.EncodeUint64(r.config.ChainID), nil
+}
+
+func (r *Rootchain) netVersion(_ []json.RawMessage) (interface{}, error) {
+	return strconv.FormatUint(r.config.ChainID, 10), nil
+}
+
+func (r *Rootchain) ethAccounts(_ []json.RawMessage) (interface{}, error) {
+	accounts := make([]ethgo.Address, len(r.accounts))
+	for i := range r.accounts {
+		accounts[i] = r.Account(i)
+	}
+
+	return accounts, nil
+}
+
+func (r *Rootchain) blockNumber(_ []json.RawMessage) (interface{}, error) {
+	return hex.EncodeUint64(r.head().header.Number), nil
+}
+
+func (r *Rootchain) gasPrice(_ []json.RawMessage) (interface{}, error) {
+	return hex.EncodeUint64(DefaultGasPrice), nil
+}
+
+func (r *Rootchain) getBalance(params []json.RawMessage) (interface{}, error) {
+	transition, addr, err := r.accountState(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeBig(transition.GetBalance(addr)), nil
+}
+
+func (r *Rootchain) getTransactionCount(params []json.RawMessage) (interface{}, error) {
+	transition, addr, err := r.accountState(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeUint64(transition.GetNonce(addr)), nil
+}
+
+func (r *Rootchain) getCode(params []json.RawMessage) (interface{}, error) {
+	transition, addr, err := r.accountState(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeToHex(transition.GetCode(addr)), nil
+}
+
+// accountState decodes the address and block params and returns the state at the block
+func (r *Rootchain) accountState(params []json.RawMessage) (*state.Transition, types.Address, error) {
+	var (
+		addr   types.Address
+		number = "latest"
+	)
+
+	if err := decodeParams(params, &addr, &number); err != nil {
+		return nil, addr, err
+	}
+
+	b, err := r.blockByNumber(number)
+	if err != nil {
+		return nil, addr, err
+	}
+
+	transition, err := r.executor.BeginTxn(b.header.StateRoot, b.header, types.ZeroAddress)
+
+	return transition, addr, err
+}
+
+func (r *Rootchain) ethCall(params []json.RawMessage) (interface{}, error) {
+	args := &txnArgs{}
+	if err := decodeParams(params, args); err != nil {
+		return nil, err
+	}
+
+	result, err := r.apply(args, r.head().header.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Failed() {
+		return nil, executionError(result)
+	}
+
+	return hex.EncodeToHex(result.ReturnValue), nil
+}
+
+// estimateGas finds the lowest gas limit the transaction succeeds with
+func (r *Rootchain) estimateGas(params []json.RawMessage) (interface{}, error) {
+	args := &txnArgs{}
+	if err := decodeParams(params, args); err != nil {
+		return nil, err
+	}
+
+	gas, err := r.estimate(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeUint64(gas), nil
+}
+
+func (r *Rootchain) estimate(args *txnArgs) (uint64, error) {
+	lo, hi := state.TxGas-1, r.head().header.GasLimit
+
+	result, err := r.apply(args, hi)
+	if err != nil {
+		return 0, err
+	}
+
+	if result.Failed() {
+		return 0, executionError(result)
+	}
+
+	for lo+1 < hi {
+		mid := (lo + hi) / 2
+
+		if result, err := r.apply(args, mid); err == nil && !result.Failed() {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	return hi, nil
+}
+
+// apply executes the call on top of the latest block without persisting the state
+func (r *Rootchain) apply(args *txnArgs, gas uint64) (*runtime.ExecutionResult, error) {
+	head := r.head().header
+
+	header := head.Copy()
+	header.Number++
+
+	transition, err := r.executor.BeginTxn(head.StateRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := args.toTxn()
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Nonce = transition.GetNonce(msg.From)
+	msg.Gas = gas
+	msg.GasPrice = big.NewInt(0)
+
+	return transition.Apply(msg)
+}
+
+// sendTransactionRPC signs the transaction with the dev account and mines it
+func (r *Rootchain) sendTransactionRPC(params []json.RawMessage) (interface{}, error) {
+	args := &txnArgs{}
+	if err := decodeParams(params, args); err != nil {
+		return nil, err
+	}
+
+	tx, err := args.toTxn()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := r.key(tx.From)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Nonce == nil {
+		transition, err := r.executor.BeginTxn(r.head().header.StateRoot, r.head().header, types.ZeroAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		tx.Nonce = transition.GetNonce(tx.From)
+	}
+
+	if args.Gas == nil {
+		if tx.Gas, err = r.estimate(args); err != nil {
+			return nil, err
+		}
+	}
+
+	if args.GasPrice == nil || tx.GasPrice.Sign() == 0 {
+		tx.GasPrice = big.NewInt(DefaultGasPrice)
+	}
+
+	if tx, err = r.signer.SignTx(tx, key); err != nil {
+		return nil, err
+	}
+
+	return r.sendTransaction(tx)
+}
+
+func (r *Rootchain) sendRawTransaction(params []json.RawMessage) (interface{}, error) {
+	var raw string
+	if err := decodeParams(params, &raw); err != nil {
+		return nil, err
+	}
+
+	buf, err := hex.DecodeHex(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalRLP(buf); err != nil {
+		return nil, err
+	}
+
+	return r.sendTransaction(tx)
+}
+
+func (r *Rootchain) getTransactionByHash(params []json.RawMessage) (interface{}, error) {
+	var hash types.Hash
+	if err := decodeParams(params, &hash); err != nil {
+		return nil, err
+	}
+
+	lookup, ok := r.txs[hash]
+	if !ok {
+		return nil, nil
+	}
+
+	return toTransaction(lookup.block, lookup.index), nil
+}
+
+// getTransactionReceipt returns null for unknown transactions, the same as geth does
+func (r *Rootchain) getTransactionReceipt(params []json.RawMessage) (interface{}, error) {
+	var hash types.Hash
+	if err := decodeParams(params, &hash); err != nil {
+		return nil, err
+	}
+
+	lookup, ok := r.txs[hash]
+	if !ok {
+		return nil, nil
+	}
+
+	b, index := lookup.block, lookup.index
+	tx, rec := b.transactions[index], b.receipts[index]
+
+	logIndex := uint64(0)
+	for _, rec := range b.receipts[:index] {
+		logIndex += uint64(len(rec.Logs))
+	}
+
+	res := &receipt{
+		TransactionHash:   tx.Hash,
+		TransactionIndex:  hex.EncodeUint64(uint64(index)),
+		BlockHash:         b.header.Hash,
+		BlockNumber:       hex.EncodeUint64(b.header.Number),
+		From:              tx.From,
+		To:                tx.To,
+		GasUsed:           hex.EncodeUint64(rec.GasUsed),
+		CumulativeGasUsed: hex.EncodeUint64(rec.CumulativeGasUsed),
+		ContractAddress:   rec.ContractAddress,
+		Logs:              toLogs(b, index, logIndex),
+		LogsBloom:         rec.LogsBloom,
+		Status:            hex.EncodeUint64(uint64(*rec.Status)),
+	}
+
+	return res, nil
+}
+
+func (r *Rootchain) getBlockByNumber(params []json.RawMessage) (interface{}, error) {
+	var (
+		number string
+		full   bool
+	)
+
+	if err := decodeParams(params, &number, &full); err != nil {
+		return nil, err
+	}
+
+	b, err := r.blockByNumber(number)
+	if errors.Is(err, errBlockNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return toBlock(b, full), nil
+}
+
+// getBlockByHash returns null for unknown blocks, the block tracker relies on it on reorgs
+func (r *Rootchain) getBlockByHash(params []json.RawMessage) (interface{}, error) {
+	var (
+		hash types.Hash
+		full bool
+	)
+
+	if err := decodeParams(params, &hash, &full); err != nil {
+		return nil, err
+	}
+
+	b, ok := r.hashes[hash]
+	if !ok {
+		return nil, nil
+	}
+
+	return toBlock(b, full), nil
+}
+
+func (r *Rootchain) getLogs(params []json.RawMessage) (interface{}, error) {
+	var raw map[string]json.RawMessage
+	if err := decodeParams(params, &raw); err != nil {
+		return nil, err
+	}
+
+	// the block tags are not supported by the ethgo filter decoding, so they are resolved first
+	for _, key := range []string{"fromBlock", "toBlock"} {
+		var number string
+		if err := json.Unmarshal(raw[key], &number); err != nil || strings.HasPrefix(number, "0x") {
+			continue
+		}
+
+		b, err := r.blockByNumber(number)
+		if err != nil {
+			return nil, err
+		}
+
+		raw[key], _ = json.Marshal(hex.EncodeUint64(b.header.Number))
+	}
+
+	buf, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &ethgo.LogFilter{}
+	if err := filter.UnmarshalJSON(buf); err != nil {
+		return nil, err
+	}
+
+	var blocks []*block
+
+	if filter.BlockHash != nil {
+		b, ok := r.hashes[types.Hash(*filter.BlockHash)]
+		if !ok {
+			return nil, errBlockNotFound
+		}
+
+		blocks = []*block{b}
+	} else {
+		from, to := r.filterRange(filter.From), r.filterRange(filter.To)
+		if head := r.head().header.Number; to > head {
+			to = head
+		}
+
+		if from <= to {
+			blocks = r.blocks[from : to+1]
+		}
+	}
+
+	logs := []*ethgo.Log{}
+
+	for _, b := range blocks {
+		logIndex := uint64(0)
+
+		for i, rec := range b.receipts {
+			for _, log := range toLogs(b, i, logIndex) {
+				if matchLog(filter, log) {
+					logs = append(logs, log)
+				}
+			}
+
+			logIndex += uint64(len(rec.Logs))
+		}
+	}
+
+	return logs, nil
+}
+
+// filterRange returns the block number of the filter range bound, the latest block by default
+func (r *Rootchain) filterRange(number *ethgo.BlockNumber) uint64 {
+	if number == nil || *number < 0 {
+		return r.head().header.Number
+	}
+
+	return uint64(*number)
+}
+
+// blockByNumber returns the block with the given number, which can also be latest, pending or earliest
+func (r *Rootchain) blockByNumber(number string) (*block, error) {
+	switch number {
+	case "latest", "pending", "":
+		return r.head(), nil
+	case "earliest":
+		return r.blocks[0], nil
+	}
+
+	n, err := hex.DecodeUint64(number)
+	if err != nil {
+		return nil, err
+	}
+
+	if n >= uint64(len(r.blocks)) {
+		return nil, errBlockNotFound
+	}
+
+	return r.blocks[n], nil
+}
+
+// matchLog checks if the log matches the address and the topics of the filter
+func matchLog(filter *ethgo.LogFilter, log *ethgo.Log) bool {
+	if len(filter.Address) > 0 {
+		found := false
+
+		for _, addr := range filter.Address {
+			if addr == log.Address {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	for i, topics := range filter.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+
+		if i >= len(log.Topics) {
+			return false
+		}
+
+		found := false
+
+		for _, topic := range topics {
+			if topic == nil || *topic == log.Topics[i] {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// executionError returns the error of the failed execution
+func executionError(result *runtime.ExecutionResult) error {
+	if result.Reverted() {
+		return errExecutionReverted
+	}
+
+	return result.Err
+}
+
+// toTxn creates the transaction from the arguments, the sender is the first dev account by default
+func (args *txnArgs) toTxn() (*types.Transaction, error) {
+	tx := &types.Transaction{
+		Type:     types.LegacyTx,
+		To:       args.To,
+		GasPrice: big.NewInt(0),
+		Value:    big.NewInt(0),
+	}
+
+	var err error
+
+	if args.From != nil {
+		tx.From = *args.From
+	}
+
+	if args.Gas != nil {
+		if tx.Gas, err = types.ParseUint64orHex(args.Gas); err != nil {
+			return nil, err
+		}
+	}
+
+	if args.Nonce != nil {
+		if tx.Nonce, err = types.ParseUint64orHex(args.Nonce); err != nil {
+			return nil, err
+		}
+	}
+
+	if args.GasPrice != nil {
+		if tx.GasPrice, err = types.ParseUint256orHex(args.GasPrice); err != nil {
+			return nil, err
+		}
+	}
+
+	if args.Value != nil {
+		if tx.Value, err = types.ParseUint256orHex(args.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	input := args.Input
+	if input == nil {
+		input = args.Data
+	}
+
+	if input != nil {
+		if tx.Input, err = types.ParseBytes(input); err != nil {
+			return nil, err
+		}
+	}
+
+	return tx, nil
+}
+
+// toBlock converts the block to the ethgo block, which is encoded the same way as by geth
+func toBlock(b *block, full bool) *ethgo.Block {
+	header := b.header
+
+	res := &ethgo.Block{
+		Number:           header.Number,
+		Hash:             ethgo.Hash(header.Hash),
+		ParentHash:       ethgo.Hash(header.ParentHash),
+		Sha3Uncles:       ethgo.Hash(header.Sha3Uncles),
+		TransactionsRoot: ethgo.Hash(header.TxRoot),
+		StateRoot:        ethgo.Hash(header.StateRoot),
+		ReceiptsRoot:     ethgo.Hash(header.ReceiptsRoot),
+		Miner:            ethgo.BytesToAddress(header.Miner),
+		Difficulty:       new(big.Int).SetUint64(header.Difficulty),
+		ExtraData:        header.ExtraData,
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Timestamp:        header.Timestamp,
+		MixHash:          ethgo.Hash(header.MixHash),
+		Nonce:            header.Nonce,
+	}
+
+	for i, tx := range b.transactions {
+		if full {
+			res.Transactions = append(res.Transactions, toTransaction(b, i))
+		} else {
+			res.TransactionsHashes = append(res.TransactionsHashes, ethgo.Hash(tx.Hash))
+		}
+	}
+
+	return res
+}
+
+// toTransaction converts the mined transaction to the ethgo transaction
+func toTransaction(b *block, index int) *ethgo.Transaction {
+	tx := b.transactions[index]
+
+	res := &ethgo.Transaction{
+		Hash:        ethgo.Hash(tx.Hash),
+		From:        ethgo.Address(tx.From),
+		Input:       tx.Input,
+		GasPrice:    tx.GasPrice.Uint64(),
+		Gas:         tx.Gas,
+		Value:       tx.Value,
+		Nonce:       tx.Nonce,
+		V:           tx.V.Bytes(),
+		R:           tx.R.Bytes(),
+		S:           tx.S.Bytes(),
+		BlockHash:   ethgo.Hash(b.header.Hash),
+		BlockNumber: b.header.Number,
+		TxnIndex:    uint64(index),
+	}
+
+	if tx.To != nil {
+		to := ethgo.Address(*tx.To)
+		res.To = &to
+	}
+
+	return res
+}
+
+// toLogs converts the logs of the transaction receipt to the ethgo logs,
+// logIndex is the index of the first log within the block
+func toLogs(b *block, index int, logIndex uint64) []*ethgo.Log {
+	rec := b.receipts[index]
+	logs := make([]*ethgo.Log, len(rec.Logs))
+
+	for i, log := range rec.Logs {
+		topics := make([]ethgo.Hash, len(log.Topics))
+		for j, topic := range log.Topics {
+			topics[j] = ethgo.Hash(topic)
+		}
+
+		logs[i] = &ethgo.Log{
+			LogIndex:         logIndex + uint64(i),
+			TransactionIndex: uint64(index),
+			TransactionHash:  ethgo.Hash(b.transactions[index].Hash),
+			BlockHash:        ethgo.Hash(b.header.Hash),
+			BlockNumber:      b.header.Number,
+			Address:          ethgo.Address(log.Address),
+			Topics:           topics,
+			Data:             log.Data,
+		}
+	}
+
+	return logs
+}
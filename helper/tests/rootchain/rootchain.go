@@ -0,0 +1,434 @@
+package rootchain
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/fastrlp"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+)
+
+const (
+	// DefaultChainID is the chain id of the rootchain, the same as the one of the geth dev mode
+	DefaultChainID = 1337
+
+	// DefaultGasPrice is the gas price returned by eth_gasPrice and used for the transactions without one
+	DefaultGasPrice = 1879048192
+
+	// DefaultBlockGasLimit is the gas limit of the rootchain blocks
+	DefaultBlockGasLimit = 30_000_000
+
+	// defaultAddr is the address the JSON-RPC server is listening on, a random free port by default
+	defaultAddr = "127.0.0.1:0"
+)
+
+var (
+	// DevAccountBalance is the balance of the dev account at genesis
+	DevAccountBalance = new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)
+
+	// FundAmount is the amount sent to the funded accounts (1 ETH)
+	FundAmount = big.NewInt(1_000_000_000_000_000_000)
+
+	errUnknownAccount = errors.New("unknown account")
+	errAlreadyKnown   = errors.New("already known")
+)
+
+// forks are the forks enabled on the rootchain, London is disabled so the legacy transactions
+// sent by the relayer and the rootchain commands are priced the same way as on the geth dev mode
+var forks = &chain.Forks{
+	chain.Homestead:      chain.NewFork(0),
+	chain.EIP150:         chain.NewFork(0),
+	chain.EIP155:         chain.NewFork(0),
+	chain.EIP158:         chain.NewFork(0),
+	chain.Byzantium:      chain.NewFork(0),
+	chain.Constantinople: chain.NewFork(0),
+	chain.Petersburg:     chain.NewFork(0),
+	chain.Istanbul:       chain.NewFork(0),
+}
+
+// Config is the configuration of the in-process rootchain
+type Config struct {
+	// ChainID is the chain id of the rootchain
+	ChainID uint64
+
+	// Addr is the address the JSON-RPC server is listening on
+	Addr string
+
+	// BlockTime is the period of the empty blocks production, disabled if zero.
+	// The transactions are mined as soon as they are received regardless of it
+	BlockTime time.Duration
+
+	// Premine are the balances of the accounts at genesis, besides the dev account
+	Premine map[types.Address]*big.Int
+}
+
+// DefaultConfig returns the default configuration of the in-process rootchain
+func DefaultConfig() *Config {
+	return &Config{
+		ChainID:   DefaultChainID,
+		Addr:      defaultAddr,
+		BlockTime: 2 * time.Second,
+	}
+}
+
+// block is the mined rootchain block
+type block struct {
+	header       *types.Header
+	transactions []*types.Transaction
+	receipts     []*types.Receipt
+}
+
+// txLookup is the location of the mined transaction
+type txLookup struct {
+	block *block
+	index int
+}
+
+// Rootchain is the in-process rootchain (EVM chain with the Ethereum JSON-RPC API),
+// replacing the geth dev node in the bridge tests. Every transaction is mined in its own block
+// as soon as it is received, so its receipt is available right after it is sent
+type Rootchain struct {
+	logger   hclog.Logger
+	config   *Config
+	executor *state.Executor
+	signer   *crypto.EIP155Signer
+
+	// accounts are the dev accounts which transactions are signed by the rootchain (eth_sendTransaction)
+	accounts []*ecdsa.PrivateKey
+
+	lock   sync.Mutex
+	blocks []*block
+	hashes map[types.Hash]*block
+	txs    map[types.Hash]*txLookup
+
+	listener net.Listener
+	server   *http.Server
+	client   *jsonrpc.Client
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewRootchain creates the rootchain, writes its genesis and starts the JSON-RPC server
+func NewRootchain(logger hclog.Logger, config *Config) (*Rootchain, error) {
+	devKey, err := crypto.GenerateECDSAKey()
+	if err != nil {
+		return nil, err
+	}
+
+	params := &chain.Params{
+		ChainID: int64(config.ChainID),
+		Forks:   forks,
+	}
+
+	r := &Rootchain{
+		logger:   logger.Named("rootchain"),
+		config:   config,
+		executor: state.NewExecutor(params, itrie.NewState(itrie.NewMemoryStorage()), logger),
+		signer:   crypto.NewEIP155Signer(config.ChainID, true),
+		accounts: []*ecdsa.PrivateKey{devKey},
+		hashes:   map[types.Hash]*block{},
+		txs:      map[types.Hash]*txLookup{},
+		closeCh:  make(chan struct{}),
+	}
+
+	r.executor.GetHash = r.getHashHelper
+
+	if err := r.writeGenesis(); err != nil {
+		return nil, err
+	}
+
+	if r.listener, err = net.Listen("tcp", config.Addr); err != nil {
+		return nil, err
+	}
+
+	r.server = &http.Server{
+		Handler:           http.HandlerFunc(r.handle),
+		ReadHeaderTimeout: time.Minute,
+	}
+
+	go func() {
+		if err := r.server.Serve(r.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			r.logger.Error("JSON-RPC server stopped", "err", err)
+		}
+	}()
+
+	if r.client, err = jsonrpc.NewClient(r.HTTPAddr()); err != nil {
+		_ = r.server.Close()
+
+		return nil, err
+	}
+
+	if config.BlockTime > 0 {
+		go r.runBlockProduction()
+	}
+
+	r.logger.Info("Rootchain started", "addr", r.HTTPAddr(), "chain id", config.ChainID)
+
+	return r, nil
+}
+
+// NewTestRootchain creates the rootchain, which is closed on the test cleanup.
+// The default configuration is used if the config is nil
+func NewTestRootchain(t *testing.T, config *Config) *Rootchain {
+	t.Helper()
+
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	r, err := NewRootchain(hclog.NewNullLogger(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(r.Close)
+
+	return r
+}
+
+// Close stops the JSON-RPC server and the block production
+func (r *Rootchain) Close() {
+	r.closeOnce.Do(func() {
+		close(r.closeCh)
+
+		if err := r.server.Close(); err != nil {
+			r.logger.Error("failed to close JSON-RPC server", "err", err)
+		}
+
+		if err := r.client.Close(); err != nil {
+			r.logger.Error("failed to close JSON-RPC client", "err", err)
+		}
+	})
+}
+
+// HTTPAddr returns the JSON-RPC endpoint of the rootchain
+func (r *Rootchain) HTTPAddr() string {
+	return fmt.Sprintf("http://%s", r.listener.Addr().String())
+}
+
+// Account returns the address of the i-th dev account
+func (r *Rootchain) Account(i int) ethgo.Address {
+	return ethgo.Address(crypto.PubKeyToAddress(&r.accounts[i].PublicKey))
+}
+
+// Client returns the JSON-RPC client connected to the rootchain
+func (r *Rootchain) Client() *jsonrpc.Client {
+	return r.client
+}
+
+// Fund sends FundAmount from the dev account to the given address
+func (r *Rootchain) Fund(addr ethgo.Address) (*ethgo.Receipt, error) {
+	return r.SendTxn(&ethgo.Transaction{
+		To:    &addr,
+		Value: FundAmount,
+	})
+}
+
+// SendTxn sends the transaction signed by the dev account (unless the sender is set) and returns its receipt
+func (r *Rootchain) SendTxn(txn *ethgo.Transaction) (*ethgo.Receipt, error) {
+	if txn.From == ethgo.ZeroAddress {
+		txn.From = r.Account(0)
+	}
+
+	hash, err := r.client.Eth().SendTransaction(txn)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.Eth().GetTransactionReceipt(hash)
+}
+
+// writeGenesis writes the genesis state, which premines the dev accounts and the configured accounts
+func (r *Rootchain) writeGenesis() error {
+	alloc := map[types.Address]*chain.GenesisAccount{}
+
+	for _, key := range r.accounts {
+		alloc[crypto.PubKeyToAddress(&key.PublicKey)] = &chain.GenesisAccount{Balance: DevAccountBalance}
+	}
+
+	for addr, balance := range r.config.Premine {
+		alloc[addr] = &chain.GenesisAccount{Balance: balance}
+	}
+
+	root, err := r.executor.WriteGenesis(alloc, types.ZeroHash)
+	if err != nil {
+		return err
+	}
+
+	header := &types.Header{
+		Sha3Uncles:   types.EmptyUncleHash,
+		Miner:        types.ZeroAddress.Bytes(),
+		StateRoot:    root,
+		TxRoot:       types.EmptyRootHash,
+		ReceiptsRoot: types.EmptyRootHash,
+		Difficulty:   1,
+		GasLimit:     DefaultBlockGasLimit,
+		Timestamp:    uint64(time.Now().UTC().Unix()),
+	}
+	computeHash(header)
+
+	r.addBlock(&block{header: header})
+
+	return nil
+}
+
+// runBlockProduction mines the empty blocks, so the block confirmations advance without the transactions
+func (r *Rootchain) runBlockProduction() {
+	ticker := time.NewTicker(r.config.BlockTime)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case <-ticker.C:
+		}
+
+		r.lock.Lock()
+		_, err := r.mine(nil)
+		r.lock.Unlock()
+
+		if err != nil {
+			r.logger.Error("failed to mine empty block", "err", err)
+		}
+	}
+}
+
+// sendTransaction mines the transaction in a new block. The lock must be held by the caller
+func (r *Rootchain) sendTransaction(tx *types.Transaction) (types.Hash, error) {
+	tx.ComputeHash(r.head().header.Number + 1)
+
+	if _, ok := r.txs[tx.Hash]; ok {
+		return types.ZeroHash, errAlreadyKnown
+	}
+
+	if _, err := r.mine([]*types.Transaction{tx}); err != nil {
+		return types.ZeroHash, err
+	}
+
+	return tx.Hash, nil
+}
+
+// mine executes the transactions and adds the block with them to the chain.
+// The lock must be held by the caller
+func (r *Rootchain) mine(txs []*types.Transaction) (*block, error) {
+	parent := r.head().header
+
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Sha3Uncles: types.EmptyUncleHash,
+		Miner:      types.ZeroAddress.Bytes(),
+		Difficulty: 1,
+		Number:     parent.Number + 1,
+		GasLimit:   parent.GasLimit,
+		Timestamp:  uint64(time.Now().UTC().Unix()),
+	}
+
+	// the timestamps must be increasing, even if several blocks are mined within a second
+	if header.Timestamp <= parent.Timestamp {
+		header.Timestamp = parent.Timestamp + 1
+	}
+
+	transition, err := r.executor.BeginTxn(parent.StateRoot, header, types.ZeroAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		if err := transition.Write(tx); err != nil {
+			return nil, err
+		}
+	}
+
+	_, root, err := transition.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := transition.Receipts()
+
+	header.StateRoot = root
+	header.GasUsed = transition.TotalGas()
+	header.LogsBloom = types.CreateBloom(receipts)
+	header.TxRoot = types.EmptyRootHash
+	header.ReceiptsRoot = types.EmptyRootHash
+
+	if len(txs) > 0 {
+		header.TxRoot = buildroot.CalculateTransactionsRoot(txs, header.Number)
+		header.ReceiptsRoot = buildroot.CalculateReceiptsRoot(receipts)
+	}
+
+	computeHash(header)
+
+	b := &block{
+		header:       header,
+		transactions: txs,
+		receipts:     receipts,
+	}
+
+	r.addBlock(b)
+
+	return b, nil
+}
+
+// addBlock adds the block to the chain. The lock must be held by the caller
+func (r *Rootchain) addBlock(b *block) {
+	r.blocks = append(r.blocks, b)
+	r.hashes[b.header.Hash] = b
+
+	for i, tx := range b.transactions {
+		r.txs[tx.Hash] = &txLookup{block: b, index: i}
+	}
+}
+
+// head returns the latest block. The lock must be held by the caller
+func (r *Rootchain) head() *block {
+	return r.blocks[len(r.blocks)-1]
+}
+
+// getHashHelper is used by the EVM, so that the SC can get the hash of the header number.
+// It is called while the lock is held
+func (r *Rootchain) getHashHelper(header *types.Header) func(i uint64) types.Hash {
+	return func(i uint64) types.Hash {
+		if i >= header.Number || i >= uint64(len(r.blocks)) {
+			return types.ZeroHash
+		}
+
+		return r.blocks[i].header.Hash
+	}
+}
+
+// computeHash computes the Ethereum header hash. The types.HeaderHash is not used,
+// since it is overridden by the consensus engines running in the same process (e.g. in the unit tests)
+func computeHash(header *types.Header) {
+	header.Hash = types.BytesToHash(keccak.Keccak256Rlp(nil, header.MarshalRLPWith(&fastrlp.Arena{})))
+}
+
+// key returns the private key of the dev account
+func (r *Rootchain) key(addr types.Address) (*ecdsa.PrivateKey, error) {
+	for _, key := range r.accounts {
+		if crypto.PubKeyToAddress(&key.PublicKey) == addr {
+			return key, nil
+		}
+	}
+
+	return nil, errUnknownAccount
+}
@@ -0,0 +1,178 @@
+package rootchain
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestRootchain_StateSender(t *testing.T) {
+	t.Parallel()
+
+	r := NewTestRootchain(t, nil)
+
+	receipt, err := r.SendTxn(&ethgo.Transaction{Input: contractsapi.StateSender.Bytecode})
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.ReceiptSuccess), receipt.Status)
+
+	stateSender := receipt.ContractAddress
+
+	code, err := r.Client().Eth().GetCode(stateSender, ethgo.Latest)
+	require.NoError(t, err)
+	require.NotEqual(t, "0x", code)
+
+	input, err := (&contractsapi.SyncStateStateSenderFn{
+		Receiver: types.BytesToAddress(r.Account(0).Bytes()),
+		Data:     []byte{1, 2, 3},
+	}).EncodeAbi()
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		receipt, err = r.SendTxn(&ethgo.Transaction{To: &stateSender, Input: input})
+		require.NoError(t, err)
+		require.Equal(t, uint64(types.ReceiptSuccess), receipt.Status)
+		require.Len(t, receipt.Logs, 1)
+	}
+
+	// the transactions are mined in their own blocks
+	head, err := r.Client().Eth().BlockNumber()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, head, uint64(4))
+
+	block, err := r.Client().Eth().GetBlockByNumber(ethgo.BlockNumber(receipt.BlockNumber), false)
+	require.NoError(t, err)
+	require.Equal(t, receipt.BlockHash, block.Hash)
+	require.Equal(t, []ethgo.Hash{receipt.TransactionHash}, block.TransactionsHashes)
+
+	parent, err := r.Client().Eth().GetBlockByHash(block.ParentHash, false)
+	require.NoError(t, err)
+	require.Equal(t, block.Number-1, parent.Number)
+
+	// the state sync events are returned by the logs filter
+	from, to := ethgo.BlockNumber(0), ethgo.Latest
+
+	logs, err := r.Client().Eth().GetLogs(&ethgo.LogFilter{
+		Address: []ethgo.Address{stateSender},
+		From:    &from,
+		To:      &to,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	var event contractsapi.StateSyncedEvent
+
+	for i, log := range logs {
+		doesMatch, err := event.ParseLog(log)
+		require.NoError(t, err)
+		require.True(t, doesMatch)
+		require.Equal(t, uint64(i+1), event.ID.Uint64())
+		require.Equal(t, []byte{1, 2, 3}, event.Data)
+	}
+
+	logs, err = r.Client().Eth().GetLogs(&ethgo.LogFilter{
+		Address: []ethgo.Address{r.Account(0)},
+		From:    &from,
+		To:      &to,
+	})
+	require.NoError(t, err)
+	require.Empty(t, logs)
+}
+
+func TestRootchain_TxRelayer(t *testing.T) {
+	t.Parallel()
+
+	r := NewTestRootchain(t, nil)
+
+	relayer, err := txrelayer.NewTxRelayer(txrelayer.WithClient(r.Client()))
+	require.NoError(t, err)
+
+	key, err := wallet.GenerateKey()
+	require.NoError(t, err)
+
+	// fund the account from the dev account, the same way as the rootchain commands in the test mode
+	addr := key.Address()
+
+	receipt, err := relayer.SendTransactionLocal(&ethgo.Transaction{To: &addr, Value: FundAmount})
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.ReceiptSuccess), receipt.Status)
+
+	balance, err := r.Client().Eth().GetBalance(key.Address(), ethgo.Latest)
+	require.NoError(t, err)
+	require.Equal(t, FundAmount, balance)
+
+	// deploy the contract with the transaction signed by the account
+	receipt, err = relayer.SendTransaction(&ethgo.Transaction{Input: contractsapi.StateSender.Bytecode}, key)
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.ReceiptSuccess), receipt.Status)
+	require.Equal(t, key.Address(), receipt.From)
+
+	nonce, err := r.Client().Eth().GetNonce(key.Address(), ethgo.Latest)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), nonce)
+
+	// call the contract
+	input, err := contractsapi.StateSender.Abi.Methods["counter"].Encode([]interface{}{})
+	require.NoError(t, err)
+
+	output, err := relayer.Call(key.Address(), receipt.ContractAddress, input)
+	require.NoError(t, err)
+	require.Equal(t, "0x"+strings.Repeat("0", 64), output)
+
+	// the same transaction is not mined twice
+	txn, err := wallet.NewEIP155Signer(DefaultChainID).SignTx(&ethgo.Transaction{
+		To:       &addr,
+		Nonce:    1,
+		Gas:      state.TxGas,
+		GasPrice: DefaultGasPrice,
+	}, key)
+	require.NoError(t, err)
+
+	raw, err := txn.MarshalRLPTo(nil)
+	require.NoError(t, err)
+
+	_, err = r.Client().Eth().SendRawTransaction(raw)
+	require.NoError(t, err)
+
+	_, err = r.Client().Eth().SendRawTransaction(raw)
+	require.ErrorContains(t, err, errAlreadyKnown.Error())
+}
+
+func TestRootchain_BlockProduction(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig()
+	config.BlockTime = 50 * time.Millisecond
+	config.Premine = map[types.Address]*big.Int{types.StringToAddress("1"): big.NewInt(100)}
+
+	r := NewTestRootchain(t, config)
+
+	balance, err := r.Client().Eth().GetBalance(ethgo.Address(types.StringToAddress("1")), ethgo.Latest)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), balance)
+
+	// the empty blocks are produced without the transactions
+	require.Eventually(t, func() bool {
+		head, err := r.Client().Eth().BlockNumber()
+
+		return err == nil && head >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	block, err := r.Client().Eth().GetBlockByNumber(ethgo.Latest, false)
+	require.NoError(t, err)
+	require.Empty(t, block.TransactionsHashes)
+
+	r.Close()
+
+	_, err = r.Client().Eth().BlockNumber()
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// serviceName is the name under which the node spans are reported
+const serviceName = "polygon-edge"
+
+var ErrInvalidSampleRate = errors.New("tracing sample rate must be between 0 and 1")
+
+// Tracer returns the tracer of the given instrumented module.
+// Spans are not recorded unless the tracer provider is set up with NewTracerProvider
+func Tracer(module string) trace.Tracer {
+	return otel.Tracer(serviceName + "/" + module)
+}
+
+// NewTracerProvider creates the tracer provider which exports the sampled spans to the OTLP gRPC endpoint
+// and registers it globally. The sample rate is the fraction of the traces being sampled,
+// unless the parent span is sampled by the remote caller
+func NewTracerProvider(ctx context.Context, endpoint string, sampleRate float64) (*sdktrace.TracerProvider, error) {
+	if sampleRate < 0 || sampleRate > 1 {
+		return nil, ErrInvalidSampleRate
+	}
+
+	exporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(endpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRate))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider, nil
+}
+
+// EndSpan ends the span, marking it as failed if the error is not nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
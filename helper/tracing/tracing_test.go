@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestNewTracerProvider_InvalidSampleRate(t *testing.T) {
+	t.Parallel()
+
+	for _, rate := range []float64{-0.1, 1.1} {
+		_, err := NewTracerProvider(context.Background(), "localhost:4317", rate)
+		require.ErrorIs(t, err, ErrInvalidSampleRate)
+	}
+}
+
+func TestEndSpan(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "ok")
+	EndSpan(span, nil)
+
+	_, span = tracer.Start(context.Background(), "failed")
+	EndSpan(span, errors.New("failure"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+	require.Empty(t, spans[0].Events())
+
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+	require.Equal(t, "failure", spans[1].Status().Description)
+	require.Len(t, spans[1].Events(), 1)
+}
@@ -0,0 +1,190 @@
+package jsonrpc
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// signatureLength is the length of the signature (R || S || V)
+	signatureLength = 65
+	// recoveryIDOffset is the offset of V in the signature
+	recoveryIDOffset = 64
+)
+
+var (
+	// ErrUnknownAccount is returned if the node doesn't hold the key of the account
+	ErrUnknownAccount = errors.New("unknown account")
+	// ErrInvalidSignatureLength is returned if the signature to recover the signer from isn't 65 bytes long
+	ErrInvalidSignatureLength = errors.New("signature must be 65 bytes long")
+)
+
+// accountsStore provides access to the methods needed by the nonce manager
+type accountsStore interface {
+	// GetNonce returns the next nonce for this address
+	GetNonce(addr types.Address) uint64
+
+	// GetPendingTx gets the pending transaction from the transaction pool, if it's present
+	GetPendingTx(txHash types.Hash) (*types.Transaction, bool)
+
+	// ReadTxLookup returns a block hash in which a given txn was mined
+	ReadTxLookup(txnHash types.Hash) (types.Hash, bool)
+}
+
+// accountNonce is the nonce of the last transaction sent from the managed account
+type accountNonce struct {
+	lock sync.Mutex
+
+	sent   bool
+	nonce  uint64
+	txHash types.Hash
+}
+
+// accounts are the unlocked accounts the node signs the transactions and the messages for (e.g. the dev accounts).
+// The transactions of the account are serialized by its nonce manager, so the concurrent transactions
+// get the consecutive nonces, even before the earlier ones are promoted in the txpool
+type accounts struct {
+	addresses []types.Address
+	keys      map[types.Address]*ecdsa.PrivateKey
+	nonces    map[types.Address]*accountNonce
+}
+
+// newAccounts creates the accounts of the given keys
+func newAccounts(keys []*ecdsa.PrivateKey) *accounts {
+	a := &accounts{
+		addresses: make([]types.Address, 0, len(keys)),
+		keys:      make(map[types.Address]*ecdsa.PrivateKey, len(keys)),
+		nonces:    make(map[types.Address]*accountNonce, len(keys)),
+	}
+
+	for _, key := range keys {
+		addr := crypto.PubKeyToAddress(&key.PublicKey)
+		if _, exists := a.keys[addr]; exists {
+			continue
+		}
+
+		a.addresses = append(a.addresses, addr)
+		a.keys[addr] = key
+		a.nonces[addr] = &accountNonce{}
+	}
+
+	return a
+}
+
+// list returns the addresses of the accounts in the order of their keys
+func (a *accounts) list() []types.Address {
+	if a == nil {
+		return []types.Address{}
+	}
+
+	return a.addresses
+}
+
+// key returns the key of the account
+func (a *accounts) key(addr types.Address) (*ecdsa.PrivateKey, error) {
+	if a != nil {
+		if key, ok := a.keys[addr]; ok {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownAccount, addr)
+}
+
+// send sends the transaction of the account with the next nonce of the account, unless the nonce is given.
+// The sends of the same account are serialized
+func (a *accounts) send(
+	addr types.Address,
+	nonce *argUint64,
+	store accountsStore,
+	send func(nonce uint64) (*types.Transaction, error),
+) (*types.Transaction, error) {
+	if _, err := a.key(addr); err != nil {
+		return nil, err
+	}
+
+	account := a.nonces[addr]
+
+	account.lock.Lock()
+	defer account.lock.Unlock()
+
+	next := store.GetNonce(addr)
+
+	// the txpool nonce lags behind until the last transaction is promoted, it is trusted though
+	// if the last transaction is neither in the txpool nor in a block, as it must have been dropped
+	if account.sent && account.nonce >= next {
+		if _, ok := store.GetPendingTx(account.txHash); ok {
+			next = account.nonce + 1
+		} else if _, ok := store.ReadTxLookup(account.txHash); ok {
+			next = account.nonce + 1
+		}
+	}
+
+	if nonce != nil {
+		next = uint64(*nonce)
+	}
+
+	tx, err := send(next)
+	if err != nil {
+		return nil, err
+	}
+
+	if !account.sent || tx.Nonce >= account.nonce {
+		account.sent = true
+		account.nonce = tx.Nonce
+		account.txHash = tx.Hash
+	}
+
+	return tx, nil
+}
+
+// sign signs the EIP-191 hash of the data with the key of the account,
+// the V of the signature is 27 or 28
+func (a *accounts) sign(addr types.Address, data []byte) ([]byte, error) {
+	key, err := a.key(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := crypto.Sign(key, signHash(data))
+	if err != nil {
+		return nil, err
+	}
+
+	sig[recoveryIDOffset] += 27
+
+	return sig, nil
+}
+
+// ecRecover returns the address of the account which signed the EIP-191 hash of the data
+func ecRecover(data, sig []byte) (types.Address, error) {
+	if len(sig) != signatureLength {
+		return types.ZeroAddress, ErrInvalidSignatureLength
+	}
+
+	// the V of the signature is 27 or 28, but the legacy 0 or 1 is accepted too
+	recoverable := append([]byte{}, sig...)
+	if recoverable[recoveryIDOffset] >= 27 {
+		recoverable[recoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.RecoverPubkey(recoverable, signHash(data))
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return crypto.PubKeyToAddress(pub), nil
+}
+
+// signHash returns the EIP-191 hash of the data, which is signed by eth_sign and personal_sign.
+// The prefix prevents the signing of the transactions
+func signHash(data []byte) []byte {
+	msg := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(data), data)
+
+	return crypto.Keccak256([]byte(msg))
+}
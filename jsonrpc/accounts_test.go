@@ -0,0 +1,194 @@
+package jsonrpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSigningStore is the txpool which doesn't promote the added transactions,
+// so its nonce lags behind like the txpool nonce before the promotion
+type mockSigningStore struct {
+	*mockStore
+
+	lock      sync.Mutex
+	poolNonce uint64
+	added     map[types.Hash]*types.Transaction
+}
+
+func newMockSigningStore() *mockSigningStore {
+	return &mockSigningStore{
+		mockStore: newMockStore(),
+		added:     map[types.Hash]*types.Transaction{},
+	}
+}
+
+func (m *mockSigningStore) AddTxWithContext(_ context.Context, tx *types.Transaction) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tx.ComputeHash(0)
+	m.added[tx.Hash] = tx
+
+	return nil
+}
+
+func (m *mockSigningStore) GetNonce(types.Address) uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.poolNonce
+}
+
+func (m *mockSigningStore) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tx, ok := m.added[txHash]
+
+	return tx, ok
+}
+
+func (m *mockSigningStore) ReadTxLookup(types.Hash) (types.Hash, bool) {
+	return types.ZeroHash, false
+}
+
+func (m *mockSigningStore) GetForksInTime(uint64) chain.ForksInTime {
+	return chain.AllForksEnabled.At(0)
+}
+
+func (m *mockSigningStore) drop() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.added = map[types.Hash]*types.Transaction{}
+}
+
+func newTestSigningKey(t *testing.T) (*ecdsa.PrivateKey, types.Address) {
+	t.Helper()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	return key, crypto.PubKeyToAddress(&key.PublicKey)
+}
+
+func TestAccounts_SignAndRecover(t *testing.T) {
+	t.Parallel()
+
+	key, addr := newTestSigningKey(t)
+	signing := newAccounts([]*ecdsa.PrivateKey{key, key})
+
+	assert.Equal(t, []types.Address{addr}, signing.list())
+
+	sig, err := signing.sign(addr, []byte("hello"))
+	require.NoError(t, err)
+	require.Len(t, sig, signatureLength)
+	assert.Contains(t, []byte{27, 28}, sig[recoveryIDOffset])
+
+	signer, err := ecRecover([]byte("hello"), sig)
+	require.NoError(t, err)
+	assert.Equal(t, addr, signer)
+
+	_, err = ecRecover([]byte("hello"), sig[1:])
+	require.ErrorIs(t, err, ErrInvalidSignatureLength)
+
+	_, err = signing.sign(types.StringToAddress("1"), []byte("hello"))
+	require.ErrorIs(t, err, ErrUnknownAccount)
+
+	// the nodes without the keys don't have the accounts
+	var none *accounts
+
+	assert.Empty(t, none.list())
+}
+
+func TestEth_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	key, addr := newTestSigningKey(t)
+	store := newMockSigningStore()
+
+	eth := &Eth{
+		logger:   hclog.NewNullLogger(),
+		store:    store,
+		chainID:  100,
+		accounts: newAccounts([]*ecdsa.PrivateKey{key}),
+	}
+
+	newArgs := func() *txnArgs {
+		return &txnArgs{
+			From:     &addr,
+			To:       &addr,
+			Gas:      argUintPtr(21000),
+			GasPrice: argBytesPtr([]byte{0x01}),
+		}
+	}
+
+	// the concurrent transactions get the consecutive nonces
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		nonces []uint64
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			res, err := eth.SendTransaction(context.Background(), newArgs())
+			assert.NoError(t, err)
+
+			tx, ok := store.GetPendingTx(types.StringToHash(res.(string))) //nolint:forcetypeassert
+			assert.True(t, ok)
+
+			lock.Lock()
+			nonces = append(nonces, tx.Nonce)
+			lock.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	assert.Equal(t, []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, nonces)
+
+	// the transactions are signed by the account
+	for _, tx := range store.added {
+		signer, err := crypto.NewSigner(store.GetForksInTime(0), 100).Sender(tx)
+		require.NoError(t, err)
+		assert.Equal(t, addr, signer)
+	}
+
+	// the nonce of the txpool is used once the sent transactions are dropped
+	store.drop()
+
+	res, err := eth.SendTransaction(context.Background(), newArgs())
+	require.NoError(t, err)
+
+	tx, ok := store.GetPendingTx(types.StringToHash(res.(string))) //nolint:forcetypeassert
+	require.True(t, ok)
+	assert.Equal(t, uint64(0), tx.Nonce)
+
+	// the accounts not held by the node are rejected
+	other := types.StringToAddress("1")
+	args := newArgs()
+	args.From = &other
+
+	_, err = eth.SendTransaction(context.Background(), args)
+	require.ErrorIs(t, err, ErrUnknownAccount)
+
+	// the nodes without the keys don't support the method
+	_, err = (&Eth{store: store}).SendTransaction(context.Background(), newArgs())
+	require.ErrorContains(t, err, "use eth_sendRawTransaction instead")
+}
@@ -0,0 +1,303 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// APIKeyHeader is the HTTP header carrying the API key of the request
+	APIKeyHeader = "X-Api-Key"
+
+	// apiKeyRateWindow is the window the rate limit of the API key is accounted over
+	apiKeyRateWindow = time.Second
+)
+
+var (
+	ErrAPIKeyRequired         = errors.New("api key required")
+	ErrAPIKeyUnknown          = errors.New("unknown api key")
+	ErrAPIKeyMethodNotAllowed = errors.New("method not allowed for the api key")
+	ErrAPIKeyRateLimited      = errors.New("api key rate limit exceeded")
+)
+
+// APIKey is the JSON-RPC access granted to the holder of the key
+type APIKey struct {
+	// Key is the secret the clients present in the X-Api-Key header
+	Key string `json:"key" yaml:"key"`
+	// Name identifies the key in the logs and the metrics
+	Name string `json:"name" yaml:"name"`
+	// Methods are the allowed methods (e.g. eth_call), namespaces (e.g. eth_*) or * for all of them.
+	// All of the methods are allowed if empty
+	Methods []string `json:"methods" yaml:"methods"`
+	// RateLimit is the max number of requests per second, zero disables the limit
+	RateLimit uint64 `json:"rate_limit" yaml:"rate_limit"`
+}
+
+// validate checks the key and its method patterns
+func (k *APIKey) validate() error {
+	if k.Key == "" {
+		return errors.New("api key is empty")
+	}
+
+	if k.Name == "" {
+		return errors.New("api key name is empty")
+	}
+
+	for _, method := range k.Methods {
+		if method == "*" {
+			continue
+		}
+
+		// either the method (eth_call) or the namespace (eth_*)
+		name := strings.TrimSuffix(method, "*")
+		if strings.Contains(name, "*") || strings.Index(name, "_") < 1 ||
+			(name != method && !strings.HasSuffix(name, "_")) {
+			return fmt.Errorf("invalid method %q of api key %s", method, k.Name)
+		}
+	}
+
+	return nil
+}
+
+// allows returns whether the method is allowed for the key
+func (k *APIKey) allows(method string) bool {
+	if len(k.Methods) == 0 {
+		return true
+	}
+
+	for _, allowed := range k.Methods {
+		if allowed == "*" || allowed == method {
+			return true
+		}
+
+		if prefix := strings.TrimSuffix(allowed, "*"); prefix != allowed && strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// APIKeyStatus is the API key along with its usage since the node start
+type APIKeyStatus struct {
+	APIKey
+
+	// Requests is the number of the served requests
+	Requests uint64 `json:"requests"`
+	// Rejected is the number of the requests rejected due to the permissions or the rate limit
+	Rejected uint64 `json:"rejected"`
+}
+
+type apiKeyEntry struct {
+	lock sync.Mutex
+
+	key APIKey
+
+	// number of the requests in the current rate limit window
+	windowStart    time.Time
+	windowRequests uint64
+
+	requests uint64
+	rejected uint64
+}
+
+// status returns the copy of the key along with its usage
+func (e *apiKeyEntry) status() *APIKeyStatus {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return &APIKeyStatus{
+		APIKey: APIKey{
+			Key:       e.key.Key,
+			Name:      e.key.Name,
+			Methods:   append([]string{}, e.key.Methods...),
+			RateLimit: e.key.RateLimit,
+		},
+		Requests: e.requests,
+		Rejected: e.rejected,
+	}
+}
+
+// APIKeyManager authorizes the JSON-RPC requests by their API keys. The keys are either configured
+// or provisioned at runtime by the operator, in which case they are kept until the node restart
+type APIKeyManager struct {
+	lock sync.RWMutex
+
+	// required rejects the requests without the key, otherwise they are served without restrictions
+	required bool
+	keys     map[string]*apiKeyEntry
+
+	now func() time.Time
+}
+
+// NewAPIKeyManager creates the manager with the configured keys
+func NewAPIKeyManager(required bool, keys []*APIKey) (*APIKeyManager, error) {
+	m := &APIKeyManager{
+		required: required,
+		keys:     make(map[string]*apiKeyEntry, len(keys)),
+		now:      time.Now,
+	}
+
+	for _, key := range keys {
+		if _, exists := m.keys[key.Key]; exists {
+			return nil, fmt.Errorf("api key %s is configured more than once", key.Name)
+		}
+
+		if err := m.SetKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// SetKey adds the key or updates its name, permissions and rate limit, keeping the usage
+func (m *APIKeyManager) SetKey(key *APIKey) error {
+	if err := key.validate(); err != nil {
+		return err
+	}
+
+	methods := make([]string, len(key.Methods))
+	copy(methods, key.Methods)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entry, exists := m.keys[key.Key]
+	if !exists {
+		m.keys[key.Key] = &apiKeyEntry{
+			key: APIKey{Key: key.Key, Name: key.Name, Methods: methods, RateLimit: key.RateLimit},
+		}
+
+		return nil
+	}
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	entry.key.Name = key.Name
+	entry.key.Methods = methods
+	entry.key.RateLimit = key.RateLimit
+
+	return nil
+}
+
+// RemoveKey removes the key, returning false if it doesn't exist
+func (m *APIKeyManager) RemoveKey(key string) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	_, exists := m.keys[key]
+	delete(m.keys, key)
+
+	return exists
+}
+
+// Keys returns the keys with their usage, sorted by their names
+func (m *APIKeyManager) Keys() []*APIKeyStatus {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	keys := make([]*APIKeyStatus, 0, len(m.keys))
+
+	for _, entry := range m.keys {
+		keys = append(keys, entry.status())
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Name != keys[j].Name {
+			return keys[i].Name < keys[j].Name
+		}
+
+		return keys[i].Key < keys[j].Key
+	})
+
+	return keys
+}
+
+// Key returns the key with its usage, false if it doesn't exist
+func (m *APIKeyManager) Key(key string) (*APIKeyStatus, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	entry, ok := m.keys[key]
+	if !ok {
+		return nil, false
+	}
+
+	return entry.status(), true
+}
+
+// Authorize checks that the method is allowed for the key and the key is within its rate limit,
+// accounting the request to the key. The empty key is allowed unless the key is required
+func (m *APIKeyManager) Authorize(key, method string) error {
+	if key == "" {
+		if m.required {
+			return ErrAPIKeyRequired
+		}
+
+		return nil
+	}
+
+	m.lock.RLock()
+	entry, ok := m.keys[key]
+	m.lock.RUnlock()
+
+	if !ok {
+		return ErrAPIKeyUnknown
+	}
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	err := m.authorize(entry, method)
+	if err != nil {
+		entry.rejected++
+		metrics.IncrCounterWithLabels([]string{jsonRPCMetric, "api_key_rejected"}, 1,
+			[]metrics.Label{{Name: "key", Value: entry.key.Name}})
+
+		return err
+	}
+
+	entry.requests++
+	metrics.IncrCounterWithLabels([]string{jsonRPCMetric, "api_key_requests"}, 1,
+		[]metrics.Label{{Name: "key", Value: entry.key.Name}})
+
+	return nil
+}
+
+// authorize checks the permissions and the rate limit of the key [NOT Thread Safe]
+func (m *APIKeyManager) authorize(entry *apiKeyEntry, method string) error {
+	if !entry.key.allows(method) {
+		return ErrAPIKeyMethodNotAllowed
+	}
+
+	if entry.key.RateLimit == 0 {
+		return nil
+	}
+
+	if now := m.now(); now.Sub(entry.windowStart) >= apiKeyRateWindow {
+		entry.windowStart = now
+		entry.windowRequests = 0
+	}
+
+	if entry.windowRequests >= entry.key.RateLimit {
+		return ErrAPIKeyRateLimited
+	}
+
+	entry.windowRequests++
+
+	return nil
+}
+
+// getAPIKey returns the API key supplied by the client in the request header
+func getAPIKey(req *http.Request) string {
+	return req.Header.Get(APIKeyHeader)
+}
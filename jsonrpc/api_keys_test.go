@@ -0,0 +1,117 @@
+package jsonrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKey_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		methods []string
+		valid   bool
+	}{
+		{nil, true},
+		{[]string{"*"}, true},
+		{[]string{"eth_call", "net_*"}, true},
+		{[]string{"eth"}, false},
+		{[]string{"_call"}, false},
+		{[]string{"eth*"}, false},
+		{[]string{"eth_*_call"}, false},
+		{[]string{"*_call"}, false},
+	}
+
+	for _, c := range cases {
+		err := (&APIKey{Key: "key", Name: "name", Methods: c.methods}).validate()
+		assert.Equal(t, c.valid, err == nil, c.methods)
+	}
+
+	assert.Error(t, (&APIKey{Name: "name"}).validate())
+	assert.Error(t, (&APIKey{Key: "key"}).validate())
+}
+
+func TestAPIKey_Allows(t *testing.T) {
+	t.Parallel()
+
+	key := &APIKey{Methods: []string{"eth_call", "net_*"}}
+
+	assert.True(t, key.allows("eth_call"))
+	assert.True(t, key.allows("net_version"))
+	assert.False(t, key.allows("eth_sendRawTransaction"))
+	assert.False(t, key.allows("debug_traceTransaction"))
+
+	assert.True(t, (&APIKey{}).allows("debug_traceTransaction"))
+	assert.True(t, (&APIKey{Methods: []string{"*"}}).allows("debug_traceTransaction"))
+}
+
+func TestAPIKeyManager_Authorize(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewAPIKeyManager(false, []*APIKey{
+		{Key: "key-1", Name: "limited", Methods: []string{"eth_*"}, RateLimit: 2},
+	})
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	m.now = func() time.Time { return now }
+
+	// the requests without the key are served, unless the key is required
+	require.NoError(t, m.Authorize("", "debug_traceTransaction"))
+	require.ErrorIs(t, m.Authorize("key-2", "eth_call"), ErrAPIKeyUnknown)
+	require.ErrorIs(t, m.Authorize("key-1", "debug_traceTransaction"), ErrAPIKeyMethodNotAllowed)
+
+	require.NoError(t, m.Authorize("key-1", "eth_call"))
+	require.NoError(t, m.Authorize("key-1", "eth_call"))
+	require.ErrorIs(t, m.Authorize("key-1", "eth_call"), ErrAPIKeyRateLimited)
+
+	// the rate limit is reset in the next window
+	now = now.Add(apiKeyRateWindow)
+	require.NoError(t, m.Authorize("key-1", "eth_call"))
+
+	key, ok := m.Key("key-1")
+	require.True(t, ok)
+	assert.Equal(t, uint64(3), key.Requests)
+	assert.Equal(t, uint64(2), key.Rejected)
+
+	m.required = true
+	require.ErrorIs(t, m.Authorize("", "eth_call"), ErrAPIKeyRequired)
+}
+
+func TestAPIKeyManager_SetAndRemoveKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewAPIKeyManager(false, []*APIKey{
+		{Key: "key-1", Name: "first"},
+		{Key: "key-1", Name: "second"},
+	})
+	require.Error(t, err)
+
+	m, err := NewAPIKeyManager(false, nil)
+	require.NoError(t, err)
+
+	require.Error(t, m.SetKey(&APIKey{Key: "key-1", Name: "first", Methods: []string{"eth"}}))
+	require.NoError(t, m.SetKey(&APIKey{Key: "key-1", Name: "first", Methods: []string{"eth_*"}}))
+	require.NoError(t, m.SetKey(&APIKey{Key: "key-2", Name: "second"}))
+
+	require.NoError(t, m.Authorize("key-1", "eth_call"))
+	require.ErrorIs(t, m.Authorize("key-1", "net_version"), ErrAPIKeyMethodNotAllowed)
+
+	// the update keeps the usage
+	require.NoError(t, m.SetKey(&APIKey{Key: "key-1", Name: "renamed"}))
+	require.NoError(t, m.Authorize("key-1", "net_version"))
+
+	keys := m.Keys()
+	require.Len(t, keys, 2)
+	assert.Equal(t, "renamed", keys[0].Name)
+	assert.Equal(t, uint64(2), keys[0].Requests)
+	assert.Equal(t, uint64(1), keys[0].Rejected)
+	assert.Equal(t, "second", keys[1].Name)
+
+	assert.True(t, m.RemoveKey("key-1"))
+	assert.False(t, m.RemoveKey("key-1"))
+	require.ErrorIs(t, m.Authorize("key-1", "eth_call"), ErrAPIKeyUnknown)
+}
@@ -8,6 +8,7 @@ import (
 type bridgeStore interface {
 	GenerateExitProof(exitID uint64) (types.Proof, error)
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
+	GetStateSyncTimeline(stateSyncID uint64) (*types.BridgeMessageTimeline, error)
 }
 
 // Bridge is the bridge jsonrpc endpoint
@@ -24,3 +25,9 @@ func (b *Bridge) GenerateExitProof(exitID argUint64) (interface{}, error) {
 func (b *Bridge) GetStateSyncProof(stateSyncID argUint64) (interface{}, error) {
 	return b.store.GetStateSyncProof(uint64(stateSyncID))
 }
+
+// GetStateSyncTimeline retrieves the times the StateSync reached each of the bridge stages
+// (rootchain event, observed, quorum, committed and executed), along with the latency of each stage
+func (b *Bridge) GetStateSyncTimeline(stateSyncID argUint64) (interface{}, error) {
+	return b.store.GetStateSyncTimeline(uint64(stateSyncID))
+}
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -30,7 +31,7 @@ func TestBridgeEndpoint(t *testing.T) {
 		"id": 1
 	}`)
 
-	data, err := dispatcher.HandleWs(msg, mockConnection)
+	data, err := dispatcher.HandleWs(context.Background(), msg, mockConnection, "", "")
 	require.NoError(t, err)
 
 	resp := new(SuccessResponse)
@@ -44,11 +45,27 @@ func TestBridgeEndpoint(t *testing.T) {
 		"id": 1
 	}`)
 
-	data, err = dispatcher.HandleWs(msg, mockConnection)
+	data, err = dispatcher.HandleWs(context.Background(), msg, mockConnection, "", "")
 	require.NoError(t, err)
 
 	resp = new(SuccessResponse)
 	require.NoError(t, json.Unmarshal(data, resp))
 	require.Nil(t, resp.Error)
 	require.NotNil(t, resp.Result)
+
+	msg = []byte(`{
+		"method": "bridge_getStateSyncTimeline",
+		"params": ["0x1"],
+		"id": 1
+	}`)
+
+	data, err = dispatcher.HandleWs(context.Background(), msg, mockConnection, "", "")
+	require.NoError(t, err)
+
+	resp = new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+	require.Nil(t, resp.Error)
+	require.JSONEq(t,
+		`{"id":1,"stages":[{"stage":"observed","time":"2023-01-01T00:00:00Z","latency":0}]}`,
+		string(resp.Result))
 }
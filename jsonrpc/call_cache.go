@@ -0,0 +1,107 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// callCacheResult is the cached response of the eth_call or eth_estimateGas request
+type callCacheResult struct {
+	value interface{}
+	err   error
+}
+
+// callCache caches the responses of the eth_call and eth_estimateGas requests. The responses are keyed
+// by the method, the block hash and the request parameters. The cache is purged once the chain head changes,
+// so it holds only the responses computed since the latest block. The nil cache is disabled
+type callCache struct {
+	cache *lru.Cache
+
+	lock sync.Mutex
+	head types.Hash
+}
+
+// newCallCache creates the call cache of the given size, the cache is disabled if the size is zero
+func newCallCache(size uint64) *callCache {
+	if size == 0 {
+		return nil
+	}
+
+	// lru.New only fails for a non-positive size
+	cache, _ := lru.New(int(size))
+
+	return &callCache{cache: cache}
+}
+
+// get returns the cached response of the request, if the chain head hasn't changed since it was cached
+func (c *callCache) get(head, key types.Hash) (*callCacheResult, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.updateHead(head)
+
+	value, ok := c.cache.Get(key)
+	if !ok {
+		metrics.IncrCounter([]string{jsonRPCMetric, "call_cache_misses"}, 1)
+
+		return nil, false
+	}
+
+	metrics.IncrCounter([]string{jsonRPCMetric, "call_cache_hits"}, 1)
+
+	result, ok := value.(*callCacheResult)
+
+	return result, ok
+}
+
+// add caches the response of the request computed at the given chain head
+func (c *callCache) add(head, key types.Hash, value interface{}, err error) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// the chain head changed while the response was computed
+	if c.head != head {
+		return
+	}
+
+	c.cache.Add(key, &callCacheResult{value: value, err: err})
+}
+
+// updateHead purges the cache if the chain head changed. The cached responses of the previous heads
+// remain valid, since they are keyed by the block hash, but they are rarely requested again
+func (c *callCache) updateHead(head types.Hash) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.head == head {
+		return
+	}
+
+	c.head = head
+	c.cache.Purge()
+}
+
+// newCallCacheKey returns the cache key of the request of the given method, executed on the given block
+func newCallCacheKey(method string, blockHash types.Hash, params ...interface{}) (types.Hash, error) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	buf := make([]byte, 0, len(method)+types.HashLength+len(raw))
+	buf = append(buf, method...)
+	buf = append(buf, blockHash.Bytes()...)
+	buf = append(buf, raw...)
+
+	return types.BytesToHash(keccak.Keccak256(nil, buf)), nil
+}
@@ -0,0 +1,147 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type countingCallStore struct {
+	*mockBlockStore
+	calls int
+
+	pendingCalls []*types.Transaction
+	pendingNonce uint64
+}
+
+func (m *countingCallStore) ApplyStaticTxn(ctx context.Context, header *types.Header, txn *types.Transaction,
+	overrides types.StateOverride) (*runtime.ExecutionResult, error) {
+	m.calls++
+
+	return m.mockBlockStore.ApplyStaticTxn(ctx, header, txn, overrides)
+}
+
+func (m *countingCallStore) ApplyPendingTxn(ctx context.Context, txn *types.Transaction,
+	overrides types.StateOverride) (*runtime.ExecutionResult, error) {
+	m.pendingCalls = append(m.pendingCalls, txn)
+
+	return m.mockBlockStore.ApplyTxn(ctx, m.Header(), txn, overrides)
+}
+
+func (m *countingCallStore) GetNonce(types.Address) uint64 {
+	return m.pendingNonce
+}
+
+func TestEth_Call_Cache(t *testing.T) {
+	t.Parallel()
+
+	store := &countingCallStore{mockBlockStore: newMockBlockStore()}
+	store.add(newTestBlock(100, hash1))
+	store.returnValue = []byte{0x1}
+
+	eth := newTestEthEndpoint(store)
+	eth.callCache = newCallCache(16)
+
+	newCall := func(to types.Address) *txnArgs {
+		return &txnArgs{
+			From:  &addr0,
+			To:    &to,
+			Gas:   argUintPtr(100000),
+			Nonce: argUintPtr(0),
+		}
+	}
+
+	// the identical requests are executed once per block
+	for i := 0; i < 3; i++ {
+		res, err := eth.Call(context.Background(), newCall(addr1), BlockNumberOrHash{}, nil)
+		require.NoError(t, err)
+		require.Equal(t, argBytesPtr([]byte{0x1}), res)
+	}
+
+	require.Equal(t, 1, store.calls)
+
+	// the different requests are executed
+	_, err := eth.Call(context.Background(), newCall(addr2), BlockNumberOrHash{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, store.calls)
+
+	// the reverted executions are cached with their errors
+	store.ethCallError = runtime.ErrExecutionReverted
+
+	for i := 0; i < 2; i++ {
+		_, err = eth.Call(context.Background(), newCall(types.StringToAddress("3")), BlockNumberOrHash{}, nil)
+		require.ErrorIs(t, err, runtime.ErrExecutionReverted)
+	}
+
+	require.Equal(t, 3, store.calls)
+
+	// the other failures aren't cached
+	store.ethCallError = errors.New("failure")
+
+	for i := 0; i < 2; i++ {
+		_, err = eth.Call(context.Background(), newCall(types.StringToAddress("4")), BlockNumberOrHash{}, nil)
+		require.Error(t, err)
+	}
+
+	require.Equal(t, 5, store.calls)
+
+	// the cache is purged on the new head
+	store.ethCallError = nil
+	store.add(newTestBlock(101, hash2))
+
+	_, err = eth.Call(context.Background(), newCall(addr1), BlockNumberOrHash{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 6, store.calls)
+	require.Equal(t, 1, eth.callCache.cache.Len())
+}
+
+func TestEth_Call_Pending(t *testing.T) {
+	t.Parallel()
+
+	store := &countingCallStore{mockBlockStore: newMockBlockStore(), pendingNonce: 5}
+	store.add(newTestBlock(100, hash1))
+	store.returnValue = []byte{0x1}
+
+	eth := newTestEthEndpoint(store)
+	eth.callCache = newCallCache(16)
+
+	pending, _ := createBlockNumberPointer("pending")
+
+	// the pending calls are executed on top of the pending state each time
+	for i := 0; i < 2; i++ {
+		res, err := eth.Call(context.Background(), &txnArgs{From: &addr0, To: &addr1}, BlockNumberOrHash{BlockNumber: pending}, nil)
+		require.NoError(t, err)
+		require.Equal(t, argBytesPtr([]byte{0x1}), res)
+	}
+
+	require.Equal(t, 0, store.calls)
+	require.Len(t, store.pendingCalls, 2)
+	require.Equal(t, 0, eth.callCache.cache.Len())
+
+	// the sender's nonce is the pending one
+	require.Equal(t, uint64(5), store.pendingCalls[0].Nonce)
+
+	// the explicit nonce is kept
+	_, err := eth.Call(context.Background(), &txnArgs{From: &addr0, To: &addr1, Nonce: argUintPtr(3)},
+		BlockNumberOrHash{BlockNumber: pending}, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), store.pendingCalls[2].Nonce)
+}
+
+func TestCallCache_Disabled(t *testing.T) {
+	t.Parallel()
+
+	cache := newCallCache(0)
+	require.Nil(t, cache)
+
+	// the disabled cache is a no-op
+	cache.add(hash1, hash2, nil, nil)
+
+	_, ok := cache.get(hash1, hash2)
+	require.False(t, ok)
+}
@@ -98,9 +98,13 @@ func (e *ObjectError) Error() string {
 func (e *ObjectError) MarshalJSON() ([]byte, error) {
 	var ds string
 
-	data, ok := e.Data.([]byte)
-	if ok && len(data) > 0 {
-		ds = "0x" + string(data)
+	switch data := e.Data.(type) {
+	case []byte:
+		if len(data) > 0 {
+			ds = "0x" + string(data)
+		}
+	case string:
+		ds = data
 	}
 
 	return json.Marshal(&struct {
@@ -175,7 +179,9 @@ func stringToBlockNumber(str string) (BlockNumber, error) {
 
 	str = strings.Trim(str, "\"")
 	switch str {
-	case pending, latest:
+	case pending:
+		return PendingBlockNumber, nil
+	case latest:
 		return LatestBlockNumber, nil
 	case earliest:
 		return EarliestBlockNumber, nil
@@ -225,13 +231,20 @@ func NewRPCErrorResponse(id interface{}, errCode int, err string, data []byte, j
 
 // NewRPCResponse returns Success/Error response object
 func NewRPCResponse(id interface{}, jsonrpcver string, reply []byte, err Error) Response {
-	var response Response
-	switch err.(type) {
-	case nil:
-		response = &SuccessResponse{JSONRPC: jsonrpcver, ID: id, Result: reply}
-	default:
-		response = NewRPCErrorResponse(id, err.ErrorCode(), err.Error(), reply, jsonrpcver)
+	if err == nil {
+		return &SuccessResponse{JSONRPC: jsonrpcver, ID: id, Result: reply}
 	}
 
-	return response
+	// the data of the error takes precedence over the reply
+	if dataErr, ok := err.(DataError); ok {
+		if data := dataErr.ErrorData(); data != nil {
+			return &ErrorResponse{
+				JSONRPC: jsonrpcver,
+				ID:      id,
+				Error:   &ObjectError{Code: err.ErrorCode(), Message: err.Error(), Data: data},
+			}
+		}
+	}
+
+	return NewRPCErrorResponse(id, err.ErrorCode(), err.Error(), reply, jsonrpcver)
 }
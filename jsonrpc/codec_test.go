@@ -16,6 +16,7 @@ func TestBlockNumberOrHash_UnmarshalJSON(t *testing.T) {
 
 	blockNumberZero := BlockNumber(0x0)
 	blockNumberLatest := LatestBlockNumber
+	blockNumberPending := PendingBlockNumber
 
 	tests := []struct {
 		name        string
@@ -58,6 +59,14 @@ func TestBlockNumberOrHash_UnmarshalJSON(t *testing.T) {
 				BlockNumber: &blockNumberLatest,
 			},
 		},
+		{
+			"should unmarshal pending block number properly",
+			`"pending"`,
+			false,
+			BlockNumberOrHash{
+				BlockNumber: &blockNumberPending,
+			},
+		},
 		{
 			"should unmarshal block number 0 properly #1",
 			`{"blockNumber": "0x0"}`,
@@ -2,12 +2,16 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/builtin"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -21,6 +25,8 @@ var (
 	ErrTraceGenesisBlock = errors.New("genesis is not traceable")
 	// ErrNoConfig is an error returns when config is empty
 	ErrNoConfig = errors.New("missing config object")
+	// ErrUnknownTracer is an error returned when the requested tracer is not registered
+	ErrUnknownTracer = errors.New("unknown tracer")
 )
 
 type debugBlockchainStore interface {
@@ -40,13 +46,16 @@ type debugBlockchainStore interface {
 	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
 
 	// TraceBlock traces all transactions in the given block
-	TraceBlock(*types.Block, tracer.Tracer) ([]interface{}, error)
+	TraceBlock(context.Context, *types.Block, tracer.Tracer) ([]interface{}, error)
 
 	// TraceTxn traces a transaction in the block, associated with the given hash
-	TraceTxn(*types.Block, types.Hash, tracer.Tracer) (interface{}, error)
+	TraceTxn(context.Context, *types.Block, types.Hash, tracer.Tracer) (interface{}, error)
 
 	// TraceCall traces a single call at the point when the given header is mined
-	TraceCall(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
+	TraceCall(context.Context, *types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
+
+	// GetBadBlocks returns the blocks which failed the verification, from the newest to the oldest one
+	GetBadBlocks() ([]*blockchain.BadBlock, error)
 }
 
 type debugTxPoolStore interface {
@@ -66,6 +75,9 @@ type debugStore interface {
 // Debug is the debug jsonrpc endpoint
 type Debug struct {
 	store debugStore
+
+	// tracers are the tracers registered by the extensions, selected by their names
+	tracers map[string]extension.TracerFactory
 }
 
 type TraceConfig struct {
@@ -74,9 +86,16 @@ type TraceConfig struct {
 	DisableStorage   bool    `json:"disableStorage"`
 	EnableReturnData bool    `json:"enableReturnData"`
 	Timeout          *string `json:"timeout"`
+
+	// Tracer is the name of the built-in tracer or the tracer registered by an extension,
+	// the struct tracer is used if not set
+	Tracer *string `json:"tracer"`
+	// TracerConfig is the configuration passed to the selected tracer
+	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
 
 func (d *Debug) TraceBlockByNumber(
+	ctx context.Context,
 	blockNumber BlockNumber,
 	config *TraceConfig,
 ) (interface{}, error) {
@@ -90,10 +109,11 @@ func (d *Debug) TraceBlockByNumber(
 		return nil, fmt.Errorf("block %d not found", num)
 	}
 
-	return d.traceBlock(block, config)
+	return d.traceBlock(ctx, block, config)
 }
 
 func (d *Debug) TraceBlockByHash(
+	ctx context.Context,
 	blockHash types.Hash,
 	config *TraceConfig,
 ) (interface{}, error) {
@@ -102,10 +122,11 @@ func (d *Debug) TraceBlockByHash(
 		return nil, fmt.Errorf("block %s not found", blockHash)
 	}
 
-	return d.traceBlock(block, config)
+	return d.traceBlock(ctx, block, config)
 }
 
 func (d *Debug) TraceBlock(
+	ctx context.Context,
 	input string,
 	config *TraceConfig,
 ) (interface{}, error) {
@@ -119,10 +140,11 @@ func (d *Debug) TraceBlock(
 		return nil, err
 	}
 
-	return d.traceBlock(block, config)
+	return d.traceBlock(ctx, block, config)
 }
 
 func (d *Debug) TraceTransaction(
+	ctx context.Context,
 	txHash types.Hash,
 	config *TraceConfig,
 ) (interface{}, error) {
@@ -135,17 +157,18 @@ func (d *Debug) TraceTransaction(
 		return nil, ErrTraceGenesisBlock
 	}
 
-	tracer, cancel, err := newTracer(config)
+	tracer, cancel, err := newTracer(ctx, config, d.tracers)
 	if err != nil {
 		return nil, err
 	}
 
 	defer cancel()
 
-	return d.store.TraceTxn(block, tx.Hash, tracer)
+	return d.store.TraceTxn(ctx, block, tx.Hash, tracer)
 }
 
 func (d *Debug) TraceCall(
+	ctx context.Context,
 	arg *txnArgs,
 	filter BlockNumberOrHash,
 	config *TraceConfig,
@@ -165,17 +188,47 @@ func (d *Debug) TraceCall(
 		tx.Gas = header.GasLimit
 	}
 
-	tracer, cancel, err := newTracer(config)
+	tracer, cancel, err := newTracer(ctx, config, d.tracers)
 	defer cancel()
 
 	if err != nil {
 		return nil, err
 	}
 
-	return d.store.TraceCall(tx, header, tracer)
+	return d.store.TraceCall(ctx, tx, header, tracer)
+}
+
+// GetBadBlocks returns the blocks which failed the verification or the execution,
+// along with the rejection reason and the local execution result
+func (d *Debug) GetBadBlocks() (interface{}, error) {
+	badBlocks, err := d.store.GetBadBlocks()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*badBlock, 0, len(badBlocks))
+
+	for _, b := range badBlocks {
+		block, err := b.Block()
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode bad block %s, %w", b.Hash, err)
+		}
+
+		res = append(res, &badBlock{
+			Hash:   b.Hash,
+			Block:  toBlock(block, true),
+			RLP:    b.RLP,
+			Reason: b.Reason,
+			Time:   b.Time,
+			Result: b.Result,
+		})
+	}
+
+	return res, nil
 }
 
 func (d *Debug) traceBlock(
+	ctx context.Context,
 	block *types.Block,
 	config *TraceConfig,
 ) (interface{}, error) {
@@ -183,18 +236,19 @@ func (d *Debug) traceBlock(
 		return nil, ErrTraceGenesisBlock
 	}
 
-	tracer, cancel, err := newTracer(config)
+	tracer, cancel, err := newTracer(ctx, config, d.tracers)
 	defer cancel()
 
 	if err != nil {
 		return nil, err
 	}
 
-	return d.store.TraceBlock(block, tracer)
+	return d.store.TraceBlock(ctx, block, tracer)
 }
 
-// newTracer creates new tracer by config
-func newTracer(config *TraceConfig) (
+// newTracer creates new tracer by config, which is either the built-in tracer,
+// the tracer registered by an extension, or the struct tracer by default
+func newTracer(ctx context.Context, config *TraceConfig, tracers map[string]extension.TracerFactory) (
 	tracer.Tracer,
 	context.CancelFunc,
 	error,
@@ -214,14 +268,38 @@ func newTracer(config *TraceConfig) (
 		}
 	}
 
-	tracer := structtracer.NewStructTracer(structtracer.Config{
-		EnableMemory:     config.EnableMemory,
-		EnableStack:      !config.DisableStack,
-		EnableStorage:    !config.DisableStorage,
-		EnableReturnData: config.EnableReturnData,
-	})
+	var t tracer.Tracer
+
+	if config.Tracer != nil {
+		factory, ok := tracers[*config.Tracer]
+		if !ok {
+			builtinFactory, isBuiltin := builtin.Get(*config.Tracer)
+			if !isBuiltin {
+				return nil, nil, fmt.Errorf("%w: %s", ErrUnknownTracer, *config.Tracer)
+			}
+
+			factory = extension.TracerFactory(builtinFactory)
+		}
+
+		if t, err = factory(config.TracerConfig); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		t = structtracer.NewStructTracer(structtracer.Config{
+			EnableMemory:     config.EnableMemory,
+			EnableStack:      !config.DisableStack,
+			EnableStorage:    !config.DisableStorage,
+			EnableReturnData: config.EnableReturnData,
+		})
+	}
+
+	// cancellation of context is done by caller
+	return t, cancelTracerOnTimeout(ctx, timeout, t), nil
+}
 
-	timeoutCtx, cancel := context.WithTimeout(context.Background(), timeout)
+// cancelTracerOnTimeout cancels the tracer once the timeout expires
+func cancelTracerOnTimeout(ctx context.Context, timeout time.Duration, tracer tracer.Tracer) context.CancelFunc {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 
 	go func() {
 		<-timeoutCtx.Done()
@@ -231,6 +309,5 @@ func newTracer(config *TraceConfig) (
 		}
 	}()
 
-	// cancellation of context is done by caller
-	return tracer, cancel, nil
+	return cancel
 }
@@ -1,13 +1,18 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/opcounttracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,6 +28,7 @@ type debugEndpointMockStore struct {
 	traceCallFn         func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
 	getNonceFn          func(types.Address) uint64
 	getAccountFn        func(types.Hash, types.Address) (*Account, error)
+	getBadBlocksFn      func() ([]*blockchain.BadBlock, error)
 }
 
 func (s *debugEndpointMockStore) Header() *types.Header {
@@ -45,15 +51,15 @@ func (s *debugEndpointMockStore) GetBlockByNumber(num uint64, full bool) (*types
 	return s.getBlockByNumberFn(num, full)
 }
 
-func (s *debugEndpointMockStore) TraceBlock(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
+func (s *debugEndpointMockStore) TraceBlock(_ context.Context, block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
 	return s.traceBlockFn(block, tracer)
 }
 
-func (s *debugEndpointMockStore) TraceTxn(block *types.Block, targetTx types.Hash, tracer tracer.Tracer) (interface{}, error) {
+func (s *debugEndpointMockStore) TraceTxn(_ context.Context, block *types.Block, targetTx types.Hash, tracer tracer.Tracer) (interface{}, error) {
 	return s.traceTxnFn(block, targetTx, tracer)
 }
 
-func (s *debugEndpointMockStore) TraceCall(tx *types.Transaction, parent *types.Header, tracer tracer.Tracer) (interface{}, error) {
+func (s *debugEndpointMockStore) TraceCall(_ context.Context, tx *types.Transaction, parent *types.Header, tracer tracer.Tracer) (interface{}, error) {
 	return s.traceCallFn(tx, parent, tracer)
 }
 
@@ -65,6 +71,10 @@ func (s *debugEndpointMockStore) GetAccount(root types.Hash, addr types.Address)
 	return s.getAccountFn(root, addr)
 }
 
+func (s *debugEndpointMockStore) GetBadBlocks() ([]*blockchain.BadBlock, error) {
+	return s.getBadBlocksFn()
+}
+
 func TestDebugTraceConfigDecode(t *testing.T) {
 	timeout15s := "15s"
 
@@ -269,9 +279,9 @@ func TestTraceBlockByNumber(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
-			res, err := endpoint.TraceBlockByNumber(test.blockNumber, test.config)
+			res, err := endpoint.TraceBlockByNumber(context.Background(), test.blockNumber, test.config)
 
 			assert.Equal(t, test.result, res)
 
@@ -338,9 +348,9 @@ func TestTraceBlockByHash(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
-			res, err := endpoint.TraceBlockByHash(test.blockHash, test.config)
+			res, err := endpoint.TraceBlockByHash(context.Background(), test.blockHash, test.config)
 
 			assert.Equal(t, test.result, res)
 
@@ -397,9 +407,9 @@ func TestTraceBlock(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
-			res, err := endpoint.TraceBlock(test.input, test.config)
+			res, err := endpoint.TraceBlock(context.Background(), test.input, test.config)
 
 			assert.Equal(t, test.result, res)
 
@@ -543,9 +553,9 @@ func TestTraceTransaction(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
-			res, err := endpoint.TraceTransaction(test.txHash, test.config)
+			res, err := endpoint.TraceTransaction(context.Background(), test.txHash, test.config)
 
 			assert.Equal(t, test.result, res)
 
@@ -679,9 +689,9 @@ func TestTraceCall(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			endpoint := &Debug{test.store}
+			endpoint := &Debug{store: test.store}
 
-			res, err := endpoint.TraceCall(test.arg, test.filter, test.config)
+			res, err := endpoint.TraceCall(context.Background(), test.arg, test.filter, test.config)
 
 			assert.Equal(t, test.result, res)
 
@@ -700,12 +710,12 @@ func Test_newTracer(t *testing.T) {
 	t.Run("should create tracer", func(t *testing.T) {
 		t.Parallel()
 
-		tracer, cancel, err := newTracer(&TraceConfig{
+		tracer, cancel, err := newTracer(context.Background(), &TraceConfig{
 			EnableMemory:     true,
 			EnableReturnData: true,
 			DisableStack:     false,
 			DisableStorage:   false,
-		})
+		}, nil)
 
 		t.Cleanup(func() {
 			cancel()
@@ -718,24 +728,76 @@ func Test_newTracer(t *testing.T) {
 	t.Run("should return error if arg is nil", func(t *testing.T) {
 		t.Parallel()
 
-		tracer, cancel, err := newTracer(nil)
+		tracer, cancel, err := newTracer(context.Background(), nil, nil)
 
 		assert.Nil(t, tracer)
 		assert.Nil(t, cancel)
 		assert.ErrorIs(t, ErrNoConfig, err)
 	})
 
+	t.Run("should create the tracer registered by the extension", func(t *testing.T) {
+		t.Parallel()
+
+		var received json.RawMessage
+
+		tracers := map[string]extension.TracerFactory{
+			"customTracer": func(config json.RawMessage) (tracer.Tracer, error) {
+				received = config
+
+				return structtracer.NewStructTracer(structtracer.Config{}), nil
+			},
+		}
+
+		name := "customTracer"
+
+		tracer, cancel, err := newTracer(context.Background(), &TraceConfig{
+			Tracer:       &name,
+			TracerConfig: json.RawMessage(`{"onlyTopCall":true}`),
+		}, tracers)
+
+		t.Cleanup(func() {
+			cancel()
+		})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, tracer)
+		assert.JSONEq(t, `{"onlyTopCall":true}`, string(received))
+
+		name = "unknownTracer"
+
+		_, _, err = newTracer(context.Background(), &TraceConfig{Tracer: &name}, tracers)
+		assert.ErrorIs(t, err, ErrUnknownTracer)
+	})
+
+	t.Run("should create the built-in tracer", func(t *testing.T) {
+		t.Parallel()
+
+		name := "opcount"
+
+		tracer, cancel, err := newTracer(context.Background(), &TraceConfig{
+			Tracer:       &name,
+			TracerConfig: json.RawMessage(`{"opcodes":["SSTORE"]}`),
+		}, nil)
+
+		t.Cleanup(func() {
+			cancel()
+		})
+
+		assert.NoError(t, err)
+		assert.IsType(t, &opcounttracer.OpCountTracer{}, tracer)
+	})
+
 	t.Run("GetResult should return errExecutionTimeout if timeout happens", func(t *testing.T) {
 		t.Parallel()
 
 		timeout := "0s"
-		tracer, cancel, err := newTracer(&TraceConfig{
+		tracer, cancel, err := newTracer(context.Background(), &TraceConfig{
 			EnableMemory:     true,
 			EnableReturnData: true,
 			DisableStack:     false,
 			DisableStorage:   false,
 			Timeout:          &timeout,
-		})
+		}, nil)
 
 		t.Cleanup(func() {
 			cancel()
@@ -755,13 +817,13 @@ func Test_newTracer(t *testing.T) {
 		t.Parallel()
 
 		timeout := "5s"
-		tracer, cancel, err := newTracer(&TraceConfig{
+		tracer, cancel, err := newTracer(context.Background(), &TraceConfig{
 			EnableMemory:     true,
 			EnableReturnData: true,
 			DisableStack:     false,
 			DisableStorage:   false,
 			Timeout:          &timeout,
-		})
+		}, nil)
 
 		assert.NoError(t, err)
 
@@ -773,3 +835,49 @@ func Test_newTracer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestGetBadBlocks(t *testing.T) {
+	t.Parallel()
+
+	block := &types.Block{
+		Header: &types.Header{Number: 10, Sha3Uncles: types.EmptyUncleHash},
+		Transactions: []*types.Transaction{
+			{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1), Value: big.NewInt(0), V: big.NewInt(0), R: big.NewInt(0), S: big.NewInt(0)},
+		},
+	}
+	block.Header.ComputeHash()
+
+	captured := &blockchain.BadBlock{
+		Hash:   block.Hash(),
+		Number: block.Number(),
+		Reason: "invalid block state root",
+		Time:   time.Now().UTC(),
+		RLP:    hex.EncodeToHex(block.MarshalRLP()),
+		Result: &blockchain.BadBlockResult{GasUsed: 21000},
+	}
+
+	endpoint := &Debug{store: &debugEndpointMockStore{
+		getBadBlocksFn: func() ([]*blockchain.BadBlock, error) {
+			return []*blockchain.BadBlock{captured}, nil
+		},
+	}}
+
+	res, err := endpoint.GetBadBlocks()
+	assert.NoError(t, err)
+
+	badBlocks, ok := res.([]*badBlock)
+	assert.True(t, ok)
+	assert.Len(t, badBlocks, 1)
+	assert.Equal(t, block.Hash(), badBlocks[0].Hash)
+	assert.Equal(t, captured.RLP, badBlocks[0].RLP)
+	assert.Equal(t, captured.Reason, badBlocks[0].Reason)
+	assert.Equal(t, captured.Result, badBlocks[0].Result)
+	assert.Equal(t, argUint64(10), badBlocks[0].Block.Number)
+	assert.Len(t, badBlocks[0].Block.Transactions, 1)
+
+	// the undecodable bad block is reported
+	captured.RLP = "0x01"
+
+	_, err = endpoint.GetBadBlocks()
+	assert.Error(t, err)
+}
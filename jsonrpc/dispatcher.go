@@ -2,6 +2,8 @@ package jsonrpc
 
 import (
 	"bytes"
+	"context"
+	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,36 +11,56 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 	"unicode"
 
+	"github.com/0xPolygon/polygon-edge/extension"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// rpcTracer is the tracer of the JSON-RPC request spans
+var rpcTracer = tracing.Tracer("jsonrpc")
+
 type serviceData struct {
 	sv      reflect.Value
 	funcMap map[string]*funcData
 }
 
 type funcData struct {
-	inNum int
-	reqt  []reflect.Type
-	fv    reflect.Value
-	isDyn bool
+	inNum  int
+	reqt   []reflect.Type
+	fv     reflect.Value
+	isDyn  bool
+	hasCtx bool // the first argument of the function is the request context
 }
 
+// numParams returns the number of the function arguments decoded from the request params
 func (f *funcData) numParams() int {
+	if f.hasCtx {
+		return f.inNum - 2
+	}
+
 	return f.inNum - 1
 }
 
 type endpoints struct {
-	Eth    *Eth
-	Web3   *Web3
-	Net    *Net
-	TxPool *TxPool
-	Bridge *Bridge
-	Debug  *Debug
+	Eth      *Eth
+	Web3     *Web3
+	Net      *Net
+	TxPool   *TxPool
+	Bridge   *Bridge
+	Debug    *Debug
+	Edge     *Edge
+	Trace    *Trace
+	Relay    *Relay
+	Miner    *Miner
+	Evm      *Evm
+	Personal *Personal
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -55,14 +77,49 @@ type Dispatcher struct {
 type dispatcherParams struct {
 	chainID   uint64
 	chainName string
+	// networkID is the network ID reported by net_version, the chain ID is reported if it is not set
+	networkID uint64
+
+	priceLimit uint64
 
-	priceLimit              uint64
+	// limits which can be changed at runtime, accessed with atomics
 	jsonRPCBatchLengthLimit uint64
 	blockRangeLimit         uint64
+
+	// number of the cached eth_call and eth_estimateGas responses, zero disables the cache
+	callCacheSize uint64
+
+	// timeout of the polling filters, the default is used if not set
+	filterTimeout time.Duration
+	// retention of the timed out polling filters, zero drops them right away
+	filterRetention time.Duration
+	// max number of logs returned by a single logs query, zero disables the limit
+	logsResultLimit uint64
+
+	// authorizes the requests by their API keys, nil if the API keys are disabled
+	apiKeys *APIKeyManager
+
+	// keys of the unlocked accounts the node signs the transactions for (e.g. the dev accounts)
+	signingKeys []*ecdsa.PrivateKey
+
+	// namespaces and tracers registered by the extensions
+	namespaces map[string]interface{}
+	tracers    map[string]extension.TracerFactory
 }
 
-func (dp dispatcherParams) isExceedingBatchLengthLimit(value uint64) bool {
-	return dp.jsonRPCBatchLengthLimit != 0 && value > dp.jsonRPCBatchLengthLimit
+func (dp *dispatcherParams) isExceedingBatchLengthLimit(value uint64) bool {
+	limit := atomic.LoadUint64(&dp.jsonRPCBatchLengthLimit)
+
+	return limit != 0 && value > limit
+}
+
+// getNetworkID returns the network ID reported by net_version, which defaults to the chain ID
+func (dp *dispatcherParams) getNetworkID() uint64 {
+	if dp.networkID != 0 {
+		return dp.networkID
+	}
+
+	return dp.chainID
 }
 
 func newDispatcher(
@@ -77,6 +134,13 @@ func newDispatcher(
 
 	if store != nil {
 		d.filterManager = NewFilterManager(logger, store, params.blockRangeLimit)
+		d.filterManager.retention = params.filterRetention
+		d.filterManager.logsResultLimit = params.logsResultLimit
+
+		if params.filterTimeout != 0 {
+			d.filterManager.timeout = params.filterTimeout
+		}
+
 		go d.filterManager.Run()
 	}
 
@@ -87,6 +151,18 @@ func newDispatcher(
 	return d, nil
 }
 
+// SetLimits sets the batch request length limit and the block range limit, zero disables the limit. [thread-safe]
+func (d *Dispatcher) SetLimits(batchLengthLimit, blockRangeLimit uint64) {
+	atomic.StoreUint64(&d.params.jsonRPCBatchLengthLimit, batchLengthLimit)
+	atomic.StoreUint64(&d.params.blockRangeLimit, blockRangeLimit)
+
+	if d.filterManager != nil {
+		d.filterManager.setBlockRangeLimit(blockRangeLimit)
+	}
+
+	d.endpoints.Edge.setBlockRangeLimit(blockRangeLimit)
+}
+
 func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 	d.endpoints.Eth = &Eth{
 		d.logger,
@@ -94,10 +170,12 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		d.params.chainID,
 		d.filterManager,
 		d.params.priceLimit,
+		newCallCache(d.params.callCacheSize),
+		newAccounts(d.params.signingKeys),
 	}
 	d.endpoints.Net = &Net{
 		store,
-		d.params.chainID,
+		d.params.getNetworkID(),
 	}
 	d.endpoints.Web3 = &Web3{
 		d.params.chainID,
@@ -111,6 +189,26 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 	}
 	d.endpoints.Debug = &Debug{
 		store,
+		d.params.tracers,
+	}
+	d.endpoints.Edge = &Edge{
+		store,
+		d.params.blockRangeLimit,
+	}
+	d.endpoints.Trace = &Trace{
+		store,
+	}
+	d.endpoints.Relay = &Relay{
+		store,
+	}
+	d.endpoints.Miner = &Miner{
+		store,
+	}
+	d.endpoints.Evm = &Evm{
+		store,
+	}
+	d.endpoints.Personal = &Personal{
+		d.endpoints.Eth,
 	}
 
 	var err error
@@ -135,7 +233,51 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		return err
 	}
 
-	return d.registerService("debug", d.endpoints.Debug)
+	if err = d.registerService("debug", d.endpoints.Debug); err != nil {
+		return err
+	}
+
+	if err = d.registerService("edge", d.endpoints.Edge); err != nil {
+		return err
+	}
+
+	if err = d.registerService("trace", d.endpoints.Trace); err != nil {
+		return err
+	}
+
+	if err = d.registerService("relay", d.endpoints.Relay); err != nil {
+		return err
+	}
+
+	if err = d.registerService("miner", d.endpoints.Miner); err != nil {
+		return err
+	}
+
+	if err = d.registerService("evm", d.endpoints.Evm); err != nil {
+		return err
+	}
+
+	if err = d.registerService("personal", d.endpoints.Personal); err != nil {
+		return err
+	}
+
+	return d.registerExtensionNamespaces()
+}
+
+// registerExtensionNamespaces registers the namespaces of the extensions,
+// which must not override the built-in ones
+func (d *Dispatcher) registerExtensionNamespaces() error {
+	for namespace, service := range d.params.namespaces {
+		if _, exists := d.serviceMap[namespace]; exists {
+			return fmt.Errorf("jsonrpc: extension namespace '%s' is already registered", namespace)
+		}
+
+		if err := d.registerService(namespace, service); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
@@ -204,11 +346,17 @@ func (d *Dispatcher) handleSubscribe(req Request, conn wsConn) (string, Error) {
 	if subscribeMethod == "newHeads" {
 		filterID = d.filterManager.NewBlockFilter(conn)
 	} else if subscribeMethod == "logs" {
+		if len(params) < 2 {
+			return "", NewInvalidParamsError("Invalid params")
+		}
+
 		logQuery, err := decodeLogQueryFromInterface(params[1])
 		if err != nil {
 			return "", NewInternalError(err.Error())
 		}
 		filterID = d.filterManager.NewLogFilter(logQuery, conn)
+	} else if subscribeMethod == "reorgs" {
+		filterID = d.filterManager.NewReorgFilter(conn)
 	} else {
 		return "", NewSubscriptionNotFoundError(subscribeMethod)
 	}
@@ -234,11 +382,60 @@ func (d *Dispatcher) handleUnsubscribe(req Request) (bool, Error) {
 	return d.filterManager.Uninstall(filterID), nil
 }
 
+// SubscribeStream creates the subscription with the given eth_subscribe params, whose updates are written
+// to the given stream connection (e.g. server-sent events)
+func (d *Dispatcher) SubscribeStream(params []byte, conn wsConn) (string, error) {
+	filterID, err := d.handleSubscribe(Request{Params: params}, conn)
+	if err != nil {
+		return "", err
+	}
+
+	return filterID, nil
+}
+
+// WaitFilterChanges returns the updates of the filter, waiting for them until the context is done
+func (d *Dispatcher) WaitFilterChanges(ctx context.Context, filterID string) (interface{}, error) {
+	return d.filterManager.WaitFilterChanges(ctx, filterID)
+}
+
+// Authorize checks that the method is allowed for the API key, if the API keys are enabled
+func (d *Dispatcher) Authorize(method, apiKey string) error {
+	if err := d.authorize(method, apiKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// authorize checks the method against the API key, as the request error
+func (d *Dispatcher) authorize(method, apiKey string) Error {
+	if d.params.apiKeys == nil {
+		return nil
+	}
+
+	if err := d.params.apiKeys.Authorize(apiKey, method); err != nil {
+		d.logger.Debug("request rejected", "method", method, "err", err)
+
+		if errors.Is(err, ErrAPIKeyRateLimited) {
+			return toRPCError(err)
+		}
+
+		return NewInvalidRequestError(err.Error())
+	}
+
+	return nil
+}
+
 func (d *Dispatcher) RemoveFilterByWs(conn wsConn) {
 	d.filterManager.RemoveFilterByWs(conn)
 }
 
-func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
+func (d *Dispatcher) HandleWs(
+	ctx context.Context,
+	reqBody []byte,
+	conn wsConn,
+	traceID, apiKey string,
+) ([]byte, error) {
 	const (
 		openSquareBracket  byte = '['
 		closeSquareBracket byte = ']'
@@ -270,7 +467,7 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		responses := make([][]byte, len(batchReq))
 
 		for i, req := range batchReq {
-			responses[i], err = d.handleSingleWs(req, conn).Bytes()
+			responses[i], err = d.handleSingleWs(ctx, req, conn, traceID, apiKey).Bytes()
 			if err != nil {
 				return nil, err
 			}
@@ -292,10 +489,10 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 	}
 
-	return d.handleSingleWs(req, conn).Bytes()
+	return d.handleSingleWs(ctx, req, conn, traceID, apiKey).Bytes()
 }
 
-func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
+func (d *Dispatcher) handleSingleWs(ctx context.Context, req Request, conn wsConn, traceID, apiKey string) Response {
 	id, err := formatID(req.ID)
 	if err != nil {
 		return NewRPCResponse(nil, "2.0", nil, err)
@@ -307,6 +504,10 @@ func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
 	case "eth_subscribe":
 		var filterID string
 
+		if err = d.authorize(req.Method, apiKey); err != nil {
+			break
+		}
+
 		// if the request method is eth_subscribe we need to create a new filter with ws connection
 		if filterID, err = d.handleSubscribe(req, conn); err == nil {
 			response = []byte(fmt.Sprintf("\"%s\"", filterID))
@@ -314,18 +515,19 @@ func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
 	case "eth_unsubscribe":
 		var ok bool
 
+		// the subscription is always allowed to be cancelled, so it isn't authorized
 		if ok, err = d.handleUnsubscribe(req); err == nil {
 			response = []byte(strconv.FormatBool(ok))
 		}
 	default:
 		// its a normal query that we handle with the dispatcher
-		response, err = d.handleReq(req)
+		response, err = d.handleReq(ctx, req, traceID, apiKey)
 	}
 
 	return NewRPCResponse(id, "2.0", response, err)
 }
 
-func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
+func (d *Dispatcher) Handle(ctx context.Context, reqBody []byte, traceID, apiKey string) ([]byte, error) {
 	x := bytes.TrimLeft(reqBody, " \t\r\n")
 	if len(x) == 0 {
 		return NewRPCResponse(nil, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
@@ -341,7 +543,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 			return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 		}
 
-		resp, err := d.handleReq(req)
+		resp, err := d.handleReq(ctx, req, traceID, apiKey)
 
 		return NewRPCResponse(req.ID, "2.0", resp, err).Bytes()
 	}
@@ -370,7 +572,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	responses := make([]Response, 0)
 
 	for _, req := range requests {
-		var response, err = d.handleReq(req)
+		var response, err = d.handleReq(ctx, req, traceID, apiKey)
 		if err != nil {
 			errorResponse := NewRPCResponse(req.ID, "2.0", response, err)
 			responses = append(responses, errorResponse)
@@ -390,23 +592,43 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	return respBytes, nil
 }
 
-func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
-	d.logger.Debug("request", "method", req.Method, "id", req.ID)
+func (d *Dispatcher) handleReq(ctx context.Context, req Request, traceID, apiKey string) (_ []byte, rpcErr Error) {
+	d.logger.Debug("request", "method", req.Method, "id", req.ID, "traceID", traceID)
 
 	service, fd, ferr := d.getFnHandler(req)
 	if ferr != nil {
 		return nil, ferr
 	}
 
+	if err := d.authorize(req.Method, apiKey); err != nil {
+		return nil, err
+	}
+
+	// the span is started once the method is known to exist, so the span names are bounded.
+	// The context of the request is canceled once the client goes away
+	ctx, span := rpcTracer.Start(ctx, "jsonrpc."+req.Method, trace.WithAttributes(
+		attribute.String("rpc.method", req.Method),
+		attribute.String("rpc.trace_id", traceID),
+	))
+	defer func() { tracing.EndSpan(span, rpcErr) }()
+
 	inArgs := make([]reflect.Value, fd.inNum)
 	inArgs[0] = service.sv
 
+	// index of the first argument decoded from the request params
+	offset := 1
+
+	if fd.hasCtx {
+		inArgs[1] = reflect.ValueOf(contextWithTraceID(ctx, traceID))
+		offset = 2
+	}
+
 	inputs := make([]interface{}, fd.numParams())
 
-	for i := 0; i < fd.inNum-1; i++ {
-		val := reflect.New(fd.reqt[i+1])
+	for i := 0; i < fd.numParams(); i++ {
+		val := reflect.New(fd.reqt[i+offset])
 		inputs[i] = val.Interface()
-		inArgs[i+1] = val.Elem()
+		inArgs[i+offset] = val.Elem()
 	}
 
 	if fd.numParams() > 0 {
@@ -418,7 +640,6 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	var (
 		data []byte
 		err  error
-		ok   bool
 	)
 
 	start := time.Now().UTC()
@@ -429,23 +650,15 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	if err := getError(output[1]); err != nil {
 		// measure error on the rpc endpoint function
 		metrics.IncrCounter([]string{jsonRPCMetric, req.Method + "_errors"}, 1)
-		d.logInternalError(req.Method, err)
+		d.logInternalError(req.Method, traceID, err)
 
-		if res := output[0].Interface(); res != nil {
-			data, ok = res.([]byte)
-
-			if !ok {
-				return nil, NewInternalError(err.Error())
-			}
-		}
-
-		return data, NewInvalidRequestError(err.Error())
+		return nil, toRPCError(err)
 	}
 
 	if res := output[0].Interface(); res != nil {
 		data, err = json.Marshal(res)
 		if err != nil {
-			d.logInternalError(req.Method, err)
+			d.logInternalError(req.Method, traceID, err)
 
 			return nil, NewInternalError("Internal error")
 		}
@@ -454,8 +667,8 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	return data, nil
 }
 
-func (d *Dispatcher) logInternalError(method string, err error) {
-	d.logger.Warn("failed to dispatch", "method", method, "err", err)
+func (d *Dispatcher) logInternalError(method, traceID string, err error) {
+	d.logger.Warn("failed to dispatch", "method", method, "traceID", traceID, "err", err)
 }
 
 func (d *Dispatcher) registerService(serviceName string, service interface{}) error {
@@ -492,9 +705,12 @@ func (d *Dispatcher) registerService(serviceName string, service interface{}) er
 		if fd.inNum, fd.reqt, err = validateFunc(funcName, fd.fv, true); err != nil {
 			return fmt.Errorf("jsonrpc: %w", err)
 		}
+		// check if the first argument is the request context
+		fd.hasCtx = fd.inNum > 1 && fd.reqt[1] == contextt
+
 		// check if last item is a pointer
 		if fd.numParams() != 0 {
-			last := fd.reqt[fd.numParams()]
+			last := fd.reqt[fd.inNum-1]
 			if last.Kind() == reflect.Ptr {
 				fd.isDyn = true
 			}
@@ -552,7 +768,10 @@ func validateFunc(funcName string, fv reflect.Value, _ bool) (inNum int, reqt []
 	return
 }
 
-var errt = reflect.TypeOf((*error)(nil)).Elem()
+var (
+	errt     = reflect.TypeOf((*error)(nil)).Elem()
+	contextt = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
 
 func isErrorType(t reflect.Type) bool {
 	return t.Implements(errt)
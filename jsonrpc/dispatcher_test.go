@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -80,7 +81,7 @@ func TestDispatcher_HandleWebsocketConnection_EthSubscribe(t *testing.T) {
 		"method": "eth_subscribe",
 		"params": ["newHeads"]
 	}`)
-		if _, err := dispatcher.HandleWs(req, mockConnection); err != nil {
+		if _, err := dispatcher.HandleWs(context.Background(), req, mockConnection, "", ""); err != nil {
 			t.Fatal(err)
 		}
 
@@ -164,7 +165,7 @@ func TestDispatcher_WebsocketConnection_RequestFormats(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		data, err := dispatcher.HandleWs(c.msg, mockConnection)
+		data, err := dispatcher.HandleWs(context.Background(), c.msg, mockConnection, "", "")
 		resp := new(SuccessResponse)
 		merr := json.Unmarshal(data, resp)
 
@@ -214,6 +215,13 @@ func (m *mockService) Filter(f LogQuery) (interface{}, error) {
 	return nil, nil
 }
 
+func (m *mockService) TraceID(ctx context.Context, f BlockNumber) (interface{}, error) {
+	m.msgCh <- TraceIDFromContext(ctx)
+	m.msgCh <- f
+
+	return nil, nil
+}
+
 func TestDispatcherFuncDecode(t *testing.T) {
 	t.Parallel()
 
@@ -233,10 +241,10 @@ func TestDispatcherFuncDecode(t *testing.T) {
 	require.NoError(t, dispatcher.registerService("mock", srv))
 
 	handleReq := func(typ string, msg string) interface{} {
-		_, err := dispatcher.handleReq(Request{
+		_, err := dispatcher.handleReq(context.Background(), Request{
 			Method: "mock_" + typ,
 			Params: []byte(msg),
-		})
+		}, "", "")
 		assert.NoError(t, err)
 
 		return <-srv.msgCh
@@ -294,6 +302,102 @@ func TestDispatcherFuncDecode(t *testing.T) {
 	}
 }
 
+func TestDispatcher_TraceIDPropagation(t *testing.T) {
+	t.Parallel()
+
+	srv := &mockService{msgCh: make(chan interface{}, 10)}
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{},
+	)
+
+	require.NoError(t, dispatcher.registerService("mock", srv))
+
+	_, err := dispatcher.Handle(context.Background(),
+		[]byte(`{"method": "mock_traceID", "params": ["0x1"]}`), "trace-1", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "trace-1", <-srv.msgCh)
+	assert.Equal(t, BlockNumber(1), <-srv.msgCh)
+
+	_, err = dispatcher.HandleWs(context.Background(),
+		[]byte(`{"method": "mock_traceID", "params": ["latest"]}`), &mockWsConn{}, "trace-2", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "trace-2", <-srv.msgCh)
+	assert.Equal(t, LatestBlockNumber, <-srv.msgCh)
+}
+
+func TestDispatcher_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	apiKeys, err := NewAPIKeyManager(true, []*APIKey{
+		{Key: "key-1", Name: "eth", Methods: []string{"eth_*"}},
+	})
+	require.NoError(t, err)
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{chainID: 1, apiKeys: apiKeys},
+	)
+
+	handle := func(method, apiKey string) *ObjectError {
+		t.Helper()
+
+		res, err := dispatcher.Handle(context.Background(),
+			[]byte(`{"method": "`+method+`", "params": []}`), "", apiKey)
+		require.NoError(t, err)
+
+		var resp SuccessResponse
+		require.NoError(t, json.Unmarshal(res, &resp))
+
+		return resp.Error
+	}
+
+	assert.Nil(t, handle("eth_chainId", "key-1"))
+	assert.Contains(t, handle("net_version", "key-1").Message, ErrAPIKeyMethodNotAllowed.Error())
+	assert.Contains(t, handle("eth_chainId", "").Message, ErrAPIKeyRequired.Error())
+	assert.Contains(t, handle("eth_chainId", "key-2").Message, ErrAPIKeyUnknown.Error())
+
+	// the subscriptions are authorized too
+	res, err := dispatcher.HandleWs(context.Background(),
+		[]byte(`{"method": "eth_subscribe", "params": ["newHeads"]}`), &mockWsConn{}, "", "")
+	require.NoError(t, err)
+	assert.Contains(t, string(res), ErrAPIKeyRequired.Error())
+
+	key, ok := apiKeys.Key("key-1")
+	require.True(t, ok)
+	assert.Equal(t, uint64(1), key.Requests)
+	assert.Equal(t, uint64(1), key.Rejected)
+}
+
+func TestDispatcher_ExtensionNamespaces(t *testing.T) {
+	t.Parallel()
+
+	srv := &mockService{msgCh: make(chan interface{}, 10)}
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{namespaces: map[string]interface{}{"ext": srv}},
+	)
+
+	_, err := dispatcher.Handle(context.Background(),
+		[]byte(`{"method": "ext_traceID", "params": ["0x1"]}`), "trace-1", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "trace-1", <-srv.msgCh)
+	assert.Equal(t, BlockNumber(1), <-srv.msgCh)
+
+	// the built-in namespaces can't be overridden
+	_, err = newDispatcher(hclog.NewNullLogger(), newMockStore(),
+		&dispatcherParams{namespaces: map[string]interface{}{"eth": srv}})
+	require.ErrorContains(t, err, "extension namespace 'eth' is already registered")
+}
+
 func TestDispatcherBatchRequest(t *testing.T) {
 	t.Parallel()
 
@@ -474,11 +578,11 @@ func TestDispatcherBatchRequest(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
 
-			res, _ := c.dispatcher.HandleWs(c.reqBody, mock)
+			res, _ := c.dispatcher.HandleWs(context.Background(), c.reqBody, mock, "", "")
 
 			check(c, res)
 
-			res, _ = c.dispatcher.Handle(c.reqBody)
+			res, _ = c.dispatcher.Handle(context.Background(), c.reqBody, "", "")
 
 			check(c, res)
 		})
@@ -516,25 +620,56 @@ func TestDispatcher_WebsocketConnection_Unsubscribe(t *testing.T) {
 	}
 
 	// non existing subscription
-	r, err := dispatcher.HandleWs(reqUnsub("\"787832\""), mockConn)
+	r, err := dispatcher.HandleWs(context.Background(), reqUnsub("\"787832\""), mockConn, "", "")
 	require.NoError(t, err)
 
 	require.NoError(t, json.Unmarshal(r, &resp))
 	assert.Equal(t, "false", string(resp.Result))
 
-	r, err = dispatcher.HandleWs([]byte(`{"method": "eth_subscribe", "params": ["newHeads"]}`), mockConn)
+	r, err = dispatcher.HandleWs(context.Background(),
+		[]byte(`{"method": "eth_subscribe", "params": ["newHeads"]}`), mockConn, "", "")
 	require.NoError(t, err)
 
 	require.NoError(t, json.Unmarshal(r, &resp))
 
 	// existing subscription
-	r, err = dispatcher.HandleWs(reqUnsub(string(resp.Result)), mockConn)
+	r, err = dispatcher.HandleWs(context.Background(), reqUnsub(string(resp.Result)), mockConn, "", "")
 	require.NoError(t, err)
 
 	require.NoError(t, json.Unmarshal(r, &resp))
 	assert.Equal(t, "true", string(resp.Result))
 }
 
+func TestDispatcher_SetLimits(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{
+			jsonRPCBatchLengthLimit: 1,
+			blockRangeLimit:         1000,
+		},
+	)
+
+	req := []byte(`[
+		{"id":1,"jsonrpc":"2.0","method":"eth_chainId","params":[]},
+		{"id":2,"jsonrpc":"2.0","method":"eth_chainId","params":[]}]`)
+
+	res, err := dispatcher.Handle(context.Background(), req, "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(res), "Batch request length too long")
+
+	dispatcher.SetLimits(0, 10)
+
+	res, err = dispatcher.Handle(context.Background(), req, "", "")
+	require.NoError(t, err)
+	require.NotContains(t, string(res), "Batch request length too long")
+
+	require.Equal(t, uint64(10), dispatcher.filterManager.blockRangeLimit)
+	require.Equal(t, uint64(10), dispatcher.endpoints.Edge.blockRangeLimit)
+}
+
 func newTestDispatcher(t *testing.T, logger hclog.Logger, store JSONRPCStore, params *dispatcherParams) *Dispatcher {
 	t.Helper()
 
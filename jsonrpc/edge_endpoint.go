@@ -0,0 +1,314 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// senderTxsPageSize is the maximum number of transaction hashes returned in a single page
+const senderTxsPageSize = 100
+
+var (
+	ErrSenderTxLookupDisabled = errors.New("transaction lookup by sender is disabled")
+	// ErrContractCreationLookupDisabled is returned if the contract creations are not indexed
+	ErrContractCreationLookupDisabled = errors.New("contract creation lookup is disabled")
+	// ErrEpochValidatorsUnavailable is returned if the consensus doesn't elect the validator set per epoch
+	ErrEpochValidatorsUnavailable = errors.New("epoch validators are not available for the consensus")
+	// ErrValidatorQueueUnavailable is returned if the consensus doesn't queue the validator candidates
+	ErrValidatorQueueUnavailable = errors.New("validator queue is not available for the consensus")
+)
+
+// edgeStore provides access to the methods needed by edge endpoint
+type edgeStore interface {
+	// Header returns the current header of the chain (genesis if empty)
+	Header() *types.Header
+
+	// GetHeaderByNumber gets a header using the provided number
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+
+	// SenderTxLookupEnabled returns true if the transaction lookups by sender are written
+	SenderTxLookupEnabled() bool
+
+	// ReadSenderTxLookups returns the hashes of the transactions sent by the sender in the given block range,
+	// ordered by the block number and the position in the block, skipping the first skip transactions
+	// and returning at most limit hashes
+	ReadSenderTxLookups(sender types.Address, fromBlock, toBlock uint64, skip, limit uint64) ([]types.Hash, error)
+
+	// ContractCreationLookupEnabled returns true if the contract creations are indexed
+	ContractCreationLookupEnabled() bool
+
+	// GetContractCreation returns the last creation of the contract at the given address
+	GetContractCreation(addr types.Address) (*types.ContractCreation, bool)
+
+	// GetHeaderAccumulatorProof returns the proof that the canonical header with the given number
+	// is an ancestor of the current head
+	GetHeaderAccumulatorProof(number uint64) (*blockchain.HeaderAccumulatorProof, error)
+
+	// AddScheduledTx adds the transaction which can't be included before the given block to the tx pool
+	AddScheduledTx(tx *types.Transaction, notBefore uint64) error
+
+	// CancelScheduledTx removes the scheduled transaction which is not yet added to the tx pool
+	CancelScheduledTx(hash types.Hash) error
+
+	// GetScheduledTxs returns the scheduled transactions which are not yet added to the tx pool,
+	// grouped by the number of the first block they can be included in
+	GetScheduledTxs() map[uint64][]*types.Transaction
+
+	// GetValidatorsByEpoch returns the validator set of the ended epoch, with the proofs of its membership
+	GetValidatorsByEpoch(epoch uint64) (*types.EpochValidators, error)
+
+	// GetValidatorQueue returns the candidates waiting for a slot in the validator set
+	GetValidatorQueue() (*types.ValidatorQueue, error)
+
+	// GetBlockByNumber returns a block using the provided number
+	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
+
+	// GetReceiptsByHash returns the receipts for a block hash
+	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
+
+	// GetForksInTime returns the active forks at the given block height
+	GetForksInTime(blockNumber uint64) chain.ForksInTime
+
+	// GetBlockCreator returns the address the fees of the block are credited to
+	GetBlockCreator(header *types.Header) (types.Address, error)
+
+	// GenerateWitness re-executes the block and returns the state trie nodes and the codes accessed by it
+	GenerateWitness(block *types.Block) (*state.Witness, error)
+
+	// GetBurnContract returns the contract the base fee is credited to at the given block height
+	// and the address the contract withdraws the burned base fee to
+	GetBurnContract(blockNumber uint64) (contract types.Address, destination types.Address, err error)
+}
+
+// Edge is the edge jsonrpc endpoint, exposing the node specific functionalities
+type Edge struct {
+	store           edgeStore
+	blockRangeLimit uint64 // accessed with atomics
+}
+
+// setBlockRangeLimit sets the max block range of the queries, zero disables the limit
+func (e *Edge) setBlockRangeLimit(blockRangeLimit uint64) {
+	atomic.StoreUint64(&e.blockRangeLimit, blockRangeLimit)
+}
+
+// GetTransactionsBySender returns the requested page of the hashes of the transactions sent by the address
+// in the given block range, ordered by the block number and the position in the block
+func (e *Edge) GetTransactionsBySender(
+	address types.Address,
+	fromBlock, toBlock BlockNumber,
+	page argUint64,
+) (interface{}, error) {
+	if !e.store.SenderTxLookupEnabled() {
+		return nil, ErrSenderTxLookupDisabled
+	}
+
+	from, err := GetNumericBlockNumber(fromBlock, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := GetNumericBlockNumber(toBlock, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	if to < from {
+		return nil, ErrIncorrectBlockRange
+	}
+
+	// if not disabled, avoid handling large block ranges
+	if limit := atomic.LoadUint64(&e.blockRangeLimit); limit != 0 && to-from > limit {
+		return nil, ErrBlockRangeTooHigh
+	}
+
+	return e.store.ReadSenderTxLookups(address, from, to, uint64(page)*senderTxsPageSize, senderTxsPageSize)
+}
+
+// GetContractCreation returns the creator and the creation transaction of the contract at the given address,
+// including the contracts created by the other contracts. Null is returned if the creation is not indexed
+func (e *Edge) GetContractCreation(address types.Address) (interface{}, error) {
+	if !e.store.ContractCreationLookupEnabled() {
+		return nil, ErrContractCreationLookupDisabled
+	}
+
+	creation, ok := e.store.GetContractCreation(address)
+	if !ok {
+		return nil, nil
+	}
+
+	return toContractCreation(creation), nil
+}
+
+// GetHeaderAccumulatorProof returns the proof that the canonical header at the given height
+// is an ancestor of the current head. The header accumulator root is not part of the header,
+// so the light clients verifying the proof must obtain the root of the head from a trusted source
+func (e *Edge) GetHeaderAccumulatorProof(number BlockNumber) (interface{}, error) {
+	header, err := GetBlockHeader(number, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := e.store.GetHeaderAccumulatorProof(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	return toHeaderAccumulatorProof(header, proof), nil
+}
+
+// SendScheduledRawTransaction sends the transaction which can't be included before the given block.
+// The transaction is held by the node and added to the tx pool once the preceding block is written,
+// so it is not visible to the other nodes until then
+func (e *Edge) SendScheduledRawTransaction(buf argBytes, notBefore argUint64) (interface{}, error) {
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalRLP(buf); err != nil {
+		return nil, err
+	}
+
+	// tx hash will be calculated inside e.store.AddScheduledTx
+	if err := e.store.AddScheduledTx(tx, uint64(notBefore)); err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
+}
+
+// CancelScheduledTransaction cancels the scheduled transaction which is not yet added to the tx pool
+func (e *Edge) CancelScheduledTransaction(hash types.Hash) (interface{}, error) {
+	if err := e.store.CancelScheduledTx(hash); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// GetScheduledTransactions returns the scheduled transactions which are not yet added to the tx pool,
+// grouped by the number of the first block they can be included in
+func (e *Edge) GetScheduledTransactions() (interface{}, error) {
+	scheduledTxs := e.store.GetScheduledTxs()
+	result := make(map[argUint64][]*transaction, len(scheduledTxs))
+
+	for notBefore, txs := range scheduledTxs {
+		for _, tx := range txs {
+			result[argUint64(notBefore)] = append(result[argUint64(notBefore)], toPendingTransaction(tx))
+		}
+	}
+
+	return result, nil
+}
+
+// GetValidatorsByEpoch returns the validator set of the ended epoch, bound to the header of its epoch ending block.
+// The header is returned RLP encoded and stripped of the seals, so it hashes to the block hash,
+// and its extra data commits to the validators hash. Each validator comes with the merkle proof
+// of its membership, rooted in the tree of the validators in the order of the validator set
+func (e *Edge) GetValidatorsByEpoch(epoch argUint64) (interface{}, error) {
+	validators, err := e.store.GetValidatorsByEpoch(uint64(epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	return toEpochValidators(validators), nil
+}
+
+// GetValidatorQueue returns the staked candidates waiting for a slot in the validator set, ordered by stake,
+// and the number of the slots they take when the current epoch ends
+func (e *Edge) GetValidatorQueue() (interface{}, error) {
+	queue, err := e.store.GetValidatorQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	return toValidatorQueue(queue), nil
+}
+
+// GetBlockFeeBreakdown returns the fees paid by the transactions of the block and the accounts they are credited to:
+// the base fee burned into the burn contract and the tips accrued by the block creator.
+// The breakdown is derived from the block and its receipts, the block is not re-executed
+func (e *Edge) GetBlockFeeBreakdown(number BlockNumber) (interface{}, error) {
+	num, err := GetNumericBlockNumber(number, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := e.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	var receipts []*types.Receipt
+
+	// the receipts of the blocks without transactions (e.g. genesis) are not written
+	if len(block.Transactions) > 0 {
+		if receipts, err = e.store.GetReceiptsByHash(block.Hash()); err != nil {
+			return nil, err
+		}
+
+		if len(receipts) != len(block.Transactions) {
+			return nil, fmt.Errorf("receipts of block %d not found", num)
+		}
+	}
+
+	creator, err := e.store.GetBlockCreator(block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	london := e.store.GetForksInTime(num).London
+	baseFee := new(big.Int).SetUint64(block.Header.BaseFee)
+
+	breakdown := newBlockFeeBreakdown(block.Header, len(block.Transactions))
+	cumulativeGasUsed := uint64(0)
+
+	for i, tx := range block.Transactions {
+		gasUsed := receipts[i].CumulativeGasUsed - cumulativeGasUsed
+		cumulativeGasUsed = receipts[i].CumulativeGasUsed
+
+		breakdown.addTx(tx, gasUsed, state.CalculateTxFees(tx, gasUsed, baseFee, london))
+	}
+
+	breakdown.ValidatorRewards = []*validatorFeeReward{
+		{Validator: creator, Amount: breakdown.TipsPaid},
+	}
+
+	if london {
+		contract, destination, err := e.store.GetBurnContract(num)
+		if err != nil {
+			return nil, err
+		}
+
+		breakdown.ProtocolFees = []*protocolFee{
+			{Recipient: contract, Destination: destination, Amount: breakdown.BurnedFees},
+		}
+	}
+
+	return breakdown, nil
+}
+
+// GetBlockWitness returns the witness of the block: the state trie nodes and the contract codes accessed
+// by the block execution, which are sufficient to re-execute the block on top of the parent state root
+// without the state database. The block is re-executed to record the witness
+func (e *Edge) GetBlockWitness(number BlockNumber) (interface{}, error) {
+	num, err := GetNumericBlockNumber(number, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := e.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	witness, err := e.store.GenerateWitness(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return toBlockWitness(block.Header, witness), nil
+}
@@ -0,0 +1,594 @@
+package jsonrpc
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/accumulator"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEdgeStore struct {
+	enabled  bool
+	headers  []*types.Header
+	txHashes map[types.Address][][]types.Hash // by the block number
+
+	scheduled map[uint64][]*types.Transaction
+
+	epochValidators map[uint64]*types.EpochValidators
+	validatorQueue  *types.ValidatorQueue
+
+	blocks   map[uint64]*types.Block
+	receipts map[types.Hash][]*types.Receipt
+	london   bool
+
+	contractCreations map[types.Address]*types.ContractCreation
+
+	witnesses map[types.Hash]*state.Witness
+}
+
+func (m *mockEdgeStore) Header() *types.Header {
+	return m.headers[len(m.headers)-1]
+}
+
+func (m *mockEdgeStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
+	if num >= uint64(len(m.headers)) {
+		return nil, false
+	}
+
+	return m.headers[num], true
+}
+
+func (m *mockEdgeStore) SenderTxLookupEnabled() bool {
+	return m.enabled
+}
+
+func (m *mockEdgeStore) ReadSenderTxLookups(
+	sender types.Address,
+	fromBlock, toBlock uint64,
+	skip, limit uint64,
+) ([]types.Hash, error) {
+	txHashes := make([]types.Hash, 0)
+
+	for i := fromBlock; i <= toBlock && i < uint64(len(m.txHashes[sender])); i++ {
+		txHashes = append(txHashes, m.txHashes[sender][i]...)
+	}
+
+	if skip >= uint64(len(txHashes)) {
+		return []types.Hash{}, nil
+	}
+
+	txHashes = txHashes[skip:]
+	if uint64(len(txHashes)) > limit {
+		txHashes = txHashes[:limit]
+	}
+
+	return txHashes, nil
+}
+
+func (m *mockEdgeStore) ContractCreationLookupEnabled() bool {
+	return m.contractCreations != nil
+}
+
+func (m *mockEdgeStore) GetContractCreation(addr types.Address) (*types.ContractCreation, bool) {
+	creation, ok := m.contractCreations[addr]
+
+	return creation, ok
+}
+
+func (m *mockEdgeStore) GetHeaderAccumulatorProof(number uint64) (*blockchain.HeaderAccumulatorProof, error) {
+	head := m.Header()
+	if number > head.Number {
+		return nil, blockchain.ErrHeaderNotCanonical
+	}
+
+	return &blockchain.HeaderAccumulatorProof{
+		Head: head,
+		Root: types.StringToHash("1"),
+		Proof: &accumulator.Proof{
+			LeafIndex: number,
+			NumLeaves: head.Number + 1,
+			Siblings:  []types.Hash{types.StringToHash("2")},
+			Peaks:     []types.Hash{types.StringToHash("3")},
+		},
+	}, nil
+}
+
+func (m *mockEdgeStore) AddScheduledTx(tx *types.Transaction, notBefore uint64) error {
+	tx.ComputeHash(1)
+
+	m.scheduled[notBefore] = append(m.scheduled[notBefore], tx)
+
+	return nil
+}
+
+func (m *mockEdgeStore) CancelScheduledTx(hash types.Hash) error {
+	for notBefore, txs := range m.scheduled {
+		for i, tx := range txs {
+			if tx.Hash == hash {
+				m.scheduled[notBefore] = append(txs[:i], txs[i+1:]...)
+
+				return nil
+			}
+		}
+	}
+
+	return errors.New("not found")
+}
+
+func (m *mockEdgeStore) GetScheduledTxs() map[uint64][]*types.Transaction {
+	return m.scheduled
+}
+
+func (m *mockEdgeStore) GetValidatorsByEpoch(epoch uint64) (*types.EpochValidators, error) {
+	if m.epochValidators == nil {
+		return nil, ErrEpochValidatorsUnavailable
+	}
+
+	validators, ok := m.epochValidators[epoch]
+	if !ok {
+		return nil, errors.New("epoch has not ended yet")
+	}
+
+	return validators, nil
+}
+
+func (m *mockEdgeStore) GetValidatorQueue() (*types.ValidatorQueue, error) {
+	if m.validatorQueue == nil {
+		return nil, ErrValidatorQueueUnavailable
+	}
+
+	return m.validatorQueue, nil
+}
+
+func (m *mockEdgeStore) GetBlockByNumber(num uint64, _ bool) (*types.Block, bool) {
+	block, ok := m.blocks[num]
+
+	return block, ok
+}
+
+func (m *mockEdgeStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return m.receipts[hash], nil
+}
+
+func (m *mockEdgeStore) GetForksInTime(uint64) chain.ForksInTime {
+	return chain.ForksInTime{London: m.london}
+}
+
+func (m *mockEdgeStore) GetBlockCreator(header *types.Header) (types.Address, error) {
+	return types.BytesToAddress(header.Miner), nil
+}
+
+func (m *mockEdgeStore) GenerateWitness(block *types.Block) (*state.Witness, error) {
+	witness, ok := m.witnesses[block.Hash()]
+	if !ok {
+		return nil, errors.New("genesis block has no witness")
+	}
+
+	return witness, nil
+}
+
+func (m *mockEdgeStore) GetBurnContract(uint64) (types.Address, types.Address, error) {
+	return types.StringToAddress("b1"), types.StringToAddress("b2"), nil
+}
+
+func TestEdge_GetTransactionsBySender(t *testing.T) {
+	t.Parallel()
+
+	sender := types.StringToAddress("1")
+	store := &mockEdgeStore{
+		enabled:  true,
+		txHashes: map[types.Address][][]types.Hash{sender: make([][]types.Hash, 20)},
+	}
+
+	// block i contains i transactions of the sender
+	expected := make([]types.Hash, 0)
+
+	for i := 0; i < 20; i++ {
+		header := &types.Header{Number: uint64(i)}
+		header.ComputeHash()
+
+		store.headers = append(store.headers, header)
+
+		for j := 0; j < i; j++ {
+			txHash := types.BytesToHash([]byte{byte(i), byte(j)})
+
+			store.txHashes[sender][i] = append(store.txHashes[sender][i], txHash)
+			expected = append(expected, txHash)
+		}
+	}
+
+	edge := &Edge{store: store, blockRangeLimit: 100}
+
+	getPage := func(page uint64) []types.Hash {
+		t.Helper()
+
+		res, err := edge.GetTransactionsBySender(sender, EarliestBlockNumber, LatestBlockNumber, argUint64(page))
+		require.NoError(t, err)
+
+		return res.([]types.Hash) //nolint:forcetypeassert
+	}
+
+	assert.Equal(t, expected[:senderTxsPageSize], getPage(0))
+	assert.Equal(t, expected[senderTxsPageSize:], getPage(1))
+	assert.Empty(t, getPage(2))
+
+	t.Run("unknown sender", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := edge.GetTransactionsBySender(types.StringToAddress("2"), EarliestBlockNumber, LatestBlockNumber, 0)
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("block range", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := edge.GetTransactionsBySender(sender, BlockNumber(2), BlockNumber(3), 0)
+		require.NoError(t, err)
+		assert.Equal(t, expected[1:6], res)
+
+		_, err = edge.GetTransactionsBySender(sender, BlockNumber(3), BlockNumber(2), 0)
+		assert.ErrorIs(t, err, ErrIncorrectBlockRange)
+
+		limitedEdge := &Edge{store: store, blockRangeLimit: 5}
+
+		_, err = limitedEdge.GetTransactionsBySender(sender, EarliestBlockNumber, LatestBlockNumber, 0)
+		assert.ErrorIs(t, err, ErrBlockRangeTooHigh)
+	})
+
+	t.Run("lookup disabled", func(t *testing.T) {
+		t.Parallel()
+
+		disabledEdge := &Edge{store: &mockEdgeStore{}}
+
+		_, err := disabledEdge.GetTransactionsBySender(sender, EarliestBlockNumber, LatestBlockNumber, 0)
+		assert.ErrorIs(t, err, ErrSenderTxLookupDisabled)
+	})
+}
+
+func TestEdge_GetContractCreation(t *testing.T) {
+	t.Parallel()
+
+	contract := types.StringToAddress("2")
+	store := &mockEdgeStore{
+		contractCreations: map[types.Address]*types.ContractCreation{
+			contract: {
+				Address:     contract,
+				Creator:     types.StringToAddress("1"),
+				TxHash:      types.StringToHash("3"),
+				BlockHash:   types.StringToHash("4"),
+				BlockNumber: 5,
+			},
+		},
+	}
+	edge := &Edge{store: store}
+
+	res, err := edge.GetContractCreation(contract)
+	require.NoError(t, err)
+	assert.Equal(t, &contractCreation{
+		Address:     contract,
+		Creator:     types.StringToAddress("1"),
+		TxHash:      types.StringToHash("3"),
+		BlockHash:   types.StringToHash("4"),
+		BlockNumber: argUint64(5),
+	}, res)
+
+	// not indexed
+	res, err = edge.GetContractCreation(types.StringToAddress("6"))
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	_, err = (&Edge{store: &mockEdgeStore{}}).GetContractCreation(contract)
+	assert.ErrorIs(t, err, ErrContractCreationLookupDisabled)
+}
+
+func TestEdge_GetHeaderAccumulatorProof(t *testing.T) {
+	t.Parallel()
+
+	store := &mockEdgeStore{}
+
+	for i := 0; i < 5; i++ {
+		header := &types.Header{Number: uint64(i)}
+		header.ComputeHash()
+
+		store.headers = append(store.headers, header)
+	}
+
+	edge := &Edge{store: store}
+
+	res, err := edge.GetHeaderAccumulatorProof(BlockNumber(2))
+	require.NoError(t, err)
+
+	proof := res.(*headerAccumulatorProof) //nolint:forcetypeassert
+	assert.Equal(t, store.headers[2].Hash, proof.Header)
+	assert.Equal(t, argUint64(2), proof.Number)
+	assert.Equal(t, store.headers[4].Hash, proof.Head)
+	assert.Equal(t, argUint64(4), proof.HeadNumber)
+	assert.Equal(t, types.StringToHash("1"), proof.Root)
+	assert.Equal(t, argUint64(5), proof.NumLeaves)
+	assert.Equal(t, []types.Hash{types.StringToHash("2")}, proof.Siblings)
+	assert.Equal(t, []types.Hash{types.StringToHash("3")}, proof.Peaks)
+
+	_, err = edge.GetHeaderAccumulatorProof(BlockNumber(10))
+	assert.Error(t, err)
+}
+
+func TestEdge_ScheduledTransactions(t *testing.T) {
+	t.Parallel()
+
+	store := &mockEdgeStore{scheduled: map[uint64][]*types.Transaction{}}
+	edge := &Edge{store: store}
+
+	tx := &types.Transaction{Nonce: 1, Value: big.NewInt(1), GasPrice: big.NewInt(1), V: big.NewInt(1)}
+
+	res, err := edge.SendScheduledRawTransaction(tx.MarshalRLP(), argUint64(10))
+	require.NoError(t, err)
+
+	tx.ComputeHash(1)
+	assert.Equal(t, tx.Hash.String(), res)
+
+	res, err = edge.GetScheduledTransactions()
+	require.NoError(t, err)
+
+	scheduled := res.(map[argUint64][]*transaction) //nolint:forcetypeassert
+	require.Len(t, scheduled[10], 1)
+	assert.Equal(t, tx.Hash, scheduled[10][0].Hash)
+	assert.Equal(t, argUint64(1), scheduled[10][0].Nonce)
+
+	res, err = edge.CancelScheduledTransaction(tx.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, true, res)
+
+	_, err = edge.CancelScheduledTransaction(tx.Hash)
+	assert.Error(t, err)
+
+	_, err = edge.SendScheduledRawTransaction([]byte{0x1}, argUint64(10))
+	assert.Error(t, err)
+}
+
+func TestEdge_GetValidatorsByEpoch(t *testing.T) {
+	t.Parallel()
+
+	header := &types.Header{Number: 10, ExtraData: []byte{0x1}}
+	header.ComputeHash()
+
+	store := &mockEdgeStore{
+		epochValidators: map[uint64]*types.EpochValidators{
+			1: {
+				Epoch:          1,
+				Header:         header,
+				ValidatorsHash: types.StringToHash("1"),
+				Root:           types.StringToHash("2"),
+				Validators: []*types.EpochValidator{
+					{Address: types.StringToAddress("1"), BlsKey: []byte{0x2}, VotingPower: big.NewInt(3),
+						Leaf: []byte{0x4}, Proof: []types.Hash{types.StringToHash("5")}},
+					{Address: types.StringToAddress("6"), BlsKey: []byte{0x7}, VotingPower: big.NewInt(8),
+						Leaf: []byte{0x9}, Proof: []types.Hash{types.StringToHash("10")}},
+				},
+			},
+		},
+	}
+	edge := &Edge{store: store}
+
+	res, err := edge.GetValidatorsByEpoch(1)
+	require.NoError(t, err)
+
+	validators := res.(*epochValidators) //nolint:forcetypeassert
+	assert.Equal(t, argUint64(1), validators.Epoch)
+	assert.Equal(t, argUint64(10), validators.BlockNumber)
+	assert.Equal(t, header.Hash, validators.BlockHash)
+	assert.Equal(t, argBytes(header.MarshalRLP()), validators.Header)
+	assert.Equal(t, types.StringToHash("1"), validators.ValidatorsHash)
+	assert.Equal(t, types.StringToHash("2"), validators.Root)
+	require.Len(t, validators.Validators, 2)
+	assert.Equal(t, types.StringToAddress("6"), validators.Validators[1].Address)
+	assert.Equal(t, argBytes{0x7}, validators.Validators[1].BlsKey)
+	assert.Equal(t, argBigPtr(big.NewInt(8)), validators.Validators[1].VotingPower)
+	assert.Equal(t, argUint64(1), validators.Validators[1].LeafIndex)
+	assert.Equal(t, argBytes{0x9}, validators.Validators[1].Leaf)
+	assert.Equal(t, []types.Hash{types.StringToHash("10")}, validators.Validators[1].Proof)
+
+	_, err = edge.GetValidatorsByEpoch(2)
+	assert.Error(t, err)
+
+	edge = &Edge{store: &mockEdgeStore{}}
+
+	_, err = edge.GetValidatorsByEpoch(1)
+	assert.ErrorIs(t, err, ErrEpochValidatorsUnavailable)
+}
+
+func TestEdge_GetValidatorQueue(t *testing.T) {
+	t.Parallel()
+
+	store := &mockEdgeStore{
+		validatorQueue: &types.ValidatorQueue{
+			BlockNumber:         25,
+			Epoch:               3,
+			MaxValidatorSetSize: 4,
+			Validators:          3,
+			OpenSlots:           1,
+			Queue: []*types.QueuedValidator{
+				{Address: types.StringToAddress("1"), VotingPower: big.NewInt(20)},
+				{Address: types.StringToAddress("2"), VotingPower: big.NewInt(10)},
+			},
+		},
+	}
+	edge := &Edge{store: store}
+
+	res, err := edge.GetValidatorQueue()
+	require.NoError(t, err)
+
+	queue := res.(*validatorQueue) //nolint:forcetypeassert
+	assert.Equal(t, argUint64(25), queue.BlockNumber)
+	assert.Equal(t, argUint64(3), queue.Epoch)
+	assert.Equal(t, argUint64(4), queue.MaxValidatorSetSize)
+	assert.Equal(t, argUint64(3), queue.Validators)
+	assert.Equal(t, argUint64(1), queue.OpenSlots)
+	require.Len(t, queue.Queue, 2)
+	assert.Equal(t, types.StringToAddress("1"), queue.Queue[0].Address)
+	assert.Equal(t, argBigPtr(big.NewInt(20)), queue.Queue[0].VotingPower)
+	assert.Equal(t, types.StringToAddress("2"), queue.Queue[1].Address)
+
+	edge = &Edge{store: &mockEdgeStore{}}
+
+	_, err = edge.GetValidatorQueue()
+	assert.ErrorIs(t, err, ErrValidatorQueueUnavailable)
+}
+
+func TestEdge_GetBlockFeeBreakdown(t *testing.T) {
+	t.Parallel()
+
+	creator := types.StringToAddress("c")
+	header := &types.Header{Number: 1, BaseFee: 10, GasUsed: 51000, Miner: creator.Bytes()}
+	header.ComputeHash()
+
+	dynamicTx := &types.Transaction{
+		Type:      types.DynamicFeeTx,
+		From:      types.StringToAddress("1"),
+		GasFeeCap: big.NewInt(30),
+		GasTipCap: big.NewInt(5),
+		Hash:      types.StringToHash("1"),
+	}
+	legacyTx := &types.Transaction{
+		Type:     types.LegacyTx,
+		From:     types.StringToAddress("2"),
+		GasPrice: big.NewInt(20),
+		Hash:     types.StringToHash("2"),
+	}
+
+	newStore := func(london bool) *mockEdgeStore {
+		return &mockEdgeStore{
+			headers: []*types.Header{{Number: 0}, header},
+			blocks: map[uint64]*types.Block{
+				1: {Header: header, Transactions: []*types.Transaction{dynamicTx, legacyTx}},
+			},
+			receipts: map[types.Hash][]*types.Receipt{
+				header.Hash: {{CumulativeGasUsed: 21000}, {CumulativeGasUsed: 51000}},
+			},
+			london: london,
+		}
+	}
+
+	getBreakdown := func(t *testing.T, store *mockEdgeStore) *blockFeeBreakdown {
+		t.Helper()
+
+		res, err := (&Edge{store: store}).GetBlockFeeBreakdown(LatestBlockNumber)
+		require.NoError(t, err)
+
+		return res.(*blockFeeBreakdown) //nolint:forcetypeassert
+	}
+
+	t.Run("london", func(t *testing.T) {
+		t.Parallel()
+
+		breakdown := getBreakdown(t, newStore(true))
+
+		assert.Equal(t, argUint64(1), breakdown.Number)
+		assert.Equal(t, header.Hash, breakdown.Hash)
+		assert.Equal(t, argUint64(10), breakdown.BaseFee)
+		assert.Equal(t, argUint64(51000), breakdown.GasUsed)
+		assert.Equal(t, argBigPtr(big.NewInt(915000)), breakdown.TotalFees)
+		assert.Equal(t, argBigPtr(big.NewInt(510000)), breakdown.BurnedFees)
+		assert.Equal(t, argBigPtr(big.NewInt(705000)), breakdown.TipsPaid)
+
+		assert.Equal(t, []*protocolFee{{
+			Recipient:   types.StringToAddress("b1"),
+			Destination: types.StringToAddress("b2"),
+			Amount:      argBigPtr(big.NewInt(510000)),
+		}}, breakdown.ProtocolFees)
+		assert.Equal(t, []*validatorFeeReward{{
+			Validator: creator,
+			Amount:    argBigPtr(big.NewInt(705000)),
+		}}, breakdown.ValidatorRewards)
+
+		require.Len(t, breakdown.Transactions, 2)
+		assert.Equal(t, &txFeeBreakdown{
+			Hash:              dynamicTx.Hash,
+			From:              dynamicTx.From,
+			GasUsed:           argUint64(21000),
+			EffectiveGasPrice: argBigPtr(big.NewInt(15)),
+			Fee:               argBigPtr(big.NewInt(315000)),
+			Burned:            argBigPtr(big.NewInt(210000)),
+			Tip:               argBigPtr(big.NewInt(105000)),
+		}, breakdown.Transactions[0])
+		// the coinbase is credited with the whole gas price of the legacy transaction
+		assert.Equal(t, &txFeeBreakdown{
+			Hash:              legacyTx.Hash,
+			From:              legacyTx.From,
+			GasUsed:           argUint64(30000),
+			EffectiveGasPrice: argBigPtr(big.NewInt(20)),
+			Fee:               argBigPtr(big.NewInt(600000)),
+			Burned:            argBigPtr(big.NewInt(300000)),
+			Tip:               argBigPtr(big.NewInt(600000)),
+		}, breakdown.Transactions[1])
+	})
+
+	t.Run("pre london", func(t *testing.T) {
+		t.Parallel()
+
+		breakdown := getBreakdown(t, newStore(false))
+
+		assert.Equal(t, argBigPtr(big.NewInt(0)), breakdown.BurnedFees)
+		assert.Equal(t, breakdown.TotalFees, breakdown.TipsPaid)
+		assert.Empty(t, breakdown.ProtocolFees)
+		require.Len(t, breakdown.ValidatorRewards, 1)
+		assert.Equal(t, breakdown.TipsPaid, breakdown.ValidatorRewards[0].Amount)
+	})
+
+	t.Run("unknown block", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := (&Edge{store: newStore(true)}).GetBlockFeeBreakdown(BlockNumber(5))
+		assert.ErrorIs(t, err, ErrBlockNotFound)
+	})
+}
+
+func TestEdge_GetBlockWitness(t *testing.T) {
+	t.Parallel()
+
+	genesis := &types.Header{Number: 0}
+	genesis.ComputeHash()
+
+	header := &types.Header{Number: 1, StateRoot: types.StringToHash("2")}
+	header.ComputeHash()
+
+	store := &mockEdgeStore{
+		headers: []*types.Header{genesis, header},
+		blocks: map[uint64]*types.Block{
+			0: {Header: genesis},
+			1: {Header: header},
+		},
+		witnesses: map[types.Hash]*state.Witness{
+			header.Hash: {
+				PreStateRoot: types.StringToHash("1"),
+				Nodes:        [][]byte{{0x1}, {0x2}},
+				Codes:        [][]byte{{0x3}},
+			},
+		},
+	}
+	edge := &Edge{store: store}
+
+	res, err := edge.GetBlockWitness(LatestBlockNumber)
+	require.NoError(t, err)
+	assert.Equal(t, &blockWitness{
+		Number:       argUint64(1),
+		Hash:         header.Hash,
+		PreStateRoot: types.StringToHash("1"),
+		StateRoot:    types.StringToHash("2"),
+		Nodes:        []argBytes{{0x1}, {0x2}},
+		Codes:        []argBytes{{0x3}},
+	}, res)
+
+	_, err = edge.GetBlockWitness(EarliestBlockNumber)
+	assert.Error(t, err)
+
+	_, err = edge.GetBlockWitness(BlockNumber(5))
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
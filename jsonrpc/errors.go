@@ -4,18 +4,62 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/umbracle/ethgo/abi"
 )
 
+// The codes of the JSON-RPC errors. The codes of the endpoint errors match geth,
+// as the tooling (e.g. ethers.js) relies on them to decode the errors
+const (
+	// ErrCodeExecutionReverted is the code of the reverted execution, the revert data is the error data
+	ErrCodeExecutionReverted = 3
+	// ErrCodeServerError is the code of the endpoint errors of no specific kind
+	ErrCodeServerError = -32000
+	// ErrCodeLimitExceeded is the code of the requests exceeding the limits of the node (EIP-1474)
+	ErrCodeLimitExceeded = -32005
+	// ErrCodeGasCapExceeded is the code of the transactions requiring more gas than the gas cap
+	// or the block gas limit
+	ErrCodeGasCapExceeded = -32010
+	// ErrCodeIntrinsicGasTooLow is the code of the transactions supplying less gas than their intrinsic gas
+	ErrCodeIntrinsicGasTooLow = -32011
+	ErrCodeInvalidRequest     = -32600
+	ErrCodeMethodNotFound     = -32601
+	ErrCodeInvalidParams      = -32602
+	ErrCodeInternal           = -32603
+)
+
 var (
-	ErrStateNotFound = errors.New("given root and slot not found in storage")
+	ErrStateNotFound  = errors.New("given root and slot not found in storage")
+	ErrGasCapExceeded = errors.New("gas required exceeds allowance")
 )
 
+// errorCodes are the codes of the endpoint errors by their kind, the other errors are server errors
+var errorCodes = []struct {
+	err  error
+	code int
+}{
+	{ErrGasCapExceeded, ErrCodeGasCapExceeded},
+	{txpool.ErrBlockLimitExceeded, ErrCodeGasCapExceeded},
+	{state.ErrNotEnoughIntrinsicGas, ErrCodeIntrinsicGasTooLow},
+	{txpool.ErrIntrinsicGas, ErrCodeIntrinsicGasTooLow},
+	{ErrBlockRangeTooHigh, ErrCodeLimitExceeded},
+	{ErrLogsResultLimitExceeded, ErrCodeLimitExceeded},
+	{ErrAPIKeyRateLimited, ErrCodeLimitExceeded},
+}
+
 type Error interface {
 	Error() string
 	ErrorCode() int
 }
+
+// DataError is the error carrying the data of the JSON-RPC error object
+type DataError interface {
+	Error
+	ErrorData() interface{}
+}
 type invalidParamsError struct {
 	err string
 }
@@ -29,7 +73,7 @@ func (e *internalError) Error() string {
 }
 
 func (e *internalError) ErrorCode() int {
-	return -32603
+	return ErrCodeInternal
 }
 
 func (e *invalidParamsError) Error() string {
@@ -37,7 +81,7 @@ func (e *invalidParamsError) Error() string {
 }
 
 func (e *invalidParamsError) ErrorCode() int {
-	return -32602
+	return ErrCodeInvalidParams
 }
 
 type invalidRequestError struct {
@@ -49,7 +93,7 @@ func (e *invalidRequestError) Error() string {
 }
 
 func (e *invalidRequestError) ErrorCode() int {
-	return -32600
+	return ErrCodeInvalidRequest
 }
 
 type subscriptionNotFoundError struct {
@@ -61,7 +105,7 @@ func (e *subscriptionNotFoundError) Error() string {
 }
 
 func (e *subscriptionNotFoundError) ErrorCode() int {
-	return -32601
+	return ErrCodeMethodNotFound
 }
 
 type methodNotFoundError struct {
@@ -73,7 +117,7 @@ func (e *methodNotFoundError) Error() string {
 }
 
 func (e *methodNotFoundError) ErrorCode() int {
-	return -32601
+	return ErrCodeMethodNotFound
 }
 
 func NewMethodNotFoundError(method string) *methodNotFoundError {
@@ -94,11 +138,80 @@ func NewSubscriptionNotFoundError(method string) *subscriptionNotFoundError {
 	return &subscriptionNotFoundError{fmt.Sprintf("subscribe method %s not found", method)}
 }
 
+// codedError is the endpoint error coded by its kind
+type codedError struct {
+	err  error
+	code int
+	data interface{}
+}
+
+func (e *codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codedError) ErrorCode() int {
+	return e.code
+}
+
+func (e *codedError) ErrorData() interface{} {
+	return e.data
+}
+
+func (e *codedError) Unwrap() error {
+	return e.err
+}
+
+// revertError is the error of the reverted execution, carrying the revert data
+type revertError struct {
+	err  error
+	data []byte
+}
+
+func (e *revertError) Error() string {
+	return e.err.Error()
+}
+
+func (e *revertError) ErrorCode() int {
+	return ErrCodeExecutionReverted
+}
+
+// ErrorData returns the hex encoded revert data, which is decoded by the clients into the custom errors
+func (e *revertError) ErrorData() interface{} {
+	return hex.EncodeToHex(e.data)
+}
+
+func (e *revertError) Unwrap() error {
+	return e.err
+}
+
 func constructErrorFromRevert(result *runtime.ExecutionResult) error {
 	revertErrMsg, unpackErr := abi.UnpackRevertError(result.ReturnValue)
 	if unpackErr != nil {
-		return result.Err
+		return &revertError{err: result.Err, data: result.ReturnValue}
+	}
+
+	return &revertError{err: fmt.Errorf("%w: %s", result.Err, revertErrMsg), data: result.ReturnValue}
+}
+
+// toRPCError converts the endpoint error to the JSON-RPC error, keeping the code and the data
+// of the wrapped JSON-RPC error, or coding the error by its kind
+func toRPCError(err error) Error {
+	var rpcErr Error
+	if errors.As(err, &rpcErr) {
+		coded := &codedError{err: err, code: rpcErr.ErrorCode()}
+
+		if dataErr, ok := rpcErr.(DataError); ok {
+			coded.data = dataErr.ErrorData()
+		}
+
+		return coded
+	}
+
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return &codedError{err: err, code: c.code}
+		}
 	}
 
-	return fmt.Errorf("%w: %s", result.Err, revertErrMsg)
+	return &codedError{err: err, code: ErrCodeServerError}
 }
@@ -0,0 +1,86 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo/abi"
+)
+
+func Test_toRPCError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{errors.New("failure"), ErrCodeServerError},
+		{fmt.Errorf("%w (%d)", ErrGasCapExceeded, 100), ErrCodeGasCapExceeded},
+		{txpool.ErrBlockLimitExceeded, ErrCodeGasCapExceeded},
+		{fmt.Errorf("unable to apply transaction: %w", state.ErrNotEnoughIntrinsicGas), ErrCodeIntrinsicGasTooLow},
+		{ErrBlockRangeTooHigh, ErrCodeLimitExceeded},
+		{NewInvalidParamsError("invalid params"), ErrCodeInvalidParams},
+		{fmt.Errorf("wrapped: %w", NewInvalidParamsError("invalid params")), ErrCodeInvalidParams},
+	}
+
+	for _, c := range cases {
+		rpcErr := toRPCError(c.err)
+
+		assert.Equal(t, c.code, rpcErr.ErrorCode(), c.err.Error())
+		assert.Equal(t, c.err.Error(), rpcErr.Error())
+	}
+}
+
+func TestRevertError_Response(t *testing.T) {
+	t.Parallel()
+
+	// the revert data of Error(string) with the "revert reason" reason
+	returnValue, err := abi.Encode([]interface{}{"revert reason"}, abi.MustNewType("tuple(string)"))
+	require.NoError(t, err)
+
+	returnValue = append([]byte{0x08, 0xc3, 0x79, 0xa0}, returnValue...)
+
+	revertErr := constructErrorFromRevert(&runtime.ExecutionResult{
+		ReturnValue: returnValue,
+		Err:         runtime.ErrExecutionReverted,
+	})
+	require.ErrorIs(t, revertErr, runtime.ErrExecutionReverted)
+
+	res, err := NewRPCResponse(1, "2.0", nil, toRPCError(revertErr)).Bytes()
+	require.NoError(t, err)
+
+	var resp struct {
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+			Data    string `json:"data"`
+		} `json:"error"`
+	}
+
+	require.NoError(t, json.Unmarshal(res, &resp))
+
+	assert.Equal(t, ErrCodeExecutionReverted, resp.Error.Code)
+	assert.Equal(t, "execution reverted: revert reason", resp.Error.Message)
+	assert.Equal(t, "0x"+fmt.Sprintf("%x", returnValue), resp.Error.Data)
+
+	// the custom errors can't be unpacked, so only their data is returned
+	customErr := constructErrorFromRevert(&runtime.ExecutionResult{
+		ReturnValue: []byte{0x01, 0x02, 0x03, 0x04},
+		Err:         runtime.ErrExecutionReverted,
+	})
+
+	res, err = NewRPCResponse(1, "2.0", nil, toRPCError(customErr)).Bytes()
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &resp))
+
+	assert.Equal(t, ErrCodeExecutionReverted, resp.Error.Code)
+	assert.Equal(t, runtime.ErrExecutionReverted.Error(), resp.Error.Message)
+	assert.Equal(t, "0x01020304", resp.Error.Data)
+}
@@ -1,9 +1,11 @@
 package jsonrpc
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
@@ -11,6 +13,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEth_Block_GetBlockByNumber(t *testing.T) {
@@ -262,6 +265,11 @@ func TestEth_Syncing(t *testing.T) {
 		assert.Equal(t, argUint64(1), response.StartingBlock)
 		assert.Equal(t, argUint64(10), response.CurrentBlock)
 		assert.Equal(t, argUint64(100), response.HighestBlock)
+		assert.Equal(t, string(progress.SyncPhaseBodies), response.Phase)
+		assert.Equal(t, 4.5, response.BlocksPerSecond)
+		assert.Equal(t, argUint64(20), response.ETA)
+		assert.Equal(t, []syncPeer{{ID: "peer", Number: 100}}, response.Peers)
+		assert.Equal(t, argUint64(2048), response.DownloadedBytes)
 	})
 
 	t.Run("returns \"false\" if sync is not progress", func(t *testing.T) {
@@ -333,7 +341,7 @@ func TestEth_Call(t *testing.T) {
 			Nonce:    argUintPtr(0),
 		}
 
-		res, err := eth.Call(contractCall, BlockNumberOrHash{}, nil)
+		res, err := eth.Call(context.Background(), contractCall, BlockNumberOrHash{}, nil)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), store.ethCallError.Error())
@@ -357,13 +365,13 @@ func TestEth_Call(t *testing.T) {
 			Nonce:    argUintPtr(0),
 		}
 
-		res, err := eth.Call(contractCall, BlockNumberOrHash{}, nil)
+		res, err := eth.Call(context.Background(), contractCall, BlockNumberOrHash{}, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 	})
 
-	t.Run("returns error and result as data of a reverted transaction execution", func(t *testing.T) {
+	t.Run("returns error with the result as data of a reverted transaction execution", func(t *testing.T) {
 		t.Parallel()
 
 		returnValue := []byte("Reverted()")
@@ -383,11 +391,13 @@ func TestEth_Call(t *testing.T) {
 			Nonce:    argUintPtr(0),
 		}
 
-		res, err := eth.Call(contractCall, BlockNumberOrHash{}, nil)
-		assert.Error(t, err)
-		assert.NotNil(t, res)
-		bres := res.([]byte) //nolint:forcetypeassert
-		assert.Equal(t, []byte(hex.EncodeToString(returnValue)), bres)
+		res, err := eth.Call(context.Background(), contractCall, BlockNumberOrHash{}, nil)
+		assert.Nil(t, res)
+		require.ErrorIs(t, err, runtime.ErrExecutionReverted)
+
+		rpcErr := toRPCError(err)
+		assert.Equal(t, ErrCodeExecutionReverted, rpcErr.ErrorCode())
+		assert.Equal(t, hex.EncodeToHex(returnValue), rpcErr.(DataError).ErrorData()) //nolint:forcetypeassert
 	})
 }
 
@@ -560,10 +570,15 @@ func (m *mockBlockStore) GetPendingTx(txHash types.Hash) (*types.Transaction, bo
 func (m *mockBlockStore) GetSyncProgression() *progress.Progression {
 	if m.isSyncing {
 		return &progress.Progression{
-			SyncType:      progress.ChainSyncBulk,
-			StartingBlock: 1,
-			CurrentBlock:  10,
-			HighestBlock:  100,
+			SyncType:        progress.ChainSyncBulk,
+			StartingBlock:   1,
+			CurrentBlock:    10,
+			HighestBlock:    100,
+			Phase:           progress.SyncPhaseBodies,
+			BlocksPerSecond: 4.5,
+			ETA:             20 * time.Second,
+			Peers:           []progress.SyncPeer{{ID: "peer", Number: 100}},
+			DownloadedBytes: 2048,
 		}
 	} else {
 		return nil
@@ -574,13 +589,19 @@ func (m *mockBlockStore) GetAvgGasPrice() *big.Int {
 	return big.NewInt(m.averageGasPrice)
 }
 
-func (m *mockBlockStore) ApplyTxn(header *types.Header, txn *types.Transaction, overrides types.StateOverride) (*runtime.ExecutionResult, error) {
+func (m *mockBlockStore) ApplyTxn(_ context.Context, header *types.Header, txn *types.Transaction,
+	overrides types.StateOverride) (*runtime.ExecutionResult, error) {
 	return &runtime.ExecutionResult{
 		Err:         m.ethCallError,
 		ReturnValue: m.returnValue,
 	}, nil
 }
 
+func (m *mockBlockStore) ApplyStaticTxn(ctx context.Context, header *types.Header, txn *types.Transaction,
+	overrides types.StateOverride) (*runtime.ExecutionResult, error) {
+	return m.ApplyTxn(ctx, header, txn, overrides)
+}
+
 func (m *mockBlockStore) SubscribeEvents() blockchain.Subscription {
 	return nil
 }
@@ -1,14 +1,16 @@
 package jsonrpc
 
 import (
-	"encoding/hex"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/gasprice"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
@@ -18,8 +20,8 @@ import (
 )
 
 type ethTxPoolStore interface {
-	// AddTx adds a new transaction to the tx pool
-	AddTx(tx *types.Transaction) error
+	// AddTxWithContext adds a new transaction to the tx pool, the context carries the request trace ID
+	AddTxWithContext(ctx context.Context, tx *types.Transaction) error
 
 	// GetPendingTx gets the pending transaction from the transaction pool, if it's present
 	GetPendingTx(txHash types.Hash) (*types.Transaction, bool)
@@ -63,7 +65,18 @@ type ethBlockchainStore interface {
 	GetAvgGasPrice() *big.Int
 
 	// ApplyTxn applies a transaction object to the blockchain
-	ApplyTxn(header *types.Header, txn *types.Transaction, override types.StateOverride) (*runtime.ExecutionResult, error)
+	ApplyTxn(ctx context.Context, header *types.Header, txn *types.Transaction,
+		override types.StateOverride) (*runtime.ExecutionResult, error)
+
+	// ApplyStaticTxn applies a transaction object to the blockchain as the read-only call,
+	// falling back to the regular execution if the transaction modifies the state
+	ApplyStaticTxn(ctx context.Context, header *types.Header, txn *types.Transaction,
+		override types.StateOverride) (*runtime.ExecutionResult, error)
+
+	// ApplyPendingTxn applies a transaction object on top of the pending state,
+	// which is the latest block with the txpool pending transactions applied
+	ApplyPendingTxn(ctx context.Context, txn *types.Transaction,
+		override types.StateOverride) (*runtime.ExecutionResult, error)
 
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
@@ -80,6 +93,7 @@ type ethStore interface {
 	ethStateStore
 	ethBlockchainStore
 	ethFilter
+	minerStore
 	gasprice.GasStore
 }
 
@@ -90,10 +104,25 @@ type Eth struct {
 	chainID       uint64
 	filterManager *FilterManager
 	priceLimit    uint64
+	callCache     *callCache
+	accounts      *accounts
 }
 
 var (
 	ErrInsufficientFunds = errors.New("insufficient funds for execution")
+	// ErrTxInclusionTimeout is returned when the transaction is not included in a block before the timeout elapses
+	ErrTxInclusionTimeout = errors.New("transaction not included before timeout")
+)
+
+const (
+	// defaultSendRawTxSyncTimeout is the default time eth_sendRawTransactionSync waits for the transaction inclusion
+	defaultSendRawTxSyncTimeout = 10 * time.Second
+	// maxSendRawTxSyncTimeout is the maximum time eth_sendRawTransactionSync waits for the transaction inclusion
+	maxSendRawTxSyncTimeout = time.Minute
+	// sendRawTxSyncPollInterval is the interval at which the inclusion of the transaction is checked
+	sendRawTxSyncPollInterval = 100 * time.Millisecond
+	// maxEstimateGasIterations is the maximum number of executions in the eth_estimateGas binary search
+	maxEstimateGasIterations = 20
 )
 
 // ChainId returns the chain id of the client
@@ -103,14 +132,34 @@ func (e *Eth) ChainId() (interface{}, error) {
 	return argUintPtr(e.chainID), nil
 }
 
+// Coinbase returns the address credited with the fees of the blocks built by the node
+func (e *Eth) Coinbase() (interface{}, error) {
+	feeRecipient, err := e.store.FeeRecipient()
+	if err != nil {
+		return nil, err
+	}
+
+	return feeRecipient, nil
+}
+
 func (e *Eth) Syncing() (interface{}, error) {
 	if syncProgression := e.store.GetSyncProgression(); syncProgression != nil {
 		// Node is bulk syncing, return the status
+		peers := make([]syncPeer, len(syncProgression.Peers))
+		for i, p := range syncProgression.Peers {
+			peers[i] = syncPeer{ID: p.ID, Number: argUint64(p.Number)}
+		}
+
 		return progression{
-			Type:          string(syncProgression.SyncType),
-			StartingBlock: argUint64(syncProgression.StartingBlock),
-			CurrentBlock:  argUint64(syncProgression.CurrentBlock),
-			HighestBlock:  argUint64(syncProgression.HighestBlock),
+			Type:            string(syncProgression.SyncType),
+			StartingBlock:   argUint64(syncProgression.StartingBlock),
+			CurrentBlock:    argUint64(syncProgression.CurrentBlock),
+			HighestBlock:    argUint64(syncProgression.HighestBlock),
+			Phase:           string(syncProgression.Phase),
+			BlocksPerSecond: syncProgression.BlocksPerSecond,
+			ETA:             argUint64(syncProgression.ETA / time.Second),
+			Peers:           peers,
+			DownloadedBytes: argUint64(syncProgression.DownloadedBytes),
 		}, nil
 	}
 
@@ -194,24 +243,206 @@ func (e *Eth) BlockNumber() (interface{}, error) {
 }
 
 // SendRawTransaction sends a raw transaction
-func (e *Eth) SendRawTransaction(buf argBytes) (interface{}, error) {
+func (e *Eth) SendRawTransaction(ctx context.Context, buf argBytes) (interface{}, error) {
+	tx, err := e.addRawTx(ctx, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
+}
+
+// SendRawTransactionSync sends a transaction and holds the response until the transaction
+// is included in a block, returning its receipt. The optional timeout is given in milliseconds.
+// If the transaction is not included before the timeout elapses, ErrTxInclusionTimeout is returned
+// along with the transaction hash, so the caller can keep polling for the receipt.
+// The wait is abandoned once the request is canceled (e.g. the client disconnects).
+// No preconfirmations are given, since the blocks are final once they are inserted,
+// so the receipt is the earliest confirmation the node can give
+func (e *Eth) SendRawTransactionSync(ctx context.Context, buf argBytes, timeout *argUint64) (interface{}, error) {
+	waitTimeout := defaultSendRawTxSyncTimeout
+
+	if timeout != nil {
+		waitTimeout = time.Duration(*timeout) * time.Millisecond
+		if waitTimeout > maxSendRawTxSyncTimeout {
+			waitTimeout = maxSendRawTxSyncTimeout
+		}
+	}
+
+	tx, err := e.addRawTx(ctx, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.waitForReceipt(ctx, tx.Hash, waitTimeout)
+}
+
+// addRawTx decodes the RLP encoded transaction and adds it to the tx pool
+func (e *Eth) addRawTx(ctx context.Context, buf argBytes) (*types.Transaction, error) {
 	tx := &types.Transaction{}
 	if err := tx.UnmarshalRLP(buf); err != nil {
 		return nil, err
 	}
 
-	// tx hash will be calculated inside e.store.AddTx
-	if err := e.store.AddTx(tx); err != nil {
+	// tx hash will be calculated inside e.store.AddTxWithContext
+	if err := e.store.AddTxWithContext(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// waitForReceipt polls for the receipt of the transaction with the given hash until it is found,
+// the timeout elapses or the request is canceled
+func (e *Eth) waitForReceipt(ctx context.Context, hash types.Hash, timeout time.Duration) (interface{}, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(sendRawTxSyncPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			res, err := e.GetTransactionReceipt(hash)
+			if err != nil {
+				return nil, err
+			}
+
+			if res != nil {
+				return res, nil
+			}
+		case <-timer.C:
+			return nil, fmt.Errorf("%w: %s", ErrTxInclusionTimeout, hash)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Accounts returns the addresses of the unlocked accounts the node signs the transactions for
+func (e *Eth) Accounts() (interface{}, error) {
+	return e.accounts.list(), nil
+}
+
+// Sign returns the EIP-191 signature of the data signed by the unlocked account
+func (e *Eth) Sign(address types.Address, data argBytes) (interface{}, error) {
+	sig, err := e.accounts.sign(address, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return argBytes(sig), nil
+}
+
+// SendTransaction signs the transaction with the unlocked account and sends it. The transactions
+// of the same account are serialized, so the concurrent calls get the consecutive nonces
+func (e *Eth) SendTransaction(ctx context.Context, arg *txnArgs) (interface{}, error) {
+	if len(e.accounts.list()) == 0 {
+		return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
+			" use eth_sendRawTransaction instead")
+	}
+
+	tx, err := e.sendTransaction(ctx, arg)
+	if err != nil {
 		return nil, err
 	}
 
 	return tx.Hash.String(), nil
 }
 
-// SendTransaction rejects eth_sendTransaction json-rpc call as we don't support wallet management
-func (e *Eth) SendTransaction(_ *txnArgs) (interface{}, error) {
-	return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
-		" use eth_sendRawTransaction instead")
+// sendTransaction fills the defaults of the transaction, signs it with the unlocked account and adds it to the txpool
+func (e *Eth) sendTransaction(ctx context.Context, arg *txnArgs) (*types.Transaction, error) {
+	if arg == nil || arg.From == nil {
+		return nil, errors.New("missing value for required argument from")
+	}
+
+	key, err := e.accounts.key(*arg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.accounts.send(*arg.From, arg.Nonce, e.store, func(nonce uint64) (*types.Transaction, error) {
+		header := e.store.Header()
+		forks := e.store.GetForksInTime(header.Number)
+
+		// the arguments are copied, as decoding the transaction sets their defaults
+		txArg := *arg
+		txArg.Nonce = argUintPtr(nonce)
+
+		if err := e.setTxDefaults(ctx, header, forks.London, &txArg); err != nil {
+			return nil, err
+		}
+
+		tx, err := DecodeTxn(&txArg, header.Number, e.store)
+		if err != nil {
+			return nil, err
+		}
+
+		signed, err := crypto.NewSigner(forks, e.chainID).SignTx(tx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign transaction: %w", err)
+		}
+
+		// tx hash will be calculated inside e.store.AddTxWithContext
+		if err := e.store.AddTxWithContext(ctx, signed); err != nil {
+			return nil, err
+		}
+
+		return signed, nil
+	})
+}
+
+// setTxDefaults sets the fees and the gas of the transaction sent by the node, if they are not given
+func (e *Eth) setTxDefaults(ctx context.Context, header *types.Header, london bool, arg *txnArgs) error {
+	if arg.GasFeeCap != nil && arg.Type == nil {
+		arg.Type = argUintPtr(uint64(types.DynamicFeeTx))
+	}
+
+	// the legacy transaction can't carry the access list
+	if arg.AccessList != nil && arg.GasPrice != nil && arg.Type == nil {
+		arg.Type = argUintPtr(uint64(types.AccessListTx))
+	}
+
+	if arg.GasPrice == nil && arg.GasFeeCap == nil {
+		if london {
+			tip, err := e.store.MaxPriorityFeePerGas()
+			if err != nil {
+				return err
+			}
+
+			// leave the room for the base fee increase until the transaction gets included
+			feeCap := new(big.Int).Add(
+				new(big.Int).Mul(new(big.Int).SetUint64(header.BaseFee), big.NewInt(2)),
+				tip,
+			)
+
+			arg.Type = argUintPtr(uint64(types.DynamicFeeTx))
+			arg.GasTipCap = argBytesPtr(tip.Bytes())
+			arg.GasFeeCap = argBytesPtr(feeCap.Bytes())
+		} else {
+			arg.GasPrice = argBytesPtr(new(big.Int).SetUint64(e.gasPrice()).Bytes())
+		}
+	}
+
+	if arg.Gas == nil {
+		// the estimation sets the defaults of its own copy of the arguments
+		estimateArg := *arg
+
+		gas, err := e.estimateGas(ctx, header, &estimateArg, LatestBlockNumber)
+		if err != nil {
+			return err
+		}
+
+		estimate, ok := gas.(argUint64)
+		if !ok {
+			return fmt.Errorf("unexpected gas estimate %v", gas)
+		}
+
+		arg.Gas = &estimate
+	}
+
+	return nil
 }
 
 // GetTransactionByHash returns a transaction by its hash.
@@ -391,11 +622,15 @@ func (e *Eth) GetStorageAt(
 // GasPrice returns the average gas price based on the last x blocks
 // taking into consideration operator defined price limit
 func (e *Eth) GasPrice() (interface{}, error) {
+	return argUint64(e.gasPrice()), nil
+}
+
+// gasPrice returns the average gas price, or the --price-limit flag defined value if it is greater
+func (e *Eth) gasPrice() uint64 {
 	// Fetch average gas price in uint64
 	avgGasPrice := e.store.GetAvgGasPrice().Uint64()
 
-	// Return --price-limit flag defined value if it is greater than avgGasPrice
-	return argUint64(common.Max(e.priceLimit, avgGasPrice)), nil
+	return common.Max(e.priceLimit, avgGasPrice)
 }
 
 type overrideAccount struct {
@@ -436,12 +671,59 @@ func (o *overrideAccount) ToType() types.OverrideAccount {
 type stateOverride map[types.Address]overrideAccount
 
 // Call executes a smart contract call using the transaction object data
-func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOverride) (interface{}, error) {
+func (e *Eth) Call(
+	ctx context.Context,
+	arg *txnArgs,
+	filter BlockNumberOrHash,
+	apiOverride *stateOverride,
+) (interface{}, error) {
+	if filter.BlockNumber != nil && *filter.BlockNumber == PendingBlockNumber {
+		// the pending state changes along with the txpool, so the pending calls aren't cached
+		return e.callPending(ctx, arg, apiOverride)
+	}
+
 	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
 	if err != nil {
 		return nil, err
 	}
 
+	return e.withCallCache("eth_call", header, func() (interface{}, error) {
+		return e.call(header, arg, apiOverride,
+			func(txn *types.Transaction, override types.StateOverride) (*runtime.ExecutionResult, error) {
+				return e.store.ApplyStaticTxn(ctx, header, txn, override)
+			})
+	}, arg, apiOverride)
+}
+
+// callPending executes the call on top of the pending state
+func (e *Eth) callPending(ctx context.Context, arg *txnArgs, apiOverride *stateOverride) (interface{}, error) {
+	header := e.store.Header()
+	if header == nil {
+		return nil, ErrLatestNotFound
+	}
+
+	// the sender's pending transactions are applied, so its next nonce is taken from the txpool
+	if arg != nil && arg.From != nil && arg.Nonce == nil {
+		nonce, err := GetNextNonce(*arg.From, PendingBlockNumber, e.store)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Nonce = argUintPtr(nonce)
+	}
+
+	return e.call(header, arg, apiOverride,
+		func(txn *types.Transaction, override types.StateOverride) (*runtime.ExecutionResult, error) {
+			return e.store.ApplyPendingTxn(ctx, txn, override)
+		})
+}
+
+func (e *Eth) call(
+	header *types.Header,
+	arg *txnArgs,
+	apiOverride *stateOverride,
+	apply func(*types.Transaction, types.StateOverride) (*runtime.ExecutionResult, error),
+) (interface{}, error) {
 	transaction, err := DecodeTxn(arg, header.Number, e.store)
 	if err != nil {
 		return nil, err
@@ -460,14 +742,14 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOve
 	}
 
 	// The return value of the execution is saved in the transition (returnValue field)
-	result, err := e.store.ApplyTxn(header, transaction, override)
+	result, err := apply(transaction, override)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check if an EVM revert happened
+	// Check if an EVM revert happened, the revert data is returned along with the error
 	if result.Reverted() {
-		return []byte(hex.EncodeToString(result.ReturnValue)), constructErrorFromRevert(result)
+		return nil, constructErrorFromRevert(result)
 	}
 
 	if result.Failed() {
@@ -477,8 +759,36 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOve
 	return argBytesPtr(result.ReturnValue), nil
 }
 
+// withCallCache returns the cached response of the request executed on the given block, or executes
+// the request and caches its response. Only the successful and the reverted executions are cached,
+// the other errors may be transient
+func (e *Eth) withCallCache(method string, header *types.Header,
+	execute func() (interface{}, error), params ...interface{}) (interface{}, error) {
+	if e.callCache == nil {
+		return execute()
+	}
+
+	key, err := newCallCacheKey(method, header.Hash, params...)
+	if err != nil {
+		return execute()
+	}
+
+	head := e.store.Header().Hash
+
+	if cached, ok := e.callCache.get(head, key); ok {
+		return cached.value, cached.err
+	}
+
+	value, err := execute()
+	if err == nil || errors.Is(err, runtime.ErrExecutionReverted) {
+		e.callCache.add(head, key, value, err)
+	}
+
+	return value, err
+}
+
 // EstimateGas estimates the gas needed to execute a transaction
-func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
+func (e *Eth) EstimateGas(ctx context.Context, arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
 	number := LatestBlockNumber
 	if rawNum != nil {
 		number = *rawNum
@@ -490,6 +800,17 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		return nil, err
 	}
 
+	return e.withCallCache("eth_estimateGas", header, func() (interface{}, error) {
+		return e.estimateGas(ctx, header, arg, number)
+	}, arg)
+}
+
+func (e *Eth) estimateGas(
+	ctx context.Context,
+	header *types.Header,
+	arg *txnArgs,
+	number BlockNumber,
+) (interface{}, error) {
 	transaction, err := DecodeTxn(arg, header.Number, e.store)
 	if err != nil {
 		return nil, err
@@ -589,13 +910,13 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 	}
 
 	// Run the transaction with the specified gas value.
-	// Returns a status indicating if the transaction failed and the accompanying error
-	testTransaction := func(gas uint64, shouldOmitErr bool) (bool, error) {
+	// Returns the execution result, a status indicating if the transaction failed and the accompanying error
+	testTransaction := func(gas uint64, shouldOmitErr bool) (*runtime.ExecutionResult, bool, error) {
 		// Create a dummy transaction with the new gas
 		txn := transaction.Copy()
 		txn.Gas = gas
 
-		result, applyErr := e.store.ApplyTxn(header, txn, nil)
+		result, applyErr := e.store.ApplyTxn(ctx, header, txn, nil)
 
 		if applyErr != nil {
 			// Check the application error.
@@ -604,10 +925,10 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 				// Specifying the transaction failed, but not providing an error
 				// is an indication that a valid error occurred due to low gas,
 				// which will increase the lower bound for the search
-				return true, nil
+				return nil, true, nil
 			}
 
-			return true, applyErr
+			return nil, true, applyErr
 		}
 
 		// Check if an out of gas error happened during EVM execution
@@ -616,30 +937,72 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 				// Specifying the transaction failed, but not providing an error
 				// is an indication that a valid error occurred due to low gas,
 				// which will increase the lower bound for the search
-				return true, nil
+				return result, true, nil
 			}
 
 			if isEVMRevertError(result.Err) {
 				// The EVM reverted during execution, attempt to extract the
 				// error message and return it
-				return true, constructErrorFromRevert(result)
+				return result, true, constructErrorFromRevert(result)
 			}
 
-			return true, result.Err
+			return result, true, result.Err
 		}
 
-		return false, nil
+		return result, false, nil
 	}
 
-	// Start the binary search for the lowest possible gas price
-	for lowEnd < highEnd {
+	// Check if the highEnd is a good value to make the transaction pass,
+	// the search is pointless if the transaction fails, for whatever reason, at highEnd
+	result, failed, err := testTransaction(highEnd, false)
+	if failed {
+		switch {
+		case isEVMRevertError(err):
+			return 0, err
+		case isGasEVMError(err):
+			return 0, fmt.Errorf("%w (%d)", ErrGasCapExceeded, highEnd)
+		}
+
+		return 0, fmt.Errorf(
+			"unable to apply transaction even for the highest gas limit %d: %w",
+			highEnd,
+			err,
+		)
+	}
+
+	// The transaction can't pass with less gas than its intrinsic gas, nor than the gas used by the execution
+	intrinsicGas, err := state.TransactionGasCost(transaction,
+		forksInTime.Homestead, forksInTime.Istanbul, forksInTime.Berlin)
+	if err != nil {
+		return 0, err
+	}
+
+	lowEnd = common.Max(lowEnd, common.Max(intrinsicGas, result.GasUsed))
+
+	// Most of the transactions pass with the gas used plus the 1/64 of it which is retained by the calls,
+	// so try it first to narrow down the search
+	if optimisticGas := result.GasUsed * 64 / 63; optimisticGas > lowEnd && optimisticGas < highEnd {
+		_, failed, testErr := testTransaction(optimisticGas, true)
+		if testErr != nil && !isEVMRevertError(testErr) {
+			return 0, testErr
+		}
+
+		if failed {
+			lowEnd = optimisticGas + 1
+		} else {
+			highEnd = optimisticGas
+		}
+	}
+
+	// Start the binary search for the lowest possible gas limit. The highEnd always passes,
+	// so it's a valid (though not the lowest) estimate if the search is stopped early
+	for i := 0; lowEnd < highEnd && i < maxEstimateGasIterations; i++ {
 		mid := (lowEnd + highEnd) / 2
 
-		failed, testErr := testTransaction(mid, true)
+		_, failed, testErr := testTransaction(mid, true)
 		if testErr != nil &&
 			!isEVMRevertError(testErr) {
-			// Reverts are ignored in the binary search, but are checked later on
-			// during the execution for the optimal gas limit found
+			// Reverts are ignored in the binary search, since the transaction passes at highEnd
 			return 0, testErr
 		}
 
@@ -652,17 +1015,6 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		}
 	}
 
-	// Check if the highEnd is a good value to make the transaction pass
-	failed, err := testTransaction(highEnd, false)
-	if failed {
-		// The transaction shouldn't fail, for whatever reason, at highEnd
-		return 0, fmt.Errorf(
-			"unable to apply transaction even for the highest gas limit %d: %w",
-			highEnd,
-			err,
-		)
-	}
-
 	return argUint64(highEnd), nil
 }
 
@@ -676,8 +1028,13 @@ func (e *Eth) GetFilterLogs(id string) (interface{}, error) {
 	return e.filterManager.GetLogsForQuery(logFilter.query)
 }
 
-// GetLogs returns an array of logs matching the filter options
+// GetLogs returns an array of logs matching the filter options,
+// or the page of them if the limit or the cursor of the query is set
 func (e *Eth) GetLogs(query *LogQuery) (interface{}, error) {
+	if query.Limit != 0 || query.Cursor != nil {
+		return e.filterManager.GetLogsPageForQuery(query)
+	}
+
 	return e.filterManager.GetLogsForQuery(query)
 }
 
@@ -772,6 +1129,12 @@ func (e *Eth) GetFilterChanges(id string) (interface{}, error) {
 	return e.filterManager.GetFilterChanges(id)
 }
 
+// RefreshFilter extends the timeout of the polling filter with given ID without taking its changes,
+// it returns false if the filter doesn't exist
+func (e *Eth) RefreshFilter(id string) (bool, error) {
+	return e.filterManager.RefreshFilter(id)
+}
+
 // UninstallFilter uninstalls a filter with given ID
 func (e *Eth) UninstallFilter(id string) (bool, error) {
 	return e.filterManager.Uninstall(id), nil
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"testing"
@@ -173,6 +174,7 @@ func TestEth_State_GetTransactionCount(t *testing.T) {
 	eth := newTestEthEndpoint(store)
 	blockNumberEarliest := EarliestBlockNumber
 	blockNumberLatest := LatestBlockNumber
+	blockNumberPending := PendingBlockNumber
 	blockNumberZero := BlockNumber(0x0)
 	blockNumberInvalid := BlockNumber(0x1)
 
@@ -184,6 +186,14 @@ func TestEth_State_GetTransactionCount(t *testing.T) {
 		shouldFail    bool
 		expectedNonce uint64
 	}{
+		{
+			"should return the txpool nonce for pending block number",
+			addr0,
+			&blockNumberPending,
+			nil,
+			false,
+			1,
+		},
 		{
 			"should return valid nonce using earliest block number",
 			addr0,
@@ -680,7 +690,7 @@ func TestEth_EstimateGas_GasLimit(t *testing.T) {
 			}
 
 			// Run the estimation
-			estimate, estimateErr := ethEndpoint.EstimateGas(testCase.transaction, nil)
+			estimate, estimateErr := ethEndpoint.EstimateGas(context.Background(), testCase.transaction, nil)
 
 			if testCase.expectedError != nil {
 				if estimateErr == nil {
@@ -703,6 +713,38 @@ func TestEth_EstimateGas_GasLimit(t *testing.T) {
 	}
 }
 
+func TestEth_EstimateGas_GasUsedLowerBound(t *testing.T) {
+	t.Parallel()
+
+	const requiredGas = 60000
+
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+
+	executions := 0
+
+	// The transaction passes only with the required gas, but it uses less gas because of the refunds
+	store.applyTxnHook = func(
+		header *types.Header,
+		txn *types.Transaction,
+	) (*runtime.ExecutionResult, error) {
+		executions++
+
+		if txn.Gas < requiredGas {
+			return &runtime.ExecutionResult{Err: runtime.ErrOutOfGas}, nil
+		}
+
+		return &runtime.ExecutionResult{GasUsed: requiredGas - 500}, nil
+	}
+
+	estimate, err := ethEndpoint.EstimateGas(context.Background(), constructMockTx(nil, nil), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, argUint64(requiredGas), estimate)
+
+	// The search starts from the gas used, instead of from the intrinsic gas
+	assert.LessOrEqual(t, executions, 12)
+}
+
 func TestEth_EstimateGas_Reverts(t *testing.T) {
 	// Example revert data that has the string "revert reason" as the revert reason
 	exampleReturnData := "08c379a000000000000000000000000000000000000000000000000000000000000000" +
@@ -729,6 +771,7 @@ func TestEth_EstimateGas_Reverts(t *testing.T) {
 
 	// Run the estimation
 	estimate, estimateErr := ethEndpoint.EstimateGas(
+		context.Background(),
 		constructMockTx(nil, nil),
 		nil,
 	)
@@ -755,6 +798,7 @@ func TestEth_EstimateGas_Errors(t *testing.T) {
 
 	// Run the estimation
 	estimate, estimateErr := ethEndpoint.EstimateGas(
+		context.Background(),
 		mockTx,
 		nil,
 	)
@@ -840,10 +884,16 @@ func (m *mockSpecialStore) GetForksInTime(blockNumber uint64) chain.ForksInTime
 	return chain.ForksInTime{}
 }
 
-func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction, overrides types.StateOverride) (*runtime.ExecutionResult, error) {
+func (m *mockSpecialStore) ApplyTxn(_ context.Context, header *types.Header, txn *types.Transaction,
+	overrides types.StateOverride) (*runtime.ExecutionResult, error) {
 	if m.applyTxnHook != nil {
 		return m.applyTxnHook(header, txn)
 	}
 
 	return &runtime.ExecutionResult{}, nil
 }
+
+func (m *mockSpecialStore) ApplyStaticTxn(ctx context.Context, header *types.Header, txn *types.Transaction,
+	overrides types.StateOverride) (*runtime.ExecutionResult, error) {
+	return m.ApplyTxn(ctx, header, txn, overrides)
+}
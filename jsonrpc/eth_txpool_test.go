@@ -1,11 +1,13 @@
 package jsonrpc
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEth_TxnPool_SendRawTransaction(t *testing.T) {
@@ -19,10 +21,13 @@ func TestEth_TxnPool_SendRawTransaction(t *testing.T) {
 	txn.ComputeHash(1)
 
 	data := txn.MarshalRLP()
-	_, err := eth.SendRawTransaction(data)
+	_, err := eth.SendRawTransaction(contextWithTraceID(context.Background(), "trace"), data)
 	assert.NoError(t, err)
 	assert.NotEqual(t, store.txn.Hash, types.ZeroHash)
 
+	// the trace ID of the request is passed along to the txpool
+	assert.Equal(t, "trace", store.traceID)
+
 	// the hash in the txn pool should match the one we send
 	if txn.Hash != store.txn.Hash {
 		t.Fatal("bad")
@@ -41,19 +46,99 @@ func TestEth_TxnPool_SendTransaction(t *testing.T) {
 		GasPrice: big.NewInt(int64(1)),
 	}
 
-	_, err := eth.SendRawTransaction(txToSend.MarshalRLP())
+	_, err := eth.SendRawTransaction(context.Background(), txToSend.MarshalRLP())
 	assert.NoError(t, err)
 	assert.NotEqual(t, store.txn.Hash, types.ZeroHash)
 }
 
+func TestEth_TxnPool_SendRawTransactionSync(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns receipt once transaction is included", func(t *testing.T) {
+		t.Parallel()
+
+		store := &mockStoreTxnSync{mockBlockStore: newMockBlockStore(), include: true}
+		eth := newTestEthEndpoint(store)
+
+		txn := newTestTransaction(0, addr0)
+
+		res, err := eth.SendRawTransactionSync(context.Background(), txn.MarshalRLP(), nil)
+		require.NoError(t, err)
+
+		//nolint:forcetypeassert
+		response := res.(*receipt)
+		assert.Equal(t, store.txn.Hash, response.TxHash)
+		assert.Equal(t, uint64(1), uint64(response.BlockNumber))
+	})
+
+	t.Run("returns error if transaction is not included before timeout", func(t *testing.T) {
+		t.Parallel()
+
+		store := &mockStoreTxnSync{mockBlockStore: newMockBlockStore()}
+		eth := newTestEthEndpoint(store)
+
+		txn := newTestTransaction(0, addr0)
+		timeout := argUint64(10)
+
+		res, err := eth.SendRawTransactionSync(context.Background(), txn.MarshalRLP(), &timeout)
+		require.ErrorIs(t, err, ErrTxInclusionTimeout)
+		assert.ErrorContains(t, err, store.txn.Hash.String())
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns once the request is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		store := &mockStoreTxnSync{mockBlockStore: newMockBlockStore()}
+		eth := newTestEthEndpoint(store)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		txn := newTestTransaction(0, addr0)
+
+		res, err := eth.SendRawTransactionSync(ctx, txn.MarshalRLP(), nil)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, res)
+	})
+}
+
+// mockStoreTxnSync includes the added transaction in a new block right away, if include is set
+type mockStoreTxnSync struct {
+	*mockBlockStore
+	include bool
+	txn     *types.Transaction
+}
+
+func (m *mockStoreTxnSync) AddTxWithContext(_ context.Context, tx *types.Transaction) error {
+	m.txn = tx
+
+	tx.ComputeHash(1)
+
+	if m.include {
+		block := newTestBlock(1, hash1)
+		block.Transactions = []*types.Transaction{tx}
+
+		receipt := &types.Receipt{}
+		receipt.SetStatus(types.ReceiptSuccess)
+
+		m.add(block)
+		m.receipts[hash1] = []*types.Receipt{receipt}
+	}
+
+	return nil
+}
+
 type mockStoreTxn struct {
 	ethStore
 	accounts map[types.Address]*mockAccount
 	txn      *types.Transaction
+	traceID  string
 }
 
-func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
+func (m *mockStoreTxn) AddTxWithContext(ctx context.Context, tx *types.Transaction) error {
 	m.txn = tx
+	m.traceID = TraceIDFromContext(ctx)
 
 	tx.ComputeHash(1)
 
@@ -0,0 +1,65 @@
+package jsonrpc
+
+import (
+	"errors"
+)
+
+// ErrTimeTravelUnavailable is returned by the evm methods if the node doesn't run in the dev mode
+var ErrTimeTravelUnavailable = errors.New("the evm methods are available only in the dev mode")
+
+// evmStore provides access to the methods needed by evm endpoint
+type evmStore interface {
+	// SnapshotChain saves the current chain head and returns the snapshot id
+	SnapshotChain() (uint64, error)
+
+	// RevertChain rewinds the chain to the given snapshot, returns false if the snapshot doesn't exist
+	RevertChain(id uint64) (bool, error)
+
+	// MineBlock seals a block immediately, at the given timestamp if it is set
+	MineBlock(timestamp uint64) error
+
+	// IncreaseTime moves the timestamps of the next blocks forward, returns the total time offset in seconds
+	IncreaseTime(seconds uint64) (int64, error)
+}
+
+// Evm is the evm jsonrpc endpoint. It implements the dev methods of ganache, hardhat and anvil,
+// so the test suites written for them run against the dev mode unmodified
+type Evm struct {
+	store evmStore
+}
+
+// Snapshot saves the current chain head and returns the snapshot id
+func (e *Evm) Snapshot() (interface{}, error) {
+	id, err := e.store.SnapshotChain()
+	if err != nil {
+		return nil, err
+	}
+
+	return argUint64(id), nil
+}
+
+// Revert rewinds the chain to the given snapshot. The snapshot and the ones taken after it are deleted,
+// so the snapshot needs to be taken again to revert to the same state twice
+func (e *Evm) Revert(id argNumberOrHex) (interface{}, error) {
+	return e.store.RevertChain(uint64(id))
+}
+
+// Mine seals a block immediately, even if there are no pending transactions
+func (e *Evm) Mine(timestamp *argNumberOrHex) (interface{}, error) {
+	var ts uint64
+	if timestamp != nil {
+		ts = uint64(*timestamp)
+	}
+
+	if err := e.store.MineBlock(ts); err != nil {
+		return nil, err
+	}
+
+	return "0x0", nil
+}
+
+// IncreaseTime moves the timestamps of the next blocks forward by the given number of seconds
+// and returns the total time offset
+func (e *Evm) IncreaseTime(seconds argNumberOrHex) (interface{}, error) {
+	return e.store.IncreaseTime(uint64(seconds))
+}
@@ -0,0 +1,89 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockEvmStore struct {
+	JSONRPCStore
+
+	err        error
+	reverted   []uint64
+	timestamps []uint64
+	timeOffset int64
+}
+
+func (m *mockEvmStore) SnapshotChain() (uint64, error) {
+	return 1, m.err
+}
+
+func (m *mockEvmStore) RevertChain(id uint64) (bool, error) {
+	m.reverted = append(m.reverted, id)
+
+	return id == 1, m.err
+}
+
+func (m *mockEvmStore) MineBlock(timestamp uint64) error {
+	m.timestamps = append(m.timestamps, timestamp)
+
+	return m.err
+}
+
+func (m *mockEvmStore) IncreaseTime(seconds uint64) (int64, error) {
+	m.timeOffset += int64(seconds)
+
+	return m.timeOffset, m.err
+}
+
+func TestEvm_Methods(t *testing.T) {
+	t.Parallel()
+
+	store := &mockEvmStore{JSONRPCStore: newMockStore()}
+	dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{})
+
+	call := func(method, params string) json.RawMessage {
+		t.Helper()
+
+		resp, err := dispatcher.Handle(context.Background(), []byte(`{"method": "`+method+`", "params": `+params+`}`), "", "")
+		require.NoError(t, err)
+
+		var res SuccessResponse
+
+		require.NoError(t, json.Unmarshal(resp, &res))
+		require.Nil(t, res.Error)
+
+		return res.Result
+	}
+
+	assert.JSONEq(t, `"0x1"`, string(call("evm_snapshot", `[]`)))
+
+	// the snapshot id is accepted both as the hex string and as the number
+	assert.JSONEq(t, `true`, string(call("evm_revert", `["0x1"]`)))
+	assert.JSONEq(t, `false`, string(call("evm_revert", `[2]`)))
+	assert.Equal(t, []uint64{1, 2}, store.reverted)
+
+	assert.JSONEq(t, `"0x0"`, string(call("evm_mine", `[]`)))
+	assert.JSONEq(t, `"0x0"`, string(call("evm_mine", `[1700000000]`)))
+	assert.Equal(t, []uint64{0, 1700000000}, store.timestamps)
+
+	assert.JSONEq(t, `3600`, string(call("evm_increaseTime", `[3600]`)))
+	assert.JSONEq(t, `7200`, string(call("evm_increaseTime", `["0xe10"]`)))
+}
+
+func TestEvm_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	evm := &Evm{store: &mockEvmStore{err: ErrTimeTravelUnavailable}}
+
+	_, err := evm.Snapshot()
+	assert.ErrorIs(t, err, ErrTimeTravelUnavailable)
+
+	_, err = evm.Mine(nil)
+	assert.ErrorIs(t, err, ErrTimeTravelUnavailable)
+}
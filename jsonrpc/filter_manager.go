@@ -2,10 +2,12 @@ package jsonrpc
 
 import (
 	"container/heap"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
+	"reflect"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -24,6 +26,7 @@ var (
 	ErrBlockNotFound                    = errors.New("block not found")
 	ErrIncorrectBlockRange              = errors.New("incorrect range")
 	ErrBlockRangeTooHigh                = errors.New("block range too high")
+	ErrLogsResultLimitExceeded          = errors.New("query returned more logs than the limit, use limit and cursor to paginate")
 	ErrNoWSConnection                   = errors.New("no websocket connection")
 )
 
@@ -212,6 +215,54 @@ func (f *logFilter) sendUpdates() error {
 	return nil
 }
 
+// reorgFilter is a filter to store the chain reorganization events
+type reorgFilter struct {
+	filterBase
+	sync.Mutex
+
+	reorgs []*reorg
+}
+
+// appendReorg appends new reorg event to reorgs
+func (f *reorgFilter) appendReorg(reorg *reorg) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.reorgs = append(f.reorgs, reorg)
+}
+
+// takeReorgUpdates returns all saved reorg events in filter and set new reorg slice
+func (f *reorgFilter) takeReorgUpdates() []*reorg {
+	f.Lock()
+	defer f.Unlock()
+
+	reorgs := f.reorgs
+	f.reorgs = []*reorg{}
+
+	return reorgs
+}
+
+// getUpdates returns stored reorg events
+func (f *reorgFilter) getUpdates() (interface{}, error) {
+	return f.takeReorgUpdates(), nil
+}
+
+// sendUpdates writes stored reorg events to web socket stream
+func (f *reorgFilter) sendUpdates() error {
+	for _, reorg := range f.takeReorgUpdates() {
+		res, err := json.Marshal(reorg)
+		if err != nil {
+			return err
+		}
+
+		if err := f.writeMessageToWs(string(res)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // filterManagerStore provides methods required by FilterManager
 type filterManagerStore interface {
 	// Header returns the current header of the chain (genesis if empty)
@@ -230,6 +281,17 @@ type filterManagerStore interface {
 	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
 }
 
+// expiredFilter is the timed out polling filter, kept with its cursor so that it can be restored
+type expiredFilter struct {
+	filter filter
+
+	// block is the number of the last block processed by the filter
+	block uint64
+
+	// removeAt is the time the filter is dropped for good
+	removeAt time.Time
+}
+
 // FilterManager manages all running filters
 type FilterManager struct {
 	sync.RWMutex
@@ -238,14 +300,26 @@ type FilterManager struct {
 
 	timeout time.Duration
 
+	// retention is how long the timed out polling filters are kept to be restored on the next poll,
+	// zero drops them right away
+	retention time.Duration
+	expired   map[string]*expiredFilter
+
 	store           filterManagerStore
 	subscription    blockchain.Subscription
 	blockStream     *blockStream
-	blockRangeLimit uint64
+	blockRangeLimit uint64 // accessed with atomics
+
+	// logsResultLimit is the max number of logs returned by a single logs query, zero disables the limit
+	logsResultLimit uint64
 
 	filters  map[string]filter
 	timeouts timeHeapImpl
 
+	// eventCh is closed and replaced once the new blockchain event is processed,
+	// so that the long-polling requests are woken up
+	eventCh chan struct{}
+
 	updateCh chan struct{}
 	closeCh  chan struct{}
 }
@@ -257,7 +331,9 @@ func NewFilterManager(logger hclog.Logger, store filterManagerStore, blockRangeL
 		store:           store,
 		blockRangeLimit: blockRangeLimit,
 		filters:         make(map[string]filter),
+		expired:         make(map[string]*expiredFilter),
 		timeouts:        timeHeapImpl{},
+		eventCh:         make(chan struct{}),
 		updateCh:        make(chan struct{}),
 		closeCh:         make(chan struct{}),
 	}
@@ -276,6 +352,11 @@ func NewFilterManager(logger hclog.Logger, store filterManagerStore, blockRangeL
 	return m
 }
 
+// setBlockRangeLimit sets the max block range of the logs queries, zero disables the limit
+func (f *FilterManager) setBlockRangeLimit(blockRangeLimit uint64) {
+	atomic.StoreUint64(&f.blockRangeLimit, blockRangeLimit)
+}
+
 // Run starts worker process to handle events
 func (f *FilterManager) Run() {
 	// watch for new events in the blockchain
@@ -312,7 +393,7 @@ func (f *FilterManager) Run() {
 		case <-timeoutCh:
 			// timeout for filter
 			// if filter still exists
-			if !f.Uninstall(filterID) {
+			if !f.expireFilter(filterID) {
 				f.logger.Warn("failed to uninstall filter", "id", filterID)
 			}
 
@@ -359,6 +440,19 @@ func (f *FilterManager) NewLogFilter(logQuery *LogQuery, ws wsConn) string {
 	return f.addFilter(filter)
 }
 
+// NewReorgFilter adds new ReorgFilter
+func (f *FilterManager) NewReorgFilter(ws wsConn) string {
+	filter := &reorgFilter{
+		filterBase: newFilterBase(ws),
+	}
+
+	if filter.hasWSConn() {
+		ws.SetFilterID(filter.id)
+	}
+
+	return f.addFilter(filter)
+}
+
 // Exists checks the filter with given ID exists
 func (f *FilterManager) Exists(id string) bool {
 	f.RLock()
@@ -400,19 +494,20 @@ func (f *FilterManager) getLogsFromBlock(query *LogQuery, block *types.Block) ([
 	return logs, nil
 }
 
-func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
+// getLogsRange returns the block range of the query
+func (f *FilterManager) getLogsRange(query *LogQuery) (uint64, uint64, error) {
 	from, err := GetNumericBlockNumber(query.fromBlock, f.store)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
 	to, err := GetNumericBlockNumber(query.toBlock, f.store)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 
 	if to < from {
-		return nil, ErrIncorrectBlockRange
+		return 0, 0, ErrIncorrectBlockRange
 	}
 
 	// If from equals genesis block
@@ -422,8 +517,28 @@ func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
 	}
 
 	// if not disabled, avoid handling large block ranges
-	if f.blockRangeLimit != 0 && to-from > f.blockRangeLimit {
-		return nil, ErrBlockRangeTooHigh
+	if limit := atomic.LoadUint64(&f.blockRangeLimit); limit != 0 && to-from > limit {
+		return 0, 0, ErrBlockRangeTooHigh
+	}
+
+	return from, to, nil
+}
+
+// collectLogs collects the logs of the blocks in the range, starting at the cursor if set.
+// Once more than max logs are collected, the first max logs are returned along with the
+// cursor of the next log. Zero max collects all of the logs
+func (f *FilterManager) collectLogs(
+	query *LogQuery,
+	from, to uint64,
+	cursor *LogCursor,
+	max uint64,
+) ([]*Log, *LogCursor, error) {
+	if cursor != nil {
+		if cursor.BlockNumber < from || cursor.BlockNumber > to {
+			return nil, nil, ErrInvalidLogCursor
+		}
+
+		from = cursor.BlockNumber
 	}
 
 	logs := make([]*Log, 0)
@@ -441,10 +556,51 @@ func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
 
 		blockLogs, err := f.getLogsFromBlock(query, block)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		var next *LogCursor
+
+		if logs, next = appendLogsPage(logs, blockLogs, cursor, max); next != nil {
+			return logs, next, nil
 		}
+	}
+
+	return logs, nil, nil
+}
+
+// appendLogsPage appends the block logs following the cursor to the page. Once the page holds
+// max logs, the cursor of the first log left out is returned. Zero max appends all of the logs
+func appendLogsPage(page, blockLogs []*Log, cursor *LogCursor, max uint64) ([]*Log, *LogCursor) {
+	for _, log := range blockLogs {
+		if cursor != nil && uint64(log.BlockNumber) == cursor.BlockNumber && uint64(log.LogIndex) < cursor.LogIndex {
+			// the log was returned by the previous page
+			continue
+		}
+
+		if max != 0 && uint64(len(page)) == max {
+			return page, &LogCursor{BlockNumber: uint64(log.BlockNumber), LogIndex: uint64(log.LogIndex)}
+		}
+
+		page = append(page, log)
+	}
+
+	return page, nil
+}
+
+func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
+	from, to, err := f.getLogsRange(query)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, next, err := f.collectLogs(query, from, to, nil, f.logsResultLimit)
+	if err != nil {
+		return nil, err
+	}
 
-		logs = append(logs, blockLogs...)
+	if next != nil {
+		return nil, fmt.Errorf("%w (%d)", ErrLogsResultLimitExceeded, f.logsResultLimit)
 	}
 
 	return logs, nil
@@ -464,13 +620,64 @@ func (f *FilterManager) GetLogsForQuery(query *LogQuery) ([]*Log, error) {
 			return []*Log{}, nil
 		}
 
-		return f.getLogsFromBlock(query, block)
+		logs, err := f.getLogsFromBlock(query, block)
+		if err != nil {
+			return nil, err
+		}
+
+		if limit := f.logsResultLimit; limit != 0 && uint64(len(logs)) > limit {
+			return nil, fmt.Errorf("%w (%d)", ErrLogsResultLimitExceeded, limit)
+		}
+
+		return logs, nil
 	}
 
 	// gets logs from a range of blocks
 	return f.getLogsFromBlocks(query)
 }
 
+// GetLogsPageForQuery returns the page of the logs for given query, starting at the query cursor.
+// The page holds at most the query limit of logs, capped by the logs result limit
+func (f *FilterManager) GetLogsPageForQuery(query *LogQuery) (*LogsPage, error) {
+	max := query.Limit
+	if limit := f.logsResultLimit; limit != 0 && (max == 0 || max > limit) {
+		max = limit
+	}
+
+	if query.BlockHash != nil {
+		// BlockHash is set -> page the logs of this block only
+		block, ok := f.store.GetBlockByHash(*query.BlockHash, true)
+		if !ok {
+			return nil, ErrBlockNotFound
+		}
+
+		if query.Cursor != nil && query.Cursor.BlockNumber != block.Number() {
+			return nil, ErrInvalidLogCursor
+		}
+
+		blockLogs, err := f.getLogsFromBlock(query, block)
+		if err != nil {
+			return nil, err
+		}
+
+		logs, next := appendLogsPage(make([]*Log, 0), blockLogs, query.Cursor, max)
+
+		return &LogsPage{Logs: logs, Cursor: next}, nil
+	}
+
+	from, to, err := f.getLogsRange(query)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, next, err := f.collectLogs(query, from, to, query.Cursor, max)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogsPage{Logs: logs, Cursor: next}, nil
+}
+
 // getFilterByID fetches the filter by the ID
 func (f *FilterManager) getFilterByID(filterID string) filter {
 	f.RLock()
@@ -495,8 +702,13 @@ func (f *FilterManager) GetLogFilterFromID(filterID string) (*logFilter, error)
 	return logFilter, nil
 }
 
-// GetFilterChanges returns the updates of the filter with given ID in string, and refreshes the timeout on the filter
+// GetFilterChanges returns the updates of the filter with given ID in string, and refreshes the timeout on the filter.
+// The timed out filter is restored if it is still retained, along with the updates it missed
 func (f *FilterManager) GetFilterChanges(id string) (interface{}, error) {
+	if err := f.restoreExpiredFilter(id); err != nil {
+		return nil, err
+	}
+
 	filter, res, err := f.getFilterAndChanges(id)
 
 	if err == nil && !filter.hasWSConn() {
@@ -509,6 +721,30 @@ func (f *FilterManager) GetFilterChanges(id string) (interface{}, error) {
 	return res, err
 }
 
+// WaitFilterChanges returns the updates of the filter with given ID, waiting for the new blockchain events
+// until there are any updates or the context is done. It is used by the long-polling clients
+func (f *FilterManager) WaitFilterChanges(ctx context.Context, id string) (interface{}, error) {
+	for {
+		// the channel is taken before the changes, so that the event processed in between isn't missed
+		f.RLock()
+		eventCh := f.eventCh
+		f.RUnlock()
+
+		res, err := f.GetFilterChanges(id)
+		if err != nil || reflect.ValueOf(res).Len() > 0 {
+			return res, err
+		}
+
+		select {
+		case <-eventCh:
+		case <-ctx.Done():
+			return res, nil
+		case <-f.closeCh:
+			return res, nil
+		}
+	}
+}
+
 // getFilterAndChanges returns the updates of the filter with given ID in string (read lock only)
 func (f *FilterManager) getFilterAndChanges(id string) (filter, interface{}, error) {
 	f.RLock()
@@ -533,14 +769,123 @@ func (f *FilterManager) getFilterAndChanges(id string) (filter, interface{}, err
 	return filter, res, nil
 }
 
+// RefreshFilter refreshes the timeout of the polling filter with given ID without taking its updates,
+// restoring the timed out filter if it is still retained. It returns false if the filter doesn't exist
+func (f *FilterManager) RefreshFilter(id string) (bool, error) {
+	if err := f.restoreExpiredFilter(id); err != nil {
+		return false, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	filter, ok := f.filters[id]
+	if !ok {
+		return false, nil
+	}
+
+	if !filter.hasWSConn() {
+		f.refreshFilterTimeout(filter.getFilterBase())
+	}
+
+	return true, nil
+}
+
 // Uninstall removes the filter with given ID from list
 func (f *FilterManager) Uninstall(id string) bool {
 	f.Lock()
 	defer f.Unlock()
 
+	if _, ok := f.expired[id]; ok {
+		delete(f.expired, id)
+
+		return true
+	}
+
 	return f.removeFilterByID(id)
 }
 
+// expireFilter removes the timed out filter, keeping the block and log filters for the retention
+func (f *FilterManager) expireFilter(id string) bool {
+	f.Lock()
+	defer f.Unlock()
+
+	filter, ok := f.filters[id]
+	if !ok {
+		return false
+	}
+
+	f.removeFilterByID(id)
+	f.pruneExpiredFilters()
+
+	if f.retention == 0 {
+		return true
+	}
+
+	switch filter.(type) {
+	case *blockFilter, *logFilter:
+		// the blocks processed so far are already in the filter
+		f.expired[id] = &expiredFilter{
+			filter:   filter,
+			block:    uint64(f.blockStream.getHead().header.Number),
+			removeAt: time.Now().UTC().Add(f.retention),
+		}
+	}
+
+	return true
+}
+
+// restoreExpiredFilter reinstalls the timed out filter if it is still retained.
+// The block filter still follows the block stream, the log filter gets the logs
+// of the blocks processed since it timed out
+func (f *FilterManager) restoreExpiredFilter(id string) error {
+	f.Lock()
+	defer f.Unlock()
+
+	f.pruneExpiredFilters()
+
+	expired, ok := f.expired[id]
+	if !ok {
+		return nil
+	}
+
+	// the events are not processed while the lock is held, so the head doesn't move
+	head := uint64(f.blockStream.getHead().header.Number)
+
+	if logFilter, ok := expired.filter.(*logFilter); ok && logFilter.query.BlockHash == nil && head > expired.block {
+		query := *logFilter.query
+		query.fromBlock = BlockNumber(expired.block + 1)
+		query.toBlock = BlockNumber(head)
+
+		logs, err := f.getLogsFromBlocks(&query)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			logFilter.appendLog(log)
+		}
+	}
+
+	delete(f.expired, id)
+
+	f.filters[id] = expired.filter
+	f.addFilterTimeout(expired.filter.getFilterBase())
+
+	return nil
+}
+
+// pruneExpiredFilters drops the timed out filters kept longer than the retention [NOT Thread Safe]
+func (f *FilterManager) pruneExpiredFilters() {
+	now := time.Now().UTC()
+
+	for id, expired := range f.expired {
+		if now.After(expired.removeAt) {
+			delete(f.expired, id)
+		}
+	}
+}
+
 // removeFilterByID removes the filter with given ID [NOT Thread Safe]
 func (f *FilterManager) removeFilterByID(id string) bool {
 	// Make sure filter exists
@@ -625,6 +970,12 @@ func (f *FilterManager) dispatchEvent(evnt *blockchain.Event) error {
 	// store new event in each filters
 	f.processEvent(evnt)
 
+	// wake up the long-polling requests
+	f.Lock()
+	close(f.eventCh)
+	f.eventCh = make(chan struct{})
+	f.Unlock()
+
 	// send data to web socket stream
 	if err := f.flushWsFilters(); err != nil {
 		return err
@@ -638,12 +989,21 @@ func (f *FilterManager) processEvent(evnt *blockchain.Event) {
 	f.RLock()
 	defer f.RUnlock()
 
+	if evnt.Reorg != nil {
+		f.appendReorgToFilters(toReorg(evnt.Reorg))
+	}
+
 	for _, header := range evnt.NewChain {
 		block := toBlock(&types.Block{Header: header}, false)
 
 		// first include all the new headers in the blockstream for BlockFilter
 		f.blockStream.push(block)
 
+		if header.Number == 0 {
+			// the genesis (the head of the chain rewound in the dev mode) has no logs
+			continue
+		}
+
 		// process new chain to include new logs for LogFilter
 		if processErr := f.appendLogsToFilters(block); processErr != nil {
 			f.logger.Error(fmt.Sprintf("Unable to process block, %v", processErr))
@@ -651,6 +1011,15 @@ func (f *FilterManager) processEvent(evnt *blockchain.Event) {
 	}
 }
 
+// appendReorgToFilters makes each ReorgFilter append the reorg event
+func (f *FilterManager) appendReorgToFilters(reorg *reorg) {
+	for _, filter := range f.filters {
+		if reorgFilter, ok := filter.(*reorgFilter); ok {
+			reorgFilter.appendReorg(reorg)
+		}
+	}
+}
+
 // appendLogsToFilters makes each LogFilters append logs in the header
 func (f *FilterManager) appendLogsToFilters(header *block) error {
 	receipts, err := f.store.GetReceiptsByHash(header.Hash)
@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -155,6 +156,145 @@ func Test_GetLogsForQuery(t *testing.T) {
 	}
 }
 
+func Test_GetLogsPageForQuery(t *testing.T) {
+	t.Parallel()
+
+	store := &mockBlockStore{}
+	store.setupLogs()
+
+	blocks := make([]*types.Block, 5)
+
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{
+				{
+					Value: big.NewInt(10),
+				},
+				{
+					Value: big.NewInt(11),
+				},
+				{
+					Value: big.NewInt(12),
+				},
+			},
+		}
+	}
+
+	store.appendBlocksToStore(blocks)
+
+	f := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+
+	t.Cleanup(func() {
+		defer f.Close()
+	})
+
+	allLogs, err := f.GetLogsForQuery(&LogQuery{fromBlock: 1, toBlock: 4})
+	require.NoError(t, err)
+	require.Len(t, allLogs, 7)
+
+	t.Run("pages return all logs", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			logs   []*Log
+			cursor *LogCursor
+		)
+
+		for {
+			page, err := f.GetLogsPageForQuery(&LogQuery{fromBlock: 1, toBlock: 4, Limit: 3, Cursor: cursor})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page.Logs), 3)
+
+			logs = append(logs, page.Logs...)
+
+			if cursor = page.Cursor; cursor == nil {
+				break
+			}
+		}
+
+		require.Equal(t, allLogs, logs)
+	})
+
+	t.Run("pages of the block hash", func(t *testing.T) {
+		t.Parallel()
+
+		blockHash := blocks[3].Hash()
+
+		page, err := f.GetLogsPageForQuery(&LogQuery{BlockHash: &blockHash, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page.Logs, 2)
+		require.Equal(t, &LogCursor{BlockNumber: 3, LogIndex: 2}, page.Cursor)
+
+		page, err = f.GetLogsPageForQuery(&LogQuery{BlockHash: &blockHash, Limit: 2, Cursor: page.Cursor})
+		require.NoError(t, err)
+		require.Len(t, page.Logs, 1)
+		require.Nil(t, page.Cursor)
+	})
+
+	t.Run("cursor out of the block range", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := f.GetLogsPageForQuery(&LogQuery{fromBlock: 1, toBlock: 2, Limit: 3, Cursor: &LogCursor{BlockNumber: 3}})
+		require.ErrorIs(t, err, ErrInvalidLogCursor)
+	})
+}
+
+func Test_LogsResultLimit(t *testing.T) {
+	t.Parallel()
+
+	store := &mockBlockStore{}
+	store.setupLogs()
+
+	blocks := make([]*types.Block, 4)
+
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{
+				{
+					Value: big.NewInt(10),
+				},
+				{
+					Value: big.NewInt(11),
+				},
+				{
+					Value: big.NewInt(12),
+				},
+			},
+		}
+	}
+
+	store.appendBlocksToStore(blocks)
+
+	f := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	f.logsResultLimit = 2
+
+	t.Cleanup(func() {
+		defer f.Close()
+	})
+
+	// the query exceeding the limit has to be paginated
+	_, err := f.GetLogsForQuery(&LogQuery{fromBlock: 1, toBlock: 3})
+	require.ErrorIs(t, err, ErrLogsResultLimitExceeded)
+
+	logs, err := f.GetLogsForQuery(&LogQuery{fromBlock: 1, toBlock: 1})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+
+	// the page size is capped by the limit
+	page, err := f.GetLogsPageForQuery(&LogQuery{fromBlock: 1, toBlock: 3, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, page.Logs, 2)
+	require.Equal(t, &LogCursor{BlockNumber: 2, LogIndex: 0}, page.Cursor)
+}
+
 func Test_getLogsFromBlock(t *testing.T) {
 	t.Parallel()
 
@@ -403,6 +543,74 @@ func TestFilterTimeout(t *testing.T) {
 	assert.False(t, m.Exists(id))
 }
 
+func TestFilterRetention(t *testing.T) {
+	t.Parallel()
+
+	topics := [][]types.Hash{{types.StringToHash("4")}, {types.StringToHash("5")}, {types.StringToHash("6")}}
+
+	blocks := make([]*types.Block, 4)
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{{Value: big.NewInt(10)}, {Value: big.NewInt(11)}},
+		}
+	}
+
+	store := &mockBlockStore{topics: []types.Hash{topics[0][0], topics[1][0], topics[2][0]}}
+	store.setupLogs()
+	store.appendBlocksToStore(blocks[:2])
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	m.retention = time.Minute
+
+	blockFilterID := m.NewBlockFilter(nil)
+	logFilterID := m.NewLogFilter(&LogQuery{Topics: topics}, nil)
+	droppedID := m.NewReorgFilter(nil)
+
+	ok, err := m.RefreshFilter(blockFilterID)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.True(t, m.expireFilter(blockFilterID))
+	require.True(t, m.expireFilter(logFilterID))
+	require.True(t, m.expireFilter(droppedID))
+	require.False(t, m.Exists(blockFilterID))
+	require.False(t, m.Exists(logFilterID))
+
+	// the blocks are processed while the filters are timed out
+	store.appendBlocksToStore(blocks[2:])
+	m.processEvent(&blockchain.Event{NewChain: []*types.Header{blocks[2].Header, blocks[3].Header}})
+
+	res, err := m.GetFilterChanges(blockFilterID)
+	require.NoError(t, err)
+	require.Equal(t, []string{blocks[2].Hash().String(), blocks[3].Hash().String()}, res)
+
+	res, err = m.GetFilterChanges(logFilterID)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.True(t, m.Exists(logFilterID))
+
+	// only the block and log filters are retained
+	_, err = m.GetFilterChanges(droppedID)
+	require.ErrorIs(t, err, ErrFilterNotFound)
+
+	ok, err = m.RefreshFilter(droppedID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the uninstalled filter is not retained
+	require.True(t, m.expireFilter(blockFilterID))
+	require.True(t, m.Uninstall(blockFilterID))
+
+	_, err = m.GetFilterChanges(blockFilterID)
+	require.ErrorIs(t, err, ErrFilterNotFound)
+}
+
 func TestRemoveFilterByWebsocket(t *testing.T) {
 	t.Parallel()
 
@@ -546,6 +754,54 @@ func TestFilterWebsocket(t *testing.T) {
 	}
 }
 
+func TestFilterWebsocket_Reorg(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	mock, msgCh := newMockWsConnWithMsgCh()
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	go m.Run()
+
+	m.NewReorgFilter(mock)
+
+	oldHead := &types.Header{Number: 2, Hash: types.StringToHash("1")}
+	newHead := &types.Header{Number: 2, Hash: types.StringToHash("2")}
+
+	store.emitEvent(&mockEvent{
+		OldChain: []*mockHeader{{header: oldHead}},
+		NewChain: []*mockHeader{{header: newHead}},
+		Reorg: &blockchain.ReorgEvent{
+			OldHead:    oldHead,
+			NewHead:    newHead,
+			Depth:      1,
+			DroppedTxs: []types.Hash{types.StringToHash("3")},
+		},
+	})
+
+	select {
+	case msg := <-msgCh:
+		var notification struct {
+			Params struct {
+				Result reorg `json:"result"`
+			} `json:"params"`
+		}
+
+		require.NoError(t, json.Unmarshal(msg, &notification))
+
+		result := notification.Params.Result
+		assert.Equal(t, oldHead.Hash, result.OldHead)
+		assert.Equal(t, newHead.Hash, result.NewHead)
+		assert.Equal(t, argUint64(1), result.Depth)
+		assert.Equal(t, []types.Hash{types.StringToHash("3")}, result.DroppedTxs)
+	case <-time.After(2 * time.Second):
+		t.Fatal("reorg notification not received")
+	}
+}
+
 type mockWsConn struct {
 	SetFilterIDFn  func(string)
 	GetFilterIDFn  func() string
@@ -675,6 +675,35 @@ func TestDecodeTxn(t *testing.T) {
 			},
 			err: false,
 		},
+		{
+			name: "should map the access list transaction",
+			arg: &txnArgs{
+				From:       &from,
+				To:         &to,
+				Gas:        &gas,
+				GasPrice:   &gasPrice,
+				Value:      &value,
+				Input:      &input,
+				Nonce:      &nonce,
+				Type:       argUintPtr(uint64(types.AccessListTx)),
+				AccessList: &types.TxAccessList{{Address: to, StorageKeys: []types.Hash{types.StringToHash("1")}}},
+			},
+			store: &debugEndpointMockStore{},
+			expected: &types.Transaction{
+				Type:       types.AccessListTx,
+				From:       from,
+				To:         &to,
+				Gas:        uint64(gas),
+				GasPrice:   new(big.Int).SetBytes([]byte(gasPrice)),
+				GasTipCap:  new(big.Int),
+				GasFeeCap:  new(big.Int),
+				Value:      new(big.Int).SetBytes([]byte(value)),
+				Input:      input,
+				Nonce:      uint64(nonce),
+				AccessList: types.TxAccessList{{Address: to, StorageKeys: []types.Hash{types.StringToHash("1")}}},
+			},
+			err: false,
+		},
 		{
 			name: "should set zero address to from and 0 to nonce if from is not given",
 			arg: &txnArgs{
@@ -1,14 +1,18 @@
 package jsonrpc
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
@@ -44,8 +48,12 @@ type JSONRPC struct {
 
 type dispatcher interface {
 	RemoveFilterByWs(conn wsConn)
-	HandleWs(reqBody []byte, conn wsConn) ([]byte, error)
-	Handle(reqBody []byte) ([]byte, error)
+	SubscribeStream(params []byte, conn wsConn) (string, error)
+	WaitFilterChanges(ctx context.Context, filterID string) (interface{}, error)
+	HandleWs(ctx context.Context, reqBody []byte, conn wsConn, traceID, apiKey string) ([]byte, error)
+	Handle(ctx context.Context, reqBody []byte, traceID, apiKey string) ([]byte, error)
+	Authorize(method, apiKey string) error
+	SetLimits(batchLengthLimit, blockRangeLimit uint64)
 }
 
 // JSONRPCStore defines all the methods required
@@ -57,6 +65,10 @@ type JSONRPCStore interface {
 	filterManagerStore
 	bridgeStore
 	debugStore
+	edgeStore
+	traceStore
+	relayStore
+	evmStore
 }
 
 type Config struct {
@@ -64,10 +76,34 @@ type Config struct {
 	Addr                     *net.TCPAddr
 	ChainID                  uint64
 	ChainName                string
+	NetworkID                uint64
 	AccessControlAllowOrigin []string
 	PriceLimit               uint64
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	CallCacheSize            uint64
+
+	// AllowedHosts are the host names accepted in the Host header of the requests, * allows any host.
+	// The IP addresses are always accepted, any host is accepted if empty
+	AllowedHosts []string
+
+	// FilterTimeout is the timeout of the polling filters, the default is used if not set
+	FilterTimeout time.Duration
+	// FilterRetention is how long the timed out polling filters are kept to be restored on the next poll
+	FilterRetention time.Duration
+	// LogsResultLimit is the max number of logs returned by a single logs query, zero disables it
+	LogsResultLimit uint64
+
+	// APIKeys authorizes the requests by their API keys, nil disables the API keys
+	APIKeys *APIKeyManager
+	// SigningKeys are the keys of the unlocked accounts the node signs the transactions
+	// and the messages for (e.g. the dev accounts)
+	SigningKeys []*ecdsa.PrivateKey
+
+	// Namespaces are the JSON-RPC namespaces registered by the extensions
+	Namespaces map[string]interface{}
+	// Tracers are the tracers registered by the extensions
+	Tracers map[string]extension.TracerFactory
 }
 
 // NewJSONRPC returns the JSONRPC http server
@@ -78,9 +114,18 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 		&dispatcherParams{
 			chainID:                 config.ChainID,
 			chainName:               config.ChainName,
+			networkID:               config.NetworkID,
 			priceLimit:              config.PriceLimit,
 			jsonRPCBatchLengthLimit: config.BatchLengthLimit,
 			blockRangeLimit:         config.BlockRangeLimit,
+			callCacheSize:           config.CallCacheSize,
+			filterTimeout:           config.FilterTimeout,
+			filterRetention:         config.FilterRetention,
+			logsResultLimit:         config.LogsResultLimit,
+			apiKeys:                 config.APIKeys,
+			signingKeys:             config.SigningKeys,
+			namespaces:              config.Namespaces,
+			tracers:                 config.Tracers,
 		},
 	)
 
@@ -102,6 +147,12 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 	return srv, nil
 }
 
+// SetLimits sets the batch request length limit and the block range limit of the running server,
+// zero disables the limit. [thread-safe]
+func (j *JSONRPC) SetLimits(batchLengthLimit, blockRangeLimit uint64) {
+	j.dispatcher.SetLimits(batchLengthLimit, blockRangeLimit)
+}
+
 func (j *JSONRPC) setupHTTP() error {
 	j.logger.Info("http server started", "addr", j.config.Addr.String())
 
@@ -119,7 +170,11 @@ func (j *JSONRPC) setupHTTP() error {
 	jsonRPCHandler := http.HandlerFunc(j.handle)
 	mux.Handle("/", middlewareFactory(j.config)(jsonRPCHandler))
 
-	mux.HandleFunc("/ws", j.handleWs)
+	mux.Handle("/ws", middlewareFactory(j.config)(http.HandlerFunc(j.handleWs)))
+
+	// the subscriptions fallbacks for the clients which can't hold the WS connection
+	mux.Handle("/sse", middlewareFactory(j.config)(http.HandlerFunc(j.handleSSE)))
+	mux.Handle("/poll", middlewareFactory(j.config)(http.HandlerFunc(j.handlePoll)))
 
 	srv := http.Server{
 		Handler:           mux,
@@ -135,10 +190,17 @@ func (j *JSONRPC) setupHTTP() error {
 	return nil
 }
 
-// The middlewareFactory builds a middleware which enables CORS using the provided config.
+// The middlewareFactory builds a middleware which rejects the requests to the hosts which are not allowed
+// and enables CORS using the provided config.
 func middlewareFactory(config *Config) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isAllowedHost(config.AllowedHosts, r.Host) {
+				http.Error(w, "invalid host specified", http.StatusForbidden)
+
+				return
+			}
+
 			origin := r.Header.Get("Origin")
 
 			for _, allowedOrigin := range config.AccessControlAllowOrigin {
@@ -159,6 +221,46 @@ func middlewareFactory(config *Config) func(http.Handler) http.Handler {
 	}
 }
 
+// isAllowedHost returns whether the host (with an optional port) of the request is allowed.
+// The IP addresses are always allowed, as they can't be used for the DNS rebinding
+func isAllowedHost(allowedHosts []string, host string) bool {
+	if len(allowedHosts) == 0 {
+		return true
+	}
+
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+
+	if net.ParseIP(strings.Trim(host, "[]")) != nil {
+		return true
+	}
+
+	for _, allowedHost := range allowedHosts {
+		if allowedHost == "*" || strings.EqualFold(allowedHost, host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAllowedOrigin returns whether the origin of the browser request is allowed by the CORS config.
+// The requests without the origin don't come from the browsers and are always allowed
+func isAllowedOrigin(allowedOrigins []string, origin string) bool {
+	if origin == "" {
+		return true
+	}
+
+	for _, allowedOrigin := range allowedOrigins {
+		if allowedOrigin == "*" || strings.EqualFold(allowedOrigin, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // wsUpgrader defines upgrade parameters for the WS connection
 var wsUpgrader = websocket.Upgrader{
 	// Uses the default HTTP buffer sizes for Read / Write buffers.
@@ -208,11 +310,18 @@ func isSupportedWSType(messageType int) bool {
 }
 
 func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
-	// CORS rule - Allow requests from anywhere
-	wsUpgrader.CheckOrigin = func(r *http.Request) bool { return true }
+	// CORS rule - allow the upgrade requests from the configured origins only
+	upgrader := wsUpgrader
+	upgrader.CheckOrigin = func(r *http.Request) bool {
+		return isAllowedOrigin(j.config.AccessControlAllowOrigin, r.Header.Get("Origin"))
+	}
+
+	// all the requests sent over the WS connection share the trace ID and the API key of the upgrade request
+	traceID := getTraceID(req)
+	apiKey := getAPIKey(req)
 
 	// Upgrade the connection to a WS one
-	ws, err := wsUpgrader.Upgrade(w, req, nil)
+	ws, err := upgrader.Upgrade(w, req, http.Header{TraceIDHeader: []string{traceID}})
 	if err != nil {
 		j.logger.Error(fmt.Sprintf("Unable to upgrade to a WS connection, %s", err.Error()))
 
@@ -229,9 +338,9 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 		}
 	}(ws)
 
-	wrapConn := &wsWrapper{ws: ws, logger: j.logger}
+	wrapConn := &wsWrapper{ws: ws, logger: j.logger.With("traceID", traceID)}
 
-	j.logger.Info("Websocket connection established")
+	j.logger.Info("Websocket connection established", "traceID", traceID)
 	// Run the listen loop
 	for {
 		// Read the incoming message
@@ -256,7 +365,7 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 
 		if isSupportedWSType(msgType) {
 			go func() {
-				resp, handleErr := j.dispatcher.HandleWs(message, wrapConn)
+				resp, handleErr := j.dispatcher.HandleWs(req.Context(), message, wrapConn, traceID, apiKey)
 				if handleErr != nil {
 					j.logger.Error(fmt.Sprintf("Unable to handle WS request, %s", handleErr.Error()))
 
@@ -277,8 +386,10 @@ func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
 	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
 	w.Header().Set(
 		"Access-Control-Allow-Headers",
-		"Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization",
+		"Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, "+
+			TraceIDHeader+", "+APIKeyHeader,
 	)
+	w.Header().Set("Access-Control-Expose-Headers", TraceIDHeader)
 
 	switch req.Method {
 	case "POST":
@@ -300,10 +411,15 @@ func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request)
 		return
 	}
 
+	traceID := getTraceID(req)
+
+	// echo the trace ID, so the client can correlate the response with the node logs
+	w.Header().Set(TraceIDHeader, traceID)
+
 	// log request
-	j.logger.Debug("handle", "request", string(data))
+	j.logger.Debug("handle", "request", string(data), "traceID", traceID)
 
-	resp, err := j.dispatcher.Handle(data)
+	resp, err := j.dispatcher.Handle(req.Context(), data, traceID, getAPIKey(req))
 
 	if err != nil {
 		_, _ = w.Write([]byte(err.Error()))
@@ -311,7 +427,7 @@ func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request)
 		_, _ = w.Write(resp)
 	}
 
-	j.logger.Debug("handle", "response", string(resp))
+	j.logger.Debug("handle", "response", string(resp), "traceID", traceID)
 }
 
 type GetResponse struct {
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/helper/tests"
 	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/go-hclog"
 )
@@ -66,3 +70,125 @@ func Test_handleGetRequest(t *testing.T) {
 		response,
 	)
 }
+
+func Test_handleJSONRPCRequest_TraceID(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{},
+	)
+
+	jsonRPC := &JSONRPC{
+		logger:     hclog.NewNullLogger(),
+		config:     &Config{},
+		dispatcher: dispatcher,
+	}
+
+	newRequest := func(traceID string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method": "web3_clientVersion"}`))
+		if traceID != "" {
+			req.Header.Set(TraceIDHeader, traceID)
+		}
+
+		return req
+	}
+
+	t.Run("client supplied trace ID is echoed", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := httptest.NewRecorder()
+		jsonRPC.handle(recorder, newRequest("client-trace:1"))
+
+		assert.Equal(t, "client-trace:1", recorder.Header().Get(TraceIDHeader))
+	})
+
+	t.Run("trace ID is generated when not supplied", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := httptest.NewRecorder()
+		jsonRPC.handle(recorder, newRequest(""))
+
+		require.NotEmpty(t, recorder.Header().Get(TraceIDHeader))
+	})
+
+	t.Run("invalid trace ID is replaced", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := httptest.NewRecorder()
+		jsonRPC.handle(recorder, newRequest("bad trace id"))
+
+		traceID := recorder.Header().Get(TraceIDHeader)
+		require.NotEmpty(t, traceID)
+		assert.NotEqual(t, "bad trace id", traceID)
+	})
+}
+
+func Test_middlewareFactory_AllowedHosts(t *testing.T) {
+	t.Parallel()
+
+	handler := middlewareFactory(&Config{
+		AccessControlAllowOrigin: []string{"https://app.example.com"},
+		AllowedHosts:             []string{"localhost", "rpc.example.com"},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	cases := []struct {
+		host    string
+		allowed bool
+	}{
+		{"localhost", true},
+		{"localhost:8545", true},
+		{"RPC.example.com:443", true},
+		{"127.0.0.1:8545", true},
+		{"[::1]:8545", true},
+		{"attacker.example.com", false},
+		{"attacker.example.com:8545", false},
+	}
+
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Host = c.host
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+
+		if c.allowed {
+			assert.Equal(t, http.StatusOK, recorder.Code, c.host)
+		} else {
+			assert.Equal(t, http.StatusForbidden, recorder.Code, c.host)
+		}
+	}
+
+	assert.True(t, isAllowedHost(nil, "attacker.example.com"))
+	assert.True(t, isAllowedHost([]string{"*"}, "attacker.example.com"))
+}
+
+func Test_middlewareFactory_AllowedOrigins(t *testing.T) {
+	t.Parallel()
+
+	handler := middlewareFactory(&Config{
+		AccessControlAllowOrigin: []string{"https://app.example.com"},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	newRequest := func(origin string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Origin", origin)
+
+		return req
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest("https://app.example.com"))
+	assert.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest("https://attacker.example.com"))
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+
+	// the WS upgrade requests are checked against the same origins
+	assert.True(t, isAllowedOrigin([]string{"https://app.example.com"}, "https://app.example.com"))
+	assert.True(t, isAllowedOrigin([]string{"https://app.example.com"}, ""))
+	assert.False(t, isAllowedOrigin([]string{"https://app.example.com"}, "https://attacker.example.com"))
+	assert.True(t, isAllowedOrigin([]string{"*"}, "https://attacker.example.com"))
+}
@@ -0,0 +1,42 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// ErrFeeRecipientUnavailable is returned if the consensus doesn't credit the block fees to a known address
+	ErrFeeRecipientUnavailable = errors.New("fee recipient is not available for the consensus")
+	// ErrFeeRecipientFixed is returned on the attempt to change the fee recipient
+	ErrFeeRecipientFixed = errors.New("fee recipient is set by the node configuration and can't be changed")
+)
+
+// minerStore provides access to the methods needed by miner endpoint
+type minerStore interface {
+	// FeeRecipient returns the address credited with the fees of the blocks built by the node
+	FeeRecipient() (types.Address, error)
+}
+
+// Miner is the miner jsonrpc endpoint. It exists for the compatibility with the tools
+// written for the clients where the fee recipient (etherbase) is set at runtime
+type Miner struct {
+	store minerStore
+}
+
+// SetEtherbase succeeds only if the address is the fee recipient of the node,
+// since the fee recipient is determined by the node configuration
+func (m *Miner) SetEtherbase(address types.Address) (interface{}, error) {
+	feeRecipient, err := m.store.FeeRecipient()
+	if err != nil {
+		return nil, err
+	}
+
+	if address != feeRecipient {
+		return nil, fmt.Errorf("%w: %s", ErrFeeRecipientFixed, feeRecipient)
+	}
+
+	return true, nil
+}
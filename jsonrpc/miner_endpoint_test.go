@@ -0,0 +1,58 @@
+package jsonrpc
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFeeRecipientStore struct {
+	ethStore
+
+	feeRecipient types.Address
+	err          error
+}
+
+func (m *mockFeeRecipientStore) FeeRecipient() (types.Address, error) {
+	return m.feeRecipient, m.err
+}
+
+func TestEth_Coinbase(t *testing.T) {
+	t.Parallel()
+
+	feeRecipient := types.StringToAddress("1")
+
+	eth := &Eth{store: &mockFeeRecipientStore{feeRecipient: feeRecipient}}
+
+	res, err := eth.Coinbase()
+	require.NoError(t, err)
+	assert.Equal(t, feeRecipient, res)
+
+	eth = &Eth{store: &mockFeeRecipientStore{err: ErrFeeRecipientUnavailable}}
+
+	_, err = eth.Coinbase()
+	assert.ErrorIs(t, err, ErrFeeRecipientUnavailable)
+}
+
+func TestMiner_SetEtherbase(t *testing.T) {
+	t.Parallel()
+
+	feeRecipient := types.StringToAddress("1")
+
+	miner := &Miner{store: &mockFeeRecipientStore{feeRecipient: feeRecipient}}
+
+	// setting the current fee recipient is a no-op
+	res, err := miner.SetEtherbase(feeRecipient)
+	require.NoError(t, err)
+	assert.Equal(t, true, res)
+
+	_, err = miner.SetEtherbase(types.StringToAddress("2"))
+	assert.ErrorIs(t, err, ErrFeeRecipientFixed)
+
+	miner = &Miner{store: &mockFeeRecipientStore{err: ErrFeeRecipientUnavailable}}
+
+	_, err = miner.SetEtherbase(feeRecipient)
+	assert.ErrorIs(t, err, ErrFeeRecipientUnavailable)
+}
@@ -3,8 +3,10 @@ package jsonrpc
 import (
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -39,6 +41,7 @@ type mockHeader struct {
 type mockEvent struct {
 	OldChain []*mockHeader
 	NewChain []*mockHeader
+	Reorg    *blockchain.ReorgEvent
 }
 
 type mockStore struct {
@@ -92,6 +95,7 @@ func (m *mockStore) emitEvent(evnt *mockEvent) {
 	bEvnt := &blockchain.Event{
 		NewChain: []*types.Header{},
 		OldChain: []*types.Header{},
+		Reorg:    evnt.Reorg,
 	}
 
 	for _, i := range evnt.NewChain {
@@ -172,6 +176,10 @@ func (m *mockStore) GetCapacity() (uint64, uint64) {
 	return 0, 0
 }
 
+func (m *mockStore) GetNonceGaps(addr types.Address) txpool.NonceGaps {
+	return txpool.NonceGaps{}
+}
+
 func (m *mockStore) GenerateExitProof(exitID uint64) (types.Proof, error) {
 	hash := types.BytesToHash([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
 
@@ -183,6 +191,15 @@ func (m *mockStore) GenerateExitProof(exitID uint64) (types.Proof, error) {
 	}, nil
 }
 
+func (m *mockStore) GetStateSyncTimeline(stateSyncID uint64) (*types.BridgeMessageTimeline, error) {
+	return &types.BridgeMessageTimeline{
+		ID: stateSyncID,
+		Stages: []*types.BridgeStageTime{
+			{Stage: "observed", Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}, nil
+}
+
 func (m *mockStore) GetPeers() int {
 	return 20
 }
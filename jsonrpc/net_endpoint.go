@@ -9,13 +9,13 @@ type networkStore interface {
 
 // Net is the net jsonrpc endpoint
 type Net struct {
-	store   networkStore
-	chainID uint64
+	store     networkStore
+	networkID uint64
 }
 
 // Version returns the current network id
 func (n *Net) Version() (interface{}, error) {
-	return strconv.FormatUint(n.chainID, 10), nil
+	return strconv.FormatUint(n.networkID, 10), nil
 }
 
 // Listening returns true if client is actively listening for network connections
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
@@ -15,10 +16,10 @@ func TestNetEndpoint_PeerCount(t *testing.T) {
 			chainID: 1,
 		})
 
-	resp, err := dispatcher.Handle([]byte(`{
+	resp, err := dispatcher.Handle(context.Background(), []byte(`{
 		"method": "net_peerCount",
 		"params": [""]
-	}`))
+	}`), "", "")
 	assert.NoError(t, err)
 
 	var res string
@@ -26,3 +27,41 @@ func TestNetEndpoint_PeerCount(t *testing.T) {
 	assert.NoError(t, expectJSONResult(resp, &res))
 	assert.Equal(t, "0x14", res)
 }
+
+func TestNetEndpoint_Version(t *testing.T) {
+	cases := []struct {
+		name     string
+		params   *dispatcherParams
+		expected string
+	}{
+		{
+			name:     "defaults to the chain ID",
+			params:   &dispatcherParams{chainID: 100},
+			expected: "100",
+		},
+		{
+			name:     "configured network ID",
+			params:   &dispatcherParams{chainID: 100, networkID: 7},
+			expected: "7",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), newMockStore(), c.params)
+
+			resp, err := dispatcher.Handle(context.Background(), []byte(`{
+				"method": "net_version",
+				"params": []
+			}`), "", "")
+			assert.NoError(t, err)
+
+			var res string
+
+			assert.NoError(t, expectJSONResult(resp, &res))
+			assert.Equal(t, c.expected, res)
+		})
+	}
+}
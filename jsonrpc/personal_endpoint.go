@@ -0,0 +1,38 @@
+package jsonrpc
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Personal is the personal jsonrpc endpoint, which signs with the unlocked accounts of the node
+// (e.g. the dev accounts). The accounts are never locked, so the passwords of the requests are ignored
+type Personal struct {
+	eth *Eth
+}
+
+// ListAccounts returns the addresses of the unlocked accounts
+func (p *Personal) ListAccounts() (interface{}, error) {
+	return p.eth.accounts.list(), nil
+}
+
+// Sign returns the EIP-191 signature of the data signed by the unlocked account
+func (p *Personal) Sign(data argBytes, address types.Address, _ *string) (interface{}, error) {
+	return p.eth.Sign(address, data)
+}
+
+// EcRecover returns the address of the account which signed the data with personal_sign
+func (p *Personal) EcRecover(data argBytes, sig argBytes) (interface{}, error) {
+	return ecRecover(data, sig)
+}
+
+// SendTransaction signs the transaction with the unlocked account and sends it
+func (p *Personal) SendTransaction(ctx context.Context, arg *txnArgs, _ *string) (interface{}, error) {
+	tx, err := p.eth.sendTransaction(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
+}
@@ -1,12 +1,20 @@
 package jsonrpc
 
 import (
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
+// logCursorLength is the length of the encoded log cursor (block number and log index)
+const logCursorLength = 16
+
+var ErrInvalidLogCursor = errors.New("invalid log cursor")
+
 // LogQuery is a query to filter logs
 type LogQuery struct {
 	BlockHash *types.Hash
@@ -16,6 +24,40 @@ type LogQuery struct {
 
 	Addresses []types.Address
 	Topics    [][]types.Hash
+
+	// Limit is the max number of logs of the page, the paginated response is returned if set
+	Limit uint64
+	// Cursor is the position the page starts at, returned by the previous page
+	Cursor *LogCursor
+}
+
+// LogCursor is the position of the log the next page of the logs query starts at
+type LogCursor struct {
+	BlockNumber uint64
+	LogIndex    uint64
+}
+
+// MarshalText encodes the cursor as an opaque hex string
+func (c LogCursor) MarshalText() ([]byte, error) {
+	buf := make([]byte, logCursorLength)
+
+	binary.BigEndian.PutUint64(buf[:8], c.BlockNumber)
+	binary.BigEndian.PutUint64(buf[8:], c.LogIndex)
+
+	return []byte(hex.EncodeToHex(buf)), nil
+}
+
+// UnmarshalText decodes the cursor returned by the previous page
+func (c *LogCursor) UnmarshalText(text []byte) error {
+	buf, err := hex.DecodeHex(string(text))
+	if err != nil || len(buf) != logCursorLength {
+		return ErrInvalidLogCursor
+	}
+
+	c.BlockNumber = binary.BigEndian.Uint64(buf[:8])
+	c.LogIndex = binary.BigEndian.Uint64(buf[8:])
+
+	return nil
 }
 
 // addTopicSet adds specific topics to the log filter topics
@@ -72,6 +114,25 @@ func decodeLogQueryFromInterface(i interface{}) (*LogQuery, error) {
 	return query, nil
 }
 
+// toLogQueryBlockNumber decodes the block number of the log query, the latest block by default.
+// The pending block has no logs yet, so it is queried as the latest one
+func toLogQueryBlockNumber(str string) (BlockNumber, error) {
+	if str == "" {
+		return LatestBlockNumber, nil
+	}
+
+	num, err := stringToBlockNumber(str)
+	if err != nil {
+		return 0, err
+	}
+
+	if num == PendingBlockNumber {
+		return LatestBlockNumber, nil
+	}
+
+	return num, nil
+}
+
 // UnmarshalJSON decodes a json object
 func (q *LogQuery) UnmarshalJSON(data []byte) error {
 	var obj struct {
@@ -80,6 +141,8 @@ func (q *LogQuery) UnmarshalJSON(data []byte) error {
 		ToBlock   string        `json:"toBlock"`
 		Address   interface{}   `json:"address"`
 		Topics    []interface{} `json:"topics"`
+		Limit     *argUint64    `json:"limit"`
+		Cursor    *LogCursor    `json:"cursor"`
 	}
 
 	err := json.Unmarshal(data, &obj)
@@ -89,21 +152,18 @@ func (q *LogQuery) UnmarshalJSON(data []byte) error {
 	}
 
 	q.BlockHash = obj.BlockHash
+	q.Cursor = obj.Cursor
 
-	if obj.FromBlock == "" {
-		q.fromBlock = LatestBlockNumber
-	} else {
-		if q.fromBlock, err = stringToBlockNumber(obj.FromBlock); err != nil {
-			return err
-		}
+	if obj.Limit != nil {
+		q.Limit = uint64(*obj.Limit)
 	}
 
-	if obj.ToBlock == "" {
-		q.toBlock = LatestBlockNumber
-	} else {
-		if q.toBlock, err = stringToBlockNumber(obj.ToBlock); err != nil {
-			return err
-		}
+	if q.fromBlock, err = toLogQueryBlockNumber(obj.FromBlock); err != nil {
+		return err
+	}
+
+	if q.toBlock, err = toLogQueryBlockNumber(obj.ToBlock); err != nil {
+		return err
 	}
 
 	if obj.Address != nil {
@@ -119,6 +119,29 @@ func TestFilterDecode(t *testing.T) {
 				toBlock:   LatestBlockNumber,
 			},
 		},
+		{
+			`{
+				"fromBlock": "0x1",
+				"toBlock": "0x10",
+				"limit": "0x64",
+				"cursor": "0x00000000000000050000000000000003"
+			}`,
+			&LogQuery{
+				fromBlock: 1,
+				toBlock:   16,
+				Limit:     100,
+				Cursor: &LogCursor{
+					BlockNumber: 5,
+					LogIndex:    3,
+				},
+			},
+		},
+		{
+			`{
+				"cursor": "0x05"
+			}`,
+			nil,
+		},
 	}
 
 	for indx, c := range cases {
@@ -141,6 +164,24 @@ func TestFilterDecode(t *testing.T) {
 	}
 }
 
+func TestLogCursor_MarshalText(t *testing.T) {
+	cursor := LogCursor{BlockNumber: 10, LogIndex: 2}
+
+	raw, err := cursor.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := LogCursor{}
+	if err := decoded.UnmarshalText(raw); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded != cursor {
+		t.Fatalf("bad cursor %v", decoded)
+	}
+}
+
 func TestFilterMatch(t *testing.T) {
 	cases := []struct {
 		filter LogQuery
@@ -0,0 +1,46 @@
+package jsonrpc
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/server/metatx"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// relayStore provides access to the methods needed by relay endpoint
+type relayStore interface {
+	// RelayMetaTx submits the signed meta-transaction to the txpool, paid by the sponsor account
+	RelayMetaTx(req *metatx.ForwardRequest, signature []byte) (types.Hash, error)
+
+	// MetaTxSponsor returns the address of the account paying for the relayed meta-transactions
+	MetaTxSponsor() (types.Address, error)
+}
+
+// Relay is the relay jsonrpc endpoint, accepting the EIP-2771 meta-transactions
+type Relay struct {
+	store relayStore
+}
+
+// SendMetaTransaction relays the meta-transaction signed by the sender to the trusted forwarder
+// and returns the hash of the sponsor transaction
+func (r *Relay) SendMetaTransaction(req *metaTxRequest, signature argBytes) (interface{}, error) {
+	forwardRequest := &metatx.ForwardRequest{
+		From:  req.From,
+		To:    req.To,
+		Value: big.NewInt(0),
+		Gas:   uint64(req.Gas),
+		Nonce: uint64(req.Nonce),
+		Data:  req.Data,
+	}
+
+	if req.Value != nil {
+		forwardRequest.Value = (*big.Int)(req.Value)
+	}
+
+	return r.store.RelayMetaTx(forwardRequest, signature)
+}
+
+// Sponsor returns the address of the account paying for the relayed meta-transactions
+func (r *Relay) Sponsor() (interface{}, error) {
+	return r.store.MetaTxSponsor()
+}
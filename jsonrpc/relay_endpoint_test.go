@@ -0,0 +1,77 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/server/metatx"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRelayStore struct {
+	req       *metatx.ForwardRequest
+	signature []byte
+	disabled  bool
+}
+
+func (m *mockRelayStore) RelayMetaTx(req *metatx.ForwardRequest, signature []byte) (types.Hash, error) {
+	if m.disabled {
+		return types.ZeroHash, metatx.ErrRelayerDisabled
+	}
+
+	m.req = req
+	m.signature = signature
+
+	return types.StringToHash("1"), nil
+}
+
+func (m *mockRelayStore) MetaTxSponsor() (types.Address, error) {
+	if m.disabled {
+		return types.ZeroAddress, metatx.ErrRelayerDisabled
+	}
+
+	return types.StringToAddress("1"), nil
+}
+
+func TestRelay_SendMetaTransaction(t *testing.T) {
+	t.Parallel()
+
+	store := &mockRelayStore{}
+	endpoint := &Relay{store: store}
+
+	req := &metaTxRequest{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"from": "0x0000000000000000000000000000000000000002",
+		"to": "0x0000000000000000000000000000000000000003",
+		"gas": "0x64",
+		"nonce": "0x1",
+		"data": "0x0102"
+	}`), req))
+
+	res, err := endpoint.SendMetaTransaction(req, argBytes{0x1})
+	require.NoError(t, err)
+	require.Equal(t, types.StringToHash("1"), res)
+	require.Equal(t, &metatx.ForwardRequest{
+		From:  types.StringToAddress("2"),
+		To:    types.StringToAddress("3"),
+		Value: big.NewInt(0),
+		Gas:   100,
+		Nonce: 1,
+		Data:  []byte{0x1, 0x2},
+	}, store.req)
+	require.Equal(t, []byte{0x1}, store.signature)
+
+	sponsor, err := endpoint.Sponsor()
+	require.NoError(t, err)
+	require.Equal(t, types.StringToAddress("1"), sponsor)
+
+	store.disabled = true
+
+	_, err = endpoint.SendMetaTransaction(req, argBytes{0x1})
+	require.ErrorIs(t, err, metatx.ErrRelayerDisabled)
+
+	_, err = endpoint.Sponsor()
+	require.ErrorIs(t, err, metatx.ErrRelayerDisabled)
+}
@@ -0,0 +1,214 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// ssePingInterval is the interval of the keep-alive comments sent to the server-sent events stream,
+	// so that the proxies don't close the idle connection
+	ssePingInterval = 30 * time.Second
+
+	// defaultPollTimeout and maxPollTimeout are the default and max time the long-polling request waits
+	// for the filter changes. The max timeout is kept below the filter timeout, so the polled filter isn't removed
+	defaultPollTimeout = 15 * time.Second
+	maxPollTimeout     = 30 * time.Second
+)
+
+// sseConn is the server-sent events stream of the subscription, which implements wsConn
+// so the filter manager pushes the subscription updates to it
+type sseConn struct {
+	sync.Mutex
+
+	ctx      context.Context
+	writer   http.ResponseWriter
+	flusher  http.Flusher
+	filterID string
+}
+
+func (c *sseConn) SetFilterID(filterID string) {
+	c.filterID = filterID
+}
+
+func (c *sseConn) GetFilterID() string {
+	return c.filterID
+}
+
+// WriteMessage writes out the message as the data of the server-sent event
+func (c *sseConn) WriteMessage(_ int, data []byte) error {
+	// the event data must not contain the new lines
+	buf := new(bytes.Buffer)
+	if err := json.Compact(buf, data); err != nil {
+		return err
+	}
+
+	return c.writeEvent("", buf.Bytes())
+}
+
+// writeEvent writes out the event of the given type to the stream, the event is a comment if the data is nil
+func (c *sseConn) writeEvent(event string, data []byte) error {
+	c.Lock()
+	defer c.Unlock()
+
+	// the client has gone away
+	if c.ctx.Err() != nil {
+		return net.ErrClosed
+	}
+
+	var err error
+
+	switch {
+	case data == nil:
+		_, err = fmt.Fprint(c.writer, ": ping\n\n")
+	case event != "":
+		_, err = fmt.Fprintf(c.writer, "event: %s\ndata: %s\n\n", event, data)
+	default:
+		_, err = fmt.Fprintf(c.writer, "data: %s\n\n", data)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.flusher.Flush()
+
+	return nil
+}
+
+// handleSSE streams the subscription updates as the server-sent events, for the clients which can't hold
+// the WS connection. The subscription is created with the eth_subscribe params passed in the query,
+// e.g. /sse?params=["logs",{"address":"0x..."}], its ID is sent as the first "subscription" event,
+// and the updates are sent as the eth_subscription notifications. The subscription is removed
+// once the client closes the connection
+func (j *JSONRPC) handleSSE(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Access-Control-Expose-Headers", TraceIDHeader)
+
+	if req.Method != http.MethodGet {
+		http.Error(w, "method "+req.Method+" not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+
+		return
+	}
+
+	traceID := getTraceID(req)
+	w.Header().Set(TraceIDHeader, traceID)
+
+	if err := j.dispatcher.Authorize("eth_subscribe", getAPIKey(req)); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	}
+
+	conn := &sseConn{ctx: req.Context(), writer: w, flusher: flusher}
+
+	// the updates pushed by the filter manager wait until the subscription event is sent
+	conn.Lock()
+
+	filterID, err := j.dispatcher.SubscribeStream([]byte(req.URL.Query().Get("params")), conn)
+	if err != nil {
+		conn.Unlock()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	defer j.dispatcher.RemoveFilterByWs(conn)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	conn.Unlock()
+
+	j.logger.Debug("sse subscription created", "id", filterID, "traceID", traceID)
+
+	if err := conn.writeEvent("subscription", []byte(strconv.Quote(filterID))); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(ssePingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := conn.writeEvent("", nil); err != nil {
+				return
+			}
+		case <-req.Context().Done():
+			j.logger.Debug("sse subscription closed", "id", filterID, "traceID", traceID)
+
+			return
+		}
+	}
+}
+
+// handlePoll returns the changes of the filter created with eth_newFilter, eth_newBlockFilter
+// or eth_newReorgFilter, e.g. /poll?id=0x...&timeout=15. Unlike eth_getFilterChanges,
+// the request is held until there are any changes or the timeout (in seconds) expires
+func (j *JSONRPC) handlePoll(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Expose-Headers", TraceIDHeader)
+	w.Header().Set(TraceIDHeader, getTraceID(req))
+
+	if req.Method != http.MethodGet {
+		http.Error(w, "method "+req.Method+" not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if err := j.dispatcher.Authorize("eth_getFilterChanges", getAPIKey(req)); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	}
+
+	timeout := defaultPollTimeout
+
+	if raw := req.URL.Query().Get("timeout"); raw != "" {
+		seconds, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid timeout: "+err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		if timeout = time.Duration(seconds) * time.Second; timeout > maxPollTimeout {
+			timeout = maxPollTimeout
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+
+	changes, err := j.dispatcher.WaitFilterChanges(ctx, req.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	resp, err := json.Marshal(changes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	_, _ = w.Write(resp)
+}
@@ -0,0 +1,163 @@
+package jsonrpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func emitTestHeader(store *mockStore, hash types.Hash) {
+	store.emitEvent(&mockEvent{
+		NewChain: []*mockHeader{
+			{
+				header: &types.Header{Hash: hash},
+			},
+		},
+	})
+}
+
+func TestFilterManager_WaitFilterChanges(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	go m.Run()
+
+	id := m.NewBlockFilter(nil)
+
+	// no changes until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	res, err := m.WaitFilterChanges(ctx, id)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// the request is woken up by the new block
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		emitTestHeader(store, hash1)
+	}()
+
+	res, err = m.WaitFilterChanges(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, []string{hash1.String()}, res)
+
+	// the unknown filter
+	_, err = m.WaitFilterChanges(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrFilterNotFound)
+}
+
+func TestJSONRPC_handlePoll(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{})
+	jsonRPC := &JSONRPC{logger: hclog.NewNullLogger(), config: &Config{}, dispatcher: dispatcher}
+
+	id := dispatcher.filterManager.NewBlockFilter(nil)
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		emitTestHeader(store, hash1)
+	}()
+
+	recorder := httptest.NewRecorder()
+	jsonRPC.handlePoll(recorder, httptest.NewRequest(http.MethodGet, "/poll?timeout=5&id="+id, nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `["`+hash1.String()+`"]`, recorder.Body.String())
+
+	// the invalid timeout
+	recorder = httptest.NewRecorder()
+	jsonRPC.handlePoll(recorder, httptest.NewRequest(http.MethodGet, "/poll?timeout=abc&id="+id, nil))
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestJSONRPC_handleSSE(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{})
+	jsonRPC := &JSONRPC{logger: hclog.NewNullLogger(), config: &Config{}, dispatcher: dispatcher}
+
+	srv := httptest.NewServer(http.HandlerFunc(jsonRPC.handleSSE))
+	defer srv.Close()
+
+	// the unknown subscription is rejected
+	resp, err := http.Get(srv.URL + "?params=" + url.QueryEscape(`["unknown"]`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		srv.URL+"?params="+url.QueryEscape(`["newHeads"]`), nil)
+	require.NoError(t, err)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readLine := func() string {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		return strings.TrimSuffix(line, "\n")
+	}
+
+	// the subscription ID is sent first
+	require.Equal(t, "event: subscription", readLine())
+
+	var filterID string
+
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(readLine(), "data: ")), &filterID))
+	require.True(t, dispatcher.filterManager.Exists(filterID))
+	require.Equal(t, "", readLine())
+
+	// the new head is sent as the eth_subscription notification
+	emitTestHeader(store, hash1)
+
+	var notification struct {
+		Method string `json:"method"`
+		Params struct {
+			Subscription string `json:"subscription"`
+			Result       struct {
+				Hash types.Hash `json:"hash"`
+			} `json:"result"`
+		} `json:"params"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(readLine(), "data: ")), &notification))
+	require.Equal(t, "eth_subscription", notification.Method)
+	require.Equal(t, filterID, notification.Params.Subscription)
+	require.Equal(t, hash1, notification.Params.Result.Hash)
+
+	// the subscription is removed once the client goes away
+	cancel()
+
+	require.Eventually(t, func() bool {
+		return !dispatcher.filterManager.Exists(filterID)
+	}, 5*time.Second, 50*time.Millisecond)
+}
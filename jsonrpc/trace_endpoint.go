@@ -0,0 +1,348 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// trace types of trace_replayBlockTransactions
+const (
+	traceTypeTrace     = "trace"
+	traceTypeStateDiff = "stateDiff"
+	traceTypeVMTrace   = "vmTrace"
+)
+
+// OpenEthereum trace types
+const (
+	traceCall    = "call"
+	traceCreate  = "create"
+	traceSuicide = "suicide"
+)
+
+var (
+	ErrVMTraceNotSupported = errors.New("vmTrace is not supported")
+	ErrNoTraceTypes        = errors.New("at least one trace type must be requested")
+)
+
+// ReplayResult is the result of the transaction re-execution
+type ReplayResult struct {
+	// Trace is the result of the tracer the transaction was executed with
+	Trace interface{}
+	// StateDiff are the account changes made by the transaction, nil if not requested
+	StateDiff []*state.AccountDiff
+}
+
+// traceStore provides access to the methods needed by trace endpoint
+type traceStore interface {
+	// Header returns the current header of the chain (genesis if empty)
+	Header() *types.Header
+
+	// GetBlockByNumber gets a block using the provided height
+	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
+
+	// ReplayBlock re-executes the transactions of the block with the given tracer,
+	// collecting the state changes of each transaction if stateDiff is set
+	ReplayBlock(ctx context.Context, block *types.Block, tracer tracer.Tracer, stateDiff bool) ([]*ReplayResult, error)
+}
+
+// Trace is the trace jsonrpc endpoint, compatible with the OpenEthereum trace module
+type Trace struct {
+	store traceStore
+}
+
+// ReplayBlockTransactions re-executes all the transactions of the block
+// and returns the requested call traces and state diffs of each of them
+func (t *Trace) ReplayBlockTransactions(
+	ctx context.Context,
+	blockNumber BlockNumber,
+	traceTypes []string,
+) (interface{}, error) {
+	var withTrace, withStateDiff bool
+
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case traceTypeTrace:
+			withTrace = true
+		case traceTypeStateDiff:
+			withStateDiff = true
+		case traceTypeVMTrace:
+			return nil, ErrVMTraceNotSupported
+		default:
+			return nil, fmt.Errorf("unknown trace type %s", traceType)
+		}
+	}
+
+	if !withTrace && !withStateDiff {
+		return nil, ErrNoTraceTypes
+	}
+
+	block, results, err := t.replayBlock(ctx, blockNumber, withStateDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*traceReplay, len(results))
+
+	for i, result := range results {
+		call, _ := result.Trace.(*calltracer.Call)
+
+		replay := &traceReplay{
+			Output:          argBytes{},
+			Trace:           []*parityTrace{},
+			TransactionHash: block.Transactions[i].Hash,
+		}
+
+		if call != nil {
+			replay.Output = call.Output
+		}
+
+		if withTrace {
+			replay.Trace = toParityTraces(call, nil)
+		}
+
+		if withStateDiff {
+			replay.StateDiff = toStateDiff(result.StateDiff)
+		}
+
+		res[i] = replay
+	}
+
+	return res, nil
+}
+
+// Block returns the call traces of all the transactions of the block
+func (t *Trace) Block(ctx context.Context, blockNumber BlockNumber) (interface{}, error) {
+	block, results, err := t.replayBlock(ctx, blockNumber, false)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*parityTrace{}
+
+	for i, result := range results {
+		call, _ := result.Trace.(*calltracer.Call)
+
+		for _, trace := range toParityTraces(call, nil) {
+			trace.BlockHash = argHashPtr(block.Hash())
+			trace.BlockNumber = argUintPtr(block.Number())
+			trace.TransactionHash = argHashPtr(block.Transactions[i].Hash)
+			trace.TransactionPosition = argUintPtr(uint64(i))
+
+			res = append(res, trace)
+		}
+	}
+
+	return res, nil
+}
+
+// replayBlock re-executes the transactions of the block with the call tracer
+func (t *Trace) replayBlock(
+	ctx context.Context,
+	blockNumber BlockNumber,
+	stateDiff bool,
+) (*types.Block, []*ReplayResult, error) {
+	num, err := GetNumericBlockNumber(blockNumber, t.store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if num == 0 {
+		return nil, nil, ErrTraceGenesisBlock
+	}
+
+	block, ok := t.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, nil, fmt.Errorf("block %d not found", num)
+	}
+
+	tracer := calltracer.NewCallTracer()
+
+	cancel := cancelTracerOnTimeout(ctx, defaultTraceTimeout, tracer)
+	defer cancel()
+
+	results, err := t.store.ReplayBlock(ctx, block, tracer, stateDiff)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return block, results, nil
+}
+
+// toParityTraces flattens the call tree into the list of traces, in the execution order
+func toParityTraces(call *calltracer.Call, traceAddress []int) []*parityTrace {
+	if call == nil {
+		return []*parityTrace{}
+	}
+
+	trace := &parityTrace{
+		Subtraces:    len(call.Calls),
+		TraceAddress: append([]int{}, traceAddress...),
+	}
+
+	value := call.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	if call.Type == runtime.Create || call.Type == runtime.Create2 {
+		trace.Type = traceCreate
+		trace.Action = &parityCreateAction{
+			From:  call.From,
+			Gas:   argUint64(call.Gas),
+			Init:  call.Input,
+			Value: argBig(*value),
+		}
+
+		if call.Err == nil {
+			trace.Result = &parityCreateResult{
+				Address: call.To,
+				Code:    call.Output,
+				GasUsed: argUint64(call.GasUsed),
+			}
+		}
+	} else {
+		trace.Type = traceCall
+		trace.Action = &parityCallAction{
+			CallType: parityCallType(call.Type),
+			From:     call.From,
+			Gas:      argUint64(call.Gas),
+			Input:    call.Input,
+			To:       call.To,
+			Value:    argBig(*value),
+		}
+
+		if call.Err == nil {
+			trace.Result = &parityCallResult{
+				GasUsed: argUint64(call.GasUsed),
+				Output:  call.Output,
+			}
+		}
+	}
+
+	if call.Err != nil {
+		trace.Error = parityError(call.Err)
+	}
+
+	if call.SelfDestruct != nil {
+		trace.Subtraces++
+	}
+
+	traces := []*parityTrace{trace}
+
+	for i, subcall := range call.Calls {
+		traces = append(traces, toParityTraces(subcall, append(trace.TraceAddress, i))...)
+	}
+
+	if sd := call.SelfDestruct; sd != nil {
+		traces = append(traces, &parityTrace{
+			Type: traceSuicide,
+			Action: &paritySuicideAction{
+				Address:       sd.Address,
+				Balance:       argBig(*sd.Balance),
+				RefundAddress: sd.RefundAddress,
+			},
+			TraceAddress: append(append([]int{}, trace.TraceAddress...), len(call.Calls)),
+		})
+	}
+
+	return traces
+}
+
+// parityCallType returns the OpenEthereum name of the call type
+func parityCallType(callType runtime.CallType) string {
+	switch callType {
+	case runtime.CallCode:
+		return "callcode"
+	case runtime.DelegateCall:
+		return "delegatecall"
+	case runtime.StaticCall:
+		return "staticcall"
+	default:
+		return "call"
+	}
+}
+
+// parityError returns the OpenEthereum representation of the execution error
+func parityError(err error) string {
+	switch {
+	case errors.Is(err, runtime.ErrExecutionReverted):
+		return "Reverted"
+	case errors.Is(err, runtime.ErrOutOfGas), errors.Is(err, runtime.ErrCodeStoreOutOfGas):
+		return "Out of gas"
+	default:
+		return err.Error()
+	}
+}
+
+// toStateDiff converts the account changes into the OpenEthereum state diff
+func toStateDiff(diffs []*state.AccountDiff) map[types.Address]*parityAccountDiff {
+	res := make(map[types.Address]*parityAccountDiff, len(diffs))
+
+	for _, diff := range diffs {
+		accountDiff := &parityAccountDiff{Storage: map[types.Hash]interface{}{}}
+
+		switch {
+		case diff.Before == nil:
+			accountDiff.Balance = parityBorn(argBig(*diff.After.Balance))
+			accountDiff.Nonce = parityBorn(argUint64(diff.After.Nonce))
+			accountDiff.Code = parityBorn(argBytes(diff.After.Code))
+
+			for key, val := range diff.After.Storage {
+				accountDiff.Storage[key] = parityBorn(val)
+			}
+		case diff.After == nil:
+			accountDiff.Balance = parityDied(argBig(*diff.Before.Balance))
+			accountDiff.Nonce = parityDied(argUint64(diff.Before.Nonce))
+			accountDiff.Code = parityDied(argBytes(diff.Before.Code))
+
+			for key, val := range diff.Before.Storage {
+				accountDiff.Storage[key] = parityDied(val)
+			}
+		default:
+			accountDiff.Balance = parityChange(
+				diff.Before.Balance.Cmp(diff.After.Balance) == 0,
+				argBig(*diff.Before.Balance), argBig(*diff.After.Balance),
+			)
+			accountDiff.Nonce = parityChange(
+				diff.Before.Nonce == diff.After.Nonce,
+				argUint64(diff.Before.Nonce), argUint64(diff.After.Nonce),
+			)
+			accountDiff.Code = parityChange(
+				string(diff.Before.Code) == string(diff.After.Code),
+				argBytes(diff.Before.Code), argBytes(diff.After.Code),
+			)
+
+			for key, val := range diff.After.Storage {
+				accountDiff.Storage[key] = parityChange(false, diff.Before.Storage[key], val)
+			}
+		}
+
+		res[diff.Address] = accountDiff
+	}
+
+	return res
+}
+
+func parityBorn(val interface{}) interface{} {
+	return map[string]interface{}{"+": val}
+}
+
+func parityDied(val interface{}) interface{} {
+	return map[string]interface{}{"-": val}
+}
+
+func parityChange(same bool, from, to interface{}) interface{} {
+	if same {
+		return "="
+	}
+
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
@@ -0,0 +1,311 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTraceStore struct {
+	block   *types.Block
+	results []*ReplayResult
+
+	stateDiff bool
+}
+
+func (m *mockTraceStore) Header() *types.Header {
+	return m.block.Header
+}
+
+func (m *mockTraceStore) GetBlockByNumber(num uint64, _ bool) (*types.Block, bool) {
+	if num != m.block.Number() {
+		return nil, false
+	}
+
+	return m.block, true
+}
+
+func (m *mockTraceStore) ReplayBlock(
+	_ context.Context,
+	_ *types.Block,
+	_ tracer.Tracer,
+	stateDiff bool,
+) ([]*ReplayResult, error) {
+	m.stateDiff = stateDiff
+
+	return m.results, nil
+}
+
+var (
+	traceFrom     = types.StringToAddress("1")
+	traceContract = types.StringToAddress("2")
+	traceCreated  = types.StringToAddress("3")
+)
+
+func newMockTraceStore() *mockTraceStore {
+	block := &types.Block{
+		Header:       &types.Header{Number: 5},
+		Transactions: []*types.Transaction{{Hash: types.StringToHash("10")}, {Hash: types.StringToHash("11")}},
+	}
+	block.Header.ComputeHash()
+
+	return &mockTraceStore{
+		block: block,
+		results: []*ReplayResult{
+			{
+				Trace: &calltracer.Call{
+					Type:    runtime.Call,
+					From:    traceFrom,
+					To:      traceContract,
+					Value:   big.NewInt(1),
+					Gas:     1000,
+					GasUsed: 600,
+					Input:   []byte{0x1},
+					Output:  []byte{0x2},
+					Calls: []*calltracer.Call{
+						{
+							Type:    runtime.Create,
+							From:    traceContract,
+							To:      traceCreated,
+							Gas:     500,
+							GasUsed: 100,
+							Input:   []byte{0x3},
+							Output:  []byte{0x4},
+							SelfDestruct: &calltracer.SelfDestruct{
+								Address:       traceCreated,
+								RefundAddress: traceFrom,
+								Balance:       big.NewInt(0),
+							},
+						},
+						{
+							Type:    runtime.DelegateCall,
+							From:    traceContract,
+							To:      traceFrom,
+							Gas:     300,
+							GasUsed: 300,
+							Err:     runtime.ErrExecutionReverted,
+						},
+					},
+				},
+				StateDiff: []*state.AccountDiff{
+					{
+						Address: traceFrom,
+						Before:  &state.AccountState{Balance: big.NewInt(10), Nonce: 1, Storage: map[types.Hash]types.Hash{}},
+						After:   &state.AccountState{Balance: big.NewInt(9), Nonce: 2, Storage: map[types.Hash]types.Hash{}},
+					},
+					{
+						Address: traceContract,
+						Before: &state.AccountState{
+							Balance: big.NewInt(0),
+							Code:    []byte{0x1},
+							Storage: map[types.Hash]types.Hash{types.StringToHash("1"): types.StringToHash("2")},
+						},
+						After: &state.AccountState{
+							Balance: big.NewInt(0),
+							Code:    []byte{0x1},
+							Storage: map[types.Hash]types.Hash{types.StringToHash("1"): types.StringToHash("3")},
+						},
+					},
+					{
+						Address: traceCreated,
+						After:   &state.AccountState{Balance: big.NewInt(0), Nonce: 1, Code: []byte{0x4}},
+					},
+				},
+			},
+			{
+				// the transaction which failed before the call started
+				Trace: (*calltracer.Call)(nil),
+			},
+		},
+	}
+}
+
+func toJSONMap(t *testing.T, v interface{}) interface{} {
+	t.Helper()
+
+	raw, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var res interface{}
+
+	require.NoError(t, json.Unmarshal(raw, &res))
+
+	return res
+}
+
+func TestTrace_ReplayBlockTransactions(t *testing.T) {
+	t.Parallel()
+
+	store := newMockTraceStore()
+	endpoint := &Trace{store: store}
+
+	res, err := endpoint.ReplayBlockTransactions(context.Background(), BlockNumber(5), []string{"trace", "stateDiff"})
+	require.NoError(t, err)
+	require.True(t, store.stateDiff)
+
+	expected := `[
+		{
+			"output": "0x02",
+			"stateDiff": {
+				"0x0000000000000000000000000000000000000001": {
+					"balance": {"*": {"from": "0xa", "to": "0x9"}},
+					"code": "=",
+					"nonce": {"*": {"from": "0x1", "to": "0x2"}},
+					"storage": {}
+				},
+				"0x0000000000000000000000000000000000000002": {
+					"balance": "=",
+					"code": "=",
+					"nonce": "=",
+					"storage": {
+						"0x0000000000000000000000000000000000000000000000000000000000000001": {
+							"*": {
+								"from": "0x0000000000000000000000000000000000000000000000000000000000000002",
+								"to": "0x0000000000000000000000000000000000000000000000000000000000000003"
+							}
+						}
+					}
+				},
+				"0x0000000000000000000000000000000000000003": {
+					"balance": {"+": "0x0"},
+					"code": {"+": "0x04"},
+					"nonce": {"+": "0x1"},
+					"storage": {}
+				}
+			},
+			"trace": [
+				{
+					"action": {
+						"callType": "call",
+						"from": "0x0000000000000000000000000000000000000001",
+						"gas": "0x3e8",
+						"input": "0x01",
+						"to": "0x0000000000000000000000000000000000000002",
+						"value": "0x1"
+					},
+					"result": {"gasUsed": "0x258", "output": "0x02"},
+					"subtraces": 2,
+					"traceAddress": [],
+					"type": "call"
+				},
+				{
+					"action": {
+						"from": "0x0000000000000000000000000000000000000002",
+						"gas": "0x1f4",
+						"init": "0x03",
+						"value": "0x0"
+					},
+					"result": {
+						"address": "0x0000000000000000000000000000000000000003",
+						"code": "0x04",
+						"gasUsed": "0x64"
+					},
+					"subtraces": 1,
+					"traceAddress": [0],
+					"type": "create"
+				},
+				{
+					"action": {
+						"address": "0x0000000000000000000000000000000000000003",
+						"balance": "0x0",
+						"refundAddress": "0x0000000000000000000000000000000000000001"
+					},
+					"result": null,
+					"subtraces": 0,
+					"traceAddress": [0, 0],
+					"type": "suicide"
+				},
+				{
+					"action": {
+						"callType": "delegatecall",
+						"from": "0x0000000000000000000000000000000000000002",
+						"gas": "0x12c",
+						"input": "0x",
+						"to": "0x0000000000000000000000000000000000000001",
+						"value": "0x0"
+					},
+					"error": "Reverted",
+					"result": null,
+					"subtraces": 0,
+					"traceAddress": [1],
+					"type": "call"
+				}
+			],
+			"transactionHash": "0x0000000000000000000000000000000000000000000000000000000000000010",
+			"vmTrace": null
+		},
+		{
+			"output": "0x",
+			"stateDiff": {},
+			"trace": [],
+			"transactionHash": "0x0000000000000000000000000000000000000000000000000000000000000011",
+			"vmTrace": null
+		}
+	]`
+
+	var expectedRes interface{}
+
+	require.NoError(t, json.Unmarshal([]byte(expected), &expectedRes))
+	require.Equal(t, expectedRes, toJSONMap(t, res))
+
+	// state diff is not collected if not requested
+	res, err = endpoint.ReplayBlockTransactions(context.Background(), BlockNumber(5), []string{"trace"})
+	require.NoError(t, err)
+	require.False(t, store.stateDiff)
+
+	replays, ok := res.([]*traceReplay)
+	require.True(t, ok)
+	require.Nil(t, replays[0].StateDiff)
+	require.Len(t, replays[0].Trace, 4)
+}
+
+func TestTrace_ReplayBlockTransactions_Errors(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &Trace{store: newMockTraceStore()}
+
+	_, err := endpoint.ReplayBlockTransactions(context.Background(), BlockNumber(5), []string{"vmTrace"})
+	require.ErrorIs(t, err, ErrVMTraceNotSupported)
+
+	_, err = endpoint.ReplayBlockTransactions(context.Background(), BlockNumber(5), []string{})
+	require.ErrorIs(t, err, ErrNoTraceTypes)
+
+	_, err = endpoint.ReplayBlockTransactions(context.Background(), BlockNumber(5), []string{"unknown"})
+	require.ErrorContains(t, err, "unknown trace type")
+
+	_, err = endpoint.ReplayBlockTransactions(context.Background(), BlockNumber(0), []string{"trace"})
+	require.ErrorIs(t, err, ErrTraceGenesisBlock)
+
+	_, err = endpoint.ReplayBlockTransactions(context.Background(), BlockNumber(4), []string{"trace"})
+	require.ErrorContains(t, err, "block 4 not found")
+}
+
+func TestTrace_Block(t *testing.T) {
+	t.Parallel()
+
+	store := newMockTraceStore()
+	endpoint := &Trace{store: store}
+
+	res, err := endpoint.Block(context.Background(), LatestBlockNumber)
+	require.NoError(t, err)
+	require.False(t, store.stateDiff)
+
+	traces, ok := res.([]*parityTrace)
+	require.True(t, ok)
+	require.Len(t, traces, 4)
+
+	for _, trace := range traces {
+		require.Equal(t, store.block.Hash(), *trace.BlockHash)
+		require.Equal(t, argUint64(5), *trace.BlockNumber)
+		require.Equal(t, types.StringToHash("10"), *trace.TransactionHash)
+		require.Equal(t, argUint64(0), *trace.TransactionPosition)
+	}
+}
@@ -0,0 +1,64 @@
+package jsonrpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// TraceIDHeader is the HTTP header carrying the request trace ID
+	TraceIDHeader = "X-Trace-Id"
+
+	// maxTraceIDLength is the maximum length of the client supplied trace ID
+	maxTraceIDLength = 128
+)
+
+type traceIDContextKey struct{}
+
+// contextWithTraceID returns a copy of the given context which carries the trace ID
+func contextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDContextKey{}, traceID)
+}
+
+// TraceIDFromContext returns the trace ID of the JSON-RPC request the given context belongs to,
+// or an empty string if the context doesn't carry it
+func TraceIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	traceID, _ := ctx.Value(traceIDContextKey{}).(string)
+
+	return traceID
+}
+
+// getTraceID returns the trace ID supplied by the client in the request header.
+// If the client didn't supply a valid one, a new trace ID is generated
+func getTraceID(req *http.Request) string {
+	if traceID := req.Header.Get(TraceIDHeader); isValidTraceID(traceID) {
+		return traceID
+	}
+
+	return uuid.NewString()
+}
+
+// isValidTraceID checks that the trace ID is non-empty, not too long and consists only of
+// alphanumeric characters and the '-', '_', '.' and ':' separators, so it is safe to log and echo back
+func isValidTraceID(traceID string) bool {
+	if len(traceID) == 0 || len(traceID) > maxTraceIDLength {
+		return false
+	}
+
+	for _, c := range traceID {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,38 @@
+package jsonrpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidTraceID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		traceID string
+		valid   bool
+	}{
+		{"", false},
+		{"4bf92f3577b34da6a3ce929d0e0e4736", true},
+		{"service-a:req_1.2", true},
+		{"with space", false},
+		{"new\nline", false},
+		{"quote\"", false},
+		{strings.Repeat("a", maxTraceIDLength), true},
+		{strings.Repeat("a", maxTraceIDLength+1), false},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.valid, isValidTraceID(c.traceID), c.traceID)
+	}
+}
+
+func TestTraceIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", TraceIDFromContext(context.Background()))
+	assert.Equal(t, "trace", TraceIDFromContext(contextWithTraceID(context.Background(), "trace")))
+}
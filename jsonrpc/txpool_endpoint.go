@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -17,6 +18,9 @@ type txPoolStore interface {
 
 	// GetBaseFee returns current base fee
 	GetBaseFee() uint64
+
+	// GetNonceGaps returns the enqueued nonces of the account and the nonce gaps preventing their promotion
+	GetNonceGaps(addr types.Address) txpool.NonceGaps
 }
 
 // TxPool is the txpool jsonrpc endpoint
@@ -125,3 +129,10 @@ func (t *TxPool) Status() (interface{}, error) {
 
 	return resp, nil
 }
+
+// Create response for txpool_nonceGaps request.
+// Returns the account's next nonce, the nonces of its enqueued transactions
+// and the ranges of missing nonces preventing their promotion.
+func (t *TxPool) NonceGaps(address types.Address) (interface{}, error) {
+	return t.store.GetNonceGaps(address), nil
+}
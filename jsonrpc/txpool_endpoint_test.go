@@ -1,10 +1,12 @@
 package jsonrpc
 
 import (
+	"encoding/json"
 	"math/big"
 	"strconv"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 
 	"github.com/stretchr/testify/assert"
@@ -264,6 +266,32 @@ func TestStatusEndpoint(t *testing.T) {
 	})
 }
 
+func TestNonceGapsEndpoint(t *testing.T) {
+	t.Parallel()
+
+	address := types.Address{0x1}
+	gaps := txpool.NonceGaps{
+		NextNonce: 2,
+		Enqueued:  []uint64{4, 5, 9},
+		Gaps:      []txpool.NonceGap{{From: 2, To: 3}, {From: 6, To: 8}},
+	}
+
+	mockStore := newMockTxPoolStore()
+	mockStore.nonceGaps = map[types.Address]txpool.NonceGaps{address: gaps}
+	txPoolEndpoint := &TxPool{mockStore}
+
+	result, err := txPoolEndpoint.NonceGaps(address)
+	assert.NoError(t, err)
+	assert.Equal(t, gaps, result)
+
+	encoded, err := json.Marshal(result)
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"nextNonce":2,"enqueued":[4,5,9],"gaps":[{"from":2,"to":3},{"from":6,"to":8}]}`,
+		string(encoded),
+	)
+}
+
 type mockTxPoolStore struct {
 	pending       map[types.Address][]*types.Transaction
 	queued        map[types.Address][]*types.Transaction
@@ -271,6 +299,7 @@ type mockTxPoolStore struct {
 	maxSlots      uint64
 	baseFee       uint64
 	includeQueued bool
+	nonceGaps     map[types.Address]txpool.NonceGaps
 }
 
 func newMockTxPoolStore() *mockTxPoolStore {
@@ -294,6 +323,10 @@ func (s *mockTxPoolStore) GetBaseFee() uint64 {
 	return s.baseFee
 }
 
+func (s *mockTxPoolStore) GetNonceGaps(addr types.Address) txpool.NonceGaps {
+	return s.nonceGaps[addr]
+}
+
 func newTestTransaction(nonce uint64, from types.Address) *types.Transaction {
 	txn := &types.Transaction{
 		Nonce:    nonce,
@@ -1,11 +1,15 @@
 package jsonrpc
 
 import (
+	"encoding/json"
 	"math/big"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -17,24 +21,26 @@ type transactionOrHash interface {
 }
 
 type transaction struct {
-	Nonce       argUint64      `json:"nonce"`
-	GasPrice    *argBig        `json:"gasPrice,omitempty"`
-	GasTipCap   *argBig        `json:"maxPriorityFeePerGas,omitempty"`
-	GasFeeCap   *argBig        `json:"maxFeePerGas,omitempty"`
-	Gas         argUint64      `json:"gas"`
-	To          *types.Address `json:"to"`
-	Value       argBig         `json:"value"`
-	Input       argBytes       `json:"input"`
-	V           argBig         `json:"v"`
-	R           argBig         `json:"r"`
-	S           argBig         `json:"s"`
-	Hash        types.Hash     `json:"hash"`
-	From        types.Address  `json:"from"`
-	BlockHash   *types.Hash    `json:"blockHash"`
-	BlockNumber *argUint64     `json:"blockNumber"`
-	TxIndex     *argUint64     `json:"transactionIndex"`
-	ChainID     *argBig        `json:"chainID,omitempty"`
-	Type        argUint64      `json:"type"`
+	Nonce       argUint64           `json:"nonce"`
+	GasPrice    *argBig             `json:"gasPrice,omitempty"`
+	GasTipCap   *argBig             `json:"maxPriorityFeePerGas,omitempty"`
+	GasFeeCap   *argBig             `json:"maxFeePerGas,omitempty"`
+	Gas         argUint64           `json:"gas"`
+	To          *types.Address      `json:"to"`
+	Value       argBig              `json:"value"`
+	Input       argBytes            `json:"input"`
+	V           argBig              `json:"v"`
+	R           argBig              `json:"r"`
+	S           argBig              `json:"s"`
+	Hash        types.Hash          `json:"hash"`
+	From        types.Address       `json:"from"`
+	BlockHash   *types.Hash         `json:"blockHash"`
+	BlockNumber *argUint64          `json:"blockNumber"`
+	TxIndex     *argUint64          `json:"transactionIndex"`
+	ChainID     *argBig             `json:"chainID,omitempty"`
+	Type        argUint64           `json:"type"`
+	AccessList  *types.TxAccessList `json:"accessList,omitempty"`
+	Sponsor     *types.Address      `json:"sponsor,omitempty"`
 }
 
 func (t transaction) getHash() types.Hash { return t.Hash }
@@ -94,6 +100,20 @@ func toTransaction(
 		res.ChainID = &chainID
 	}
 
+	// the typed transactions always report their access list, even if it's empty
+	if t.Type.HasAccessList() {
+		accessList := t.AccessList
+		if accessList == nil {
+			accessList = types.TxAccessList{}
+		}
+
+		res.AccessList = &accessList
+	}
+
+	if t.Type == types.SponsoredTx {
+		res.Sponsor = t.Sponsor
+	}
+
 	if txIndex != nil {
 		res.TxIndex = argUintPtr(uint64(*txIndex))
 	}
@@ -190,6 +210,82 @@ func toBlock(b *types.Block, fullTx bool) *block {
 	return res
 }
 
+// badBlock is the block which failed the verification, returned by debug_getBadBlocks
+type badBlock struct {
+	Hash   types.Hash                 `json:"hash"`
+	Block  *block                     `json:"block"`
+	RLP    string                     `json:"rlp"`
+	Reason string                     `json:"reason"`
+	Time   time.Time                  `json:"time"`
+	Result *blockchain.BadBlockResult `json:"result,omitempty"`
+}
+
+// traceReplay is the replayed transaction, returned by trace_replayBlockTransactions
+type traceReplay struct {
+	Output          argBytes                             `json:"output"`
+	StateDiff       map[types.Address]*parityAccountDiff `json:"stateDiff"`
+	Trace           []*parityTrace                       `json:"trace"`
+	VMTrace         interface{}                          `json:"vmTrace"`
+	TransactionHash types.Hash                           `json:"transactionHash"`
+}
+
+// parityTrace is the single call trace in the OpenEthereum format
+type parityTrace struct {
+	Action              interface{} `json:"action"`
+	BlockHash           *types.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *argUint64  `json:"blockNumber,omitempty"`
+	Error               string      `json:"error,omitempty"`
+	Result              interface{} `json:"result"`
+	Subtraces           int         `json:"subtraces"`
+	TraceAddress        []int       `json:"traceAddress"`
+	TransactionHash     *types.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *argUint64  `json:"transactionPosition,omitempty"`
+	Type                string      `json:"type"`
+}
+
+type parityCallAction struct {
+	CallType string        `json:"callType"`
+	From     types.Address `json:"from"`
+	Gas      argUint64     `json:"gas"`
+	Input    argBytes      `json:"input"`
+	To       types.Address `json:"to"`
+	Value    argBig        `json:"value"`
+}
+
+type parityCallResult struct {
+	GasUsed argUint64 `json:"gasUsed"`
+	Output  argBytes  `json:"output"`
+}
+
+type parityCreateAction struct {
+	From  types.Address `json:"from"`
+	Gas   argUint64     `json:"gas"`
+	Init  argBytes      `json:"init"`
+	Value argBig        `json:"value"`
+}
+
+type parityCreateResult struct {
+	Address types.Address `json:"address"`
+	Code    argBytes      `json:"code"`
+	GasUsed argUint64     `json:"gasUsed"`
+}
+
+type paritySuicideAction struct {
+	Address       types.Address `json:"address"`
+	Balance       argBig        `json:"balance"`
+	RefundAddress types.Address `json:"refundAddress"`
+}
+
+// parityAccountDiff is the account change in the OpenEthereum format.
+// Each field is either "=" if unchanged, {"+": value} if the account was created,
+// {"-": value} if the account was deleted or {"*": {"from": value, "to": value}} if changed
+type parityAccountDiff struct {
+	Balance interface{}                `json:"balance"`
+	Code    interface{}                `json:"code"`
+	Nonce   interface{}                `json:"nonce"`
+	Storage map[types.Hash]interface{} `json:"storage"`
+}
+
 type receipt struct {
 	Root              types.Hash     `json:"root"`
 	CumulativeGasUsed argUint64      `json:"cumulativeGasUsed"`
@@ -218,6 +314,12 @@ type Log struct {
 	Removed     bool          `json:"removed"`
 }
 
+// LogsPage is the page of the paginated logs query, the cursor of the next page is nil once all logs are returned
+type LogsPage struct {
+	Logs   []*Log     `json:"logs"`
+	Cursor *LogCursor `json:"cursor"`
+}
+
 type argBig big.Int
 
 func argBigPtr(b *big.Int) *argBig {
@@ -282,6 +384,32 @@ func (u *argUint64) UnmarshalText(input []byte) error {
 	return nil
 }
 
+// argNumberOrHex is the uint64 argument encoded either as the JSON number or as the hex string,
+// since the dev tooling sends both
+type argNumberOrHex uint64
+
+func (n *argNumberOrHex) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		var num argUint64
+		if err := json.Unmarshal(input, &num); err != nil {
+			return err
+		}
+
+		*n = argNumberOrHex(num)
+
+		return nil
+	}
+
+	num, err := strconv.ParseUint(string(input), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*n = argNumberOrHex(num)
+
+	return nil
+}
+
 type argBytes []byte
 
 func argBytesPtr(b []byte) *argBytes {
@@ -329,17 +457,28 @@ func encodeToHex(b []byte) []byte {
 
 // txnArgs is the transaction argument for the rpc endpoints
 type txnArgs struct {
-	From      *types.Address
-	To        *types.Address
-	Gas       *argUint64
-	GasPrice  *argBytes
-	GasTipCap *argBytes
-	GasFeeCap *argBytes
-	Value     *argBytes
-	Data      *argBytes
-	Input     *argBytes
-	Nonce     *argUint64
-	Type      *argUint64
+	From       *types.Address
+	To         *types.Address
+	Gas        *argUint64
+	GasPrice   *argBytes
+	GasTipCap  *argBytes
+	GasFeeCap  *argBytes
+	Value      *argBytes
+	Data       *argBytes
+	Input      *argBytes
+	Nonce      *argUint64
+	Type       *argUint64
+	AccessList *types.TxAccessList
+}
+
+// metaTxRequest is the EIP-2771 forward request signed by the sender
+type metaTxRequest struct {
+	From  types.Address
+	To    types.Address
+	Value *argBig
+	Gas   argUint64
+	Nonce argUint64
+	Data  argBytes
 }
 
 type progression struct {
@@ -347,6 +486,258 @@ type progression struct {
 	StartingBlock argUint64 `json:"startingBlock"`
 	CurrentBlock  argUint64 `json:"currentBlock"`
 	HighestBlock  argUint64 `json:"highestBlock"`
+
+	// Phase is the current phase of the sync (headers, bodies, blocks or state)
+	Phase string `json:"phase,omitempty"`
+	// BlocksPerSecond is the average sync rate
+	BlocksPerSecond float64 `json:"blocksPerSecond"`
+	// ETA is the estimated number of the seconds left to reach the highest block, 0 if unknown
+	ETA argUint64 `json:"eta"`
+	// Peers are the peers the node syncs from
+	Peers []syncPeer `json:"peers"`
+	// DownloadedBytes is the number of the bytes downloaded since the sync started
+	DownloadedBytes argUint64 `json:"downloadedBytes"`
+}
+
+type syncPeer struct {
+	ID     string    `json:"id"`
+	Number argUint64 `json:"number"`
+}
+
+type reorg struct {
+	OldHead       types.Hash   `json:"oldHead"`
+	OldHeadNumber argUint64    `json:"oldHeadNumber"`
+	NewHead       types.Hash   `json:"newHead"`
+	NewHeadNumber argUint64    `json:"newHeadNumber"`
+	Depth         argUint64    `json:"depth"`
+	DroppedTxs    []types.Hash `json:"droppedTransactions"`
+}
+
+func toReorg(r *blockchain.ReorgEvent) *reorg {
+	return &reorg{
+		OldHead:       r.OldHead.Hash,
+		OldHeadNumber: argUint64(r.OldHead.Number),
+		NewHead:       r.NewHead.Hash,
+		NewHeadNumber: argUint64(r.NewHead.Number),
+		Depth:         argUint64(r.Depth),
+		DroppedTxs:    r.DroppedTxs,
+	}
+}
+
+type headerAccumulatorProof struct {
+	Header     types.Hash   `json:"header"`
+	Number     argUint64    `json:"number"`
+	Head       types.Hash   `json:"head"`
+	HeadNumber argUint64    `json:"headNumber"`
+	Root       types.Hash   `json:"root"`
+	NumLeaves  argUint64    `json:"numLeaves"`
+	Siblings   []types.Hash `json:"siblings"`
+	Peaks      []types.Hash `json:"peaks"`
+}
+
+func toHeaderAccumulatorProof(header *types.Header, p *blockchain.HeaderAccumulatorProof) *headerAccumulatorProof {
+	return &headerAccumulatorProof{
+		Header:     header.Hash,
+		Number:     argUint64(header.Number),
+		Head:       p.Head.Hash,
+		HeadNumber: argUint64(p.Head.Number),
+		Root:       p.Root,
+		NumLeaves:  argUint64(p.Proof.NumLeaves),
+		Siblings:   p.Proof.Siblings,
+		Peaks:      p.Proof.Peaks,
+	}
+}
+
+type blockWitness struct {
+	Number       argUint64  `json:"number"`
+	Hash         types.Hash `json:"hash"`
+	PreStateRoot types.Hash `json:"preStateRoot"`
+	StateRoot    types.Hash `json:"stateRoot"`
+	Nodes        []argBytes `json:"nodes"`
+	Codes        []argBytes `json:"codes"`
+}
+
+func toBlockWitness(header *types.Header, w *state.Witness) *blockWitness {
+	result := &blockWitness{
+		Number:       argUint64(header.Number),
+		Hash:         header.Hash,
+		PreStateRoot: w.PreStateRoot,
+		StateRoot:    header.StateRoot,
+		Nodes:        make([]argBytes, len(w.Nodes)),
+		Codes:        make([]argBytes, len(w.Codes)),
+	}
+
+	for i, node := range w.Nodes {
+		result.Nodes[i] = argBytes(node)
+	}
+
+	for i, code := range w.Codes {
+		result.Codes[i] = argBytes(code)
+	}
+
+	return result
+}
+
+type contractCreation struct {
+	Address     types.Address `json:"address"`
+	Creator     types.Address `json:"creator"`
+	TxHash      types.Hash    `json:"transactionHash"`
+	BlockHash   types.Hash    `json:"blockHash"`
+	BlockNumber argUint64     `json:"blockNumber"`
+}
+
+func toContractCreation(c *types.ContractCreation) *contractCreation {
+	return &contractCreation{
+		Address:     c.Address,
+		Creator:     c.Creator,
+		TxHash:      c.TxHash,
+		BlockHash:   c.BlockHash,
+		BlockNumber: argUint64(c.BlockNumber),
+	}
+}
+
+type epochValidators struct {
+	Epoch          argUint64         `json:"epoch"`
+	BlockNumber    argUint64         `json:"blockNumber"`
+	BlockHash      types.Hash        `json:"blockHash"`
+	Header         argBytes          `json:"header"`
+	ValidatorsHash types.Hash        `json:"validatorsHash"`
+	Root           types.Hash        `json:"root"`
+	Validators     []*epochValidator `json:"validators"`
+}
+
+type epochValidator struct {
+	Address     types.Address `json:"address"`
+	BlsKey      argBytes      `json:"blsKey"`
+	VotingPower *argBig       `json:"votingPower"`
+	LeafIndex   argUint64     `json:"leafIndex"`
+	Leaf        argBytes      `json:"leaf"`
+	Proof       []types.Hash  `json:"proof"`
+}
+
+func toEpochValidators(v *types.EpochValidators) *epochValidators {
+	result := &epochValidators{
+		Epoch:          argUint64(v.Epoch),
+		BlockNumber:    argUint64(v.Header.Number),
+		BlockHash:      v.Header.Hash,
+		Header:         argBytes(v.Header.MarshalRLP()),
+		ValidatorsHash: v.ValidatorsHash,
+		Root:           v.Root,
+		Validators:     make([]*epochValidator, len(v.Validators)),
+	}
+
+	for i, val := range v.Validators {
+		result.Validators[i] = &epochValidator{
+			Address:     val.Address,
+			BlsKey:      argBytes(val.BlsKey),
+			VotingPower: argBigPtr(val.VotingPower),
+			LeafIndex:   argUint64(i),
+			Leaf:        argBytes(val.Leaf),
+			Proof:       val.Proof,
+		}
+	}
+
+	return result
+}
+
+type validatorQueue struct {
+	BlockNumber         argUint64          `json:"blockNumber"`
+	Epoch               argUint64          `json:"epoch"`
+	MaxValidatorSetSize argUint64          `json:"maxValidatorSetSize"`
+	Validators          argUint64          `json:"validators"`
+	OpenSlots           argUint64          `json:"openSlots"`
+	Queue               []*queuedValidator `json:"queue"`
+}
+
+type queuedValidator struct {
+	Address     types.Address `json:"address"`
+	VotingPower *argBig       `json:"votingPower"`
+}
+
+func toValidatorQueue(q *types.ValidatorQueue) *validatorQueue {
+	result := &validatorQueue{
+		BlockNumber:         argUint64(q.BlockNumber),
+		Epoch:               argUint64(q.Epoch),
+		MaxValidatorSetSize: argUint64(q.MaxValidatorSetSize),
+		Validators:          argUint64(q.Validators),
+		OpenSlots:           argUint64(q.OpenSlots),
+		Queue:               make([]*queuedValidator, len(q.Queue)),
+	}
+
+	for i, v := range q.Queue {
+		result.Queue[i] = &queuedValidator{
+			Address:     v.Address,
+			VotingPower: argBigPtr(v.VotingPower),
+		}
+	}
+
+	return result
+}
+
+type blockFeeBreakdown struct {
+	Number           argUint64             `json:"number"`
+	Hash             types.Hash            `json:"hash"`
+	BaseFee          argUint64             `json:"baseFeePerGas"`
+	GasUsed          argUint64             `json:"gasUsed"`
+	TotalFees        *argBig               `json:"totalFees"`
+	BurnedFees       *argBig               `json:"burnedFees"`
+	TipsPaid         *argBig               `json:"tipsPaid"`
+	ProtocolFees     []*protocolFee        `json:"protocolFees"`
+	ValidatorRewards []*validatorFeeReward `json:"validatorRewards"`
+	Transactions     []*txFeeBreakdown     `json:"transactions"`
+}
+
+type protocolFee struct {
+	Recipient   types.Address `json:"recipient"`
+	Destination types.Address `json:"destination"`
+	Amount      *argBig       `json:"amount"`
+}
+
+type validatorFeeReward struct {
+	Validator types.Address `json:"validator"`
+	Amount    *argBig       `json:"amount"`
+}
+
+type txFeeBreakdown struct {
+	Hash              types.Hash    `json:"hash"`
+	From              types.Address `json:"from"`
+	GasUsed           argUint64     `json:"gasUsed"`
+	EffectiveGasPrice *argBig       `json:"effectiveGasPrice"`
+	Fee               *argBig       `json:"fee"`
+	Burned            *argBig       `json:"burned"`
+	Tip               *argBig       `json:"tip"`
+}
+
+func newBlockFeeBreakdown(header *types.Header, txsCount int) *blockFeeBreakdown {
+	return &blockFeeBreakdown{
+		Number:           argUint64(header.Number),
+		Hash:             header.Hash,
+		BaseFee:          argUint64(header.BaseFee),
+		GasUsed:          argUint64(header.GasUsed),
+		TotalFees:        argBigPtr(big.NewInt(0)),
+		BurnedFees:       argBigPtr(big.NewInt(0)),
+		TipsPaid:         argBigPtr(big.NewInt(0)),
+		ProtocolFees:     []*protocolFee{},
+		ValidatorRewards: []*validatorFeeReward{},
+		Transactions:     make([]*txFeeBreakdown, 0, txsCount),
+	}
+}
+
+// addTx adds the fees of the transaction to the block totals
+func (b *blockFeeBreakdown) addTx(tx *types.Transaction, gasUsed uint64, fees *state.TxFees) {
+	(*big.Int)(b.TotalFees).Add((*big.Int)(b.TotalFees), fees.Paid)
+	(*big.Int)(b.BurnedFees).Add((*big.Int)(b.BurnedFees), fees.Burned)
+	(*big.Int)(b.TipsPaid).Add((*big.Int)(b.TipsPaid), fees.Tip)
+
+	b.Transactions = append(b.Transactions, &txFeeBreakdown{
+		Hash:              tx.Hash,
+		From:              tx.From,
+		GasUsed:           argUint64(gasUsed),
+		EffectiveGasPrice: argBigPtr(tx.GetGasPrice(uint64(b.BaseFee))),
+		Fee:               argBigPtr(fees.Paid),
+		Burned:            argBigPtr(fees.Burned),
+		Tip:               argBigPtr(fees.Tip),
+	})
 }
 
 type feeHistoryResult struct {
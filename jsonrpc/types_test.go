@@ -74,6 +74,18 @@ func TestDecode_TxnArgs(t *testing.T) {
 				Value: &hex,
 			},
 		},
+		{
+			data: `{
+				"to": "{{.Libp2pAddr}}",
+				"type": "0x1",
+				"accessList": [{"address": "{{.Libp2pAddr}}", "storageKeys": ["{{.Hash}}"]}]
+			}`,
+			res: &txnArgs{
+				To:         &addr,
+				Type:       argUintPtr(uint64(types.AccessListTx)),
+				AccessList: &types.TxAccessList{{Address: addr, StorageKeys: []types.Hash{{}}}},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -161,6 +173,34 @@ func TestToTransaction_EIP1559(t *testing.T) {
 	assert.Equal(t, hexWithoutLeading0, string(jsonS))
 }
 
+func TestToTransaction_AccessList(t *testing.T) {
+	txn := types.Transaction{
+		Type:     types.AccessListTx,
+		ChainID:  big.NewInt(100),
+		GasPrice: big.NewInt(10),
+		Value:    big.NewInt(0),
+		V:        big.NewInt(0),
+		R:        big.NewInt(1),
+		S:        big.NewInt(1),
+	}
+
+	// the typed transaction reports the empty access list
+	jsonTx := toTransaction(&txn, nil, nil, nil)
+	require.NotNil(t, jsonTx.AccessList)
+	assert.Empty(t, *jsonTx.AccessList)
+
+	txn.AccessList = types.TxAccessList{{Address: types.StringToAddress("1"), StorageKeys: []types.Hash{{0x1}}}}
+
+	res, err := json.Marshal(toTransaction(&txn, nil, nil, nil))
+	require.NoError(t, err)
+	assert.Contains(t, string(res), `"accessList":[{"address":"0x0000000000000000000000000000000000000001",`+
+		`"storageKeys":["0x0100000000000000000000000000000000000000000000000000000000000000"]}]`)
+
+	// the legacy transaction doesn't have the access list
+	txn.Type = types.LegacyTx
+	assert.Nil(t, toTransaction(&txn, nil, nil, nil).AccessList)
+}
+
 func TestBlock_Copy(t *testing.T) {
 	b := &block{
 		ExtraData: []byte{0x1},
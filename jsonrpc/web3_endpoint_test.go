@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -21,10 +22,10 @@ func TestWeb3EndpointSha3(t *testing.T) {
 			blockRangeLimit:         1000,
 		})
 
-	resp, err := dispatcher.Handle([]byte(`{
+	resp, err := dispatcher.Handle(context.Background(), []byte(`{
 		"method": "web3_sha3",
 		"params": ["0x68656c6c6f20776f726c64"]
-	}`))
+	}`), "", "")
 	assert.NoError(t, err)
 
 	var res string
@@ -51,10 +52,10 @@ func TestWeb3EndpointClientVersion(t *testing.T) {
 		},
 	)
 
-	resp, err := dispatcher.Handle([]byte(`{
+	resp, err := dispatcher.Handle(context.Background(), []byte(`{
 		"method": "web3_clientVersion",
 		"params": []
-	}`))
+	}`), "", "")
 	assert.NoError(t, err)
 
 	var res string
@@ -0,0 +1,199 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+var errEmptyAllowList = errors.New("allow list must contain at least one peer")
+
+// AllowList holds the only peers the node is allowed to dial and to accept the connections from (strict mode).
+// The peer configured with its multiaddrs is only allowed on the hosts of these multiaddrs,
+// while the peer configured by its peer ID only is allowed on any host
+type AllowList struct {
+	peers map[peer.ID][]multiaddr.Multiaddr
+}
+
+// ParseAllowList parses the allow list entries, which are either the multiaddrs
+// with the peer ID (/ip4/<ip>/tcp/<port>/p2p/<peer ID>) or the bare peer IDs
+func ParseAllowList(entries []string) (*AllowList, error) {
+	if len(entries) == 0 {
+		return nil, errEmptyAllowList
+	}
+
+	a := &AllowList{peers: make(map[peer.ID][]multiaddr.Multiaddr, len(entries))}
+
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry, "/") {
+			peerID, err := peer.Decode(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allow list peer ID %s: %w", entry, err)
+			}
+
+			a.peers[peerID] = nil
+
+			continue
+		}
+
+		info, err := peer.AddrInfoFromString(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allow list multiaddr %s: %w", entry, err)
+		}
+
+		if addrs, ok := a.peers[info.ID]; ok && addrs == nil {
+			// the peer is already allowed on any host
+			continue
+		}
+
+		a.peers[info.ID] = append(a.peers[info.ID], info.Addrs...)
+	}
+
+	return a, nil
+}
+
+// AddrInfos returns the allowed peers with the known multiaddrs, which are dialed by the node
+func (a *AllowList) AddrInfos() []*peer.AddrInfo {
+	infos := make([]*peer.AddrInfo, 0, len(a.peers))
+
+	for peerID, addrs := range a.peers {
+		if len(addrs) > 0 {
+			infos = append(infos, &peer.AddrInfo{ID: peerID, Addrs: addrs})
+		}
+	}
+
+	return infos
+}
+
+// Len returns the number of the allowed peers
+func (a *AllowList) Len() int {
+	return len(a.peers)
+}
+
+// allowsPeer checks if the peer is allowed on any of its hosts
+func (a *AllowList) allowsPeer(peerID peer.ID) bool {
+	_, ok := a.peers[peerID]
+
+	return ok
+}
+
+// allowsPeerAddr checks if the peer is allowed on the host of the given multiaddr
+func (a *AllowList) allowsPeerAddr(peerID peer.ID, addr multiaddr.Multiaddr) bool {
+	allowed, ok := a.peers[peerID]
+	if !ok {
+		return false
+	}
+
+	// the peer configured by its peer ID only is allowed on any host
+	if allowed == nil {
+		return true
+	}
+
+	for _, allowedAddr := range allowed {
+		if sameHost(allowedAddr, addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsRemoteAddr checks if any of the peers is allowed on the host of the given multiaddr,
+// it is used for the inbound connections, whose remote peer is not yet known
+func (a *AllowList) allowsRemoteAddr(addr multiaddr.Multiaddr) bool {
+	for peerID := range a.peers {
+		if a.allowsPeerAddr(peerID, addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameHost checks if the multiaddrs point to the same host. The IP multiaddrs are compared by their IPs,
+// since the port of the inbound connection is ephemeral, while the other ones (such as DNS) have to be equal
+func sameHost(a, b multiaddr.Multiaddr) bool {
+	ipA, errA := manet.ToIP(a)
+	ipB, errB := manet.ToIP(b)
+
+	if errA == nil && errB == nil {
+		return ipA.Equal(ipB)
+	}
+
+	return a.Equal(b)
+}
+
+// connectionGater rejects the connections of the banned peers, the connections of the peers
+// which are not on the allow list, if it is set, and the connections filtered out by the peer filter.
+// It implements the libp2p connection gater interface
+type connectionGater struct {
+	banList    *banList
+	allowList  *AllowList  // nil if all the peers are allowed
+	peerFilter *PeerFilter // nil if the peers are not filtered
+}
+
+// InterceptPeerDial rejects dialing the banned or not allowed peer
+func (g *connectionGater) InterceptPeerDial(peerID peer.ID) bool {
+	if g.allowList != nil && !g.allowList.allowsPeer(peerID) {
+		return false
+	}
+
+	if g.peerFilter != nil && !g.peerFilter.allowsPeer(peerID) {
+		return false
+	}
+
+	return g.banList.InterceptPeerDial(peerID)
+}
+
+// InterceptAddrDial rejects dialing the addresses of the banned peer, the addresses of the allowed peer
+// which are not on the allow list, and the addresses filtered out by the peer filter
+func (g *connectionGater) InterceptAddrDial(peerID peer.ID, addr multiaddr.Multiaddr) bool {
+	if g.allowList != nil && !g.allowList.allowsPeerAddr(peerID, addr) {
+		return false
+	}
+
+	if g.peerFilter != nil && !g.peerFilter.allowsPeerAddr(peerID, addr) {
+		return false
+	}
+
+	return g.banList.InterceptAddrDial(peerID, addr)
+}
+
+// InterceptAccept rejects the inbound connections from the hosts of none of the allowed peers,
+// and from the hosts filtered out by the peer filter
+func (g *connectionGater) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	if g.allowList != nil && !g.allowList.allowsRemoteAddr(addrs.RemoteMultiaddr()) {
+		return false
+	}
+
+	if g.peerFilter != nil && !g.peerFilter.allowsAddr(addrs.RemoteMultiaddr()) {
+		return false
+	}
+
+	return g.banList.InterceptAccept(addrs)
+}
+
+// InterceptSecured rejects the connection once the remote peer is authenticated,
+// if it is banned or not allowed on the remote host
+func (g *connectionGater) InterceptSecured(dir network.Direction, peerID peer.ID, addrs network.ConnMultiaddrs) bool {
+	if g.allowList != nil && !g.allowList.allowsPeerAddr(peerID, addrs.RemoteMultiaddr()) {
+		return false
+	}
+
+	if g.peerFilter != nil && !g.peerFilter.allowsPeerAddr(peerID, addrs.RemoteMultiaddr()) {
+		return false
+	}
+
+	return g.banList.InterceptSecured(dir, peerID, addrs)
+}
+
+// InterceptUpgraded accepts all the upgraded connections, as they already passed InterceptSecured
+func (g *connectionGater) InterceptUpgraded(conn network.Conn) (bool, control.DisconnectReason) {
+	return g.banList.InterceptUpgraded(conn)
+}
@@ -0,0 +1,123 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockConnMultiaddrs struct {
+	remote multiaddr.Multiaddr
+}
+
+func (m *mockConnMultiaddrs) LocalMultiaddr() multiaddr.Multiaddr {
+	return nil
+}
+
+func (m *mockConnMultiaddrs) RemoteMultiaddr() multiaddr.Multiaddr {
+	return m.remote
+}
+
+func newTestPeerID(t *testing.T) peer.ID {
+	t.Helper()
+
+	key, _, err := crypto.GenerateSecp256k1Key(nil)
+	require.NoError(t, err)
+
+	peerID, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	return peerID
+}
+
+func TestParseAllowList(t *testing.T) {
+	t.Parallel()
+
+	addrPeer := newTestPeerID(t)
+	anyHostPeer := newTestPeerID(t)
+
+	list, err := ParseAllowList([]string{
+		"/ip4/10.0.0.1/tcp/1478/p2p/" + addrPeer.String(),
+		"/ip4/10.0.0.2/tcp/1478/p2p/" + addrPeer.String(),
+		anyHostPeer.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, list.Len())
+
+	infos := list.AddrInfos()
+	require.Len(t, infos, 1)
+	require.Equal(t, addrPeer, infos[0].ID)
+	require.Len(t, infos[0].Addrs, 2)
+
+	_, err = ParseAllowList(nil)
+	require.ErrorIs(t, err, errEmptyAllowList)
+
+	_, err = ParseAllowList([]string{"invalid"})
+	require.ErrorContains(t, err, "invalid allow list peer ID")
+
+	// the multiaddr must contain the peer ID
+	_, err = ParseAllowList([]string{"/ip4/10.0.0.1/tcp/1478"})
+	require.ErrorContains(t, err, "invalid allow list multiaddr")
+}
+
+func TestConnectionGater_AllowList(t *testing.T) {
+	t.Parallel()
+
+	addrPeer := newTestPeerID(t)
+	anyHostPeer := newTestPeerID(t)
+	unknownPeer := newTestPeerID(t)
+
+	list, err := ParseAllowList([]string{
+		"/ip4/10.0.0.1/tcp/1478/p2p/" + addrPeer.String(),
+		anyHostPeer.String(),
+	})
+	require.NoError(t, err)
+
+	gater := &connectionGater{banList: newBanList(), allowList: list}
+
+	allowedAddr := multiaddr.StringCast("/ip4/10.0.0.1/tcp/30000")
+	otherAddr := multiaddr.StringCast("/ip4/10.0.0.3/tcp/1478")
+
+	// dialing
+	assert.True(t, gater.InterceptPeerDial(addrPeer))
+	assert.True(t, gater.InterceptPeerDial(anyHostPeer))
+	assert.False(t, gater.InterceptPeerDial(unknownPeer))
+
+	assert.True(t, gater.InterceptAddrDial(addrPeer, allowedAddr))
+	assert.False(t, gater.InterceptAddrDial(addrPeer, otherAddr))
+	assert.True(t, gater.InterceptAddrDial(anyHostPeer, otherAddr))
+	assert.False(t, gater.InterceptAddrDial(unknownPeer, allowedAddr))
+
+	// accepting, the peer allowed on any host lets any host pass until the peer is authenticated
+	assert.True(t, gater.InterceptAccept(&mockConnMultiaddrs{remote: otherAddr}))
+
+	assert.True(t, gater.InterceptSecured(network.DirInbound, addrPeer, &mockConnMultiaddrs{remote: allowedAddr}))
+	assert.False(t, gater.InterceptSecured(network.DirInbound, addrPeer, &mockConnMultiaddrs{remote: otherAddr}))
+	assert.True(t, gater.InterceptSecured(network.DirInbound, anyHostPeer, &mockConnMultiaddrs{remote: otherAddr}))
+	assert.False(t, gater.InterceptSecured(network.DirInbound, unknownPeer, &mockConnMultiaddrs{remote: allowedAddr}))
+
+	// the allowed peer can still be banned
+	gater.banList.ban(addrPeer, DefaultBanDuration)
+	assert.False(t, gater.InterceptPeerDial(addrPeer))
+
+	// only the hosts of the allowed peers are accepted if all of them have the multiaddrs
+	list, err = ParseAllowList([]string{"/ip4/10.0.0.1/tcp/1478/p2p/" + addrPeer.String()})
+	require.NoError(t, err)
+
+	gater = &connectionGater{banList: newBanList(), allowList: list}
+
+	assert.True(t, gater.InterceptAccept(&mockConnMultiaddrs{remote: allowedAddr}))
+	assert.False(t, gater.InterceptAccept(&mockConnMultiaddrs{remote: otherAddr}))
+
+	// no allow list allows all the peers
+	gater = &connectionGater{banList: newBanList()}
+
+	assert.True(t, gater.InterceptPeerDial(unknownPeer))
+	assert.True(t, gater.InterceptAddrDial(unknownPeer, otherAddr))
+	assert.True(t, gater.InterceptAccept(&mockConnMultiaddrs{remote: otherAddr}))
+}
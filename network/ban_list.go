@@ -0,0 +1,85 @@
+package network
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// DefaultBanDuration is the time a banned peer is not allowed to connect to the node
+const DefaultBanDuration = time.Hour
+
+// banList keeps track of the banned peers, and rejects their connections.
+// It implements the libp2p connection gater interface
+type banList struct {
+	banned map[peer.ID]time.Time // peerID -> time the ban expires
+	lock   sync.RWMutex
+}
+
+func newBanList() *banList {
+	return &banList{
+		banned: make(map[peer.ID]time.Time),
+	}
+}
+
+// ban bans the peer for the given duration
+func (b *banList) ban(peerID peer.ID, duration time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.banned[peerID] = time.Now().UTC().Add(duration)
+}
+
+// isBanned checks if the peer is banned, and removes the expired ban
+func (b *banList) isBanned(peerID peer.ID) bool {
+	b.lock.RLock()
+	expiresAt, ok := b.banned[peerID]
+	b.lock.RUnlock()
+
+	if !ok {
+		return false
+	}
+
+	if time.Now().UTC().Before(expiresAt) {
+		return true
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	// the ban could have been renewed in the meantime
+	if expiresAt, ok = b.banned[peerID]; ok && !time.Now().UTC().Before(expiresAt) {
+		delete(b.banned, peerID)
+	}
+
+	return false
+}
+
+// InterceptPeerDial rejects dialing the banned peer
+func (b *banList) InterceptPeerDial(peerID peer.ID) bool {
+	return !b.isBanned(peerID)
+}
+
+// InterceptAddrDial rejects dialing the addresses of the banned peer
+func (b *banList) InterceptAddrDial(peerID peer.ID, _ multiaddr.Multiaddr) bool {
+	return !b.isBanned(peerID)
+}
+
+// InterceptAccept accepts all the inbound connections, as the peer is not yet known
+func (b *banList) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured rejects the connection once the remote peer is authenticated, if it is banned
+func (b *banList) InterceptSecured(_ network.Direction, peerID peer.ID, _ network.ConnMultiaddrs) bool {
+	return !b.isBanned(peerID)
+}
+
+// InterceptUpgraded accepts all the upgraded connections, as they already passed InterceptSecured
+func (b *banList) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
@@ -0,0 +1,38 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBanList(t *testing.T) {
+	t.Parallel()
+
+	var (
+		list      = newBanList()
+		bannedID  = peer.ID("banned")
+		expiredID = peer.ID("expired")
+		otherID   = peer.ID("other")
+	)
+
+	list.ban(bannedID, time.Hour)
+	list.ban(expiredID, -time.Second)
+
+	assert.True(t, list.isBanned(bannedID))
+	assert.False(t, list.isBanned(otherID))
+
+	// the expired ban is removed
+	assert.False(t, list.isBanned(expiredID))
+	assert.NotContains(t, list.banned, expiredID)
+
+	assert.False(t, list.InterceptPeerDial(bannedID))
+	assert.False(t, list.InterceptAddrDial(bannedID, nil))
+	assert.False(t, list.InterceptSecured(network.DirInbound, bannedID, nil))
+	assert.True(t, list.InterceptPeerDial(otherID))
+	assert.True(t, list.InterceptSecured(network.DirOutbound, otherID, nil))
+	assert.True(t, list.InterceptAccept(nil))
+}
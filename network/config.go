@@ -2,6 +2,7 @@ package network
 
 import (
 	"net"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/secrets"
@@ -20,6 +21,20 @@ type Config struct {
 	MaxOutboundPeers int64                  // the maximum number of outbound peer connections
 	Chain            *chain.Chain           // the reference to the chain configuration
 	SecretsManager   secrets.SecretsManager // the secrets manager used for key storage
+
+	KeepAliveInterval time.Duration // the interval of the connection level keep-alive pings, 0 uses the default
+	IdleTimeout       time.Duration // the time to wait for the keep-alive ping response before closing the connection
+	PingInterval      time.Duration // the interval of the application level peer pings, 0 disables them
+	PingTimeout       time.Duration // the time to wait for the application level ping response
+
+	QUIC            bool // flag indicating if the QUIC transport is enabled alongside TCP, on the same port over UDP
+	NoNATPortMap    bool // flag indicating if the listening port should not be mapped on the router (UPnP, NAT-PMP)
+	NoObservedAddrs bool // flag indicating if the addresses observed by the peers should not be advertised
+
+	GossipCompression bool // flag indicating if the large gossip messages are published snappy compressed
+
+	AllowList  *AllowList  // the only peers the node connects to (discovery is disabled), nil allows all the peers
+	PeerFilter *PeerFilter // the CIDR ranges and peer IDs the peers are filtered by, nil allows all the peers
 }
 
 func DefaultConfig() *Config {
@@ -36,5 +51,10 @@ func DefaultConfig() *Config {
 		// The default ratio for outbound / inbound connections is 0.25
 		MaxInboundPeers:  32,
 		MaxOutboundPeers: 8,
+		// Dead peers are detected within seconds, instead of relying on the OS level TCP timeouts
+		KeepAliveInterval: 5 * time.Second,
+		IdleTimeout:       10 * time.Second,
+		PingInterval:      5 * time.Second,
+		PingTimeout:       5 * time.Second,
 	}
 }
@@ -10,6 +10,7 @@ const (
 	PeerDisconnected                          // Emitted when a peer disconnected from node
 	PeerDialCompleted                         // Emitted when a peer completed dial
 	PeerAddedToDialQueue                      // Emitted when a peer is added to dial queue
+	PeerBanned                                // Emitted when a peer is banned
 )
 
 var peerEventToName = map[PeerEventType]string{
@@ -18,6 +19,7 @@ var peerEventToName = map[PeerEventType]string{
 	PeerDisconnected:     "PeerDisconnected",
 	PeerDialCompleted:    "PeerDialCompleted",
 	PeerAddedToDialQueue: "PeerAddedToDialQueue",
+	PeerBanned:           "PeerBanned",
 }
 
 type PeerEvent struct {
@@ -27,6 +29,9 @@ type PeerEvent struct {
 
 	// Type is the type of the event
 	Type PeerEventType
+
+	// Reason is the reason the peer failed to connect or was banned, if any
+	Reason string
 }
 
 func (s PeerEventType) String() string {
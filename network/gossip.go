@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -21,14 +22,27 @@ const (
 	subscribeOutputBufferSize = 1024
 )
 
+// TopicOption configures the topic
+type TopicOption func(*Topic)
+
+// WithMaxMessageSize sets the max size of the marshaled (uncompressed) messages of the topic.
+// The larger messages are neither published nor accepted from the peers
+func WithMaxMessageSize(size int) TopicOption {
+	return func(t *Topic) {
+		t.maxMessageSize = size
+	}
+}
+
 type Topic struct {
 	logger hclog.Logger
 
-	topic     *pubsub.Topic
-	typ       reflect.Type
-	closeCh   chan struct{}
-	closed    atomic.Bool
-	waitGroup sync.WaitGroup
+	topic          *pubsub.Topic
+	typ            reflect.Type
+	maxMessageSize int
+	compress       bool
+	closeCh        chan struct{}
+	closed         atomic.Bool
+	waitGroup      sync.WaitGroup
 }
 
 func (t *Topic) createObj() proto.Message {
@@ -62,6 +76,12 @@ func (t *Topic) Publish(obj proto.Message) error {
 		return err
 	}
 
+	if len(data) > t.maxMessageSize {
+		return fmt.Errorf("%w: %d > %d", errMessageTooLarge, len(data), t.maxMessageSize)
+	}
+
+	data = encodeGossipPayload(data, t.compress)
+
 	metrics.SetGauge([]string{networkMetrics, "egress_bytes"}, float32(len(data)))
 
 	return t.topic.Publish(context.Background(), data)
@@ -107,8 +127,16 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 		}
 
 		go func() {
+			data, err := decodeGossipPayload(msg.Data, t.maxMessageSize)
+			if err != nil {
+				t.logger.Error("failed to decode topic", "err", err)
+				metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
+
+				return
+			}
+
 			obj := t.createObj()
-			if err := proto.Unmarshal(msg.Data, obj); err != nil {
+			if err := proto.Unmarshal(data, obj); err != nil {
 				t.logger.Error("failed to unmarshal topic", "err", err)
 				metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
 
@@ -122,19 +150,42 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 	}
 }
 
-func (s *Server) NewTopic(protoID string, obj proto.Message) (*Topic, error) {
+func (s *Server) NewTopic(protoID string, obj proto.Message, opts ...TopicOption) (*Topic, error) {
 	topic, err := s.ps.Join(protoID)
 	if err != nil {
 		return nil, err
 	}
 
 	tt := &Topic{
-		logger:  s.logger.Named(protoID),
-		topic:   topic,
-		typ:     reflect.TypeOf(obj).Elem(),
-		closeCh: make(chan struct{}),
+		logger:         s.logger.Named(protoID),
+		topic:          topic,
+		typ:            reflect.TypeOf(obj).Elem(),
+		maxMessageSize: pubsub.DefaultMaxMessageSize,
+		compress:       s.config.GossipCompression,
+		closeCh:        make(chan struct{}),
 	}
 	tt.closed.Store(false)
 
+	for _, opt := range opts {
+		opt(tt)
+	}
+
+	if err := s.ps.RegisterTopicValidator(protoID,
+		func(_ context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+			// the oversized messages are rejected, penalizing the peers relaying them
+			if size, err := decodedGossipPayloadLen(msg.Data); err != nil || size > tt.maxMessageSize {
+				return pubsub.ValidationReject
+			}
+
+			// the lost messages are ignored, so they aren't relayed further, but can still arrive from other peers
+			if s.dropGossip != nil && from != s.host.ID() && s.dropGossip(from) {
+				return pubsub.ValidationIgnore
+			}
+
+			return pubsub.ValidationAccept
+		}); err != nil {
+		return nil, err
+	}
+
 	return tt, nil
 }
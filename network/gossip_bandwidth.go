@@ -0,0 +1,51 @@
+package network
+
+import (
+	"github.com/armon/go-metrics"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+// bandwidthTracer accounts the gossip bandwidth per peer and topic, as the size of the payloads
+// received from and sent to each peer (including the duplicates, relayed by multiple peers)
+type bandwidthTracer struct{}
+
+var _ pubsub.RawTracer = (*bandwidthTracer)(nil)
+
+// ValidateMessage is called for the first received copy of the message
+func (bandwidthTracer) ValidateMessage(msg *pubsub.Message) {
+	addGossipBandwidth("ingress_bytes", msg.ReceivedFrom, msg.Message)
+}
+
+// DuplicateMessage is called for the already seen message received from another peer
+func (bandwidthTracer) DuplicateMessage(msg *pubsub.Message) {
+	addGossipBandwidth("ingress_bytes", msg.ReceivedFrom, msg.Message)
+}
+
+// SendRPC is called for each RPC sent to the peer, which carries the published and relayed messages
+func (bandwidthTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
+	for _, msg := range rpc.GetPublish() {
+		addGossipBandwidth("egress_bytes", p, msg)
+	}
+}
+
+func (bandwidthTracer) AddPeer(peer.ID, protocol.ID)          {}
+func (bandwidthTracer) RemovePeer(peer.ID)                    {}
+func (bandwidthTracer) Join(string)                           {}
+func (bandwidthTracer) Leave(string)                          {}
+func (bandwidthTracer) Graft(peer.ID, string)                 {}
+func (bandwidthTracer) Prune(peer.ID, string)                 {}
+func (bandwidthTracer) DeliverMessage(*pubsub.Message)        {}
+func (bandwidthTracer) RejectMessage(*pubsub.Message, string) {}
+func (bandwidthTracer) ThrottlePeer(peer.ID)                  {}
+func (bandwidthTracer) RecvRPC(*pubsub.RPC)                   {}
+func (bandwidthTracer) DropRPC(*pubsub.RPC, peer.ID)          {}
+func (bandwidthTracer) UndeliverableMessage(*pubsub.Message)  {}
+
+// addGossipBandwidth increments the gossip bandwidth counter of the peer and the topic of the message
+func addGossipBandwidth(name string, p peer.ID, msg *pb.Message) {
+	metrics.IncrCounterWithLabels([]string{networkMetrics, "gossip", name}, float32(len(msg.GetData())),
+		[]metrics.Label{{Name: "peer", Value: p.String()}, {Name: "topic", Value: msg.GetTopic()}})
+}
@@ -0,0 +1,77 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+)
+
+const (
+	// compressedPayloadMarker prefixes the snappy compressed gossip payloads.
+	// It never starts the protobuf encoded message (field number 0 is invalid),
+	// so the plain and the compressed payloads can't be mistaken for each other
+	compressedPayloadMarker byte = 0x00
+
+	// compressionThreshold is the size of the payload above which it is compressed
+	compressionThreshold = 1024
+)
+
+var errMessageTooLarge = errors.New("gossip message exceeds the topic max message size")
+
+// encodeGossipPayload compresses the marshaled message if the compression is enabled and the message is large enough.
+// The payload is left as is if the compression doesn't make it smaller
+func encodeGossipPayload(data []byte, compress bool) []byte {
+	if !compress || len(data) <= compressionThreshold {
+		return data
+	}
+
+	compressed := make([]byte, 1+snappy.MaxEncodedLen(len(data)))
+	compressed[0] = compressedPayloadMarker
+	compressed = compressed[:1+len(snappy.Encode(compressed[1:], data))]
+
+	if len(compressed) >= len(data) {
+		return data
+	}
+
+	return compressed
+}
+
+// decodedGossipPayloadLen returns the size of the marshaled message carried by the payload,
+// without decompressing it
+func decodedGossipPayloadLen(payload []byte) (int, error) {
+	if len(payload) == 0 || payload[0] != compressedPayloadMarker {
+		return len(payload), nil
+	}
+
+	size, err := snappy.DecodedLen(payload[1:])
+	if err != nil {
+		return 0, fmt.Errorf("invalid compressed gossip payload: %w", err)
+	}
+
+	return size, nil
+}
+
+// decodeGossipPayload returns the marshaled message carried by the payload, decompressing it if needed.
+// The size of the message is checked before decompressing it, so the oversized messages are never allocated
+func decodeGossipPayload(payload []byte, maxSize int) ([]byte, error) {
+	size, err := decodedGossipPayloadLen(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > maxSize {
+		return nil, fmt.Errorf("%w: %d > %d", errMessageTooLarge, size, maxSize)
+	}
+
+	if len(payload) == 0 || payload[0] != compressedPayloadMarker {
+		return payload, nil
+	}
+
+	data, err := snappy.Decode(nil, payload[1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid compressed gossip payload: %w", err)
+	}
+
+	return data, nil
+}
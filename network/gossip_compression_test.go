@@ -0,0 +1,67 @@
+package network
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeGossipPayload(t *testing.T) {
+	t.Parallel()
+
+	small := []byte(strings.Repeat("a", compressionThreshold))
+	large := []byte(strings.Repeat("a", 4*compressionThreshold))
+
+	// the compression is disabled or the payload is small
+	assert.Equal(t, large, encodeGossipPayload(large, false))
+	assert.Equal(t, small, encodeGossipPayload(small, true))
+
+	compressed := encodeGossipPayload(large, true)
+	require.Equal(t, compressedPayloadMarker, compressed[0])
+	assert.Less(t, len(compressed), len(large))
+
+	size, err := decodedGossipPayloadLen(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, len(large), size)
+
+	decoded, err := decodeGossipPayload(compressed, len(large))
+	require.NoError(t, err)
+	assert.Equal(t, large, decoded)
+
+	// the incompressible payload is left as is
+	random := make([]byte, 4*compressionThreshold)
+	_, err = rand.Read(random)
+	require.NoError(t, err)
+
+	assert.Equal(t, random, encodeGossipPayload(random, true))
+}
+
+func TestDecodeGossipPayload(t *testing.T) {
+	t.Parallel()
+
+	plain := []byte{0x0a, 0x01, 'a'}
+
+	decoded, err := decodeGossipPayload(plain, len(plain))
+	require.NoError(t, err)
+	assert.Equal(t, plain, decoded)
+
+	decoded, err = decodeGossipPayload(nil, 0)
+	require.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	// the size is checked against the decompressed message
+	large := []byte(strings.Repeat("a", 4*compressionThreshold))
+
+	_, err = decodeGossipPayload(encodeGossipPayload(large, true), len(large)-1)
+	require.ErrorIs(t, err, errMessageTooLarge)
+
+	_, err = decodeGossipPayload(plain, len(plain)-1)
+	require.ErrorIs(t, err, errMessageTooLarge)
+
+	// corrupted compressed payload
+	_, err = decodeGossipPayload([]byte{compressedPayloadMarker, 0xff, 0xff, 0xff}, len(large))
+	require.Error(t, err)
+}
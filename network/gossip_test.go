@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	testproto "github.com/0xPolygon/polygon-edge/network/proto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
 )
 
 func NumSubscribers(srv *Server, topic string) int {
@@ -118,3 +120,66 @@ func Test_RepeatedClose(t *testing.T) {
 	topic.Close()
 	topic.Close()
 }
+
+func TestGossip_CompressionAndMaxMessageSize(t *testing.T) {
+	t.Parallel()
+
+	const (
+		topicName      = "msg-compressed"
+		maxMessageSize = 4 * compressionThreshold
+	)
+
+	// the publisher compresses the messages, the receiver doesn't but still decodes them
+	servers, err := createServers(2, map[int]*CreateServerParams{
+		0: {ConfigCallback: func(c *Config) { c.GossipCompression = true }},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.Empty(t, MeshJoin(servers...))
+
+	publisherTopic, err := servers[0].NewTopic(topicName, &testproto.GenericMessage{})
+	require.NoError(t, err)
+
+	receiverTopic, err := servers[1].NewTopic(topicName, &testproto.GenericMessage{}, WithMaxMessageSize(maxMessageSize))
+	require.NoError(t, err)
+
+	messageCh := make(chan string, 10)
+
+	require.NoError(t, publisherTopic.Subscribe(func(interface{}, peer.ID) {}))
+	require.NoError(t, receiverTopic.Subscribe(func(obj interface{}, _ peer.ID) {
+		if msg, ok := obj.(*testproto.GenericMessage); ok {
+			messageCh <- msg.Message
+		}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, WaitForSubscribers(ctx, servers[0], topicName, 1))
+
+	// the oversized messages are neither published nor accepted
+	oversized := &testproto.GenericMessage{Message: strings.Repeat("b", 2*maxMessageSize)}
+
+	require.ErrorIs(t, receiverTopic.Publish(oversized), errMessageTooLarge)
+	require.NoError(t, publisherTopic.Publish(oversized))
+
+	compressed := &testproto.GenericMessage{Message: strings.Repeat("a", maxMessageSize/2)}
+	require.NoError(t, publisherTopic.Publish(compressed))
+
+	select {
+	case msg := <-messageCh:
+		require.Equal(t, compressed.Message, msg)
+	case <-time.After(10 * time.Second):
+		t.Fatal("compressed message not received before timeout")
+	}
+
+	select {
+	case msg := <-messageCh:
+		t.Fatalf("unexpected message of size %d received", len(msg))
+	case <-time.After(500 * time.Millisecond):
+	}
+}
@@ -34,6 +34,9 @@ type networkingServer interface {
 	// DisconnectFromPeer attempts to disconnect from the specified peer
 	DisconnectFromPeer(peerID peer.ID, reason string)
 
+	// BanPeer disconnects from the specified peer and rejects its further connections
+	BanPeer(peerID peer.ID, reason string)
+
 	// AddPeer adds a peer to the networking server's peer store
 	AddPeer(id peer.ID, direction network.Direction)
 
@@ -103,13 +106,18 @@ func (i *IdentityService) GetNotifyBundle() *network.NotifyBundle {
 			i.addPendingStatus(peerID, conn.Stat().Direction)
 
 			go func() {
-				eventType := event.PeerDialCompleted
+				eventType, reason := event.PeerDialCompleted, ""
 
 				if err := i.handleConnected(peerID, conn.Stat().Direction); err != nil {
-					// Close the connection to the peer
-					i.disconnectFromPeer(peerID, err.Error())
-
-					eventType = event.PeerFailedToConnect
+					if errors.Is(err, ErrInvalidChainID) {
+						// The peer is working on the other chain, don't let it reconnect
+						i.baseServer.BanPeer(peerID, err.Error())
+					} else {
+						// Close the connection to the peer
+						i.disconnectFromPeer(peerID, err.Error())
+					}
+
+					eventType, reason = event.PeerFailedToConnect, err.Error()
 				}
 
 				// Mark the peer as no longer pending
@@ -119,6 +127,7 @@ func (i *IdentityService) GetNotifyBundle() *network.NotifyBundle {
 				i.baseServer.EmitEvent(&event.PeerEvent{
 					PeerID: peerID,
 					Type:   eventType,
+					Reason: reason,
 				})
 			}()
 		},
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+)
+
+// maxPeerPingFailures is the number of consecutive failed pings after which the peer is considered dead
+const maxPeerPingFailures = 2
+
+var errPingTimeout = errors.New("ping timeout")
+
+// newMuxerTransport creates the stream multiplexer which sends keep-alive pings over the connection
+// every keepAliveInterval and closes it if a ping is not answered within idleTimeout.
+// Zero values keep the multiplexer defaults
+func newMuxerTransport(keepAliveInterval, idleTimeout time.Duration) *yamux.Transport {
+	transport := *yamux.DefaultTransport
+
+	if keepAliveInterval > 0 {
+		transport.KeepAliveInterval = keepAliveInterval
+	}
+
+	if idleTimeout > 0 {
+		transport.ConnectionWriteTimeout = idleTimeout
+	}
+
+	return &transport
+}
+
+// runPeerPing periodically pings all the connected peers
+// and disconnects the ones which don't respond, so they can be replaced
+func (s *Server) runPeerPing() {
+	ticker := time.NewTicker(s.config.PingInterval)
+	defer ticker.Stop()
+
+	failures := make(map[peer.ID]int)
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.closeCh:
+			return
+		}
+
+		s.pingPeers(failures)
+	}
+}
+
+// pingPeers pings all the connected peers concurrently, updates the number of their consecutive
+// ping failures and disconnects the peers which reached maxPeerPingFailures
+func (s *Server) pingPeers(failures map[peer.ID]int) {
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs = make(map[peer.ID]error)
+	)
+
+	for _, peerInfo := range s.Peers() {
+		peerID := peerInfo.Info.ID
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := s.pingPeer(peerID)
+
+			lock.Lock()
+			errs[peerID] = err
+			lock.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	// forget the peers which are not connected anymore
+	for peerID := range failures {
+		if _, ok := errs[peerID]; !ok {
+			delete(failures, peerID)
+		}
+	}
+
+	for peerID, err := range errs {
+		if err == nil {
+			delete(failures, peerID)
+
+			continue
+		}
+
+		failures[peerID]++
+
+		s.logger.Debug("Peer ping failed", "id", peerID, "failures", failures[peerID], "err", err)
+
+		if failures[peerID] >= maxPeerPingFailures {
+			delete(failures, peerID)
+
+			metrics.IncrCounter([]string{networkMetrics, "dead_peers"}, 1)
+
+			s.DisconnectFromPeer(peerID, "peer not responding to pings")
+		}
+	}
+}
+
+// pingPeer sends a single ping to the peer and waits for the response at most PingTimeout
+func (s *Server) pingPeer(peerID peer.ID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.PingTimeout)
+	defer cancel()
+
+	// the results channel is closed without any result if the ping times out
+	res, ok := <-ping.Ping(ctx, s.host, peerID)
+	if !ok {
+		return errPingTimeout
+	}
+
+	if res.Error != nil {
+		return res.Error
+	}
+
+	metrics.AddSample([]string{networkMetrics, "ping_rtt"}, float32(res.RTT.Milliseconds()))
+
+	return nil
+}
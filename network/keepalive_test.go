@@ -0,0 +1,66 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMuxerTransport(t *testing.T) {
+	t.Parallel()
+
+	transport := newMuxerTransport(time.Second, 2*time.Second)
+
+	assert.Equal(t, time.Second, transport.KeepAliveInterval)
+	assert.Equal(t, 2*time.Second, transport.ConnectionWriteTimeout)
+
+	// zero values keep the defaults and the default transport is not modified
+	transport = newMuxerTransport(0, 0)
+
+	assert.Equal(t, yamux.DefaultTransport.KeepAliveInterval, transport.KeepAliveInterval)
+	assert.Equal(t, yamux.DefaultTransport.ConnectionWriteTimeout, transport.ConnectionWriteTimeout)
+}
+
+func TestPingPeers_DisconnectDeadPeer(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	failures := make(map[peer.ID]int)
+
+	// responsive peer stays connected
+	servers[0].pingPeers(failures)
+
+	assert.Empty(t, failures)
+	assert.True(t, servers[0].IsConnected(servers[1].AddrInfo().ID))
+
+	// the peer stops responding to pings
+	servers[1].host.RemoveStreamHandler(ping.ID)
+
+	servers[0].pingPeers(failures)
+
+	assert.Equal(t, 1, failures[servers[1].AddrInfo().ID])
+	assert.True(t, servers[0].IsConnected(servers[1].AddrInfo().ID))
+
+	servers[0].pingPeers(failures)
+
+	assert.Empty(t, failures)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	disconnected, err := WaitUntilPeerDisconnectsFrom(ctx, servers[0], servers[1].AddrInfo().ID)
+	require.NoError(t, err)
+	assert.True(t, disconnected)
+}
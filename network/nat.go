@@ -0,0 +1,131 @@
+package network
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	basichost "github.com/libp2p/go-libp2p/p2p/host/basic"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// natPortMapEnabled checks if the listening port is mapped on the router (UPnP or NAT-PMP).
+// The port is not mapped if the external address is set explicitly, or if the node listens on the loopback only
+func natPortMapEnabled(config *Config) bool {
+	return !config.NoNATPortMap && config.NatAddr == nil && config.DNS == nil &&
+		(config.Addr == nil || !config.Addr.IP.IsLoopback())
+}
+
+// natMapper holds the NAT manager of the libp2p host, which maps the listening port on the router
+type natMapper struct {
+	lock    sync.RWMutex
+	manager basichost.NATManager
+}
+
+// newManager creates the NAT manager, it is passed to the libp2p host as the NAT manager constructor
+func (m *natMapper) newManager(n network.Network) basichost.NATManager {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.manager = basichost.NewNATManager(n)
+
+	return m.manager
+}
+
+// mappedAddrs returns the external addresses of the ports mapped on the router
+func (m *natMapper) mappedAddrs() []multiaddr.Multiaddr {
+	if m == nil {
+		return nil
+	}
+
+	m.lock.RLock()
+	manager := m.manager
+	m.lock.RUnlock()
+
+	if manager == nil || manager.NAT() == nil {
+		return nil
+	}
+
+	mappings := manager.NAT().Mappings()
+	addrs := make([]multiaddr.Multiaddr, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		netAddr, err := mapping.ExternalAddr()
+		if err != nil {
+			// the mapping is not ready yet
+			continue
+		}
+
+		if addr, err := manet.FromNetAddr(netAddr); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
+// withoutObservedAddrs drops the addresses observed by the peers (through identify), keeping only
+// the addresses of the local interfaces and the external addresses of the ports mapped on the router
+func withoutObservedAddrs(addrs []multiaddr.Multiaddr, mapper *natMapper) []multiaddr.Multiaddr {
+	interfaceAddrs, err := manet.InterfaceMultiaddrs()
+	if err != nil {
+		return addrs
+	}
+
+	mappedAddrs := mapper.mappedAddrs()
+	filtered := make([]multiaddr.Multiaddr, 0, len(addrs))
+
+	for _, addr := range addrs {
+		if containsAddr(interfaceAddrs, addr, sameHost) || containsAddr(mappedAddrs, addr, multiaddr.Multiaddr.Equal) {
+			filtered = append(filtered, addr)
+		}
+	}
+
+	return filtered
+}
+
+// containsAddr checks if any of the addresses matches the given one
+func containsAddr(
+	addrs []multiaddr.Multiaddr,
+	addr multiaddr.Multiaddr,
+	match func(a, b multiaddr.Multiaddr) bool,
+) bool {
+	for _, a := range addrs {
+		if match(a, addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// logAddrsUpdates logs the addresses the node is reachable on (advertised to the peers through identify),
+// whenever they change (such as once the port is mapped on the router or the external address is observed)
+func (s *Server) logAddrsUpdates() {
+	sub, err := s.host.EventBus().Subscribe(new(event.EvtLocalAddressesUpdated))
+	if err != nil {
+		s.logger.Error("failed to subscribe to the address updates", "err", err)
+
+		return
+	}
+
+	go func() {
+		defer sub.Close()
+
+		for {
+			select {
+			case <-s.closeCh:
+				return
+			case evt, ok := <-sub.Out():
+				if !ok {
+					return
+				}
+
+				if update, ok := evt.(event.EvtLocalAddressesUpdated); ok && update.Diffs {
+					s.logger.Info("Advertised addresses updated", "addrs", s.host.Addrs())
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,56 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNATPortMapEnabled(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(ip string) *Config {
+		config := DefaultConfig()
+		config.Addr = &net.TCPAddr{IP: net.ParseIP(ip), Port: DefaultLibp2pPort}
+
+		return config
+	}
+
+	assert.True(t, natPortMapEnabled(newConfig("0.0.0.0")))
+	assert.True(t, natPortMapEnabled(newConfig("192.168.1.10")))
+
+	// listening on the loopback only
+	assert.False(t, natPortMapEnabled(newConfig("127.0.0.1")))
+
+	// disabled by the flag
+	config := newConfig("0.0.0.0")
+	config.NoNATPortMap = true
+	assert.False(t, natPortMapEnabled(config))
+
+	// the external address is set explicitly
+	config = newConfig("0.0.0.0")
+	config.NatAddr = net.ParseIP("203.0.113.1")
+	assert.False(t, natPortMapEnabled(config))
+
+	config = newConfig("0.0.0.0")
+	config.DNS = multiaddr.StringCast("/dns4/example.com/tcp/1478")
+	assert.False(t, natPortMapEnabled(config))
+}
+
+func TestWithoutObservedAddrs(t *testing.T) {
+	t.Parallel()
+
+	localAddr := multiaddr.StringCast("/ip4/127.0.0.1/tcp/1478")
+	observedAddr := multiaddr.StringCast("/ip4/203.0.113.1/tcp/1478")
+
+	// the mapper without the NAT manager has no mapped addresses
+	for _, mapper := range []*natMapper{nil, {}} {
+		addrs := withoutObservedAddrs([]multiaddr.Multiaddr{localAddr, observedAddr}, mapper)
+
+		require.Len(t, addrs, 1)
+		assert.True(t, addrs[0].Equal(localAddr))
+	}
+}
@@ -0,0 +1,128 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// PeerFilter filters the peers the node dials and accepts the connections from by their IPs and peer IDs.
+// Unlike the allow list, it doesn't restrict the peer discovery, the filtered out peers are just not connected.
+// The denied peers and networks are always rejected. If the allowed networks are set, only the peers on these
+// networks are connected, and if the allowed peer IDs are set, only these peers are connected (both must match)
+type PeerFilter struct {
+	allowedNets  []*net.IPNet
+	allowedPeers map[peer.ID]struct{}
+	deniedNets   []*net.IPNet
+	deniedPeers  map[peer.ID]struct{}
+}
+
+// ParsePeerFilter parses the allowed and denied entries, which are either the CIDR ranges (10.0.0.0/8),
+// the IPs (matching the single host) or the peer IDs. It returns nil if there are no entries
+func ParsePeerFilter(allowed, denied []string) (*PeerFilter, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, nil
+	}
+
+	f := &PeerFilter{
+		allowedPeers: make(map[peer.ID]struct{}),
+		deniedPeers:  make(map[peer.ID]struct{}),
+	}
+
+	var err error
+
+	if f.allowedNets, err = parseFilterEntries(allowed, f.allowedPeers); err != nil {
+		return nil, fmt.Errorf("invalid peer allowlist: %w", err)
+	}
+
+	if f.deniedNets, err = parseFilterEntries(denied, f.deniedPeers); err != nil {
+		return nil, fmt.Errorf("invalid peer denylist: %w", err)
+	}
+
+	return f, nil
+}
+
+// parseFilterEntries parses the entries into the networks, adding the peer IDs to the given set
+func parseFilterEntries(entries []string, peers map[peer.ID]struct{}) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+
+		if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+			nets = append(nets, ipNet)
+
+			continue
+		}
+
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+
+			continue
+		}
+
+		peerID, err := peer.Decode(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%s is neither a CIDR range, an IP nor a peer ID", entry)
+		}
+
+		peers[peerID] = struct{}{}
+	}
+
+	return nets, nil
+}
+
+// allowsPeer checks if the peer ID is allowed
+func (f *PeerFilter) allowsPeer(peerID peer.ID) bool {
+	if _, ok := f.deniedPeers[peerID]; ok {
+		return false
+	}
+
+	if len(f.allowedPeers) == 0 {
+		return true
+	}
+
+	_, ok := f.allowedPeers[peerID]
+
+	return ok
+}
+
+// allowsAddr checks if the host of the multiaddr is allowed. The non-IP multiaddrs (such as DNS)
+// are allowed only if the allowed networks are not set, since their IP is not known
+func (f *PeerFilter) allowsAddr(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return len(f.allowedNets) == 0
+	}
+
+	if containsIP(f.deniedNets, ip) {
+		return false
+	}
+
+	return len(f.allowedNets) == 0 || containsIP(f.allowedNets, ip)
+}
+
+// allowsPeerAddr checks if both the peer ID and the host of the multiaddr are allowed
+func (f *PeerFilter) allowsPeerAddr(peerID peer.ID, addr multiaddr.Multiaddr) bool {
+	return f.allowsPeer(peerID) && f.allowsAddr(addr)
+}
+
+// containsIP checks if any of the networks contains the IP
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
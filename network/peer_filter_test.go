@@ -0,0 +1,104 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePeerFilter(t *testing.T) {
+	t.Parallel()
+
+	allowedPeer := newTestPeerID(t)
+	deniedPeer := newTestPeerID(t)
+
+	filter, err := ParsePeerFilter(
+		[]string{"10.0.0.0/8", "192.168.1.1", "fd00::/8", allowedPeer.String()},
+		[]string{"10.1.0.0/16", deniedPeer.String()},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, filter.allowedNets, 3)
+	require.Contains(t, filter.allowedPeers, allowedPeer)
+	require.Len(t, filter.deniedNets, 1)
+	require.Contains(t, filter.deniedPeers, deniedPeer)
+
+	// the single IP matches the single host
+	assert.Equal(t, "192.168.1.1/32", filter.allowedNets[1].String())
+
+	// no entries disable the filter
+	filter, err = ParsePeerFilter(nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	_, err = ParsePeerFilter([]string{"invalid"}, nil)
+	require.ErrorContains(t, err, "invalid peer allowlist")
+
+	_, err = ParsePeerFilter(nil, []string{"10.0.0.0/33"})
+	require.ErrorContains(t, err, "invalid peer denylist")
+}
+
+func TestConnectionGater_PeerFilter(t *testing.T) {
+	t.Parallel()
+
+	allowedPeer := newTestPeerID(t)
+	deniedPeer := newTestPeerID(t)
+	unknownPeer := newTestPeerID(t)
+
+	allowedAddr := multiaddr.StringCast("/ip4/10.0.0.1/tcp/1478")
+	deniedAddr := multiaddr.StringCast("/ip4/10.1.0.1/tcp/1478")
+	otherAddr := multiaddr.StringCast("/ip4/172.16.0.1/tcp/1478")
+	dnsAddr := multiaddr.StringCast("/dns4/example.com/tcp/1478")
+
+	// the networks only
+	filter, err := ParsePeerFilter([]string{"10.0.0.0/8"}, []string{"10.1.0.0/16", deniedPeer.String()})
+	require.NoError(t, err)
+
+	gater := &connectionGater{banList: newBanList(), peerFilter: filter}
+
+	assert.True(t, gater.InterceptPeerDial(unknownPeer))
+	assert.False(t, gater.InterceptPeerDial(deniedPeer))
+
+	assert.True(t, gater.InterceptAddrDial(unknownPeer, allowedAddr))
+	assert.False(t, gater.InterceptAddrDial(unknownPeer, deniedAddr))
+	assert.False(t, gater.InterceptAddrDial(unknownPeer, otherAddr))
+	assert.False(t, gater.InterceptAddrDial(unknownPeer, dnsAddr))
+	assert.False(t, gater.InterceptAddrDial(deniedPeer, allowedAddr))
+
+	assert.True(t, gater.InterceptAccept(&mockConnMultiaddrs{remote: allowedAddr}))
+	assert.False(t, gater.InterceptAccept(&mockConnMultiaddrs{remote: deniedAddr}))
+	assert.False(t, gater.InterceptAccept(&mockConnMultiaddrs{remote: otherAddr}))
+
+	assert.True(t, gater.InterceptSecured(network.DirInbound, unknownPeer, &mockConnMultiaddrs{remote: allowedAddr}))
+	assert.False(t, gater.InterceptSecured(network.DirInbound, deniedPeer, &mockConnMultiaddrs{remote: allowedAddr}))
+
+	// the peer IDs and the networks must both match
+	filter, err = ParsePeerFilter([]string{"10.0.0.0/8", allowedPeer.String()}, nil)
+	require.NoError(t, err)
+
+	gater = &connectionGater{banList: newBanList(), peerFilter: filter}
+
+	assert.True(t, gater.InterceptPeerDial(allowedPeer))
+	assert.False(t, gater.InterceptPeerDial(unknownPeer))
+
+	assert.True(t, gater.InterceptSecured(network.DirOutbound, allowedPeer, &mockConnMultiaddrs{remote: allowedAddr}))
+	assert.False(t, gater.InterceptSecured(network.DirOutbound, allowedPeer, &mockConnMultiaddrs{remote: otherAddr}))
+	assert.False(t, gater.InterceptSecured(network.DirOutbound, unknownPeer, &mockConnMultiaddrs{remote: allowedAddr}))
+
+	// the denylist only allows all the other peers, including the non-IP hosts
+	filter, err = ParsePeerFilter(nil, []string{"10.1.0.0/16"})
+	require.NoError(t, err)
+
+	gater = &connectionGater{banList: newBanList(), peerFilter: filter}
+
+	assert.True(t, gater.InterceptAddrDial(unknownPeer, otherAddr))
+	assert.True(t, gater.InterceptAddrDial(unknownPeer, dnsAddr))
+	assert.False(t, gater.InterceptAddrDial(unknownPeer, deniedAddr))
+
+	// the allowed peer can still be banned
+	gater.banList.ban(unknownPeer, DefaultBanDuration)
+	assert.False(t, gater.InterceptPeerDial(unknownPeer))
+}
@@ -0,0 +1,60 @@
+package network
+
+import (
+	"github.com/libp2p/go-libp2p"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// transportOptions returns the transports of the libp2p host, TCP and optionally QUIC
+func transportOptions(config *Config) []libp2p.Option {
+	opts := []libp2p.Option{libp2p.Transport(tcp.NewTCPTransport)}
+
+	if config.QUIC {
+		opts = append(opts, libp2p.Transport(libp2pquic.NewTransport))
+	}
+
+	return opts
+}
+
+// withQUICAddrs returns the TCP addresses along with the matching QUIC addresses (same host and port number),
+// it is used for the explicitly set external addresses, since the QUIC is served on the same port over UDP
+func withQUICAddrs(config *Config, addrs ...multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	if !config.QUIC {
+		return addrs
+	}
+
+	result := make([]multiaddr.Multiaddr, 0, 2*len(addrs))
+
+	for _, addr := range addrs {
+		result = append(result, addr)
+
+		if quicAddr := toQUICAddr(addr); quicAddr != nil {
+			result = append(result, quicAddr)
+		}
+	}
+
+	return result
+}
+
+// toQUICAddr converts the TCP address (/<ip or dns>/<host>/tcp/<port>) to the QUIC address
+// (/<ip or dns>/<host>/udp/<port>/quic-v1), it returns nil if the address is not the TCP one
+func toQUICAddr(addr multiaddr.Multiaddr) multiaddr.Multiaddr {
+	port, err := addr.ValueForProtocol(multiaddr.P_TCP)
+	if err != nil {
+		return nil
+	}
+
+	host, _ := multiaddr.SplitFirst(addr)
+	if host == nil {
+		return nil
+	}
+
+	quicAddr, err := multiaddr.NewMultiaddr("/udp/" + port + "/quic-v1")
+	if err != nil {
+		return nil
+	}
+
+	return multiaddr.Join(host, quicAddr)
+}
@@ -0,0 +1,92 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToQUICAddr(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"/ip4/127.0.0.1/tcp/1478":     "/ip4/127.0.0.1/udp/1478/quic-v1",
+		"/ip6/::1/tcp/1478":           "/ip6/::1/udp/1478/quic-v1",
+		"/dns4/example.com/tcp/10001": "/dns4/example.com/udp/10001/quic-v1",
+	}
+
+	for addr, expected := range cases {
+		quicAddr := toQUICAddr(multiaddr.StringCast(addr))
+		require.NotNil(t, quicAddr, addr)
+		assert.Equal(t, expected, quicAddr.String())
+	}
+
+	// not the TCP address
+	assert.Nil(t, toQUICAddr(multiaddr.StringCast("/ip4/127.0.0.1/udp/1478/quic-v1")))
+}
+
+func TestWithQUICAddrs(t *testing.T) {
+	t.Parallel()
+
+	addr := multiaddr.StringCast("/ip4/203.0.113.1/tcp/1478")
+	config := DefaultConfig()
+
+	// QUIC disabled
+	assert.Equal(t, []multiaddr.Multiaddr{addr}, withQUICAddrs(config, addr))
+
+	config.QUIC = true
+
+	assert.Equal(t, []multiaddr.Multiaddr{
+		addr,
+		multiaddr.StringCast("/ip4/203.0.113.1/udp/1478/quic-v1"),
+	}, withQUICAddrs(config, addr))
+}
+
+func TestServer_ConnectOverQUIC(t *testing.T) {
+	t.Parallel()
+
+	servers := make([]*Server, 2)
+
+	for i := range servers {
+		srv, err := CreateServer(&CreateServerParams{
+			ConfigCallback: func(c *Config) {
+				c.QUIC = true
+				c.NoDiscover = true
+			},
+		})
+		require.NoError(t, err)
+
+		servers[i] = srv
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	// dial the QUIC addresses only
+	var quicAddrs []multiaddr.Multiaddr
+
+	for _, addr := range servers[1].host.Addrs() {
+		if _, err := addr.ValueForProtocol(multiaddr.P_QUIC_V1); err == nil {
+			quicAddrs = append(quicAddrs, addr)
+		}
+	}
+
+	require.NotEmpty(t, quicAddrs)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, servers[0].host.Connect(ctx, peer.AddrInfo{ID: servers[1].host.ID(), Addrs: quicAddrs}))
+
+	conns := servers[0].host.Network().ConnsToPeer(servers[1].host.ID())
+	require.NotEmpty(t, conns)
+
+	_, err := conns[0].RemoteMultiaddr().ValueForProtocol(multiaddr.P_QUIC_V1)
+	assert.NoError(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/network/discovery"
 	"github.com/armon/go-metrics"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/p2p/muxer/yamux"
 	"github.com/libp2p/go-libp2p/p2p/security/noise"
 	rawGrpc "google.golang.org/grpc"
 
@@ -88,6 +89,10 @@ type Server struct {
 	temporaryDials sync.Map // map of temporary connections; peerID -> bool
 
 	bootnodes *bootnodesWrapper // reference of all bootnodes for the node
+
+	banList *banList // list of the peers which are not allowed to connect
+
+	dropGossip func(from peer.ID) bool // decides if the gossip message relayed by the peer is lost (simulation only)
 }
 
 // NewServer returns a new instance of the networking server
@@ -104,31 +109,79 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// QUIC listens on the same port over UDP
+	listenAddrs := withQUICAddrs(config, listenAddr)
+
+	var mapper *natMapper
+
+	if natPortMapEnabled(config) {
+		mapper = &natMapper{}
+	}
+
 	addrsFactory := func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+		if config.NoObservedAddrs {
+			addrs = withoutObservedAddrs(addrs, mapper)
+		}
+
 		if config.NatAddr != nil {
 			addr, _ := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", config.NatAddr.String(), config.Addr.Port))
 
 			if addr != nil {
-				addrs = []multiaddr.Multiaddr{addr}
+				addrs = withQUICAddrs(config, addr)
 			}
 		} else if config.DNS != nil {
-			addrs = []multiaddr.Multiaddr{config.DNS}
+			addrs = withQUICAddrs(config, config.DNS)
 		}
 
 		return addrs
 	}
 
-	host, err := libp2p.New(
+	banList := newBanList()
+
+	if config.AllowList != nil {
+		// the node must not talk to the unknown hosts, so the peers are not discovered
+		config.NoDiscover = true
+
+		logger.Info("Strict allow list mode enabled, discovery is disabled", "peers", config.AllowList.Len())
+	}
+
+	if config.PeerFilter != nil {
+		logger.Info("Peer filter enabled, the peers are connected only if allowed by the filter")
+	}
+
+	opts := []libp2p.Option{
 		// Use noise as the encryption protocol
 		libp2p.Security(noise.ID, noise.New),
-		libp2p.ListenAddrs(listenAddr),
+		libp2p.ListenAddrs(listenAddrs...),
 		libp2p.AddrsFactory(addrsFactory),
 		libp2p.Identity(key),
-	)
+		libp2p.Muxer(yamux.ID, newMuxerTransport(config.KeepAliveInterval, config.IdleTimeout)),
+		libp2p.ConnectionGater(&connectionGater{
+			banList:    banList,
+			allowList:  config.AllowList,
+			peerFilter: config.PeerFilter,
+		}),
+	}
+
+	opts = append(opts, transportOptions(config)...)
+
+	if mapper != nil {
+		// map the listening port on the router (UPnP or NAT-PMP), so the node is reachable behind the NAT
+		opts = append(opts, libp2p.NATManager(mapper.newManager))
+
+		logger.Info("NAT port mapping enabled")
+	}
+
+	host, err := libp2p.New(opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create libp2p stack: %w", err)
 	}
 
+	return newServer(logger, config, host, banList)
+}
+
+// newServer creates the networking server on top of the libp2p host
+func newServer(logger hclog.Logger, config *Config, host host.Host, banList *banList) (*Server, error) {
 	emitter, err := host.EventBus().Emitter(new(peerEvent.PeerEvent))
 	if err != nil {
 		return nil, err
@@ -154,6 +207,7 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 			config.MaxInboundPeers,
 			config.MaxOutboundPeers,
 		),
+		banList: banList,
 	}
 
 	// start gossip protocol
@@ -161,6 +215,7 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		context.Background(),
 		host, pubsub.WithPeerOutboundQueueSize(peerOutboundBufferSize),
 		pubsub.WithValidateQueueSize(validateBufferSize),
+		pubsub.WithRawTracer(bandwidthTracer{}),
 	)
 	if err != nil {
 		return nil, err
@@ -266,9 +321,21 @@ func (s *Server) Start() error {
 		}
 	}
 
+	s.logAddrsUpdates()
+
 	go s.runDial()
 	go s.keepAliveMinimumPeerConnections()
 
+	if s.config.AllowList != nil {
+		for _, info := range s.config.AllowList.AddrInfos() {
+			s.addToDialQueue(info, common.PriorityRequestedDial)
+		}
+	}
+
+	if s.config.PingInterval > 0 {
+		go s.runPeerPing()
+	}
+
 	// watch for disconnected peers
 	s.host.Network().Notify(&network.NotifyBundle{
 		DisconnectedF: func(net network.Network, conn network.Conn) {
@@ -334,7 +401,14 @@ func (s *Server) keepAliveMinimumPeerConnections() {
 		}
 
 		if s.numPeers() < MinimumPeerConnections {
-			if s.config.NoDiscover || !s.bootnodes.hasBootnodes() {
+			if s.config.AllowList != nil {
+				// redial the disconnected allowed peers
+				for _, info := range s.config.AllowList.AddrInfos() {
+					if !s.IsConnected(info.ID) {
+						s.addToDialQueue(info, common.PriorityRandomDial)
+					}
+				}
+			} else if s.config.NoDiscover || !s.bootnodes.hasBootnodes() {
 				// dial unconnected peer
 				randPeer := s.GetRandomPeer()
 				if randPeer != nil && !s.IsConnected(*randPeer) {
@@ -412,7 +486,7 @@ func (s *Server) runDial() {
 				if err := s.host.Connect(ctx, *peerInfo); err != nil {
 					s.logger.Debug("failed to dial", "addr", peerInfo, "err", err.Error())
 
-					s.emitEvent(peerInfo.ID, peerEvent.PeerFailedToConnect)
+					s.emitEventWithReason(peerInfo.ID, peerEvent.PeerFailedToConnect, err.Error())
 				}
 			}()
 		}
@@ -542,6 +616,22 @@ func (s *Server) DisconnectFromPeer(peer peer.ID, reason string) {
 	}
 }
 
+// BanPeer disconnects the networking server from the specified peer,
+// and rejects the connections to and from the peer for the DefaultBanDuration
+func (s *Server) BanPeer(peerID peer.ID, reason string) {
+	s.logger.Info("Banning peer", "id", peerID, "reason", reason, "duration", DefaultBanDuration)
+
+	s.banList.ban(peerID, DefaultBanDuration)
+	s.emitEventWithReason(peerID, peerEvent.PeerBanned, reason)
+
+	s.DisconnectFromPeer(peerID, reason)
+}
+
+// IsBanned checks if the specified peer is banned
+func (s *Server) IsBanned(peerID peer.ID) bool {
+	return s.banList.isBanned(peerID)
+}
+
 var (
 	// Anything below 35s is prone to false timeouts, as seen from empirical test data
 	DefaultJoinTimeout   = 100 * time.Second
@@ -650,10 +740,15 @@ func (s *Server) addToDialQueue(addr *peer.AddrInfo, priority common.DialPriorit
 }
 
 func (s *Server) emitEvent(peerID peer.ID, peerEventType peerEvent.PeerEventType) {
+	s.emitEventWithReason(peerID, peerEventType, "")
+}
+
+func (s *Server) emitEventWithReason(peerID peer.ID, peerEventType peerEvent.PeerEventType, reason string) {
 	// POTENTIALLY BLOCKING
 	if err := s.emitterPeerEvent.Emit(peerEvent.PeerEvent{
 		PeerID: peerID,
 		Type:   peerEventType,
+		Reason: reason,
 	}); err != nil {
 		s.logger.Info("failed to emit event", "peer", peerID, "type", peerEventType, "err", err)
 	}
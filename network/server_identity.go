@@ -94,7 +94,7 @@ func (s *Server) UpdatePendingConnCount(delta int64, direction network.Direction
 
 // EmitEvent emits a specified event to the networking server's event bus
 func (s *Server) EmitEvent(event *peerEvent.PeerEvent) {
-	s.emitEvent(event.PeerID, event.Type)
+	s.emitEventWithReason(event.PeerID, event.Type, event.Reason)
 }
 
 // IsTemporaryDial checks if a peer connection is temporary [Thread safe]
@@ -1158,3 +1158,59 @@ func generateRandomPeers(t *testing.T, count int) ([]*randomPeer, error) {
 
 	return randomPeers, nil
 }
+
+func TestBanPeer(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	if joinErr := JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+
+	eventCh, err := servers[0].SubscribeCh(ctx)
+	if err != nil {
+		t.Fatalf("Unable to subscribe to events, %v", err)
+	}
+
+	waitForEvent := func(eventType peerEvent.PeerEventType) *peerEvent.PeerEvent {
+		for {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("Event %s not received", eventType)
+			case evnt := <-eventCh:
+				if evnt.Type == eventType {
+					return evnt
+				}
+			}
+		}
+	}
+
+	bannedID := servers[1].host.ID()
+	servers[0].BanPeer(bannedID, "misbehaving")
+
+	banned := waitForEvent(peerEvent.PeerBanned)
+	assert.Equal(t, bannedID, banned.PeerID)
+	assert.Equal(t, "misbehaving", banned.Reason)
+	assert.True(t, servers[0].IsBanned(bannedID))
+
+	if _, err := WaitUntilPeerDisconnectsFrom(ctx, servers[0], bannedID); err != nil {
+		t.Fatalf("Unable to wait for peer disconnect, %v", err)
+	}
+
+	// the banned peer can't be dialed
+	servers[0].joinPeer(servers[1].AddrInfo())
+
+	failed := waitForEvent(peerEvent.PeerFailedToConnect)
+	assert.Equal(t, bannedID, failed.PeerID)
+	assert.NotEmpty(t, failed.Reason)
+	assert.False(t, servers[0].hasPeer(bannedID))
+}
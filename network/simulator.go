@@ -0,0 +1,264 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	"github.com/multiformats/go-multiaddr"
+)
+
+var errUnknownServer = errors.New("server is not part of the simulation")
+
+// LinkConditions are the conditions of the simulated link between two servers
+type LinkConditions struct {
+	Latency time.Duration // the delay of the data sent over the link, in both directions
+	Loss    float64       // the probability (0 to 1) of losing the gossip message relayed over the link
+}
+
+// Simulator is the in-memory network of the servers, used by the multi-node unit tests of the protocols
+// running on top of the networking server (consensus, syncer, gossip). The servers talk over the in-memory
+// libp2p transport, so no sockets are opened, and each link between two servers has its own conditions.
+// The streams are reliable (as over TCP), so the loss applies to the gossip messages only.
+// The networking keys and the losses are drawn from the seeded source, so the runs are reproducible.
+// The connection gater isn't supported by the in-memory transport, so the ban and allow lists are not enforced
+type Simulator struct {
+	logger  hclog.Logger
+	mocknet mocknet.Mocknet
+
+	lock     sync.Mutex
+	rand     *rand.Rand
+	servers  []*Server
+	defaults LinkConditions
+	links    map[[2]peer.ID]LinkConditions
+}
+
+// NewSimulator creates the network simulator, the links use the default conditions unless set by SetLink
+func NewSimulator(seed int64, defaults LinkConditions, logger hclog.Logger) *Simulator {
+	if logger == nil {
+		logger = hclog.NewNullLogger()
+	}
+
+	mn := mocknet.New()
+	mn.SetLinkDefaults(mocknet.LinkOptions{Latency: defaults.Latency})
+
+	return &Simulator{
+		logger:   logger,
+		mocknet:  mn,
+		rand:     rand.New(rand.NewSource(seed)), //nolint:gosec
+		defaults: defaults,
+		links:    make(map[[2]peer.ID]LinkConditions),
+	}
+}
+
+// AddServer creates and starts the server on the simulated network, linked with all the other servers.
+// The discovery is disabled by default, and if enabled by the config callback, the first server is the bootnode
+func (sim *Simulator) AddServer(configCallback func(c *Config)) (*Server, error) {
+	sim.lock.Lock()
+
+	seed := make([]byte, 32)
+	sim.rand.Read(seed)
+
+	key, err := crypto.UnmarshalSecp256k1PrivateKey(seed)
+	if err != nil {
+		sim.lock.Unlock()
+
+		return nil, err
+	}
+
+	index := len(sim.servers)
+	others := append([]*Server(nil), sim.servers...)
+
+	sim.lock.Unlock()
+
+	addr, err := multiaddr.NewMultiaddr(
+		fmt.Sprintf("/ip4/10.0.%d.%d/tcp/%d", index/256, index%256, DefaultLibp2pPort))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := DefaultConfig()
+	cfg.NoDiscover = true
+	cfg.Chain = &chain.Chain{
+		Params: &chain.Params{
+			ChainID: 1,
+		},
+	}
+
+	if configCallback != nil {
+		configCallback(cfg)
+	}
+
+	host, err := sim.mocknet.AddPeer(key, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the in-memory hosts don't run the ping service, used by the peer pings
+	ping.NewPingService(host)
+
+	server, err := newServer(sim.logger.Named("network"), cfg, host, newBanList())
+	if err != nil {
+		return nil, err
+	}
+
+	server.dropGossip = func(from peer.ID) bool {
+		return sim.lost(host.ID(), from)
+	}
+
+	for _, other := range others {
+		if err := sim.link(other, server); err != nil {
+			return nil, err
+		}
+	}
+
+	if !cfg.NoDiscover {
+		var bootnodes []string
+
+		if len(others) > 0 {
+			bootnode := others[0].AddrInfo()
+			bootnodes = append(bootnodes, fmt.Sprintf("%s/p2p/%s", bootnode.Addrs[0], bootnode.ID))
+		}
+
+		initBootnodes(server, bootnodes...)
+	}
+
+	if err := server.Start(); err != nil {
+		return nil, err
+	}
+
+	sim.lock.Lock()
+	sim.servers = append(sim.servers, server)
+	sim.lock.Unlock()
+
+	return server, nil
+}
+
+// SetLink sets the conditions of the link between the two servers, the partitioned servers are linked again
+func (sim *Simulator) SetLink(a, b *Server, conditions LinkConditions) error {
+	if err := sim.link(a, b); err != nil {
+		return err
+	}
+
+	for _, l := range sim.mocknet.LinksBetweenPeers(a.host.ID(), b.host.ID()) {
+		l.SetOptions(mocknet.LinkOptions{Latency: conditions.Latency})
+	}
+
+	sim.lock.Lock()
+	defer sim.lock.Unlock()
+
+	sim.links[linkKey(a.host.ID(), b.host.ID())] = conditions
+
+	return nil
+}
+
+// Connect connects the two servers (linking the partitioned ones again) and waits until they are connected
+func (sim *Simulator) Connect(a, b *Server) error {
+	if err := sim.link(a, b); err != nil {
+		return err
+	}
+
+	return JoinAndWait(a, b, DefaultBufferTimeout, DefaultJoinTimeout)
+}
+
+// ConnectAll connects each pair of the linked servers
+func (sim *Simulator) ConnectAll() error {
+	sim.lock.Lock()
+	servers := append([]*Server(nil), sim.servers...)
+	sim.lock.Unlock()
+
+	return errors.Join(MeshJoin(servers...)...)
+}
+
+// Partition removes the link between the two servers and disconnects them, so they can't talk directly
+func (sim *Simulator) Partition(a, b *Server) error {
+	if err := sim.mocknet.UnlinkPeers(a.host.ID(), b.host.ID()); err != nil {
+		return err
+	}
+
+	return sim.mocknet.DisconnectPeers(a.host.ID(), b.host.ID())
+}
+
+// Close closes the servers and the simulated network
+func (sim *Simulator) Close() error {
+	sim.lock.Lock()
+	servers := sim.servers
+	sim.servers = nil
+	sim.lock.Unlock()
+
+	errs := make([]error, 0, len(servers)+1)
+
+	for _, server := range servers {
+		errs = append(errs, server.Close())
+	}
+
+	errs = append(errs, sim.mocknet.Close())
+
+	return errors.Join(errs...)
+}
+
+// link creates the link between the two servers with their link conditions, unless it exists
+func (sim *Simulator) link(a, b *Server) error {
+	if a.host.ID() == b.host.ID() {
+		return nil
+	}
+
+	for _, id := range []peer.ID{a.host.ID(), b.host.ID()} {
+		if sim.mocknet.Host(id) == nil {
+			return fmt.Errorf("%w: %s", errUnknownServer, id)
+		}
+	}
+
+	if len(sim.mocknet.LinksBetweenPeers(a.host.ID(), b.host.ID())) > 0 {
+		return nil
+	}
+
+	l, err := sim.mocknet.LinkPeers(a.host.ID(), b.host.ID())
+	if err != nil {
+		return err
+	}
+
+	sim.lock.Lock()
+	conditions, ok := sim.links[linkKey(a.host.ID(), b.host.ID())]
+	sim.lock.Unlock()
+
+	if ok {
+		l.SetOptions(mocknet.LinkOptions{Latency: conditions.Latency})
+	}
+
+	return nil
+}
+
+// lost decides if the gossip message relayed over the link between the two peers is lost
+func (sim *Simulator) lost(a, b peer.ID) bool {
+	sim.lock.Lock()
+	defer sim.lock.Unlock()
+
+	conditions, ok := sim.links[linkKey(a, b)]
+	if !ok {
+		conditions = sim.defaults
+	}
+
+	if conditions.Loss <= 0 {
+		return false
+	}
+
+	return sim.rand.Float64() < conditions.Loss
+}
+
+// linkKey returns the key of the link between the two peers, regardless of their order
+func linkKey(a, b peer.ID) [2]peer.ID {
+	if a > b {
+		a, b = b, a
+	}
+
+	return [2]peer.ID{a, b}
+}
@@ -0,0 +1,159 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	testproto "github.com/0xPolygon/polygon-edge/network/proto"
+)
+
+func newTestSimulator(t *testing.T, numServers int, defaults LinkConditions) (*Simulator, []*Server) {
+	t.Helper()
+
+	sim := NewSimulator(1, defaults, nil)
+	servers := make([]*Server, numServers)
+
+	for i := range servers {
+		server, err := sim.AddServer(nil)
+		require.NoError(t, err)
+
+		servers[i] = server
+	}
+
+	t.Cleanup(func() {
+		require.NoError(t, sim.Close())
+	})
+
+	return sim, servers
+}
+
+func contextWithTimeout(t *testing.T, timeout time.Duration) context.Context {
+	t.Helper()
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancelFn)
+
+	return ctx
+}
+
+// waitForGossipMesh waits for the gossipsub heartbeat, which forms the mesh of the subscribed peers
+func waitForGossipMesh() {
+	time.Sleep(2 * time.Second)
+}
+
+func subscribeTestTopic(t *testing.T, server *Server, topicName string) (*Topic, <-chan string) {
+	t.Helper()
+
+	topic, err := server.NewTopic(topicName, &testproto.GenericMessage{})
+	require.NoError(t, err)
+
+	messageCh := make(chan string, 10)
+
+	require.NoError(t, topic.Subscribe(func(obj interface{}, _ peer.ID) {
+		msg, ok := obj.(*testproto.GenericMessage)
+		require.True(t, ok)
+
+		messageCh <- msg.Message
+	}))
+
+	return topic, messageCh
+}
+
+func TestSimulator_Gossip(t *testing.T) {
+	t.Parallel()
+
+	const topicName = "sim-gossip"
+
+	sim, servers := newTestSimulator(t, 3, LinkConditions{Latency: 10 * time.Millisecond})
+
+	require.NoError(t, sim.ConnectAll())
+
+	topics := make([]*Topic, len(servers))
+	messageChs := make([]<-chan string, len(servers))
+
+	for i, server := range servers {
+		topics[i], messageChs[i] = subscribeTestTopic(t, server, topicName)
+	}
+
+	for _, server := range servers {
+		require.NoError(t, WaitForSubscribers(contextWithTimeout(t, 10*time.Second), server, topicName, len(servers)-1))
+	}
+
+	waitForGossipMesh()
+	require.NoError(t, topics[0].Publish(&testproto.GenericMessage{Message: "hello"}))
+
+	for _, messageCh := range messageChs {
+		select {
+		case msg := <-messageCh:
+			require.Equal(t, "hello", msg)
+		case <-time.After(10 * time.Second):
+			t.Fatal("message not received")
+		}
+	}
+}
+
+func TestSimulator_LinkConditions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		topicName = "sim-link"
+		latency   = 200 * time.Millisecond
+	)
+
+	sim, servers := newTestSimulator(t, 2, LinkConditions{})
+
+	require.NoError(t, sim.Connect(servers[0], servers[1]))
+
+	topic, _ := subscribeTestTopic(t, servers[0], topicName)
+	_, messageCh := subscribeTestTopic(t, servers[1], topicName)
+
+	require.NoError(t, WaitForSubscribers(contextWithTimeout(t, 10*time.Second), servers[0], topicName, 1))
+	waitForGossipMesh()
+
+	// every message relayed over the link is lost
+	require.NoError(t, sim.SetLink(servers[0], servers[1], LinkConditions{Loss: 1}))
+	require.NoError(t, topic.Publish(&testproto.GenericMessage{Message: "lost"}))
+
+	select {
+	case msg := <-messageCh:
+		t.Fatalf("message %s not lost", msg)
+	case <-time.After(time.Second):
+	}
+
+	// the messages are delayed by the link latency
+	require.NoError(t, sim.SetLink(servers[0], servers[1], LinkConditions{Latency: latency}))
+
+	start := time.Now().UTC()
+
+	require.NoError(t, topic.Publish(&testproto.GenericMessage{Message: "delayed"}))
+
+	select {
+	case msg := <-messageCh:
+		require.Equal(t, "delayed", msg)
+		require.GreaterOrEqual(t, time.Since(start), latency)
+	case <-time.After(10 * time.Second):
+		t.Fatal("message not received")
+	}
+
+	// the partitioned servers can't connect
+	require.NoError(t, sim.Partition(servers[0], servers[1]))
+
+	_, err := servers[0].host.Network().DialPeer(contextWithTimeout(t, time.Second), servers[1].host.ID())
+	require.Error(t, err)
+}
+
+func TestSimulator_SeededKeys(t *testing.T) {
+	t.Parallel()
+
+	_, first := newTestSimulator(t, 2, LinkConditions{})
+	_, second := newTestSimulator(t, 2, LinkConditions{})
+
+	// the simulations with the same seed have the same peers
+	for i := range first {
+		require.Equal(t, first[i].AddrInfo(), second[i].AddrInfo())
+	}
+}
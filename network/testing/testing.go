@@ -25,6 +25,7 @@ type MockNetworkingServer struct {
 	// Identity Hooks
 	newIdentityClientFn      newIdentityClientDelegate
 	disconnectFromPeerFn     disconnectFromPeerDelegate
+	banPeerFn                banPeerDelegate
 	addPeerFn                addPeerDelegate
 	updatePendingConnCountFn updatePendingConnCountDelegate
 	emitEventFn              emitEventDelegate
@@ -69,6 +70,7 @@ func (m *MockNetworkingServer) GetMockPeerMetrics() *MockPeerMetrics {
 // Required for Identity
 type newIdentityClientDelegate func(peer.ID) (proto.IdentityClient, error)
 type disconnectFromPeerDelegate func(peer.ID, string)
+type banPeerDelegate func(peer.ID, string)
 type addPeerDelegate func(peer.ID, network.Direction)
 type updatePendingConnCountDelegate func(int64, network.Direction)
 type emitEventDelegate func(*event.PeerEvent)
@@ -120,6 +122,16 @@ func (m *MockNetworkingServer) HookDisconnectFromPeer(fn disconnectFromPeerDeleg
 	m.disconnectFromPeerFn = fn
 }
 
+func (m *MockNetworkingServer) BanPeer(peerID peer.ID, reason string) {
+	if m.banPeerFn != nil {
+		m.banPeerFn(peerID, reason)
+	}
+}
+
+func (m *MockNetworkingServer) HookBanPeer(fn banPeerDelegate) {
+	m.banPeerFn = fn
+}
+
 func (m *MockNetworkingServer) AddPeer(id peer.ID, direction network.Direction) {
 	if m.addPeerFn != nil {
 		m.addPeerFn(id, direction)
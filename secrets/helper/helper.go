@@ -12,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/secrets/gcpssm"
 	"github.com/0xPolygon/polygon-edge/secrets/hashicorpvault"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/secrets/pkcs11"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
@@ -21,14 +22,34 @@ import (
 
 var addressTypeABI = abi.MustNewType("address")
 
-// SetupLocalSecretsManager is a helper method for boilerplate local secrets manager setup
+// SetupLocalSecretsManager is a helper method for boilerplate local secrets manager setup.
+// The passphrase of the encrypted secrets is read from the environment variable or prompted for
 func SetupLocalSecretsManager(dataDir string) (secrets.SecretsManager, error) {
+	return local.SecretsManagerFactory(
+		nil, // Local secrets manager doesn't require a config
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path:       dataDir,
+				secrets.Passphrase: PassphraseProvider(),
+			},
+		},
+	)
+}
+
+// SetupEncryptedLocalSecretsManager is a helper method for the local secrets manager setup,
+// which stores the secrets encrypted by the passphrase
+func SetupEncryptedLocalSecretsManager(dataDir, passphrase string) (secrets.SecretsManager, error) {
 	return local.SecretsManagerFactory(
 		nil, // Local secrets manager doesn't require a config
 		&secrets.SecretsManagerParams{
 			Logger: hclog.NewNullLogger(),
 			Extra: map[string]interface{}{
 				secrets.Path: dataDir,
+				secrets.Passphrase: secrets.PassphraseProvider(func() (string, error) {
+					return passphrase, nil
+				}),
+				secrets.Encrypt: true,
 			},
 		},
 	)
@@ -70,6 +91,18 @@ func setupGCPSSM(
 	)
 }
 
+// setupPKCS11 is a helper method for boilerplate PKCS#11 secrets manager setup
+func setupPKCS11(
+	secretsConfig *secrets.SecretsManagerConfig,
+) (secrets.SecretsManager, error) {
+	return pkcs11.SecretsManagerFactory(
+		secretsConfig,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+		},
+	)
+}
+
 // InitECDSAValidatorKey creates new ECDSA key and set as a validator key
 func InitECDSAValidatorKey(secretsManager secrets.SecretsManager) (types.Address, error) {
 	if secretsManager.HasSecret(secrets.ValidatorKey) {
@@ -235,6 +268,13 @@ func InitCloudSecretsManager(secretsConfig *secrets.SecretsManagerConfig) (secre
 		}
 
 		secretsManager = GCPSSM
+	case secrets.PKCS11:
+		PKCS11, err := setupPKCS11(secretsConfig)
+		if err != nil {
+			return secretsManager, err
+		}
+
+		secretsManager = PKCS11
 	default:
 		return secretsManager, errors.New("unsupported secrets manager")
 	}
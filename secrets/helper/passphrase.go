@@ -0,0 +1,90 @@
+package helper
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+const (
+	// PassphraseEnvVar is the environment variable with the passphrase of the encrypted local secrets
+	PassphraseEnvVar = "EDGE_SECRETS_PASSPHRASE"
+
+	// KeystorePassphraseEnvVar is the environment variable with the passphrase of the exported
+	// or imported keystore file
+	KeystorePassphraseEnvVar = "EDGE_KEYSTORE_PASSPHRASE"
+)
+
+var (
+	errPassphraseMismatch = errors.New("passphrases do not match")
+	errEmptyPassphrase    = errors.New("passphrase must not be empty")
+)
+
+// ReadPassphrase reads the passphrase from the environment variable, otherwise it prompts for it
+// on the terminal. The prompted passphrase is confirmed if it is going to encrypt the secrets
+func ReadPassphrase(envVar, prompt string, confirm bool) (string, error) {
+	if passphrase, ok := os.LookupEnv(envVar); ok {
+		if passphrase == "" {
+			return "", errEmptyPassphrase
+		}
+
+		return passphrase, nil
+	}
+
+	passphrase, err := promptPassphrase(prompt)
+	if err != nil {
+		return "", fmt.Errorf("unable to read the passphrase, set it by %s instead: %w", envVar, err)
+	}
+
+	if passphrase == "" {
+		return "", errEmptyPassphrase
+	}
+
+	if confirm {
+		repeated, err := promptPassphrase("Repeat " + strings.ToLower(prompt[:1]) + prompt[1:])
+		if err != nil {
+			return "", err
+		}
+
+		if repeated != passphrase {
+			return "", errPassphraseMismatch
+		}
+	}
+
+	return passphrase, nil
+}
+
+// PassphraseProvider returns the provider of the passphrase of the encrypted local secrets,
+// which is read from the environment variable or prompted for once the encrypted secret is read
+func PassphraseProvider() secrets.PassphraseProvider {
+	return func() (string, error) {
+		return ReadPassphrase(PassphraseEnvVar, "Secrets passphrase: ", false)
+	}
+}
+
+// promptPassphrase prompts for the passphrase on the terminal, without echoing it
+func promptPassphrase(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+
+	restore, err := disableEcho(fd)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+
+	restore()
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil && line == "" {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,12 @@
+//go:build darwin || freebsd || netbsd || openbsd
+
+package helper
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ioctlReadTermios  = unix.TIOCGETA
+	ioctlWriteTermios = unix.TIOCSETA
+)
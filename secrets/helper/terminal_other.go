@@ -0,0 +1,12 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+
+package helper
+
+import (
+	"errors"
+)
+
+// disableEcho is not supported, the passphrase must be set by the environment variable
+func disableEcho(_ int) (func(), error) {
+	return nil, errors.New("passphrase prompt is not supported on this platform")
+}
@@ -0,0 +1,32 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package helper
+
+import (
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+var errNotTerminal = errors.New("standard input is not a terminal")
+
+// disableEcho disables the echo of the terminal, the returned function restores it
+func disableEcho(fd int) (func(), error) {
+	state, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return nil, errNotTerminal
+	}
+
+	noEcho := *state
+	noEcho.Lflag &^= unix.ECHO
+	noEcho.Lflag |= unix.ICANON | unix.ISIG
+	noEcho.Iflag |= unix.ICRNL
+
+	if err := unix.IoctlSetTermios(fd, ioctlWriteTermios, &noEcho); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		_ = unix.IoctlSetTermios(fd, ioctlWriteTermios, state)
+	}, nil
+}
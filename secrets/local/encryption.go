@@ -0,0 +1,156 @@
+package local
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	encryptedSecretVersion = 1
+	encryptedSecretKDF     = "scrypt"
+	encryptedSecretCipher  = "aes-256-gcm"
+
+	scryptKeyLength  = 32
+	scryptSaltLength = 32
+)
+
+var (
+	// ErrInvalidPassphrase is returned if the secret can't be decrypted by the passphrase
+	ErrInvalidPassphrase = errors.New("invalid passphrase")
+
+	// ErrPassphraseRequired is returned if the secret is encrypted but there is no passphrase provider
+	ErrPassphraseRequired = errors.New("secret is encrypted, passphrase is required")
+
+	errEmptyPassphrase = errors.New("passphrase must not be empty")
+)
+
+// scryptN is the CPU/memory cost of the key derivation, it is the variable so the tests can lower it
+var scryptN = 1 << 18
+
+// scryptParams are the scrypt key derivation params of the encrypted secret
+type scryptParams struct {
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+	Salt string `json:"salt"`
+}
+
+// encryptedSecret is the secret stored encrypted by the passphrase. The key is derived from the passphrase
+// by scrypt and the secret is sealed by AES-GCM, authenticating the name of the secret as well,
+// so the encrypted secret files can't be swapped
+type encryptedSecret struct {
+	Version    int          `json:"version"`
+	KDF        string       `json:"kdf"`
+	KDFParams  scryptParams `json:"kdfparams"`
+	Cipher     string       `json:"cipher"`
+	Nonce      string       `json:"nonce"`
+	CipherText string       `json:"ciphertext"`
+}
+
+// isEncrypted checks whether the stored secret is encrypted,
+// the secrets stored unencrypted are never JSON objects
+func isEncrypted(stored []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(stored), []byte("{"))
+}
+
+// encryptSecret encrypts the secret of the name by the passphrase
+func encryptSecret(name string, value []byte, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errEmptyPassphrase
+	}
+
+	salt := make([]byte, scryptSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	params := scryptParams{N: scryptN, R: 8, P: 1, Salt: hex.EncodeToString(salt)}
+
+	aead, err := newAEAD(passphrase, params)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&encryptedSecret{
+		Version:    encryptedSecretVersion,
+		KDF:        encryptedSecretKDF,
+		KDFParams:  params,
+		Cipher:     encryptedSecretCipher,
+		Nonce:      hex.EncodeToString(nonce),
+		CipherText: hex.EncodeToString(aead.Seal(nil, nonce, value, []byte(name))),
+	})
+}
+
+// decryptSecret decrypts the stored secret of the name by the passphrase
+func decryptSecret(name string, stored []byte, passphrase string) ([]byte, error) {
+	var secret encryptedSecret
+
+	if err := json.Unmarshal(stored, &secret); err != nil {
+		return nil, fmt.Errorf("invalid encrypted secret: %w", err)
+	}
+
+	if secret.Version != encryptedSecretVersion || secret.KDF != encryptedSecretKDF ||
+		secret.Cipher != encryptedSecretCipher {
+		return nil, fmt.Errorf("unsupported encrypted secret (version %d, kdf %s, cipher %s)",
+			secret.Version, secret.KDF, secret.Cipher)
+	}
+
+	nonce, err := hex.DecodeString(secret.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted secret nonce: %w", err)
+	}
+
+	cipherText, err := hex.DecodeString(secret.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted secret ciphertext: %w", err)
+	}
+
+	aead, err := newAEAD(passphrase, secret.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid encrypted secret nonce length")
+	}
+
+	value, err := aead.Open(nil, nonce, cipherText, []byte(name))
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	return value, nil
+}
+
+// newAEAD creates the AES-GCM cipher of the key derived from the passphrase
+func newAEAD(passphrase string, params scryptParams) (cipher.AEAD, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted secret salt: %w", err)
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, scryptKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive the key from the passphrase: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,107 @@
+package local
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// the standard cost makes the tests too slow
+	scryptN = 1 << 10
+}
+
+func newEncryptedLocalSecretsManager(t *testing.T, dir string, passphrase string, encrypt bool) secrets.SecretsManager {
+	t.Helper()
+
+	manager, err := SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra: map[string]interface{}{
+			secrets.Path: dir,
+			secrets.Passphrase: secrets.PassphraseProvider(func() (string, error) {
+				return passphrase, nil
+			}),
+			secrets.Encrypt: encrypt,
+		},
+	})
+	require.NoError(t, err)
+
+	return manager
+}
+
+func TestEncryptSecret(t *testing.T) {
+	t.Parallel()
+
+	stored, err := encryptSecret(secrets.ValidatorKey, []byte("key"), "passphrase")
+	require.NoError(t, err)
+	assert.True(t, isEncrypted(stored))
+	assert.NotContains(t, string(stored), "key\"")
+
+	value, err := decryptSecret(secrets.ValidatorKey, stored, "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), value)
+
+	_, err = decryptSecret(secrets.ValidatorKey, stored, "wrong")
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+
+	// the secret is bound to its name, so the encrypted files can't be swapped
+	_, err = decryptSecret(secrets.NetworkKey, stored, "passphrase")
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+
+	_, err = encryptSecret(secrets.ValidatorKey, []byte("key"), "")
+	require.Error(t, err)
+}
+
+func TestLocalSecretsManager_Encrypted(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, common.SetupDataDir(dir, []string{secrets.ConsensusFolderLocal, secrets.NetworkFolderLocal}, 0770))
+
+	// the secret stored before the encryption is enabled is kept readable
+	plain := newEncryptedLocalSecretsManager(t, dir, "passphrase", false)
+	require.NoError(t, plain.SetSecret(secrets.NetworkKey, []byte("network")))
+
+	manager := newEncryptedLocalSecretsManager(t, dir, "passphrase", true)
+	require.NoError(t, manager.SetSecret(secrets.ValidatorKey, []byte("validator")))
+
+	stored, err := os.ReadFile(filepath.Join(dir, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+	require.NoError(t, err)
+	assert.True(t, isEncrypted(stored))
+
+	value, err := manager.GetSecret(secrets.ValidatorKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("validator"), value)
+
+	value, err = manager.GetSecret(secrets.NetworkKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("network"), value)
+
+	// the wrong passphrase doesn't decrypt the secret
+	wrong := newEncryptedLocalSecretsManager(t, dir, "wrong", false)
+	_, err = wrong.GetSecret(secrets.ValidatorKey)
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+
+	// the encrypted secret can't be read without the passphrase
+	noPassphrase, err := SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra:  map[string]interface{}{secrets.Path: dir},
+	})
+	require.NoError(t, err)
+
+	_, err = noPassphrase.GetSecret(secrets.ValidatorKey)
+	require.ErrorIs(t, err, ErrPassphraseRequired)
+
+	// the encryption requires the passphrase
+	_, err = SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra:  map[string]interface{}{secrets.Path: dir, secrets.Encrypt: true},
+	})
+	require.Error(t, err)
+}
@@ -26,6 +26,16 @@ type LocalSecretsManager struct {
 
 	// Mux for the secretPathMap
 	secretPathMapLock sync.RWMutex
+
+	// Provider of the passphrase of the encrypted secrets, nil if the secrets can't be decrypted
+	passphraseProvider secrets.PassphraseProvider
+
+	// Whether the stored secrets are encrypted by the passphrase
+	encrypt bool
+
+	// The passphrase, once provided and accepted
+	passphrase     string
+	passphraseLock sync.Mutex
 }
 
 // SecretsManagerFactory implements the factory method
@@ -50,6 +60,21 @@ func SecretsManagerFactory(
 		return nil, errors.New("invalid type assertion")
 	}
 
+	// Grab the optional passphrase of the encrypted secrets
+	if provider, ok := params.Extra[secrets.Passphrase]; ok && provider != nil {
+		if localManager.passphraseProvider, ok = provider.(secrets.PassphraseProvider); !ok {
+			return nil, errors.New("invalid passphrase provider")
+		}
+	}
+
+	if encrypt, ok := params.Extra[secrets.Encrypt].(bool); ok && encrypt {
+		if localManager.passphraseProvider == nil {
+			return nil, errors.New("no passphrase specified for the encrypted local secrets manager")
+		}
+
+		localManager.encrypt = true
+	}
+
 	// Run the initial setup
 	_ = localManager.Setup()
 
@@ -114,7 +139,61 @@ func (l *LocalSecretsManager) GetSecret(name string) ([]byte, error) {
 		)
 	}
 
-	return secret, nil
+	// The secrets stored before the encryption was enabled are kept unencrypted
+	if !isEncrypted(secret) {
+		return secret, nil
+	}
+
+	return l.decrypt(name, secret)
+}
+
+// decrypt decrypts the stored secret, the passphrase is kept once it decrypts the secret
+func (l *LocalSecretsManager) decrypt(name string, secret []byte) ([]byte, error) {
+	l.passphraseLock.Lock()
+	defer l.passphraseLock.Unlock()
+
+	if l.passphrase != "" {
+		return decryptSecret(name, secret, l.passphrase)
+	}
+
+	if l.passphraseProvider == nil {
+		return nil, ErrPassphraseRequired
+	}
+
+	passphrase, err := l.passphraseProvider()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the passphrase, %w", err)
+	}
+
+	value, err := decryptSecret(name, secret, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	l.passphrase = passphrase
+
+	return value, nil
+}
+
+// encryptValue encrypts the secret by the passphrase, if the encryption is enabled
+func (l *LocalSecretsManager) encryptValue(name string, value []byte) ([]byte, error) {
+	if !l.encrypt {
+		return value, nil
+	}
+
+	l.passphraseLock.Lock()
+	defer l.passphraseLock.Unlock()
+
+	if l.passphrase == "" {
+		passphrase, err := l.passphraseProvider()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the passphrase, %w", err)
+		}
+
+		l.passphrase = passphrase
+	}
+
+	return encryptSecret(name, value, l.passphrase)
 }
 
 // SetSecret saves the local SecretsManager's secret to disk
@@ -139,6 +218,12 @@ func (l *LocalSecretsManager) SetSecret(name string, value []byte) error {
 			secretPath,
 		)
 	}
+
+	value, err := l.encryptValue(name, value)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt secret (%s), %w", name, err)
+	}
+
 	// Write the secret to disk
 	if err := common.SaveFileSafe(secretPath, value, 0440); err != nil {
 		return fmt.Errorf(
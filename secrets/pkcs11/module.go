@@ -0,0 +1,535 @@
+//go:build cgo && !windows
+
+package pkcs11
+
+/*
+#cgo linux LDFLAGS: -ldl
+
+#include <dlfcn.h>
+#include <stdlib.h>
+#include <string.h>
+
+// The subset of the PKCS#11 v2.40 types and functions needed to store the secrets as the token data objects
+typedef unsigned char CK_BYTE;
+typedef unsigned char CK_BBOOL;
+typedef unsigned long CK_ULONG;
+typedef CK_ULONG CK_RV;
+typedef CK_ULONG CK_FLAGS;
+typedef CK_ULONG CK_SLOT_ID;
+typedef CK_ULONG CK_SESSION_HANDLE;
+typedef CK_ULONG CK_OBJECT_HANDLE;
+typedef CK_ULONG CK_USER_TYPE;
+typedef CK_ULONG CK_ATTRIBUTE_TYPE;
+
+typedef struct CK_VERSION {
+	CK_BYTE major;
+	CK_BYTE minor;
+} CK_VERSION;
+
+typedef struct CK_ATTRIBUTE {
+	CK_ATTRIBUTE_TYPE type;
+	void *pValue;
+	CK_ULONG ulValueLen;
+} CK_ATTRIBUTE;
+
+typedef struct CK_TOKEN_INFO {
+	CK_BYTE label[32];
+	CK_BYTE manufacturerID[32];
+	CK_BYTE model[16];
+	CK_BYTE serialNumber[16];
+	CK_FLAGS flags;
+	CK_ULONG ulMaxSessionCount;
+	CK_ULONG ulSessionCount;
+	CK_ULONG ulMaxRwSessionCount;
+	CK_ULONG ulRwSessionCount;
+	CK_ULONG ulMaxPinLen;
+	CK_ULONG ulMinPinLen;
+	CK_ULONG ulTotalPublicMemory;
+	CK_ULONG ulFreePublicMemory;
+	CK_ULONG ulTotalPrivateMemory;
+	CK_ULONG ulFreePrivateMemory;
+	CK_VERSION hardwareVersion;
+	CK_VERSION firmwareVersion;
+	CK_BYTE utcTime[16];
+} CK_TOKEN_INFO;
+
+typedef struct CK_C_INITIALIZE_ARGS {
+	void *CreateMutex;
+	void *DestroyMutex;
+	void *LockMutex;
+	void *UnlockMutex;
+	CK_FLAGS flags;
+	void *pReserved;
+} CK_C_INITIALIZE_ARGS;
+
+// CK_FUNCTION_LIST up to C_FindObjectsFinal, the functions after it aren't used
+typedef struct CK_FUNCTION_LIST {
+	CK_VERSION version;
+	CK_RV (*C_Initialize)(void *);
+	CK_RV (*C_Finalize)(void *);
+	void *C_GetInfo;
+	void *C_GetFunctionList;
+	CK_RV (*C_GetSlotList)(CK_BBOOL, CK_SLOT_ID *, CK_ULONG *);
+	void *C_GetSlotInfo;
+	CK_RV (*C_GetTokenInfo)(CK_SLOT_ID, CK_TOKEN_INFO *);
+	void *C_GetMechanismList;
+	void *C_GetMechanismInfo;
+	void *C_InitToken;
+	void *C_InitPIN;
+	void *C_SetPIN;
+	CK_RV (*C_OpenSession)(CK_SLOT_ID, CK_FLAGS, void *, void *, CK_SESSION_HANDLE *);
+	CK_RV (*C_CloseSession)(CK_SESSION_HANDLE);
+	void *C_CloseAllSessions;
+	void *C_GetSessionInfo;
+	void *C_GetOperationState;
+	void *C_SetOperationState;
+	CK_RV (*C_Login)(CK_SESSION_HANDLE, CK_USER_TYPE, CK_BYTE *, CK_ULONG);
+	CK_RV (*C_Logout)(CK_SESSION_HANDLE);
+	CK_RV (*C_CreateObject)(CK_SESSION_HANDLE, CK_ATTRIBUTE *, CK_ULONG, CK_OBJECT_HANDLE *);
+	void *C_CopyObject;
+	CK_RV (*C_DestroyObject)(CK_SESSION_HANDLE, CK_OBJECT_HANDLE);
+	void *C_GetObjectSize;
+	CK_RV (*C_GetAttributeValue)(CK_SESSION_HANDLE, CK_OBJECT_HANDLE, CK_ATTRIBUTE *, CK_ULONG);
+	void *C_SetAttributeValue;
+	CK_RV (*C_FindObjectsInit)(CK_SESSION_HANDLE, CK_ATTRIBUTE *, CK_ULONG);
+	CK_RV (*C_FindObjects)(CK_SESSION_HANDLE, CK_OBJECT_HANDLE *, CK_ULONG, CK_ULONG *);
+	CK_RV (*C_FindObjectsFinal)(CK_SESSION_HANDLE);
+} CK_FUNCTION_LIST;
+
+typedef CK_RV (*CK_C_GetFunctionList)(CK_FUNCTION_LIST **);
+
+static CK_RV p11_load(const char *path, void **handle, CK_FUNCTION_LIST **functions) {
+	CK_C_GetFunctionList getFunctionList;
+
+	*handle = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+	if (*handle == NULL) {
+		return (CK_RV)-1;
+	}
+
+	getFunctionList = (CK_C_GetFunctionList)dlsym(*handle, "C_GetFunctionList");
+	if (getFunctionList == NULL) {
+		dlclose(*handle);
+
+		return (CK_RV)-1;
+	}
+
+	return getFunctionList(functions);
+}
+
+static const char *p11_load_error() {
+	const char *err = dlerror();
+
+	return err == NULL ? "unknown error" : err;
+}
+
+static void p11_unload(void *handle) {
+	dlclose(handle);
+}
+
+static CK_RV p11_initialize(CK_FUNCTION_LIST *f) {
+	CK_C_INITIALIZE_ARGS args;
+
+	// the module uses the OS locking, the sessions are called from the different threads
+	memset(&args, 0, sizeof(args));
+	args.flags = 0x2;
+
+	return f->C_Initialize(&args);
+}
+
+static CK_RV p11_finalize(CK_FUNCTION_LIST *f) {
+	return f->C_Finalize(NULL);
+}
+
+static CK_RV p11_get_slot_list(CK_FUNCTION_LIST *f, CK_SLOT_ID *slots, CK_ULONG *count) {
+	return f->C_GetSlotList(1, slots, count);
+}
+
+static CK_RV p11_get_token_info(CK_FUNCTION_LIST *f, CK_SLOT_ID slot, CK_TOKEN_INFO *info) {
+	return f->C_GetTokenInfo(slot, info);
+}
+
+static CK_RV p11_open_session(CK_FUNCTION_LIST *f, CK_SLOT_ID slot, CK_SESSION_HANDLE *session) {
+	// CKF_SERIAL_SESSION | CKF_RW_SESSION
+	return f->C_OpenSession(slot, 0x4 | 0x2, NULL, NULL, session);
+}
+
+static CK_RV p11_close_session(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session) {
+	return f->C_CloseSession(session);
+}
+
+static CK_RV p11_login(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session, char *pin, CK_ULONG pinLen) {
+	// CKU_USER
+	return f->C_Login(session, 1, (CK_BYTE *)pin, pinLen);
+}
+
+static CK_RV p11_logout(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session) {
+	return f->C_Logout(session);
+}
+
+static CK_RV p11_create_object(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session,
+	CK_ATTRIBUTE *template, CK_ULONG count, CK_OBJECT_HANDLE *object) {
+	return f->C_CreateObject(session, template, count, object);
+}
+
+static CK_RV p11_destroy_object(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session, CK_OBJECT_HANDLE object) {
+	return f->C_DestroyObject(session, object);
+}
+
+static CK_RV p11_get_attribute_value(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session,
+	CK_OBJECT_HANDLE object, CK_ATTRIBUTE *template, CK_ULONG count) {
+	return f->C_GetAttributeValue(session, object, template, count);
+}
+
+static CK_RV p11_find_objects_init(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session,
+	CK_ATTRIBUTE *template, CK_ULONG count) {
+	return f->C_FindObjectsInit(session, template, count);
+}
+
+static CK_RV p11_find_objects(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session,
+	CK_OBJECT_HANDLE *objects, CK_ULONG max, CK_ULONG *count) {
+	return f->C_FindObjects(session, objects, max, count);
+}
+
+static CK_RV p11_find_objects_final(CK_FUNCTION_LIST *f, CK_SESSION_HANDLE session) {
+	return f->C_FindObjectsFinal(session);
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// The PKCS#11 constants used by the token
+const (
+	ckrOK                         = 0x000
+	ckrUserAlreadyLoggedIn        = 0x100
+	ckrCryptokiAlreadyInitialized = 0x191
+
+	ckaClass       = 0x000
+	ckaToken       = 0x001
+	ckaPrivate     = 0x002
+	ckaLabel       = 0x003
+	ckaApplication = 0x010
+	ckaValue       = 0x011
+	ckaModifiable  = 0x170
+
+	ckoData = 0x000
+
+	ckfLoginRequired    = 0x000004
+	ckfUserPinLocked    = 0x040000
+	ckfTokenInitialized = 0x000400
+
+	// findObjectsBatch is the number of the object handles read by C_FindObjects at once
+	findObjectsBatch = 16
+
+	// dataApplication is the application of the data objects of the secrets
+	dataApplication = "polygon-edge"
+)
+
+// ckUnavailableInformation is the length of the attribute which can't be read
+const ckUnavailableInformation = ^C.CK_ULONG(0)
+
+var (
+	errTokenNotFound       = errors.New("token not found")
+	errTokenNotInitialized = errors.New("token is not initialized")
+	errUserPINLocked       = errors.New("user PIN is locked")
+)
+
+// ckError is the error returned by the PKCS#11 function
+type ckError struct {
+	function string
+	rv       C.CK_RV
+}
+
+func (e *ckError) Error() string {
+	return fmt.Sprintf("%s failed: CKR 0x%X", e.function, uint64(e.rv))
+}
+
+func check(function string, rv C.CK_RV) error {
+	if rv == ckrOK {
+		return nil
+	}
+
+	return &ckError{function: function, rv: rv}
+}
+
+// moduleToken is the session to the token of the PKCS#11 module loaded by dlopen
+type moduleToken struct {
+	handle    unsafe.Pointer
+	functions *C.CK_FUNCTION_LIST
+	session   C.CK_SESSION_HANDLE
+
+	label        string
+	manufacturer string
+}
+
+// openToken loads the module, finds the token, checks its health and logs in with the user PIN
+func openToken(config *tokenConfig) (token, error) {
+	path := C.CString(config.modulePath)
+	defer C.free(unsafe.Pointer(path))
+
+	t := &moduleToken{}
+
+	if rv := C.p11_load(path, &t.handle, &t.functions); rv != ckrOK {
+		if rv == ^C.CK_RV(0) {
+			return nil, fmt.Errorf("unable to load PKCS#11 module %s: %s", config.modulePath, C.GoString(C.p11_load_error()))
+		}
+
+		return nil, check("C_GetFunctionList", rv)
+	}
+
+	if rv := C.p11_initialize(t.functions); rv != ckrOK && rv != ckrCryptokiAlreadyInitialized {
+		C.p11_unload(t.handle)
+
+		return nil, check("C_Initialize", rv)
+	}
+
+	if err := t.open(config); err != nil {
+		C.p11_finalize(t.functions)
+		C.p11_unload(t.handle)
+
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *moduleToken) open(config *tokenConfig) error {
+	slot, info, err := t.findSlot(config)
+	if err != nil {
+		return err
+	}
+
+	t.label = trimPadded(info.label[:])
+	t.manufacturer = trimPadded(info.manufacturerID[:])
+
+	// the health of the token
+	if info.flags&ckfTokenInitialized == 0 {
+		return fmt.Errorf("%w: %s", errTokenNotInitialized, t.label)
+	}
+
+	if info.flags&ckfUserPinLocked != 0 {
+		return fmt.Errorf("%w: %s", errUserPINLocked, t.label)
+	}
+
+	if err := check("C_OpenSession", C.p11_open_session(t.functions, slot, &t.session)); err != nil {
+		return err
+	}
+
+	if info.flags&ckfLoginRequired == 0 {
+		return nil
+	}
+
+	pin := C.CString(config.pin)
+	defer C.free(unsafe.Pointer(pin))
+
+	rv := C.p11_login(t.functions, t.session, pin, C.CK_ULONG(len(config.pin)))
+	if rv != ckrOK && rv != ckrUserAlreadyLoggedIn {
+		C.p11_close_session(t.functions, t.session)
+
+		return check("C_Login", rv)
+	}
+
+	return nil
+}
+
+// findSlot finds the slot of the token by its ID or by the label of the token
+func (t *moduleToken) findSlot(config *tokenConfig) (C.CK_SLOT_ID, *C.CK_TOKEN_INFO, error) {
+	var count C.CK_ULONG
+
+	if err := check("C_GetSlotList", C.p11_get_slot_list(t.functions, nil, &count)); err != nil {
+		return 0, nil, err
+	}
+
+	if count == 0 {
+		return 0, nil, errTokenNotFound
+	}
+
+	slots := (*C.CK_SLOT_ID)(C.malloc(C.size_t(count) * C.size_t(unsafe.Sizeof(C.CK_SLOT_ID(0)))))
+	defer C.free(unsafe.Pointer(slots))
+
+	if err := check("C_GetSlotList", C.p11_get_slot_list(t.functions, slots, &count)); err != nil {
+		return 0, nil, err
+	}
+
+	info := (*C.CK_TOKEN_INFO)(C.malloc(C.size_t(unsafe.Sizeof(C.CK_TOKEN_INFO{}))))
+	defer C.free(unsafe.Pointer(info))
+
+	for _, slot := range unsafe.Slice(slots, int(count)) {
+		if config.slot != nil && uint(slot) != *config.slot {
+			continue
+		}
+
+		if err := check("C_GetTokenInfo", C.p11_get_token_info(t.functions, slot, info)); err != nil {
+			return 0, nil, err
+		}
+
+		if config.slot == nil && trimPadded(info.label[:]) != config.tokenLabel {
+			continue
+		}
+
+		found := *info
+
+		return slot, &found, nil
+	}
+
+	return 0, nil, errTokenNotFound
+}
+
+func (t *moduleToken) info() (string, string) {
+	return t.label, t.manufacturer
+}
+
+func (t *moduleToken) findData(label string) ([]uint, error) {
+	template, count, free := newTemplate(
+		ulongAttribute(ckaClass, ckoData),
+		bytesAttribute(ckaLabel, []byte(label)),
+	)
+	defer free()
+
+	if err := check("C_FindObjectsInit", C.p11_find_objects_init(t.functions, t.session, template, count)); err != nil {
+		return nil, err
+	}
+
+	defer C.p11_find_objects_final(t.functions, t.session)
+
+	objects := (*C.CK_OBJECT_HANDLE)(C.malloc(C.size_t(findObjectsBatch) * C.size_t(unsafe.Sizeof(C.CK_OBJECT_HANDLE(0)))))
+	defer C.free(unsafe.Pointer(objects))
+
+	handles := []uint{}
+
+	for {
+		var count C.CK_ULONG
+
+		if err := check("C_FindObjects",
+			C.p11_find_objects(t.functions, t.session, objects, findObjectsBatch, &count)); err != nil {
+			return nil, err
+		}
+
+		for _, object := range unsafe.Slice(objects, int(count)) {
+			handles = append(handles, uint(object))
+		}
+
+		if count < findObjectsBatch {
+			return handles, nil
+		}
+	}
+}
+
+func (t *moduleToken) readData(handle uint) ([]byte, error) {
+	// the length of the value is read first
+	template, count, free := newTemplate(bytesAttribute(ckaValue, nil))
+	defer free()
+
+	if err := check("C_GetAttributeValue",
+		C.p11_get_attribute_value(t.functions, t.session, C.CK_OBJECT_HANDLE(handle), template, count)); err != nil {
+		return nil, err
+	}
+
+	if template.ulValueLen == ckUnavailableInformation {
+		return nil, errors.New("secret value is not readable")
+	}
+
+	if template.ulValueLen == 0 {
+		return []byte{}, nil
+	}
+
+	// the value is freed along with the template
+	template.pValue = C.malloc(C.size_t(template.ulValueLen))
+
+	if err := check("C_GetAttributeValue",
+		C.p11_get_attribute_value(t.functions, t.session, C.CK_OBJECT_HANDLE(handle), template, count)); err != nil {
+		return nil, err
+	}
+
+	return C.GoBytes(template.pValue, C.int(template.ulValueLen)), nil
+}
+
+func (t *moduleToken) createData(label string, value []byte) error {
+	template, count, free := newTemplate(
+		ulongAttribute(ckaClass, ckoData),
+		boolAttribute(ckaToken, true),
+		boolAttribute(ckaPrivate, true),
+		boolAttribute(ckaModifiable, false),
+		bytesAttribute(ckaLabel, []byte(label)),
+		bytesAttribute(ckaApplication, []byte(dataApplication)),
+		bytesAttribute(ckaValue, value),
+	)
+	defer free()
+
+	var object C.CK_OBJECT_HANDLE
+
+	return check("C_CreateObject", C.p11_create_object(t.functions, t.session, template, count, &object))
+}
+
+func (t *moduleToken) destroy(handle uint) error {
+	return check("C_DestroyObject", C.p11_destroy_object(t.functions, t.session, C.CK_OBJECT_HANDLE(handle)))
+}
+
+func (t *moduleToken) close() error {
+	C.p11_logout(t.functions, t.session)
+
+	err := check("C_CloseSession", C.p11_close_session(t.functions, t.session))
+
+	C.p11_finalize(t.functions)
+	C.p11_unload(t.handle)
+
+	return err
+}
+
+// attribute is the PKCS#11 attribute of the object template
+type attribute struct {
+	typ   C.CK_ATTRIBUTE_TYPE
+	value []byte
+}
+
+func bytesAttribute(typ C.CK_ATTRIBUTE_TYPE, value []byte) attribute {
+	return attribute{typ: typ, value: value}
+}
+
+func boolAttribute(typ C.CK_ATTRIBUTE_TYPE, value bool) attribute {
+	if value {
+		return attribute{typ: typ, value: []byte{1}}
+	}
+
+	return attribute{typ: typ, value: []byte{0}}
+}
+
+func ulongAttribute(typ C.CK_ATTRIBUTE_TYPE, value C.CK_ULONG) attribute {
+	return attribute{typ: typ, value: C.GoBytes(unsafe.Pointer(&value), C.int(unsafe.Sizeof(value)))}
+}
+
+// newTemplate copies the attributes to the C memory, which is freed by the returned function
+func newTemplate(attributes ...attribute) (*C.CK_ATTRIBUTE, C.CK_ULONG, func()) {
+	template := (*C.CK_ATTRIBUTE)(C.malloc(C.size_t(len(attributes)) * C.size_t(unsafe.Sizeof(C.CK_ATTRIBUTE{}))))
+	entries := unsafe.Slice(template, len(attributes))
+
+	for i, attr := range attributes {
+		entries[i]._type = attr.typ
+		entries[i].pValue = nil
+		entries[i].ulValueLen = C.CK_ULONG(len(attr.value))
+
+		if len(attr.value) > 0 {
+			entries[i].pValue = C.CBytes(attr.value)
+		}
+	}
+
+	return template, C.CK_ULONG(len(attributes)), func() {
+		for i := range entries {
+			if entries[i].pValue != nil {
+				C.free(entries[i].pValue)
+			}
+		}
+
+		C.free(unsafe.Pointer(template))
+	}
+}
+
+// trimPadded trims the blank padding of the PKCS#11 fixed length strings
+func trimPadded(value []C.CK_BYTE) string {
+	return strings.TrimRight(string(C.GoBytes(unsafe.Pointer(&value[0]), C.int(len(value)))), " \x00")
+}
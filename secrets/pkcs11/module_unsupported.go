@@ -0,0 +1,12 @@
+//go:build !cgo || windows
+
+package pkcs11
+
+import (
+	"errors"
+)
+
+// openToken is not supported, the PKCS#11 modules are loaded by cgo
+func openToken(_ *tokenConfig) (token, error) {
+	return nil, errors.New("PKCS#11 secrets manager requires a cgo enabled build on a non-Windows platform")
+}
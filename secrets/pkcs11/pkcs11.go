@@ -0,0 +1,251 @@
+package pkcs11
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/hashicorp/go-hclog"
+)
+
+// Define constant key names for SecretsManagerConfig.Extra
+const (
+	// ModuleKey is the path of the PKCS#11 module (.so) of the HSM vendor
+	ModuleKey = "module"
+
+	// SlotKey is the ID of the slot of the token
+	SlotKey = "slot"
+
+	// TokenLabelKey is the label of the token, used to find the slot if its ID isn't set
+	TokenLabelKey = "token-label"
+)
+
+// token is the logged in session to the PKCS#11 token the secrets are stored on,
+// as the token data objects labeled by the secret names
+type token interface {
+	// info returns the label of the token and its manufacturer
+	info() (string, string)
+
+	// findData returns the handles of the data objects with the label
+	findData(label string) ([]uint, error)
+
+	// readData reads the value of the data object
+	readData(handle uint) ([]byte, error)
+
+	// createData creates the private data object with the label and the value
+	createData(label string, value []byte) error
+
+	// destroy destroys the object
+	destroy(handle uint) error
+
+	// close logs out and closes the session
+	close() error
+}
+
+// tokenConfig is the configuration of the token session
+type tokenConfig struct {
+	modulePath string
+	slot       *uint
+	tokenLabel string
+	pin        string
+}
+
+// PKCS11Manager is a SecretsManager that stores secrets
+// on the hardware security module (e.g. YubiHSM, CloudHSM) accessed over PKCS#11
+type PKCS11Manager struct {
+	// Local logger object
+	logger hclog.Logger
+
+	// The configuration of the token session
+	config *tokenConfig
+
+	// The prefix of the labels of the secrets, which is the name of the node
+	labelPrefix string
+
+	// openToken opens the session to the token
+	openToken func(config *tokenConfig) (token, error)
+
+	// The session to the token, the token sessions aren't safe for the concurrent use
+	token     token
+	tokenLock sync.Mutex
+}
+
+// SecretsManagerFactory implements the factory method
+func SecretsManagerFactory(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+) (secrets.SecretsManager, error) {
+	manager, err := newPKCS11Manager(config, params, openToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run the initial setup, which checks the token health
+	if err := manager.Setup(); err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
+
+func newPKCS11Manager(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+	open func(config *tokenConfig) (token, error),
+) (*PKCS11Manager, error) {
+	// Check if the node name is present
+	if config.Name == "" {
+		return nil, errors.New("no node name specified for PKCS#11 secrets manager")
+	}
+
+	// Check if the user PIN is present
+	if config.Token == "" {
+		return nil, errors.New("no user PIN (token) specified for PKCS#11 secrets manager")
+	}
+
+	if config.Extra == nil || config.Extra[ModuleKey] == nil {
+		return nil, fmt.Errorf("required extra map containing '%s' not found for pkcs11", ModuleKey)
+	}
+
+	tokenConfig := &tokenConfig{
+		modulePath: fmt.Sprintf("%v", config.Extra[ModuleKey]),
+		pin:        config.Token,
+	}
+
+	// The token is found either by the slot or by the label
+	if slot, ok := config.Extra[SlotKey]; ok {
+		id, err := strconv.ParseUint(fmt.Sprintf("%v", slot), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PKCS#11 slot '%v': %w", slot, err)
+		}
+
+		slotID := uint(id)
+		tokenConfig.slot = &slotID
+	} else if label, ok := config.Extra[TokenLabelKey]; ok {
+		tokenConfig.tokenLabel = fmt.Sprintf("%v", label)
+	} else {
+		return nil, fmt.Errorf("either '%s' or '%s' must be specified for pkcs11", SlotKey, TokenLabelKey)
+	}
+
+	return &PKCS11Manager{
+		logger:      params.Logger.Named(string(secrets.PKCS11)),
+		config:      tokenConfig,
+		labelPrefix: config.Name,
+		openToken:   open,
+	}, nil
+}
+
+// Setup opens the session to the token, checking that the module is loaded,
+// the token is present and initialized and the user PIN is accepted
+func (p *PKCS11Manager) Setup() error {
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+
+	if p.token != nil {
+		return nil
+	}
+
+	token, err := p.openToken(p.config)
+	if err != nil {
+		return fmt.Errorf("unable to open PKCS#11 token session: %w", err)
+	}
+
+	label, manufacturer := token.info()
+	p.logger.Info("PKCS#11 token session opened", "token", label, "manufacturer", manufacturer)
+
+	p.token = token
+
+	return nil
+}
+
+// constructSecretLabel is a helper method for constructing a label of the secret
+func (p *PKCS11Manager) constructSecretLabel(name string) string {
+	return fmt.Sprintf("%s/%s", p.labelPrefix, name)
+}
+
+// GetSecret reads a secret from the token
+func (p *PKCS11Manager) GetSecret(name string) ([]byte, error) {
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+
+	handles, err := p.token.findData(p.constructSecretLabel(name))
+	if err != nil {
+		return nil, fmt.Errorf("unable to find secret (%s), %w", name, err)
+	}
+
+	if len(handles) == 0 {
+		return nil, secrets.ErrSecretNotFound
+	}
+
+	return p.token.readData(handles[0])
+}
+
+// SetSecret stores a secret on the token, the existing secrets aren't overwritten
+func (p *PKCS11Manager) SetSecret(name string, value []byte) error {
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+
+	label := p.constructSecretLabel(name)
+
+	handles, err := p.token.findData(label)
+	if err != nil {
+		return fmt.Errorf("unable to find secret (%s), %w", name, err)
+	}
+
+	if len(handles) != 0 {
+		return fmt.Errorf("unable to store secret (%s), secret already exists", name)
+	}
+
+	if err := p.token.createData(label, value); err != nil {
+		return fmt.Errorf("unable to store secret (%s), %w", name, err)
+	}
+
+	return nil
+}
+
+// HasSecret checks if the secret is present on the token
+func (p *PKCS11Manager) HasSecret(name string) bool {
+	_, err := p.GetSecret(name)
+
+	return err == nil
+}
+
+// RemoveSecret removes a secret from the token
+func (p *PKCS11Manager) RemoveSecret(name string) error {
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+
+	handles, err := p.token.findData(p.constructSecretLabel(name))
+	if err != nil {
+		return fmt.Errorf("unable to find secret (%s), %w", name, err)
+	}
+
+	if len(handles) == 0 {
+		return secrets.ErrSecretNotFound
+	}
+
+	for _, handle := range handles {
+		if err := p.token.destroy(handle); err != nil {
+			return fmt.Errorf("unable to delete secret (%s), %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the session to the token
+func (p *PKCS11Manager) Close() error {
+	p.tokenLock.Lock()
+	defer p.tokenLock.Unlock()
+
+	if p.token == nil {
+		return nil
+	}
+
+	err := p.token.close()
+	p.token = nil
+
+	return err
+}
@@ -0,0 +1,191 @@
+package pkcs11
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockToken is the in-memory token
+type mockToken struct {
+	objects map[uint]string
+	values  map[uint][]byte
+	next    uint
+	closed  bool
+}
+
+func newMockToken() *mockToken {
+	return &mockToken{
+		objects: map[uint]string{},
+		values:  map[uint][]byte{},
+	}
+}
+
+func (m *mockToken) info() (string, string) {
+	return "edge", "mock"
+}
+
+func (m *mockToken) findData(label string) ([]uint, error) {
+	handles := []uint{}
+
+	for handle, objectLabel := range m.objects {
+		if objectLabel == label {
+			handles = append(handles, handle)
+		}
+	}
+
+	return handles, nil
+}
+
+func (m *mockToken) readData(handle uint) ([]byte, error) {
+	return m.values[handle], nil
+}
+
+func (m *mockToken) createData(label string, value []byte) error {
+	m.next++
+	m.objects[m.next] = label
+	m.values[m.next] = value
+
+	return nil
+}
+
+func (m *mockToken) destroy(handle uint) error {
+	delete(m.objects, handle)
+	delete(m.values, handle)
+
+	return nil
+}
+
+func (m *mockToken) close() error {
+	m.closed = true
+
+	return nil
+}
+
+func newTestConfig(extra map[string]interface{}) *secrets.SecretsManagerConfig {
+	return &secrets.SecretsManagerConfig{
+		Token: "1234",
+		Type:  secrets.PKCS11,
+		Name:  "node-1",
+		Extra: extra,
+	}
+}
+
+func TestPKCS11Manager_Config(t *testing.T) {
+	t.Parallel()
+
+	params := &secrets.SecretsManagerParams{Logger: hclog.NewNullLogger()}
+	open := func(*tokenConfig) (token, error) { return newMockToken(), nil }
+
+	testTable := []struct {
+		name          string
+		config        *secrets.SecretsManagerConfig
+		shouldSucceed bool
+	}{
+		{
+			"Valid configuration with the slot",
+			newTestConfig(map[string]interface{}{ModuleKey: "/lib/hsm.so", SlotKey: float64(1)}),
+			true,
+		},
+		{
+			"Valid configuration with the token label",
+			newTestConfig(map[string]interface{}{ModuleKey: "/lib/hsm.so", TokenLabelKey: "edge"}),
+			true,
+		},
+		{
+			"Invalid configuration without the module",
+			newTestConfig(map[string]interface{}{SlotKey: "1"}),
+			false,
+		},
+		{
+			"Invalid configuration without the token",
+			newTestConfig(map[string]interface{}{ModuleKey: "/lib/hsm.so"}),
+			false,
+		},
+		{
+			"Invalid configuration with the invalid slot",
+			newTestConfig(map[string]interface{}{ModuleKey: "/lib/hsm.so", SlotKey: "first"}),
+			false,
+		},
+		{
+			"Invalid configuration without the PIN",
+			&secrets.SecretsManagerConfig{
+				Name:  "node-1",
+				Extra: map[string]interface{}{ModuleKey: "/lib/hsm.so", SlotKey: "1"},
+			},
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		manager, err := newPKCS11Manager(testCase.config, params, open)
+		assert.Equal(t, testCase.shouldSucceed, err == nil, testCase.name)
+
+		if err == nil {
+			assert.Equal(t, "/lib/hsm.so", manager.config.modulePath)
+			assert.Equal(t, "1234", manager.config.pin)
+		}
+	}
+}
+
+func TestPKCS11Manager_Secrets(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockToken()
+
+	manager, err := newPKCS11Manager(
+		newTestConfig(map[string]interface{}{ModuleKey: "/lib/hsm.so", SlotKey: "0"}),
+		&secrets.SecretsManagerParams{Logger: hclog.NewNullLogger()},
+		func(*tokenConfig) (token, error) { return mock, nil },
+	)
+	require.NoError(t, err)
+	require.NoError(t, manager.Setup())
+
+	_, err = manager.GetSecret(secrets.ValidatorKey)
+	require.ErrorIs(t, err, secrets.ErrSecretNotFound)
+	assert.False(t, manager.HasSecret(secrets.ValidatorKey))
+
+	require.NoError(t, manager.SetSecret(secrets.ValidatorKey, []byte("key")))
+	require.Error(t, manager.SetSecret(secrets.ValidatorKey, []byte("other")))
+
+	value, err := manager.GetSecret(secrets.ValidatorKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), value)
+	assert.True(t, manager.HasSecret(secrets.ValidatorKey))
+
+	// the secrets are labeled by the node name
+	assert.Equal(t, "node-1/"+secrets.ValidatorKey, mock.objects[1])
+
+	require.NoError(t, manager.RemoveSecret(secrets.ValidatorKey))
+	require.ErrorIs(t, manager.RemoveSecret(secrets.ValidatorKey), secrets.ErrSecretNotFound)
+
+	require.NoError(t, manager.Close())
+	assert.True(t, mock.closed)
+}
+
+func TestPKCS11Manager_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	errLocked := errors.New("user PIN is locked")
+
+	manager, err := newPKCS11Manager(
+		newTestConfig(map[string]interface{}{ModuleKey: "/lib/hsm.so", SlotKey: "0"}),
+		&secrets.SecretsManagerParams{Logger: hclog.NewNullLogger()},
+		func(*tokenConfig) (token, error) { return nil, errLocked },
+	)
+	require.NoError(t, err)
+
+	// the unhealthy token fails the setup
+	require.ErrorIs(t, manager.Setup(), errLocked)
+
+	// the module which can't be loaded fails the startup
+	_, err = SecretsManagerFactory(
+		newTestConfig(map[string]interface{}{ModuleKey: "/nonexistent/hsm.so", SlotKey: "0"}),
+		&secrets.SecretsManagerParams{Logger: hclog.NewNullLogger()},
+	)
+	require.Error(t, err)
+}
@@ -19,6 +19,12 @@ const (
 
 	// Name is the name of the current node
 	Name = "name"
+
+	// Passphrase is the PassphraseProvider of the passphrase the local secrets are encrypted with
+	Passphrase = "passphrase"
+
+	// Encrypt indicates whether the local secrets are stored encrypted by the passphrase
+	Encrypt = "encrypt"
 )
 
 // Define constant names for available secrets
@@ -50,6 +56,10 @@ var (
 	ErrSecretNotFound = errors.New("secret not found")
 )
 
+// PassphraseProvider returns the passphrase of the encrypted local secrets,
+// it is called once the passphrase is needed (e.g. to prompt for it)
+type PassphraseProvider func() (string, error)
+
 type SecretsManagerType string
 
 // Define constant types of secrets managers
@@ -65,6 +75,9 @@ const (
 
 	// GCPSSM pertains to the Google Cloud Computing secret store manager
 	GCPSSM SecretsManagerType = "gcp-ssm"
+
+	// PKCS11 pertains to the hardware security module accessed over PKCS#11
+	PKCS11 SecretsManagerType = "pkcs11"
 )
 
 // SecretsManager defines the base public interface that all
@@ -110,5 +123,5 @@ type SecretsManagerFactory func(
 // SupportedServiceManager checks if the passed in service manager type is supported
 func SupportedServiceManager(service SecretsManagerType) bool {
 	return service == HashicorpVault || service == AWSSSM ||
-		service == Local || service == GCPSSM
+		service == Local || service == GCPSSM || service == PKCS11
 }
@@ -32,6 +32,11 @@ func TestSupportedServiceManager(t *testing.T) {
 			GCPSSM,
 			true,
 		},
+		{
+			"Valid PKCS#11 secrets manager",
+			PKCS11,
+			true,
+		},
 		{
 			"Invalid secrets manager",
 			"MarsSecretsManager",
@@ -1,13 +1,26 @@
 package server
 
 import (
+	"crypto/ecdsa"
 	"net"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bridgealert"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/server/faucet"
+	"github.com/0xPolygon/polygon-edge/server/grpcauth"
+	"github.com/0xPolygon/polygon-edge/server/health"
+	"github.com/0xPolygon/polygon-edge/server/metatx"
+	"github.com/0xPolygon/polygon-edge/server/telemetry"
+	"github.com/0xPolygon/polygon-edge/syncer"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
 )
 
 const DefaultGRPCPort int = 9632
@@ -21,22 +34,72 @@ type Config struct {
 	GRPCAddr   *net.TCPAddr
 	LibP2PAddr *net.TCPAddr
 
+	// GRPCAuth is the authentication of the operator gRPC server, the server is unauthenticated if not set
+	GRPCAuth *grpcauth.Config
+
 	PriceLimit         uint64
 	MaxAccountEnqueued uint64
 	MaxSlots           uint64
+	TxPoolDenyList     *txpool.DenyList
+
+	TxPoolAdmissionRateLimit      uint64
+	TxPoolAdmissionMinProbability float64
+
+	// TxPoolAutoTune are the bounds of the txpool limits auto-tuning, nil disables the auto-tuning
+	TxPoolAutoTune *txpool.AutoTuneConfig
+
+	// TxPoolPriorityLane holds the recipients of the consensus critical transactions, nil disables the priority lane
+	TxPoolPriorityLane *txpool.PriorityLaneConfig
+
+	// TxPoolFutureTxLifetime is the maximum lifetime of the transactions preceded by a nonce gap, zero if unlimited
+	TxPoolFutureTxLifetime time.Duration
+
+	// OverrideFile is the path of the file overriding the runtime parameters, reloaded on SIGHUP
+	OverrideFile string
 
 	Telemetry *Telemetry
 	Network   *network.Config
 
+	// Health is the configuration of the health and readiness endpoints, disabled if the address is not set
+	Health *health.Config
+
 	DataDir     string
 	RestoreFile *string
 
+	TxLookupBySender bool
+
+	// ContractCreationLookup enables indexing of the contract creations
+	ContractCreationLookup bool
+
+	// TxHistory is the number of the latest blocks whose transaction lookups and receipts are kept (0 keeps all)
+	TxHistory uint64
+
+	// FlatState enables the flat state layer serving the account and storage reads
+	FlatState bool
+
+	// DirtyStateLimit is the estimated size (in bytes) of the state modified by the block being executed,
+	// after which the modified state is flushed to the trie, zero disables the flushing
+	DirtyStateLimit uint64
+
+	// MetaTx is the configuration of the meta-transaction relayer, disabled if the forwarder is not set
+	MetaTx *metatx.Config
+
+	// Faucet is the configuration of the faucet, disabled if the address is not set
+	Faucet *faucet.Config
+
+	// BridgeAlert is the configuration of the bridge alerts, disabled if none of the rules is set
+	BridgeAlert *bridgealert.Config
+
 	Seal bool
 
 	SecretsManager *secrets.SecretsManagerConfig
 
 	LogLevel hclog.Level
 
+	// LogLevelFlagSet is true if the log level is set on the command line,
+	// so it takes precedence over the log level persisted in the data dir
+	LogLevelFlagSet bool
+
 	JSONLogFormat bool
 
 	LogFilePath string
@@ -44,17 +107,58 @@ type Config struct {
 	Relayer bool
 
 	NumBlockConfirmations uint64
+
+	// SyncStallTimeout is the timeout of the chain head not advancing while the peers are ahead,
+	// after which the sync peer is rotated and the node resyncs, 0 disables the stall detection
+	SyncStallTimeout time.Duration
+
+	// SyncPipeline configures the block import pipeline of the bulk sync
+	SyncPipeline syncer.PipelineConfig
+
+	// ShutdownTimeout is the timeout of waiting for the in-flight block sealing on the shutdown,
+	// 0 waits without the limit
+	ShutdownTimeout time.Duration
+
+	// RootchainJSONRPCEndpoints are the rootchain JSON-RPC endpoints used for tracking the rootchain events
+	RootchainJSONRPCEndpoints []string
+
+	// Plugins are the paths of the Go plugins with the out-of-tree extensions
+	Plugins []string
+
+	// Signer is the configuration of the external signer or Web3Signer the validator signing is delegated to,
+	// the validator keys are read from the secrets manager if neither is set
+	Signer wallet.SignerConfig
+
+	// RootchainFeeBump is the fee management config of the rootchain transactions, nil if disabled
+	RootchainFeeBump *txrelayer.FeeBumpConfig
 }
 
 // Telemetry holds the config details for metric services
 type Telemetry struct {
 	PrometheusAddr *net.TCPAddr
+
+	// TracingEndpoint is the OTLP gRPC endpoint the traces are exported to, tracing is disabled if empty
+	TracingEndpoint string
+
+	// TracingSampleRate is the fraction of the traces being exported
+	TracingSampleRate float64
+
+	// Report is the configuration of the opt-in node health reporting, disabled if the endpoint is not set
+	Report *telemetry.Config
 }
 
 // JSONRPC holds the config details for the JSON-RPC server
 type JSONRPC struct {
 	JSONRPCAddr              *net.TCPAddr
 	AccessControlAllowOrigin []string
+	AllowedHosts             []string
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	CallCacheSize            uint64
+	FilterTimeout            time.Duration
+	FilterRetention          time.Duration
+	LogsResultLimit          uint64
+	APIKeysRequired          bool
+	APIKeys                  []*jsonrpc.APIKey
+	SigningKeys              []*ecdsa.PrivateKey
 }
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/versioning"
+	"google.golang.org/grpc"
+)
+
+const (
+	// crashDirName is the name of the data dir subdirectory holding the diagnostic bundles
+	crashDirName = "crash"
+
+	// crashLogLines is the number of the latest log lines included in the diagnostic bundle
+	crashLogLines = 1000
+
+	// crashOutputFileName is the name of the file in the crash subdirectory the runtime appends
+	// the fatal errors and the unrecovered panics to
+	crashOutputFileName = "crash-output.txt"
+)
+
+// logRingBuffer is an io.Writer which keeps the last N written log lines in memory
+type logRingBuffer struct {
+	lock  sync.Mutex
+	lines []string
+	next  int
+	full  bool
+}
+
+// newLogRingBuffer creates a new log ring buffer of the given capacity
+func newLogRingBuffer(capacity int) *logRingBuffer {
+	return &logRingBuffer{
+		lines: make([]string, capacity),
+	}
+}
+
+// Write implements io.Writer interface
+func (r *logRingBuffer) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		r.lines[r.next] = line
+		r.next = (r.next + 1) % len(r.lines)
+
+		if r.next == 0 {
+			r.full = true
+		}
+	}
+
+	return len(p), nil
+}
+
+// Lines returns the buffered log lines, from the oldest to the newest one
+func (r *logRingBuffer) Lines() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.full {
+		return append([]string(nil), r.lines[:r.next]...)
+	}
+
+	return append(append([]string(nil), r.lines[r.next:]...), r.lines[:r.next]...)
+}
+
+// crashInfo holds the node metadata written to the diagnostic bundle
+type crashInfo struct {
+	Time       time.Time  `json:"time"`
+	Version    string     `json:"version"`
+	Commit     string     `json:"commit"`
+	Panic      string     `json:"panic"`
+	ConfigHash types.Hash `json:"configHash"`
+	HeadNumber uint64     `json:"headNumber"`
+	HeadHash   types.Hash `json:"headHash"`
+}
+
+// RecoverPanic recovers from a panic, writes the diagnostic bundle to the crash subdirectory
+// of the data dir and exits the process. It must be called directly by a deferred function call
+func (s *Server) RecoverPanic() {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	stack := debug.Stack()
+
+	s.logger.Error("unrecoverable error occurred", "panic", r, "stack", string(stack))
+
+	bundlePath, err := s.writeCrashBundle(r, stack)
+	if err != nil {
+		s.logger.Error("failed to write diagnostic bundle", "err", err)
+	} else {
+		s.logger.Error("diagnostic bundle written", "path", bundlePath)
+	}
+
+	os.Exit(1)
+}
+
+// recoveryUnaryInterceptor writes the diagnostic bundle if the unary GRPC handler panics
+func (s *Server) recoveryUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	defer s.RecoverPanic()
+
+	return handler(ctx, req)
+}
+
+// recoveryStreamInterceptor writes the diagnostic bundle if the stream GRPC handler panics
+func (s *Server) recoveryStreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	defer s.RecoverPanic()
+
+	return handler(srv, stream)
+}
+
+// writeCrashBundle writes stack traces of all goroutines, the latest log lines,
+// the chain head and the config hash to a new directory and returns its path
+func (s *Server) writeCrashBundle(panicValue interface{}, stack []byte) (string, error) {
+	now := time.Now().UTC()
+	bundlePath := filepath.Join(s.config.DataDir, crashDirName, fmt.Sprintf("crash-%d", now.UnixNano()))
+
+	if err := os.MkdirAll(bundlePath, 0750); err != nil {
+		return "", err
+	}
+
+	info := &crashInfo{
+		Time:       now,
+		Version:    versioning.Version,
+		Commit:     versioning.Commit,
+		Panic:      fmt.Sprintf("%v", panicValue),
+		ConfigHash: s.configHash(),
+	}
+
+	if s.blockchain != nil {
+		if head := s.blockchain.Header(); head != nil {
+			info.HeadNumber = head.Number
+			info.HeadHash = head.Hash
+		}
+	}
+
+	infoRaw, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	var goroutines bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&goroutines, 2); err != nil {
+		return "", err
+	}
+
+	var logs []string
+	if s.logs != nil {
+		logs = s.logs.Lines()
+	}
+
+	files := map[string][]byte{
+		"info.json":      infoRaw,
+		"stack.txt":      stack,
+		"goroutines.txt": goroutines.Bytes(),
+		"logs.txt":       []byte(strings.Join(logs, "\n")),
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(bundlePath, name), data, 0600); err != nil {
+			return "", err
+		}
+	}
+
+	return bundlePath, nil
+}
+
+// configHash returns the hash of the chain configuration the node is running with
+func (s *Server) configHash() types.Hash {
+	if s.chain == nil {
+		return types.ZeroHash
+	}
+
+	raw, err := json.Marshal(s.chain)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	return types.BytesToHash(crypto.Keccak256(raw))
+}
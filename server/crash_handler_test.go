@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestLogRingBuffer(t *testing.T) {
+	t.Parallel()
+
+	r := newLogRingBuffer(3)
+	require.Empty(t, r.Lines())
+
+	_, err := r.Write([]byte("line 1\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"line 1"}, r.Lines())
+
+	_, err = r.Write([]byte("line 2\nline 3\nline 4\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"line 2", "line 3", "line 4"}, r.Lines())
+
+	_, err = r.Write([]byte("line 5\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"line 3", "line 4", "line 5"}, r.Lines())
+}
+
+func TestServer_WriteCrashBundle(t *testing.T) {
+	t.Parallel()
+
+	logs := newLogRingBuffer(10)
+	_, err := logs.Write([]byte("last log line\n"))
+	require.NoError(t, err)
+
+	s := &Server{
+		logger: hclog.NewNullLogger(),
+		logs:   logs,
+		config: &Config{DataDir: t.TempDir()},
+		chain:  &chain.Chain{Name: "test"},
+	}
+
+	bundlePath, err := s.writeCrashBundle("boom", []byte("stack"))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(s.config.DataDir, crashDirName), filepath.Dir(bundlePath))
+
+	raw, err := os.ReadFile(filepath.Join(bundlePath, "info.json"))
+	require.NoError(t, err)
+
+	var info crashInfo
+
+	require.NoError(t, json.Unmarshal(raw, &info))
+	require.Equal(t, "boom", info.Panic)
+	require.Equal(t, s.configHash(), info.ConfigHash)
+
+	raw, err = os.ReadFile(filepath.Join(bundlePath, "logs.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "last log line", string(raw))
+
+	raw, err = os.ReadFile(filepath.Join(bundlePath, "goroutines.txt"))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "TestServer_WriteCrashBundle")
+}
+
+func TestServer_RecoveryInterceptors(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{logger: hclog.NewNullLogger()}
+
+	resp, err := s.recoveryUnaryInterceptor(context.Background(), "req", &grpc.UnaryServerInfo{},
+		func(_ context.Context, req interface{}) (interface{}, error) {
+			return req, nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, "req", resp)
+
+	err = s.recoveryStreamInterceptor(nil, nil, &grpc.StreamServerInfo{},
+		func(interface{}, grpc.ServerStream) error {
+			return context.Canceled
+		})
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"runtime/debug"
+)
+
+// setCrashOutput makes the runtime append the fatal errors and the unrecovered panics of all goroutines
+// to the crash output file in the crash subdirectory of the data dir, besides the standard error.
+// It covers the goroutines of the subsystems which don't defer RecoverPanic
+func setCrashOutput(dataDir string) error {
+	if dataDir == "" {
+		return nil
+	}
+
+	crashDir := filepath.Join(dataDir, crashDirName)
+	if err := os.MkdirAll(crashDir, 0750); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(filepath.Join(crashDir, crashOutputFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	// the runtime duplicates the file descriptor
+	defer f.Close()
+
+	return debug.SetCrashOutput(f, debug.CrashOptions{})
+}
@@ -0,0 +1,8 @@
+//go:build !go1.23
+
+package server
+
+// setCrashOutput is a no-op, as the runtime can't write the crash output to a file before go 1.23
+func setCrashOutput(string) error {
+	return nil
+}
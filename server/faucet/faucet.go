@@ -0,0 +1,434 @@
+package faucet
+
+import (
+	"crypto/ecdsa"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// DripPath is the endpoint sending the native tokens to the requested address
+	DripPath = "/drip"
+
+	// APIKeyHeader is the header holding the API key of the client
+	APIKeyHeader = "X-API-Key" //nolint:gosec
+
+	// DefaultCooldown is the default minimum time between two drips to the same address
+	DefaultCooldown = 24 * time.Hour
+
+	// DefaultCaptchaVerifyURL is the default captcha verification endpoint (hCaptcha)
+	DefaultCaptchaVerifyURL = "https://api.hcaptcha.com/siteverify"
+
+	// maxRequestSize is the maximum size of the drip request body
+	maxRequestSize = 4 * 1024
+
+	// captchaTimeout is the timeout of the captcha verification request
+	captchaTimeout = 10 * time.Second
+)
+
+// DefaultAmount is the default amount of the native tokens sent by a single drip (1 token with 18 decimals)
+var DefaultAmount = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+var (
+	ErrInvalidAddress = errors.New("invalid address")
+	ErrUnauthorized   = errors.New("missing or invalid API key")
+	ErrCaptchaFailed  = errors.New("captcha verification failed")
+	ErrRateLimited    = errors.New("address received the tokens recently")
+	errMissingKey     = errors.New("faucet key is not set")
+	errInvalidAmount  = errors.New("faucet amount must be greater than zero")
+)
+
+// Config is the configuration of the faucet
+type Config struct {
+	// Addr is the address the faucet endpoint is served on, the faucet is disabled if not set
+	Addr *net.TCPAddr
+
+	// Key is the key of the account the tokens are sent from
+	Key *ecdsa.PrivateKey
+
+	// Amount is the amount of the native tokens sent by a single drip
+	Amount *big.Int
+
+	// Cooldown is the minimum time between two drips to the same address
+	Cooldown time.Duration
+
+	// APIKeys are the keys authorizing the clients, the requests without
+	// the API key must solve the captcha if the captcha secret is set
+	APIKeys []string
+
+	// CaptchaSecret is the secret of the captcha verification, the captcha is not required if empty
+	CaptchaSecret string
+
+	// CaptchaVerifyURL is the captcha verification endpoint, compatible with hCaptcha and reCAPTCHA
+	CaptchaVerifyURL string
+
+	// GasPrice is the gas price (the tip after London) of the drip transactions
+	GasPrice *big.Int
+}
+
+// Enabled returns true if the faucet is configured
+func (c *Config) Enabled() bool {
+	return c != nil && c.Addr != nil
+}
+
+// Validate validates the faucet configuration
+func (c *Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.Key == nil {
+		return errMissingKey
+	}
+
+	if c.Amount == nil || c.Amount.Sign() <= 0 {
+		return errInvalidAmount
+	}
+
+	return nil
+}
+
+// Backend provides the chain state and the txpool the drip transactions are submitted to
+type Backend interface {
+	// Header returns the current head
+	Header() *types.Header
+
+	// GetForksInTime returns the forks enabled at the given block
+	GetForksInTime(blockNumber uint64) chain.ForksInTime
+
+	// GetNonce returns the next nonce of the account, including the pending transactions
+	GetNonce(addr types.Address) uint64
+
+	// AddTx adds the transaction to the txpool
+	AddTx(tx *types.Transaction) error
+}
+
+// DripRequest is the body of the drip request
+type DripRequest struct {
+	Address string `json:"address"`
+	Captcha string `json:"captcha,omitempty"`
+}
+
+// DripResponse is the body of the successful drip response
+type DripResponse struct {
+	Hash   types.Hash `json:"hash"`
+	Amount string     `json:"amount"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Faucet sends the native tokens from the configured account to the requested addresses,
+// at most once per cooldown to each address
+type Faucet struct {
+	logger     hclog.Logger
+	config     *Config
+	backend    Backend
+	chainID    uint64
+	address    types.Address
+	httpClient *http.Client
+
+	// lock serializes the drips, so that the nonces are not reused
+	// and the rate limit can't be bypassed by the concurrent requests
+	lock sync.Mutex
+	// nextNonce is the nonce of the next drip transaction,
+	// tracked because the txpool can not yet report the just submitted transactions
+	nextNonce uint64
+	// lastDrips holds the time of the last drip to each address within the cooldown
+	lastDrips map[types.Address]time.Time
+
+	now func() time.Time
+}
+
+// NewFaucet creates a new faucet
+func NewFaucet(config *Config, backend Backend, chainID uint64, logger hclog.Logger) (*Faucet, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	if config.CaptchaVerifyURL == "" {
+		config.CaptchaVerifyURL = DefaultCaptchaVerifyURL
+	}
+
+	f := &Faucet{
+		logger:     logger.Named("faucet"),
+		config:     config,
+		backend:    backend,
+		chainID:    chainID,
+		address:    crypto.PubKeyToAddress(&config.Key.PublicKey),
+		httpClient: &http.Client{Timeout: captchaTimeout},
+		lastDrips:  make(map[types.Address]time.Time),
+		now:        time.Now,
+	}
+
+	if len(config.APIKeys) == 0 && config.CaptchaSecret == "" {
+		f.logger.Warn("faucet is not protected by the API keys nor the captcha")
+	}
+
+	f.logger.Info("faucet enabled", "address", f.address, "amount", config.Amount, "cooldown", config.Cooldown)
+
+	return f, nil
+}
+
+// Address returns the address of the account the tokens are sent from
+func (f *Faucet) Address() types.Address {
+	return f.address
+}
+
+// Drip sends the configured amount of the native tokens to the given address.
+// It returns the hash of the submitted transaction
+func (f *Faucet) Drip(to types.Address) (types.Hash, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	now := f.now()
+
+	if wait := f.cooldownLeft(to, now); wait > 0 {
+		return types.ZeroHash, fmt.Errorf("%w, retry in %s", ErrRateLimited, wait)
+	}
+
+	tx, err := f.buildTx(to)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	if err := f.backend.AddTx(tx); err != nil {
+		return types.ZeroHash, err
+	}
+
+	f.nextNonce = tx.Nonce + 1
+	f.recordDrip(to, now)
+
+	f.logger.Debug("tokens sent", "to", to, "hash", tx.Hash)
+
+	return tx.Hash, nil
+}
+
+// cooldownLeft returns the time left until the address can receive the tokens again.
+// The lock is expected to be held
+func (f *Faucet) cooldownLeft(to types.Address, now time.Time) time.Duration {
+	last, ok := f.lastDrips[to]
+	if !ok {
+		return 0
+	}
+
+	if wait := f.config.Cooldown - now.Sub(last); wait > 0 {
+		return wait.Truncate(time.Second) + time.Second
+	}
+
+	return 0
+}
+
+// recordDrip records the drip to the address and forgets the drips older than the cooldown.
+// The lock is expected to be held
+func (f *Faucet) recordDrip(to types.Address, now time.Time) {
+	for addr, last := range f.lastDrips {
+		if now.Sub(last) >= f.config.Cooldown {
+			delete(f.lastDrips, addr)
+		}
+	}
+
+	if f.config.Cooldown > 0 {
+		f.lastDrips[to] = now
+	}
+}
+
+// buildTx builds and signs the transaction transferring the tokens to the given address
+func (f *Faucet) buildTx(to types.Address) (*types.Transaction, error) {
+	header := f.backend.Header()
+	forks := f.backend.GetForksInTime(header.Number + 1)
+
+	nonce := f.backend.GetNonce(f.address)
+	if f.nextNonce > nonce {
+		nonce = f.nextNonce
+	}
+
+	gasPrice := f.config.GasPrice
+	if gasPrice == nil {
+		gasPrice = big.NewInt(0)
+	}
+
+	tx := &types.Transaction{
+		Nonce: nonce,
+		To:    &to,
+		Value: new(big.Int).Set(f.config.Amount),
+		Input: []byte{},
+		From:  f.address,
+	}
+
+	if forks.London {
+		tx.Type = types.DynamicFeeTx
+		tx.GasTipCap = new(big.Int).Set(gasPrice)
+		// leave the room for the base fee increase until the transaction gets included
+		tx.GasFeeCap = new(big.Int).Add(
+			new(big.Int).Mul(new(big.Int).SetUint64(header.BaseFee), big.NewInt(2)),
+			gasPrice,
+		)
+	} else {
+		tx.Type = types.LegacyTx
+		tx.GasPrice = new(big.Int).Set(gasPrice)
+	}
+
+	gas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul, forks.Berlin)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Gas = gas
+
+	signed, err := crypto.NewSigner(forks, f.chainID).SignTx(tx, f.config.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign drip transaction: %w", err)
+	}
+
+	return signed, nil
+}
+
+// authorize checks the API key of the request, or the captcha solution if the API key is not provided
+func (f *Faucet) authorize(apiKey, captcha, remoteIP string) error {
+	if apiKey != "" {
+		for _, key := range f.config.APIKeys {
+			if subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) == 1 {
+				return nil
+			}
+		}
+
+		return ErrUnauthorized
+	}
+
+	if f.config.CaptchaSecret != "" {
+		return f.verifyCaptcha(captcha, remoteIP)
+	}
+
+	if len(f.config.APIKeys) > 0 {
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+// verifyCaptcha verifies the captcha solution against the verification endpoint
+func (f *Faucet) verifyCaptcha(captcha, remoteIP string) error {
+	if captcha == "" {
+		return ErrCaptchaFailed
+	}
+
+	form := url.Values{
+		"secret":   {f.config.CaptchaSecret},
+		"response": {captcha},
+	}
+
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	resp, err := f.httpClient.PostForm(f.config.CaptchaVerifyURL, form)
+	if err != nil {
+		return fmt.Errorf("failed to verify captcha: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxRequestSize)).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode captcha verification: %w", err)
+	}
+
+	if !result.Success {
+		return ErrCaptchaFailed
+	}
+
+	return nil
+}
+
+// Handler returns the HTTP handler serving the drip endpoint
+func (f *Faucet) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(DripPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+
+			return
+		}
+
+		var req DripRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+
+			return
+		}
+
+		var to types.Address
+		if err := to.UnmarshalText([]byte(strings.TrimSpace(req.Address))); err != nil || to == types.ZeroAddress {
+			writeError(w, http.StatusBadRequest, ErrInvalidAddress)
+
+			return
+		}
+
+		remoteIP, _, _ := net.SplitHostPort(r.RemoteAddr)
+
+		if err := f.authorize(r.Header.Get(APIKeyHeader), req.Captcha, remoteIP); err != nil {
+			if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrCaptchaFailed) {
+				writeError(w, http.StatusUnauthorized, err)
+			} else {
+				f.logger.Error("failed to authorize drip request", "err", err)
+				writeError(w, http.StatusBadGateway, err)
+			}
+
+			return
+		}
+
+		hash, err := f.Drip(to)
+		if err != nil {
+			if errors.Is(err, ErrRateLimited) {
+				f.lock.Lock()
+				wait := f.cooldownLeft(to, f.now())
+				f.lock.Unlock()
+
+				w.Header().Set("Retry-After", strconv.Itoa(int(wait.Seconds())))
+				writeError(w, http.StatusTooManyRequests, err)
+			} else {
+				f.logger.Error("failed to send tokens", "to", to, "err", err)
+				writeError(w, http.StatusInternalServerError, err)
+			}
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_ = json.NewEncoder(w).Encode(&DripResponse{Hash: hash, Amount: f.config.Amount.String()})
+	})
+
+	return mux
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(&errorResponse{Error: err.Error()})
+}
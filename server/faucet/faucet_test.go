@@ -0,0 +1,267 @@
+package faucet
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBackend struct {
+	london bool
+	nonce  uint64
+	txs    []*types.Transaction
+}
+
+func (m *mockBackend) Header() *types.Header {
+	return &types.Header{Number: 10, BaseFee: 100}
+}
+
+func (m *mockBackend) GetForksInTime(uint64) chain.ForksInTime {
+	return chain.ForksInTime{Homestead: true, EIP155: true, Istanbul: true, London: m.london}
+}
+
+func (m *mockBackend) GetNonce(types.Address) uint64 {
+	return m.nonce
+}
+
+func (m *mockBackend) AddTx(tx *types.Transaction) error {
+	tx.ComputeHash(1)
+	m.txs = append(m.txs, tx)
+
+	return nil
+}
+
+var (
+	recipient1 = types.StringToAddress("1000")
+	recipient2 = types.StringToAddress("2000")
+)
+
+func newTestFaucet(t *testing.T, backend Backend, modify func(*Config)) *Faucet {
+	t.Helper()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	config := &Config{
+		Addr:     &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 0},
+		Key:      key,
+		Amount:   big.NewInt(1000),
+		Cooldown: time.Hour,
+		GasPrice: big.NewInt(5),
+	}
+
+	if modify != nil {
+		modify(config)
+	}
+
+	faucet, err := NewFaucet(config, backend, 100, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	return faucet
+}
+
+func drip(t *testing.T, handler http.Handler, req *DripRequest, apiKey string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	httpReq := httptest.NewRequest(http.MethodPost, DripPath, bytes.NewReader(body))
+	if apiKey != "" {
+		httpReq.Header.Set(APIKeyHeader, apiKey)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httpReq)
+
+	return rec
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{Port: 1}
+
+	require.NoError(t, (*Config)(nil).Validate())
+	require.NoError(t, (&Config{}).Validate())
+	require.ErrorIs(t, (&Config{Addr: addr, Amount: big.NewInt(1)}).Validate(), errMissingKey)
+	require.ErrorIs(t, (&Config{Addr: addr, Key: key}).Validate(), errInvalidAmount)
+	require.NoError(t, (&Config{Addr: addr, Key: key, Amount: big.NewInt(1)}).Validate())
+}
+
+func TestFaucet_Drip(t *testing.T) {
+	t.Parallel()
+
+	for _, london := range []bool{false, true} {
+		backend := &mockBackend{london: london, nonce: 3}
+		faucet := newTestFaucet(t, backend, nil)
+
+		hash, err := faucet.Drip(recipient1)
+		require.NoError(t, err)
+
+		// the nonce is tracked until the txpool reports the submitted transaction
+		_, err = faucet.Drip(recipient2)
+		require.NoError(t, err)
+
+		require.Len(t, backend.txs, 2)
+
+		tx := backend.txs[0]
+		require.Equal(t, hash, tx.Hash)
+		require.Equal(t, faucet.Address(), tx.From)
+		require.Equal(t, recipient1, *tx.To)
+		require.Equal(t, big.NewInt(1000), tx.Value)
+		require.Equal(t, uint64(21000), tx.Gas)
+		require.Equal(t, uint64(3), tx.Nonce)
+		require.Equal(t, uint64(4), backend.txs[1].Nonce)
+
+		if london {
+			require.Equal(t, types.DynamicFeeTx, tx.Type)
+			require.Equal(t, big.NewInt(5), tx.GasTipCap)
+			require.Equal(t, big.NewInt(205), tx.GasFeeCap)
+		} else {
+			require.Equal(t, types.LegacyTx, tx.Type)
+			require.Equal(t, big.NewInt(5), tx.GasPrice)
+		}
+
+		sender, err := crypto.NewSigner(backend.GetForksInTime(11), 100).Sender(tx)
+		require.NoError(t, err)
+		require.Equal(t, faucet.Address(), sender)
+	}
+}
+
+func TestFaucet_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	backend := &mockBackend{}
+	faucet := newTestFaucet(t, backend, nil)
+
+	now := time.Now().UTC()
+	faucet.now = func() time.Time { return now }
+
+	_, err := faucet.Drip(recipient1)
+	require.NoError(t, err)
+
+	_, err = faucet.Drip(recipient1)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	// the other addresses are not limited
+	_, err = faucet.Drip(recipient2)
+	require.NoError(t, err)
+
+	// the address can receive the tokens again after the cooldown
+	now = now.Add(time.Hour)
+
+	_, err = faucet.Drip(recipient1)
+	require.NoError(t, err)
+	require.Len(t, backend.txs, 3)
+
+	// the expired drips are forgotten
+	require.Len(t, faucet.lastDrips, 1)
+}
+
+func TestFaucet_Handler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("drips and rate limits", func(t *testing.T) {
+		t.Parallel()
+
+		backend := &mockBackend{}
+		faucet := newTestFaucet(t, backend, nil)
+		handler := faucet.Handler()
+
+		now := time.Now().UTC()
+		faucet.now = func() time.Time { return now }
+
+		rec := drip(t, handler, &DripRequest{Address: recipient1.String()}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp DripResponse
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		require.Equal(t, backend.txs[0].Hash, resp.Hash)
+		require.Equal(t, "1000", resp.Amount)
+
+		now = now.Add(30 * time.Minute)
+
+		rec = drip(t, handler, &DripRequest{Address: recipient1.String()}, "")
+		require.Equal(t, http.StatusTooManyRequests, rec.Code)
+		require.Equal(t, "1801", rec.Header().Get("Retry-After"))
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newTestFaucet(t, &mockBackend{}, nil).Handler()
+
+		rec := drip(t, handler, &DripRequest{Address: "0x123"}, "")
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = drip(t, handler, &DripRequest{Address: types.ZeroAddress.String()}, "")
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, DripPath, nil))
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	t.Run("API keys", func(t *testing.T) {
+		t.Parallel()
+
+		handler := newTestFaucet(t, &mockBackend{}, func(c *Config) {
+			c.APIKeys = []string{"secret"}
+		}).Handler()
+
+		rec := drip(t, handler, &DripRequest{Address: recipient1.String()}, "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = drip(t, handler, &DripRequest{Address: recipient1.String()}, "invalid")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = drip(t, handler, &DripRequest{Address: recipient1.String()}, "secret")
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("captcha", func(t *testing.T) {
+		t.Parallel()
+
+		captchaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "captcha-secret", r.PostForm.Get("secret"))
+
+			_ = json.NewEncoder(w).Encode(map[string]bool{"success": r.PostForm.Get("response") == "solved"})
+		}))
+		defer captchaServer.Close()
+
+		handler := newTestFaucet(t, &mockBackend{}, func(c *Config) {
+			c.APIKeys = []string{"secret"}
+			c.CaptchaSecret = "captcha-secret"
+			c.CaptchaVerifyURL = captchaServer.URL
+		}).Handler()
+
+		rec := drip(t, handler, &DripRequest{Address: recipient1.String()}, "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = drip(t, handler, &DripRequest{Address: recipient1.String(), Captcha: "wrong"}, "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = drip(t, handler, &DripRequest{Address: recipient1.String(), Captcha: "solved"}, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		// the API key bypasses the captcha
+		rec = drip(t, handler, &DripRequest{Address: recipient2.String()}, "secret")
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,104 @@
+package grpcauth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ClientConfig holds the credentials the operator gRPC client connects with
+type ClientConfig struct {
+	// CAFile is the PEM encoded CA bundle the server certificate is verified against,
+	// the system roots are used if not set
+	CAFile string
+
+	// CertFile is the PEM encoded client certificate presented to the servers requiring mutual TLS
+	CertFile string
+
+	// KeyFile is the PEM encoded private key of the client certificate
+	KeyFile string
+
+	// ServerName overrides the name the server certificate is verified for, the dialed host by default
+	ServerName string
+
+	// Token is the bearer token sent with each call
+	Token string
+}
+
+// TLSEnabled returns true if the client connects over TLS
+func (c *ClientConfig) TLSEnabled() bool {
+	return c != nil && (c.CAFile != "" || c.CertFile != "" || c.ServerName != "")
+}
+
+// DialOptions returns the dial options applying the client credentials,
+// the connection is not encrypted if none of the TLS options is set
+func DialOptions(config *ClientConfig) ([]grpc.DialOption, error) {
+	if !config.TLSEnabled() {
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+		if config != nil && config.Token != "" {
+			opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{token: config.Token}))
+		}
+
+		return opts, nil
+	}
+
+	if (config.CertFile == "") != (config.KeyFile == "") {
+		return nil, errMissingKeyPair
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: config.ServerName,
+	}
+
+	if config.CAFile != "" {
+		data, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA file: %w", err)
+		}
+
+		if tlsConfig.RootCAs, err = certPoolFromPEM(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+
+	if config.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{token: config.Token, secure: true}))
+	}
+
+	return opts, nil
+}
+
+// tokenCredentials sends the bearer token with each call
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+// GetRequestMetadata implements the credentials.PerRPCCredentials interface
+func (t *tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: bearerPrefix + t.token}, nil
+}
+
+// RequireTransportSecurity implements the credentials.PerRPCCredentials interface.
+// The token is sent over the plain connection too, so the local node is reachable without TLS
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return t.secure
+}
@@ -0,0 +1,319 @@
+package grpcauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// authorizationHeader is the metadata key of the bearer token
+	authorizationHeader = "authorization"
+
+	// bearerPrefix is the prefix of the bearer token in the authorization header
+	bearerPrefix = "Bearer "
+)
+
+var (
+	errMissingKeyPair = errors.New("both the TLS certificate and the key must be set")
+	errClientCANoTLS  = errors.New("the client CA requires the TLS certificate and the key to be set")
+	errEmptyToken     = errors.New("token file is empty")
+	errInvalidCA      = errors.New("no valid certificates found in the CA file")
+)
+
+// Config is the configuration of the operator gRPC server authentication.
+// The files are read again once modified, so the certificates and the token are rotated without a restart
+type Config struct {
+	// CertFile is the PEM encoded TLS certificate of the server, TLS is disabled if not set
+	CertFile string
+
+	// KeyFile is the PEM encoded private key of the TLS certificate
+	KeyFile string
+
+	// ClientCAFile is the PEM encoded CA bundle the client certificates are verified against,
+	// the client certificates are not required (mutual TLS disabled) if not set
+	ClientCAFile string
+
+	// TokenFile is the file holding the bearer token required from the clients, token auth is disabled if not set
+	TokenFile string
+}
+
+// TLSEnabled returns true if the server is served over TLS
+func (c *Config) TLSEnabled() bool {
+	return c != nil && c.CertFile != ""
+}
+
+// TokenEnabled returns true if the clients must present the bearer token
+func (c *Config) TokenEnabled() bool {
+	return c != nil && c.TokenFile != ""
+}
+
+// Enabled returns true if any of the authentication methods is enabled
+func (c *Config) Enabled() bool {
+	return c.TLSEnabled() || c.TokenEnabled()
+}
+
+// Validate checks the combination of the set options
+func (c *Config) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errMissingKeyPair
+	}
+
+	if c.ClientCAFile != "" && c.CertFile == "" {
+		return errClientCANoTLS
+	}
+
+	return nil
+}
+
+// ServerOptions returns the gRPC server options enforcing the configured authentication.
+// The files are loaded right away, so the misconfiguration is reported on startup
+func ServerOptions(config *Config) ([]grpc.ServerOption, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts []grpc.ServerOption
+
+	if config.TLSEnabled() {
+		tlsConfig, err := serverTLSConfig(config)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	if config.TokenEnabled() {
+		token := newFileReloader(func(data [][]byte) (string, error) {
+			value := strings.TrimSpace(string(data[0]))
+			if value == "" {
+				return "", errEmptyToken
+			}
+
+			return value, nil
+		}, config.TokenFile)
+
+		if _, err := token.get(); err != nil {
+			return nil, fmt.Errorf("failed to load the token: %w", err)
+		}
+
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(tokenUnaryInterceptor(token)),
+			grpc.ChainStreamInterceptor(tokenStreamInterceptor(token)),
+		)
+	}
+
+	return opts, nil
+}
+
+// serverTLSConfig returns the TLS config of the server, reloading the certificate and the client CA once modified
+func serverTLSConfig(config *Config) (*tls.Config, error) {
+	keyPair := newFileReloader(func(data [][]byte) (*tls.Certificate, error) {
+		cert, err := tls.X509KeyPair(data[0], data[1])
+		if err != nil {
+			return nil, err
+		}
+
+		return &cert, nil
+	}, config.CertFile, config.KeyFile)
+
+	if _, err := keyPair.get(); err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate: %w", err)
+	}
+
+	var clientCAs *fileReloader[*x509.CertPool]
+
+	if config.ClientCAFile != "" {
+		clientCAs = newFileReloader(func(data [][]byte) (*x509.CertPool, error) {
+			return certPoolFromPEM(data[0])
+		}, config.ClientCAFile)
+
+		if _, err := clientCAs.get(); err != nil {
+			return nil, fmt.Errorf("failed to load the client CA: %w", err)
+		}
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, err := keyPair.get()
+			if err != nil {
+				return nil, err
+			}
+
+			tlsConfig := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+			}
+
+			if clientCAs != nil {
+				pool, err := clientCAs.get()
+				if err != nil {
+					return nil, err
+				}
+
+				tlsConfig.ClientCAs = pool
+				tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return tlsConfig, nil
+		},
+	}, nil
+}
+
+// tokenUnaryInterceptor rejects the unary calls without the valid bearer token
+func tokenUnaryInterceptor(token *fileReloader[string]) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkToken(ctx, token); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// tokenStreamInterceptor rejects the streams without the valid bearer token
+func tokenStreamInterceptor(token *fileReloader[string]) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkToken(stream.Context(), token); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// checkToken checks the bearer token sent in the metadata of the call
+func checkToken(ctx context.Context, token *fileReloader[string]) error {
+	expected, err := token.get()
+	if err != nil {
+		return status.Error(codes.Internal, "failed to load the token")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, value := range md.Get(authorizationHeader) {
+		if !strings.HasPrefix(value, bearerPrefix) {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(value, bearerPrefix)), []byte(expected)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+}
+
+// certPoolFromPEM returns the pool of the PEM encoded certificates
+func certPoolFromPEM(data []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errInvalidCA
+	}
+
+	return pool, nil
+}
+
+// fileReloader holds the value parsed from the files, parsed again once any of the files is modified.
+// If the modified files can't be parsed (e.g. the certificate is replaced before its key),
+// the last valid value is kept until the next modification
+type fileReloader[T any] struct {
+	paths []string
+	parse func(data [][]byte) (T, error)
+
+	lock     sync.Mutex
+	modTimes []time.Time
+	value    T
+	loaded   bool
+}
+
+func newFileReloader[T any](parse func(data [][]byte) (T, error), paths ...string) *fileReloader[T] {
+	return &fileReloader[T]{
+		paths:    paths,
+		parse:    parse,
+		modTimes: make([]time.Time, len(paths)),
+	}
+}
+
+// get returns the value, parsing the files again if modified since the last call
+func (r *fileReloader[T]) get() (T, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	modTimes := make([]time.Time, len(r.paths))
+	modified := !r.loaded
+
+	for i, path := range r.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return r.fallback(err)
+		}
+
+		modTimes[i] = info.ModTime()
+		modified = modified || !modTimes[i].Equal(r.modTimes[i])
+	}
+
+	if !modified {
+		return r.value, nil
+	}
+
+	data := make([][]byte, len(r.paths))
+
+	for i, path := range r.paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return r.fallback(err)
+		}
+
+		data[i] = raw
+	}
+
+	value, err := r.parse(data)
+	if err != nil {
+		return r.fallback(err)
+	}
+
+	r.value, r.modTimes, r.loaded = value, modTimes, true
+
+	return value, nil
+}
+
+// fallback returns the last valid value, or the error if the files were never parsed
+func (r *fileReloader[T]) fallback(err error) (T, error) {
+	if !r.loaded {
+		var empty T
+
+		return empty, err
+	}
+
+	return r.value, nil
+}
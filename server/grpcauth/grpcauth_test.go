@@ -0,0 +1,264 @@
+package grpcauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// testCA is the certificate authority issuing the test certificates
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().UTC().Add(-time.Hour),
+		NotAfter:              time.Now().UTC().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key}
+}
+
+// writeCA writes the PEM encoded CA certificate to the file
+func (ca *testCA) writeCA(t *testing.T, path string) {
+	t.Helper()
+
+	writeFile(t, path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}))
+}
+
+// writeLeaf issues the certificate for the localhost and writes it with its key to the files
+func (ca *testCA) writeLeaf(t *testing.T, certPath, keyPath string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().UTC().Add(-time.Hour),
+		NotAfter:     time.Now().UTC().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	writeFile(t, certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+// writeFile writes the file, moving its modification time forward so the rewrite is always detected
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	modTime := time.Now().UTC()
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+
+	require.NoError(t, os.WriteFile(path, data, 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+// startServer starts the gRPC server with the health service, returning its address
+func startServer(t *testing.T, config *Config) string {
+	t.Helper()
+
+	opts, err := ServerOptions(config)
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+// checkHealth calls the health service with the client credentials
+func checkHealth(t *testing.T, addr string, config *ClientConfig) error {
+	t.Helper()
+
+	opts, err := DialOptions(config)
+	require.NoError(t, err)
+
+	conn, err := grpc.Dial(addr, opts...)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+
+	return err
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (*Config)(nil).Validate())
+	require.NoError(t, (&Config{TokenFile: "token"}).Validate())
+	require.NoError(t, (&Config{CertFile: "cert", KeyFile: "key", ClientCAFile: "ca"}).Validate())
+
+	require.ErrorIs(t, (&Config{CertFile: "cert"}).Validate(), errMissingKeyPair)
+	require.ErrorIs(t, (&Config{KeyFile: "key"}).Validate(), errMissingKeyPair)
+	require.ErrorIs(t, (&Config{ClientCAFile: "ca"}).Validate(), errClientCANoTLS)
+}
+
+func TestServerOptions_Token(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+
+	writeFile(t, tokenFile, []byte("secret\n"))
+
+	addr := startServer(t, &Config{TokenFile: tokenFile})
+
+	for _, token := range []string{"", "invalid"} {
+		err := checkHealth(t, addr, &ClientConfig{Token: token})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	require.NoError(t, checkHealth(t, addr, &ClientConfig{Token: "secret"}))
+
+	// rotate the token
+	writeFile(t, tokenFile, []byte("rotated"))
+
+	require.Equal(t, codes.Unauthenticated, status.Code(checkHealth(t, addr, &ClientConfig{Token: "secret"})))
+	require.NoError(t, checkHealth(t, addr, &ClientConfig{Token: "rotated"}))
+
+	// the emptied token file keeps the last valid token
+	writeFile(t, tokenFile, nil)
+
+	require.NoError(t, checkHealth(t, addr, &ClientConfig{Token: "rotated"}))
+}
+
+func TestServerOptions_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+
+	serverCA, clientCA := newTestCA(t), newTestCA(t)
+
+	serverCA.writeCA(t, path("server-ca.pem"))
+	serverCA.writeLeaf(t, path("server.pem"), path("server-key.pem"))
+	clientCA.writeCA(t, path("client-ca.pem"))
+	clientCA.writeLeaf(t, path("client.pem"), path("client-key.pem"))
+
+	addr := startServer(t, &Config{
+		CertFile:     path("server.pem"),
+		KeyFile:      path("server-key.pem"),
+		ClientCAFile: path("client-ca.pem"),
+	})
+
+	// plain connection
+	require.Error(t, checkHealth(t, addr, nil))
+
+	// no client certificate
+	require.Error(t, checkHealth(t, addr, &ClientConfig{CAFile: path("server-ca.pem")}))
+
+	client := &ClientConfig{
+		CAFile:   path("server-ca.pem"),
+		CertFile: path("client.pem"),
+		KeyFile:  path("client-key.pem"),
+	}
+
+	require.NoError(t, checkHealth(t, addr, client))
+
+	// rotate the server certificate to the one issued by the new CA
+	rotatedCA := newTestCA(t)
+
+	rotatedCA.writeCA(t, path("rotated-ca.pem"))
+	rotatedCA.writeLeaf(t, path("server.pem"), path("server-key.pem"))
+
+	require.Error(t, checkHealth(t, addr, client))
+
+	client.CAFile = path("rotated-ca.pem")
+
+	require.NoError(t, checkHealth(t, addr, client))
+
+	// rotate the client CA, the certificate issued by the old one is rejected
+	rotatedCA.writeCA(t, path("client-ca.pem"))
+
+	require.Error(t, checkHealth(t, addr, client))
+
+	rotatedCA.writeLeaf(t, path("client.pem"), path("client-key.pem"))
+
+	require.NoError(t, checkHealth(t, addr, client))
+}
+
+func TestServerOptions_InvalidFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.pem")
+
+	writeFile(t, invalid, []byte("invalid"))
+
+	_, err := ServerOptions(&Config{CertFile: invalid, KeyFile: invalid})
+	require.Error(t, err)
+
+	_, err = ServerOptions(&Config{TokenFile: filepath.Join(dir, "missing")})
+	require.Error(t, err)
+
+	_, err = ServerOptions(&Config{TokenFile: invalid, CertFile: invalid})
+	require.ErrorIs(t, err, errMissingKeyPair)
+}
@@ -0,0 +1,213 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// HealthPath is the liveness endpoint, it fails only if the node is broken and must be restarted
+	HealthPath = "/health"
+
+	// ReadyPath is the readiness endpoint, it fails if the node should not serve the traffic
+	ReadyPath = "/ready"
+)
+
+const (
+	// DefaultMinPeers is the default minimum number of connected peers of a ready node
+	DefaultMinPeers uint64 = 1
+
+	// DefaultMaxBlocksBehind is the default maximum number of blocks a ready node is behind its peers
+	DefaultMaxBlocksBehind uint64 = 10
+
+	// DefaultMaxBlockAge is the default maximum age of the head block of a ready node
+	DefaultMaxBlockAge = time.Minute
+)
+
+// names of the checks
+const (
+	checkStorage   = "storage"
+	checkSync      = "sync"
+	checkPeers     = "peers"
+	checkConsensus = "consensus"
+)
+
+// Config is the configuration of the health and readiness endpoints
+type Config struct {
+	// Addr is the address the endpoints are served on
+	Addr *net.TCPAddr
+
+	// MinPeers is the minimum number of connected peers
+	MinPeers uint64
+
+	// MaxBlocksBehind is the maximum number of blocks the node is behind the highest known block
+	MaxBlocksBehind uint64
+
+	// MaxBlockAge is the maximum age of the head block, the consensus check is disabled if zero
+	MaxBlockAge time.Duration
+}
+
+// Backend provides the node state the checks are performed on
+type Backend interface {
+	// Header returns the current head
+	Header() *types.Header
+
+	// GetHeaderByNumber reads the canonical header from the storage
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+
+	// GetSyncProgression returns the progression of the ongoing sync, nil if the node is not syncing
+	GetSyncProgression() *progress.Progression
+
+	// PeerCount returns the number of connected peers
+	PeerCount() int
+
+	// IsSealing returns true if the node takes part in the block production
+	IsSealing() bool
+}
+
+// CheckResult is the result of a single check
+type CheckResult struct {
+	Healthy bool   `json:"healthy"`
+	Details string `json:"details"`
+}
+
+// Report is the result of all the checks
+type Report struct {
+	Healthy bool                    `json:"healthy"`
+	Checks  map[string]*CheckResult `json:"checks"`
+}
+
+// Checker performs the health checks of the node
+type Checker struct {
+	config  *Config
+	backend Backend
+
+	now func() time.Time
+}
+
+// NewChecker creates the health checker
+func NewChecker(config *Config, backend Backend) *Checker {
+	return &Checker{
+		config:  config,
+		backend: backend,
+		now:     time.Now,
+	}
+}
+
+// Check performs all the checks
+func (c *Checker) Check() *Report {
+	head := c.backend.Header()
+
+	report := &Report{
+		Healthy: true,
+		Checks: map[string]*CheckResult{
+			checkStorage:   c.checkStorage(head),
+			checkSync:      c.checkSync(head),
+			checkPeers:     c.checkPeers(),
+			checkConsensus: c.checkConsensus(head),
+		},
+	}
+
+	for _, result := range report.Checks {
+		report.Healthy = report.Healthy && result.Healthy
+	}
+
+	return report
+}
+
+// checkStorage checks that the head is readable from the storage
+func (c *Checker) checkStorage(head *types.Header) *CheckResult {
+	header, ok := c.backend.GetHeaderByNumber(head.Number)
+	if !ok {
+		return &CheckResult{Details: fmt.Sprintf("head block %d not found in the storage", head.Number)}
+	}
+
+	if header.Hash != head.Hash {
+		return &CheckResult{Details: fmt.Sprintf("head block %d hash mismatch in the storage", head.Number)}
+	}
+
+	return &CheckResult{Healthy: true, Details: fmt.Sprintf("head block %d readable", head.Number)}
+}
+
+// checkSync checks that the node is not too far behind the highest block known from the sync
+func (c *Checker) checkSync(head *types.Header) *CheckResult {
+	progression := c.backend.GetSyncProgression()
+	if progression == nil || progression.HighestBlock <= head.Number {
+		return &CheckResult{Healthy: true, Details: "synced"}
+	}
+
+	behind := progression.HighestBlock - head.Number
+
+	return &CheckResult{
+		Healthy: behind <= c.config.MaxBlocksBehind,
+		Details: fmt.Sprintf("%s in progress, %d blocks behind", progression.SyncType, behind),
+	}
+}
+
+// checkPeers checks the number of connected peers
+func (c *Checker) checkPeers() *CheckResult {
+	peers := c.backend.PeerCount()
+
+	return &CheckResult{
+		Healthy: uint64(peers) >= c.config.MinPeers,
+		Details: fmt.Sprintf("%d peers connected, minimum %d", peers, c.config.MinPeers),
+	}
+}
+
+// checkConsensus checks that the blocks are being produced
+func (c *Checker) checkConsensus(head *types.Header) *CheckResult {
+	role := "not sealing"
+	if c.backend.IsSealing() {
+		role = "sealing"
+	}
+
+	if c.config.MaxBlockAge == 0 {
+		return &CheckResult{Healthy: true, Details: role}
+	}
+
+	age := c.now().Sub(time.Unix(int64(head.Timestamp), 0)).Truncate(time.Second)
+
+	return &CheckResult{
+		Healthy: age <= c.config.MaxBlockAge,
+		Details: fmt.Sprintf("%s, head block %d produced %s ago, maximum %s", role, head.Number, age, c.config.MaxBlockAge),
+	}
+}
+
+// Handler returns the HTTP handler serving the health and readiness endpoints.
+// Both endpoints respond with the report of all the checks, the health endpoint
+// fails only if the storage check fails, while the readiness endpoint fails if any check fails
+func (c *Checker) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(HealthPath, func(w http.ResponseWriter, _ *http.Request) {
+		report := c.Check()
+
+		writeReport(w, report, report.Checks[checkStorage].Healthy)
+	})
+
+	mux.HandleFunc(ReadyPath, func(w http.ResponseWriter, _ *http.Request) {
+		report := c.Check()
+
+		writeReport(w, report, report.Healthy)
+	})
+
+	return mux
+}
+
+func writeReport(w http.ResponseWriter, report *Report, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,203 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBackend struct {
+	head        *types.Header
+	stored      map[uint64]*types.Header
+	progression *progress.Progression
+	peers       int
+	sealing     bool
+}
+
+func (m *mockBackend) Header() *types.Header {
+	return m.head
+}
+
+func (m *mockBackend) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	header, ok := m.stored[number]
+
+	return header, ok
+}
+
+func (m *mockBackend) GetSyncProgression() *progress.Progression {
+	return m.progression
+}
+
+func (m *mockBackend) PeerCount() int {
+	return m.peers
+}
+
+func (m *mockBackend) IsSealing() bool {
+	return m.sealing
+}
+
+func newHealthyBackend(now time.Time) *mockBackend {
+	head := &types.Header{Number: 100, Timestamp: uint64(now.Add(-2 * time.Second).Unix())}
+	head.ComputeHash()
+
+	return &mockBackend{
+		head:    head,
+		stored:  map[uint64]*types.Header{head.Number: head},
+		peers:   3,
+		sealing: true,
+	}
+}
+
+func newTestChecker(backend Backend, now time.Time) *Checker {
+	checker := NewChecker(&Config{
+		MinPeers:        1,
+		MaxBlocksBehind: 10,
+		MaxBlockAge:     time.Minute,
+	}, backend)
+	checker.now = func() time.Time { return now }
+
+	return checker
+}
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	cases := []struct {
+		name   string
+		modify func(b *mockBackend)
+		failed string
+	}{
+		{
+			name:   "healthy",
+			modify: func(b *mockBackend) {},
+		},
+		{
+			name: "head missing in storage",
+			modify: func(b *mockBackend) {
+				b.stored = map[uint64]*types.Header{}
+			},
+			failed: checkStorage,
+		},
+		{
+			name: "head hash mismatch in storage",
+			modify: func(b *mockBackend) {
+				b.stored[b.head.Number] = &types.Header{Number: b.head.Number}
+			},
+			failed: checkStorage,
+		},
+		{
+			name: "too far behind",
+			modify: func(b *mockBackend) {
+				b.progression = &progress.Progression{SyncType: progress.ChainSyncBulk, HighestBlock: 111}
+			},
+			failed: checkSync,
+		},
+		{
+			name: "not enough peers",
+			modify: func(b *mockBackend) {
+				b.peers = 0
+			},
+			failed: checkPeers,
+		},
+		{
+			name: "head too old",
+			modify: func(b *mockBackend) {
+				b.head.Timestamp = uint64(now.Add(-2 * time.Minute).Unix())
+			},
+			failed: checkConsensus,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			backend := newHealthyBackend(now)
+			c.modify(backend)
+
+			report := newTestChecker(backend, now).Check()
+
+			require.Len(t, report.Checks, 4)
+			assert.Equal(t, c.failed == "", report.Healthy)
+
+			for name, result := range report.Checks {
+				assert.Equal(t, name != c.failed, result.Healthy, name)
+			}
+		})
+	}
+}
+
+func TestChecker_Thresholds(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	backend := newHealthyBackend(now)
+	backend.progression = &progress.Progression{HighestBlock: 110}
+	backend.head.Timestamp = uint64(now.Add(-time.Hour).Unix())
+
+	checker := newTestChecker(backend, now)
+
+	// the sync check allows the configured number of blocks behind
+	report := checker.Check()
+	assert.True(t, report.Checks[checkSync].Healthy)
+
+	// the consensus check is disabled
+	checker.config.MaxBlockAge = 0
+
+	report = checker.Check()
+	assert.True(t, report.Checks[checkConsensus].Healthy)
+	assert.True(t, report.Healthy)
+}
+
+func TestChecker_Handler(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	backend := newHealthyBackend(now)
+	handler := newTestChecker(backend, now).Handler()
+
+	get := func(path string) (int, *Report) {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		report := &Report{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), report))
+
+		return rec.Code, report
+	}
+
+	code, report := get(ReadyPath)
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, report.Healthy)
+
+	// the node without peers is alive, but not ready
+	backend.peers = 0
+
+	code, _ = get(HealthPath)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, report = get(ReadyPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, report.Checks[checkPeers].Healthy)
+
+	// the node with broken storage is not alive
+	backend.stored = map[uint64]*types.Header{}
+
+	code, _ = get(HealthPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
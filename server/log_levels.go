@@ -0,0 +1,336 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// logLevelsFileName is the name of the data dir file persisting the log levels set at runtime
+	logLevelsFileName = "log-levels.json"
+
+	// rootLoggerName is the name of the root logger, which is omitted from the module names
+	rootLoggerName = "polygon"
+
+	// logLevelsHTTPPath is the path of the HTTP endpoint getting (GET) and setting (PUT) the log levels
+	logLevelsHTTPPath = "/log-levels"
+)
+
+var errInvalidLogLevel = errors.New("invalid log level")
+
+// persistedLogLevels is the representation of the log levels persisted to the data dir
+type persistedLogLevels struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules,omitempty"`
+}
+
+// logLevelSetRequest is the body of the HTTP request setting the log level of the module,
+// or the default log level if the module is empty
+type logLevelSetRequest struct {
+	Module string `json:"module"`
+	Level  string `json:"level"`
+}
+
+// logLevels holds the default log level and the log levels of the modules overriding it.
+// A log line is emitted if its level is at least the level of the most specific module matching
+// the logger name, e.g. the level of module "network" applies to the "polygon.network.discovery" logger
+// unless "network.discovery" has its own level
+type logLevels struct {
+	lock sync.RWMutex
+
+	level   hclog.Level
+	modules map[string]hclog.Level
+
+	// path is the path of the file persisting the log levels, empty if the levels are not persisted
+	path string
+
+	// logger is the root logger. Its level is kept at the most verbose of the configured levels,
+	// so the log lines are filtered by the module levels only
+	logger hclog.Logger
+}
+
+// newLogLevels creates the log levels with the given default level.
+// If the log levels were set at runtime before the restart, they are restored from the given path.
+// The default level set on the command line takes precedence over the restored one
+func newLogLevels(level hclog.Level, levelFlagSet bool, path string) (*logLevels, error) {
+	l := &logLevels{
+		level:   level,
+		modules: make(map[string]hclog.Level),
+		path:    path,
+	}
+
+	restoredLevel, modules, ok, err := readLogLevels(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return l, nil
+	}
+
+	l.modules = modules
+
+	if !levelFlagSet {
+		l.level = restoredLevel
+	} else if restoredLevel != level {
+		// the file is updated, so the reload doesn't revert the level set on the command line
+		if err := l.persist(); err != nil {
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
+
+// readLogLevels reads the default log level and the log levels of the modules from the file.
+// False is returned if the log levels are not persisted
+func readLogLevels(path string) (hclog.Level, map[string]hclog.Level, bool, error) {
+	if path == "" {
+		return hclog.NoLevel, nil, false, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return hclog.NoLevel, nil, false, nil
+	} else if err != nil {
+		return hclog.NoLevel, nil, false, err
+	}
+
+	var persisted persistedLogLevels
+	if err := json.Unmarshal(raw, &persisted); err != nil {
+		return hclog.NoLevel, nil, false, fmt.Errorf("invalid log levels file %s: %w", path, err)
+	}
+
+	level, err := parseLogLevel(persisted.Level)
+	if err != nil {
+		return hclog.NoLevel, nil, false, err
+	}
+
+	modules := make(map[string]hclog.Level, len(persisted.Modules))
+
+	for module, rawLevel := range persisted.Modules {
+		if modules[module], err = parseLogLevel(rawLevel); err != nil {
+			return hclog.NoLevel, nil, false, err
+		}
+	}
+
+	return level, modules, true, nil
+}
+
+// parseLogLevel parses the log level (trace, debug, info, warn or error)
+func parseLogLevel(raw string) (hclog.Level, error) {
+	level := hclog.LevelFromString(raw)
+	if level == hclog.NoLevel || level == hclog.Off {
+		return hclog.NoLevel, fmt.Errorf("%w: %s", errInvalidLogLevel, raw)
+	}
+
+	return level, nil
+}
+
+// setLogger sets the root logger whose level is kept at the most verbose of the configured levels
+func (l *logLevels) setLogger(logger hclog.Logger) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.logger = logger
+	l.logger.SetLevel(l.minLevel())
+}
+
+// get returns the default log level and the log levels of the modules. [thread-safe]
+func (l *logLevels) get() (hclog.Level, map[string]hclog.Level) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	modules := make(map[string]hclog.Level, len(l.modules))
+	for module, level := range l.modules {
+		modules[module] = level
+	}
+
+	return l.level, modules
+}
+
+// set sets the log level of the module, or the default log level if the module is empty.
+// Empty level resets the module to the default log level.
+// The log levels are persisted, so they survive the node restart. [thread-safe]
+func (l *logLevels) set(module string, rawLevel string) error {
+	module = strings.TrimPrefix(strings.TrimPrefix(module, rootLoggerName), ".")
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	switch {
+	case module == "":
+		level, err := parseLogLevel(rawLevel)
+		if err != nil {
+			return err
+		}
+
+		l.level = level
+	case rawLevel == "":
+		delete(l.modules, module)
+	default:
+		level, err := parseLogLevel(rawLevel)
+		if err != nil {
+			return err
+		}
+
+		l.modules[module] = level
+	}
+
+	if l.logger != nil {
+		l.logger.SetLevel(l.minLevel())
+	}
+
+	return l.persist()
+}
+
+// reload applies the log levels of the file again, after it has been edited.
+// The log levels are left unchanged if the file is invalid or doesn't exist. [thread-safe]
+func (l *logLevels) reload() error {
+	level, modules, ok, err := readLogLevels(l.path)
+	if err != nil || !ok {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.level = level
+	l.modules = modules
+
+	if l.logger != nil {
+		l.logger.SetLevel(l.minLevel())
+	}
+
+	return nil
+}
+
+// ServeHTTP implements http.Handler interface. GET returns the log levels,
+// and PUT sets the log level of the module given in the request body
+func (l *logLevels) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var req logLevelSetRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		if err := l.set(req.Module, req.Level); errors.Is(err, errInvalidLogLevel) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	level, modules := l.get()
+
+	resp := &persistedLogLevels{
+		Level:   level.String(),
+		Modules: make(map[string]string, len(modules)),
+	}
+
+	for module, moduleLevel := range modules {
+		resp.Modules[module] = moduleLevel.String()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// levelFor returns the log level applied to the logger with the given name. [thread-safe]
+func (l *logLevels) levelFor(name string) hclog.Level {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	if len(l.modules) == 0 {
+		return l.level
+	}
+
+	module := strings.TrimPrefix(strings.TrimPrefix(name, rootLoggerName), ".")
+
+	for module != "" {
+		if level, ok := l.modules[module]; ok {
+			return level
+		}
+
+		idx := strings.LastIndex(module, ".")
+		if idx < 0 {
+			break
+		}
+
+		module = module[:idx]
+	}
+
+	return l.level
+}
+
+// minLevel returns the most verbose of the configured levels
+func (l *logLevels) minLevel() hclog.Level {
+	level := l.level
+
+	for _, moduleLevel := range l.modules {
+		if moduleLevel < level {
+			level = moduleLevel
+		}
+	}
+
+	return level
+}
+
+// persist writes the log levels to the file, if the path is set
+func (l *logLevels) persist() error {
+	if l.path == "" {
+		return nil
+	}
+
+	persisted := &persistedLogLevels{
+		Level:   l.level.String(),
+		Modules: make(map[string]string, len(l.modules)),
+	}
+
+	for module, level := range l.modules {
+		persisted.Modules[module] = level.String()
+	}
+
+	raw, err := json.MarshalIndent(persisted, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(l.path, raw, 0600)
+}
+
+// levelFilterSink is the log sink which emits only the log lines
+// whose level is at least the level of the module the logger belongs to
+type levelFilterSink struct {
+	levels *logLevels
+	sink   hclog.SinkAdapter
+}
+
+// Accept implements hclog.SinkAdapter interface
+func (s *levelFilterSink) Accept(name string, level hclog.Level, msg string, args ...interface{}) {
+	if level < s.levels.levelFor(name) {
+		return
+	}
+
+	s.sink.Accept(name, level, msg, args...)
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLevels_LevelFor(t *testing.T) {
+	t.Parallel()
+
+	levels, err := newLogLevels(hclog.Info, false, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, hclog.Info, levels.levelFor("polygon.network"))
+
+	require.NoError(t, levels.set("network", "debug"))
+	require.NoError(t, levels.set("polygon.network.discovery", "trace"))
+
+	assert.Equal(t, hclog.Info, levels.levelFor("polygon"))
+	assert.Equal(t, hclog.Info, levels.levelFor("polygon.consensus"))
+	assert.Equal(t, hclog.Debug, levels.levelFor("polygon.network"))
+	assert.Equal(t, hclog.Debug, levels.levelFor("polygon.network.dial"))
+	assert.Equal(t, hclog.Trace, levels.levelFor("polygon.network.discovery"))
+
+	// reset the module to the default level
+	require.NoError(t, levels.set("network", ""))
+
+	assert.Equal(t, hclog.Info, levels.levelFor("polygon.network"))
+
+	assert.ErrorIs(t, levels.set("network", "verbose"), errInvalidLogLevel)
+	assert.ErrorIs(t, levels.set("", ""), errInvalidLogLevel)
+}
+
+func TestLogLevels_Persist(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), logLevelsFileName)
+
+	levels, err := newLogLevels(hclog.Info, false, path)
+	require.NoError(t, err)
+
+	require.NoError(t, levels.set("", "warn"))
+	require.NoError(t, levels.set("network", "debug"))
+
+	restored, err := newLogLevels(hclog.Info, false, path)
+	require.NoError(t, err)
+
+	level, modules := restored.get()
+	assert.Equal(t, hclog.Warn, level)
+	assert.Equal(t, map[string]hclog.Level{"network": hclog.Debug}, modules)
+}
+
+func TestLogLevels_FlagPrecedence(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), logLevelsFileName)
+
+	levels, err := newLogLevels(hclog.Info, false, path)
+	require.NoError(t, err)
+
+	require.NoError(t, levels.set("", "warn"))
+	require.NoError(t, levels.set("network", "debug"))
+
+	// the log level set on the command line takes precedence over the persisted one
+	restored, err := newLogLevels(hclog.Error, true, path)
+	require.NoError(t, err)
+
+	level, modules := restored.get()
+	assert.Equal(t, hclog.Error, level)
+	assert.Equal(t, map[string]hclog.Level{"network": hclog.Debug}, modules)
+
+	// and it is persisted, so the reload doesn't revert it
+	require.NoError(t, restored.reload())
+
+	level, _ = restored.get()
+	assert.Equal(t, hclog.Error, level)
+}
+
+func TestLogLevels_Reload(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), logLevelsFileName)
+
+	levels, err := newLogLevels(hclog.Info, false, path)
+	require.NoError(t, err)
+
+	// the missing file leaves the log levels unchanged
+	require.NoError(t, levels.reload())
+
+	level, modules := levels.get()
+	assert.Equal(t, hclog.Info, level)
+	assert.Empty(t, modules)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"level":"warn","modules":{"network":"trace"}}`), 0600))
+	require.NoError(t, levels.reload())
+
+	level, modules = levels.get()
+	assert.Equal(t, hclog.Warn, level)
+	assert.Equal(t, map[string]hclog.Level{"network": hclog.Trace}, modules)
+
+	// the invalid file leaves the log levels unchanged
+	require.NoError(t, os.WriteFile(path, []byte(`{"level":"verbose"}`), 0600))
+	require.Error(t, levels.reload())
+
+	level, _ = levels.get()
+	assert.Equal(t, hclog.Warn, level)
+}
+
+func TestLogLevels_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	levels, err := newLogLevels(hclog.Info, false, "")
+	require.NoError(t, err)
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		levels.ServeHTTP(rec, httptest.NewRequest(method, logLevelsHTTPPath, strings.NewReader(body)))
+
+		return rec
+	}
+
+	rec := serve(http.MethodPut, `{"module":"network","level":"debug"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, hclog.Debug, levels.levelFor("polygon.network"))
+
+	rec = serve(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"level":"info","modules":{"network":"debug"}}`, rec.Body.String())
+
+	rec = serve(http.MethodPut, `{"module":"network","level":"verbose"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, hclog.Debug, levels.levelFor("polygon.network"))
+
+	rec = serve(http.MethodPost, "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestNewLogger_FiltersByModule(t *testing.T) {
+	t.Parallel()
+
+	levels, err := newLogLevels(hclog.Info, false, "")
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+
+	logger := newLogger(&Config{}, &output, levels)
+	networkLogger := logger.Named("network")
+	consensusLogger := logger.Named("consensus")
+
+	networkLogger.Debug("network debug")
+	consensusLogger.Info("consensus info")
+
+	assert.NotContains(t, output.String(), "network debug")
+	assert.Contains(t, output.String(), "consensus info")
+
+	require.NoError(t, levels.set("network", "debug"))
+
+	assert.True(t, networkLogger.IsDebug())
+
+	networkLogger.Debug("network debug")
+	consensusLogger.Debug("consensus debug")
+
+	assert.Contains(t, output.String(), "network debug")
+	assert.NotContains(t, output.String(), "consensus debug")
+}
@@ -0,0 +1,382 @@
+package metatx
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+	"github.com/umbracle/ethgo/signing"
+)
+
+const (
+	// DefaultMaxGas is the default maximum gas of a single meta-transaction
+	DefaultMaxGas uint64 = 1_000_000
+
+	// forwarderGasOverhead is the gas spent by the forwarder on top of the forwarded call
+	// (signature verification, nonce update and the call itself)
+	forwarderGasOverhead uint64 = 50_000
+
+	// signatureLength is the length of the meta-transaction signature (r, s, v)
+	signatureLength = 65
+)
+
+// EIP-712 domain and request type of the OpenZeppelin MinimalForwarder
+const (
+	forwarderDomainName       = "MinimalForwarder"
+	forwarderDomainVersion    = "0.0.1"
+	forwardRequestPrimaryType = "ForwardRequest"
+)
+
+var (
+	ErrRelayerDisabled    = errors.New("meta-transaction relayer is disabled")
+	ErrSenderNotAllowed   = errors.New("meta-transaction sender is not allowed")
+	ErrTargetNotAllowed   = errors.New("meta-transaction target is not allowed")
+	ErrGasLimitExceeded   = errors.New("meta-transaction gas exceeds the relayer limit")
+	ErrInvalidSignature   = errors.New("invalid meta-transaction signature")
+	ErrSignerMismatch     = errors.New("meta-transaction is not signed by the sender")
+	ErrValueNotSponsored  = errors.New("meta-transaction value transfers are not sponsored")
+	errMissingForwarder   = errors.New("meta-transaction forwarder address is not set")
+	errMissingSponsorKey  = errors.New("meta-transaction sponsor key is not set")
+	errInvalidMaxGas      = errors.New("meta-transaction max gas must be greater than zero")
+	errForwarderIsSponsor = errors.New("meta-transaction forwarder can not be the sponsor")
+)
+
+var (
+	// forwardRequestEIP712Types are the EIP-712 types of the forwarder request
+	forwardRequestEIP712Types = map[string][]*signing.EIP712Type{
+		forwardRequestPrimaryType: {
+			{Name: "from", Type: "address"},
+			{Name: "to", Type: "address"},
+			{Name: "value", Type: "uint256"},
+			{Name: "gas", Type: "uint256"},
+			{Name: "nonce", Type: "uint256"},
+			{Name: "data", Type: "bytes"},
+		},
+	}
+
+	// executeABIMethod is the MinimalForwarder function executing the signed request
+	executeABIMethod = abi.MustNewMethod("function execute(" +
+		"tuple(address from, address to, uint256 value, uint256 gas, uint256 nonce, bytes data) req, " +
+		"bytes signature) payable returns (bool, bytes)")
+)
+
+// ForwardRequest is the EIP-2771 meta-transaction signed by the sender,
+// in the format of the OpenZeppelin MinimalForwarder
+type ForwardRequest struct {
+	From  types.Address
+	To    types.Address
+	Value *big.Int
+	Gas   uint64
+	// Nonce is the nonce of the sender in the forwarder contract
+	Nonce uint64
+	Data  []byte
+}
+
+// Hash returns the EIP-712 digest of the request, signed by the sender
+func (r *ForwardRequest) Hash(chainID uint64, forwarder types.Address) (types.Hash, error) {
+	typedData := &signing.EIP712TypedData{
+		Types:       forwardRequestEIP712Types,
+		PrimaryType: forwardRequestPrimaryType,
+		Domain: &signing.EIP712Domain{
+			Name:              forwarderDomainName,
+			Version:           forwarderDomainVersion,
+			ChainId:           new(big.Int).SetUint64(chainID),
+			VerifyingContract: forwarder.String(),
+		},
+		Message: r.toMap(),
+	}
+
+	hash, err := typedData.Hash()
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return types.BytesToHash(hash), nil
+}
+
+// toMap converts the request to the map used by the ABI and EIP-712 encoding
+func (r *ForwardRequest) toMap() map[string]interface{} {
+	value := r.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	return map[string]interface{}{
+		"from":  ethgo.Address(r.From),
+		"to":    ethgo.Address(r.To),
+		"value": value,
+		"gas":   new(big.Int).SetUint64(r.Gas),
+		"nonce": new(big.Int).SetUint64(r.Nonce),
+		"data":  r.Data,
+	}
+}
+
+// Config is the configuration of the meta-transaction relayer
+type Config struct {
+	// Forwarder is the address of the trusted forwarder contract, the relayer is disabled if not set
+	Forwarder types.Address
+
+	// SponsorKey is the key of the account paying for the relayed transactions
+	SponsorKey *ecdsa.PrivateKey
+
+	// AllowedSenders are the senders whose meta-transactions are relayed, all senders are allowed if empty
+	AllowedSenders []types.Address
+
+	// AllowedTargets are the contracts the meta-transactions can call, all contracts are allowed if empty
+	AllowedTargets []types.Address
+
+	// MaxGas is the maximum gas of a single meta-transaction
+	MaxGas uint64
+
+	// GasPrice is the gas price (the tip after London) paid by the sponsor
+	GasPrice *big.Int
+}
+
+// Enabled returns true if the relayer is configured
+func (c *Config) Enabled() bool {
+	return c != nil && c.Forwarder != types.ZeroAddress
+}
+
+// Validate validates the relayer configuration
+func (c *Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.SponsorKey == nil {
+		return errMissingSponsorKey
+	}
+
+	if c.MaxGas == 0 {
+		return errInvalidMaxGas
+	}
+
+	if crypto.PubKeyToAddress(&c.SponsorKey.PublicKey) == c.Forwarder {
+		return errForwarderIsSponsor
+	}
+
+	return nil
+}
+
+// Backend provides the chain state and the txpool the relayed transactions are submitted to
+type Backend interface {
+	// Header returns the current head
+	Header() *types.Header
+
+	// GetForksInTime returns the forks enabled at the given block
+	GetForksInTime(blockNumber uint64) chain.ForksInTime
+
+	// GetNonce returns the next nonce of the account, including the pending transactions
+	GetNonce(addr types.Address) uint64
+
+	// AddTx adds the transaction to the txpool
+	AddTx(tx *types.Transaction) error
+}
+
+// Relayer wraps the signed meta-transactions into the forwarder calls
+// paid by the sponsor account and submits them to the txpool
+type Relayer struct {
+	logger  hclog.Logger
+	config  *Config
+	backend Backend
+	chainID uint64
+
+	sponsor        types.Address
+	allowedSenders map[types.Address]struct{}
+	allowedTargets map[types.Address]struct{}
+
+	// lock serializes the submissions, so that the sponsor nonces are not reused
+	lock sync.Mutex
+	// nextNonce is the nonce of the next relayed transaction,
+	// tracked because the txpool can not yet report the just submitted transactions
+	nextNonce uint64
+}
+
+// NewRelayer creates a new meta-transaction relayer
+func NewRelayer(config *Config, backend Backend, chainID uint64, logger hclog.Logger) (*Relayer, error) {
+	if !config.Enabled() {
+		return nil, errMissingForwarder
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	r := &Relayer{
+		logger:         logger.Named("metatx-relayer"),
+		config:         config,
+		backend:        backend,
+		chainID:        chainID,
+		sponsor:        crypto.PubKeyToAddress(&config.SponsorKey.PublicKey),
+		allowedSenders: make(map[types.Address]struct{}, len(config.AllowedSenders)),
+		allowedTargets: make(map[types.Address]struct{}, len(config.AllowedTargets)),
+	}
+
+	for _, sender := range config.AllowedSenders {
+		r.allowedSenders[sender] = struct{}{}
+	}
+
+	for _, target := range config.AllowedTargets {
+		r.allowedTargets[target] = struct{}{}
+	}
+
+	r.logger.Info("meta-transaction relayer enabled", "forwarder", config.Forwarder, "sponsor", r.sponsor)
+
+	return r, nil
+}
+
+// Sponsor returns the address of the account paying for the relayed transactions
+func (r *Relayer) Sponsor() types.Address {
+	return r.sponsor
+}
+
+// Relay verifies the meta-transaction against the relayer policies and submits it
+// to the txpool wrapped into the forwarder call. It returns the hash of the submitted transaction
+func (r *Relayer) Relay(req *ForwardRequest, signature []byte) (types.Hash, error) {
+	if err := r.checkPolicies(req); err != nil {
+		return types.ZeroHash, err
+	}
+
+	if err := r.verifySignature(req, signature); err != nil {
+		return types.ZeroHash, err
+	}
+
+	input, err := executeABIMethod.Encode([]interface{}{req.toMap(), signature})
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("failed to encode forwarder call: %w", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	tx, err := r.buildTx(input, req.Gas)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	if err := r.backend.AddTx(tx); err != nil {
+		return types.ZeroHash, err
+	}
+
+	r.nextNonce = tx.Nonce + 1
+
+	r.logger.Debug("meta-transaction relayed", "from", req.From, "to", req.To, "hash", tx.Hash)
+
+	return tx.Hash, nil
+}
+
+// checkPolicies checks the meta-transaction against the allow lists and the gas limit
+func (r *Relayer) checkPolicies(req *ForwardRequest) error {
+	if len(r.allowedSenders) > 0 {
+		if _, ok := r.allowedSenders[req.From]; !ok {
+			return ErrSenderNotAllowed
+		}
+	}
+
+	if len(r.allowedTargets) > 0 {
+		if _, ok := r.allowedTargets[req.To]; !ok {
+			return ErrTargetNotAllowed
+		}
+	}
+
+	if req.Gas > r.config.MaxGas {
+		return ErrGasLimitExceeded
+	}
+
+	// the forwarded value would be paid by the sponsor
+	if req.Value != nil && req.Value.Sign() != 0 {
+		return ErrValueNotSponsored
+	}
+
+	return nil
+}
+
+// verifySignature checks that the meta-transaction is signed by its sender
+func (r *Relayer) verifySignature(req *ForwardRequest, signature []byte) error {
+	if len(signature) != signatureLength {
+		return ErrInvalidSignature
+	}
+
+	hash, err := req.Hash(r.chainID, r.config.Forwarder)
+	if err != nil {
+		return err
+	}
+
+	// the solidity signatures use 27 and 28 as the recovery id
+	sig := append([]byte{}, signature...)
+	if sig[signatureLength-1] >= 27 {
+		sig[signatureLength-1] -= 27
+	}
+
+	pub, err := crypto.RecoverPubkey(sig, hash.Bytes())
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+
+	if crypto.PubKeyToAddress(pub) != req.From {
+		return ErrSignerMismatch
+	}
+
+	return nil
+}
+
+// buildTx builds and signs the sponsor transaction calling the forwarder
+func (r *Relayer) buildTx(input []byte, requestGas uint64) (*types.Transaction, error) {
+	header := r.backend.Header()
+	forks := r.backend.GetForksInTime(header.Number + 1)
+
+	nonce := r.backend.GetNonce(r.sponsor)
+	if r.nextNonce > nonce {
+		nonce = r.nextNonce
+	}
+
+	gasPrice := r.config.GasPrice
+	if gasPrice == nil {
+		gasPrice = big.NewInt(0)
+	}
+
+	tx := &types.Transaction{
+		Nonce: nonce,
+		To:    &r.config.Forwarder,
+		Value: big.NewInt(0),
+		Input: input,
+		From:  r.sponsor,
+	}
+
+	if forks.London {
+		tx.Type = types.DynamicFeeTx
+		tx.GasTipCap = new(big.Int).Set(gasPrice)
+		// leave the room for the base fee increase until the transaction gets included
+		tx.GasFeeCap = new(big.Int).Add(
+			new(big.Int).Mul(new(big.Int).SetUint64(header.BaseFee), big.NewInt(2)),
+			gasPrice,
+		)
+	} else {
+		tx.Type = types.LegacyTx
+		tx.GasPrice = new(big.Int).Set(gasPrice)
+	}
+
+	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul, forks.Berlin)
+	if err != nil {
+		return nil, err
+	}
+
+	// the forwarder checks that 1/64 of the request gas was left after the call (EIP-150)
+	tx.Gas = intrinsicGas + forwarderGasOverhead + requestGas + requestGas/63
+
+	signed, err := crypto.NewSigner(forks, r.chainID).SignTx(tx, r.config.SponsorKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign relayed transaction: %w", err)
+	}
+
+	return signed, nil
+}
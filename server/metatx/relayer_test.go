@@ -0,0 +1,254 @@
+package metatx
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBackend struct {
+	london bool
+	nonce  uint64
+	txs    []*types.Transaction
+	err    error
+}
+
+func (m *mockBackend) Header() *types.Header {
+	return &types.Header{Number: 10, BaseFee: 100}
+}
+
+func (m *mockBackend) GetForksInTime(uint64) chain.ForksInTime {
+	return chain.ForksInTime{Homestead: true, EIP155: true, Istanbul: true, London: m.london}
+}
+
+func (m *mockBackend) GetNonce(types.Address) uint64 {
+	return m.nonce
+}
+
+func (m *mockBackend) AddTx(tx *types.Transaction) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	tx.ComputeHash(1)
+	m.txs = append(m.txs, tx)
+
+	return nil
+}
+
+var (
+	forwarder = types.StringToAddress("1000")
+	target    = types.StringToAddress("2000")
+)
+
+func newTestRelayer(t *testing.T, backend Backend, modify func(*Config)) *Relayer {
+	t.Helper()
+
+	sponsorKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	config := &Config{
+		Forwarder:  forwarder,
+		SponsorKey: sponsorKey,
+		MaxGas:     DefaultMaxGas,
+		GasPrice:   big.NewInt(5),
+	}
+
+	if modify != nil {
+		modify(config)
+	}
+
+	relayer, err := NewRelayer(config, backend, 100, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	return relayer
+}
+
+func signRequest(t *testing.T, key *ecdsa.PrivateKey, req *ForwardRequest, chainID uint64) []byte {
+	t.Helper()
+
+	hash, err := req.Hash(chainID, forwarder)
+	require.NoError(t, err)
+
+	signature, err := crypto.Sign(key, hash.Bytes())
+	require.NoError(t, err)
+
+	// solidity style recovery id
+	signature[64] += 27
+
+	return signature
+}
+
+func TestRelayer_Relay(t *testing.T) {
+	t.Parallel()
+
+	for _, london := range []bool{false, true} {
+		backend := &mockBackend{london: london, nonce: 3}
+		relayer := newTestRelayer(t, backend, nil)
+
+		senderKey, err := crypto.GenerateECDSAKey()
+		require.NoError(t, err)
+
+		req := &ForwardRequest{
+			From:  crypto.PubKeyToAddress(&senderKey.PublicKey),
+			To:    target,
+			Gas:   100000,
+			Nonce: 7,
+			Data:  []byte{0x1, 0x2},
+		}
+		signature := signRequest(t, senderKey, req, 100)
+
+		hash, err := relayer.Relay(req, signature)
+		require.NoError(t, err)
+		require.Len(t, backend.txs, 1)
+
+		tx := backend.txs[0]
+		require.Equal(t, hash, tx.Hash)
+		require.Equal(t, forwarder, *tx.To)
+		require.Equal(t, uint64(3), tx.Nonce)
+		require.Greater(t, tx.Gas, req.Gas+forwarderGasOverhead)
+
+		if london {
+			require.Equal(t, types.DynamicFeeTx, tx.Type)
+			require.Equal(t, big.NewInt(5), tx.GasTipCap)
+			require.Equal(t, big.NewInt(205), tx.GasFeeCap)
+		} else {
+			require.Equal(t, types.LegacyTx, tx.Type)
+			require.Equal(t, big.NewInt(5), tx.GasPrice)
+		}
+
+		// the transaction is signed by the sponsor
+		forks := backend.GetForksInTime(0)
+		sender, err := crypto.NewSigner(forks, 100).Sender(tx)
+		require.NoError(t, err)
+		require.Equal(t, relayer.Sponsor(), sender)
+
+		// the forwarder call holds the signed request
+		decoded, err := executeABIMethod.Inputs.Decode(tx.Input[4:])
+		require.NoError(t, err)
+
+		args, ok := decoded.(map[string]interface{})
+		require.True(t, ok)
+		require.Equal(t, signature, args["signature"])
+
+		// the next transaction uses the next nonce, even if the txpool doesn't report it yet
+		req.Nonce++
+		_, err = relayer.Relay(req, signRequest(t, senderKey, req, 100))
+		require.NoError(t, err)
+		require.Len(t, backend.txs, 2)
+		require.Equal(t, uint64(4), backend.txs[1].Nonce)
+	}
+}
+
+func TestRelayer_Policies(t *testing.T) {
+	t.Parallel()
+
+	senderKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	otherKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	sender := crypto.PubKeyToAddress(&senderKey.PublicKey)
+
+	backend := &mockBackend{}
+	relayer := newTestRelayer(t, backend, func(c *Config) {
+		c.AllowedSenders = []types.Address{sender}
+		c.AllowedTargets = []types.Address{target}
+		c.MaxGas = 1000
+	})
+
+	newReq := func() *ForwardRequest {
+		return &ForwardRequest{From: sender, To: target, Gas: 1000}
+	}
+
+	cases := []struct {
+		name      string
+		req       *ForwardRequest
+		signature func(*ForwardRequest) []byte
+		err       error
+	}{
+		{
+			name:      "sender not allowed",
+			req:       &ForwardRequest{From: types.StringToAddress("1"), To: target},
+			signature: func(r *ForwardRequest) []byte { return signRequest(t, senderKey, r, 100) },
+			err:       ErrSenderNotAllowed,
+		},
+		{
+			name:      "target not allowed",
+			req:       &ForwardRequest{From: sender, To: types.StringToAddress("1")},
+			signature: func(r *ForwardRequest) []byte { return signRequest(t, senderKey, r, 100) },
+			err:       ErrTargetNotAllowed,
+		},
+		{
+			name:      "gas limit exceeded",
+			req:       &ForwardRequest{From: sender, To: target, Gas: 1001},
+			signature: func(r *ForwardRequest) []byte { return signRequest(t, senderKey, r, 100) },
+			err:       ErrGasLimitExceeded,
+		},
+		{
+			name:      "value transfer",
+			req:       &ForwardRequest{From: sender, To: target, Value: big.NewInt(1)},
+			signature: func(r *ForwardRequest) []byte { return signRequest(t, senderKey, r, 100) },
+			err:       ErrValueNotSponsored,
+		},
+		{
+			name:      "malformed signature",
+			req:       newReq(),
+			signature: func(*ForwardRequest) []byte { return []byte{0x1} },
+			err:       ErrInvalidSignature,
+		},
+		{
+			name:      "signed by other account",
+			req:       newReq(),
+			signature: func(r *ForwardRequest) []byte { return signRequest(t, otherKey, r, 100) },
+			err:       ErrSignerMismatch,
+		},
+		{
+			name:      "signed for other chain",
+			req:       newReq(),
+			signature: func(r *ForwardRequest) []byte { return signRequest(t, senderKey, r, 101) },
+			err:       ErrSignerMismatch,
+		},
+	}
+
+	for _, c := range cases {
+		_, err := relayer.Relay(c.req, c.signature(c.req))
+		require.ErrorIs(t, err, c.err, c.name)
+	}
+
+	require.Empty(t, backend.txs)
+
+	// the nonce is not consumed if the txpool rejects the transaction
+	backend.err = errors.New("rejected")
+
+	_, err = relayer.Relay(newReq(), signRequest(t, senderKey, newReq(), 100))
+	require.ErrorIs(t, err, backend.err)
+	require.Zero(t, relayer.nextNonce)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	require.NoError(t, (&Config{}).Validate())
+	require.ErrorIs(t, (&Config{Forwarder: forwarder, MaxGas: 1}).Validate(), errMissingSponsorKey)
+	require.ErrorIs(t, (&Config{Forwarder: forwarder, SponsorKey: key}).Validate(), errInvalidMaxGas)
+	require.ErrorIs(t, (&Config{
+		Forwarder:  crypto.PubKeyToAddress(&key.PublicKey),
+		SponsorKey: key,
+		MaxGas:     1,
+	}).Validate(), errForwarderIsSponsor)
+
+	_, err = NewRelayer(&Config{}, &mockBackend{}, 100, hclog.NewNullLogger())
+	require.ErrorIs(t, err, errMissingForwarder)
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	errUnsupportedOverrideFile       = errors.New("override file must have .json, .yaml or .yml extension")
+	errInvalidOverrideMinProbability = errors.New("admission min probability must be greater than 0 and at most 1")
+)
+
+// Overrides are the node-level runtime parameters overriding the node configuration.
+// The override file is applied on the node start, and applied again once the node receives SIGHUP,
+// so the parameters can be changed without restarting the node. A parameter which is not set
+// in the override file has its configured value, including the one removed from the file before the reload
+type Overrides struct {
+	TxPool *TxPoolOverrides `json:"tx_pool" yaml:"tx_pool"`
+
+	JSONRPCBatchRequestLimit *uint64 `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
+	JSONRPCBlockRangeLimit   *uint64 `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+}
+
+// TxPoolOverrides are the txpool parameters of the override file
+type TxPoolOverrides struct {
+	PriceLimit              *uint64  `json:"price_limit" yaml:"price_limit"`
+	MaxSlots                *uint64  `json:"max_slots" yaml:"max_slots"`
+	MaxAccountEnqueued      *uint64  `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	AdmissionRateLimit      *uint64  `json:"admission_rate_limit" yaml:"admission_rate_limit"`
+	AdmissionMinProbability *float64 `json:"admission_min_probability" yaml:"admission_min_probability"`
+}
+
+// ReadOverrideFile reads the overrides from the JSON or YAML file. Unknown parameters are rejected,
+// so the misspelled parameter doesn't silently keep its configured value
+func ReadOverrideFile(path string) (*Overrides, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read override file: %w", err)
+	}
+
+	overrides := &Overrides{}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+
+		err = decoder.Decode(overrides)
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(raw))
+		decoder.KnownFields(true)
+
+		err = decoder.Decode(overrides)
+	default:
+		return nil, errUnsupportedOverrideFile
+	}
+
+	// the empty file doesn't override any parameter
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse override file: %w", err)
+	}
+
+	if err := overrides.validate(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+func (o *Overrides) validate() error {
+	if o.TxPool != nil && o.TxPool.AdmissionMinProbability != nil {
+		if prob := *o.TxPool.AdmissionMinProbability; prob <= 0 || prob > 1 {
+			return errInvalidOverrideMinProbability
+		}
+	}
+
+	return nil
+}
+
+// overrideValue returns the overriding value, or the configured value if the parameter is not overridden
+func overrideValue[T any](override *T, configured T) T {
+	if override != nil {
+		return *override
+	}
+
+	return configured
+}
+
+// applyOverrides applies the overrides on top of the node configuration
+func (s *Server) applyOverrides(overrides *Overrides) {
+	txPool := overrides.TxPool
+	if txPool == nil {
+		txPool = &TxPoolOverrides{}
+	}
+
+	s.txpool.SetPriceLimit(overrideValue(txPool.PriceLimit, s.config.PriceLimit))
+	s.txpool.SetMaxSlots(overrideValue(txPool.MaxSlots, s.config.MaxSlots))
+	s.txpool.SetMaxAccountEnqueued(overrideValue(txPool.MaxAccountEnqueued, s.config.MaxAccountEnqueued))
+	s.txpool.SetAdmission(
+		overrideValue(txPool.AdmissionRateLimit, s.config.TxPoolAdmissionRateLimit),
+		overrideValue(txPool.AdmissionMinProbability, s.config.TxPoolAdmissionMinProbability),
+	)
+
+	if s.jsonrpcServer != nil {
+		s.jsonrpcServer.SetLimits(
+			overrideValue(overrides.JSONRPCBatchRequestLimit, s.config.JSONRPC.BatchLengthLimit),
+			overrideValue(overrides.JSONRPCBlockRangeLimit, s.config.JSONRPC.BlockRangeLimit),
+		)
+	}
+}
+
+// applyOverrideFile reads the override file and applies it on top of the node configuration
+func (s *Server) applyOverrideFile() error {
+	overrides, err := ReadOverrideFile(s.config.OverrideFile)
+	if err != nil {
+		return err
+	}
+
+	s.applyOverrides(overrides)
+
+	return nil
+}
+
+// Reload applies the log levels file and the override file (if any) again, after they have been changed
+func (s *Server) Reload() {
+	if err := s.logLevels.reload(); err != nil {
+		s.logger.Error("failed to reload the log levels", "path", s.logLevels.path, "err", err)
+	} else {
+		level, modules := s.logLevels.get()
+		s.logger.Info("log levels reloaded", "level", level, "modules", modules)
+	}
+
+	if s.config.OverrideFile != "" {
+		s.ReloadOverrides()
+	}
+}
+
+// ReloadOverrides applies the override file again, after it has been changed.
+// The parameters are left unchanged if the override file is invalid
+func (s *Server) ReloadOverrides() {
+	if err := s.applyOverrideFile(); err != nil {
+		s.logger.Error("failed to reload the override file", "path", s.config.OverrideFile, "err", err)
+
+		return
+	}
+
+	s.logger.Info("override file reloaded", "path", s.config.OverrideFile)
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/txpool"
+)
+
+func writeOverrideFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func TestReadOverrideFile(t *testing.T) {
+	t.Parallel()
+
+	jsonPath := writeOverrideFile(t, "overrides.json", `{
+		"tx_pool": {"max_slots": 100, "admission_min_probability": 0.5},
+		"json_rpc_block_range_limit": 10
+	}`)
+
+	yamlPath := writeOverrideFile(t, "overrides.yaml", `
+tx_pool:
+  max_slots: 100
+  admission_min_probability: 0.5
+json_rpc_block_range_limit: 10
+`)
+
+	for _, path := range []string{jsonPath, yamlPath} {
+		overrides, err := ReadOverrideFile(path)
+		require.NoError(t, err)
+
+		require.Equal(t, uint64(100), *overrides.TxPool.MaxSlots)
+		require.Equal(t, 0.5, *overrides.TxPool.AdmissionMinProbability)
+		require.Nil(t, overrides.TxPool.PriceLimit)
+		require.Equal(t, uint64(10), *overrides.JSONRPCBlockRangeLimit)
+		require.Nil(t, overrides.JSONRPCBatchRequestLimit)
+	}
+
+	// the empty file doesn't override any parameter
+	overrides, err := ReadOverrideFile(writeOverrideFile(t, "empty.yml", ""))
+	require.NoError(t, err)
+	require.Equal(t, &Overrides{}, overrides)
+
+	_, err = ReadOverrideFile(writeOverrideFile(t, "overrides.hcl", ""))
+	require.ErrorIs(t, err, errUnsupportedOverrideFile)
+
+	_, err = ReadOverrideFile(writeOverrideFile(t, "unknown.json", `{"tx_pool": {"max_slot": 100}}`))
+	require.ErrorContains(t, err, "unknown field")
+
+	_, err = ReadOverrideFile(writeOverrideFile(t, "unknown.yaml", "max_slots: 100\n"))
+	require.ErrorContains(t, err, "not found")
+
+	_, err = ReadOverrideFile(writeOverrideFile(t, "invalid.json", `{"tx_pool": {"admission_min_probability": 2}}`))
+	require.ErrorIs(t, err, errInvalidOverrideMinProbability)
+}
+
+func TestServer_ApplyOverrideFile(t *testing.T) {
+	t.Parallel()
+
+	pool, err := txpool.NewTxPool(hclog.NewNullLogger(), chain.AllForksEnabled.At(0), nil, nil, nil,
+		&txpool.Config{MaxSlots: 4096, MaxAccountEnqueued: 128})
+	require.NoError(t, err)
+
+	path := writeOverrideFile(t, "overrides.json", `{"tx_pool": {"max_slots": 100}}`)
+
+	s := &Server{
+		logger: hclog.NewNullLogger(),
+		config: &Config{MaxSlots: 4096, MaxAccountEnqueued: 128, OverrideFile: path},
+		txpool: pool,
+	}
+
+	require.NoError(t, s.applyOverrideFile())
+
+	_, maxSlots := pool.GetCapacity()
+	require.Equal(t, uint64(100), maxSlots)
+
+	// invalid file keeps the applied overrides
+	require.NoError(t, os.WriteFile(path, []byte(`{"tx_pool": {"max_slots": "lorem"}}`), 0600))
+	s.ReloadOverrides()
+
+	_, maxSlots = pool.GetCapacity()
+	require.Equal(t, uint64(100), maxSlots)
+
+	// the parameter removed from the file is reverted to the configured value
+	require.NoError(t, os.WriteFile(path, []byte(`{}`), 0600))
+	s.ReloadOverrides()
+
+	_, maxSlots = pool.GetCapacity()
+	require.Equal(t, uint64(4096), maxSlots)
+}
@@ -203,6 +203,270 @@ var _ interface {
 	ErrorName() string
 } = BlockchainEventValidationError{}
 
+// Validate checks the field values on ReorgEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReorgEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorgEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReorgEventMultiError, or
+// nil if none found.
+func (m *ReorgEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorgEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOldHead()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReorgEventValidationError{
+					field:  "OldHead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReorgEventValidationError{
+					field:  "OldHead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOldHead()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReorgEventValidationError{
+				field:  "OldHead",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNewHead()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReorgEventValidationError{
+					field:  "NewHead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReorgEventValidationError{
+					field:  "NewHead",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNewHead()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReorgEventValidationError{
+				field:  "NewHead",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Depth
+
+	if len(errors) > 0 {
+		return ReorgEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorgEventMultiError is an error wrapping multiple validation errors
+// returned by ReorgEvent.ValidateAll() if the designated constraints aren't met.
+type ReorgEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorgEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorgEventMultiError) AllErrors() []error { return m }
+
+// ReorgEventValidationError is the validation error returned by
+// ReorgEvent.Validate if the designated constraints aren't met.
+type ReorgEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorgEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorgEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorgEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorgEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorgEventValidationError) ErrorName() string { return "ReorgEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReorgEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorgEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorgEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorgEventValidationError{}
+
+// Validate checks the field values on PeerEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PeerEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeerEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PeerEventMultiError, or nil
+// if none found.
+func (m *PeerEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeerEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return PeerEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeerEventMultiError is an error wrapping multiple validation errors returned
+// by PeerEvent.ValidateAll() if the designated constraints aren't met.
+type PeerEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeerEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeerEventMultiError) AllErrors() []error { return m }
+
+// PeerEventValidationError is the validation error returned by
+// PeerEvent.Validate if the designated constraints aren't met.
+type PeerEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeerEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeerEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeerEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeerEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeerEventValidationError) ErrorName() string { return "PeerEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PeerEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeerEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeerEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeerEventValidationError{}
+
 // Validate checks the field values on ServerStatus with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1320,6 +1584,1141 @@ var _ interface {
 	ErrorName() string
 } = ExportEventValidationError{}
 
+// Validate checks the field values on BlockGasTargetSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BlockGasTargetSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockGasTargetSetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockGasTargetSetRequestMultiError, or nil if none found.
+func (m *BlockGasTargetSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockGasTargetSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Target
+
+	if len(errors) > 0 {
+		return BlockGasTargetSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockGasTargetSetRequestMultiError is an error wrapping multiple validation
+// errors returned by BlockGasTargetSetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BlockGasTargetSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockGasTargetSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockGasTargetSetRequestMultiError) AllErrors() []error { return m }
+
+// BlockGasTargetSetRequestValidationError is the validation error returned by
+// BlockGasTargetSetRequest.Validate if the designated constraints aren't met.
+type BlockGasTargetSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockGasTargetSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockGasTargetSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockGasTargetSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockGasTargetSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockGasTargetSetRequestValidationError) ErrorName() string {
+	return "BlockGasTargetSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockGasTargetSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockGasTargetSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockGasTargetSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockGasTargetSetRequestValidationError{}
+
+// Validate checks the field values on BlockGasTargetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BlockGasTargetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockGasTargetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockGasTargetResponseMultiError, or nil if none found.
+func (m *BlockGasTargetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockGasTargetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Target
+
+	// no validation rules for GasLimit
+
+	if len(errors) > 0 {
+		return BlockGasTargetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockGasTargetResponseMultiError is an error wrapping multiple validation
+// errors returned by BlockGasTargetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BlockGasTargetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockGasTargetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockGasTargetResponseMultiError) AllErrors() []error { return m }
+
+// BlockGasTargetResponseValidationError is the validation error returned by
+// BlockGasTargetResponse.Validate if the designated constraints aren't met.
+type BlockGasTargetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockGasTargetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockGasTargetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockGasTargetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockGasTargetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockGasTargetResponseValidationError) ErrorName() string {
+	return "BlockGasTargetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockGasTargetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockGasTargetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockGasTargetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockGasTargetResponseValidationError{}
+
+// Validate checks the field values on LogLevelSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LogLevelSetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogLevelSetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogLevelSetRequestMultiError, or nil if none found.
+func (m *LogLevelSetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogLevelSetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Module
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return LogLevelSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogLevelSetRequestMultiError is an error wrapping multiple validation errors
+// returned by LogLevelSetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogLevelSetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogLevelSetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogLevelSetRequestMultiError) AllErrors() []error { return m }
+
+// LogLevelSetRequestValidationError is the validation error returned by
+// LogLevelSetRequest.Validate if the designated constraints aren't met.
+type LogLevelSetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogLevelSetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogLevelSetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogLevelSetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogLevelSetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogLevelSetRequestValidationError) ErrorName() string {
+	return "LogLevelSetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LogLevelSetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogLevelSetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogLevelSetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogLevelSetRequestValidationError{}
+
+// Validate checks the field values on LogLevelResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LogLevelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogLevelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogLevelResponseMultiError, or nil if none found.
+func (m *LogLevelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogLevelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	// no validation rules for Modules
+
+	if len(errors) > 0 {
+		return LogLevelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogLevelResponseMultiError is an error wrapping multiple validation errors
+// returned by LogLevelResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LogLevelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogLevelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogLevelResponseMultiError) AllErrors() []error { return m }
+
+// LogLevelResponseValidationError is the validation error returned by
+// LogLevelResponse.Validate if the designated constraints aren't met.
+type LogLevelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogLevelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogLevelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogLevelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogLevelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogLevelResponseValidationError) ErrorName() string { return "LogLevelResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogLevelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogLevelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogLevelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogLevelResponseValidationError{}
+
+// Validate checks the field values on ApiKey with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *ApiKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ApiKeyMultiError, or nil if none found.
+func (m *ApiKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Name
+
+	// no validation rules for RateLimit
+
+	// no validation rules for Requests
+
+	// no validation rules for Rejected
+
+	if len(errors) > 0 {
+		return ApiKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyMultiError is an error wrapping multiple validation errors
+// returned by ApiKey.ValidateAll() if the designated constraints
+// aren't met.
+type ApiKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyMultiError) AllErrors() []error { return m }
+
+// ApiKeyValidationError is the validation error returned by
+// ApiKey.Validate if the designated constraints aren't met.
+type ApiKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyValidationError) ErrorName() string {
+	return "ApiKeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyValidationError{}
+
+// Validate checks the field values on ApiKeyListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyListResponseMultiError, or nil if none found.
+func (m *ApiKeyListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApiKeyListResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApiKeyListResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApiKeyListResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyListResponseMultiError is an error wrapping multiple validation errors
+// returned by ApiKeyListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ApiKeyListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyListResponseMultiError) AllErrors() []error { return m }
+
+// ApiKeyListResponseValidationError is the validation error returned by
+// ApiKeyListResponse.Validate if the designated constraints aren't met.
+type ApiKeyListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyListResponseValidationError) ErrorName() string {
+	return "ApiKeyListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyListResponseValidationError{}
+
+// Validate checks the field values on ApiKeyRemoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyRemoveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyRemoveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyRemoveRequestMultiError, or nil if none found.
+func (m *ApiKeyRemoveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyRemoveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return ApiKeyRemoveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyRemoveRequestMultiError is an error wrapping multiple validation errors
+// returned by ApiKeyRemoveRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ApiKeyRemoveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyRemoveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyRemoveRequestMultiError) AllErrors() []error { return m }
+
+// ApiKeyRemoveRequestValidationError is the validation error returned by
+// ApiKeyRemoveRequest.Validate if the designated constraints aren't met.
+type ApiKeyRemoveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyRemoveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyRemoveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyRemoveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyRemoveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyRemoveRequestValidationError) ErrorName() string {
+	return "ApiKeyRemoveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyRemoveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyRemoveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyRemoveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyRemoveRequestValidationError{}
+
+// Validate checks the field values on ApiKeyRemoveResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApiKeyRemoveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKeyRemoveResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApiKeyRemoveResponseMultiError, or nil if none found.
+func (m *ApiKeyRemoveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKeyRemoveResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return ApiKeyRemoveResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyRemoveResponseMultiError is an error wrapping multiple validation errors
+// returned by ApiKeyRemoveResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ApiKeyRemoveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyRemoveResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyRemoveResponseMultiError) AllErrors() []error { return m }
+
+// ApiKeyRemoveResponseValidationError is the validation error returned by
+// ApiKeyRemoveResponse.Validate if the designated constraints aren't met.
+type ApiKeyRemoveResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyRemoveResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyRemoveResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyRemoveResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyRemoveResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyRemoveResponseValidationError) ErrorName() string {
+	return "ApiKeyRemoveResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApiKeyRemoveResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKeyRemoveResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyRemoveResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyRemoveResponseValidationError{}
+
+// Validate checks the field values on SyncProgressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncProgressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncProgressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncProgressResponseMultiError, or nil if none found.
+func (m *SyncProgressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncProgressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Syncing
+
+	// no validation rules for Type
+
+	// no validation rules for Phase
+
+	// no validation rules for StartingBlock
+
+	// no validation rules for CurrentBlock
+
+	// no validation rules for HighestBlock
+
+	// no validation rules for BlocksPerSecond
+
+	// no validation rules for EtaSeconds
+
+	for idx, item := range m.GetPeers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncProgressResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncProgressResponseValidationError{
+						field:  fmt.Sprintf("Peers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncProgressResponseValidationError{
+					field:  fmt.Sprintf("Peers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DownloadedBytes
+
+	if len(errors) > 0 {
+		return SyncProgressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncProgressResponseMultiError is an error wrapping multiple validation errors
+// returned by SyncProgressResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SyncProgressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncProgressResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncProgressResponseMultiError) AllErrors() []error { return m }
+
+// SyncProgressResponseValidationError is the validation error returned by
+// SyncProgressResponse.Validate if the designated constraints aren't met.
+type SyncProgressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncProgressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncProgressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncProgressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncProgressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncProgressResponseValidationError) ErrorName() string {
+	return "SyncProgressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncProgressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncProgressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncProgressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncProgressResponseValidationError{}
+
+// Validate checks the field values on SyncProgressPeer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncProgressPeer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncProgressPeer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncProgressPeerMultiError, or nil if none found.
+func (m *SyncProgressPeer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncProgressPeer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return SyncProgressPeerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncProgressPeerMultiError is an error wrapping multiple validation errors
+// returned by SyncProgressPeer.ValidateAll() if the designated constraints
+// aren't met.
+type SyncProgressPeerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncProgressPeerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncProgressPeerMultiError) AllErrors() []error { return m }
+
+// SyncProgressPeerValidationError is the validation error returned by
+// SyncProgressPeer.Validate if the designated constraints aren't met.
+type SyncProgressPeerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncProgressPeerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncProgressPeerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncProgressPeerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncProgressPeerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncProgressPeerValidationError) ErrorName() string {
+	return "SyncProgressPeerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncProgressPeerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncProgressPeer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncProgressPeerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncProgressPeerValidationError{}
+
 // Validate checks the field values on BlockchainEvent_Header with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
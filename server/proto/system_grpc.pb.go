@@ -33,10 +33,30 @@ type SystemClient interface {
 	PeersStatus(ctx context.Context, in *PeersStatusRequest, opts ...grpc.CallOption) (*Peer, error)
 	// Subscribe subscribes to blockchain events
 	Subscribe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribeClient, error)
+	// SubscribeReorgs subscribes to chain reorganization events
+	SubscribeReorgs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribeReorgsClient, error)
+	// SubscribePeerEvents subscribes to peer connection events
+	SubscribePeerEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribePeerEventsClient, error)
 	// Export returns blockchain data
 	BlockByNumber(ctx context.Context, in *BlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (System_ExportClient, error)
+	// BlockGasTargetGet returns the block gas target the node proposes blocks with
+	BlockGasTargetGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BlockGasTargetResponse, error)
+	// BlockGasTargetSet sets the block gas target the node proposes blocks with
+	BlockGasTargetSet(ctx context.Context, in *BlockGasTargetSetRequest, opts ...grpc.CallOption) (*BlockGasTargetResponse, error)
+	// LogLevelGet returns the default log level and the log levels of the modules
+	LogLevelGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	// LogLevelSet sets the default log level or the log level of a module
+	LogLevelSet(ctx context.Context, in *LogLevelSetRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	// ApiKeyList returns the JSON-RPC API keys along with their usage
+	ApiKeyList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiKeyListResponse, error)
+	// ApiKeySet adds the JSON-RPC API key or updates its permissions and rate limit
+	ApiKeySet(ctx context.Context, in *ApiKey, opts ...grpc.CallOption) (*ApiKey, error)
+	// ApiKeyRemove removes the JSON-RPC API key
+	ApiKeyRemove(ctx context.Context, in *ApiKeyRemoveRequest, opts ...grpc.CallOption) (*ApiKeyRemoveResponse, error)
+	// SyncProgress returns the detailed progress of the ongoing sync
+	SyncProgress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SyncProgressResponse, error)
 }
 
 type systemClient struct {
@@ -115,6 +135,70 @@ func (x *systemSubscribeClient) Recv() (*BlockchainEvent, error) {
 	return m, nil
 }
 
+func (c *systemClient) SubscribeReorgs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribeReorgsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[1], "/v1.System/SubscribeReorgs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemSubscribeReorgsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type System_SubscribeReorgsClient interface {
+	Recv() (*ReorgEvent, error)
+	grpc.ClientStream
+}
+
+type systemSubscribeReorgsClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemSubscribeReorgsClient) Recv() (*ReorgEvent, error) {
+	m := new(ReorgEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *systemClient) SubscribePeerEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribePeerEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[2], "/v1.System/SubscribePeerEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemSubscribePeerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type System_SubscribePeerEventsClient interface {
+	Recv() (*PeerEvent, error)
+	grpc.ClientStream
+}
+
+type systemSubscribePeerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemSubscribePeerEventsClient) Recv() (*PeerEvent, error) {
+	m := new(PeerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *systemClient) BlockByNumber(ctx context.Context, in *BlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	out := new(BlockResponse)
 	err := c.cc.Invoke(ctx, "/v1.System/BlockByNumber", in, out, opts...)
@@ -125,7 +209,7 @@ func (c *systemClient) BlockByNumber(ctx context.Context, in *BlockByNumberReque
 }
 
 func (c *systemClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (System_ExportClient, error) {
-	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[1], "/v1.System/Export", opts...)
+	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[3], "/v1.System/Export", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +240,78 @@ func (x *systemExportClient) Recv() (*ExportEvent, error) {
 	return m, nil
 }
 
+func (c *systemClient) BlockGasTargetGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BlockGasTargetResponse, error) {
+	out := new(BlockGasTargetResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/BlockGasTargetGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) BlockGasTargetSet(ctx context.Context, in *BlockGasTargetSetRequest, opts ...grpc.CallOption) (*BlockGasTargetResponse, error) {
+	out := new(BlockGasTargetResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/BlockGasTargetSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) LogLevelGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/LogLevelGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) LogLevelSet(ctx context.Context, in *LogLevelSetRequest, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/LogLevelSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) ApiKeyList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApiKeyListResponse, error) {
+	out := new(ApiKeyListResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/ApiKeyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) ApiKeySet(ctx context.Context, in *ApiKey, opts ...grpc.CallOption) (*ApiKey, error) {
+	out := new(ApiKey)
+	err := c.cc.Invoke(ctx, "/v1.System/ApiKeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) ApiKeyRemove(ctx context.Context, in *ApiKeyRemoveRequest, opts ...grpc.CallOption) (*ApiKeyRemoveResponse, error) {
+	out := new(ApiKeyRemoveResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/ApiKeyRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) SyncProgress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SyncProgressResponse, error) {
+	out := new(SyncProgressResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/SyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemServer is the server API for System service.
 // All implementations must embed UnimplementedSystemServer
 // for forward compatibility
@@ -170,10 +326,30 @@ type SystemServer interface {
 	PeersStatus(context.Context, *PeersStatusRequest) (*Peer, error)
 	// Subscribe subscribes to blockchain events
 	Subscribe(*emptypb.Empty, System_SubscribeServer) error
+	// SubscribeReorgs subscribes to chain reorganization events
+	SubscribeReorgs(*emptypb.Empty, System_SubscribeReorgsServer) error
+	// SubscribePeerEvents subscribes to peer connection events
+	SubscribePeerEvents(*emptypb.Empty, System_SubscribePeerEventsServer) error
 	// Export returns blockchain data
 	BlockByNumber(context.Context, *BlockByNumberRequest) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(*ExportRequest, System_ExportServer) error
+	// BlockGasTargetGet returns the block gas target the node proposes blocks with
+	BlockGasTargetGet(context.Context, *emptypb.Empty) (*BlockGasTargetResponse, error)
+	// BlockGasTargetSet sets the block gas target the node proposes blocks with
+	BlockGasTargetSet(context.Context, *BlockGasTargetSetRequest) (*BlockGasTargetResponse, error)
+	// LogLevelGet returns the default log level and the log levels of the modules
+	LogLevelGet(context.Context, *emptypb.Empty) (*LogLevelResponse, error)
+	// LogLevelSet sets the default log level or the log level of a module
+	LogLevelSet(context.Context, *LogLevelSetRequest) (*LogLevelResponse, error)
+	// ApiKeyList returns the JSON-RPC API keys along with their usage
+	ApiKeyList(context.Context, *emptypb.Empty) (*ApiKeyListResponse, error)
+	// ApiKeySet adds the JSON-RPC API key or updates its permissions and rate limit
+	ApiKeySet(context.Context, *ApiKey) (*ApiKey, error)
+	// ApiKeyRemove removes the JSON-RPC API key
+	ApiKeyRemove(context.Context, *ApiKeyRemoveRequest) (*ApiKeyRemoveResponse, error)
+	// SyncProgress returns the detailed progress of the ongoing sync
+	SyncProgress(context.Context, *emptypb.Empty) (*SyncProgressResponse, error)
 	mustEmbedUnimplementedSystemServer()
 }
 
@@ -196,12 +372,42 @@ func (UnimplementedSystemServer) PeersStatus(context.Context, *PeersStatusReques
 func (UnimplementedSystemServer) Subscribe(*emptypb.Empty, System_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedSystemServer) SubscribeReorgs(*emptypb.Empty, System_SubscribeReorgsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeReorgs not implemented")
+}
+func (UnimplementedSystemServer) SubscribePeerEvents(*emptypb.Empty, System_SubscribePeerEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePeerEvents not implemented")
+}
 func (UnimplementedSystemServer) BlockByNumber(context.Context, *BlockByNumberRequest) (*BlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockByNumber not implemented")
 }
 func (UnimplementedSystemServer) Export(*ExportRequest, System_ExportServer) error {
 	return status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSystemServer) BlockGasTargetGet(context.Context, *emptypb.Empty) (*BlockGasTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockGasTargetGet not implemented")
+}
+func (UnimplementedSystemServer) BlockGasTargetSet(context.Context, *BlockGasTargetSetRequest) (*BlockGasTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockGasTargetSet not implemented")
+}
+func (UnimplementedSystemServer) LogLevelGet(context.Context, *emptypb.Empty) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevelGet not implemented")
+}
+func (UnimplementedSystemServer) LogLevelSet(context.Context, *LogLevelSetRequest) (*LogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevelSet not implemented")
+}
+func (UnimplementedSystemServer) ApiKeyList(context.Context, *emptypb.Empty) (*ApiKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApiKeyList not implemented")
+}
+func (UnimplementedSystemServer) ApiKeySet(context.Context, *ApiKey) (*ApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApiKeySet not implemented")
+}
+func (UnimplementedSystemServer) ApiKeyRemove(context.Context, *ApiKeyRemoveRequest) (*ApiKeyRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApiKeyRemove not implemented")
+}
+func (UnimplementedSystemServer) SyncProgress(context.Context, *emptypb.Empty) (*SyncProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncProgress not implemented")
+}
 func (UnimplementedSystemServer) mustEmbedUnimplementedSystemServer() {}
 
 // UnsafeSystemServer may be embedded to opt out of forward compatibility for this service.
@@ -308,6 +514,48 @@ func (x *systemSubscribeServer) Send(m *BlockchainEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _System_SubscribeReorgs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemServer).SubscribeReorgs(m, &systemSubscribeReorgsServer{stream})
+}
+
+type System_SubscribeReorgsServer interface {
+	Send(*ReorgEvent) error
+	grpc.ServerStream
+}
+
+type systemSubscribeReorgsServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemSubscribeReorgsServer) Send(m *ReorgEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _System_SubscribePeerEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemServer).SubscribePeerEvents(m, &systemSubscribePeerEventsServer{stream})
+}
+
+type System_SubscribePeerEventsServer interface {
+	Send(*PeerEvent) error
+	grpc.ServerStream
+}
+
+type systemSubscribePeerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemSubscribePeerEventsServer) Send(m *PeerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _System_BlockByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockByNumberRequest)
 	if err := dec(in); err != nil {
@@ -347,6 +595,150 @@ func (x *systemExportServer) Send(m *ExportEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _System_BlockGasTargetGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).BlockGasTargetGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/BlockGasTargetGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).BlockGasTargetGet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_BlockGasTargetSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockGasTargetSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).BlockGasTargetSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/BlockGasTargetSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).BlockGasTargetSet(ctx, req.(*BlockGasTargetSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_LogLevelGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).LogLevelGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/LogLevelGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).LogLevelGet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_LogLevelSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).LogLevelSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/LogLevelSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).LogLevelSet(ctx, req.(*LogLevelSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_ApiKeyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).ApiKeyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/ApiKeyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).ApiKeyList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_ApiKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).ApiKeySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/ApiKeySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).ApiKeySet(ctx, req.(*ApiKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_ApiKeyRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApiKeyRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).ApiKeyRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/ApiKeyRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).ApiKeyRemove(ctx, req.(*ApiKeyRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_SyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).SyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/SyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).SyncProgress(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // System_ServiceDesc is the grpc.ServiceDesc for System service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,6 +766,38 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockByNumber",
 			Handler:    _System_BlockByNumber_Handler,
 		},
+		{
+			MethodName: "BlockGasTargetGet",
+			Handler:    _System_BlockGasTargetGet_Handler,
+		},
+		{
+			MethodName: "BlockGasTargetSet",
+			Handler:    _System_BlockGasTargetSet_Handler,
+		},
+		{
+			MethodName: "LogLevelGet",
+			Handler:    _System_LogLevelGet_Handler,
+		},
+		{
+			MethodName: "LogLevelSet",
+			Handler:    _System_LogLevelSet_Handler,
+		},
+		{
+			MethodName: "ApiKeyList",
+			Handler:    _System_ApiKeyList_Handler,
+		},
+		{
+			MethodName: "ApiKeySet",
+			Handler:    _System_ApiKeySet_Handler,
+		},
+		{
+			MethodName: "ApiKeyRemove",
+			Handler:    _System_ApiKeyRemove_Handler,
+		},
+		{
+			MethodName: "SyncProgress",
+			Handler:    _System_SyncProgress_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -381,6 +805,16 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _System_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeReorgs",
+			Handler:       _System_SubscribeReorgs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribePeerEvents",
+			Handler:       _System_SubscribePeerEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Export",
 			Handler:       _System_Export_Handler,
@@ -1,21 +1,25 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
 	consensusPolyBFT "github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/gasprice"
 
@@ -32,7 +36,13 @@ import (
 	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/0xPolygon/polygon-edge/server/faucet"
+	"github.com/0xPolygon/polygon-edge/server/grpcauth"
+	"github.com/0xPolygon/polygon-edge/server/health"
+	"github.com/0xPolygon/polygon-edge/server/metatx"
 	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/0xPolygon/polygon-edge/server/telemetry"
 	"github.com/0xPolygon/polygon-edge/state"
 	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
@@ -41,21 +51,27 @@ import (
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validate"
+	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/hashicorp/go-hclog"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/umbracle/ethgo"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
 )
 
 var (
 	errBlockTimeMissing = errors.New("block time configuration is missing")
 	errBlockTimeInvalid = errors.New("block time configuration is invalid")
+
+	errExternalSignerNotSupported = errors.New("external signer and Web3Signer are supported by the PolyBFT consensus only")
 )
 
 // Server is the central manager of the blockchain client
 type Server struct {
 	logger       hclog.Logger
+	logs         *logRingBuffer
+	logLevels    *logLevels
 	config       *Config
 	state        state.State
 	stateStorage itrie.Storage
@@ -71,6 +87,7 @@ type Server struct {
 
 	// jsonrpc stack
 	jsonrpcServer *jsonrpc.JSONRPC
+	apiKeys       *jsonrpc.APIKeyManager
 
 	// system grpc server
 	grpcServer *grpc.Server
@@ -83,6 +100,18 @@ type Server struct {
 
 	prometheusServer *http.Server
 
+	// healthServer serves the health and readiness endpoints
+	healthServer *http.Server
+
+	// faucetServer serves the faucet endpoint, nil if the faucet is disabled
+	faucetServer *http.Server
+
+	// telemetryReporter pushes the opt-in node health reports, nil if reporting is disabled
+	telemetryReporter *telemetry.Reporter
+
+	// tracerProvider exports the OpenTelemetry spans, nil if tracing is disabled
+	tracerProvider *sdktrace.TracerProvider
+
 	// secrets manager
 	secretsManager secrets.SecretsManager
 
@@ -92,68 +121,106 @@ type Server struct {
 	// stateSyncRelayer is handling state syncs execution (Polybft exclusive)
 	stateSyncRelayer *statesyncrelayer.StateSyncRelayer
 
+	// extensions are the out-of-tree extensions loaded from the plugins
+	extensions *extension.Registry
+
 	// gasHelper is providing functions regarding gas and fees
 	gasHelper *gasprice.GasHelper
 }
 
-// newFileLogger returns logger instance that writes all logs to a specified file.
-// If log file can't be created, it returns an error
-func newFileLogger(config *Config) (hclog.Logger, error) {
-	logFileWriter, err := os.Create(config.LogFilePath)
-	if err != nil {
-		return nil, fmt.Errorf("could not create log file, %w", err)
-	}
-
-	return hclog.New(&hclog.LoggerOptions{
-		Name:       "polygon",
-		Level:      config.LogLevel,
-		Output:     logFileWriter,
-		JSONFormat: config.JSONLogFormat,
-	}), nil
-}
+// newLogger returns the root logger instance that writes all logs to the provided output.
+// Log lines are filtered by the level of the module they belong to,
+// so the log levels can be changed per module at runtime
+func newLogger(config *Config, output io.Writer, levels *logLevels) hclog.Logger {
+	logger := hclog.NewInterceptLogger(&hclog.LoggerOptions{
+		Name:   rootLoggerName,
+		Output: io.Discard,
+		// log lines are written by the level filtering sink only
+		Exclude: func(hclog.Level, string, ...interface{}) bool {
+			return true
+		},
+	})
 
-// newCLILogger returns minimal logger instance that sends all logs to standard output
-func newCLILogger(config *Config) hclog.Logger {
-	return hclog.New(&hclog.LoggerOptions{
-		Name:       "polygon",
-		Level:      config.LogLevel,
-		JSONFormat: config.JSONLogFormat,
+	logger.RegisterSink(&levelFilterSink{
+		levels: levels,
+		sink: hclog.NewSinkAdapter(&hclog.LoggerOptions{
+			Level:      hclog.Trace,
+			Output:     output,
+			JSONFormat: config.JSONLogFormat,
+		}),
 	})
+
+	levels.setLogger(logger)
+
+	return logger
 }
 
 // newLoggerFromConfig creates a new logger which logs to a specified file.
 // If log file is not set it outputs to standard output ( console ).
-// If log file is specified, and it can't be created the server command will error out
-func newLoggerFromConfig(config *Config) (hclog.Logger, error) {
+// If log file is specified, and it can't be created the server command will error out.
+// All logs are also written to the provided logs writer
+func newLoggerFromConfig(config *Config, logs io.Writer, levels *logLevels) (hclog.Logger, error) {
 	if config.LogFilePath != "" {
-		fileLoggerInstance, err := newFileLogger(config)
+		logFileWriter, err := os.Create(config.LogFilePath)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("could not create log file, %w", err)
 		}
 
-		return fileLoggerInstance, nil
+		return newLogger(config, io.MultiWriter(logFileWriter, logs), levels), nil
 	}
 
-	return newCLILogger(config), nil
+	return newLogger(config, io.MultiWriter(hclog.DefaultOutput, logs), levels), nil
 }
 
 // NewServer creates a new Minimal server, using the passed in configuration
 func NewServer(config *Config) (*Server, error) {
-	logger, err := newLoggerFromConfig(config)
+	// keep the latest log lines, so they can be included in the diagnostic bundle in case of a crash
+	logs := newLogRingBuffer(crashLogLines)
+
+	// log levels set at runtime are persisted to the data dir, so they survive the restart
+	var logLevelsPath string
+	if config.DataDir != "" {
+		logLevelsPath = filepath.Join(config.DataDir, logLevelsFileName)
+	}
+
+	logLevels, err := newLogLevels(config.LogLevel, config.LogLevelFlagSet, logLevelsPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not load log levels, %w", err)
+	}
+
+	logger, err := newLoggerFromConfig(config, logs, logLevels)
 	if err != nil {
 		return nil, fmt.Errorf("could not setup new logger instance, %w", err)
 	}
 
+	grpcOpts, err := grpcauth.ServerOptions(config.GRPCAuth)
+	if err != nil {
+		return nil, fmt.Errorf("could not setup GRPC authentication, %w", err)
+	}
+
 	m := &Server{
 		logger:             logger.Named("server"),
+		logs:               logs,
+		logLevels:          logLevels,
 		config:             config,
 		chain:              config.Chain,
-		grpcServer:         grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor)),
 		restoreProgression: progress.NewProgressionWrapper(progress.ChainSyncRestore),
 	}
 
+	defer m.RecoverPanic()
+
+	// the recovery interceptors go first, so the panics of the other interceptors are captured as well
+	m.grpcServer = grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(m.recoveryUnaryInterceptor),
+		grpc.ChainStreamInterceptor(m.recoveryStreamInterceptor),
+	}, append(grpcOpts, grpc.ChainUnaryInterceptor(unaryInterceptor))...)...)
+
 	m.logger.Info("Data dir", "path", config.DataDir)
 
+	if level, modules := logLevels.get(); level != config.LogLevel || len(modules) > 0 {
+		m.logger.Info("Log levels restored", "path", logLevelsPath, "level", level, "modules", modules)
+	}
+
 	var dirPaths = []string{
 		"blockchain",
 		"trie",
@@ -164,6 +231,15 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, fmt.Errorf("failed to create data directories: %w", err)
 	}
 
+	// the panics of the goroutines which don't recover them are written to the crash output by the runtime
+	if err := setCrashOutput(config.DataDir); err != nil {
+		return nil, fmt.Errorf("failed to set the crash output: %w", err)
+	}
+
+	if m.extensions, err = extension.Load(config.Plugins, logger); err != nil {
+		return nil, fmt.Errorf("failed to load plugins: %w", err)
+	}
+
 	if config.Telemetry.PrometheusAddr != nil {
 		// Only setup telemetry if `PrometheusAddr` has been configured.
 		if err := m.setupTelemetry(); err != nil {
@@ -173,6 +249,13 @@ func NewServer(config *Config) (*Server, error) {
 		m.prometheusServer = m.startPrometheusServer(config.Telemetry.PrometheusAddr)
 	}
 
+	if config.Telemetry.TracingEndpoint != "" {
+		// Only setup tracing if `TracingEndpoint` has been configured
+		if err := m.setupTracing(); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set up datadog profiler
 	if ddErr := m.enableDataDogProfiler(); err != nil {
 		m.logger.Error("DataDog profiler setup failed", "err", ddErr.Error())
@@ -209,6 +292,7 @@ func NewServer(config *Config) (*Server, error) {
 	m.state = st
 
 	m.executor = state.NewExecutor(config.Chain.Params, st, logger)
+	m.executor.DirtyStateLimit = config.DirtyStateLimit
 
 	// custom write genesis hook per consensus engine
 	engineName := m.config.Chain.Params.GetEngine()
@@ -330,6 +414,33 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	if config.TxLookupBySender {
+		m.blockchain.EnableSenderTxLookup()
+	}
+
+	if config.ContractCreationLookup {
+		m.blockchain.EnableContractCreationLookup()
+	}
+
+	if config.TxHistory > 0 {
+		m.blockchain.SetTxHistoryLimit(config.TxHistory)
+	}
+
+	if config.FlatState {
+		if err := st.EnableFlatState(m.blockchain.Header().StateRoot, logger); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.config.DataDir != "" {
+		// capture the blocks which fail the verification for the later debugging
+		if err := m.blockchain.EnableBadBlocksCapture(
+			filepath.Join(m.config.DataDir, blockchain.BadBlocksDirName),
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	// here we can provide some other configuration
 	m.gasHelper, err = gasprice.NewGasHelper(gasprice.DefaultGasHelperConfig, m.blockchain)
 	if err != nil {
@@ -356,6 +467,20 @@ func NewServer(config *Config) (*Server, error) {
 				PriceLimit:         m.config.PriceLimit,
 				MaxAccountEnqueued: m.config.MaxAccountEnqueued,
 				ChainID:            big.NewInt(m.config.Chain.Params.ChainID),
+				DenyList:           m.config.TxPoolDenyList,
+				MaxInitCodeSize:    m.config.Chain.Params.GetMaxInitCodeSize(),
+
+				ContractDeployerAllowList: m.config.Chain.Params.ContractDeployerAllowList != nil,
+				ContractDeployerBlockList: m.config.Chain.Params.ContractDeployerBlockList != nil,
+				TransactionsAllowList:     m.config.Chain.Params.TransactionsAllowList != nil,
+				TransactionsBlockList:     m.config.Chain.Params.TransactionsBlockList != nil,
+
+				AdmissionRateLimit:      m.config.TxPoolAdmissionRateLimit,
+				AdmissionMinProbability: m.config.TxPoolAdmissionMinProbability,
+				Validators:              m.extensions.TxValidators(),
+				AutoTune:                m.config.TxPoolAutoTune,
+				PriorityLane:            m.config.TxPoolPriorityLane,
+				FutureTxLifetime:        m.config.TxPoolFutureTxLifetime,
 			},
 		)
 		if err != nil {
@@ -380,6 +505,11 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// recover the head written without its state, unless the node was shut down cleanly
+	if err := m.recoverUncleanShutdown(); err != nil {
+		return nil, err
+	}
+
 	// initialize data in consensus layer
 	if err := m.consensus.Initialize(); err != nil {
 		return nil, err
@@ -399,6 +529,12 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	if config.OverrideFile != "" {
+		if err := m.applyOverrideFile(); err != nil {
+			return nil, err
+		}
+	}
+
 	// restore archive data before starting
 	if err := m.restoreChain(); err != nil {
 		return nil, err
@@ -418,6 +554,30 @@ func NewServer(config *Config) (*Server, error) {
 
 	m.txpool.Start()
 
+	if config.Health != nil && config.Health.Addr != nil {
+		m.healthServer = m.startHealthServer(config.Health)
+	}
+
+	if config.Faucet.Enabled() {
+		if m.faucetServer, err = m.startFaucetServer(config.Faucet); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.Telemetry.Report != nil && config.Telemetry.Report.Endpoint != "" {
+		if m.telemetryReporter, err = telemetry.NewReporter(
+			config.Telemetry.Report,
+			&healthHub{Blockchain: m.blockchain, network: m.network},
+			config.Chain.Params.ChainID,
+			versioning.Version,
+			m.logger,
+		); err != nil {
+			return nil, err
+		}
+
+		m.telemetryReporter.Start()
+	}
+
 	return m, nil
 }
 
@@ -481,6 +641,20 @@ func (t *txpoolHub) GetNonce(root types.Hash, addr types.Address) uint64 {
 	return account.Nonce
 }
 
+func (t *txpoolHub) GetStorage(root types.Hash, addr types.Address, slot types.Hash) (types.Hash, error) {
+	account, err := getAccountImpl(t.state, root, addr)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	snap, err := t.state.NewSnapshotAt(root)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return snap.GetStorage(addr, account.Root, slot), nil
+}
+
 func (t *txpoolHub) GetBalance(root types.Hash, addr types.Address) (*big.Int, error) {
 	account, err := getAccountImpl(t.state, root, addr)
 
@@ -514,7 +688,8 @@ func (s *Server) setupSecretsManager() error {
 		// Only the base directory is required for
 		// the local secrets manager
 		secretsManagerParams.Extra = map[string]interface{}{
-			secrets.Path: s.config.DataDir,
+			secrets.Path:       s.config.DataDir,
+			secrets.Passphrase: secretsHelper.PassphraseProvider(),
 		}
 	}
 
@@ -548,6 +723,10 @@ func (s *Server) setupConsensus() error {
 		return fmt.Errorf("consensus engine '%s' not found", engineName)
 	}
 
+	if s.config.Signer.IsRemote() && ConsensusType(engineName) != PolyBFTConsensus {
+		return errExternalSignerNotSupported
+	}
+
 	engineConfig, ok := s.config.Chain.Params.Engine[engineName].(map[string]interface{})
 	if !ok {
 		engineConfig = map[string]interface{}{}
@@ -584,6 +763,14 @@ func (s *Server) setupConsensus() error {
 			SecretsManager:        s.secretsManager,
 			BlockTime:             uint64(blockTime.Seconds()),
 			NumBlockConfirmations: s.config.NumBlockConfirmations,
+			SyncStallTimeout:      s.config.SyncStallTimeout,
+			SyncPipeline:          s.config.SyncPipeline,
+			BridgeAlert:           s.config.BridgeAlert,
+
+			RootchainJSONRPCEndpoints: s.config.RootchainJSONRPCEndpoints,
+			Extensions:                s.extensions,
+			RootchainFeeBump:          s.config.RootchainFeeBump,
+			Signer:                    s.config.Signer,
 		},
 	)
 
@@ -624,9 +811,14 @@ func extractBlockTime(engineConfig map[string]interface{}) (common.Duration, err
 
 // setupRelayer sets up the relayer
 func (s *Server) setupRelayer() error {
-	account, err := wallet.NewAccountFromSecret(s.secretsManager)
+	signer, err := wallet.NewSigner(s.config.Signer, s.secretsManager, s.logger)
 	if err != nil {
-		return fmt.Errorf("failed to create account from secret: %w", err)
+		return fmt.Errorf("failed to create signer: %w", err)
+	}
+
+	// the relayer sends the state sync execution transactions
+	if _, ok := signer.(*wallet.Web3Signer); ok {
+		return fmt.Errorf("failed to create relayer: %w", wallet.ErrWeb3SignerTransactionSigning)
 	}
 
 	polyBFTConfig, err := consensusPolyBFT.GetPolyBFTConfig(s.config.Chain)
@@ -645,7 +837,7 @@ func (s *Server) setupRelayer() error {
 		ethgo.Address(contracts.StateReceiverContract),
 		trackerStartBlockConfig[contracts.StateReceiverContract],
 		s.logger.Named("relayer"),
-		wallet.NewEcdsaSigner(wallet.NewKey(account)),
+		wallet.NewEcdsaSigner(wallet.NewKey(signer)),
 	)
 
 	// start relayer
@@ -660,6 +852,9 @@ type jsonRPCHub struct {
 	state              state.State
 	restoreProgression *progress.ProgressionWrapper
 
+	// metaTxRelayer relays the meta-transactions, nil if the relayer is disabled
+	metaTxRelayer *metatx.Relayer
+
 	*blockchain.Blockchain
 	*txpool.TxPool
 	*state.Executor
@@ -723,10 +918,228 @@ func (j *jsonRPCHub) GetCode(root types.Hash, addr types.Address) ([]byte, error
 }
 
 func (j *jsonRPCHub) ApplyTxn(
+	ctx context.Context,
 	header *types.Header,
 	txn *types.Transaction,
 	override types.StateOverride,
 ) (result *runtime.ExecutionResult, err error) {
+	transition, err := j.beginCallTxn(ctx, header, override)
+	if err != nil {
+		return nil, err
+	}
+
+	return transition.Apply(txn)
+}
+
+// ApplyStaticTxn applies the transaction as the read-only call, which is considerably cheaper
+// for the view calls. The transaction is applied regularly if it turns out not to be read-only
+func (j *jsonRPCHub) ApplyStaticTxn(
+	ctx context.Context,
+	header *types.Header,
+	txn *types.Transaction,
+	override types.StateOverride,
+) (*runtime.ExecutionResult, error) {
+	transition, err := j.beginCallTxn(ctx, header, override)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := transition.ApplyStatic(txn)
+	if errors.Is(err, state.ErrNotReadOnly) {
+		return j.ApplyTxn(ctx, header, txn, override)
+	}
+
+	return result, err
+}
+
+// ApplyPendingTxn applies the transaction on top of the pending state, which is the latest block
+// with the txpool promoted transactions applied in the nonce order of each account
+func (j *jsonRPCHub) ApplyPendingTxn(
+	ctx context.Context,
+	txn *types.Transaction,
+	override types.StateOverride,
+) (*runtime.ExecutionResult, error) {
+	transition, err := j.beginPendingTxn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if override != nil {
+		if err := transition.WithStateOverride(override); err != nil {
+			return nil, err
+		}
+	}
+
+	return transition.Apply(txn)
+}
+
+// AddTxWithContext adds the transaction to the txpool, the txpool logs of the transaction
+// carry the trace ID of the request which sent it
+func (j *jsonRPCHub) AddTxWithContext(ctx context.Context, tx *types.Transaction) error {
+	return j.TxPool.AddTxWithTraceID(tx, jsonrpc.TraceIDFromContext(ctx))
+}
+
+// beginPendingTxn begins the transition of the next block and applies the txpool promoted transactions.
+// The transactions are applied until the block gas limit is reached, the remaining transactions
+// of the account whose transaction failed are skipped since their nonces can't match anymore
+func (j *jsonRPCHub) beginPendingTxn(ctx context.Context) (*state.Transition, error) {
+	parent := j.Blockchain.Header()
+
+	blockCreator, err := j.GetConsensus().GetBlockCreator(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := uint64(time.Now().UTC().Unix())
+	if timestamp <= parent.Timestamp {
+		timestamp = parent.Timestamp + 1
+	}
+
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Number:     parent.Number + 1,
+		Miner:      blockCreator.Bytes(),
+		Difficulty: parent.Difficulty,
+		StateRoot:  parent.StateRoot,
+		GasLimit:   parent.GasLimit,
+		BaseFee:    j.Blockchain.CalculateBaseFee(parent),
+		Timestamp:  timestamp,
+	}
+
+	transition, err := j.BeginTxn(parent.StateRoot, header, blockCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	transition.SetTraceID(jsonrpc.TraceIDFromContext(ctx))
+
+	promoted := j.TxPool.GetPromotedTxs()
+
+	senders := make([]types.Address, 0, len(promoted))
+	for sender := range promoted {
+		senders = append(senders, sender)
+	}
+
+	// apply the accounts in a deterministic order
+	sort.Slice(senders, func(i, k int) bool {
+		return bytes.Compare(senders[i].Bytes(), senders[k].Bytes()) < 0
+	})
+
+senders:
+	for _, sender := range senders {
+		for _, tx := range promoted[sender] {
+			if err := transition.Write(tx); err != nil {
+				if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
+					break senders
+				}
+
+				continue senders
+			}
+		}
+	}
+
+	transition.ResetGasPool()
+
+	return transition, nil
+}
+
+// FeeRecipient returns the address credited with the fees of the blocks built by the node
+func (j *jsonRPCHub) FeeRecipient() (types.Address, error) {
+	provider, ok := j.Consensus.(consensus.FeeRecipientProvider)
+	if !ok {
+		return types.ZeroAddress, jsonrpc.ErrFeeRecipientUnavailable
+	}
+
+	return provider.FeeRecipient()
+}
+
+// timeTravelProvider returns the consensus if it lets the clients rewind the chain (the dev consensus)
+func (j *jsonRPCHub) timeTravelProvider() (consensus.TimeTravelProvider, error) {
+	provider, ok := j.Consensus.(consensus.TimeTravelProvider)
+	if !ok {
+		return nil, jsonrpc.ErrTimeTravelUnavailable
+	}
+
+	return provider, nil
+}
+
+// SnapshotChain saves the current chain head and returns the snapshot id
+func (j *jsonRPCHub) SnapshotChain() (uint64, error) {
+	provider, err := j.timeTravelProvider()
+	if err != nil {
+		return 0, err
+	}
+
+	return provider.Snapshot()
+}
+
+// RevertChain rewinds the chain to the given snapshot
+func (j *jsonRPCHub) RevertChain(id uint64) (bool, error) {
+	provider, err := j.timeTravelProvider()
+	if err != nil {
+		return false, err
+	}
+
+	return provider.Revert(id)
+}
+
+// MineBlock seals a block immediately, at the given timestamp if it is set
+func (j *jsonRPCHub) MineBlock(timestamp uint64) error {
+	provider, err := j.timeTravelProvider()
+	if err != nil {
+		return err
+	}
+
+	return provider.Mine(timestamp)
+}
+
+// IncreaseTime moves the timestamps of the next blocks forward
+func (j *jsonRPCHub) IncreaseTime(seconds uint64) (int64, error) {
+	provider, err := j.timeTravelProvider()
+	if err != nil {
+		return 0, err
+	}
+
+	return provider.IncreaseTime(seconds), nil
+}
+
+// GetValidatorsByEpoch returns the validator set of the ended epoch, with the proofs of its membership
+func (j *jsonRPCHub) GetValidatorsByEpoch(epoch uint64) (*types.EpochValidators, error) {
+	provider, ok := j.Consensus.(consensus.EpochValidatorsProvider)
+	if !ok {
+		return nil, jsonrpc.ErrEpochValidatorsUnavailable
+	}
+
+	return provider.GetValidatorsByEpoch(epoch)
+}
+
+// GetValidatorQueue returns the candidates waiting for a slot in the validator set
+func (j *jsonRPCHub) GetValidatorQueue() (*types.ValidatorQueue, error) {
+	provider, ok := j.Consensus.(consensus.ValidatorQueueProvider)
+	if !ok {
+		return nil, jsonrpc.ErrValidatorQueueUnavailable
+	}
+
+	return provider.GetValidatorQueue()
+}
+
+// GetBurnContract returns the contract the base fee is credited to at the given block height
+// and the address the contract withdraws the burned base fee to
+func (j *jsonRPCHub) GetBurnContract(blockNumber uint64) (types.Address, types.Address, error) {
+	contract, err := j.Executor.BurnContract(blockNumber)
+	if err != nil {
+		return types.ZeroAddress, types.ZeroAddress, err
+	}
+
+	return contract, j.Executor.BurnContractDestination(), nil
+}
+
+// beginCallTxn begins the transition on top of the given header, used to execute the calls
+func (j *jsonRPCHub) beginCallTxn(
+	ctx context.Context,
+	header *types.Header,
+	override types.StateOverride,
+) (*state.Transition, error) {
 	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
 	if err != nil {
 		return nil, err
@@ -734,22 +1147,23 @@ func (j *jsonRPCHub) ApplyTxn(
 
 	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
 	if err != nil {
-		return
+		return nil, err
 	}
 
+	transition.SetTraceID(jsonrpc.TraceIDFromContext(ctx))
+
 	if override != nil {
-		if err = transition.WithStateOverride(override); err != nil {
-			return
+		if err := transition.WithStateOverride(override); err != nil {
+			return nil, err
 		}
 	}
 
-	result, err = transition.Apply(txn)
-
-	return
+	return transition, nil
 }
 
 // TraceBlock traces all transactions in the given block and returns all results
 func (j *jsonRPCHub) TraceBlock(
+	ctx context.Context,
 	block *types.Block,
 	tracer tracer.Tracer,
 ) ([]interface{}, error) {
@@ -773,6 +1187,7 @@ func (j *jsonRPCHub) TraceBlock(
 	}
 
 	transition.SetTracer(tracer)
+	transition.SetTraceID(jsonrpc.TraceIDFromContext(ctx))
 
 	results := make([]interface{}, len(block.Transactions))
 
@@ -791,8 +1206,118 @@ func (j *jsonRPCHub) TraceBlock(
 	return results, nil
 }
 
+// GenerateWitness re-executes the block on top of the state recording the trie nodes and the codes
+// accessed by the execution, and returns them as the witness of the block
+func (j *jsonRPCHub) GenerateWitness(block *types.Block) (*state.Witness, error) {
+	if block.Number() == 0 {
+		return nil, errors.New("genesis block has no witness")
+	}
+
+	recorder, ok := j.Executor.State().(state.WitnessRecorder)
+	if !ok {
+		return nil, errors.New("the state doesn't support the witness generation")
+	}
+
+	parentHeader, ok := j.GetHeaderByHash(block.ParentHash())
+	if !ok {
+		return nil, errors.New("parent header not found")
+	}
+
+	blockCreator, err := j.GetConsensus().GetBlockCreator(block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	witnessState, witness := recorder.NewWitnessState()
+
+	transition, err := j.Executor.WithState(witnessState).ProcessBlock(parentHeader.StateRoot, block, blockCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := j.GetConsensus().PreCommitState(block, transition); err != nil {
+		return nil, err
+	}
+
+	_, root, err := transition.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if root != block.Header.StateRoot {
+		return nil, fmt.Errorf("state root mismatch of the re-executed block, expected %s, got %s",
+			block.Header.StateRoot, root)
+	}
+
+	result := witness()
+	result.PreStateRoot = parentHeader.StateRoot
+
+	return result, nil
+}
+
+// ReplayBlock re-executes the transactions of the block with the given tracer,
+// collecting the state changes of each transaction if stateDiff is set
+func (j *jsonRPCHub) ReplayBlock(
+	ctx context.Context,
+	block *types.Block,
+	tracer tracer.Tracer,
+	stateDiff bool,
+) ([]*jsonrpc.ReplayResult, error) {
+	if block.Number() == 0 {
+		return nil, errors.New("genesis block can't have transaction")
+	}
+
+	parentHeader, ok := j.GetHeaderByHash(block.ParentHash())
+	if !ok {
+		return nil, errors.New("parent header not found")
+	}
+
+	blockCreator, err := j.GetConsensus().GetBlockCreator(block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := j.BeginTxn(parentHeader.StateRoot, block.Header, blockCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	transition.SetTracer(tracer)
+	transition.SetTraceID(jsonrpc.TraceIDFromContext(ctx))
+
+	results := make([]*jsonrpc.ReplayResult, len(block.Transactions))
+
+	for idx, tx := range block.Transactions {
+		tracer.Clear()
+
+		var checkpoint *state.Checkpoint
+		if stateDiff {
+			checkpoint = transition.Txn().Checkpoint()
+		}
+
+		if _, err := transition.Apply(tx); err != nil {
+			return nil, err
+		}
+
+		result := &jsonrpc.ReplayResult{}
+
+		if result.Trace, err = tracer.GetResult(); err != nil {
+			return nil, err
+		}
+
+		if stateDiff {
+			result.StateDiff = transition.Txn().DiffSince(checkpoint)
+		}
+
+		results[idx] = result
+	}
+
+	return results, nil
+}
+
 // TraceTxn traces a transaction in the block, associated with the given hash
 func (j *jsonRPCHub) TraceTxn(
+	ctx context.Context,
 	block *types.Block,
 	targetTxHash types.Hash,
 	tracer tracer.Tracer,
@@ -816,6 +1341,8 @@ func (j *jsonRPCHub) TraceTxn(
 		return nil, err
 	}
 
+	transition.SetTraceID(jsonrpc.TraceIDFromContext(ctx))
+
 	var targetTx *types.Transaction
 
 	for _, tx := range block.Transactions {
@@ -845,6 +1372,7 @@ func (j *jsonRPCHub) TraceTxn(
 }
 
 func (j *jsonRPCHub) TraceCall(
+	ctx context.Context,
 	tx *types.Transaction,
 	parentHeader *types.Header,
 	tracer tracer.Tracer,
@@ -860,6 +1388,7 @@ func (j *jsonRPCHub) TraceCall(
 	}
 
 	transition.SetTracer(tracer)
+	transition.SetTraceID(jsonrpc.TraceIDFromContext(ctx))
 
 	if _, err := transition.Apply(tx); err != nil {
 		return nil, err
@@ -868,6 +1397,24 @@ func (j *jsonRPCHub) TraceCall(
 	return tracer.GetResult()
 }
 
+// RelayMetaTx submits the signed meta-transaction to the txpool, paid by the sponsor account
+func (j *jsonRPCHub) RelayMetaTx(req *metatx.ForwardRequest, signature []byte) (types.Hash, error) {
+	if j.metaTxRelayer == nil {
+		return types.ZeroHash, metatx.ErrRelayerDisabled
+	}
+
+	return j.metaTxRelayer.Relay(req, signature)
+}
+
+// MetaTxSponsor returns the address of the account paying for the relayed meta-transactions
+func (j *jsonRPCHub) MetaTxSponsor() (types.Address, error) {
+	if j.metaTxRelayer == nil {
+		return types.ZeroAddress, metatx.ErrRelayerDisabled
+	}
+
+	return j.metaTxRelayer.Sponsor(), nil
+}
+
 func (j *jsonRPCHub) GetSyncProgression() *progress.Progression {
 	// restore progression
 	if restoreProg := j.restoreProgression.GetProgression(); restoreProg != nil {
@@ -898,15 +1445,39 @@ func (s *Server) setupJSONRPC() error {
 		GasStore:           s.gasHelper,
 	}
 
+	if s.config.MetaTx.Enabled() {
+		relayer, err := metatx.NewRelayer(s.config.MetaTx, hub, uint64(s.config.Chain.Params.ChainID), s.logger)
+		if err != nil {
+			return err
+		}
+
+		hub.metaTxRelayer = relayer
+	}
+
+	apiKeys, err := jsonrpc.NewAPIKeyManager(s.config.JSONRPC.APIKeysRequired, s.config.JSONRPC.APIKeys)
+	if err != nil {
+		return err
+	}
+
 	conf := &jsonrpc.Config{
 		Store:                    hub,
 		Addr:                     s.config.JSONRPC.JSONRPCAddr,
 		ChainID:                  uint64(s.config.Chain.Params.ChainID),
 		ChainName:                s.chain.Name,
+		NetworkID:                s.config.Chain.Params.GetNetworkID(),
 		AccessControlAllowOrigin: s.config.JSONRPC.AccessControlAllowOrigin,
+		AllowedHosts:             s.config.JSONRPC.AllowedHosts,
 		PriceLimit:               s.config.PriceLimit,
 		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,
 		BlockRangeLimit:          s.config.JSONRPC.BlockRangeLimit,
+		CallCacheSize:            s.config.JSONRPC.CallCacheSize,
+		FilterTimeout:            s.config.JSONRPC.FilterTimeout,
+		FilterRetention:          s.config.JSONRPC.FilterRetention,
+		LogsResultLimit:          s.config.JSONRPC.LogsResultLimit,
+		APIKeys:                  apiKeys,
+		SigningKeys:              s.config.JSONRPC.SigningKeys,
+		Namespaces:               s.extensions.RPCNamespaces(),
+		Tracers:                  s.extensions.Tracers(),
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -915,6 +1486,7 @@ func (s *Server) setupJSONRPC() error {
 	}
 
 	s.jsonrpcServer = srv
+	s.apiKeys = apiKeys
 
 	return nil
 }
@@ -930,12 +1502,24 @@ func (s *Server) setupGRPC() error {
 
 	// Start server with infinite retries
 	go func() {
+		defer s.RecoverPanic()
+
 		if err := s.grpcServer.Serve(lis); err != nil {
 			s.logger.Error(err.Error())
 		}
 	}()
 
-	s.logger.Info("GRPC server running", "addr", s.config.GRPCAddr.String())
+	s.logger.Info("GRPC server running", "addr", s.config.GRPCAddr.String(),
+		"tls", s.config.GRPCAuth.TLSEnabled(), "token", s.config.GRPCAuth.TokenEnabled())
+
+	if !s.config.GRPCAddr.IP.IsLoopback() {
+		switch {
+		case !s.config.GRPCAuth.Enabled():
+			s.logger.Warn("GRPC server is exposed beyond localhost without authentication")
+		case !s.config.GRPCAuth.TLSEnabled():
+			s.logger.Warn("GRPC server is exposed beyond localhost without TLS, the token is sent unencrypted")
+		}
+	}
 
 	return nil
 }
@@ -950,26 +1534,52 @@ func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
 	return s.network.JoinPeer(rawPeerMultiaddr)
 }
 
-// Close closes the Minimal server (blockchain, networking, consensus)
+// Close closes the Minimal server (consensus, txpool, networking, blockchain).
+// The consensus is closed first, waiting for the in-flight block sealing, so the blockchain isn't closed
+// in the middle of the block commit. If everything is closed cleanly, the clean shutdown marker is written,
+// which skips the recovery on the next start
 func (s *Server) Close() {
-	// Close the blockchain layer
-	if err := s.blockchain.Close(); err != nil {
-		s.logger.Error("failed to close blockchain", "err", err.Error())
+	// Close the consensus layer
+	clean := s.closeConsensus()
+
+	// Stop state sync relayer
+	if s.stateSyncRelayer != nil {
+		s.stateSyncRelayer.Stop()
 	}
 
-	// Close the networking layer
+	// Close the txpool's main loop
+	s.txpool.Close()
+
+	// Close the networking layer, once nothing is gossiped anymore
 	if err := s.network.Close(); err != nil {
 		s.logger.Error("failed to close networking", "err", err.Error())
 	}
 
-	// Close the consensus layer
-	if err := s.consensus.Close(); err != nil {
-		s.logger.Error("failed to close consensus", "err", err.Error())
+	head := s.blockchain.Header()
+
+	// Close the blockchain layer
+	if err := s.blockchain.Close(); err != nil {
+		s.logger.Error("failed to close blockchain", "err", err.Error())
+
+		clean = false
+	}
+
+	// Persist the flat state before closing the state storage
+	if st, ok := s.state.(*itrie.State); ok {
+		st.CloseFlatState()
 	}
 
 	// Close the state storage
 	if err := s.stateStorage.Close(); err != nil {
 		s.logger.Error("failed to close storage for trie", "err", err.Error())
+
+		clean = false
+	}
+
+	if clean && s.config.DataDir != "" && head != nil {
+		if err := writeShutdownMarker(s.config.DataDir, head); err != nil {
+			s.logger.Error("failed to write the clean shutdown marker", "err", err)
+		}
 	}
 
 	if s.prometheusServer != nil {
@@ -978,13 +1588,28 @@ func (s *Server) Close() {
 		}
 	}
 
-	// Stop state sync relayer
-	if s.stateSyncRelayer != nil {
-		s.stateSyncRelayer.Stop()
+	if s.healthServer != nil {
+		if err := s.healthServer.Shutdown(context.Background()); err != nil {
+			s.logger.Error("Health server shutdown error", "err", err)
+		}
 	}
 
-	// Close the txpool's main loop
-	s.txpool.Close()
+	if s.faucetServer != nil {
+		if err := s.faucetServer.Shutdown(context.Background()); err != nil {
+			s.logger.Error("Faucet server shutdown error", "err", err)
+		}
+	}
+
+	if s.telemetryReporter != nil {
+		s.telemetryReporter.Close()
+	}
+
+	// Flush the pending spans and stop the tracer provider
+	if s.tracerProvider != nil {
+		if err := s.tracerProvider.Shutdown(context.Background()); err != nil {
+			s.logger.Error("Tracer provider shutdown error", "err", err)
+		}
+	}
 
 	// Close DataDog profiler
 	s.closeDataDogProfiler()
@@ -997,20 +1622,26 @@ type Entry struct {
 }
 
 func (s *Server) startPrometheusServer(listenAddr *net.TCPAddr) *http.Server {
-	srv := &http.Server{
-		Addr: listenAddr.String(),
-		Handler: promhttp.InstrumentMetricHandler(
-			prometheus.DefaultRegisterer, promhttp.HandlerFor(
-				prometheus.DefaultGatherer,
-				promhttp.HandlerOpts{},
-			),
+	mux := http.NewServeMux()
+	mux.Handle("/", promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer, promhttp.HandlerFor(
+			prometheus.DefaultGatherer,
+			promhttp.HandlerOpts{},
 		),
+	))
+	mux.Handle(logLevelsHTTPPath, s.logLevels)
+
+	srv := &http.Server{
+		Addr:              listenAddr.String(),
+		Handler:           mux,
 		ReadHeaderTimeout: 60 * time.Second,
 	}
 
 	s.logger.Info("Prometheus server started", "addr=", listenAddr.String())
 
 	go func() {
+		defer s.RecoverPanic()
+
 		if err := srv.ListenAndServe(); err != nil {
 			if !errors.Is(err, http.ErrServerClosed) {
 				s.logger.Error("Prometheus HTTP server ListenAndServe", "err", err)
@@ -1021,6 +1652,105 @@ func (s *Server) startPrometheusServer(listenAddr *net.TCPAddr) *http.Server {
 	return srv
 }
 
+// healthHub provides the node state to the health checks
+type healthHub struct {
+	*blockchain.Blockchain
+
+	network            *network.Server
+	consensus          consensus.Consensus
+	restoreProgression *progress.ProgressionWrapper
+	seal               bool
+}
+
+func (h *healthHub) GetSyncProgression() *progress.Progression {
+	if restoreProg := h.restoreProgression.GetProgression(); restoreProg != nil {
+		return restoreProg
+	}
+
+	return h.consensus.GetSyncProgression()
+}
+
+func (h *healthHub) PeerCount() int {
+	return len(h.network.Peers())
+}
+
+func (h *healthHub) IsSealing() bool {
+	return h.seal
+}
+
+func (s *Server) startHealthServer(config *health.Config) *http.Server {
+	checker := health.NewChecker(config, &healthHub{
+		Blockchain:         s.blockchain,
+		network:            s.network,
+		consensus:          s.consensus,
+		restoreProgression: s.restoreProgression,
+		seal:               s.config.Seal,
+	})
+
+	srv := &http.Server{
+		Addr:              config.Addr.String(),
+		Handler:           checker.Handler(),
+		ReadHeaderTimeout: 60 * time.Second,
+	}
+
+	s.logger.Info("Health server started", "addr", config.Addr.String())
+
+	go func() {
+		defer s.RecoverPanic()
+
+		if err := srv.ListenAndServe(); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Error("Health HTTP server ListenAndServe", "err", err)
+			}
+		}
+	}()
+
+	return srv
+}
+
+// faucetHub provides the chain state and the txpool to the faucet
+type faucetHub struct {
+	*blockchain.Blockchain
+	*txpool.TxPool
+
+	executor *state.Executor
+}
+
+func (f *faucetHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
+	return f.executor.GetForksInTime(blockNumber)
+}
+
+func (s *Server) startFaucetServer(config *faucet.Config) (*http.Server, error) {
+	tokenFaucet, err := faucet.NewFaucet(config, &faucetHub{
+		Blockchain: s.blockchain,
+		TxPool:     s.txpool,
+		executor:   s.executor,
+	}, uint64(s.config.Chain.Params.ChainID), s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &http.Server{
+		Addr:              config.Addr.String(),
+		Handler:           tokenFaucet.Handler(),
+		ReadHeaderTimeout: 60 * time.Second,
+	}
+
+	s.logger.Info("Faucet server started", "addr", config.Addr.String())
+
+	go func() {
+		defer s.RecoverPanic()
+
+		if err := srv.ListenAndServe(); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Error("Faucet HTTP server ListenAndServe", "err", err)
+			}
+		}
+	}()
+
+	return srv, nil
+}
+
 func initForkManager(engineName string, config *chain.Chain) error {
 	var initialParams *forkmanager.ForkParams
 
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
@@ -32,6 +34,27 @@ func (s *Server) setupTelemetry() error {
 	return err
 }
 
+// setupTracing sets up the export of the OpenTelemetry spans to the configured OTLP endpoint
+func (s *Server) setupTracing() error {
+	provider, err := tracing.NewTracerProvider(
+		context.Background(),
+		s.config.Telemetry.TracingEndpoint,
+		s.config.Telemetry.TracingSampleRate,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+
+	s.tracerProvider = provider
+
+	s.logger.Info("Tracing enabled",
+		"endpoint", s.config.Telemetry.TracingEndpoint,
+		"sample_rate", s.config.Telemetry.TracingSampleRate,
+	)
+
+	return nil
+}
+
 // enableDataDogProfiler enables DataDog profiler. Enable it by setting DD_ENABLE env var.
 // Additional parameters can be set with env vars (DD_) - https://docs.datadoghq.com/profiler/enabling/go/
 func (s *Server) enableDataDogProfiler() error {
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// cleanShutdownFileName is the name of the data dir file marking the node was shut down cleanly
+	cleanShutdownFileName = "clean-shutdown.json"
+
+	// maxRecoveryDepth is the maximal number of blocks the head is rewound by on the recovery
+	// from the unclean shutdown, looking for the latest block with the available state
+	maxRecoveryDepth = 128
+)
+
+// shutdownMarker is the clean shutdown marker, holding the chain head at the time of the shutdown
+type shutdownMarker struct {
+	Time       time.Time  `json:"time"`
+	HeadNumber uint64     `json:"headNumber"`
+	HeadHash   types.Hash `json:"headHash"`
+}
+
+// writeShutdownMarker writes the clean shutdown marker of the head to the data dir
+func writeShutdownMarker(dataDir string, head *types.Header) error {
+	content, err := json.Marshal(&shutdownMarker{
+		Time:       time.Now().UTC(),
+		HeadNumber: head.Number,
+		HeadHash:   head.Hash,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dataDir, cleanShutdownFileName), content, 0600)
+}
+
+// consumeShutdownMarker reads and removes the clean shutdown marker from the data dir,
+// so the marker of the previous run is never reused. It returns nil if there is no marker
+func consumeShutdownMarker(dataDir string) (*shutdownMarker, error) {
+	path := filepath.Join(dataDir, cleanShutdownFileName)
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := os.Remove(path); err != nil {
+		return nil, err
+	}
+
+	marker := &shutdownMarker{}
+	if err := json.Unmarshal(content, marker); err != nil {
+		return nil, fmt.Errorf("invalid clean shutdown marker: %w", err)
+	}
+
+	return marker, nil
+}
+
+// recoverUncleanShutdown checks the node was shut down cleanly at the current head. Otherwise, the head
+// may have been written without its state, so the head is rewound to the latest block with the available state
+func (s *Server) recoverUncleanShutdown() error {
+	if s.config.DataDir == "" {
+		return nil
+	}
+
+	head := s.blockchain.Header()
+
+	marker, err := consumeShutdownMarker(s.config.DataDir)
+	if err != nil {
+		s.logger.Warn("failed to read the clean shutdown marker", "err", err)
+	} else if marker != nil && marker.HeadHash == head.Hash {
+		s.logger.Debug("clean shutdown detected, skipping the recovery", "head", head.Number, "at", marker.Time)
+
+		return nil
+	}
+
+	s.logger.Info("no clean shutdown detected, checking the state of the head", "head", head.Number)
+
+	header := head
+
+	for {
+		if _, err := s.state.NewSnapshotAt(header.StateRoot); err == nil {
+			break
+		}
+
+		if header.Number == 0 || head.Number-header.Number >= maxRecoveryDepth {
+			return fmt.Errorf("state of the head %d not found within %d blocks", head.Number, maxRecoveryDepth)
+		}
+
+		parent, ok := s.blockchain.GetHeaderByHash(header.ParentHash)
+		if !ok {
+			return fmt.Errorf("header '%s' not found", header.ParentHash)
+		}
+
+		header = parent
+	}
+
+	if header.Number == head.Number {
+		return nil
+	}
+
+	s.logger.Warn("state of the head not found, rewinding the head",
+		"head", head.Number, "rewind to", header.Number, "hash", header.Hash)
+
+	return s.blockchain.Rewind(header.Hash)
+}
+
+// closeConsensus closes the consensus, waiting for the in-flight block sealing for at most the shutdown timeout.
+// It returns false if the consensus failed to close or the timeout expired
+func (s *Server) closeConsensus() bool {
+	doneCh := make(chan error, 1)
+
+	go func() {
+		doneCh <- s.consensus.Close()
+	}()
+
+	var timeoutCh <-chan time.Time
+
+	if s.config.ShutdownTimeout > 0 {
+		timer := time.NewTimer(s.config.ShutdownTimeout)
+		defer timer.Stop()
+
+		timeoutCh = timer.C
+	}
+
+	select {
+	case err := <-doneCh:
+		if err != nil {
+			s.logger.Error("failed to close consensus", "err", err.Error())
+
+			return false
+		}
+
+		return true
+	case <-timeoutCh:
+		s.logger.Warn("timed out waiting for the block sealing to finish", "timeout", s.config.ShutdownTimeout)
+
+		return false
+	}
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdownMarker(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+
+	// no marker is written
+	marker, err := consumeShutdownMarker(dataDir)
+	require.NoError(t, err)
+	require.Nil(t, marker)
+
+	head := &types.Header{Number: 10, Hash: types.StringToHash("0x1")}
+	require.NoError(t, writeShutdownMarker(dataDir, head))
+
+	marker, err = consumeShutdownMarker(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, head.Number, marker.HeadNumber)
+	require.Equal(t, head.Hash, marker.HeadHash)
+
+	// the marker is consumed
+	_, err = os.Stat(filepath.Join(dataDir, cleanShutdownFileName))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	marker, err = consumeShutdownMarker(dataDir)
+	require.NoError(t, err)
+	require.Nil(t, marker)
+}
+
+// closingConsensus is the consensus taking the delay to close
+type closingConsensus struct {
+	consensus.Consensus
+	delay time.Duration
+}
+
+func (c *closingConsensus) Close() error {
+	time.Sleep(c.delay)
+
+	return nil
+}
+
+func TestServer_CloseConsensus(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name    string
+		delay   time.Duration
+		timeout time.Duration
+		clean   bool
+	}{
+		{"closed in time", 0, time.Second, true},
+		{"timed out", time.Second, 10 * time.Millisecond, false},
+		{"no timeout", 10 * time.Millisecond, 0, true},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Server{
+				logger:    hclog.NewNullLogger(),
+				config:    &Config{ShutdownTimeout: testCase.timeout},
+				consensus: &closingConsensus{delay: testCase.delay},
+			}
+
+			require.Equal(t, testCase.clean, s.closeConsensus())
+		})
+	}
+}
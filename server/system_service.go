@@ -5,9 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/0xPolygon/polygon-edge/network/event"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -88,6 +92,89 @@ func (s *systemService) Subscribe(req *empty.Empty, stream proto.System_Subscrib
 	return nil
 }
 
+// SubscribeReorgs implements the operator endpoint streaming chain reorganization events
+func (s *systemService) SubscribeReorgs(_ *empty.Empty, stream proto.System_SubscribeReorgsServer) error {
+	sub := s.server.blockchain.SubscribeEvents()
+	defer sub.Close()
+
+	for {
+		evnt := sub.GetEvent()
+		if evnt == nil {
+			return nil
+		}
+
+		if evnt.Reorg == nil {
+			continue
+		}
+
+		droppedTxs := make([]string, len(evnt.Reorg.DroppedTxs))
+		for i, hash := range evnt.Reorg.DroppedTxs {
+			droppedTxs[i] = hash.String()
+		}
+
+		pEvent := &proto.ReorgEvent{
+			OldHead: &proto.BlockchainEvent_Header{
+				Hash:   evnt.Reorg.OldHead.Hash.String(),
+				Number: int64(evnt.Reorg.OldHead.Number),
+			},
+			NewHead: &proto.BlockchainEvent_Header{
+				Hash:   evnt.Reorg.NewHead.Hash.String(),
+				Number: int64(evnt.Reorg.NewHead.Number),
+			},
+			Depth:      evnt.Reorg.Depth,
+			DroppedTxs: droppedTxs,
+		}
+
+		if err := stream.Send(pEvent); err != nil {
+			return nil
+		}
+	}
+}
+
+// peerEventTypes maps the network peer events to the streamed ones.
+// Other network events are internal to the networking server
+var peerEventTypes = map[event.PeerEventType]proto.PeerEvent_Type{
+	event.PeerConnected:       proto.PeerEvent_CONNECTED,
+	event.PeerDisconnected:    proto.PeerEvent_DISCONNECTED,
+	event.PeerBanned:          proto.PeerEvent_BANNED,
+	event.PeerFailedToConnect: proto.PeerEvent_DIAL_FAILED,
+}
+
+// SubscribePeerEvents implements the operator endpoint streaming peer connection events
+func (s *systemService) SubscribePeerEvents(_ *empty.Empty, stream proto.System_SubscribePeerEventsServer) error {
+	ctx, cancelFn := context.WithCancel(stream.Context())
+	defer cancelFn()
+
+	eventCh, err := s.server.network.SubscribeCh(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evnt, ok := <-eventCh:
+			if !ok {
+				return nil
+			}
+
+			eventType, ok := peerEventTypes[evnt.Type]
+			if !ok {
+				continue
+			}
+
+			if err := stream.Send(&proto.PeerEvent{
+				Id:     evnt.PeerID.String(),
+				Type:   eventType,
+				Reason: evnt.Reason,
+			}); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
 // PeersAdd implements the 'peers add' operator service
 func (s *systemService) PeersAdd(_ context.Context, req *proto.PeersAddRequest) (*proto.PeersAddResponse, error) {
 	if joinErr := s.server.JoinPeer(req.Id); joinErr != nil {
@@ -176,6 +263,178 @@ func (s *systemService) BlockByNumber(
 	}, nil
 }
 
+// BlockGasTargetGet implements the 'block-gas-target get' operator service
+func (s *systemService) BlockGasTargetGet(
+	ctx context.Context,
+	req *empty.Empty,
+) (*proto.BlockGasTargetResponse, error) {
+	return s.getBlockGasTargetResponse(), nil
+}
+
+// BlockGasTargetSet implements the 'block-gas-target set' operator service
+func (s *systemService) BlockGasTargetSet(
+	ctx context.Context,
+	req *proto.BlockGasTargetSetRequest,
+) (*proto.BlockGasTargetResponse, error) {
+	s.server.blockchain.SetBlockGasTarget(req.Target)
+
+	return s.getBlockGasTargetResponse(), nil
+}
+
+// getBlockGasTargetResponse returns the current block gas target and the gas limit of the latest block
+func (s *systemService) getBlockGasTargetResponse() *proto.BlockGasTargetResponse {
+	return &proto.BlockGasTargetResponse{
+		Target:   s.server.blockchain.BlockGasTarget(),
+		GasLimit: s.server.blockchain.Header().GasLimit,
+	}
+}
+
+// LogLevelGet implements the 'log-level get' operator service
+func (s *systemService) LogLevelGet(
+	ctx context.Context,
+	req *empty.Empty,
+) (*proto.LogLevelResponse, error) {
+	return s.getLogLevelResponse(), nil
+}
+
+// LogLevelSet implements the 'log-level set' operator service
+func (s *systemService) LogLevelSet(
+	ctx context.Context,
+	req *proto.LogLevelSetRequest,
+) (*proto.LogLevelResponse, error) {
+	if err := s.server.logLevels.set(req.Module, req.Level); err != nil {
+		return nil, err
+	}
+
+	s.server.logger.Info("log level updated", "module", req.Module, "level", req.Level)
+
+	return s.getLogLevelResponse(), nil
+}
+
+// getLogLevelResponse returns the default log level and the log levels of the modules
+func (s *systemService) getLogLevelResponse() *proto.LogLevelResponse {
+	level, modules := s.server.logLevels.get()
+
+	resp := &proto.LogLevelResponse{
+		Level:   level.String(),
+		Modules: make(map[string]string, len(modules)),
+	}
+
+	for module, moduleLevel := range modules {
+		resp.Modules[module] = moduleLevel.String()
+	}
+
+	return resp
+}
+
+// ApiKeyList implements the 'api-key list' operator service
+func (s *systemService) ApiKeyList(
+	ctx context.Context,
+	req *empty.Empty,
+) (*proto.ApiKeyListResponse, error) {
+	keys := s.server.apiKeys.Keys()
+
+	resp := &proto.ApiKeyListResponse{
+		Keys: make([]*proto.ApiKey, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, toProtoAPIKey(key))
+	}
+
+	return resp, nil
+}
+
+// ApiKeySet implements the 'api-key set' operator service
+func (s *systemService) ApiKeySet(
+	ctx context.Context,
+	req *proto.ApiKey,
+) (*proto.ApiKey, error) {
+	err := s.server.apiKeys.SetKey(&jsonrpc.APIKey{
+		Key:       req.Key,
+		Name:      req.Name,
+		Methods:   req.Methods,
+		RateLimit: req.RateLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.server.logger.Info("api key set", "name", req.Name, "methods", req.Methods, "rateLimit", req.RateLimit)
+
+	key, ok := s.server.apiKeys.Key(req.Key)
+	if !ok {
+		// removed in the meantime
+		return nil, fmt.Errorf("api key %s not found", req.Name)
+	}
+
+	return toProtoAPIKey(key), nil
+}
+
+// ApiKeyRemove implements the 'api-key remove' operator service
+func (s *systemService) ApiKeyRemove(
+	ctx context.Context,
+	req *proto.ApiKeyRemoveRequest,
+) (*proto.ApiKeyRemoveResponse, error) {
+	removed := s.server.apiKeys.RemoveKey(req.Key)
+	if removed {
+		s.server.logger.Info("api key removed")
+	}
+
+	return &proto.ApiKeyRemoveResponse{Removed: removed}, nil
+}
+
+// SyncProgress implements the 'status' operator service reporting the detailed progress of the ongoing sync
+func (s *systemService) SyncProgress(
+	ctx context.Context,
+	req *empty.Empty,
+) (*proto.SyncProgressResponse, error) {
+	prog := s.server.restoreProgression.GetProgression()
+	if prog == nil {
+		prog = s.server.consensus.GetSyncProgression()
+	}
+
+	return toProtoSyncProgress(prog), nil
+}
+
+// toProtoSyncProgress converts the sync progression to its proto representation, nil if the node isn't syncing
+func toProtoSyncProgress(prog *progress.Progression) *proto.SyncProgressResponse {
+	if prog == nil {
+		return &proto.SyncProgressResponse{}
+	}
+
+	resp := &proto.SyncProgressResponse{
+		Syncing:         true,
+		Type:            string(prog.SyncType),
+		Phase:           string(prog.Phase),
+		StartingBlock:   prog.StartingBlock,
+		CurrentBlock:    prog.CurrentBlock,
+		HighestBlock:    prog.HighestBlock,
+		BlocksPerSecond: prog.BlocksPerSecond,
+		EtaSeconds:      uint64(prog.ETA / time.Second),
+		Peers:           make([]*proto.SyncProgressPeer, 0, len(prog.Peers)),
+		DownloadedBytes: prog.DownloadedBytes,
+	}
+
+	for _, syncPeer := range prog.Peers {
+		resp.Peers = append(resp.Peers, &proto.SyncProgressPeer{Id: syncPeer.ID, Number: syncPeer.Number})
+	}
+
+	return resp
+}
+
+// toProtoAPIKey converts the API key status to its proto representation
+func toProtoAPIKey(key *jsonrpc.APIKeyStatus) *proto.ApiKey {
+	return &proto.ApiKey{
+		Key:       key.Key,
+		Name:      key.Name,
+		Methods:   key.Methods,
+		RateLimit: key.RateLimit,
+		Requests:  key.Requests,
+		Rejected:  key.Rejected,
+	}
+}
+
 func (s *systemService) Export(req *proto.ExportRequest, stream proto.System_ExportServer) error {
 	var (
 		from uint64 = 0
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToProtoSyncProgress(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, &proto.SyncProgressResponse{}, toProtoSyncProgress(nil))
+
+	resp := toProtoSyncProgress(&progress.Progression{
+		SyncType:        progress.ChainSyncBulk,
+		StartingBlock:   10,
+		CurrentBlock:    110,
+		HighestBlock:    1010,
+		Phase:           progress.SyncPhaseBodies,
+		BlocksPerSecond: 12.5,
+		ETA:             72*time.Second + 500*time.Millisecond,
+		Peers:           []progress.SyncPeer{{ID: "A", Number: 1010}, {ID: "B", Number: 900}},
+		DownloadedBytes: 4096,
+	})
+
+	assert.Equal(t, &proto.SyncProgressResponse{
+		Syncing:         true,
+		Type:            "bulk-sync",
+		Phase:           "bodies",
+		StartingBlock:   10,
+		CurrentBlock:    110,
+		HighestBlock:    1010,
+		BlocksPerSecond: 12.5,
+		EtaSeconds:      72,
+		Peers:           []*proto.SyncProgressPeer{{Id: "A", Number: 1010}, {Id: "B", Number: 900}},
+		DownloadedBytes: 4096,
+	}, resp)
+}
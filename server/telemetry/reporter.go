@@ -0,0 +1,206 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// DefaultReportInterval is the default interval between two reports
+	DefaultReportInterval = 5 * time.Minute
+
+	// reportTimeout is the timeout of a single report request
+	reportTimeout = 10 * time.Second
+)
+
+var (
+	errInvalidEndpoint = errors.New("telemetry endpoint must be an absolute http(s) URL")
+	errInvalidInterval = errors.New("telemetry report interval must be greater than zero")
+)
+
+// Config is the configuration of the telemetry reporter
+type Config struct {
+	// Endpoint is the URL the reports are pushed to, the reporting is disabled if empty
+	Endpoint string
+
+	// Interval is the interval between two reports
+	Interval time.Duration
+}
+
+// Validate validates the reporter configuration
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return nil
+	}
+
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil || !endpoint.IsAbs() || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return errInvalidEndpoint
+	}
+
+	if c.Interval <= 0 {
+		return errInvalidInterval
+	}
+
+	return nil
+}
+
+// Backend provides the node state the reports are built from
+type Backend interface {
+	// Header returns the current head
+	Header() *types.Header
+
+	// PeerCount returns the number of connected peers
+	PeerCount() int
+}
+
+// Report is the anonymized node health report. It doesn't contain any data
+// which identifies the node (addresses, keys, peer ids), the session id is generated
+// randomly on each start, so that the reports of the same run can be grouped
+type Report struct {
+	SessionID  string    `json:"sessionId"`
+	ChainID    int64     `json:"chainId"`
+	Version    string    `json:"version"`
+	HeadHeight uint64    `json:"headHeight"`
+	PeerCount  int       `json:"peerCount"`
+	OS         string    `json:"os"`
+	Arch       string    `json:"arch"`
+	Time       time.Time `json:"time"`
+}
+
+// Reporter periodically pushes the node health reports to the configured endpoint
+type Reporter struct {
+	config  *Config
+	backend Backend
+	logger  hclog.Logger
+	client  *http.Client
+
+	sessionID string
+	chainID   int64
+	version   string
+
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewReporter creates the telemetry reporter
+func NewReporter(config *Config, backend Backend, chainID int64, version string,
+	logger hclog.Logger) (*Reporter, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	sessionID := make([]byte, 16)
+	if _, err := rand.Read(sessionID); err != nil {
+		return nil, fmt.Errorf("failed to generate session id: %w", err)
+	}
+
+	return &Reporter{
+		config:    config,
+		backend:   backend,
+		logger:    logger.Named("telemetry"),
+		client:    &http.Client{Timeout: reportTimeout},
+		sessionID: hex.EncodeToString(sessionID),
+		chainID:   chainID,
+		version:   version,
+		closeCh:   make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}, nil
+}
+
+// Start starts pushing the reports, the first one is pushed immediately
+func (r *Reporter) Start() {
+	r.logger.Info("telemetry reporting enabled", "endpoint", r.config.Endpoint, "interval", r.config.Interval)
+
+	go r.run()
+}
+
+// Close stops pushing the reports
+func (r *Reporter) Close() {
+	close(r.closeCh)
+	<-r.doneCh
+}
+
+func (r *Reporter) run() {
+	defer close(r.doneCh)
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.push(r.buildReport()); err != nil {
+			r.logger.Debug("failed to push telemetry report", "err", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-r.closeCh:
+			return
+		}
+	}
+}
+
+// buildReport builds the report from the current node state
+func (r *Reporter) buildReport() *Report {
+	report := &Report{
+		SessionID: r.sessionID,
+		ChainID:   r.chainID,
+		Version:   r.version,
+		PeerCount: r.backend.PeerCount(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		Time:      time.Now().UTC(),
+	}
+
+	if head := r.backend.Header(); head != nil {
+		report.HeadHeight = head.Number
+	}
+
+	return report
+}
+
+// push sends the report to the endpoint as JSON
+func (r *Reporter) push(report *Report) error {
+	raw, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), reportTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.config.Endpoint, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return nil
+}
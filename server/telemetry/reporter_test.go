@@ -0,0 +1,118 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBackend struct {
+	head  *types.Header
+	peers int
+}
+
+func (m *mockBackend) Header() *types.Header {
+	return m.head
+}
+
+func (m *mockBackend) PeerCount() int {
+	return m.peers
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		config *Config
+		err    error
+	}{
+		{"disabled", &Config{}, nil},
+		{"valid", &Config{Endpoint: "https://telemetry.example.com/report", Interval: time.Minute}, nil},
+		{"relative endpoint", &Config{Endpoint: "telemetry.example.com", Interval: time.Minute}, errInvalidEndpoint},
+		{"invalid scheme", &Config{Endpoint: "ftp://telemetry.example.com", Interval: time.Minute}, errInvalidEndpoint},
+		{"invalid interval", &Config{Endpoint: "https://telemetry.example.com"}, errInvalidInterval},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, c.config.Validate(), c.err)
+		})
+	}
+}
+
+func TestReporter_Push(t *testing.T) {
+	t.Parallel()
+
+	reportsCh := make(chan *Report, 2)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		report := &Report{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(report))
+
+		reportsCh <- report
+	}))
+	defer srv.Close()
+
+	backend := &mockBackend{head: &types.Header{Number: 42}, peers: 3}
+
+	reporter, err := NewReporter(&Config{Endpoint: srv.URL, Interval: 50 * time.Millisecond},
+		backend, 100, "v1.0.0", hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	reporter.Start()
+	defer reporter.Close()
+
+	var reports []*Report
+
+	for len(reports) < 2 {
+		select {
+		case report := <-reportsCh:
+			reports = append(reports, report)
+		case <-time.After(5 * time.Second):
+			t.Fatal("report not pushed")
+		}
+	}
+
+	for _, report := range reports {
+		require.Len(t, report.SessionID, 32)
+		require.Equal(t, reports[0].SessionID, report.SessionID)
+		require.Equal(t, int64(100), report.ChainID)
+		require.Equal(t, "v1.0.0", report.Version)
+		require.Equal(t, uint64(42), report.HeadHeight)
+		require.Equal(t, 3, report.PeerCount)
+		require.Equal(t, runtime.GOOS, report.OS)
+		require.Equal(t, runtime.GOARCH, report.Arch)
+	}
+}
+
+func TestReporter_PushFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	reporter, err := NewReporter(&Config{Endpoint: srv.URL, Interval: time.Minute},
+		&mockBackend{}, 100, "v1.0.0", hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	report := reporter.buildReport()
+	require.Zero(t, report.HeadHeight)
+	require.ErrorContains(t, reporter.push(report), "unexpected response status")
+}
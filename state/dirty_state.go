@@ -0,0 +1,159 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// DefaultDirtyStateLimit is the default estimated size (in bytes) of the transient state
+	// after which the state is flushed to the trie during the block execution
+	DefaultDirtyStateLimit = 512 * 1024 * 1024
+
+	// dirtyAccountSize is the estimated memory held by a single account of the transient state
+	dirtyAccountSize = 256
+	// dirtySlotSize is the estimated memory held by a single storage slot of the transient state
+	dirtySlotSize = 192
+)
+
+// committedRoot maps the storage root of the flushed account to its root at the beginning of the block
+type committedRoot struct {
+	flushed   types.Hash
+	committed types.Hash
+}
+
+// DirtySize returns the estimated memory held by the transient state.
+// The estimation only grows until the state is committed, so the writes
+// reverted during the execution are still accounted for
+func (txn *Txn) DirtySize() uint64 {
+	return txn.dirtySize
+}
+
+// committedRoot returns the storage root the account had at the beginning of the block.
+// It differs from the given root only if the account was flushed to the trie in the meantime
+func (txn *Txn) committedRoot(addr types.Address, root types.Hash) types.Hash {
+	if r, ok := txn.committedRoots[addr]; ok && r.flushed == root {
+		return r.committed
+	}
+
+	return root
+}
+
+// flushedCommittedRoots returns the committed roots of the accounts after the objects are flushed to the snapshot
+func (txn *Txn) flushedCommittedRoots(objs []*Object, snap readSnapshot) map[types.Address]*committedRoot {
+	roots := make(map[types.Address]*committedRoot, len(txn.committedRoots)+len(objs))
+	for addr, root := range txn.committedRoots {
+		roots[addr] = root
+	}
+
+	for _, obj := range objs {
+		delete(roots, obj.Address)
+
+		if obj.Deleted {
+			continue
+		}
+
+		account, err := snap.GetAccount(obj.Address)
+		if err != nil || account == nil {
+			continue
+		}
+
+		committed := txn.committedRoot(obj.Address, obj.Root)
+		if account.Root != committed {
+			roots[obj.Address] = &committedRoot{flushed: account.Root, committed: committed}
+		}
+	}
+
+	return roots
+}
+
+// flushDirtyState commits the transient state to the trie and continues the block execution
+// on top of the committed snapshot, so the memory held by the transient state is released.
+// The final state root and the gas used by the transactions are not affected
+func (t *Transition) flushDirtyState() error {
+	dirtySize := t.state.DirtySize()
+
+	objs, err := t.state.Commit(t.config.EIP155)
+	if err != nil {
+		return fmt.Errorf("failed to commit the dirty state: %w", err)
+	}
+
+	snap, root := t.snap.Commit(objs)
+
+	flushed := NewTxn(snap)
+	flushed.committedRoots = t.state.flushedCommittedRoots(objs, snap)
+
+	t.snap = snap
+	t.state = flushed
+	t.flushedObjects = mergeObjects(t.flushedObjects, objs)
+
+	if t.logger != nil {
+		t.logger.Debug("dirty state flushed", "block", t.ctx.Number, "size", dirtySize,
+			"objects", len(objs), "root", types.BytesToHash(root))
+	}
+
+	return nil
+}
+
+// mergeObjects merges the objects committed by a later flush into the objects committed before,
+// so they describe all the changes since the beginning of the block
+func mergeObjects(prev, next []*Object) []*Object {
+	if len(prev) == 0 {
+		return next
+	}
+
+	merged := make([]*Object, len(prev), len(prev)+len(next))
+	index := make(map[types.Address]int, len(prev))
+
+	for i, obj := range prev {
+		merged[i] = obj
+		index[obj.Address] = i
+	}
+
+	for _, obj := range next {
+		i, ok := index[obj.Address]
+		if !ok {
+			index[obj.Address] = len(merged)
+			merged = append(merged, obj)
+
+			continue
+		}
+
+		merged[i] = mergeObject(merged[i], obj)
+	}
+
+	return merged
+}
+
+// mergeObject merges the later changes of the account into its earlier ones
+func mergeObject(prev, next *Object) *Object {
+	// the changes before the deletion are irrelevant
+	if next.Deleted || prev.Deleted {
+		return next
+	}
+
+	obj := *next
+
+	if !next.DirtyCode {
+		obj.DirtyCode, obj.Code = prev.DirtyCode, prev.Code
+	}
+
+	obj.Storage = make([]*StorageObject, 0, len(prev.Storage)+len(next.Storage))
+	slots := make(map[string]int, len(prev.Storage)+len(next.Storage))
+
+	for _, entries := range [][]*StorageObject{prev.Storage, next.Storage} {
+		for _, entry := range entries {
+			if i, ok := slots[string(entry.Key)]; ok {
+				obj.Storage[i] = entry
+
+				continue
+			}
+
+			slots[string(entry.Key)] = len(obj.Storage)
+			obj.Storage = append(obj.Storage, entry)
+		}
+	}
+
+	return &obj
+}
@@ -11,21 +11,24 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/crypto"
-	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/messagebridge"
 	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
+	"github.com/0xPolygon/polygon-edge/state/runtime/storagerent"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
 const (
-	SpuriousDragonMaxCodeSize = 24576
-	TxPoolMaxInitCodeSize     = 2 * SpuriousDragonMaxCodeSize
+	SpuriousDragonMaxCodeSize = chain.DefaultMaxCodeSize
+	TxPoolMaxInitCodeSize     = chain.DefaultMaxInitCodeSize
 
-	TxGas                 uint64 = 21000 // Per transaction not creating a contract
-	TxGasContractCreation uint64 = 53000 // Per transaction that creates a contract
+	TxGas                     uint64 = 21000 // Per transaction not creating a contract
+	TxGasContractCreation     uint64 = 53000 // Per transaction that creates a contract
+	TxAccessListAddressGas    uint64 = 2400  // Per address in the transaction access list
+	TxAccessListStorageKeyGas uint64 = 1900  // Per storage key in the transaction access list
 )
 
 // GetHashByNumber returns the hash function of a block number
@@ -42,6 +45,10 @@ type Executor struct {
 
 	PostHook        func(txn *Transition)
 	GenesisPostHook func(*Transition) error
+
+	// DirtyStateLimit is the estimated size of the transient state after which
+	// the state is flushed to the trie during the block execution, zero disables the flushing
+	DirtyStateLimit uint64
 }
 
 // NewExecutor creates a new executor
@@ -86,6 +93,7 @@ func (e *Executor) WriteGenesis(
 		gasPool:     uint64(env.GasLimit),
 		config:      config,
 		precompiles: precompiled.NewPrecompiled(),
+		maxCodeSize: e.config.GetMaxCodeSize(),
 	}
 
 	for addr, account := range alloc {
@@ -157,6 +165,14 @@ func (e *Executor) State() State {
 	return e.state
 }
 
+// WithState returns a copy of the executor which executes the transactions on top of the given state
+func (e *Executor) WithState(s State) *Executor {
+	executor := *e
+	executor.state = s
+
+	return &executor
+}
+
 // StateAt returns snapshot at given root
 func (e *Executor) StateAt(root types.Hash) (Snapshot, error) {
 	return e.state.NewSnapshotAt(root)
@@ -167,6 +183,21 @@ func (e *Executor) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return e.config.Forks.At(blockNumber)
 }
 
+// BurnContract returns the contract the base fee is credited to at the given block height,
+// the zero address if the london hardfork is not enabled
+func (e *Executor) BurnContract(blockNumber uint64) (types.Address, error) {
+	if !e.config.Forks.IsActive(chain.London, blockNumber) {
+		return types.ZeroAddress, nil
+	}
+
+	return e.config.CalculateBurnContract(blockNumber)
+}
+
+// BurnContractDestination returns the address the burn contract withdraws the burned base fee to
+func (e *Executor) BurnContractDestination() types.Address {
+	return e.config.BurnContractDestinationAddress
+}
+
 func (e *Executor) BeginTxn(
 	parentRoot types.Hash,
 	header *types.Header,
@@ -179,12 +210,9 @@ func (e *Executor) BeginTxn(
 		return nil, err
 	}
 
-	burnContract := types.ZeroAddress
-	if forkConfig.London {
-		burnContract, err = e.config.CalculateBurnContract(header.Number)
-		if err != nil {
-			return nil, err
-		}
+	burnContract, err := e.BurnContract(header.Number)
+	if err != nil {
+		return nil, err
 	}
 
 	newTxn := NewTxn(auxSnap2)
@@ -216,6 +244,11 @@ func (e *Executor) BeginTxn(
 		evm:         evm.NewEVM(),
 		precompiles: precompiled.NewPrecompiled(),
 		PostHook:    e.PostHook,
+
+		dirtyStateLimit: e.DirtyStateLimit,
+
+		maxCodeSize:     e.config.GetMaxCodeSize(),
+		maxInitCodeSize: e.config.MaxInitCodeSize,
 	}
 
 	// enable contract deployment allow list (if any)
@@ -245,6 +278,21 @@ func (e *Executor) BeginTxn(
 		txn.bridgeBlockList = addresslist.NewAddressList(txn, contracts.BlockListBridgeAddr)
 	}
 
+	// enable storage rent (if any)
+	if forkConfig.StorageRent && e.config.StorageRent != nil {
+		txn.storageRent = storagerent.NewStorageRent(txn, contracts.StorageRentAddr, e.config.StorageRent, header.Number)
+	}
+
+	// enable the reserved system addresses (if any)
+	if forkConfig.ReservedAddresses && e.config.ReservedAddresses != nil {
+		txn.reservedAddresses = newReservedAddresses(e.config.ReservedAddresses)
+	}
+
+	// enable the cross-chain messages dispatcher
+	if forkConfig.MessageBridge {
+		txn.messageDispatcher = messagebridge.NewDispatcher(contracts.MessageDispatcherPrecompile)
+	}
+
 	return txn, nil
 }
 
@@ -265,6 +313,9 @@ type Transition struct {
 	receipts []*types.Receipt
 	totalGas uint64
 
+	// contractCreations are the contracts created by the current transaction, including the reverted ones
+	contractCreations []*types.ContractCreation
+
 	PostHook func(t *Transition)
 
 	// runtimes
@@ -278,6 +329,31 @@ type Transition struct {
 	txnBlockList        *addresslist.AddressList
 	bridgeAllowList     *addresslist.AddressList
 	bridgeBlockList     *addresslist.AddressList
+
+	// storage rent runtime
+	storageRent *storagerent.StorageRent
+
+	// cross-chain messages dispatcher runtime
+	messageDispatcher *messagebridge.Dispatcher
+
+	// address ranges reserved for the system contracts (nil if not configured)
+	reservedAddresses *reservedAddresses
+
+	// max size of the deployed contract code
+	maxCodeSize uint64
+	// max size of the contract creation code, zero if not configured in the genesis,
+	// in which case the limit is only enforced by the txpool
+	maxInitCodeSize uint64
+
+	// dirtyStateLimit is the estimated size of the transient state after which it is flushed, see Write
+	dirtyStateLimit uint64
+	// flushedObjects are the objects committed by the flushes of the dirty state, see CommitObjects
+	flushedObjects []*Object
+
+	// static is set during the read-only execution, see ApplyStatic
+	static bool
+	// writeAttempted is set if any call of the read-only execution attempted to modify the state
+	writeAttempted bool
 }
 
 func NewTransition(config chain.ForksInTime, snap Snapshot, radix *Txn) *Transition {
@@ -287,6 +363,7 @@ func NewTransition(config chain.ForksInTime, snap Snapshot, radix *Txn) *Transit
 		snap:        snap,
 		evm:         evm.NewEVM(),
 		precompiles: precompiled.NewPrecompiled(),
+		maxCodeSize: SpuriousDragonMaxCodeSize,
 	}
 }
 
@@ -334,8 +411,15 @@ var emptyFrom = types.Address{}
 func (t *Transition) Write(txn *types.Transaction) error {
 	var err error
 
+	// flush the dirty state of the giant blocks, before it exhausts the memory
+	if t.dirtyStateLimit > 0 && t.state.DirtySize() > t.dirtyStateLimit {
+		if err := t.flushDirtyState(); err != nil {
+			return err
+		}
+	}
+
 	if txn.From == emptyFrom &&
-		(txn.Type == types.LegacyTx || txn.Type == types.DynamicFeeTx) {
+		(txn.Type == types.LegacyTx || txn.Type.HasAccessList()) {
 		// Decrypt the from address
 		signer := crypto.NewSigner(t.config, uint64(t.ctx.ChainID))
 
@@ -348,6 +432,8 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	// Make a local copy and apply the transaction
 	msg := txn.Copy()
 
+	t.contractCreations = t.contractCreations[:0]
+
 	result, e := t.Apply(msg)
 	if e != nil {
 		t.logger.Error("failed to apply tx", "err", e)
@@ -366,6 +452,8 @@ func (t *Transition) Write(txn *types.Transaction) error {
 		GasUsed:           result.GasUsed,
 	}
 
+	receipt.ContractCreations = t.committedContractCreations(txn.Hash)
+
 	// The suicided accounts are set as deleted for the next iteration
 	if err := t.state.CleanDeleteObjects(true); err != nil {
 		return fmt.Errorf("failed to clean deleted objects: %w", err)
@@ -392,14 +480,22 @@ func (t *Transition) Write(txn *types.Transaction) error {
 
 // Commit commits the final result
 func (t *Transition) Commit() (Snapshot, types.Hash, error) {
+	s2, root, _, err := t.CommitObjects()
+
+	return s2, root, err
+}
+
+// CommitObjects commits the final result and returns the committed state objects as well.
+// If the dirty state was flushed during the execution, the objects committed by the flushes are included
+func (t *Transition) CommitObjects() (Snapshot, types.Hash, []*Object, error) {
 	objs, err := t.state.Commit(t.config.EIP155)
 	if err != nil {
-		return nil, types.ZeroHash, err
+		return nil, types.ZeroHash, nil, err
 	}
 
 	s2, root := t.snap.Commit(objs)
 
-	return s2, types.BytesToHash(root), nil
+	return s2, types.BytesToHash(root), mergeObjects(t.flushedObjects, objs), nil
 }
 
 func (t *Transition) subGasPool(amount uint64) error {
@@ -416,6 +512,12 @@ func (t *Transition) addGasPool(amount uint64) {
 	t.gasPool += amount
 }
 
+// ResetGasPool restores the whole block gas limit, so the following transactions are executed
+// as if they were the first in the block. Used to execute the calls on top of the pending state
+func (t *Transition) ResetGasPool() {
+	t.gasPool = uint64(t.ctx.GasLimit)
+}
+
 func (t *Transition) Txn() *Txn {
 	return t.state
 }
@@ -438,6 +540,34 @@ func (t *Transition) Apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	return result, err
 }
 
+// ApplyStatic executes the transaction as the read-only call. The top level call is executed
+// as the STATICCALL, so every attempt to modify the state fails. Since the calls can't modify
+// the state, the journaling (state snapshots and reverts) is skipped, which makes the view calls
+// considerably cheaper. ErrNotReadOnly is returned if the transaction attempted to modify the state,
+// in which case the result may differ from the regular execution. The transition must be discarded afterwards
+func (t *Transition) ApplyStatic(msg *types.Transaction) (*runtime.ExecutionResult, error) {
+	if msg.IsContractCreation() || (msg.Value != nil && msg.Value.Sign() != 0) {
+		return nil, ErrNotReadOnly
+	}
+
+	t.static, t.writeAttempted = true, false
+
+	defer func() {
+		t.static = false
+	}()
+
+	result, err := t.apply(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.writeAttempted {
+		return nil, ErrNotReadOnly
+	}
+
+	return result, nil
+}
+
 // ContextPtr returns reference of context
 // This method is called only by test
 func (t *Transition) ContextPtr() *runtime.TxContext {
@@ -458,7 +588,7 @@ func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
 
 	upfrontGasCost = upfrontGasCost.Mul(upfrontGasCost, factor)
 
-	if err := t.state.SubBalance(msg.From, upfrontGasCost); err != nil {
+	if err := t.state.SubBalance(msg.GasPayer(), upfrontGasCost); err != nil {
 		if errors.Is(err, runtime.ErrNotEnoughFunds) {
 			return ErrNotEnoughFundsForGas
 		}
@@ -469,6 +599,55 @@ func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
 	return nil
 }
 
+// checkSponsor checks that the sponsored transaction is allowed and signed by its sponsor
+// and that the sender is able to pay the value, so the gas of the sponsor isn't spent on the
+// transaction which can't succeed
+func (t *Transition) checkSponsor(msg *types.Transaction) error {
+	if msg.Type != types.SponsoredTx {
+		return nil
+	}
+
+	if !t.config.SponsoredGas {
+		return NewTransitionApplicationError(ErrSponsoredTxNotAllowed, true)
+	}
+
+	if err := crypto.VerifySponsor(msg, uint64(t.ctx.ChainID)); err != nil {
+		return NewTransitionApplicationError(fmt.Errorf("%w: %s", ErrInvalidSponsor, err.Error()), false)
+	}
+
+	if t.state.GetBalance(msg.From).Cmp(msg.Value) < 0 {
+		return NewTransitionApplicationError(ErrNotEnoughFundsForValue, true)
+	}
+
+	return nil
+}
+
+// checkAccessList checks that the access list transaction, or the access list of the other typed
+// transactions, isn't applied before the berlin fork. The access lists used to be dropped
+// before it, so the transactions carrying them would recover a different sender
+func (t *Transition) checkAccessList(msg *types.Transaction) error {
+	if t.config.Berlin || (msg.Type != types.AccessListTx && len(msg.AccessList) == 0) {
+		return nil
+	}
+
+	return ErrAccessListNotAllowed
+}
+
+// checkReservedAddress checks that the user transaction doesn't call the address reserved
+// for the system contracts directly, unless the address is the entry point. The read-only calls
+// can't modify the system contracts, so they are allowed
+func (t *Transition) checkReservedAddress(msg *types.Transaction) error {
+	if t.reservedAddresses == nil || t.static || msg.IsContractCreation() || msg.From == contracts.SystemCaller {
+		return nil
+	}
+
+	if !t.reservedAddresses.callable(*msg.To) {
+		return fmt.Errorf("%w: %s", ErrReservedAddress, msg.To)
+	}
+
+	return nil
+}
+
 func (t *Transition) nonceCheck(msg *types.Transaction) error {
 	nonce := t.state.GetNonce(msg.From)
 
@@ -482,7 +661,7 @@ func (t *Transition) nonceCheck(msg *types.Transaction) error {
 // checkDynamicFees checks correctness of the EIP-1559 feature-related fields.
 // Basically, makes sure gas tip cap and gas fee cap are good.
 func (t *Transition) checkDynamicFees(msg *types.Transaction) error {
-	if msg.Type != types.DynamicFeeTx {
+	if !msg.Type.HasDynamicFees() {
 		return nil
 	}
 
@@ -525,6 +704,24 @@ var (
 	ErrIntrinsicGasOverflow  = fmt.Errorf("overflow in intrinsic gas calculation")
 	ErrNotEnoughIntrinsicGas = fmt.Errorf("not enough gas supplied for intrinsic gas costs")
 
+	// ErrSponsoredTxNotAllowed is returned if the sponsored transaction is applied before the sponsoredGas fork
+	ErrSponsoredTxNotAllowed = errors.New("sponsored transactions are not allowed")
+
+	// ErrAccessListNotAllowed is returned if the transaction carrying the access list is applied before the berlin fork
+	ErrAccessListNotAllowed = errors.New("access lists are not allowed")
+
+	// ErrInvalidSponsor is returned if the sponsored transaction is not signed by its sponsor
+	ErrInvalidSponsor = errors.New("invalid sponsor signature")
+
+	// ErrNotEnoughFundsForValue is returned if the sender of the sponsored transaction can't pay the value
+	ErrNotEnoughFundsForValue = errors.New("not enough funds to cover the value")
+
+	// ErrReservedAddress is returned if the user transaction calls the address reserved for the system contracts
+	ErrReservedAddress = errors.New("address is reserved for the system contracts")
+
+	// ErrNotReadOnly is returned by the read-only execution of the transaction which modifies the state
+	ErrNotReadOnly = errors.New("transaction is not read-only")
+
 	// ErrTipAboveFeeCap is a sanity error to ensure no one is able to specify a
 	// transaction with a tip higher than the total fee cap.
 	ErrTipAboveFeeCap = errors.New("max priority fee per gas higher than max fee per gas")
@@ -551,6 +748,10 @@ func (e *TransitionApplicationError) Error() string {
 	return e.Err.Error()
 }
 
+func (e *TransitionApplicationError) Unwrap() error {
+	return e.Err
+}
+
 func NewTransitionApplicationError(err error, isRecoverable bool) *TransitionApplicationError {
 	return &TransitionApplicationError{
 		Err:           err,
@@ -571,6 +772,10 @@ func NewGasLimitReachedTransitionApplicationError(err error) *GasLimitReachedTra
 func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, error) {
 	var err error
 
+	if txCapturer, ok := t.ctx.Tracer.(tracer.TxCapturer); ok {
+		txCapturer.CaptureTx(msg, t)
+	}
+
 	if msg.Type == types.StateTx {
 		err = checkAndProcessStateTx(msg)
 	} else {
@@ -591,7 +796,7 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	}
 
 	// 4. there is no overflow when calculating intrinsic gas
-	intrinsicGasCost, err := TransactionGasCost(msg, t.config.Homestead, t.config.Istanbul)
+	intrinsicGasCost, err := TransactionGasCost(msg, t.config.Homestead, t.config.Istanbul, t.config.Berlin)
 	if err != nil {
 		return nil, NewTransitionApplicationError(err, false)
 	}
@@ -610,6 +815,10 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	t.ctx.GasPrice = types.BytesToHash(gasPrice.Bytes())
 	t.ctx.Origin = msg.From
 
+	if t.config.Berlin {
+		t.prepareAccessList(msg)
+	}
+
 	var result *runtime.ExecutionResult
 	if msg.IsContractCreation() {
 		result = t.Create2(msg.From, msg.Input, value, gasLeft)
@@ -625,24 +834,15 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		t.ctx.Tracer.TxEnd(result.GasLeft)
 	}
 
-	// Refund the sender
+	// Refund the sender, or the sponsor of the sponsored transaction
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(result.GasLeft), gasPrice)
-	t.state.AddBalance(msg.From, remaining)
-
-	// Spec: https://eips.ethereum.org/EIPS/eip-1559#specification
-	// Define effective tip based on tx type.
-	// We use EIP-1559 fields of the tx if the london hardfork is enabled.
-	// Effective tip became to be either gas tip cap or (gas fee cap - current base fee)
-	effectiveTip := new(big.Int).Set(gasPrice)
-	if t.config.London && msg.Type == types.DynamicFeeTx {
-		effectiveTip = common.BigMin(
-			new(big.Int).Sub(msg.GasFeeCap, t.ctx.BaseFee),
-			new(big.Int).Set(msg.GasTipCap),
-		)
-	}
+	t.state.AddBalance(msg.GasPayer(), remaining)
 
 	// Pay the coinbase fee as a miner reward using the calculated effective tip.
-	coinbaseFee := new(big.Int).Mul(new(big.Int).SetUint64(result.GasUsed), effectiveTip)
+	coinbaseFee := new(big.Int).Mul(
+		new(big.Int).SetUint64(result.GasUsed),
+		effectiveTip(msg, gasPrice, t.ctx.BaseFee, t.config.London),
+	)
 	t.state.AddBalance(t.ctx.Coinbase, coinbaseFee)
 
 	// Burn some amount if the london hardfork is applied.
@@ -658,6 +858,30 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	return result, nil
 }
 
+// prepareAccessList resets the access list and warms up the sender, the recipient, the precompiled contracts
+// and the entries of the transaction access list before the transaction is executed (eip-2929, eip-2930)
+func (t *Transition) prepareAccessList(msg *types.Transaction) {
+	t.state.ClearAccessList()
+
+	t.state.AddAddressToAccessList(msg.From)
+
+	if msg.To != nil {
+		t.state.AddAddressToAccessList(*msg.To)
+	}
+
+	for _, addr := range t.precompiles.ActiveAddresses(&t.config) {
+		t.state.AddAddressToAccessList(addr)
+	}
+
+	for _, tuple := range msg.AccessList {
+		t.state.AddAddressToAccessList(tuple.Address)
+
+		for _, key := range tuple.StorageKeys {
+			t.state.AddSlotToAccessList(tuple.Address, key)
+		}
+	}
+}
+
 func (t *Transition) Create2(
 	caller types.Address,
 	code []byte,
@@ -678,6 +902,7 @@ func (t *Transition) Call2(
 	gas uint64,
 ) *runtime.ExecutionResult {
 	c := runtime.NewContractCall(1, caller, caller, to, value, gas, t.state.GetCode(to), input)
+	c.Static = t.static
 
 	return t.applyCall(c, runtime.Call, t)
 }
@@ -687,6 +912,14 @@ func (t *Transition) run(contract *runtime.Contract, host runtime.Host) *runtime
 		return result
 	}
 
+	if t.storageRent != nil && t.storageRent.Addr() == contract.CodeAddress {
+		return t.storageRent.Run(contract, host, &t.config)
+	}
+
+	if t.messageDispatcher != nil && t.messageDispatcher.Addr() == contract.CodeAddress {
+		return t.messageDispatcher.Run(contract, host, &t.config)
+	}
+
 	// check txns access lists, allow list takes precedence over block list
 	if t.txnAllowList != nil {
 		if contract.Caller != contracts.SystemCaller {
@@ -766,7 +999,14 @@ func (t *Transition) applyCall(
 		}
 	}
 
-	snapshot := t.state.Snapshot()
+	// the read-only calls can only touch the account, which matters only if the account doesn't exist
+	journal := !t.static || !t.state.Exist(c.Address)
+
+	var snapshot int
+	if journal {
+		snapshot = t.state.Snapshot()
+	}
+
 	t.state.TouchAccount(c.Address)
 
 	if callType == runtime.Call {
@@ -784,7 +1024,11 @@ func (t *Transition) applyCall(
 	t.captureCallStart(c, callType)
 
 	result = t.run(c, host)
-	if result.Failed() {
+	if t.static && errors.Is(result.Err, runtime.ErrWriteProtection) {
+		t.writeAttempted = true
+	}
+
+	if result.Failed() && journal {
 		if err := t.state.RevertToSnapshot(snapshot); err != nil {
 			return &runtime.ExecutionResult{
 				GasLeft: c.Gas,
@@ -808,7 +1052,7 @@ func (t *Transition) hasCodeOrNonce(addr types.Address) bool {
 	return codeHash != types.EmptyCodeHash && codeHash != types.ZeroHash
 }
 
-func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtime.ExecutionResult {
+func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) (result *runtime.ExecutionResult) {
 	gasLimit := c.Gas
 
 	if c.Depth > int(1024)+1 {
@@ -818,9 +1062,22 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		}
 	}
 
+	// eip-3860, the creation code above the limit fails the creation
+	if t.maxInitCodeSize != 0 && uint64(len(c.Code)) > t.maxInitCodeSize {
+		return &runtime.ExecutionResult{
+			GasLeft: 0,
+			Err:     runtime.ErrMaxCodeSizeExceeded,
+		}
+	}
+
 	// Increment the nonce of the caller
 	t.state.IncrNonce(c.Caller)
 
+	// the created address stays warm even if the creation fails (eip-2929)
+	if t.config.Berlin {
+		t.state.AddAddressToAccessList(c.Address)
+	}
+
 	// Check if there is a collision and the address already exists
 	if t.hasCodeOrNonce(c.Address) {
 		return &runtime.ExecutionResult{
@@ -829,9 +1086,28 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		}
 	}
 
+	// Only the system calls can deploy the contracts to the reserved system addresses
+	if t.reservedAddresses != nil && c.Caller != contracts.SystemCaller && t.reservedAddresses.reserved(c.Address) {
+		t.logger.Debug(
+			"Failing contract deployment. The address is reserved for the system contracts",
+			"contract.Caller", c.Caller,
+			"contract.Address", c.Address,
+		)
+
+		return &runtime.ExecutionResult{
+			GasLeft: 0,
+			Err:     runtime.ErrNotAuth,
+		}
+	}
+
 	// Take snapshot of the current state
 	snapshot := t.state.Snapshot()
 
+	if t.config.EIP6780 {
+		// journaled, so a reverted creation does not count as created
+		t.state.MarkCreated(c.Address)
+	}
+
 	if t.config.EIP158 {
 		// Force the creation of the account
 		t.state.CreateAccount(c.Address)
@@ -846,12 +1122,10 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		}
 	}
 
-	var result *runtime.ExecutionResult
-
-	t.captureCallStart(c, evm.CREATE)
+	t.captureCallStart(c, runtime.Create)
 
 	defer func() {
-		// pass result to be set later
+		// the named result is the one actually returned
 		t.captureCallEnd(c, result)
 	}()
 
@@ -899,8 +1173,8 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		return result
 	}
 
-	if t.config.EIP158 && len(result.ReturnValue) > SpuriousDragonMaxCodeSize {
-		// Contract size exceeds 'SpuriousDragon' size limit
+	if t.config.EIP158 && uint64(len(result.ReturnValue)) > t.maxCodeSize {
+		// Contract size exceeds the max code size limit (EIP-170 by default)
 		if err := t.state.RevertToSnapshot(snapshot); err != nil {
 			return &runtime.ExecutionResult{
 				Err: err,
@@ -937,9 +1211,34 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 	result.Address = c.Address
 	t.state.SetCode(c.Address, result.ReturnValue)
 
+	t.contractCreations = append(t.contractCreations, &types.ContractCreation{
+		Address: c.Address,
+		Creator: c.Caller,
+	})
+
 	return result
 }
 
+// committedContractCreations returns the contracts created by the applied transaction
+// which were not reverted by the enclosing calls
+func (t *Transition) committedContractCreations(txHash types.Hash) []*types.ContractCreation {
+	if len(t.contractCreations) == 0 {
+		return nil
+	}
+
+	creations := make([]*types.ContractCreation, 0, len(t.contractCreations))
+
+	for _, creation := range t.contractCreations {
+		// the reverted creation leaves neither the code nor the nonce (set by the creation) behind
+		if t.hasCodeOrNonce(creation.Address) {
+			creation.TxHash = txHash
+			creations = append(creations, creation)
+		}
+	}
+
+	return creations
+}
+
 func (t *Transition) handleAllowBlockListsUpdate(contract *runtime.Contract,
 	host runtime.Host) *runtime.ExecutionResult {
 	// check contract deployment allow list (if any)
@@ -985,7 +1284,18 @@ func (t *Transition) SetStorage(
 	value types.Hash,
 	config *chain.ForksInTime,
 ) runtime.StorageStatus {
-	return t.state.SetStorage(addr, key, value, config)
+	status := t.state.SetStorage(addr, key, value, config)
+
+	if t.storageRent != nil {
+		t.storageRent.OnStorageSet(addr, key, value)
+	}
+
+	return status
+}
+
+// IsStorageExpired returns true if the storage slot is unreadable because its rent is not paid
+func (t *Transition) IsStorageExpired(addr types.Address, key types.Hash) bool {
+	return t.storageRent != nil && t.storageRent.IsExpired(addr, key)
 }
 
 func (t *Transition) GetTxContext() runtime.TxContext {
@@ -1033,6 +1343,26 @@ func (t *Transition) GetNonce(addr types.Address) uint64 {
 }
 
 func (t *Transition) Selfdestruct(addr types.Address, beneficiary types.Address) {
+	if t.config.EIP6780 {
+		// eip-6780, the account is only deleted if it was created in the same transaction.
+		// The refund is kept for the deleted accounts, it's only removed by eip-3529
+		balance := t.state.GetBalance(addr)
+
+		if t.state.IsCreated(addr) {
+			if !t.state.HasSuicided(addr) {
+				t.state.AddRefund(24000)
+			}
+
+			t.state.AddBalance(beneficiary, balance)
+			t.state.Suicide(addr)
+		} else if addr != beneficiary {
+			t.state.AddBalance(beneficiary, balance)
+			t.state.SetBalance(addr, big.NewInt(0))
+		}
+
+		return
+	}
+
 	if !t.state.HasSuicided(addr) {
 		t.state.AddRefund(24000)
 	}
@@ -1041,6 +1371,14 @@ func (t *Transition) Selfdestruct(addr types.Address, beneficiary types.Address)
 	t.state.Suicide(addr)
 }
 
+func (t *Transition) AddAddressToAccessList(addr types.Address) bool {
+	return t.state.AddAddressToAccessList(addr)
+}
+
+func (t *Transition) AddSlotToAccessList(addr types.Address, slot types.Hash) bool {
+	return t.state.AddSlotToAccessList(addr, slot)
+}
+
 func (t *Transition) Callx(c *runtime.Contract, h runtime.Host) *runtime.ExecutionResult {
 	if c.Type == runtime.Create {
 		return t.applyCreate(c, h)
@@ -1085,6 +1423,15 @@ func (t *Transition) SetTracer(tracer tracer.Tracer) {
 	t.ctx.Tracer = tracer
 }
 
+// SetTraceID annotates the transition logs with the trace ID of the request which initiated the execution
+func (t *Transition) SetTraceID(traceID string) {
+	if traceID == "" || t.logger == nil {
+		return
+	}
+
+	t.logger = t.logger.With("traceID", traceID)
+}
+
 // GetTracer returns a tracer in context
 func (t *Transition) GetTracer() runtime.VMTracer {
 	return t.ctx.Tracer
@@ -1094,7 +1441,7 @@ func (t *Transition) GetRefund() uint64 {
 	return t.state.GetRefund()
 }
 
-func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (uint64, error) {
+func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul, isBerlin bool) (uint64, error) {
 	cost := uint64(0)
 
 	// Contract creation is only paid on the homestead fork
@@ -1134,6 +1481,24 @@ func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (u
 		cost += zeros * 4
 	}
 
+	// the access list entries are paid on the berlin fork (eip-2930)
+	if isBerlin && len(msg.AccessList) > 0 {
+		addresses := uint64(len(msg.AccessList))
+		storageKeys := uint64(msg.AccessList.StorageKeys())
+
+		if (math.MaxUint64-cost)/TxAccessListAddressGas < addresses {
+			return 0, ErrIntrinsicGasOverflow
+		}
+
+		cost += addresses * TxAccessListAddressGas
+
+		if (math.MaxUint64-cost)/TxAccessListStorageKeyGas < storageKeys {
+			return 0, ErrIntrinsicGasOverflow
+		}
+
+		cost += storageKeys * TxAccessListStorageKeyGas
+	}
+
 	return cost, nil
 }
 
@@ -1152,7 +1517,22 @@ func checkAndProcessTx(msg *types.Transaction, t *Transition) error {
 		return NewTransitionApplicationError(err, true)
 	}
 
-	// 3. caller has enough balance to cover transaction
+	// 3. the access list is allowed
+	if err := t.checkAccessList(msg); err != nil {
+		return NewTransitionApplicationError(err, true)
+	}
+
+	// 4. the transaction doesn't call the reserved system address directly
+	if err := t.checkReservedAddress(msg); err != nil {
+		return NewTransitionApplicationError(err, false)
+	}
+
+	// 5. sponsored transaction is signed by the sponsor
+	if err := t.checkSponsor(msg); err != nil {
+		return err
+	}
+
+	// 6. caller, or the sponsor, has enough balance to cover transaction
 	if err := t.subGasLimitPrice(msg); err != nil {
 		return NewTransitionApplicationError(err, true)
 	}
@@ -1218,6 +1598,7 @@ func (t *Transition) captureCallEnd(c *runtime.Contract, result *runtime.Executi
 	t.ctx.Tracer.CallEnd(
 		c.Depth,
 		result.ReturnValue,
+		result.GasLeft,
 		result.Err,
 	)
 }
@@ -1,14 +1,18 @@
 package state
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 	"testing"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -154,3 +158,458 @@ func Test_Transition_checkDynamicFees(t *testing.T) {
 		})
 	}
 }
+
+func TestTransition_ApplyStatic(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender  = types.StringToAddress("1000")
+		viewer  = types.StringToAddress("1001")
+		writer  = types.StringToAddress("1002")
+		catcher = types.StringToAddress("1003")
+
+		// returns 42
+		viewCode = []byte{0x60, 0x2a, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+		// stores 1 into the slot 0
+		writeCode = []byte{0x60, 0x01, 0x60, 0x00, 0x55, 0x00}
+	)
+
+	// calls the writer, ignores its failure and returns 42
+	catchCode := append([]byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73}, writer.Bytes()...)
+	catchCode = append(catchCode, 0x61, 0xff, 0xff, 0xf1, 0x50)
+	catchCode = append(catchCode, viewCode...)
+
+	newTransition := func() *Transition {
+		state := newStateWithPreState(map[types.Address]*PreState{
+			sender: {Balance: 1000},
+		})
+
+		forks := chain.AllForksEnabled.At(0)
+		forks.London = false
+
+		tt := NewTransition(forks, state, newTxn(state))
+		tt.ctx.BaseFee = big.NewInt(0)
+		tt.gasPool = 1000000
+
+		tt.state.SetCode(viewer, viewCode)
+		tt.state.SetCode(writer, writeCode)
+		tt.state.SetCode(catcher, catchCode)
+
+		return tt
+	}
+
+	newCall := func(to types.Address) *types.Transaction {
+		return &types.Transaction{
+			From:     sender,
+			To:       &to,
+			Gas:      100000,
+			GasPrice: big.NewInt(0),
+			Value:    big.NewInt(0),
+		}
+	}
+
+	// view call
+	tt := newTransition()
+
+	result, err := tt.ApplyStatic(newCall(viewer))
+	require.NoError(t, err)
+	require.NoError(t, result.Err)
+	require.Equal(t, types.BytesToHash([]byte{0x2a}).Bytes(), result.ReturnValue)
+
+	expected, err := newTransition().Apply(newCall(viewer))
+	require.NoError(t, err)
+	require.Equal(t, expected.GasUsed, result.GasUsed)
+
+	// the transition is reusable after the read-only execution
+	require.False(t, tt.static)
+
+	// state modifications are detected, even if the failure is handled by the caller
+	for _, to := range []types.Address{writer, catcher} {
+		_, err = newTransition().ApplyStatic(newCall(to))
+		require.ErrorIs(t, err, ErrNotReadOnly)
+
+		result, err = newTransition().Apply(newCall(to))
+		require.NoError(t, err)
+		require.NoError(t, result.Err)
+	}
+
+	// value transfers and contract creations are not read-only
+	call := newCall(viewer)
+	call.Value = big.NewInt(1)
+
+	_, err = newTransition().ApplyStatic(call)
+	require.ErrorIs(t, err, ErrNotReadOnly)
+
+	call = newCall(viewer)
+	call.To = nil
+
+	_, err = newTransition().ApplyStatic(call)
+	require.ErrorIs(t, err, ErrNotReadOnly)
+}
+
+func TestTransition_ContractCreations(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender   = types.StringToAddress("1000")
+		factory  = types.StringToAddress("1001")
+		reverter = types.StringToAddress("1002")
+
+		// creates an empty contract
+		createCode = []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0xf0, 0x50}
+		// creates an empty contract and reverts
+		revertCode = append(append([]byte{}, createCode...), 0x60, 0x00, 0x60, 0x00, 0xfd)
+	)
+
+	// creates an empty contract, calls the reverter and ignores its failure
+	factoryCode := append(append([]byte{}, createCode...), 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73)
+	factoryCode = append(factoryCode, reverter.Bytes()...)
+	factoryCode = append(factoryCode, 0x61, 0xff, 0xff, 0xf1, 0x50, 0x00)
+
+	state := newStateWithPreState(map[types.Address]*PreState{
+		sender: {Balance: 1000},
+	})
+
+	tt := NewTransition(chain.AllForksEnabled.At(0), state, newTxn(state))
+	tt.ctx.BaseFee = big.NewInt(0)
+	tt.gasPool = 1000000
+
+	tt.state.SetCode(factory, factoryCode)
+	tt.state.SetCode(reverter, revertCode)
+
+	// the internal creations, except the reverted ones
+	call := &types.Transaction{
+		From:     sender,
+		To:       &factory,
+		Gas:      200000,
+		GasPrice: big.NewInt(0),
+		Value:    big.NewInt(0),
+		Hash:     types.StringToHash("1"),
+	}
+
+	require.NoError(t, tt.Write(call))
+	require.Equal(t, []*types.ContractCreation{{
+		Address: crypto.CreateAddress(factory, 0),
+		Creator: factory,
+		TxHash:  call.Hash,
+	}}, tt.Receipts()[0].ContractCreations)
+
+	// the top level creation
+	deployment := &types.Transaction{
+		From:     sender,
+		Nonce:    1,
+		Gas:      200000,
+		GasPrice: big.NewInt(0),
+		Value:    big.NewInt(0),
+		Hash:     types.StringToHash("2"),
+	}
+
+	require.NoError(t, tt.Write(deployment))
+	require.Equal(t, []*types.ContractCreation{{
+		Address: crypto.CreateAddress(sender, 1),
+		Creator: sender,
+		TxHash:  deployment.Hash,
+	}}, tt.Receipts()[1].ContractCreations)
+}
+
+func TestTransition_CodeSizeLimits(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender = types.StringToAddress("1000")
+
+		// deploys a 2 bytes long contract
+		initCode = []byte{0x60, 0x02, 0x60, 0x00, 0xf3}
+	)
+
+	cases := []struct {
+		name            string
+		maxCodeSize     uint64
+		maxInitCodeSize uint64
+		expectedErr     error
+	}{
+		{
+			name:        "within the limits",
+			maxCodeSize: SpuriousDragonMaxCodeSize,
+		},
+		{
+			name:        "code size above the limit",
+			maxCodeSize: 1,
+			expectedErr: runtime.ErrMaxCodeSizeExceeded,
+		},
+		{
+			name:            "init code size above the limit",
+			maxCodeSize:     SpuriousDragonMaxCodeSize,
+			maxInitCodeSize: 4,
+			expectedErr:     runtime.ErrMaxCodeSizeExceeded,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := newStateWithPreState(map[types.Address]*PreState{
+				sender: {Balance: 1000},
+			})
+
+			tt := NewTransition(chain.AllForksEnabled.At(0), state, newTxn(state))
+			tt.maxCodeSize = c.maxCodeSize
+			tt.maxInitCodeSize = c.maxInitCodeSize
+
+			result := tt.Create2(sender, initCode, big.NewInt(0), 100000)
+			require.ErrorIs(t, result.Err, c.expectedErr)
+		})
+	}
+}
+
+func TestTransition_ApplySponsored(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	senderKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	sponsorKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	var (
+		sender    = crypto.PubKeyToAddress(&senderKey.PublicKey)
+		sponsor   = crypto.PubKeyToAddress(&sponsorKey.PublicKey)
+		receiver  = types.StringToAddress("1000")
+		coinbase  = types.StringToAddress("1001")
+		gasFeeCap = big.NewInt(10)
+	)
+
+	newTransition := func(forks chain.ForksInTime, senderBalance uint64) *Transition {
+		state := newStateWithPreState(map[types.Address]*PreState{
+			sender:  {Balance: senderBalance},
+			sponsor: {Balance: 1000000},
+		})
+
+		tt := NewTransition(forks, state, newTxn(state))
+		tt.ctx.BaseFee = big.NewInt(5)
+		tt.ctx.ChainID = chainID
+		tt.ctx.Coinbase = coinbase
+		tt.gasPool = 1000000
+
+		return tt
+	}
+
+	newSponsoredTx := func(t *testing.T, sponsorKey *ecdsa.PrivateKey) *types.Transaction {
+		t.Helper()
+
+		tx, err := crypto.NewLondonSigner(chainID, true, crypto.NewEIP155Signer(chainID, true)).
+			SignTx(&types.Transaction{
+				Type:      types.SponsoredTx,
+				ChainID:   big.NewInt(chainID),
+				To:        &receiver,
+				Value:     big.NewInt(100),
+				Gas:       21000,
+				GasFeeCap: gasFeeCap,
+				GasTipCap: big.NewInt(1),
+				Sponsor:   &sponsor,
+			}, senderKey)
+		require.NoError(t, err)
+
+		tx.From = sender
+
+		tx, err = crypto.SignSponsor(tx, chainID, sponsorKey)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	t.Run("sponsor pays the gas", func(t *testing.T) {
+		t.Parallel()
+
+		tt := newTransition(chain.AllForksEnabled.At(0), 100)
+
+		result, err := tt.Apply(newSponsoredTx(t, sponsorKey))
+		require.NoError(t, err)
+		require.NoError(t, result.Err)
+
+		// the sender pays only the value, the sponsor pays the gas
+		require.Zero(t, tt.state.GetBalance(sender).Sign())
+		require.Equal(t, big.NewInt(100), tt.state.GetBalance(receiver))
+		require.Equal(t, new(big.Int).Sub(big.NewInt(1000000), new(big.Int).Mul(big.NewInt(21000), gasFeeCap)),
+			tt.state.GetBalance(sponsor))
+		require.Equal(t, big.NewInt(21000), tt.state.GetBalance(coinbase))
+		require.Equal(t, uint64(1), tt.state.GetNonce(sender))
+		require.Zero(t, tt.state.GetNonce(sponsor))
+	})
+
+	t.Run("fork not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		forks := chain.AllForksEnabled.At(0)
+		forks.SponsoredGas = false
+
+		_, err := newTransition(forks, 100).Apply(newSponsoredTx(t, sponsorKey))
+		require.ErrorIs(t, err, ErrSponsoredTxNotAllowed)
+	})
+
+	t.Run("not signed by the sponsor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newTransition(chain.AllForksEnabled.At(0), 100).Apply(newSponsoredTx(t, senderKey))
+		require.ErrorIs(t, err, ErrInvalidSponsor)
+	})
+
+	t.Run("sender can't pay the value", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newTransition(chain.AllForksEnabled.At(0), 99).Apply(newSponsoredTx(t, sponsorKey))
+		require.ErrorIs(t, err, ErrNotEnoughFundsForValue)
+	})
+}
+
+func TestTransition_ReservedAddresses(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender     = types.StringToAddress("1000")
+		entryPoint = types.StringToAddress("0x101")
+		reserved   = types.StringToAddress("0x102")
+		regular    = types.StringToAddress("0x1001")
+
+		// returns 42
+		viewCode = []byte{0x60, 0x2a, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	)
+
+	newTransition := func() *Transition {
+		state := newStateWithPreState(map[types.Address]*PreState{
+			sender:                 {Balance: 1000},
+			contracts.SystemCaller: {Balance: 0},
+		})
+
+		forks := chain.AllForksEnabled.At(0)
+		forks.London = false
+
+		tt := NewTransition(forks, state, newTxn(state))
+		tt.logger = hclog.NewNullLogger()
+		tt.ctx.BaseFee = big.NewInt(0)
+		tt.gasPool = 1000000
+		tt.reservedAddresses = newReservedAddresses(&chain.ReservedAddressesConfig{
+			Ranges: []*chain.AddressRange{
+				{From: types.StringToAddress("0x100"), To: types.StringToAddress("0x1ff")},
+			},
+			EntryPoints: []types.Address{entryPoint},
+		})
+
+		tt.state.SetCode(reserved, viewCode)
+
+		return tt
+	}
+
+	newCall := func(from, to types.Address) *types.Transaction {
+		return &types.Transaction{
+			From:     from,
+			To:       &to,
+			Value:    big.NewInt(0),
+			Gas:      100000,
+			GasPrice: big.NewInt(0),
+		}
+	}
+
+	t.Run("user transactions", func(t *testing.T) {
+		t.Parallel()
+
+		tt := newTransition()
+
+		_, err := tt.Apply(newCall(sender, reserved))
+		require.ErrorIs(t, err, ErrReservedAddress)
+
+		for nonce, to := range []types.Address{entryPoint, regular} {
+			tx := newCall(sender, to)
+			tx.Nonce = uint64(nonce)
+
+			result, err := tt.Apply(tx)
+			require.NoError(t, err)
+			require.NoError(t, result.Err)
+		}
+	})
+
+	t.Run("system and read-only calls", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := newTransition().Apply(newCall(contracts.SystemCaller, reserved))
+		require.NoError(t, err)
+		require.NoError(t, result.Err)
+
+		result, err = newTransition().ApplyStatic(newCall(sender, reserved))
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(42), new(big.Int).SetBytes(result.ReturnValue))
+	})
+
+	t.Run("deployments", func(t *testing.T) {
+		t.Parallel()
+
+		deploy := func(tt *Transition, caller, address types.Address) *runtime.ExecutionResult {
+			return tt.applyCreate(
+				runtime.NewContractCreation(1, caller, caller, address, big.NewInt(0), 100000, viewCode), tt)
+		}
+
+		tt := newTransition()
+
+		require.ErrorIs(t, deploy(tt, sender, types.StringToAddress("0x103")).Err, runtime.ErrNotAuth)
+		require.NoError(t, deploy(tt, sender, regular).Err)
+		require.NoError(t, deploy(tt, contracts.SystemCaller, types.StringToAddress("0x104")).Err)
+	})
+}
+
+func Test_Transition_AccessListTx(t *testing.T) {
+	t.Parallel()
+
+	sender := types.StringToAddress("1000")
+	receiver := types.StringToAddress("1001")
+
+	newAccessListTx := func(txType types.TxType) *types.Transaction {
+		return &types.Transaction{
+			Type:      txType,
+			From:      sender,
+			To:        &receiver,
+			Value:     big.NewInt(1),
+			Gas:       30000,
+			GasPrice:  big.NewInt(1),
+			GasFeeCap: big.NewInt(1),
+			GasTipCap: big.NewInt(1),
+			AccessList: types.TxAccessList{
+				{Address: receiver, StorageKeys: []types.Hash{types.StringToHash("1")}},
+			},
+		}
+	}
+
+	newTransition := func(forks chain.ForksInTime) *Transition {
+		state := newStateWithPreState(map[types.Address]*PreState{sender: {Balance: 1000000}})
+
+		tt := NewTransition(forks, state, newTxn(state))
+		tt.ctx.BaseFee = big.NewInt(1)
+		tt.gasPool = 1000000
+
+		return tt
+	}
+
+	for _, txType := range []types.TxType{types.AccessListTx, types.DynamicFeeTx} {
+		txType := txType
+
+		t.Run(txType.String(), func(t *testing.T) {
+			t.Parallel()
+
+			// the access list entries are paid on top of the intrinsic gas
+			result, err := newTransition(chain.AllForksEnabled.At(0)).Apply(newAccessListTx(txType))
+			require.NoError(t, err)
+			require.NoError(t, result.Err)
+			require.Equal(t, TxGas+TxAccessListAddressGas+TxAccessListStorageKeyGas, result.GasUsed)
+
+			forks := chain.AllForksEnabled.At(0)
+			forks.Berlin = false
+
+			_, err = newTransition(forks).Apply(newAccessListTx(txType))
+			require.ErrorIs(t, err, ErrAccessListNotAllowed)
+		})
+	}
+}
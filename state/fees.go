@@ -0,0 +1,52 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// TxFees is the breakdown of the fees of an executed transaction
+type TxFees struct {
+	// Paid is the fee charged to the gas payer
+	Paid *big.Int
+	// Tip is the fee credited to the coinbase
+	Tip *big.Int
+	// Burned is the base fee credited to the burn contract
+	Burned *big.Int
+}
+
+// effectiveTip returns the tip per gas credited to the coinbase.
+// Spec: https://eips.ethereum.org/EIPS/eip-1559#specification
+// The EIP-1559 fields of the tx are used if the london hardfork is enabled,
+// so the effective tip is either gas tip cap or (gas fee cap - current base fee)
+func effectiveTip(msg *types.Transaction, gasPrice, baseFee *big.Int, london bool) *big.Int {
+	if london && msg.Type.HasDynamicFees() {
+		return common.BigMin(
+			new(big.Int).Sub(msg.GasFeeCap, baseFee),
+			new(big.Int).Set(msg.GasTipCap),
+		)
+	}
+
+	return new(big.Int).Set(gasPrice)
+}
+
+// CalculateTxFees splits the fees of the transaction which used the given amount of gas
+// the same way the transition credits them, so the fees can be reconciled without re-executing the block
+func CalculateTxFees(msg *types.Transaction, gasUsed uint64, baseFee *big.Int, london bool) *TxFees {
+	gas := new(big.Int).SetUint64(gasUsed)
+	gasPrice := msg.GetGasPrice(baseFee.Uint64())
+
+	fees := &TxFees{
+		Paid:   new(big.Int).Mul(gas, gasPrice),
+		Tip:    new(big.Int).Mul(gas, effectiveTip(msg, gasPrice, baseFee, london)),
+		Burned: big.NewInt(0),
+	}
+
+	if london && msg.Type != types.StateTx {
+		fees.Burned.Mul(gas, baseFee)
+	}
+
+	return fees
+}
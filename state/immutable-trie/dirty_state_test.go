@@ -0,0 +1,128 @@
+package itrie
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestTransition_FlushDirtyState(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender   = types.StringToAddress("1000")
+		receiver = types.StringToAddress("1001")
+		contract = types.StringToAddress("1002")
+
+		slot1 = types.StringToHash("1")
+		slot2 = types.StringToHash("2")
+
+		// stores the second word of the calldata into the slot given by the first word
+		storeCode = []byte{0x60, 0x20, 0x35, 0x60, 0x00, 0x35, 0x55, 0x00}
+	)
+
+	store := func(nonce uint64, slot, value types.Hash) *types.Transaction {
+		return &types.Transaction{
+			From:     sender,
+			To:       &contract,
+			Nonce:    nonce,
+			Gas:      100000,
+			GasPrice: big.NewInt(0),
+			Value:    big.NewInt(0),
+			Input:    append(slot.Bytes(), value.Bytes()...),
+		}
+	}
+
+	// the slots are modified and reset across the flushes,
+	// so the gas used depends on the values at the beginning of the block
+	txs := []*types.Transaction{
+		store(0, slot1, types.StringToHash("2")),
+		store(1, slot2, types.StringToHash("5")),
+		{From: sender, To: &receiver, Nonce: 2, Gas: 21000, GasPrice: big.NewInt(0), Value: big.NewInt(10)},
+		store(3, slot1, types.StringToHash("1")),
+		store(4, slot2, types.ZeroHash),
+		store(5, slot1, types.StringToHash("3")),
+	}
+
+	// stateDiff describes the committed objects regardless of their order and the flushes
+	stateDiff := func(objs []*state.Object) map[types.Address]string {
+		diff := make(map[types.Address]string, len(objs))
+
+		for _, obj := range objs {
+			storage := make(map[string]string, len(obj.Storage))
+			for _, entry := range obj.Storage {
+				storage[hex.EncodeToHex(entry.Key)] = hex.EncodeToHex(entry.Val)
+			}
+
+			diff[obj.Address] = fmt.Sprintf("%s %d %t %v", obj.Balance, obj.Nonce, obj.Deleted, storage)
+		}
+
+		return diff
+	}
+
+	execute := func(dirtyStateLimit uint64) (types.Hash, []*types.Receipt, map[types.Address]string, int) {
+		params := &chain.Params{
+			Forks:        chain.AllForksEnabled,
+			BurnContract: map[uint64]types.Address{0: types.ZeroAddress},
+		}
+
+		executor := state.NewExecutor(params, NewState(NewMemoryStorage()), hclog.NewNullLogger())
+		executor.GetHash = func(*types.Header) func(uint64) types.Hash {
+			return func(uint64) types.Hash { return types.ZeroHash }
+		}
+		executor.DirtyStateLimit = dirtyStateLimit
+
+		genesisRoot, err := executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+			sender: {Balance: big.NewInt(1000)},
+			contract: {
+				Balance: big.NewInt(0),
+				Code:    storeCode,
+				Storage: map[types.Hash]types.Hash{slot1: types.StringToHash("1")},
+			},
+		}, types.ZeroHash)
+		require.NoError(t, err)
+
+		transition, err := executor.BeginTxn(genesisRoot, &types.Header{Number: 1, GasLimit: 10000000}, types.ZeroAddress)
+		require.NoError(t, err)
+
+		flushes := 0
+
+		for _, tx := range txs {
+			txn := transition.Txn()
+
+			require.NoError(t, transition.Write(tx))
+
+			if transition.Txn() != txn {
+				flushes++
+			}
+		}
+
+		_, root, objs, err := transition.CommitObjects()
+		require.NoError(t, err)
+
+		return root, transition.Receipts(), stateDiff(objs), flushes
+	}
+
+	expectedRoot, expectedReceipts, expectedDiff, flushes := execute(0)
+	require.Equal(t, 0, flushes)
+
+	root, receipts, diff, flushes := execute(1)
+	require.Equal(t, len(txs)-1, flushes)
+	require.Equal(t, expectedRoot, root)
+	require.Equal(t, expectedDiff, diff)
+	require.Len(t, receipts, len(expectedReceipts))
+
+	for i, receipt := range receipts {
+		require.Equal(t, expectedReceipts[i].GasUsed, receipt.GasUsed)
+		require.Equal(t, expectedReceipts[i].CumulativeGasUsed, receipt.CumulativeGasUsed)
+		require.Equal(t, expectedReceipts[i].Status, receipt.Status)
+	}
+}
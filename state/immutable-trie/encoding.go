@@ -78,3 +78,18 @@ func decodeCompact(compact []byte) []byte {
 
 	return base
 }
+
+// hexNibblesToBytes joins the nibbles
+// into bytes, the terminator flag is removed.
+func hexNibblesToBytes(nibbles []byte) []byte {
+	if hasTerminator(nibbles) {
+		nibbles = nibbles[:len(nibbles)-1]
+	}
+
+	key := make([]byte, len(nibbles)/2)
+	for i := range key {
+		key[i] = nibbles[2*i]<<4 | nibbles[2*i+1]
+	}
+
+	return key
+}
@@ -0,0 +1,493 @@
+package itrie
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// maxFlatDiffLayers is the number of the diff layers kept in memory on top of the disk layer.
+// The reorgs up to this depth are served by the flat state, since the disk layer is below them
+const maxFlatDiffLayers = 128
+
+var (
+	// flatPrefix is the prefix of the flat state entries in the storage, the entries of each
+	// generation are prefixed with the generation id, so the entries of the abandoned generations are ignored
+	flatPrefix = []byte("flat")
+
+	// flatMetaKey holds the metadata of the flat state disk layer
+	flatMetaKey = []byte("flatmeta")
+
+	flatAccountTag = byte('a')
+	flatEpochTag   = byte('e')
+	flatStorageTag = byte('s')
+
+	errInvalidFlatMeta = errors.New("invalid flat state metadata")
+)
+
+// flatLayer is a layer of the flat state, which answers the account and storage reads at its state root
+// without traversing the trie. The covered flag is false if the layer can't answer the read,
+// e.g. the account is not generated yet, and the read must be served by the trie
+type flatLayer interface {
+	// account returns the RLP-encoded account, nil if the account doesn't exist
+	account(addrHash types.Hash) (data []byte, covered bool)
+
+	// storage returns the RLP-encoded storage slot value, nil if the slot is empty
+	storage(addrHash, slotHash types.Hash) (data []byte, covered bool)
+}
+
+// flatDiffLayer holds the accounts and the storage slots changed by a single state commit
+type flatDiffLayer struct {
+	root   types.Hash
+	parent flatLayer
+
+	// accounts holds the changed accounts, the nil value marks the deleted account
+	accounts map[types.Hash][]byte
+
+	// destructed holds the accounts whose storage is wiped by the commit (deleted or recreated),
+	// so their slots which are not in the changed slots are empty
+	destructed map[types.Hash]struct{}
+
+	// slots holds the changed storage slots, the nil value marks the emptied slot
+	slots map[types.Hash]map[types.Hash][]byte
+}
+
+func (d *flatDiffLayer) account(addrHash types.Hash) ([]byte, bool) {
+	if data, ok := d.accounts[addrHash]; ok {
+		return data, true
+	}
+
+	return d.parent.account(addrHash)
+}
+
+func (d *flatDiffLayer) storage(addrHash, slotHash types.Hash) ([]byte, bool) {
+	if data, ok := d.slots[addrHash][slotHash]; ok {
+		return data, true
+	}
+
+	if _, ok := d.destructed[addrHash]; ok {
+		return nil, true
+	}
+
+	return d.parent.storage(addrHash, slotHash)
+}
+
+// flatDiskLayer is the flat state persisted in the storage. It is generated in the background
+// from the trie, in the order of the hashed account keys, so until the generation is done only
+// the accounts up to the marker (including their storage) are covered
+type flatDiskLayer struct {
+	db    Storage
+	root  types.Hash
+	genID uint64
+
+	generated bool
+	// marker is the last generated account, nil if none is generated yet
+	marker []byte
+}
+
+// covers returns true if the account is generated
+func (d *flatDiskLayer) covers(addrHash types.Hash) bool {
+	return d.generated || (d.marker != nil && bytes.Compare(addrHash.Bytes(), d.marker) <= 0)
+}
+
+func (d *flatDiskLayer) account(addrHash types.Hash) ([]byte, bool) {
+	if !d.covers(addrHash) {
+		return nil, false
+	}
+
+	data, ok := d.db.Get(d.accountKey(addrHash))
+	if !ok || len(data) == 0 {
+		return nil, true
+	}
+
+	return data, true
+}
+
+func (d *flatDiskLayer) storage(addrHash, slotHash types.Hash) ([]byte, bool) {
+	if !d.covers(addrHash) {
+		return nil, false
+	}
+
+	data, ok := d.db.Get(d.storageKey(addrHash, d.epoch(addrHash), slotHash))
+	if !ok || len(data) == 0 {
+		return nil, true
+	}
+
+	return data, true
+}
+
+// epoch returns the storage epoch of the account. The storage entries are keyed by the epoch,
+// which is bumped once the storage of the account is wiped, since the entries can't be iterated to delete them
+func (d *flatDiskLayer) epoch(addrHash types.Hash) uint64 {
+	data, ok := d.db.Get(d.entryKey(flatEpochTag, addrHash.Bytes()))
+	if !ok || len(data) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(data)
+}
+
+func (d *flatDiskLayer) entryKey(tag byte, parts ...[]byte) []byte {
+	key := make([]byte, 0, len(flatPrefix)+9+2*types.HashLength+8)
+	key = append(key, flatPrefix...)
+	key = binary.BigEndian.AppendUint64(key, d.genID)
+	key = append(key, tag)
+
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+
+	return key
+}
+
+func (d *flatDiskLayer) accountKey(addrHash types.Hash) []byte {
+	return d.entryKey(flatAccountTag, addrHash.Bytes())
+}
+
+func (d *flatDiskLayer) storageKey(addrHash types.Hash, epoch uint64, slotHash types.Hash) []byte {
+	return d.entryKey(flatStorageTag, addrHash.Bytes(), binary.BigEndian.AppendUint64(nil, epoch), slotHash.Bytes())
+}
+
+// writeMeta writes the metadata of the disk layer: root | generation id | generated flag | marker
+func (d *flatDiskLayer) writeMeta(batch Putter) {
+	meta := make([]byte, 0, types.HashLength+9+len(d.marker))
+	meta = append(meta, d.root.Bytes()...)
+	meta = binary.BigEndian.AppendUint64(meta, d.genID)
+
+	if d.generated {
+		meta = append(meta, 1)
+	} else {
+		meta = append(meta, 0)
+	}
+
+	batch.Put(flatMetaKey, append(meta, d.marker...))
+}
+
+// readFlatDiskLayer reads the disk layer from its metadata, nil if there is no disk layer
+func readFlatDiskLayer(db Storage) (*flatDiskLayer, error) {
+	meta, ok := db.Get(flatMetaKey)
+	if !ok || len(meta) == 0 {
+		return nil, nil
+	}
+
+	if len(meta) < types.HashLength+9 {
+		return nil, errInvalidFlatMeta
+	}
+
+	disk := &flatDiskLayer{
+		db:        db,
+		root:      types.BytesToHash(meta[:types.HashLength]),
+		genID:     binary.BigEndian.Uint64(meta[types.HashLength : types.HashLength+8]),
+		generated: meta[types.HashLength+8] == 1,
+	}
+
+	if marker := meta[types.HashLength+9:]; len(marker) > 0 {
+		disk.marker = append([]byte(nil), marker...)
+	}
+
+	return disk, nil
+}
+
+// flatTree is the flat state of the recent state roots. The disk layer holds the flat state
+// of the oldest root, and each commit adds a diff layer on top of its parent layer,
+// so the forks of the chain are kept as the branches of the tree
+type flatTree struct {
+	logger hclog.Logger
+
+	lock   sync.RWMutex
+	disk   *flatDiskLayer
+	layers map[types.Hash]flatLayer
+	// latest is the root of the latest diff layer, which is flattened into the disk layer on close
+	latest types.Hash
+
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// newFlatTree loads the flat state disk layer, which is regenerated at the given root
+// if it is missing or it is not at the given root
+func newFlatTree(db Storage, root types.Hash, logger hclog.Logger) (*flatTree, error) {
+	disk, err := readFlatDiskLayer(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if disk == nil || disk.root != root {
+		genID := uint64(1)
+		if disk != nil {
+			genID = disk.genID + 1
+		}
+
+		disk = &flatDiskLayer{
+			db:        db,
+			root:      root,
+			genID:     genID,
+			generated: root == types.EmptyRootHash,
+		}
+
+		batch := db.Batch()
+		disk.writeMeta(batch)
+		batch.Write()
+	}
+
+	tree := &flatTree{
+		logger:  logger.Named("flat_state"),
+		disk:    disk,
+		layers:  map[types.Hash]flatLayer{root: disk},
+		latest:  root,
+		closeCh: make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+
+	if disk.generated {
+		close(tree.doneCh)
+	} else {
+		tree.logger.Info("generating flat state", "root", root, "marker", types.BytesToHash(disk.marker))
+
+		go tree.generate()
+	}
+
+	return tree, nil
+}
+
+// close stops the generation, and flattens the latest layers into the disk layer,
+// so the flat state is reused on the next start
+func (t *flatTree) close() {
+	close(t.closeCh)
+	<-t.doneCh
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for t.disk.root != t.latest {
+		diff, ok := t.layers[t.latest].(*flatDiffLayer)
+		if !ok {
+			return
+		}
+
+		for diff.parent != t.disk {
+			if diff, ok = diff.parent.(*flatDiffLayer); !ok {
+				return
+			}
+		}
+
+		t.flatten(diff)
+	}
+}
+
+// account returns the RLP-encoded account at the state root
+func (t *flatTree) account(root, addrHash types.Hash) ([]byte, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	layer, ok := t.layers[root]
+	if !ok {
+		return nil, false
+	}
+
+	return layer.account(addrHash)
+}
+
+// storage returns the RLP-encoded storage slot value at the state root
+func (t *flatTree) storage(root, addrHash, slotHash types.Hash) ([]byte, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	layer, ok := t.layers[root]
+	if !ok {
+		return nil, false
+	}
+
+	return layer.storage(addrHash, slotHash)
+}
+
+// update adds the diff layer of the commit on top of the parent root layer, and flattens
+// the bottom diff layer into the disk layer if the tree is too deep. The commit on top
+// of the root which is not in the tree (e.g. the historical state) is not tracked
+func (t *flatTree) update(root, parentRoot types.Hash, diff *flatDiffLayer) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	parent, ok := t.layers[parentRoot]
+	if !ok || root == parentRoot {
+		return
+	}
+
+	if _, ok := t.layers[root]; ok {
+		// the same state is committed again, e.g. the block is re-executed
+		t.latest = root
+
+		return
+	}
+
+	diff.root = root
+	diff.parent = parent
+	t.layers[root] = diff
+	t.latest = root
+
+	var (
+		bottom = diff
+		depth  = 1
+	)
+
+	for bottom.parent != t.disk {
+		bottom = bottom.parent.(*flatDiffLayer) //nolint:forcetypeassert
+		depth++
+	}
+
+	if depth > maxFlatDiffLayers {
+		t.flatten(bottom)
+	}
+}
+
+// flatten writes the bottom diff layer to the disk layer, and drops the branches
+// which don't descend from it. It must be called while holding the lock
+func (t *flatTree) flatten(bottom *flatDiffLayer) {
+	batch := t.disk.db.Batch()
+
+	for addrHash, data := range bottom.accounts {
+		// the accounts which are not generated yet are generated from the trie at the new root
+		if !t.disk.covers(addrHash) {
+			continue
+		}
+
+		if data == nil {
+			batch.Put(t.disk.accountKey(addrHash), []byte{})
+		} else {
+			batch.Put(t.disk.accountKey(addrHash), data)
+		}
+	}
+
+	epochs := make(map[types.Hash]uint64)
+
+	for addrHash := range bottom.destructed {
+		if !t.disk.covers(addrHash) {
+			continue
+		}
+
+		epochs[addrHash] = t.disk.epoch(addrHash) + 1
+		batch.Put(t.disk.entryKey(flatEpochTag, addrHash.Bytes()),
+			binary.BigEndian.AppendUint64(nil, epochs[addrHash]))
+	}
+
+	for addrHash, slots := range bottom.slots {
+		if !t.disk.covers(addrHash) {
+			continue
+		}
+
+		epoch, ok := epochs[addrHash]
+		if !ok {
+			epoch = t.disk.epoch(addrHash)
+		}
+
+		for slotHash, data := range slots {
+			if data == nil {
+				batch.Put(t.disk.storageKey(addrHash, epoch, slotHash), []byte{})
+			} else {
+				batch.Put(t.disk.storageKey(addrHash, epoch, slotHash), data)
+			}
+		}
+	}
+
+	oldRoot := t.disk.root
+	t.disk.root = bottom.root
+	t.disk.writeMeta(batch)
+	batch.Write()
+
+	// the disk layer takes the place of the bottom diff layer,
+	// and the other branches of the old disk layer are dropped
+	dropped := make(map[flatLayer]struct{})
+
+	for {
+		count := len(dropped)
+
+		for root, layer := range t.layers {
+			diff, ok := layer.(*flatDiffLayer)
+			if !ok || diff == bottom {
+				continue
+			}
+
+			if _, ok := dropped[diff.parent]; ok || diff.parent == t.disk {
+				delete(t.layers, root)
+
+				dropped[diff] = struct{}{}
+			}
+		}
+
+		if len(dropped) == count {
+			break
+		}
+	}
+
+	for _, layer := range t.layers {
+		if diff, ok := layer.(*flatDiffLayer); ok && diff.parent == bottom {
+			diff.parent = t.disk
+		}
+	}
+
+	delete(t.layers, oldRoot)
+	t.layers[bottom.root] = t.disk
+
+	if _, ok := t.layers[t.latest]; !ok {
+		t.latest = t.disk.root
+	}
+}
+
+// newFlatDiffLayer builds the diff layer of the committed objects. The storage of the object is wiped
+// if the object is deleted, or if it is recreated with the empty storage over the account with the storage
+func (s *Snapshot) newFlatDiffLayer(objs []*state.Object, accounts map[*state.Object][]byte) *flatDiffLayer {
+	diff := &flatDiffLayer{
+		accounts:   make(map[types.Hash][]byte, len(objs)),
+		destructed: make(map[types.Hash]struct{}),
+		slots:      make(map[types.Hash]map[types.Hash][]byte),
+	}
+
+	arena := stateArenaPool.Get()
+	defer stateArenaPool.Put(arena)
+
+	for _, obj := range objs {
+		addrHash := types.BytesToHash(hashit(obj.Address.Bytes()))
+
+		if obj.Deleted {
+			diff.accounts[addrHash] = nil
+			diff.destructed[addrHash] = struct{}{}
+
+			continue
+		}
+
+		diff.accounts[addrHash] = accounts[obj]
+
+		if obj.Root == types.EmptyRootHash {
+			if prev, err := s.GetAccount(obj.Address); err == nil && prev != nil && prev.Root != types.EmptyRootHash {
+				diff.destructed[addrHash] = struct{}{}
+			}
+		}
+
+		if len(obj.Storage) == 0 {
+			continue
+		}
+
+		slots := make(map[types.Hash][]byte, len(obj.Storage))
+
+		for _, entry := range obj.Storage {
+			slotHash := types.BytesToHash(hashit(entry.Key))
+
+			if entry.Deleted {
+				slots[slotHash] = nil
+			} else {
+				slots[slotHash] = encodeStorageValue(arena, entry.Val)
+				arena.Reset()
+			}
+		}
+
+		diff.slots[addrHash] = slots
+	}
+
+	return diff
+}
@@ -0,0 +1,198 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// flatGenerateBatchSize is the number of the accounts generated while holding the flat state lock
+const flatGenerateBatchSize = 1000
+
+// errStopIteration stops the trie iteration
+var errStopIteration = errors.New("stop iteration")
+
+// generate generates the flat state disk layer from the trie at its root, in batches of accounts.
+// The disk layer root moves while generating, as the diff layers are flattened into it,
+// so each batch continues from the marker in the trie at the current disk root
+func (t *flatTree) generate() {
+	defer close(t.doneCh)
+
+	for {
+		select {
+		case <-t.closeCh:
+			return
+		default:
+		}
+
+		done, err := t.generateBatch(flatGenerateBatchSize)
+		if err != nil {
+			t.logger.Error("failed to generate flat state, the reads are served by the trie", "err", err)
+
+			return
+		}
+
+		if done {
+			t.logger.Info("flat state generated", "root", t.disk.root)
+
+			return
+		}
+	}
+}
+
+// generateBatch generates up to limit accounts following the marker, and returns true once all are generated
+func (t *flatTree) generateBatch(limit int) (bool, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	disk := t.disk
+
+	root, ok, err := GetNode(disk.root.Bytes(), disk.db)
+	if err != nil {
+		return false, err
+	}
+
+	if !ok {
+		return false, fmt.Errorf("state not found at hash %s", disk.root)
+	}
+
+	var (
+		batch  = disk.db.Batch()
+		marker = disk.marker
+		count  = 0
+	)
+
+	err = iterateTrie(root, disk.db, marker, func(key, value []byte) error {
+		if marker != nil && bytes.Equal(key, marker) {
+			return nil
+		}
+
+		if count == limit {
+			return errStopIteration
+		}
+
+		addrHash := types.BytesToHash(key)
+		batch.Put(disk.accountKey(addrHash), value)
+
+		var account state.Account
+		if err := account.UnmarshalRlp(value); err != nil {
+			return fmt.Errorf("can't parse account %s: %w", addrHash, err)
+		}
+
+		if account.Root != types.EmptyRootHash {
+			storageRoot, ok, err := GetNode(account.Root.Bytes(), disk.db)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				return fmt.Errorf("storage not found at hash %s", account.Root)
+			}
+
+			epoch := disk.epoch(addrHash)
+
+			if err := iterateTrie(storageRoot, disk.db, nil, func(slot, slotValue []byte) error {
+				batch.Put(disk.storageKey(addrHash, epoch, types.BytesToHash(slot)), slotValue)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		marker = key
+		count++
+
+		return nil
+	})
+
+	switch {
+	case err == nil:
+		disk.generated = true
+	case !errors.Is(err, errStopIteration):
+		return false, err
+	}
+
+	disk.marker = marker
+	disk.writeMeta(batch)
+	batch.Write()
+
+	return disk.generated, nil
+}
+
+// iterateTrie calls the handler for each leaf of the trie, in the order of the keys,
+// starting with the given key (all the keys if nil). The iteration stops once the handler returns an error
+func iterateTrie(root Node, storage Storage, start []byte, handler func(key, value []byte) error) error {
+	var startNibbles []byte
+	if start != nil {
+		startNibbles = bytesToHexNibbles(start)
+		startNibbles = startNibbles[:len(startNibbles)-1]
+	}
+
+	return iterateNode(root, storage, nil, startNibbles, handler)
+}
+
+func iterateNode(node Node, storage Storage, path, start []byte, handler func(key, value []byte) error) error {
+	// the subtrees preceding the start key are skipped
+	if prefix := path; len(start) > 0 {
+		if hasTerminator(prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+
+		n := len(prefix)
+		if n > len(start) {
+			n = len(start)
+		}
+
+		if bytes.Compare(prefix[:n], start[:n]) < 0 {
+			return nil
+		}
+	}
+
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	case *ValueNode:
+		if n.hash {
+			nc, ok, err := GetNode(n.buf, storage)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				return fmt.Errorf("trie node not found %x", n.buf)
+			}
+
+			return iterateNode(nc, storage, path, start, handler)
+		}
+
+		return handler(hexNibblesToBytes(path), n.buf)
+
+	case *ShortNode:
+		return iterateNode(n.child, storage, concat(path, n.key), start, handler)
+
+	case *FullNode:
+		if err := iterateNode(n.value, storage, concat(path, []byte{16}), start, handler); err != nil {
+			return err
+		}
+
+		for i, child := range n.children {
+			if child == nil {
+				continue
+			}
+
+			if err := iterateNode(child, storage, concat(path, []byte{byte(i)}), start, handler); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown node type %T", n)
+	}
+}
@@ -0,0 +1,300 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	flatTestAccounts = 50
+	flatTestSlots    = 10
+)
+
+func flatTestAddress(i int) types.Address {
+	return types.BytesToAddress(big.NewInt(int64(i + 1)).Bytes())
+}
+
+func flatTestSlot(i int) types.Hash {
+	return types.BytesToHash(big.NewInt(int64(i + 1)).Bytes())
+}
+
+// commitFlatTestState commits the objects on top of the snapshot at the given root
+func commitFlatTestState(t *testing.T, st *State, root types.Hash, objs []*state.Object) types.Hash {
+	t.Helper()
+
+	snap, err := st.NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	for _, obj := range objs {
+		// the objects are committed on top of the existing accounts
+		if account, err := snap.GetAccount(obj.Address); err == nil && account != nil && obj.Root == (types.Hash{}) {
+			obj.Root = account.Root
+		}
+
+		if obj.Root == (types.Hash{}) {
+			obj.Root = types.EmptyRootHash
+		}
+
+		if obj.Balance == nil {
+			obj.Balance = big.NewInt(0)
+		}
+
+		if obj.CodeHash == (types.Hash{}) {
+			obj.CodeHash = types.EmptyCodeHash
+		}
+	}
+
+	_, newRoot := snap.Commit(objs)
+
+	return types.BytesToHash(newRoot)
+}
+
+// requireFlatStateMatchesTrie checks that the flat state reads at the root are the same as the trie reads
+func requireFlatStateMatchesTrie(t *testing.T, st *State, root types.Hash) {
+	t.Helper()
+
+	flatSnap, err := st.NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	// the state without the flat state, on top of the same storage
+	trieSnap, err := NewState(st.storage).NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	for i := 0; i < flatTestAccounts+2; i++ {
+		addr := flatTestAddress(i)
+
+		expected, err := trieSnap.GetAccount(addr)
+		require.NoError(t, err)
+
+		actual, err := flatSnap.GetAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual, "account %d", i)
+
+		if expected == nil {
+			continue
+		}
+
+		for j := 0; j < flatTestSlots+2; j++ {
+			require.Equal(t,
+				trieSnap.GetStorage(addr, expected.Root, flatTestSlot(j)),
+				flatSnap.GetStorage(addr, expected.Root, flatTestSlot(j)),
+				"account %d slot %d", i, j)
+		}
+	}
+}
+
+func newFlatTestState(t *testing.T) (*State, types.Hash) {
+	t.Helper()
+
+	st := NewState(NewMemoryStorage())
+
+	objs := make([]*state.Object, flatTestAccounts)
+	for i := range objs {
+		objs[i] = &state.Object{
+			Address: flatTestAddress(i),
+			Balance: big.NewInt(int64(i)),
+			Nonce:   uint64(i),
+		}
+
+		// every other account has the storage
+		if i%2 == 0 {
+			for j := 0; j < flatTestSlots; j++ {
+				objs[i].Storage = append(objs[i].Storage, &state.StorageObject{
+					Key: flatTestSlot(j).Bytes(),
+					Val: big.NewInt(int64(i*100 + j + 1)).Bytes(),
+				})
+			}
+		}
+	}
+
+	return st, commitFlatTestState(t, st, types.EmptyRootHash, objs)
+}
+
+func TestFlatState_Generate(t *testing.T) {
+	t.Parallel()
+
+	st, root := newFlatTestState(t)
+
+	flat, err := newFlatTree(st.storage, root, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	// the generation is stopped, and continued in small batches
+	close(flat.closeCh)
+	<-flat.doneCh
+
+	st.flat.Store(flat)
+
+	for {
+		requireFlatStateMatchesTrie(t, st, root)
+
+		done, err := flat.generateBatch(7)
+		require.NoError(t, err)
+
+		if done {
+			break
+		}
+	}
+
+	require.True(t, flat.disk.generated)
+	requireFlatStateMatchesTrie(t, st, root)
+}
+
+func TestFlatState_DiffLayers(t *testing.T) {
+	t.Parallel()
+
+	st, root := newFlatTestState(t)
+	require.NoError(t, st.EnableFlatState(root, hclog.NewNullLogger()))
+
+	flat := st.flat.Load()
+	<-flat.doneCh
+
+	// the accounts are updated, deleted and recreated, and the storage slots are updated and deleted
+	root1 := commitFlatTestState(t, st, root, []*state.Object{
+		{Address: flatTestAddress(1), Balance: big.NewInt(1000), Nonce: 5},
+		{Address: flatTestAddress(2), Deleted: true},
+		{Address: flatTestAddress(4), Root: types.EmptyRootHash, Nonce: 1, Storage: []*state.StorageObject{
+			{Key: flatTestSlot(0).Bytes(), Val: []byte{0x1}},
+		}},
+		{Address: flatTestAddress(6), Storage: []*state.StorageObject{
+			{Key: flatTestSlot(0).Bytes(), Deleted: true},
+			{Key: flatTestSlot(flatTestSlots).Bytes(), Val: []byte{0x2}},
+		}},
+		{Address: flatTestAddress(flatTestAccounts), Balance: big.NewInt(1)},
+	})
+
+	// the fork of the same parent
+	root2 := commitFlatTestState(t, st, root, []*state.Object{
+		{Address: flatTestAddress(8), Storage: []*state.StorageObject{
+			{Key: flatTestSlot(1).Bytes(), Val: []byte{0x3}},
+		}},
+	})
+
+	require.Len(t, flat.layers, 3)
+
+	for _, r := range []types.Hash{root, root1, root2} {
+		requireFlatStateMatchesTrie(t, st, r)
+	}
+
+	// the deep chain is flattened into the disk layer, and the fork is dropped
+	head := root1
+	for i := 0; i < maxFlatDiffLayers+2; i++ {
+		head = commitFlatTestState(t, st, head, []*state.Object{
+			{Address: flatTestAddress(i % flatTestAccounts), Nonce: uint64(1000 + i), Storage: []*state.StorageObject{
+				{Key: flatTestSlot(i % flatTestSlots).Bytes(), Val: big.NewInt(int64(i + 1)).Bytes()},
+			}},
+		})
+	}
+
+	require.Len(t, flat.layers, maxFlatDiffLayers+1)
+	require.NotEqual(t, root, flat.disk.root)
+
+	for _, r := range []types.Hash{root, root1, root2} {
+		_, ok := flat.layers[r]
+		require.False(t, ok)
+	}
+
+	requireFlatStateMatchesTrie(t, st, flat.disk.root)
+	requireFlatStateMatchesTrie(t, st, head)
+
+	// the latest layers are persisted on close, so the flat state is reused
+	st.CloseFlatState()
+
+	require.NoError(t, st.EnableFlatState(head, hclog.NewNullLogger()))
+	defer st.CloseFlatState()
+
+	reopened := st.flat.Load()
+	require.True(t, reopened.disk.generated)
+	require.Equal(t, head, reopened.disk.root)
+	require.Equal(t, flat.disk.genID, reopened.disk.genID)
+
+	requireFlatStateMatchesTrie(t, st, head)
+}
+
+func TestFlatState_StorageAtPreviousRoot(t *testing.T) {
+	t.Parallel()
+
+	st, root := newFlatTestState(t)
+	require.NoError(t, st.EnableFlatState(root, hclog.NewNullLogger()))
+
+	<-st.flat.Load().doneCh
+
+	addr := flatTestAddress(0)
+
+	snap, err := st.NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(addr)
+	require.NoError(t, err)
+
+	expected := snap.GetStorage(addr, account.Root, flatTestSlot(0))
+	require.NotEqual(t, types.ZeroHash, expected)
+
+	newRoot := commitFlatTestState(t, st, root, []*state.Object{
+		{Address: addr, Storage: []*state.StorageObject{
+			{Key: flatTestSlot(0).Bytes(), Val: []byte{0xff}},
+		}},
+	})
+
+	newSnap, err := st.NewSnapshotAt(newRoot)
+	require.NoError(t, err)
+
+	// the storage at the previous storage root isn't affected by the newer flat state
+	require.Equal(t, expected, newSnap.GetStorage(addr, account.Root, flatTestSlot(0)))
+
+	newAccount, err := newSnap.GetAccount(addr)
+	require.NoError(t, err)
+	require.Equal(t, types.BytesToHash([]byte{0xff}), newSnap.GetStorage(addr, newAccount.Root, flatTestSlot(0)))
+}
+
+func TestIterateTrie(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	txn := NewTrie().Txn(storage)
+	txn.batch = storage
+
+	keys := make([][]byte, 100)
+	for i := range keys {
+		keys[i] = hashit(big.NewInt(int64(i)).Bytes())
+		txn.Insert(keys[i], keys[i])
+	}
+
+	root, err := txn.Hash()
+	require.NoError(t, err)
+
+	node, _, err := GetNode(root, storage)
+	require.NoError(t, err)
+
+	var iterated [][]byte
+
+	require.NoError(t, iterateTrie(node, storage, nil, func(key, value []byte) error {
+		require.Equal(t, key, value)
+
+		if len(iterated) > 0 {
+			require.Less(t, string(iterated[len(iterated)-1]), string(key))
+		}
+
+		iterated = append(iterated, key)
+
+		return nil
+	}))
+
+	require.Len(t, iterated, len(keys))
+
+	// the iteration starts with the given key
+	var fromMiddle [][]byte
+
+	require.NoError(t, iterateTrie(node, storage, iterated[50], func(key, _ []byte) error {
+		fromMiddle = append(fromMiddle, key)
+
+		return nil
+	}))
+
+	require.Equal(t, iterated[50:], fromMiddle)
+}
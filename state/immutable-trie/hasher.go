@@ -17,6 +17,10 @@ var (
 	emptyRoot = types.StringToHash("56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421").Bytes()
 )
 
+// concurrentHashMinChildren is the min number of the unhashed children of the root node,
+// for which the children subtries are hashed concurrently
+const concurrentHashMinChildren = 4
+
 var hasherPool = sync.Pool{
 	New: func() interface{} {
 		impl, ok := sha3.NewLegacyKeccak256().(hashImpl)
@@ -97,6 +101,10 @@ func (t *Txn) Hash() ([]byte, error) {
 
 	var root []byte
 
+	if n, ok := t.root.(*FullNode); ok {
+		t.hashChildrenConcurrently(n)
+	}
+
 	arena, _ := h.AcquireArena()
 	val := t.hash(t.root, h, arena, 0)
 
@@ -133,6 +141,69 @@ func (t *Txn) Hash() ([]byte, error) {
 	return root, nil
 }
 
+// hashChildrenConcurrently hashes the unhashed children subtries of the full node in separate goroutines,
+// so that the sequential hashing of the node only hashes the node itself. The nodes written by the goroutines
+// are buffered and written to the batch once all of them are done
+func (t *Txn) hashChildrenConcurrently(n *FullNode) {
+	if _, ok := n.Hash(); ok {
+		return
+	}
+
+	children := make([]Node, 0, len(n.children))
+
+	for _, child := range n.children {
+		if child == nil {
+			continue
+		}
+
+		if _, ok := child.Hash(); !ok {
+			children = append(children, child)
+		}
+	}
+
+	if len(children) < concurrentHashMinChildren {
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	buffers := make([]*batchBuffer, len(children))
+
+	for i, child := range children {
+		wg.Add(1)
+
+		go func(i int, child Node) {
+			defer wg.Done()
+
+			h, ok := hasherPool.Get().(*hasher)
+			if !ok {
+				return
+			}
+
+			txn := &Txn{}
+
+			if t.batch != nil {
+				buffers[i] = &batchBuffer{}
+				txn.batch = buffers[i]
+			}
+
+			arena, _ := h.AcquireArena()
+			txn.hash(child, h, arena, 1)
+
+			h.ReleaseArenas(0)
+			hasherPool.Put(h)
+		}(i, child)
+	}
+
+	wg.Wait()
+
+	for _, buffer := range buffers {
+		if buffer != nil {
+			buffer.writeTo(t.batch)
+		}
+	}
+}
+
 func (t *Txn) hash(node Node, h *hasher, a *fastrlp.Arena, d int) *fastrlp.Value {
 	var val *fastrlp.Value
 
@@ -0,0 +1,58 @@
+package itrie
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxn_Hash_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	keys := make([][]byte, 1000)
+	for i := range keys {
+		keys[i] = make([]byte, 32)
+		rand.Read(keys[i])
+	}
+
+	// the root children are hashed concurrently once all the keys are inserted
+	concurrentStorage := NewMemoryStorage()
+	concurrentTxn := NewTrie().Txn(concurrentStorage)
+	concurrentTxn.batch = concurrentStorage
+
+	for _, key := range keys {
+		concurrentTxn.Insert(key, key)
+	}
+
+	root, err := concurrentTxn.Hash()
+	require.NoError(t, err)
+
+	// the trie is hashed after each insertion, so at most a single root child is unhashed
+	sequentialStorage := NewMemoryStorage()
+	trie := NewTrie()
+
+	for _, key := range keys {
+		txn := trie.Txn(sequentialStorage)
+		txn.batch = sequentialStorage
+		txn.Insert(key, key)
+
+		_, err := txn.Hash()
+		require.NoError(t, err)
+
+		trie = txn.Commit()
+	}
+
+	require.Equal(t, trie.hashRoot(), root)
+
+	// all the nodes are written to the storage
+	loaded := &Trie{}
+	loaded.root, _, err = GetNode(root, concurrentStorage)
+	require.NoError(t, err)
+
+	for _, key := range keys {
+		value, ok := loaded.Get(key, concurrentStorage)
+		require.True(t, ok)
+		require.Equal(t, key, value)
+	}
+}
@@ -2,6 +2,8 @@ package itrie
 
 import (
 	"bytes"
+	"runtime"
+	"sync"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/state"
@@ -12,25 +14,36 @@ import (
 type Snapshot struct {
 	state *State
 	trie  *Trie
+	root  types.Hash
 }
 
 var emptyStateHash = types.StringToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
 
 func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.Hash) types.Hash {
-	var (
-		err  error
-		trie *Trie
-	)
-
+	// the storage of the account recreated on top of this snapshot is empty, regardless of the flat state
 	if root == emptyStateHash {
-		trie = s.state.newTrie()
-	} else {
-		trie, err = s.state.newTrieAt(root)
-		if err != nil {
-			return types.Hash{}
+		return types.Hash{}
+	}
+
+	// the flat state is at the snapshot root, so it only serves the storage root the account has there.
+	// The other roots, such as the roots at the beginning of the block flushed in the meantime, are read from the trie
+	if flat := s.state.flat.Load(); flat != nil && s.isStorageRootAt(addr, root) {
+		val, covered := flat.storage(s.root, types.BytesToHash(hashit(addr.Bytes())),
+			types.BytesToHash(hashit(rawkey.Bytes())))
+		if covered {
+			if val == nil {
+				return types.Hash{}
+			}
+
+			return decodeStorageValue(val)
 		}
 	}
 
+	trie, err := s.state.newTrieAt(root)
+	if err != nil {
+		return types.Hash{}
+	}
+
 	key := crypto.Keccak256(rawkey.Bytes())
 
 	val, ok := trie.Get(key, s.state.storage)
@@ -38,6 +51,21 @@ func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.
 		return types.Hash{}
 	}
 
+	return decodeStorageValue(val)
+}
+
+// isStorageRootAt returns true if the storage root is the root of the account at the snapshot root
+func (s *Snapshot) isStorageRootAt(addr types.Address, root types.Hash) bool {
+	account, err := s.GetAccount(addr)
+	if err != nil || account == nil {
+		return false
+	}
+
+	return account.Root == root
+}
+
+// decodeStorageValue decodes the RLP-encoded storage slot value
+func decodeStorageValue(val []byte) types.Hash {
 	p := &fastrlp.Parser{}
 
 	v, err := p.Parse(val)
@@ -56,8 +84,12 @@ func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.
 func (s *Snapshot) GetAccount(addr types.Address) (*state.Account, error) {
 	key := crypto.Keccak256(addr.Bytes())
 
-	data, ok := s.trie.Get(key, s.state.storage)
+	data, ok := s.flatAccount(key)
 	if !ok {
+		data, ok = s.trie.Get(key, s.state.storage)
+	}
+
+	if !ok || data == nil {
 		return nil, nil
 	}
 
@@ -69,6 +101,17 @@ func (s *Snapshot) GetAccount(addr types.Address) (*state.Account, error) {
 	return &account, nil
 }
 
+// flatAccount returns the RLP-encoded account from the flat state,
+// the account is nil if it doesn't exist, and ok is false if the flat state can't serve the read
+func (s *Snapshot) flatAccount(key []byte) ([]byte, bool) {
+	flat := s.state.flat.Load()
+	if flat == nil {
+		return nil, false
+	}
+
+	return flat.account(s.root, types.BytesToHash(key))
+}
+
 func (s *Snapshot) GetCode(hash types.Hash) ([]byte, bool) {
 	return s.state.GetCode(hash)
 }
@@ -82,6 +125,13 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 	arena := stateArenaPool.Get()
 	defer stateArenaPool.Put(arena)
 
+	// the storage tries are independent, so they are updated and hashed concurrently
+	storageRoots := s.commitStorageTries(objs, batch)
+
+	// the encoded accounts are kept for the flat state diff layer
+	flat := s.state.flat.Load()
+	accounts := make(map[*state.Object][]byte)
+
 	for _, obj := range objs {
 		if obj.Deleted {
 			tt.Delete(hashit(obj.Address.Bytes()))
@@ -93,32 +143,8 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 				Root:     obj.Root, // old root
 			}
 
-			if len(obj.Storage) != 0 {
-				trie, err := s.state.newTrieAt(obj.Root)
-				if err != nil {
-					panic(err) //nolint:gocritic
-				}
-
-				localTxn := trie.Txn(s.state.storage)
-				localTxn.batch = batch
-
-				for _, entry := range obj.Storage {
-					k := hashit(entry.Key)
-					if entry.Deleted {
-						localTxn.Delete(k)
-					} else {
-						vv := arena.NewBytes(bytes.TrimLeft(entry.Val, "\x00"))
-						localTxn.Insert(k, vv.MarshalTo(nil))
-					}
-				}
-
-				accountStateRoot, _ := localTxn.Hash()
-				accountStateTrie := localTxn.Commit()
-
-				// Add this to the cache
-				s.state.AddState(types.BytesToHash(accountStateRoot), accountStateTrie)
-
-				account.Root = types.BytesToHash(accountStateRoot)
+			if root, ok := storageRoots[obj]; ok {
+				account.Root = root
 			}
 
 			if obj.DirtyCode {
@@ -129,6 +155,10 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 			data := vv.MarshalTo(nil)
 
 			tt.Insert(hashit(obj.Address.Bytes()), data)
+
+			if flat != nil {
+				accounts[obj] = data
+			}
 			arena.Reset()
 		}
 	}
@@ -142,5 +172,112 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 
 	s.state.AddState(types.BytesToHash(root), nTrie)
 
-	return &Snapshot{trie: nTrie, state: s.state}, root
+	if flat != nil {
+		flat.update(types.BytesToHash(root), s.root, s.newFlatDiffLayer(objs, accounts))
+	}
+
+	return &Snapshot{trie: nTrie, state: s.state, root: types.BytesToHash(root)}, root
+}
+
+// commitStorageTries updates and hashes the storage tries of the objects concurrently,
+// and returns their new storage roots. The objects with the same storage root share the trie nodes,
+// so they are committed by the same goroutine. The trie nodes are buffered by the goroutines,
+// and written to the batch once all of them are done
+func (s *Snapshot) commitStorageTries(objs []*state.Object, batch Batch) map[*state.Object]types.Hash {
+	groups := make([][]*state.Object, 0)
+	groupByRoot := make(map[types.Hash]int)
+
+	for _, obj := range objs {
+		if obj.Deleted || len(obj.Storage) == 0 {
+			continue
+		}
+
+		// the new empty tries don't share any nodes
+		if idx, ok := groupByRoot[obj.Root]; ok && obj.Root != types.EmptyRootHash {
+			groups[idx] = append(groups[idx], obj)
+
+			continue
+		}
+
+		groupByRoot[obj.Root] = len(groups)
+		groups = append(groups, []*state.Object{obj})
+	}
+
+	var (
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+		roots   = make(map[*state.Object]types.Hash, len(groups))
+		buffers = make([]*batchBuffer, 0, len(groups))
+		workers = make(chan struct{}, runtime.NumCPU())
+	)
+
+	for _, group := range groups {
+		wg.Add(1)
+
+		go func(group []*state.Object) {
+			defer wg.Done()
+
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			buffer := &batchBuffer{}
+
+			for _, obj := range group {
+				root := s.commitStorageTrie(obj, buffer)
+
+				lock.Lock()
+				roots[obj] = root
+				lock.Unlock()
+			}
+
+			lock.Lock()
+			buffers = append(buffers, buffer)
+			lock.Unlock()
+		}(group)
+	}
+
+	wg.Wait()
+
+	for _, buffer := range buffers {
+		buffer.writeTo(batch)
+	}
+
+	return roots
+}
+
+// commitStorageTrie applies the storage changes of the object to its storage trie, and returns the new root
+func (s *Snapshot) commitStorageTrie(obj *state.Object, batch Putter) types.Hash {
+	trie, err := s.state.newTrieAt(obj.Root)
+	if err != nil {
+		panic(err) //nolint:gocritic
+	}
+
+	arena := stateArenaPool.Get()
+	defer stateArenaPool.Put(arena)
+
+	localTxn := trie.Txn(s.state.storage)
+	localTxn.batch = batch
+
+	for _, entry := range obj.Storage {
+		k := hashit(entry.Key)
+		if entry.Deleted {
+			localTxn.Delete(k)
+		} else {
+			localTxn.Insert(k, encodeStorageValue(arena, entry.Val))
+			arena.Reset()
+		}
+	}
+
+	accountStateRoot, _ := localTxn.Hash()
+	accountStateTrie := localTxn.Commit()
+
+	// Add this to the cache
+	s.state.AddState(types.BytesToHash(accountStateRoot), accountStateTrie)
+
+	return types.BytesToHash(accountStateRoot)
+}
+
+// encodeStorageValue encodes the storage slot value, as it is stored in the storage trie
+func encodeStorageValue(arena *fastrlp.Arena, val []byte) []byte {
+	return arena.NewBytes(bytes.TrimLeft(val, "\x00")).MarshalTo(nil)
 }
@@ -2,7 +2,9 @@ package itrie
 
 import (
 	"fmt"
+	"sync/atomic"
 
+	"github.com/hashicorp/go-hclog"
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/0xPolygon/polygon-edge/state"
@@ -12,6 +14,9 @@ import (
 type State struct {
 	storage Storage
 	cache   *lru.Cache
+
+	// flat is the flat state serving the account and storage reads, nil if disabled
+	flat atomic.Pointer[flatTree]
 }
 
 func NewState(storage Storage) *State {
@@ -26,7 +31,7 @@ func NewState(storage Storage) *State {
 }
 
 func (s *State) NewSnapshot() state.Snapshot {
-	return &Snapshot{state: s, trie: s.newTrie()}
+	return &Snapshot{state: s, trie: s.newTrie(), root: types.EmptyRootHash}
 }
 
 func (s *State) NewSnapshotAt(root types.Hash) (state.Snapshot, error) {
@@ -35,7 +40,27 @@ func (s *State) NewSnapshotAt(root types.Hash) (state.Snapshot, error) {
 		return nil, err
 	}
 
-	return &Snapshot{state: s, trie: t}, nil
+	return &Snapshot{state: s, trie: t, root: root}, nil
+}
+
+// EnableFlatState enables the flat state layer at the given (head) state root. The persisted flat state
+// is reused if it is at the given root, otherwise it is generated from the trie in the background
+func (s *State) EnableFlatState(root types.Hash, logger hclog.Logger) error {
+	flat, err := newFlatTree(s.storage, root, logger)
+	if err != nil {
+		return fmt.Errorf("failed to load flat state: %w", err)
+	}
+
+	s.flat.Store(flat)
+
+	return nil
+}
+
+// CloseFlatState stops the flat state generation, and persists the flat state of the latest root
+func (s *State) CloseFlatState() {
+	if flat := s.flat.Swap(nil); flat != nil {
+		flat.close()
+	}
 }
 
 func (s *State) newTrie() *Trie {
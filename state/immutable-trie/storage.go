@@ -23,6 +23,25 @@ type Batch interface {
 	Write()
 }
 
+// batchBuffer buffers the writes, so that they can be prepared concurrently
+// and written to the (not thread-safe) batch at once
+type batchBuffer struct {
+	keys   [][]byte
+	values [][]byte
+}
+
+func (b *batchBuffer) Put(k, v []byte) {
+	b.keys = append(b.keys, append([]byte(nil), k...))
+	b.values = append(b.values, append([]byte(nil), v...))
+}
+
+// writeTo writes the buffered entries to the given putter
+func (b *batchBuffer) writeTo(putter Putter) {
+	for i, k := range b.keys {
+		putter.Put(k, b.values[i])
+	}
+}
+
 // Storage stores the trie
 type Storage interface {
 	Put(k, v []byte)
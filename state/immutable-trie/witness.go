@@ -0,0 +1,131 @@
+package itrie
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// witnessStorage records the trie nodes and the codes read from the underlying storage.
+// The writes are kept in memory, so the underlying storage is never modified
+type witnessStorage struct {
+	disk   Storage
+	writes Storage
+
+	lock  sync.Mutex
+	nodes map[types.Hash][]byte
+	codes map[types.Hash][]byte
+}
+
+func newWitnessStorage(disk Storage) *witnessStorage {
+	return &witnessStorage{
+		disk:   disk,
+		writes: NewMemoryStorage(),
+		nodes:  make(map[types.Hash][]byte),
+		codes:  make(map[types.Hash][]byte),
+	}
+}
+
+func (w *witnessStorage) Put(k, v []byte) {
+	w.writes.Put(k, v)
+}
+
+func (w *witnessStorage) Get(k []byte) ([]byte, bool) {
+	// the nodes written by the execution are not part of the pre-state
+	if v, ok := w.writes.Get(k); ok {
+		return v, true
+	}
+
+	v, ok := w.disk.Get(k)
+	if ok && len(k) == types.HashLength {
+		w.lock.Lock()
+		w.nodes[types.BytesToHash(k)] = v
+		w.lock.Unlock()
+	}
+
+	return v, ok
+}
+
+func (w *witnessStorage) Batch() Batch {
+	return w.writes.Batch()
+}
+
+func (w *witnessStorage) SetCode(hash types.Hash, code []byte) {
+	w.writes.SetCode(hash, code)
+}
+
+func (w *witnessStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	if code, ok := w.writes.GetCode(hash); ok {
+		return code, true
+	}
+
+	code, ok := w.disk.GetCode(hash)
+	if ok {
+		w.lock.Lock()
+		w.codes[hash] = code
+		w.lock.Unlock()
+	}
+
+	return code, ok
+}
+
+func (w *witnessStorage) Close() error {
+	return nil
+}
+
+// witness returns the recorded trie nodes and codes, sorted by their hashes
+func (w *witnessStorage) witness() *state.Witness {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return &state.Witness{
+		Nodes: sortedByHash(w.nodes),
+		Codes: sortedByHash(w.codes),
+	}
+}
+
+func sortedByHash(items map[types.Hash][]byte) [][]byte {
+	hashes := make([]types.Hash, 0, len(items))
+	for hash := range items {
+		hashes = append(hashes, hash)
+	}
+
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i].Bytes(), hashes[j].Bytes()) < 0
+	})
+
+	result := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		result[i] = items[hash]
+	}
+
+	return result
+}
+
+// NewWitnessState returns the state which records the trie nodes and the codes read by the execution.
+// The state doesn't share the trie cache and the flat state, so every node accessed is read from the storage
+func (s *State) NewWitnessState() (state.State, func() *state.Witness) {
+	storage := newWitnessStorage(s.storage)
+
+	return NewState(storage), storage.witness
+}
+
+// NewStateFromWitness returns the in-memory state holding the trie nodes and the codes of the witness,
+// which can execute the block the witness was recorded for
+func NewStateFromWitness(witness *state.Witness) *State {
+	storage := NewMemoryStorage()
+
+	for _, node := range witness.Nodes {
+		storage.Put(crypto.Keccak256(node), node)
+	}
+
+	for _, code := range witness.Codes {
+		storage.SetCode(types.BytesToHash(crypto.Keccak256(code)), code)
+	}
+
+	return NewState(storage)
+}
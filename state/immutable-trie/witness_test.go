@@ -0,0 +1,98 @@
+package itrie
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestState_Witness(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender   = types.StringToAddress("1000")
+		receiver = types.StringToAddress("1001")
+		contract = types.StringToAddress("1002")
+
+		// increments the slot 0
+		incrementCode = []byte{0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x60, 0x00, 0x55, 0x00}
+	)
+
+	params := &chain.Params{
+		Forks:        chain.AllForksEnabled,
+		BurnContract: map[uint64]types.Address{0: types.ZeroAddress},
+	}
+
+	newExecutor := func(s state.State) *state.Executor {
+		executor := state.NewExecutor(params, s, hclog.NewNullLogger())
+		executor.GetHash = func(*types.Header) func(uint64) types.Hash {
+			return func(uint64) types.Hash { return types.ZeroHash }
+		}
+
+		return executor
+	}
+
+	alloc := map[types.Address]*chain.GenesisAccount{
+		sender: {Balance: big.NewInt(1000)},
+		contract: {
+			Balance: big.NewInt(0),
+			Code:    incrementCode,
+			Storage: map[types.Hash]types.Hash{types.ZeroHash: types.StringToHash("1")},
+		},
+	}
+
+	// the accounts which are not accessed by the block
+	for i := 0; i < 100; i++ {
+		alloc[types.StringToAddress(fmt.Sprintf("%d", 2000+i))] = &chain.GenesisAccount{Balance: big.NewInt(1)}
+	}
+
+	storage, ok := NewMemoryStorage().(*memStorage)
+	require.True(t, ok)
+
+	st := NewState(storage)
+
+	genesisRoot, err := newExecutor(st).WriteGenesis(alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	block := &types.Block{
+		Header: &types.Header{Number: 1, GasLimit: 10000000},
+		Transactions: []*types.Transaction{
+			{From: sender, To: &contract, Nonce: 0, Gas: 100000, GasPrice: big.NewInt(0), Value: big.NewInt(0)},
+			{From: sender, To: &receiver, Nonce: 1, Gas: 21000, GasPrice: big.NewInt(0), Value: big.NewInt(10)},
+		},
+	}
+
+	execute := func(s state.State) types.Hash {
+		transition, err := newExecutor(s).ProcessBlock(genesisRoot, block, types.ZeroAddress)
+		require.NoError(t, err)
+
+		_, root, err := transition.Commit()
+		require.NoError(t, err)
+
+		return root
+	}
+
+	nodesCount := len(storage.db)
+
+	witnessState, witness := st.NewWitnessState()
+	root := execute(witnessState)
+
+	// the witness execution doesn't modify the storage
+	require.Len(t, storage.db, nodesCount)
+
+	w := witness()
+	require.NotEmpty(t, w.Nodes)
+	require.Less(t, len(w.Nodes), nodesCount)
+	require.Equal(t, [][]byte{incrementCode}, w.Codes)
+
+	// the block is executed on top of the witness, without the rest of the state
+	require.Equal(t, root, execute(NewStateFromWitness(w)))
+	require.Equal(t, root, execute(st))
+}
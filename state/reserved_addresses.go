@@ -0,0 +1,46 @@
+package state
+
+import (
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// reservedAddresses is the runtime of the address ranges reserved for the system contracts
+type reservedAddresses struct {
+	ranges      []*chain.AddressRange
+	entryPoints map[types.Address]struct{}
+}
+
+func newReservedAddresses(config *chain.ReservedAddressesConfig) *reservedAddresses {
+	r := &reservedAddresses{
+		ranges:      config.Ranges,
+		entryPoints: make(map[types.Address]struct{}, len(config.EntryPoints)),
+	}
+
+	for _, addr := range config.EntryPoints {
+		r.entryPoints[addr] = struct{}{}
+	}
+
+	return r
+}
+
+// reserved returns true if the address is within any of the reserved ranges
+func (r *reservedAddresses) reserved(addr types.Address) bool {
+	for _, addrRange := range r.ranges {
+		if addrRange.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// callable returns true if the user transaction can call the address directly,
+// which is the case for the addresses out of the reserved ranges and for the entry points
+func (r *reservedAddresses) callable(addr types.Address) bool {
+	if _, ok := r.entryPoints[addr]; ok {
+		return true
+	}
+
+	return !r.reserved(addr)
+}
@@ -54,7 +54,7 @@ var (
 	errNoFunctionSignature = fmt.Errorf("input is too short for a function call")
 	errInputTooShort       = fmt.Errorf("wrong input size, expected 32")
 	errFunctionNotFound    = fmt.Errorf("function not found")
-	errWriteProtection     = fmt.Errorf("write protection")
+	errWriteProtection     = runtime.ErrWriteProtection
 	errAdminSelfRemove     = fmt.Errorf("cannot remove admin role from caller")
 )
 
@@ -138,6 +138,18 @@ func (m *mockHostF) GetRefund() uint64 {
 	return m.refund
 }
 
+func (m *mockHostF) IsStorageExpired(addr types.Address, key types.Hash) bool {
+	return false
+}
+
+func (m *mockHostF) AddAddressToAccessList(addr types.Address) bool {
+	return false
+}
+
+func (m *mockHostF) AddSlotToAccessList(addr types.Address, slot types.Hash) bool {
+	return false
+}
+
 func FuzzTestEVM(f *testing.F) {
 	seed := []byte{
 		PUSH1, 0x01, PUSH1, 0x02, ADD,
@@ -110,6 +110,18 @@ func (m *mockHost) GetRefund() uint64 {
 	panic("Not implemented in tests") //nolint:gocritic
 }
 
+func (m *mockHost) IsStorageExpired(addr types.Address, key types.Hash) bool {
+	panic("Not implemented in tests") //nolint:gocritic
+}
+
+func (m *mockHost) AddAddressToAccessList(addr types.Address) bool {
+	panic("Not implemented in tests") //nolint:gocritic
+}
+
+func (m *mockHost) AddSlotToAccessList(addr types.Address, slot types.Hash) bool {
+	panic("Not implemented in tests") //nolint:gocritic
+}
+
 func TestRun(t *testing.T) {
 	t.Parallel()
 
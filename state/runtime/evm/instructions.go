@@ -461,11 +461,35 @@ func opMStore8(c *state) {
 
 // --- storage ---
 
+// eip-2929 state access costs
+const (
+	coldAccountAccessCost uint64 = 2600
+	coldSloadCost         uint64 = 2100
+	warmStorageReadCost   uint64 = 100
+)
+
+// addressAccessCost returns the cost of accessing the account on the berlin fork (eip-2929),
+// the account is warm afterwards
+func (c *state) addressAccessCost(addr types.Address) uint64 {
+	if c.host.AddAddressToAccessList(addr) {
+		return warmStorageReadCost
+	}
+
+	return coldAccountAccessCost
+}
+
 func opSload(c *state) {
 	loc := c.top()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		if c.host.AddSlotToAccessList(c.msg.Address, bigToHash(loc)) {
+			gas = warmStorageReadCost
+		} else {
+			gas = coldSloadCost
+		}
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 800
 	} else if c.config.EIP150 {
@@ -478,6 +502,12 @@ func opSload(c *state) {
 		return
 	}
 
+	if c.config.StorageRent && c.host.IsStorageExpired(c.msg.Address, bigToHash(loc)) {
+		c.exit(errStorageExpired)
+
+		return
+	}
+
 	val := c.host.GetStorage(c.msg.Address, bigToHash(loc))
 	loc.SetBytes(val.Bytes())
 }
@@ -498,14 +528,29 @@ func opSStore(c *state) {
 	key := c.popHash()
 	val := c.popHash()
 
+	if c.config.StorageRent && c.host.IsStorageExpired(c.msg.Address, key) {
+		c.exit(errStorageExpired)
+
+		return
+	}
+
 	legacyGasMetering := !c.config.Istanbul && (c.config.Petersburg || !c.config.Constantinople)
 
-	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
 	cost := uint64(0)
 
+	// eip-2929, the cold slot is charged on top of the cost of the storage change
+	// and the reads of the warm slot are charged instead of the eip-2200 reads
+	if c.config.Berlin && !c.host.AddSlotToAccessList(c.msg.Address, key) {
+		cost += coldSloadCost
+	}
+
+	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
+
 	switch status {
 	case runtime.StorageUnchanged:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -515,10 +560,16 @@ func opSStore(c *state) {
 		}
 
 	case runtime.StorageModified:
-		cost = 5000
+		if c.config.Berlin {
+			cost += 5000 - coldSloadCost
+		} else {
+			cost = 5000
+		}
 
 	case runtime.StorageModifiedAgain:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -528,10 +579,14 @@ func opSStore(c *state) {
 		}
 
 	case runtime.StorageAdded:
-		cost = 20000
+		cost += 20000
 
 	case runtime.StorageDeleted:
-		cost = 5000
+		if c.config.Berlin {
+			cost += 5000 - coldSloadCost
+		} else {
+			cost = 5000
+		}
 	}
 
 	if !c.consumeGas(cost) {
@@ -575,7 +630,10 @@ func opBalance(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.addressAccessCost(addr)
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 700
 	} else if c.config.EIP150 {
@@ -658,7 +716,10 @@ func opExtCodeSize(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.addressAccessCost(addr)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -693,7 +754,10 @@ func opExtCodeHash(c *state) {
 	address, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.addressAccessCost(address)
+	} else if c.config.Istanbul {
 		gas = 700
 	} else {
 		gas = 400
@@ -767,7 +831,10 @@ func opExtCodeCopy(c *state) {
 	}
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.addressAccessCost(address)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -929,9 +996,14 @@ func opSelfDestruct(c *state) {
 	// try to remove the gas first
 	var gas uint64
 
+	// eip-2929, only the cold beneficiary is charged
+	if c.config.Berlin && !c.host.AddAddressToAccessList(address) {
+		gas = coldAccountAccessCost
+	}
+
 	// EIP150 reprice fork
 	if c.config.EIP150 {
-		gas = 5000
+		gas += 5000
 
 		if c.config.EIP158 {
 			// if empty and transfers value
@@ -1226,7 +1298,10 @@ func (c *state) buildCallContract(op OpCode) (*runtime.Contract, uint64, uint64,
 	}
 
 	var gasCost uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gasCost = c.addressAccessCost(addr)
+	} else if c.config.EIP150 {
 		gasCost = 700
 	} else {
 		gasCost = 40
@@ -14,9 +14,16 @@ import (
 var (
 	two = big.NewInt(2)
 
-	allEnabledForks = chain.AllForksEnabled.At(0)
+	// the berlin fork is enabled by the access list tests, since the mock host doesn't track the accessed state
+	allEnabledForks = withoutBerlin(chain.AllForksEnabled.At(0))
 )
 
+func withoutBerlin(forks chain.ForksInTime) chain.ForksInTime {
+	forks.Berlin = false
+
+	return forks
+}
+
 type cases2To1 []struct {
 	a *big.Int
 	b *big.Int
@@ -728,3 +735,152 @@ func Test_opCall(t *testing.T) {
 		})
 	}
 }
+
+type mockHostForStorageRent struct {
+	mockHost
+	expired map[types.Hash]bool
+}
+
+func (m *mockHostForStorageRent) IsStorageExpired(_ types.Address, key types.Hash) bool {
+	return m.expired[key]
+}
+
+func TestStorageRent_ExpiredSlot(t *testing.T) {
+	t.Parallel()
+
+	expiredSlot := types.BytesToHash(big.NewInt(1).Bytes())
+
+	host := &mockHostForStorageRent{
+		expired: map[types.Hash]bool{expiredSlot: true},
+	}
+
+	config := allEnabledForks
+	config.StorageRent = true
+
+	t.Run("SLOAD", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.msg = &runtime.Contract{Address: addr1}
+		s.config = &config
+		s.host = host
+		s.gas = 1000
+
+		s.push(big.NewInt(1))
+
+		opSload(s)
+
+		assert.True(t, s.stop)
+		assert.ErrorIs(t, s.err, errStorageExpired)
+	})
+
+	t.Run("SSTORE", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.msg = &runtime.Contract{Address: addr1}
+		s.config = &config
+		s.host = host
+		s.gas = 10000
+
+		s.push(big.NewInt(5)) // value
+		s.push(big.NewInt(1)) // key
+
+		opSStore(s)
+
+		assert.True(t, s.stop)
+		assert.ErrorIs(t, s.err, errStorageExpired)
+	})
+}
+
+type mockHostForAccessList struct {
+	mockHost
+	warmAddresses map[types.Address]bool
+	warmSlots     map[types.Hash]bool
+}
+
+func (m *mockHostForAccessList) AddAddressToAccessList(addr types.Address) bool {
+	warm := m.warmAddresses[addr]
+	m.warmAddresses[addr] = true
+
+	return warm
+}
+
+func (m *mockHostForAccessList) AddSlotToAccessList(addr types.Address, slot types.Hash) bool {
+	m.warmAddresses[addr] = true
+
+	warm := m.warmSlots[slot]
+	m.warmSlots[slot] = true
+
+	return warm
+}
+
+func (m *mockHostForAccessList) GetBalance(addr types.Address) *big.Int {
+	return big.NewInt(0)
+}
+
+func (m *mockHostForAccessList) GetStorage(addr types.Address, key types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
+func TestAccessList_ColdAndWarmAccess(t *testing.T) {
+	t.Parallel()
+
+	config := allEnabledForks
+	config.Berlin = true
+
+	newHost := func() *mockHostForAccessList {
+		return &mockHostForAccessList{
+			warmAddresses: map[types.Address]bool{},
+			warmSlots:     map[types.Hash]bool{},
+		}
+	}
+
+	t.Run("BALANCE", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.msg = &runtime.Contract{Address: addr1}
+		s.config = &config
+		s.host = newHost()
+		s.gas = 10000
+
+		// cold account
+		s.push(new(big.Int).SetBytes(types.StringToAddress("2").Bytes()))
+		opBalance(s)
+		assert.Equal(t, 10000-coldAccountAccessCost, s.gas)
+
+		// warm account
+		s.push(new(big.Int).SetBytes(types.StringToAddress("2").Bytes()))
+		opBalance(s)
+		assert.Equal(t, 10000-coldAccountAccessCost-warmStorageReadCost, s.gas)
+	})
+
+	t.Run("SLOAD", func(t *testing.T) {
+		t.Parallel()
+
+		s, closeFn := getState()
+		defer closeFn()
+
+		s.msg = &runtime.Contract{Address: addr1}
+		s.config = &config
+		s.host = newHost()
+		s.gas = 10000
+
+		// cold slot
+		s.push(big.NewInt(1))
+		opSload(s)
+		assert.Equal(t, 10000-coldSloadCost, s.gas)
+
+		// warm slot
+		s.push(big.NewInt(1))
+		opSload(s)
+		assert.Equal(t, 10000-coldSloadCost-warmStorageReadCost, s.gas)
+	})
+}
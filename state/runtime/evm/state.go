@@ -40,7 +40,8 @@ var (
 	errOutOfGas              = runtime.ErrOutOfGas
 	errRevert                = runtime.ErrExecutionReverted
 	errGasUintOverflow       = errors.New("gas uint64 overflow")
-	errWriteProtection       = errors.New("write protection")
+	errWriteProtection       = runtime.ErrWriteProtection
+	errStorageExpired        = runtime.ErrStorageExpired
 	errInvalidJump           = errors.New("invalid jump destination")
 	errOpCodeNotFound        = errors.New("opcode not found")
 	errReturnDataOutOfBounds = errors.New("return data out of bounds")
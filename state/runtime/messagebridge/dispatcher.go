@@ -0,0 +1,239 @@
+package messagebridge
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// list of the function methods and the types of the message bridge
+var (
+	// OnStateReceiveFunc is the function the state receiver calls to deliver the state sync
+	OnStateReceiveFunc = abi.MustNewMethod("function onStateReceive(uint256,address,bytes)")
+	// OnMessageReceiveFunc is the function the dispatcher calls on the target contract to deliver the message
+	OnMessageReceiveFunc = abi.MustNewMethod("function onMessageReceive(uint256 nonce, address sender, bytes data)")
+	// MessageType is the ABI type of the message, carried as the data of the state sync
+	MessageType = abi.MustNewType("tuple(uint256 nonce, address target, uint256 gasLimit, bytes data)")
+
+	// messageDispatchedEvent is emitted once the message is successfully delivered to the target contract
+	messageDispatchedEvent = abi.MustNewEvent("event MessageDispatched(" +
+		"uint256 indexed nonce, address indexed sender, address indexed target)")
+)
+
+// dispatchBaseGas is the gas charged on top of the gas limit of the message,
+// it covers the message decoding, the replay protection write and the event
+var dispatchBaseGas = uint64(30000)
+
+var (
+	errNoFunctionSignature = errors.New("input is too short for a function call")
+	errFunctionNotFound    = errors.New("function not found")
+	errMessageProcessed    = errors.New("message is already processed")
+	errNoTarget            = errors.New("message target is not a contract")
+)
+
+// Message is the arbitrary cross-chain message, sent as the data of the state sync
+// whose receiver is the message dispatcher. The nonce is chosen by the sender contract
+// and every (sender, nonce) pair is dispatched at most once
+type Message struct {
+	Nonce    *big.Int
+	Target   types.Address
+	GasLimit uint64
+	Data     []byte
+}
+
+// Encode encodes the message into the state sync data
+func (m *Message) Encode() ([]byte, error) {
+	return MessageType.Encode(map[string]interface{}{
+		"nonce":    m.Nonce,
+		"target":   m.Target,
+		"gasLimit": new(big.Int).SetUint64(m.GasLimit),
+		"data":     m.Data,
+	})
+}
+
+// DecodeMessage decodes the message from the state sync data
+func DecodeMessage(data []byte) (*Message, error) {
+	raw, err := MessageType.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid message")
+	}
+
+	nonce, ok := fields["nonce"].(*big.Int)
+	if !ok {
+		return nil, errors.New("invalid message nonce")
+	}
+
+	target, ok := fields["target"].(ethgo.Address)
+	if !ok {
+		return nil, errors.New("invalid message target")
+	}
+
+	gasLimit, ok := fields["gasLimit"].(*big.Int)
+	if !ok || !gasLimit.IsUint64() {
+		return nil, errors.New("invalid message gas limit")
+	}
+
+	payload, ok := fields["data"].([]byte)
+	if !ok {
+		return nil, errors.New("invalid message data")
+	}
+
+	return &Message{
+		Nonce:    nonce,
+		Target:   types.Address(target),
+		GasLimit: gasLimit.Uint64(),
+		Data:     payload,
+	}, nil
+}
+
+// Dispatcher is the receiver precompile of the arbitrary cross-chain messages.
+// The messages are sent to the dispatcher as the state syncs from the rootchain, whose commitments
+// are signed by the validators like the rest of the state syncs. The state receiver delivers them
+// to the dispatcher, which calls onMessageReceive of the target contract with the original sender.
+// The processed messages are stored under keccak256(sender, nonce) in the dispatcher storage
+type Dispatcher struct {
+	addr types.Address
+}
+
+// NewDispatcher creates the message dispatcher at the given address
+func NewDispatcher(addr types.Address) *Dispatcher {
+	return &Dispatcher{addr: addr}
+}
+
+func (d *Dispatcher) Addr() types.Address {
+	return d.addr
+}
+
+func (d *Dispatcher) Run(c *runtime.Contract, host runtime.Host, config *chain.ForksInTime) *runtime.ExecutionResult {
+	ret, gasLeft, err := d.runInputCall(c, host, config)
+
+	return &runtime.ExecutionResult{
+		ReturnValue: ret,
+		GasUsed:     c.Gas - gasLeft,
+		GasLeft:     gasLeft,
+		Err:         err,
+	}
+}
+
+func (d *Dispatcher) runInputCall(c *runtime.Contract, host runtime.Host,
+	config *chain.ForksInTime) ([]byte, uint64, error) {
+	if c.Gas < dispatchBaseGas {
+		return nil, 0, runtime.ErrOutOfGas
+	}
+
+	gas := c.Gas - dispatchBaseGas
+
+	// only the state syncs are dispatched
+	if c.Caller != contracts.StateReceiverContract {
+		return nil, gas, runtime.ErrUnauthorizedCaller
+	}
+
+	if c.Static {
+		return nil, gas, runtime.ErrWriteProtection
+	}
+
+	if len(c.Input) < types.SignatureSize {
+		return nil, gas, errNoFunctionSignature
+	}
+
+	if !bytes.Equal(c.Input[:types.SignatureSize], OnStateReceiveFunc.ID()) {
+		return nil, gas, errFunctionNotFound
+	}
+
+	sender, msg, err := decodeStateSync(c.Input[types.SignatureSize:])
+	if err != nil {
+		return nil, gas, err
+	}
+
+	// replay protection
+	key := processedKey(sender, msg.Nonce)
+	if host.GetStorage(d.addr, key) != types.ZeroHash {
+		return nil, gas, errMessageProcessed
+	}
+
+	if host.GetCodeSize(msg.Target) == 0 {
+		return nil, gas, errNoTarget
+	}
+
+	if msg.GasLimit > gas {
+		return nil, 0, runtime.ErrOutOfGas
+	}
+
+	host.SetStorage(d.addr, key, types.BytesToHash([]byte{1}), config)
+
+	input, err := OnMessageReceiveFunc.Encode([]interface{}{msg.Nonce, sender, msg.Data})
+	if err != nil {
+		return nil, gas, err
+	}
+
+	call := runtime.NewContractCall(c.Depth+1, host.GetTxContext().Origin, d.addr, msg.Target,
+		big.NewInt(0), msg.GasLimit, host.GetCode(msg.Target), input)
+
+	result := host.Callx(call, host)
+
+	gas = gas - msg.GasLimit + result.GasLeft
+
+	if result.Failed() {
+		return result.ReturnValue, gas, fmt.Errorf("%w: message %s of %s failed: %s",
+			runtime.ErrExecutionReverted, msg.Nonce, sender, result.Err.Error())
+	}
+
+	host.EmitLog(d.addr, []types.Hash{
+		types.Hash(messageDispatchedEvent.ID()),
+		types.BytesToHash(msg.Nonce.Bytes()),
+		types.BytesToHash(sender.Bytes()),
+		types.BytesToHash(msg.Target.Bytes()),
+	}, nil)
+
+	return result.ReturnValue, gas, nil
+}
+
+// decodeStateSync decodes the sender and the message from the onStateReceive input
+func decodeStateSync(input []byte) (types.Address, *Message, error) {
+	raw, err := OnStateReceiveFunc.Inputs.Decode(input)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	fields, ok := raw.(map[string]interface{})
+	if !ok {
+		return types.ZeroAddress, nil, runtime.ErrInvalidInputData
+	}
+
+	// the unnamed inputs are named by their index
+	sender, ok := fields["1"].(ethgo.Address)
+	if !ok {
+		return types.ZeroAddress, nil, runtime.ErrInvalidInputData
+	}
+
+	data, ok := fields["2"].([]byte)
+	if !ok {
+		return types.ZeroAddress, nil, runtime.ErrInvalidInputData
+	}
+
+	msg, err := DecodeMessage(data)
+	if err != nil {
+		return types.ZeroAddress, nil, fmt.Errorf("%w: %s", runtime.ErrInvalidInputData, err.Error())
+	}
+
+	return types.Address(sender), msg, nil
+}
+
+// processedKey returns the dispatcher storage key of the processed message
+func processedKey(sender types.Address, nonce *big.Int) types.Hash {
+	return types.BytesToHash(keccak.Keccak256(nil, append(sender.Bytes(), types.BytesToHash(nonce.Bytes()).Bytes()...)))
+}
@@ -0,0 +1,190 @@
+package messagebridge
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sender = types.StringToAddress("0x1")
+	target = types.StringToAddress("0x2")
+)
+
+type mockHost struct {
+	runtime.Host
+
+	storage map[types.Hash]types.Hash
+	code    map[types.Address][]byte
+	calls   []*runtime.Contract
+	logs    int
+
+	callResult *runtime.ExecutionResult
+}
+
+func newMockHost() *mockHost {
+	return &mockHost{
+		storage: map[types.Hash]types.Hash{},
+		code:    map[types.Address][]byte{target: {0x1}},
+	}
+}
+
+func (m *mockHost) GetStorage(_ types.Address, key types.Hash) types.Hash {
+	return m.storage[key]
+}
+
+func (m *mockHost) SetStorage(_ types.Address, key types.Hash, value types.Hash,
+	_ *chain.ForksInTime) runtime.StorageStatus {
+	m.storage[key] = value
+
+	return runtime.StorageAdded
+}
+
+func (m *mockHost) GetCodeSize(addr types.Address) int {
+	return len(m.code[addr])
+}
+
+func (m *mockHost) GetCode(addr types.Address) []byte {
+	return m.code[addr]
+}
+
+func (m *mockHost) GetTxContext() runtime.TxContext {
+	return runtime.TxContext{Origin: contracts.SystemCaller}
+}
+
+func (m *mockHost) EmitLog(types.Address, []types.Hash, []byte) {
+	m.logs++
+}
+
+func (m *mockHost) Callx(c *runtime.Contract, _ runtime.Host) *runtime.ExecutionResult {
+	m.calls = append(m.calls, c)
+
+	if m.callResult != nil {
+		return m.callResult
+	}
+
+	return &runtime.ExecutionResult{GasLeft: c.Gas / 2}
+}
+
+func newStateSyncInput(t *testing.T, msg *Message) []byte {
+	t.Helper()
+
+	data, err := msg.Encode()
+	require.NoError(t, err)
+
+	input, err := OnStateReceiveFunc.Encode([]interface{}{big.NewInt(1), sender, data})
+	require.NoError(t, err)
+
+	return input
+}
+
+func newContract(input []byte, gas uint64) *runtime.Contract {
+	return runtime.NewContractCall(1, contracts.SystemCaller, contracts.StateReceiverContract,
+		contracts.MessageDispatcherPrecompile, big.NewInt(0), gas, nil, input)
+}
+
+func TestMessage_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	msg := &Message{Nonce: big.NewInt(5), Target: target, GasLimit: 100000, Data: []byte{0x1, 0x2}}
+
+	data, err := msg.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeMessage(data)
+	require.NoError(t, err)
+	require.Equal(t, msg, decoded)
+
+	_, err = DecodeMessage([]byte{0x1})
+	require.Error(t, err)
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	d := NewDispatcher(contracts.MessageDispatcherPrecompile)
+	host := newMockHost()
+	input := newStateSyncInput(t, &Message{Nonce: big.NewInt(1), Target: target, GasLimit: 100000, Data: []byte{0x3}})
+
+	result := d.Run(newContract(input, 200000), host, &chain.ForksInTime{})
+	require.NoError(t, result.Err)
+	require.Equal(t, 1, host.logs)
+
+	// the target is called by the dispatcher with the original sender and the gas limit of the message
+	require.Len(t, host.calls, 1)
+	require.Equal(t, target, host.calls[0].Address)
+	require.Equal(t, contracts.MessageDispatcherPrecompile, host.calls[0].Caller)
+	require.Equal(t, uint64(100000), host.calls[0].Gas)
+	require.Equal(t, 2, host.calls[0].Depth)
+
+	expectedInput, err := OnMessageReceiveFunc.Encode([]interface{}{big.NewInt(1), sender, []byte{0x3}})
+	require.NoError(t, err)
+	require.Equal(t, expectedInput, host.calls[0].Input)
+
+	// the unused gas of the message is returned
+	require.Equal(t, 200000-dispatchBaseGas-50000, result.GasLeft)
+
+	// the message can't be replayed
+	result = d.Run(newContract(input, 200000), host, &chain.ForksInTime{})
+	require.ErrorIs(t, result.Err, errMessageProcessed)
+	require.Len(t, host.calls, 1)
+
+	// the message with the next nonce is dispatched
+	input = newStateSyncInput(t, &Message{Nonce: big.NewInt(2), Target: target, GasLimit: 100000})
+
+	result = d.Run(newContract(input, 200000), host, &chain.ForksInTime{})
+	require.NoError(t, result.Err)
+	require.Len(t, host.calls, 2)
+}
+
+func TestDispatcher_Errors(t *testing.T) {
+	t.Parallel()
+
+	d := NewDispatcher(contracts.MessageDispatcherPrecompile)
+	input := newStateSyncInput(t, &Message{Nonce: big.NewInt(1), Target: target, GasLimit: 100000})
+
+	// only the state receiver can dispatch the messages
+	c := newContract(input, 200000)
+	c.Caller = sender
+
+	result := d.Run(c, newMockHost(), &chain.ForksInTime{})
+	require.ErrorIs(t, result.Err, runtime.ErrUnauthorizedCaller)
+
+	// the static calls can't dispatch the messages
+	c = newContract(input, 200000)
+	c.Static = true
+
+	result = d.Run(c, newMockHost(), &chain.ForksInTime{})
+	require.ErrorIs(t, result.Err, runtime.ErrWriteProtection)
+
+	// not enough gas for the message
+	result = d.Run(newContract(input, 100000), newMockHost(), &chain.ForksInTime{})
+	require.ErrorIs(t, result.Err, runtime.ErrOutOfGas)
+
+	// unknown function
+	result = d.Run(newContract([]byte{0x1, 0x2, 0x3, 0x4}, 200000), newMockHost(), &chain.ForksInTime{})
+	require.ErrorIs(t, result.Err, errFunctionNotFound)
+
+	// the target is not a contract
+	host := newMockHost()
+	host.code = map[types.Address][]byte{}
+
+	result = d.Run(newContract(input, 200000), host, &chain.ForksInTime{})
+	require.ErrorIs(t, result.Err, errNoTarget)
+
+	// the failed message reverts the dispatch, including the replay protection write
+	host = newMockHost()
+	host.callResult = &runtime.ExecutionResult{GasLeft: 1000, Err: errors.New("call failed")}
+
+	result = d.Run(newContract(input, 200000), host, &chain.ForksInTime{})
+	require.ErrorIs(t, result.Err, runtime.ErrExecutionReverted)
+	require.True(t, result.Reverted())
+	require.Equal(t, 200000-dispatchBaseGas-100000+1000, result.GasLeft)
+	require.Zero(t, host.logs)
+}
@@ -193,3 +193,21 @@ func (d dummyHost) GetTracer() runtime.VMTracer {
 func (d dummyHost) GetRefund() uint64 {
 	return 0
 }
+
+func (d dummyHost) IsStorageExpired(addr types.Address, key types.Hash) bool {
+	d.t.Fatalf("IsStorageExpired is not implemented")
+
+	return false
+}
+
+func (d dummyHost) AddAddressToAccessList(addr types.Address) bool {
+	d.t.Fatalf("AddAddressToAccessList is not implemented")
+
+	return false
+}
+
+func (d dummyHost) AddSlotToAccessList(addr types.Address, slot types.Hash) bool {
+	d.t.Fatalf("AddSlotToAccessList is not implemented")
+
+	return false
+}
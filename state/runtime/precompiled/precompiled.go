@@ -123,6 +123,19 @@ func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.
 	return true
 }
 
+// ActiveAddresses returns the addresses of the precompiled contracts enabled by the given forks
+func (p *Precompiled) ActiveAddresses(config *chain.ForksInTime) []types.Address {
+	result := make([]types.Address, 0, len(p.contracts))
+
+	for addr := range p.contracts {
+		if p.CanRun(&runtime.Contract{CodeAddress: addr}, nil, config) {
+			result = append(result, addr)
+		}
+	}
+
+	return result
+}
+
 // Name implements the runtime interface
 func (p *Precompiled) Name() string {
 	return "precompiled"
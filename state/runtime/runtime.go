@@ -78,6 +78,9 @@ type Host interface {
 	Transfer(from types.Address, to types.Address, amount *big.Int) error
 	GetTracer() VMTracer
 	GetRefund() uint64
+	IsStorageExpired(addr types.Address, key types.Hash) bool
+	AddAddressToAccessList(addr types.Address) bool
+	AddSlotToAccessList(addr types.Address, slot types.Hash) bool
 }
 
 type VMTracer interface {
@@ -141,6 +144,8 @@ var (
 	ErrUnauthorizedCaller       = errors.New("unauthorized caller")
 	ErrInvalidInputData         = errors.New("invalid input data")
 	ErrNotAuth                  = errors.New("not in allow list")
+	ErrStorageExpired           = errors.New("storage slot expired")
+	ErrWriteProtection          = errors.New("write protection")
 )
 
 // StackUnderflowError wraps an evm error when the items on the stack less
@@ -0,0 +1,362 @@
+package storagerent
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+// list of function methods for the storage rent functionality
+var (
+	PayRentFunc   = abi.MustNewMethod("function payRent(address,bytes32,uint256)")
+	ReviveFunc    = abi.MustNewMethod("function revive(address,bytes32,bytes32)")
+	EvictFunc     = abi.MustNewMethod("function evict(address,bytes32)")
+	ExpiresAtFunc = abi.MustNewMethod("function expiresAt(address,bytes32) returns (uint256)")
+)
+
+// list of gas costs for the operations
+var (
+	writeStorageRentCost = uint64(20000)
+	readStorageRentCost  = uint64(5000)
+)
+
+var (
+	errNoFunctionSignature = errors.New("input is too short for a function call")
+	errFunctionNotFound    = errors.New("function not found")
+	errWriteProtection     = runtime.ErrWriteProtection
+	errSlotNotTracked      = errors.New("storage slot is not subject to rent")
+	errSlotExpired         = errors.New("storage slot expired, it must be revived")
+	errSlotNotExpired      = errors.New("storage slot is not expired")
+	errSlotEvicted         = errors.New("storage slot is already evicted")
+	errInvalidProof        = errors.New("storage slot value does not match the commitment")
+	errInvalidEpochs       = errors.New("invalid number of epochs")
+)
+
+// StorageRent is the native contract keeping track of the rent paid for the contract storage slots.
+// For every tracked slot, it stores the epoch in which the slot expires under keccak256(contract, slot).
+// Zero means the slot is not tracked, i.e. it was never written or it was cleared.
+// Once the slot is expired, anyone can evict it: its value is removed from the contract storage,
+// and only the commitment to the value, keccak256(value), is kept under keccak256(keccak256(contract, slot)).
+// The slot is revived with the value matching the commitment, which serves as the proof of the expired value
+type StorageRent struct {
+	state  stateRef
+	addr   types.Address
+	config *chain.StorageRentConfig
+	epoch  uint64
+	exempt map[types.Address]struct{}
+}
+
+// NewStorageRent creates the storage rent contract applying the rules of the given config at the given block
+func NewStorageRent(state stateRef, addr types.Address,
+	config *chain.StorageRentConfig, blockNumber uint64) *StorageRent {
+	epochSize := config.EpochSize
+	if epochSize == 0 {
+		epochSize = 1
+	}
+
+	exempt := make(map[types.Address]struct{}, len(config.ExemptContracts)+1)
+	exempt[addr] = struct{}{}
+
+	for _, contract := range config.ExemptContracts {
+		exempt[contract] = struct{}{}
+	}
+
+	return &StorageRent{
+		state:  state,
+		addr:   addr,
+		config: config,
+		epoch:  blockNumber / epochSize,
+		exempt: exempt,
+	}
+}
+
+func (s *StorageRent) Addr() types.Address {
+	return s.addr
+}
+
+func (s *StorageRent) Run(c *runtime.Contract, _ runtime.Host, _ *chain.ForksInTime) *runtime.ExecutionResult {
+	ret, gasUsed, err := s.runInputCall(c.Caller, c.Input, c.Gas, c.Static)
+
+	return &runtime.ExecutionResult{
+		ReturnValue: ret,
+		GasUsed:     gasUsed,
+		GasLeft:     c.Gas - gasUsed,
+		Err:         err,
+	}
+}
+
+func (s *StorageRent) runInputCall(caller types.Address, input []byte,
+	gas uint64, isStatic bool) ([]byte, uint64, error) {
+	// decode the function signature from the input
+	if len(input) < types.SignatureSize {
+		return nil, 0, errNoFunctionSignature
+	}
+
+	sig, inputBytes := input[:4], input[4:]
+
+	if bytes.Equal(sig, ExpiresAtFunc.ID()) {
+		if gas < readStorageRentCost {
+			return nil, 0, runtime.ErrOutOfGas
+		}
+
+		contract, slot, _, err := decodeInput(ExpiresAtFunc, inputBytes)
+		if err != nil {
+			return nil, readStorageRentCost, err
+		}
+
+		expiresAt := new(big.Int).SetUint64(s.expiresAt(contract, slot))
+
+		return types.BytesToHash(expiresAt.Bytes()).Bytes(), readStorageRentCost, nil
+	}
+
+	// write operation
+	var method *abi.Method
+
+	switch {
+	case bytes.Equal(sig, PayRentFunc.ID()):
+		method = PayRentFunc
+	case bytes.Equal(sig, ReviveFunc.ID()):
+		method = ReviveFunc
+	case bytes.Equal(sig, EvictFunc.ID()):
+		method = EvictFunc
+	default:
+		return nil, 0, errFunctionNotFound
+	}
+
+	if gas < writeStorageRentCost {
+		return nil, 0, runtime.ErrOutOfGas
+	}
+
+	// we cannot perform any write operation if the call is static
+	if isStatic {
+		return nil, writeStorageRentCost, errWriteProtection
+	}
+
+	contract, slot, argsMap, err := decodeInput(method, inputBytes)
+	if err != nil {
+		return nil, writeStorageRentCost, err
+	}
+
+	// the expired slots are removed from the state, no matter who pays for it
+	if method == EvictFunc {
+		return nil, writeStorageRentCost, s.evict(contract, slot)
+	}
+
+	// the rent is paid by the contract owning the slot, or by the system transactions
+	if caller != contract && caller != contracts.SystemCaller {
+		return nil, writeStorageRentCost, runtime.ErrUnauthorizedCaller
+	}
+
+	if method == PayRentFunc {
+		epochs, ok := argsMap["2"].(*big.Int)
+		if !ok {
+			return nil, writeStorageRentCost, runtime.ErrInvalidInputData
+		}
+
+		return nil, writeStorageRentCost, s.payRent(contract, slot, epochs)
+	}
+
+	value, ok := argsMap["2"].([32]byte)
+	if !ok {
+		return nil, writeStorageRentCost, runtime.ErrInvalidInputData
+	}
+
+	return nil, writeStorageRentCost, s.revive(contract, slot, value)
+}
+
+// payRent extends the expiry of the slot by the given number of epochs,
+// charging the rent from the contract balance
+func (s *StorageRent) payRent(contract types.Address, slot types.Hash, epochs *big.Int) error {
+	expiresAt := s.expiresAt(contract, slot)
+	if expiresAt == 0 {
+		return errSlotNotTracked
+	}
+
+	if s.epoch >= expiresAt {
+		return errSlotExpired
+	}
+
+	if epochs.Sign() <= 0 || !epochs.IsUint64() || epochs.Uint64() > math.MaxUint64-expiresAt {
+		return errInvalidEpochs
+	}
+
+	if err := s.charge(contract, epochs.Uint64()); err != nil {
+		return err
+	}
+
+	s.setExpiresAt(contract, slot, expiresAt+epochs.Uint64())
+
+	return nil
+}
+
+// evict removes the value of the expired slot from the contract storage, keeping the commitment to it.
+// The slot stays expired, so it doesn't read as empty until it is revived
+func (s *StorageRent) evict(contract types.Address, slot types.Hash) error {
+	expiresAt := s.expiresAt(contract, slot)
+	if expiresAt == 0 {
+		return errSlotNotTracked
+	}
+
+	if s.epoch < expiresAt {
+		return errSlotNotExpired
+	}
+
+	if s.commitment(contract, slot) != types.ZeroHash {
+		return errSlotEvicted
+	}
+
+	value := s.state.GetStorage(contract, slot)
+
+	s.setCommitment(contract, slot, types.BytesToHash(keccak.Keccak256(nil, value.Bytes())))
+	s.state.SetState(contract, slot, types.ZeroHash)
+
+	return nil
+}
+
+// revive restores the value of the expired slot and makes it readable again for ExpiryEpochs epochs,
+// charging the rent from the contract balance. The value must match the commitment taken on the eviction,
+// the slot not evicted yet is evicted first
+func (s *StorageRent) revive(contract types.Address, slot types.Hash, value types.Hash) error {
+	expiresAt := s.expiresAt(contract, slot)
+	if expiresAt == 0 {
+		return errSlotNotTracked
+	}
+
+	if s.epoch < expiresAt {
+		return errSlotNotExpired
+	}
+
+	if s.commitment(contract, slot) == types.ZeroHash {
+		if err := s.evict(contract, slot); err != nil {
+			return err
+		}
+	}
+
+	if s.commitment(contract, slot) != types.BytesToHash(keccak.Keccak256(nil, value.Bytes())) {
+		return errInvalidProof
+	}
+
+	if err := s.charge(contract, s.config.ExpiryEpochs); err != nil {
+		return err
+	}
+
+	s.state.SetState(contract, slot, value)
+	s.setCommitment(contract, slot, types.ZeroHash)
+	s.track(contract, slot)
+
+	return nil
+}
+
+// charge transfers the rent for the given number of epochs from the contract to the storage rent contract
+func (s *StorageRent) charge(contract types.Address, epochs uint64) error {
+	if s.config.SlotRentPerEpoch == nil {
+		return nil
+	}
+
+	rent := new(big.Int).Mul(s.config.SlotRentPerEpoch, new(big.Int).SetUint64(epochs))
+
+	return s.state.Transfer(contract, s.addr, rent)
+}
+
+// IsExpired returns true if the rent of the storage slot is not paid for the current epoch
+func (s *StorageRent) IsExpired(contract types.Address, slot types.Hash) bool {
+	if _, ok := s.exempt[contract]; ok {
+		return false
+	}
+
+	expiresAt := s.expiresAt(contract, slot)
+
+	return expiresAt != 0 && s.epoch >= expiresAt
+}
+
+// OnStorageSet starts tracking the rent of the slot when a value is written to it for the first time
+// and stops tracking it once the slot is cleared
+func (s *StorageRent) OnStorageSet(contract types.Address, slot types.Hash, value types.Hash) {
+	if _, ok := s.exempt[contract]; ok {
+		return
+	}
+
+	if value == types.ZeroHash {
+		s.setExpiresAt(contract, slot, 0)
+
+		return
+	}
+
+	if s.expiresAt(contract, slot) == 0 {
+		s.track(contract, slot)
+	}
+}
+
+// track sets the slot to expire ExpiryEpochs epochs after the current one
+func (s *StorageRent) track(contract types.Address, slot types.Hash) {
+	s.setExpiresAt(contract, slot, s.epoch+s.config.ExpiryEpochs+1)
+}
+
+func (s *StorageRent) expiresAt(contract types.Address, slot types.Hash) uint64 {
+	res := s.state.GetStorage(s.addr, metadataKey(contract, slot))
+
+	return new(big.Int).SetBytes(res.Bytes()).Uint64()
+}
+
+func (s *StorageRent) setExpiresAt(contract types.Address, slot types.Hash, epoch uint64) {
+	s.state.SetState(s.addr, metadataKey(contract, slot), types.BytesToHash(new(big.Int).SetUint64(epoch).Bytes()))
+}
+
+func (s *StorageRent) commitment(contract types.Address, slot types.Hash) types.Hash {
+	return s.state.GetStorage(s.addr, commitmentKey(contract, slot))
+}
+
+func (s *StorageRent) setCommitment(contract types.Address, slot types.Hash, commitment types.Hash) {
+	s.state.SetState(s.addr, commitmentKey(contract, slot), commitment)
+}
+
+// metadataKey returns the key under which the rent metadata of the contract storage slot is stored
+func metadataKey(contract types.Address, slot types.Hash) types.Hash {
+	return types.BytesToHash(keccak.Keccak256(nil, append(contract.Bytes(), slot.Bytes()...)))
+}
+
+// commitmentKey returns the key under which the commitment to the value of the evicted slot is stored
+func commitmentKey(contract types.Address, slot types.Hash) types.Hash {
+	return types.BytesToHash(keccak.Keccak256(nil, metadataKey(contract, slot).Bytes()))
+}
+
+// decodeInput decodes the method arguments. The contract address and the storage slot,
+// the first two arguments of all the methods, are returned separately
+func decodeInput(method *abi.Method, input []byte) (types.Address, types.Hash, map[string]interface{}, error) {
+	args, err := method.Inputs.Decode(input)
+	if err != nil {
+		return types.ZeroAddress, types.ZeroHash, nil, runtime.ErrInvalidInputData
+	}
+
+	argsMap, ok := args.(map[string]interface{})
+	if !ok {
+		return types.ZeroAddress, types.ZeroHash, nil, runtime.ErrInvalidInputData
+	}
+
+	contract, ok := argsMap["0"].(ethgo.Address)
+	if !ok {
+		return types.ZeroAddress, types.ZeroHash, nil, runtime.ErrInvalidInputData
+	}
+
+	slot, ok := argsMap["1"].([32]byte)
+	if !ok {
+		return types.ZeroAddress, types.ZeroHash, nil, runtime.ErrInvalidInputData
+	}
+
+	return types.Address(contract), types.Hash(slot), argsMap, nil
+}
+
+type stateRef interface {
+	SetState(addr types.Address, key, value types.Hash)
+	GetStorage(addr types.Address, key types.Hash) types.Hash
+	Transfer(from, to types.Address, amount *big.Int) error
+}
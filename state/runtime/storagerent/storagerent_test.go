@@ -0,0 +1,309 @@
+package storagerent
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	rentAddr     = types.StringToAddress("0x0400000000000000000000000000000000000000")
+	contractAddr = types.StringToAddress("0x1")
+	slot         = types.StringToHash("0x2")
+	value        = types.StringToHash("0x3")
+)
+
+type mockState struct {
+	storage  map[types.Address]map[types.Hash]types.Hash
+	balances map[types.Address]*big.Int
+}
+
+func newMockState() *mockState {
+	return &mockState{
+		storage:  map[types.Address]map[types.Hash]types.Hash{},
+		balances: map[types.Address]*big.Int{},
+	}
+}
+
+func (m *mockState) SetState(addr types.Address, key, value types.Hash) {
+	if _, ok := m.storage[addr]; !ok {
+		m.storage[addr] = map[types.Hash]types.Hash{}
+	}
+
+	m.storage[addr][key] = value
+}
+
+func (m *mockState) GetStorage(addr types.Address, key types.Hash) types.Hash {
+	return m.storage[addr][key]
+}
+
+func (m *mockState) Transfer(from, to types.Address, amount *big.Int) error {
+	balance, ok := m.balances[from]
+	if !ok || balance.Cmp(amount) < 0 {
+		return runtime.ErrInsufficientBalance
+	}
+
+	m.balances[from] = new(big.Int).Sub(balance, amount)
+
+	if _, ok := m.balances[to]; !ok {
+		m.balances[to] = big.NewInt(0)
+	}
+
+	m.balances[to] = new(big.Int).Add(m.balances[to], amount)
+
+	return nil
+}
+
+func newTestConfig() *chain.StorageRentConfig {
+	return &chain.StorageRentConfig{
+		EpochSize:        10,
+		SlotRentPerEpoch: big.NewInt(100),
+		ExpiryEpochs:     2,
+	}
+}
+
+func TestStorageRent_WrongInput(t *testing.T) {
+	t.Parallel()
+
+	s := NewStorageRent(newMockState(), rentAddr, newTestConfig(), 0)
+
+	_, _, err := s.runInputCall(contracts.SystemCaller, []byte{}, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errNoFunctionSignature)
+
+	_, _, err = s.runInputCall(contracts.SystemCaller, []byte{0x1, 0x2, 0x3, 0x4}, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errFunctionNotFound)
+
+	_, _, err = s.runInputCall(contracts.SystemCaller, PayRentFunc.ID(), writeStorageRentCost, false)
+	require.ErrorIs(t, err, runtime.ErrInvalidInputData)
+}
+
+func TestStorageRent_Expiry(t *testing.T) {
+	t.Parallel()
+
+	state := newMockState()
+	config := newTestConfig()
+
+	// slot written in the epoch 0 is paid for the epochs 1 and 2
+	s := NewStorageRent(state, rentAddr, config, 5)
+	s.OnStorageSet(contractAddr, slot, value)
+
+	require.False(t, s.IsExpired(contractAddr, slot))
+	require.Equal(t, uint64(3), s.expiresAt(contractAddr, slot))
+
+	require.False(t, NewStorageRent(state, rentAddr, config, 29).IsExpired(contractAddr, slot))
+	require.True(t, NewStorageRent(state, rentAddr, config, 30).IsExpired(contractAddr, slot))
+
+	// further writes don't extend the expiry
+	NewStorageRent(state, rentAddr, config, 25).OnStorageSet(contractAddr, slot, value)
+	require.True(t, NewStorageRent(state, rentAddr, config, 30).IsExpired(contractAddr, slot))
+
+	// cleared slot is not tracked anymore
+	s.OnStorageSet(contractAddr, slot, types.ZeroHash)
+	require.False(t, NewStorageRent(state, rentAddr, config, 30).IsExpired(contractAddr, slot))
+	require.Equal(t, uint64(0), s.expiresAt(contractAddr, slot))
+}
+
+func TestStorageRent_ExemptContracts(t *testing.T) {
+	t.Parallel()
+
+	state := newMockState()
+	config := newTestConfig()
+	config.ExemptContracts = []types.Address{contractAddr}
+
+	NewStorageRent(state, rentAddr, config, 0).OnStorageSet(contractAddr, slot, value)
+
+	s := NewStorageRent(state, rentAddr, config, 1000)
+	require.False(t, s.IsExpired(contractAddr, slot))
+	require.Equal(t, uint64(0), s.expiresAt(contractAddr, slot))
+}
+
+func TestStorageRent_PayRent(t *testing.T) {
+	t.Parallel()
+
+	state := newMockState()
+	state.balances[contractAddr] = big.NewInt(350)
+
+	config := newTestConfig()
+
+	NewStorageRent(state, rentAddr, config, 0).OnStorageSet(contractAddr, slot, value)
+
+	s := NewStorageRent(state, rentAddr, config, 20)
+
+	input, err := PayRentFunc.Encode([]interface{}{contractAddr, slot, big.NewInt(2)})
+	require.NoError(t, err)
+
+	// only the contract owning the slot, or the system transactions can pay the rent
+	_, _, err = s.runInputCall(types.StringToAddress("0x4"), input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, runtime.ErrUnauthorizedCaller)
+
+	_, _, err = s.runInputCall(contracts.SystemCaller, input, writeStorageRentCost, true)
+	require.ErrorIs(t, err, errWriteProtection)
+
+	_, _, err = s.runInputCall(contracts.SystemCaller, input, writeStorageRentCost-1, false)
+	require.ErrorIs(t, err, runtime.ErrOutOfGas)
+
+	_, gasUsed, err := s.runInputCall(contracts.SystemCaller, input, writeStorageRentCost, false)
+	require.NoError(t, err)
+	require.Equal(t, writeStorageRentCost, gasUsed)
+
+	require.Equal(t, uint64(5), s.expiresAt(contractAddr, slot))
+	require.Equal(t, big.NewInt(150), state.balances[contractAddr])
+	require.Equal(t, big.NewInt(200), state.balances[rentAddr])
+
+	// the contract pays the rent of its own slot
+	input, err = PayRentFunc.Encode([]interface{}{contractAddr, slot, big.NewInt(1)})
+	require.NoError(t, err)
+
+	_, _, err = s.runInputCall(contractAddr, input, writeStorageRentCost, false)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(6), s.expiresAt(contractAddr, slot))
+	require.Equal(t, big.NewInt(50), state.balances[contractAddr])
+
+	input, err = PayRentFunc.Encode([]interface{}{contractAddr, slot, big.NewInt(2)})
+	require.NoError(t, err)
+
+	// not enough balance to pay the rent
+	_, _, err = s.runInputCall(contracts.SystemCaller, input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, runtime.ErrInsufficientBalance)
+
+	// expired slot must be revived
+	_, _, err = NewStorageRent(state, rentAddr, config, 60).runInputCall(
+		contracts.SystemCaller, input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errSlotExpired)
+
+	// untracked slot
+	input, err = PayRentFunc.Encode([]interface{}{contractAddr, value, big.NewInt(2)})
+	require.NoError(t, err)
+
+	_, _, err = s.runInputCall(contracts.SystemCaller, input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errSlotNotTracked)
+}
+
+func TestStorageRent_Evict(t *testing.T) {
+	t.Parallel()
+
+	state := newMockState()
+	state.SetState(contractAddr, slot, value)
+
+	config := newTestConfig()
+
+	NewStorageRent(state, rentAddr, config, 0).OnStorageSet(contractAddr, slot, value)
+
+	input, err := EvictFunc.Encode([]interface{}{contractAddr, slot})
+	require.NoError(t, err)
+
+	// not expired yet
+	_, _, err = NewStorageRent(state, rentAddr, config, 0).runInputCall(
+		contractAddr, input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errSlotNotExpired)
+
+	// anyone can evict the expired slot
+	s := NewStorageRent(state, rentAddr, config, 40)
+
+	_, gasUsed, err := s.runInputCall(types.StringToAddress("0x4"), input, writeStorageRentCost, false)
+	require.NoError(t, err)
+	require.Equal(t, writeStorageRentCost, gasUsed)
+
+	// the value is removed, only the commitment to it is kept, and the slot stays expired
+	require.Equal(t, types.ZeroHash, state.GetStorage(contractAddr, slot))
+	require.Equal(t, types.BytesToHash(keccak.Keccak256(nil, value.Bytes())), s.commitment(contractAddr, slot))
+	require.True(t, s.IsExpired(contractAddr, slot))
+
+	_, _, err = s.runInputCall(contractAddr, input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errSlotEvicted)
+}
+
+func TestStorageRent_Revive(t *testing.T) {
+	t.Parallel()
+
+	state := newMockState()
+	state.balances[contractAddr] = big.NewInt(1000)
+	state.SetState(contractAddr, slot, value)
+
+	config := newTestConfig()
+
+	NewStorageRent(state, rentAddr, config, 0).OnStorageSet(contractAddr, slot, value)
+
+	// not expired yet
+	input, err := ReviveFunc.Encode([]interface{}{contractAddr, slot, value})
+	require.NoError(t, err)
+
+	_, _, err = NewStorageRent(state, rentAddr, config, 0).runInputCall(
+		contracts.SystemCaller, input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errSlotNotExpired)
+
+	s := NewStorageRent(state, rentAddr, config, 40)
+	require.True(t, s.IsExpired(contractAddr, slot))
+
+	evictInput, err := EvictFunc.Encode([]interface{}{contractAddr, slot})
+	require.NoError(t, err)
+
+	_, _, err = s.runInputCall(contracts.SystemCaller, evictInput, writeStorageRentCost, false)
+	require.NoError(t, err)
+
+	// only the contract owning the slot, or the system transactions can revive it
+	_, _, err = s.runInputCall(types.StringToAddress("0x4"), input, writeStorageRentCost, false)
+	require.ErrorIs(t, err, runtime.ErrUnauthorizedCaller)
+
+	// the value doesn't match the commitment
+	wrongInput, err := ReviveFunc.Encode([]interface{}{contractAddr, slot, slot})
+	require.NoError(t, err)
+
+	_, _, err = s.runInputCall(contractAddr, wrongInput, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errInvalidProof)
+
+	_, _, err = s.runInputCall(contractAddr, input, writeStorageRentCost, false)
+	require.NoError(t, err)
+
+	require.False(t, s.IsExpired(contractAddr, slot))
+	require.Equal(t, value, state.GetStorage(contractAddr, slot))
+	require.Equal(t, types.ZeroHash, s.commitment(contractAddr, slot))
+	require.Equal(t, uint64(7), s.expiresAt(contractAddr, slot))
+	require.Equal(t, big.NewInt(800), state.balances[contractAddr])
+
+	// anyone can read the expiry
+	input, err = ExpiresAtFunc.Encode([]interface{}{contractAddr, slot})
+	require.NoError(t, err)
+
+	ret, gasUsed, err := s.runInputCall(contractAddr, input, readStorageRentCost, true)
+	require.NoError(t, err)
+	require.Equal(t, readStorageRentCost, gasUsed)
+	require.Equal(t, uint64(7), new(big.Int).SetBytes(ret).Uint64())
+}
+
+func TestStorageRent_ReviveNotEvicted(t *testing.T) {
+	t.Parallel()
+
+	state := newMockState()
+	state.balances[contractAddr] = big.NewInt(1000)
+	state.SetState(contractAddr, slot, value)
+
+	config := newTestConfig()
+
+	NewStorageRent(state, rentAddr, config, 0).OnStorageSet(contractAddr, slot, value)
+
+	s := NewStorageRent(state, rentAddr, config, 40)
+
+	// the slot not evicted yet is revived with its current value
+	wrongInput, err := ReviveFunc.Encode([]interface{}{contractAddr, slot, slot})
+	require.NoError(t, err)
+
+	_, _, err = s.runInputCall(contractAddr, wrongInput, writeStorageRentCost, false)
+	require.ErrorIs(t, err, errInvalidProof)
+
+	input, err := ReviveFunc.Encode([]interface{}{contractAddr, slot, value})
+	require.NoError(t, err)
+
+	_, _, err = s.runInputCall(contractAddr, input, writeStorageRentCost, false)
+	require.NoError(t, err)
+
+	require.False(t, s.IsExpired(contractAddr, slot))
+	require.Equal(t, value, state.GetStorage(contractAddr, slot))
+}
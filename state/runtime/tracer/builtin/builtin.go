@@ -0,0 +1,129 @@
+package builtin
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/fourbytetracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/opcounttracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
+)
+
+const (
+	CallTracer     = "callTracer"
+	PrestateTracer = "prestateTracer"
+	FourByteTracer = "4byteTracer"
+	OpCountTracer  = "opcount"
+)
+
+// Factory creates a new tracer, configured by the raw tracerConfig of the trace request
+type Factory func(config json.RawMessage) (tracer.Tracer, error)
+
+var (
+	lock      sync.RWMutex
+	factories = map[string]Factory{}
+)
+
+func init() {
+	for name, factory := range map[string]Factory{
+		CallTracer:     newCallTracer,
+		PrestateTracer: newPrestateTracer,
+		FourByteTracer: newFourByteTracer,
+		OpCountTracer:  newOpCountTracer,
+	} {
+		if err := Register(name, factory); err != nil {
+			panic(err) //nolint:gocritic
+		}
+	}
+}
+
+// Register registers the Go tracer under the name used by the tracer field of the debug trace requests
+func Register(name string, factory Factory) error {
+	if name == "" || factory == nil {
+		return fmt.Errorf("invalid tracer %q", name)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, exists := factories[name]; exists {
+		return fmt.Errorf("tracer %s is already registered", name)
+	}
+
+	factories[name] = factory
+
+	return nil
+}
+
+// Get returns the factory of the registered tracer
+func Get(name string) (Factory, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	factory, ok := factories[name]
+
+	return factory, ok
+}
+
+// Names returns the sorted names of the registered tracers
+func Names() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// unmarshalConfig decodes the tracer config, the empty config leaves the defaults
+func unmarshalConfig(raw json.RawMessage, config interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, config); err != nil {
+		return fmt.Errorf("invalid tracer config: %w", err)
+	}
+
+	return nil
+}
+
+func newPrestateTracer(json.RawMessage) (tracer.Tracer, error) {
+	return prestatetracer.NewPrestateTracer(), nil
+}
+
+func newFourByteTracer(json.RawMessage) (tracer.Tracer, error) {
+	return fourbytetracer.NewFourByteTracer(), nil
+}
+
+func newOpCountTracer(raw json.RawMessage) (tracer.Tracer, error) {
+	var config opcounttracer.Config
+
+	if err := unmarshalConfig(raw, &config); err != nil {
+		return nil, err
+	}
+
+	return opcounttracer.NewOpCountTracer(config), nil
+}
+
+func newCallTracer(raw json.RawMessage) (tracer.Tracer, error) {
+	var config callTracerConfig
+
+	if err := unmarshalConfig(raw, &config); err != nil {
+		return nil, err
+	}
+
+	return &callTracer{
+		CallTracer: calltracer.NewCallTracer(),
+		config:     config,
+	}, nil
+}
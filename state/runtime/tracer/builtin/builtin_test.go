@@ -0,0 +1,94 @@
+package builtin
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{FourByteTracer, CallTracer, OpCountTracer, PrestateTracer}, Names())
+
+	for _, name := range Names() {
+		factory, ok := Get(name)
+		require.True(t, ok)
+
+		tr, err := factory(nil)
+		require.NoError(t, err)
+		require.NotNil(t, tr)
+	}
+
+	_, ok := Get("unknownTracer")
+	require.False(t, ok)
+
+	require.ErrorContains(t, Register(CallTracer, newCallTracer), "already registered")
+	require.Error(t, Register("", newCallTracer))
+
+	factory, _ := Get(OpCountTracer)
+	_, err := factory(json.RawMessage(`{"opcodes":"SSTORE"}`))
+	require.ErrorContains(t, err, "invalid tracer config")
+}
+
+func TestCallTracer_Format(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from     = types.StringToAddress("1")
+		contract = types.StringToAddress("2")
+		callee   = types.StringToAddress("3")
+	)
+
+	trace := func(config string) interface{} {
+		t.Helper()
+
+		tr, err := newCallTracer(json.RawMessage(config))
+		require.NoError(t, err)
+
+		callTrace(tr, from, contract, callee)
+
+		result, err := tr.GetResult()
+		require.NoError(t, err)
+
+		return result
+	}
+
+	require.Equal(t, &CallFrame{
+		Type:    "CALL",
+		From:    from,
+		To:      contract,
+		Value:   "0x1",
+		Gas:     "0x2710",
+		GasUsed: "0x1388",
+		Input:   "0x01",
+		Output:  "0x02",
+		Calls: []*CallFrame{
+			{
+				Type:    "STATICCALL",
+				From:    contract,
+				To:      callee,
+				Gas:     "0x3e8",
+				GasUsed: "0x3e8",
+				Input:   "0x",
+				Error:   runtime.ErrOutOfGas.Error(),
+			},
+		},
+	}, trace(""))
+
+	frame, ok := trace(`{"onlyTopCall":true}`).(*CallFrame)
+	require.True(t, ok)
+	require.Empty(t, frame.Calls)
+}
+
+func callTrace(tr tracer.Tracer, from, contract, callee types.Address) {
+	tr.CallStart(1, from, contract, int(runtime.Call), 10000, big.NewInt(1), []byte{0x1})
+	tr.CallStart(2, contract, callee, int(runtime.StaticCall), 1000, big.NewInt(0), nil)
+	tr.CallEnd(2, nil, 0, runtime.ErrOutOfGas)
+	tr.CallEnd(1, []byte{0x2}, 5000, nil)
+}
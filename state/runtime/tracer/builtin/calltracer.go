@@ -0,0 +1,102 @@
+package builtin
+
+import (
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var callTypeNames = map[runtime.CallType]string{
+	runtime.Call:         "CALL",
+	runtime.CallCode:     "CALLCODE",
+	runtime.DelegateCall: "DELEGATECALL",
+	runtime.StaticCall:   "STATICCALL",
+	runtime.Create:       "CREATE",
+	runtime.Create2:      "CREATE2",
+}
+
+type callTracerConfig struct {
+	// OnlyTopCall leaves out the inner calls
+	OnlyTopCall bool `json:"onlyTopCall"`
+}
+
+// CallFrame is the call frame in the format of the callTracer of the debug namespace
+type CallFrame struct {
+	Type    string        `json:"type"`
+	From    types.Address `json:"from"`
+	To      types.Address `json:"to"`
+	Value   string        `json:"value,omitempty"`
+	Gas     string        `json:"gas"`
+	GasUsed string        `json:"gasUsed"`
+	Input   string        `json:"input"`
+	Output  string        `json:"output,omitempty"`
+	Error   string        `json:"error,omitempty"`
+	Calls   []*CallFrame  `json:"calls,omitempty"`
+}
+
+// callTracer formats the call tree captured by the call tracer
+type callTracer struct {
+	*calltracer.CallTracer
+
+	config callTracerConfig
+}
+
+func (t *callTracer) GetResult() (interface{}, error) {
+	res, err := t.CallTracer.GetResult()
+	if err != nil {
+		return nil, err
+	}
+
+	call, _ := res.(*calltracer.Call)
+	if call == nil {
+		return nil, nil
+	}
+
+	return t.toCallFrame(call), nil
+}
+
+func (t *callTracer) toCallFrame(call *calltracer.Call) *CallFrame {
+	frame := &CallFrame{
+		Type:    callTypeNames[call.Type],
+		From:    call.From,
+		To:      call.To,
+		Gas:     hex.EncodeUint64(call.Gas),
+		GasUsed: hex.EncodeUint64(call.GasUsed),
+		Input:   hex.EncodeToHex(call.Input),
+	}
+
+	// the static calls can't transfer value
+	if call.Value != nil && call.Type != runtime.StaticCall {
+		frame.Value = hex.EncodeBig(call.Value)
+	}
+
+	if call.Err != nil {
+		frame.Error = call.Err.Error()
+	} else {
+		frame.Output = hex.EncodeToHex(call.Output)
+	}
+
+	// the inner frames are never formatted if only the top call is requested
+	if t.config.OnlyTopCall {
+		return frame
+	}
+
+	for _, subcall := range call.Calls {
+		frame.Calls = append(frame.Calls, t.toCallFrame(subcall))
+	}
+
+	if sd := call.SelfDestruct; sd != nil {
+		frame.Calls = append(frame.Calls, &CallFrame{
+			Type:    "SELFDESTRUCT",
+			From:    sd.Address,
+			To:      sd.RefundAddress,
+			Value:   hex.EncodeBig(sd.Balance),
+			Gas:     hex.EncodeUint64(0),
+			GasUsed: hex.EncodeUint64(0),
+			Input:   hex.EncodeToHex(nil),
+		})
+	}
+
+	return frame
+}
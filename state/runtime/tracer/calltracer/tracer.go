@@ -0,0 +1,194 @@
+package calltracer
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Call is a single call frame of the traced transaction
+type Call struct {
+	Type    runtime.CallType
+	From    types.Address
+	To      types.Address
+	Value   *big.Int
+	Gas     uint64
+	GasUsed uint64
+	Input   []byte
+	Output  []byte
+	Err     error
+
+	// Calls are the calls made by this call, in the execution order
+	Calls []*Call
+	// SelfDestruct is the destruction of the called contract, it halts the execution,
+	// so it is always the last action of the call
+	SelfDestruct *SelfDestruct
+}
+
+// SelfDestruct is the contract destruction, which transfers the contract balance to the refund address
+type SelfDestruct struct {
+	Address       types.Address
+	RefundAddress types.Address
+	Balance       *big.Int
+}
+
+// CallTracer traces the call frames of the transaction, it doesn't capture the op-level state
+type CallTracer struct {
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	root  *Call
+	stack []*Call
+
+	// pendingSelfDestruct is the self destruct captured before the op execution,
+	// which is recorded only if the op succeeds
+	pendingSelfDestruct *SelfDestruct
+}
+
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+func (t *CallTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *CallTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+func (t *CallTracer) Clear() {
+	t.reason = nil
+	t.interrupt = false
+	t.root = nil
+	t.stack = nil
+	t.pendingSelfDestruct = nil
+}
+
+func (t *CallTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *CallTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *CallTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+	call := &Call{
+		Type:  runtime.CallType(callType),
+		From:  from,
+		To:    to,
+		Gas:   gas,
+		Input: append([]byte{}, input...),
+	}
+
+	if value != nil {
+		call.Value = new(big.Int).Set(value)
+	}
+
+	if len(t.stack) == 0 {
+		t.root = call
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Calls = append(parent.Calls, call)
+	}
+
+	t.stack = append(t.stack, call)
+}
+
+func (t *CallTracer) CallEnd(
+	depth int,
+	output []byte,
+	gasLeft uint64,
+	err error,
+) {
+	if len(t.stack) == 0 {
+		return
+	}
+
+	call := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+
+	if gasLeft < call.Gas {
+		call.GasUsed = call.Gas - gasLeft
+	}
+
+	call.Output = append([]byte{}, output...)
+	call.Err = err
+}
+
+func (t *CallTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	t.pendingSelfDestruct = nil
+
+	if opCode != evm.SELFDESTRUCT || sp < 1 {
+		return
+	}
+
+	t.pendingSelfDestruct = &SelfDestruct{
+		Address:       contractAddress,
+		RefundAddress: types.BytesToAddress(stack[sp-1].Bytes()),
+		Balance:       new(big.Int).Set(host.GetBalance(contractAddress)),
+	}
+}
+
+func (t *CallTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+	selfDestruct := t.pendingSelfDestruct
+	t.pendingSelfDestruct = nil
+
+	if selfDestruct == nil || err != nil || len(t.stack) == 0 {
+		return
+	}
+
+	t.stack[len(t.stack)-1].SelfDestruct = selfDestruct
+}
+
+// GetResult returns the top level call of the transaction,
+// nil if the transaction failed before the call started
+func (t *CallTracer) GetResult() (interface{}, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	return t.root, nil
+}
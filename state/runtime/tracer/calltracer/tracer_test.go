@@ -0,0 +1,139 @@
+package calltracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockHost struct {
+	balances map[types.Address]*big.Int
+}
+
+func (m *mockHost) GetRefund() uint64 {
+	return 0
+}
+
+func (m *mockHost) GetStorage(types.Address, types.Hash) types.Hash {
+	return types.ZeroHash
+}
+
+func (m *mockHost) GetBalance(addr types.Address) *big.Int {
+	return m.balances[addr]
+}
+
+func (m *mockHost) GetNonce(types.Address) uint64 {
+	return 0
+}
+
+func (m *mockHost) GetCode(types.Address) []byte {
+	return nil
+}
+
+type mockVMState struct {
+	halted bool
+}
+
+func (m *mockVMState) Halt() {
+	m.halted = true
+}
+
+func TestCallTracer_Calls(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from     = types.StringToAddress("1")
+		contract = types.StringToAddress("2")
+		created  = types.StringToAddress("3")
+		refund   = types.StringToAddress("4")
+		host     = &mockHost{balances: map[types.Address]*big.Int{created: big.NewInt(7)}}
+	)
+
+	tracer := NewCallTracer()
+
+	tracer.TxStart(100000)
+	tracer.CallStart(1, from, contract, int(runtime.Call), 90000, big.NewInt(1), []byte{0x1})
+	tracer.CallStart(2, contract, created, int(runtime.Create), 50000, nil, []byte{0x2})
+
+	// the self destruct is recorded only if the op succeeds
+	stack := []*big.Int{new(big.Int).SetBytes(refund.Bytes())}
+	tracer.CaptureState(nil, stack, evm.SELFDESTRUCT, created, 1, host, &mockVMState{})
+	tracer.ExecuteState(created, 0, "SELFDESTRUCT", 0, 0, nil, 2, nil, host)
+
+	tracer.CallEnd(2, []byte{0x3}, 20000, nil)
+	tracer.CallStart(2, contract, from, int(runtime.StaticCall), 10000, big.NewInt(0), nil)
+	tracer.CallEnd(2, nil, 0, runtime.ErrOutOfGas)
+	tracer.CallEnd(1, []byte{0x4}, 40000, nil)
+	tracer.TxEnd(40000)
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	root, ok := result.(*Call)
+	require.True(t, ok)
+	require.Equal(t, runtime.Call, root.Type)
+	require.Equal(t, from, root.From)
+	require.Equal(t, contract, root.To)
+	require.Equal(t, uint64(50000), root.GasUsed)
+	require.Equal(t, []byte{0x4}, root.Output)
+	require.Len(t, root.Calls, 2)
+
+	create := root.Calls[0]
+	require.Equal(t, runtime.Create, create.Type)
+	require.Equal(t, uint64(30000), create.GasUsed)
+	require.Equal(t, &SelfDestruct{Address: created, RefundAddress: refund, Balance: big.NewInt(7)}, create.SelfDestruct)
+
+	staticCall := root.Calls[1]
+	require.Equal(t, runtime.StaticCall, staticCall.Type)
+	require.Equal(t, uint64(10000), staticCall.GasUsed)
+	require.ErrorIs(t, staticCall.Err, runtime.ErrOutOfGas)
+	require.Nil(t, staticCall.SelfDestruct)
+
+	tracer.Clear()
+
+	result, err = tracer.GetResult()
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestCallTracer_FailedSelfDestruct(t *testing.T) {
+	t.Parallel()
+
+	contract := types.StringToAddress("2")
+	host := &mockHost{balances: map[types.Address]*big.Int{contract: big.NewInt(1)}}
+
+	tracer := NewCallTracer()
+
+	tracer.CallStart(1, types.StringToAddress("1"), contract, int(runtime.StaticCall), 1000, nil, nil)
+	tracer.CaptureState(nil, []*big.Int{big.NewInt(1)}, evm.SELFDESTRUCT, contract, 1, host, &mockVMState{})
+	tracer.ExecuteState(contract, 0, "SELFDESTRUCT", 0, 0, nil, 1, errors.New("write protection"), host)
+	tracer.CallEnd(1, nil, 0, errors.New("write protection"))
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	root, ok := result.(*Call)
+	require.True(t, ok)
+	require.Nil(t, root.SelfDestruct)
+}
+
+func TestCallTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	reason := errors.New("timeout")
+	state := &mockVMState{}
+
+	tracer := NewCallTracer()
+	tracer.Cancel(reason)
+	tracer.CaptureState(nil, nil, int(evm.STOP), types.ZeroAddress, 0, &mockHost{}, state)
+
+	require.True(t, state.halted)
+
+	_, err := tracer.GetResult()
+	require.ErrorIs(t, err, reason)
+}
@@ -0,0 +1,124 @@
+package fourbytetracer
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// selectorLength is the length of the function selector prefixing the call data
+const selectorLength = 4
+
+// FourByteTracer counts the function selectors of the calls made by the transaction,
+// keyed by the selector and the size of the call data following it (e.g. 0x27dc297e-128)
+type FourByteTracer struct {
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	selectors map[string]uint64
+}
+
+func NewFourByteTracer() *FourByteTracer {
+	return &FourByteTracer{
+		selectors: map[string]uint64{},
+	}
+}
+
+func (t *FourByteTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *FourByteTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+func (t *FourByteTracer) Clear() {
+	t.reason = nil
+	t.interrupt = false
+	// the result of the previous transaction may still be referenced
+	t.selectors = map[string]uint64{}
+}
+
+func (t *FourByteTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *FourByteTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *FourByteTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+	// the creations carry the init code, not the call data
+	if ct := runtime.CallType(callType); ct == runtime.Create || ct == runtime.Create2 {
+		return
+	}
+
+	if len(input) < selectorLength {
+		return
+	}
+
+	key := fmt.Sprintf("%s-%d", hex.EncodeToHex(input[:selectorLength]), len(input)-selectorLength)
+	t.selectors[key]++
+}
+
+func (t *FourByteTracer) CallEnd(
+	depth int,
+	output []byte,
+	gasLeft uint64,
+	err error,
+) {
+}
+
+func (t *FourByteTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+	}
+}
+
+func (t *FourByteTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
+
+// GetResult returns the number of the calls by their selectors and call data sizes
+func (t *FourByteTracer) GetResult() (interface{}, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	return t.selectors, nil
+}
@@ -0,0 +1,43 @@
+package fourbytetracer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFourByteTracer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from     = types.StringToAddress("1")
+		contract = types.StringToAddress("2")
+		input    = []byte{0x27, 0xdc, 0x29, 0x7e, 0x1, 0x2}
+	)
+
+	tracer := NewFourByteTracer()
+
+	tracer.CallStart(1, from, contract, int(runtime.Call), 1000, big.NewInt(0), input)
+	tracer.CallStart(2, contract, from, int(runtime.StaticCall), 1000, nil, input)
+	tracer.CallStart(2, contract, from, int(runtime.DelegateCall), 1000, nil, input[:4])
+
+	// the creations and the calls without the selector are not counted
+	tracer.CallStart(2, contract, from, int(runtime.Create), 1000, nil, input)
+	tracer.CallStart(2, contract, from, int(runtime.Call), 1000, nil, input[:3])
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{
+		"0x27dc297e-2": 2,
+		"0x27dc297e-0": 1,
+	}, result)
+
+	tracer.Clear()
+
+	result, err = tracer.GetResult()
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
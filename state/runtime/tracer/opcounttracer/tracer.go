@@ -0,0 +1,137 @@
+package opcounttracer
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Config is the configuration of the op count tracer
+type Config struct {
+	// Opcodes are the names of the counted opcodes (e.g. SSTORE), all opcodes are counted if empty
+	Opcodes []string `json:"opcodes"`
+}
+
+// OpCountTracer counts the executed opcodes of the transaction by their names
+type OpCountTracer struct {
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	// filter are the counted opcodes, nil counts all of them
+	filter map[string]struct{}
+	counts map[string]uint64
+}
+
+func NewOpCountTracer(config Config) *OpCountTracer {
+	t := &OpCountTracer{
+		counts: map[string]uint64{},
+	}
+
+	if len(config.Opcodes) > 0 {
+		t.filter = make(map[string]struct{}, len(config.Opcodes))
+
+		for _, op := range config.Opcodes {
+			t.filter[op] = struct{}{}
+		}
+	}
+
+	return t
+}
+
+func (t *OpCountTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *OpCountTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+func (t *OpCountTracer) Clear() {
+	t.reason = nil
+	t.interrupt = false
+	// the result of the previous transaction may still be referenced
+	t.counts = map[string]uint64{}
+}
+
+func (t *OpCountTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *OpCountTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *OpCountTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+}
+
+func (t *OpCountTracer) CallEnd(
+	depth int,
+	output []byte,
+	gasLeft uint64,
+	err error,
+) {
+}
+
+func (t *OpCountTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	name := evm.OpCode(opCode).String()
+
+	if t.filter != nil {
+		if _, ok := t.filter[name]; !ok {
+			return
+		}
+	}
+
+	t.counts[name]++
+}
+
+func (t *OpCountTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
+
+// GetResult returns the number of the executed opcodes by their names
+func (t *OpCountTracer) GetResult() (interface{}, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	return t.counts, nil
+}
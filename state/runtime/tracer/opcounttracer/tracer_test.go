@@ -0,0 +1,80 @@
+package opcounttracer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockVMState struct {
+	halted bool
+}
+
+func (m *mockVMState) Halt() {
+	m.halted = true
+}
+
+func TestOpCountTracer(t *testing.T) {
+	t.Parallel()
+
+	ops := []evm.OpCode{evm.PUSH1, evm.PUSH1, evm.SSTORE, evm.SLOAD, evm.STOP}
+
+	cases := []struct {
+		name     string
+		config   Config
+		expected map[string]uint64
+	}{
+		{
+			name:     "all opcodes",
+			expected: map[string]uint64{"PUSH1": 2, "SSTORE": 1, "SLOAD": 1, "STOP": 1},
+		},
+		{
+			name:     "filtered opcodes",
+			config:   Config{Opcodes: []string{"SSTORE", "SLOAD"}},
+			expected: map[string]uint64{"SSTORE": 1, "SLOAD": 1},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer := NewOpCountTracer(c.config)
+
+			for _, op := range ops {
+				tracer.CaptureState(nil, nil, int(op), types.ZeroAddress, 0, nil, &mockVMState{})
+			}
+
+			result, err := tracer.GetResult()
+			require.NoError(t, err)
+			require.Equal(t, c.expected, result)
+
+			tracer.Clear()
+
+			result, err = tracer.GetResult()
+			require.NoError(t, err)
+			require.Empty(t, result)
+		})
+	}
+}
+
+func TestOpCountTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	reason := errors.New("timeout")
+	state := &mockVMState{}
+
+	tracer := NewOpCountTracer(Config{})
+	tracer.Cancel(reason)
+	tracer.CaptureState(nil, nil, int(evm.STOP), types.ZeroAddress, 0, nil, state)
+
+	require.True(t, state.halted)
+
+	_, err := tracer.GetResult()
+	require.ErrorIs(t, err, reason)
+}
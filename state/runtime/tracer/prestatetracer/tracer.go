@@ -0,0 +1,206 @@
+package prestatetracer
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// maxMemorySlice is the upper bound of the captured memory range,
+// the memory expansion above it costs more gas than any block can provide
+const maxMemorySlice = 1 << 24
+
+// Account is the state of the account before the transaction execution
+type Account struct {
+	Balance string                    `json:"balance"`
+	Nonce   uint64                    `json:"nonce,omitempty"`
+	Code    string                    `json:"code,omitempty"`
+	Storage map[types.Hash]types.Hash `json:"storage,omitempty"`
+}
+
+// PrestateTracer captures the accounts and the storage slots touched by the transaction,
+// with their values before the transaction modified them
+type PrestateTracer struct {
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	accounts map[types.Address]*Account
+}
+
+func NewPrestateTracer() *PrestateTracer {
+	return &PrestateTracer{
+		accounts: map[types.Address]*Account{},
+	}
+}
+
+func (t *PrestateTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *PrestateTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+func (t *PrestateTracer) Clear() {
+	t.reason = nil
+	t.interrupt = false
+	// the result of the previous transaction may still be referenced
+	t.accounts = map[types.Address]*Account{}
+}
+
+// CaptureTx captures the sender and the recipient before the transaction charges the gas and transfers the value
+func (t *PrestateTracer) CaptureTx(tx *types.Transaction, host tracer.RuntimeHost) {
+	t.captureAccount(tx.From, host)
+
+	if tx.IsContractCreation() {
+		t.captureAccount(crypto.CreateAddress(tx.From, tx.Nonce), host)
+	} else {
+		t.captureAccount(*tx.To, host)
+	}
+}
+
+func (t *PrestateTracer) TxStart(gasLimit uint64) {
+}
+
+func (t *PrestateTracer) TxEnd(gasLeft uint64) {
+}
+
+func (t *PrestateTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+}
+
+func (t *PrestateTracer) CallEnd(
+	depth int,
+	output []byte,
+	gasLeft uint64,
+	err error,
+) {
+}
+
+func (t *PrestateTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	stackAddr := func(i int) types.Address {
+		return types.BytesToAddress(stack[sp-i].Bytes())
+	}
+
+	switch op := evm.OpCode(opCode); {
+	case (op == evm.SLOAD || op == evm.SSTORE) && sp >= 1:
+		t.captureSlot(contractAddress, types.BytesToHash(stack[sp-1].Bytes()), host)
+	case (op == evm.BALANCE || op == evm.EXTCODESIZE || op == evm.EXTCODECOPY ||
+		op == evm.EXTCODEHASH || op == evm.SELFDESTRUCT) && sp >= 1:
+		t.captureAccount(stackAddr(1), host)
+	case (op == evm.CALL || op == evm.CALLCODE || op == evm.DELEGATECALL || op == evm.STATICCALL) && sp >= 2:
+		t.captureAccount(stackAddr(2), host)
+	case op == evm.CREATE:
+		t.captureAccount(crypto.CreateAddress(contractAddress, host.GetNonce(contractAddress)), host)
+	case op == evm.CREATE2 && sp >= 4:
+		var salt [32]byte
+
+		stack[sp-4].FillBytes(salt[:])
+
+		initCode := memorySlice(memory, stack[sp-2], stack[sp-3])
+		t.captureAccount(crypto.CreateAddress2(contractAddress, salt, initCode), host)
+	}
+}
+
+func (t *PrestateTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opcode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
+
+// GetResult returns the touched accounts by their addresses
+func (t *PrestateTracer) GetResult() (interface{}, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	return t.accounts, nil
+}
+
+// captureAccount captures the account the first time it is touched
+func (t *PrestateTracer) captureAccount(addr types.Address, host tracer.RuntimeHost) *Account {
+	if account, ok := t.accounts[addr]; ok {
+		return account
+	}
+
+	account := &Account{
+		Balance: hex.EncodeBig(host.GetBalance(addr)),
+		Nonce:   host.GetNonce(addr),
+	}
+
+	if code := host.GetCode(addr); len(code) > 0 {
+		account.Code = hex.EncodeToHex(code)
+	}
+
+	t.accounts[addr] = account
+
+	return account
+}
+
+// captureSlot captures the storage slot the first time it is touched
+func (t *PrestateTracer) captureSlot(addr types.Address, slot types.Hash, host tracer.RuntimeHost) {
+	account := t.captureAccount(addr, host)
+
+	if account.Storage == nil {
+		account.Storage = map[types.Hash]types.Hash{}
+	}
+
+	if _, ok := account.Storage[slot]; !ok {
+		account.Storage[slot] = host.GetStorage(addr, slot)
+	}
+}
+
+// memorySlice returns the memory range, the part beyond the memory size is zero filled
+func memorySlice(memory []byte, offset, size *big.Int) []byte {
+	if !offset.IsUint64() || !size.IsUint64() || size.Uint64() > maxMemorySlice {
+		return nil
+	}
+
+	res := make([]byte, size.Uint64())
+
+	if start := offset.Uint64(); start < uint64(len(memory)) {
+		copy(res, memory[start:])
+	}
+
+	return res
+}
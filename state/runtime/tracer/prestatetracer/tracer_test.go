@@ -0,0 +1,135 @@
+package prestatetracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockHost struct {
+	balances map[types.Address]*big.Int
+	nonces   map[types.Address]uint64
+	code     map[types.Address][]byte
+	storage  map[types.Hash]types.Hash
+}
+
+func (m *mockHost) GetRefund() uint64 {
+	return 0
+}
+
+func (m *mockHost) GetStorage(_ types.Address, slot types.Hash) types.Hash {
+	return m.storage[slot]
+}
+
+func (m *mockHost) GetBalance(addr types.Address) *big.Int {
+	if balance, ok := m.balances[addr]; ok {
+		return balance
+	}
+
+	return big.NewInt(0)
+}
+
+func (m *mockHost) GetNonce(addr types.Address) uint64 {
+	return m.nonces[addr]
+}
+
+func (m *mockHost) GetCode(addr types.Address) []byte {
+	return m.code[addr]
+}
+
+type mockVMState struct {
+	halted bool
+}
+
+func (m *mockVMState) Halt() {
+	m.halted = true
+}
+
+func TestPrestateTracer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from     = types.StringToAddress("1")
+		contract = types.StringToAddress("2")
+		callee   = types.StringToAddress("3")
+		slot     = types.StringToHash("1")
+		host     = &mockHost{
+			balances: map[types.Address]*big.Int{from: big.NewInt(10)},
+			nonces:   map[types.Address]uint64{from: 1, contract: 2},
+			code:     map[types.Address][]byte{contract: {0x1}},
+			storage:  map[types.Hash]types.Hash{slot: types.StringToHash("5")},
+		}
+	)
+
+	tracer := NewPrestateTracer()
+
+	tracer.CaptureTx(&types.Transaction{From: from, To: &contract}, host)
+
+	// the slot is captured the first time it is touched
+	tracer.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(slot.Bytes())}, evm.SSTORE, contract, 1, host, &mockVMState{})
+	host.storage[slot] = types.StringToHash("6")
+	tracer.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(slot.Bytes())}, evm.SLOAD, contract, 1, host, &mockVMState{})
+
+	// the called address is the second item of the stack
+	stack := []*big.Int{new(big.Int).SetBytes(callee.Bytes()), big.NewInt(1000)}
+	tracer.CaptureState(nil, stack, evm.CALL, contract, 2, host, &mockVMState{})
+
+	tracer.CaptureState(nil, nil, evm.CREATE, contract, 0, host, &mockVMState{})
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	accounts, ok := result.(map[types.Address]*Account)
+	require.True(t, ok)
+	require.Len(t, accounts, 4)
+	require.Equal(t, &Account{Balance: "0xa", Nonce: 1}, accounts[from])
+	require.Equal(t, &Account{
+		Balance: "0x0",
+		Nonce:   2,
+		Code:    "0x01",
+		Storage: map[types.Hash]types.Hash{slot: types.StringToHash("5")},
+	}, accounts[contract])
+	require.Equal(t, &Account{Balance: "0x0"}, accounts[callee])
+	require.Contains(t, accounts, crypto.CreateAddress(contract, 2))
+
+	tracer.Clear()
+
+	result, err = tracer.GetResult()
+	require.NoError(t, err)
+	require.Empty(t, result)
+	require.Len(t, accounts, 4)
+}
+
+func TestPrestateTracer_ContractCreation(t *testing.T) {
+	t.Parallel()
+
+	from := types.StringToAddress("1")
+	tracer := NewPrestateTracer()
+
+	tracer.CaptureTx(&types.Transaction{From: from, Nonce: 3}, &mockHost{})
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.Contains(t, result, crypto.CreateAddress(from, 3))
+}
+
+func TestPrestateTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	reason := errors.New("timeout")
+	state := &mockVMState{}
+
+	tracer := NewPrestateTracer()
+	tracer.Cancel(reason)
+	tracer.CaptureState(nil, nil, int(evm.STOP), types.ZeroAddress, 0, &mockHost{}, state)
+
+	require.True(t, state.halted)
+
+	_, err := tracer.GetResult()
+	require.ErrorIs(t, err, reason)
+}
@@ -124,6 +124,7 @@ func (t *StructTracer) CallStart(
 func (t *StructTracer) CallEnd(
 	depth int,
 	output []byte,
+	gasLeft uint64,
 	err error,
 ) {
 	if depth == 1 {
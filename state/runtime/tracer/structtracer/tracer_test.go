@@ -43,6 +43,18 @@ func (m *mockHost) GetStorage(a types.Address, h types.Hash) types.Hash {
 	return m.getStorageFunc(a, h)
 }
 
+func (m *mockHost) GetBalance(types.Address) *big.Int {
+	return big.NewInt(0)
+}
+
+func (m *mockHost) GetNonce(types.Address) uint64 {
+	return 0
+}
+
+func (m *mockHost) GetCode(types.Address) []byte {
+	return nil
+}
+
 func TestStructLogErrorString(t *testing.T) {
 	t.Parallel()
 
@@ -306,7 +318,7 @@ func TestStructTracerCallEnd(t *testing.T) {
 
 			tracer := NewStructTracer(testEmptyConfig)
 
-			tracer.CallEnd(test.depth, test.output, test.err)
+			tracer.CallEnd(test.depth, test.output, 0, test.err)
 
 			assert.Equal(
 				t,
@@ -12,6 +12,12 @@ type RuntimeHost interface {
 	GetRefund() uint64
 	// GetStorage access the storage slot at the given address and slot hash
 	GetStorage(types.Address, types.Hash) types.Hash
+	// GetBalance returns the balance of the given address
+	GetBalance(types.Address) *big.Int
+	// GetNonce returns the nonce of the given address
+	GetNonce(types.Address) uint64
+	// GetCode returns the code of the given address
+	GetCode(types.Address) []byte
 }
 
 type VMState interface {
@@ -43,6 +49,7 @@ type Tracer interface {
 	CallEnd(
 		depth int, // begins from 1
 		output []byte,
+		gasLeft uint64,
 		err error,
 	)
 
@@ -68,3 +75,9 @@ type Tracer interface {
 		host RuntimeHost,
 	)
 }
+
+// TxCapturer is implemented by the tracers which need the state before the transaction modifies it
+type TxCapturer interface {
+	// CaptureTx is called before the transaction is processed
+	CaptureTx(tx *types.Transaction, host RuntimeHost)
+}
@@ -0,0 +1,187 @@
+package state
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	iradix "github.com/hashicorp/go-immutable-radix"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Checkpoint is the immutable view of the transient state at some point of the block execution,
+// used to compute the state changes made after that point
+type Checkpoint struct {
+	tree *iradix.Tree
+}
+
+// AccountState is the state of a single account
+type AccountState struct {
+	Balance *big.Int
+	Nonce   uint64
+	Code    []byte
+	// Storage holds only the slots changed between the two points of the execution
+	Storage map[types.Hash]types.Hash
+}
+
+// AccountDiff is the change of a single account.
+// Before is nil if the account was created and After is nil if the account was deleted
+type AccountDiff struct {
+	Address types.Address
+	Before  *AccountState
+	After   *AccountState
+}
+
+// Checkpoint returns the view of the current transient state
+func (txn *Txn) Checkpoint() *Checkpoint {
+	return &Checkpoint{tree: txn.txn.CommitOnly()}
+}
+
+// DiffSince returns the changes of the accounts made after the checkpoint, sorted by the address.
+// The accounts which were touched, but not changed, are omitted
+func (txn *Txn) DiffSince(checkpoint *Checkpoint) []*AccountDiff {
+	diffs := []*AccountDiff{}
+
+	txn.txn.Root().Walk(func(k []byte, v interface{}) bool {
+		after, ok := v.(*StateObject)
+		if !ok {
+			// logs and refunds
+			return false
+		}
+
+		var before *StateObject
+
+		if prev, exists := checkpoint.tree.Get(k); exists {
+			if prev == v {
+				// not modified after the checkpoint
+				return false
+			}
+
+			before, _ = prev.(*StateObject)
+		} else if account, err := txn.snapshot.GetAccount(types.BytesToAddress(k)); err == nil && account != nil {
+			before = &StateObject{Account: account}
+		}
+
+		if diff := txn.diffObjects(types.BytesToAddress(k), before, after); diff != nil {
+			diffs = append(diffs, diff)
+		}
+
+		return false
+	})
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].Address.Bytes(), diffs[j].Address.Bytes()) < 0
+	})
+
+	return diffs
+}
+
+// diffObjects returns the change between the two versions of the account, nil if there is no change
+func (txn *Txn) diffObjects(addr types.Address, before, after *StateObject) *AccountDiff {
+	if before != nil && before.Deleted {
+		before = nil
+	}
+
+	if after != nil && after.Deleted {
+		after = nil
+	}
+
+	if before == nil && after == nil {
+		return nil
+	}
+
+	diff := &AccountDiff{Address: addr}
+
+	if before != nil {
+		diff.Before = &AccountState{
+			Balance: before.Account.Balance,
+			Nonce:   before.Account.Nonce,
+			Code:    txn.objectCode(before),
+			Storage: map[types.Hash]types.Hash{},
+		}
+	}
+
+	if after != nil {
+		diff.After = &AccountState{
+			Balance: after.Account.Balance,
+			Nonce:   after.Account.Nonce,
+			Code:    txn.objectCode(after),
+			Storage: map[types.Hash]types.Hash{},
+		}
+	}
+
+	// only the slots written in the transient state can be changed
+	for _, obj := range []*StateObject{before, after} {
+		if obj == nil || obj.Txn == nil {
+			continue
+		}
+
+		obj.Txn.Root().Walk(func(k []byte, _ interface{}) bool {
+			key := types.BytesToHash(k)
+
+			beforeVal := txn.objectStorage(addr, before, key)
+			afterVal := txn.objectStorage(addr, after, key)
+
+			if beforeVal != afterVal {
+				if diff.Before != nil {
+					diff.Before.Storage[key] = beforeVal
+				}
+
+				if diff.After != nil {
+					diff.After.Storage[key] = afterVal
+				}
+			}
+
+			return false
+		})
+	}
+
+	if diff.Before != nil && diff.After != nil &&
+		diff.Before.Balance.Cmp(diff.After.Balance) == 0 &&
+		diff.Before.Nonce == diff.After.Nonce &&
+		bytes.Equal(diff.Before.Code, diff.After.Code) &&
+		len(diff.After.Storage) == 0 {
+		return nil
+	}
+
+	return diff
+}
+
+// objectCode returns the code of the account
+func (txn *Txn) objectCode(obj *StateObject) []byte {
+	if obj.DirtyCode {
+		return obj.Code
+	}
+
+	if bytes.Equal(obj.Account.CodeHash, types.EmptyCodeHash.Bytes()) {
+		return nil
+	}
+
+	code, _ := txn.snapshot.GetCode(types.BytesToHash(obj.Account.CodeHash))
+
+	return code
+}
+
+// objectStorage returns the value of the storage slot of the account, zero if the account doesn't exist
+func (txn *Txn) objectStorage(addr types.Address, obj *StateObject, key types.Hash) types.Hash {
+	if obj == nil {
+		return types.ZeroHash
+	}
+
+	if obj.Txn != nil {
+		if val, ok := obj.Txn.Get(key.Bytes()); ok {
+			if val == nil {
+				return types.ZeroHash
+			}
+
+			return types.BytesToHash(val.([]byte)) //nolint:forcetypeassert
+		}
+	}
+
+	if obj.withFakeStorage {
+		return types.ZeroHash
+	}
+
+	return txn.snapshot.GetStorage(addr, obj.Account.Root, key)
+}
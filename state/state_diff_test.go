@@ -0,0 +1,71 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxn_DiffSince(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr3 = types.StringToAddress("3")
+		key1  = types.StringToHash("1")
+		key2  = types.StringToHash("2")
+	)
+
+	txn := newTestTxn(map[types.Address]*PreState{
+		addr1: {
+			Nonce:   1,
+			Balance: 100,
+			State:   map[types.Hash]types.Hash{key1: types.StringToHash("10")},
+		},
+		addr2: {
+			Balance: 50,
+		},
+	})
+
+	// the changes made before the checkpoint are not included
+	txn.AddBalance(addr2, big.NewInt(10))
+
+	checkpoint := txn.Checkpoint()
+
+	txn.IncrNonce(addr1)
+	txn.SetState(addr1, key1, types.StringToHash("11"))
+	txn.SetState(addr1, key2, types.ZeroHash)
+	txn.TouchAccount(addr2)
+	txn.AddBalance(addr3, big.NewInt(1))
+	txn.SetCode(addr3, []byte{0x1})
+
+	diffs := txn.DiffSince(checkpoint)
+	require.Len(t, diffs, 2)
+
+	// existing account changed
+	require.Equal(t, addr1, diffs[0].Address)
+	require.Equal(t, uint64(1), diffs[0].Before.Nonce)
+	require.Equal(t, uint64(2), diffs[0].After.Nonce)
+	require.Equal(t, big.NewInt(100), diffs[0].After.Balance)
+	require.Equal(t, map[types.Hash]types.Hash{key1: types.StringToHash("10")}, diffs[0].Before.Storage)
+	require.Equal(t, map[types.Hash]types.Hash{key1: types.StringToHash("11")}, diffs[0].After.Storage)
+
+	// new account created
+	require.Equal(t, addr3, diffs[1].Address)
+	require.Nil(t, diffs[1].Before)
+	require.Equal(t, big.NewInt(1), diffs[1].After.Balance)
+	require.Equal(t, []byte{0x1}, diffs[1].After.Code)
+
+	// deleted account
+	checkpoint = txn.Checkpoint()
+
+	txn.Suicide(addr3)
+	require.NoError(t, txn.CleanDeleteObjects(true))
+
+	diffs = txn.DiffSince(checkpoint)
+	require.Len(t, diffs, 1)
+	require.Equal(t, addr3, diffs[0].Address)
+	require.Equal(t, big.NewInt(1), diffs[0].Before.Balance)
+	require.Nil(t, diffs[0].After)
+}
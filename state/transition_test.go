@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
@@ -159,3 +160,55 @@ func TestTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestSelfdestruct(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		eip6780         bool
+		created         bool
+		expectedDeleted bool
+		expectedRefund  uint64
+	}{
+		{
+			name:            "before eip-6780 the account is deleted",
+			expectedDeleted: true,
+			expectedRefund:  24000,
+		},
+		{
+			name:    "after eip-6780 only the balance is moved for a pre-existing account",
+			eip6780: true,
+		},
+		{
+			name:            "after eip-6780 the account created in the same transaction is deleted",
+			eip6780:         true,
+			created:         true,
+			expectedDeleted: true,
+			expectedRefund:  24000,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr1: {Balance: 100},
+			})
+			transition.config = chain.ForksInTime{EIP6780: tt.eip6780}
+
+			if tt.created {
+				transition.state.MarkCreated(addr1)
+			}
+
+			transition.Selfdestruct(addr1, addr2)
+
+			assert.Equal(t, tt.expectedDeleted, transition.state.HasSuicided(addr1))
+			assert.Equal(t, tt.expectedRefund, transition.GetRefund())
+			assert.Equal(t, uint64(0), transition.GetBalance(addr1).Uint64())
+			assert.Equal(t, uint64(100), transition.GetBalance(addr2).Uint64())
+		})
+	}
+}
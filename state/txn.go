@@ -28,6 +28,12 @@ var (
 
 	// refundIndex is the index of the refund
 	refundIndex = types.BytesToHash([]byte{3}).Bytes()
+
+	// accessListIndex is the prefix of the access list entries (EIP-2929)
+	accessListIndex = types.BytesToHash([]byte{4}).Bytes()
+
+	// createdIndex is the prefix of the accounts created in the current transaction (EIP-6780)
+	createdIndex = types.BytesToHash([]byte{5}).Bytes()
 )
 
 // Txn is a reference of the state
@@ -36,6 +42,12 @@ type Txn struct {
 	snapshots []*iradix.Tree
 	txn       *iradix.Txn
 	codeCache *lru.Cache
+
+	// dirtySize is the estimated memory held by the transient state, see DirtySize
+	dirtySize uint64
+	// committedRoots are the storage roots of the accounts at the beginning of the block,
+	// for the accounts flushed to the trie during the block execution
+	committedRoots map[types.Address]*committedRoot
 }
 
 func NewTxn(snapshot Snapshot) *Txn {
@@ -135,7 +147,9 @@ func (txn *Txn) upsertAccount(addr types.Address, create bool, f func(object *St
 	f(object)
 
 	if object != nil {
-		txn.txn.Insert(addr.Bytes(), object)
+		if _, updated := txn.txn.Insert(addr.Bytes(), object); !updated {
+			txn.dirtySize += dirtyAccountSize
+		}
 	}
 }
 
@@ -272,13 +286,19 @@ func (txn *Txn) SetStorage(
 	if original == value {
 		if original == types.ZeroHash { // reset to original nonexistent slot (2.2.2.1)
 			// Storage was used as memory (allocation and deallocation occurred within the same contract)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929
+				txn.AddRefund(19900)
+			} else if config.Istanbul {
 				txn.AddRefund(19200)
 			} else {
 				txn.AddRefund(19800)
 			}
 		} else { // reset to original existing slot (2.2.2.2)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929
+				txn.AddRefund(2800)
+			} else if config.Istanbul {
 				txn.AddRefund(4200)
 			} else {
 				txn.AddRefund(4800)
@@ -300,10 +320,16 @@ func (txn *Txn) SetState(
 			object.Txn = iradix.New().Txn()
 		}
 
+		var updated bool
+
 		if value == types.ZeroHash {
-			object.Txn.Insert(key.Bytes(), nil)
+			_, updated = object.Txn.Insert(key.Bytes(), nil)
 		} else {
-			object.Txn.Insert(key.Bytes(), value.Bytes())
+			_, updated = object.Txn.Insert(key.Bytes(), value.Bytes())
+		}
+
+		if !updated {
+			txn.dirtySize += dirtySlotSize
 		}
 	})
 }
@@ -370,6 +396,8 @@ func (txn *Txn) SetCode(addr types.Address, code []byte) {
 		object.DirtyCode = true
 		object.Code = code
 	})
+
+	txn.dirtySize += uint64(len(code))
 }
 
 // GetCode gets the code on a given address
@@ -447,6 +475,74 @@ func (txn *Txn) SubRefund(gas uint64) {
 	txn.txn.Insert(refundIndex, refund)
 }
 
+// Access list (EIP-2929)
+
+// The access list entries are kept in the radix tree, so they are reverted together with the state
+// on the snapshot reverts. The address entry key is accessListIndex + address,
+// and the storage slot entry key is accessListIndex + address + slot
+
+func accessListKey(addr types.Address, slot *types.Hash) []byte {
+	key := make([]byte, 0, len(accessListIndex)+types.AddressLength+types.HashLength)
+	key = append(key, accessListIndex...)
+	key = append(key, addr.Bytes()...)
+
+	if slot != nil {
+		key = append(key, slot.Bytes()...)
+	}
+
+	return key
+}
+
+// AddAddressToAccessList adds the address to the access list and returns true if it was already there (warm)
+func (txn *Txn) AddAddressToAccessList(addr types.Address) bool {
+	key := accessListKey(addr, nil)
+	if _, warm := txn.txn.Get(key); warm {
+		return true
+	}
+
+	txn.txn.Insert(key, true)
+
+	return false
+}
+
+// AddSlotToAccessList adds the storage slot (and its address) to the access list
+// and returns true if the slot was already there (warm)
+func (txn *Txn) AddSlotToAccessList(addr types.Address, slot types.Hash) bool {
+	txn.AddAddressToAccessList(addr)
+
+	key := accessListKey(addr, &slot)
+	if _, warm := txn.txn.Get(key); warm {
+		return true
+	}
+
+	txn.txn.Insert(key, true)
+
+	return false
+}
+
+// ClearAccessList removes all the entries of the access list
+func (txn *Txn) ClearAccessList() {
+	txn.txn.DeletePrefix(accessListIndex)
+}
+
+func createdKey(addr types.Address) []byte {
+	key := make([]byte, 0, len(createdIndex)+types.AddressLength)
+
+	return append(append(key, createdIndex...), addr.Bytes()...)
+}
+
+// MarkCreated records that the account was created in the current transaction
+func (txn *Txn) MarkCreated(addr types.Address) {
+	txn.txn.Insert(createdKey(addr), true)
+}
+
+// IsCreated returns true if the account was created in the current transaction
+func (txn *Txn) IsCreated(addr types.Address) bool {
+	_, created := txn.txn.Get(createdKey(addr))
+
+	return created
+}
+
 func (txn *Txn) Logs() []*types.Log {
 	data, exists := txn.txn.Get(logIndex)
 	if !exists {
@@ -475,7 +571,7 @@ func (txn *Txn) GetCommittedState(addr types.Address, key types.Hash) types.Hash
 		return types.Hash{}
 	}
 
-	return txn.snapshot.GetStorage(addr, obj.Account.Root, key)
+	return txn.snapshot.GetStorage(addr, txn.committedRoot(addr, obj.Account.Root), key)
 }
 
 // SetFullStorage is used to replace the full state of the address.
@@ -535,7 +631,9 @@ func (txn *Txn) CreateAccount(addr types.Address) {
 		obj.Account.Balance.SetBytes(prev.Account.Balance.Bytes())
 	}
 
-	txn.txn.Insert(addr.Bytes(), obj)
+	if _, updated := txn.txn.Insert(addr.Bytes(), obj); !updated {
+		txn.dirtySize += dirtyAccountSize
+	}
 }
 
 func (txn *Txn) CleanDeleteObjects(deleteEmptyObjects bool) error {
@@ -569,8 +667,10 @@ func (txn *Txn) CleanDeleteObjects(deleteEmptyObjects bool) error {
 		txn.txn.Insert(k, obj2)
 	}
 
-	// delete refunds
+	// delete refunds, the access list and the created accounts
 	txn.txn.Delete(refundIndex)
+	txn.ClearAccessList()
+	txn.txn.DeletePrefix(createdIndex)
 
 	return nil
 }
@@ -70,3 +70,21 @@ func TestSnapshotUpdateData(t *testing.T) {
 	assert.NoError(t, txn.RevertToSnapshot(ss))
 	assert.Equal(t, hash1, txn.GetState(addr1, hash1))
 }
+
+func TestAccessList_Revert(t *testing.T) {
+	txn := newTestTxn(defaultPreState)
+
+	assert.False(t, txn.AddAddressToAccessList(addr1))
+	assert.True(t, txn.AddAddressToAccessList(addr1))
+
+	ss := txn.Snapshot()
+	assert.False(t, txn.AddSlotToAccessList(addr2, hash1))
+	assert.True(t, txn.AddSlotToAccessList(addr2, hash1))
+
+	assert.NoError(t, txn.RevertToSnapshot(ss))
+	assert.True(t, txn.AddAddressToAccessList(addr1))
+	assert.False(t, txn.AddSlotToAccessList(addr2, hash1))
+
+	txn.ClearAccessList()
+	assert.False(t, txn.AddAddressToAccessList(addr1))
+}
@@ -0,0 +1,19 @@
+package state
+
+import "github.com/0xPolygon/polygon-edge/types"
+
+// Witness is the part of the state accessed by the execution of a block: the state trie nodes
+// and the contract codes, sufficient to re-execute the block on top of its pre-state root
+// without the state database
+type Witness struct {
+	PreStateRoot types.Hash
+	Nodes        [][]byte
+	Codes        [][]byte
+}
+
+// WitnessRecorder is implemented by the states which can record the witness of the execution
+type WitnessRecorder interface {
+	// NewWitnessState returns the state which records the trie nodes and the codes read through it.
+	// The writes are kept in memory, and the returned function returns the witness recorded so far
+	NewWitnessState() (State, func() *Witness)
+}
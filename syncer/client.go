@@ -24,12 +24,18 @@ const (
 	SyncPeerClientLoggerName = "sync-peer-client"
 	statusTopicName          = "syncer/status/0.1"
 	defaultTimeoutForStatus  = 10 * time.Second
+	defaultTimeoutForRange   = 30 * time.Second
+)
+
+var (
+	errUnexpectedHeadersCount = errors.New("unexpected number of the returned headers")
 )
 
 type syncPeerClient struct {
 	logger     hclog.Logger // logger used for console logging
 	network    Network      // reference to the network module
 	blockchain Blockchain   // reference to the blockchain module
+	txPool     TxPool       // reference to the txpool compact blocks are reconstructed from, nil disables them
 
 	subscription           blockchain.Subscription // reference to the blockchain subscription
 	topic                  *network.Topic          // reference to the network topic
@@ -41,6 +47,8 @@ type syncPeerClient struct {
 	closeCh          chan struct{}
 	closed           atomic.Bool
 
+	downloadedBytes atomic.Uint64 // number of the block data bytes received from the peers
+
 	peerStatusUpdateChLock   sync.Mutex
 	peerStatusUpdateChClosed bool
 }
@@ -49,11 +57,13 @@ func NewSyncPeerClient(
 	logger hclog.Logger,
 	network Network,
 	blockchain Blockchain,
+	txPool TxPool,
 ) SyncPeerClient {
 	return &syncPeerClient{
 		logger:                 logger.Named(SyncPeerClientLoggerName),
 		network:                network,
 		blockchain:             blockchain,
+		txPool:                 txPool,
 		id:                     network.AddrInfo().ID.String(),
 		peerStatusUpdateCh:     make(chan *NoForkPeer, 1),
 		peerConnectionUpdateCh: make(chan *event.PeerEvent, 1),
@@ -292,6 +302,11 @@ func (m *syncPeerClient) CloseStream(peerID peer.ID) error {
 	return m.network.CloseProtocolStream(syncerProto, peerID)
 }
 
+// DisconnectPeer disconnects the node from the peer
+func (m *syncPeerClient) DisconnectPeer(peerID peer.ID, reason string) {
+	m.network.DisconnectFromPeer(peerID, reason)
+}
+
 // GetBlocks returns a stream of blocks from given height to peer's latest
 func (m *syncPeerClient) GetBlocks(
 	peerID peer.ID,
@@ -306,7 +321,8 @@ func (m *syncPeerClient) GetBlocks(
 	ctx, cancel := context.WithCancel(context.Background())
 
 	stream, err := clt.GetBlocks(ctx, &proto.GetBlocksRequest{
-		From: from,
+		From:    from,
+		Compact: m.txPool != nil,
 	})
 	if err != nil {
 		cancel()
@@ -315,7 +331,13 @@ func (m *syncPeerClient) GetBlocks(
 	}
 
 	// input channel
-	streamBlockCh, streamErrorCh := blockStreamToChannel(stream)
+	streamBlockCh, streamErrorCh := blockStreamToChannel(stream, func(protoBlock *proto.Block) (*types.Block, error) {
+		if compact := protoBlock.GetCompact(); compact != nil {
+			return m.fromCompactProto(ctx, clt, compact)
+		}
+
+		return fromProto(protoBlock)
+	}, m.recordIngress)
 
 	// output channel
 	blockCh := make(chan *types.Block, 1)
@@ -347,6 +369,121 @@ func (m *syncPeerClient) GetBlocks(
 	return blockCh, nil
 }
 
+// GetHeaders returns the given number of the consecutive headers from the given height.
+// The peer must return all of them, otherwise the request fails
+func (m *syncPeerClient) GetHeaders(peerID peer.ID, from, count uint64) ([]*types.Header, error) {
+	var resp *proto.Headers
+
+	if err := m.requestSyncPeer(peerID, func(ctx context.Context, clt proto.SyncPeerClient) (err error) {
+		resp, err = clt.GetHeaders(ctx, &proto.GetHeadersRequest{From: from, Count: count})
+
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to get headers: %w", err)
+	}
+
+	if uint64(len(resp.Headers)) != count {
+		return nil, fmt.Errorf("%w: %d != %d", errUnexpectedHeadersCount, len(resp.Headers), count)
+	}
+
+	headers := make([]*types.Header, len(resp.Headers))
+	size := 0
+
+	for i, raw := range resp.Headers {
+		size += len(raw)
+
+		header := &types.Header{}
+		if err := header.UnmarshalRLP(raw); err != nil {
+			return nil, err
+		}
+
+		headers[i] = header
+	}
+
+	m.recordIngress(size)
+
+	return headers, nil
+}
+
+// GetBodies returns the bodies of the blocks with the given hashes, in the given order.
+// The peer may return fewer bodies than requested. The senders set by the peer are discarded,
+// as they are recovered from the transaction signatures on import
+func (m *syncPeerClient) GetBodies(peerID peer.ID, hashes []types.Hash) ([]*types.Body, error) {
+	rawHashes := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		rawHashes[i] = hash.Bytes()
+	}
+
+	var resp *proto.Bodies
+
+	if err := m.requestSyncPeer(peerID, func(ctx context.Context, clt proto.SyncPeerClient) (err error) {
+		resp, err = clt.GetBodies(ctx, &proto.GetBodiesRequest{Hashes: rawHashes})
+
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to get bodies: %w", err)
+	}
+
+	if len(resp.Bodies) > len(hashes) {
+		return nil, fmt.Errorf("received %d bodies for %d requested blocks", len(resp.Bodies), len(hashes))
+	}
+
+	bodies := make([]*types.Body, len(resp.Bodies))
+	size := 0
+
+	for i, raw := range resp.Bodies {
+		size += len(raw)
+
+		body := &types.Body{}
+		if err := body.UnmarshalRLP(raw); err != nil {
+			return nil, err
+		}
+
+		for _, tx := range body.Transactions {
+			// the sender of the state transaction is a part of its signed data
+			if tx.Type != types.StateTx {
+				tx.From = types.ZeroAddress
+			}
+		}
+
+		bodies[i] = body
+	}
+
+	m.recordIngress(size)
+
+	return bodies, nil
+}
+
+// requestSyncPeer opens a connection to the peer for a single request, and closes it once the request is done.
+// The connection isn't saved, so the request doesn't interfere with the block stream opened to the same peer
+func (m *syncPeerClient) requestSyncPeer(
+	peerID peer.ID,
+	request func(context.Context, proto.SyncPeerClient) error,
+) error {
+	conn, err := m.network.NewProtoConnection(syncerProto, peerID)
+	if err != nil {
+		return fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeoutForRange)
+	defer cancel()
+
+	return request(ctx, proto.NewSyncPeerClient(conn))
+}
+
+// DownloadedBytes returns the number of the block data bytes received from the peers
+func (m *syncPeerClient) DownloadedBytes() uint64 {
+	return m.downloadedBytes.Load()
+}
+
+// recordIngress records the number of the block data bytes received from a peer
+func (m *syncPeerClient) recordIngress(size int) {
+	m.downloadedBytes.Add(uint64(size))
+	metrics.SetGauge([]string{syncerMetrics, "ingress_bytes"}, float32(size))
+}
+
 // newSyncPeerClient creates gRPC client
 func (m *syncPeerClient) newSyncPeerClient(peerID peer.ID) (proto.SyncPeerClient, error) {
 	conn, err := m.network.NewProtoConnection(syncerProto, peerID)
@@ -369,7 +506,11 @@ func fromProto(protoBlock *proto.Block) (*types.Block, error) {
 	return block, nil
 }
 
-func blockStreamToChannel(stream proto.SyncPeer_GetBlocksClient) (<-chan *types.Block, <-chan error) {
+func blockStreamToChannel(
+	stream proto.SyncPeer_GetBlocksClient,
+	decode func(*proto.Block) (*types.Block, error),
+	recordIngress func(int),
+) (<-chan *types.Block, <-chan error) {
 	blockCh := make(chan *types.Block)
 	errorCh := make(chan error, 1)
 
@@ -389,7 +530,7 @@ func blockStreamToChannel(stream proto.SyncPeer_GetBlocksClient) (<-chan *types.
 				break
 			}
 
-			block, err := fromProto(protoBlock)
+			block, err := decode(protoBlock)
 			if err != nil {
 				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
 				errorCh <- err
@@ -397,7 +538,7 @@ func blockStreamToChannel(stream proto.SyncPeer_GetBlocksClient) (<-chan *types.
 				break
 			}
 
-			metrics.SetGauge([]string{syncerMetrics, "ingress_bytes"}, float32(len(protoBlock.Block)))
+			recordIngress(protoBlockSize(protoBlock))
 
 			blockCh <- block
 		}
@@ -2,6 +2,7 @@ package syncer
 
 import (
 	"context"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/network/grpc"
 	"github.com/0xPolygon/polygon-edge/syncer/proto"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
 )
 
 var (
@@ -491,7 +493,9 @@ func Test_syncPeerClient_GetBlocks(t *testing.T) {
 			if u <= 10 {
 				return &types.Block{
 					Header: &types.Header{
-						Number: u,
+						Number:     u,
+						TxRoot:     types.EmptyRootHash,
+						Sha3Uncles: types.EmptyUncleHash,
 					},
 				}, true
 			}
@@ -626,3 +630,164 @@ func Test_EmitMultipleBlocks(t *testing.T) {
 		testGossip(t, 4)
 	})
 }
+
+// mockTxPool is the txpool the compact blocks are reconstructed from
+type mockTxPool map[types.Hash]*types.Transaction
+
+func (m mockTxPool) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
+	tx, ok := m[txHash]
+
+	return tx, ok
+}
+
+// createMockBlocksWithTxs creates the blocks with the transactions and the matching transactions root
+func createMockBlocksWithTxs(num, txsPerBlock int) []*types.Block {
+	blocks := createMockBlocks(num)
+
+	for _, block := range blocks {
+		for i := 0; i < txsPerBlock; i++ {
+			tx := &types.Transaction{
+				Nonce:    block.Number()*uint64(txsPerBlock) + uint64(i),
+				GasPrice: big.NewInt(1),
+				Gas:      21000,
+				Value:    big.NewInt(1),
+				V:        big.NewInt(27),
+				R:        big.NewInt(1),
+				S:        big.NewInt(1),
+			}
+
+			block.Transactions = append(block.Transactions, tx.ComputeHash(block.Number()))
+		}
+
+		block.Header.TxRoot = buildroot.CalculateTransactionsRoot(block.Transactions, block.Number())
+		block.Header.ComputeHash()
+	}
+
+	return blocks
+}
+
+func Test_syncPeerClient_GetCompactBlocks(t *testing.T) {
+	t.Parallel()
+
+	const peerLatest = 10
+
+	blocks := createMockBlocksWithTxs(peerLatest, 3)
+	pool := mockTxPool{}
+
+	for _, block := range blocks[len(blocks)-compactBlocksDepth:] {
+		// the first transaction is missing in the pool
+		for _, tx := range block.Transactions[1:] {
+			pool[tx.Hash] = tx
+		}
+	}
+
+	// the pool transaction doesn't match the block one, all the transactions are fetched instead
+	mismatched := blocks[peerLatest-1].Transactions[2].Copy()
+	mismatched.Nonce++
+	pool[mismatched.Hash] = mismatched
+
+	clientSrv := newTestNetwork(t)
+	client := newTestSyncPeerClient(clientSrv, nil)
+	client.txPool = pool
+
+	_, peerSrv := createTestSyncerService(t, &mockBlockchain{
+		headerHandler: newSimpleHeaderHandler(peerLatest),
+		getBlockByNumberHandler: func(u uint64, _ bool) (*types.Block, bool) {
+			if u == 0 || u > peerLatest {
+				return nil, false
+			}
+
+			return blocks[u-1], true
+		},
+	})
+
+	require.NoError(t, network.JoinAndWait(
+		clientSrv,
+		peerSrv,
+		network.DefaultBufferTimeout,
+		network.DefaultJoinTimeout,
+	))
+
+	blockStream, err := client.GetBlocks(peerSrv.AddrInfo().ID, 1, 5*time.Second)
+	require.NoError(t, err)
+
+	received := make([]*types.Block, 0, peerLatest)
+	for block := range blockStream {
+		received = append(received, block)
+	}
+
+	require.Len(t, received, peerLatest)
+
+	for i, block := range received {
+		assert.Equal(t, blocks[i].Hash(), block.Hash())
+		require.Len(t, block.Transactions, len(blocks[i].Transactions))
+
+		for j, tx := range block.Transactions {
+			assert.Equal(t, blocks[i].Transactions[j].Hash, tx.Hash)
+			assert.Equal(t, blocks[i].Transactions[j].Nonce, tx.Nonce)
+		}
+	}
+}
+
+func Test_syncPeerClient_GetHeadersAndBodies(t *testing.T) {
+	t.Parallel()
+
+	const peerLatest = 10
+
+	blocks := createMockBlocksWithTxs(peerLatest, 2)
+
+	clientSrv := newTestNetwork(t)
+	client := newTestSyncPeerClient(clientSrv, nil)
+
+	_, peerSrv := createTestSyncerService(t, &mockBlockchain{
+		headerHandler: newSimpleHeaderHandler(peerLatest),
+		getHeaderByNumberHandler: func(u uint64) (*types.Header, bool) {
+			if u == 0 || u > peerLatest {
+				return nil, false
+			}
+
+			return blocks[u-1].Header, true
+		},
+		getBodyByHashHandler: func(hash types.Hash) (*types.Body, bool) {
+			for _, block := range blocks {
+				if block.Hash() == hash {
+					return block.Body(), true
+				}
+			}
+
+			return nil, false
+		},
+	})
+
+	require.NoError(t, network.JoinAndWait(
+		clientSrv,
+		peerSrv,
+		network.DefaultBufferTimeout,
+		network.DefaultJoinTimeout,
+	))
+
+	peerID := peerSrv.AddrInfo().ID
+
+	headers, err := client.GetHeaders(peerID, 3, 5)
+	require.NoError(t, err)
+	require.Len(t, headers, 5)
+
+	for i, header := range headers {
+		assert.Equal(t, blocks[i+2].Hash(), header.Hash)
+	}
+
+	// the peer doesn't have all the requested headers
+	_, err = client.GetHeaders(peerID, 8, 5)
+	assert.ErrorIs(t, err, errUnexpectedHeadersCount)
+
+	bodies, err := client.GetBodies(peerID, []types.Hash{blocks[4].Hash(), blocks[1].Hash(), types.StringToHash("1")})
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+
+	for i, block := range []*types.Block{blocks[4], blocks[1]} {
+		block := assembleBlock(block.Header, bodies[i])
+
+		assert.NoError(t, verifyBlockRoots(block))
+		assert.Equal(t, blocks[block.Number()-1].Transactions, block.Transactions)
+	}
+}
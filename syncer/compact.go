@@ -0,0 +1,177 @@
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/syncer/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// compactBlocksDepth is the number of the latest blocks served as the compact blocks,
+	// the transactions of the older blocks are not expected to be in the txpool of the syncing peer anymore
+	compactBlocksDepth = 8
+)
+
+var (
+	errInvalidTxIndex    = errors.New("transaction index out of the block range")
+	errTxRootMismatch    = errors.New("reconstructed transactions don't match the block transactions root")
+	errUnexpectedCompact = errors.New("received the compact block without the txpool to reconstruct it from")
+	errTxsCountMismatch  = errors.New("unexpected number of the returned transactions")
+)
+
+// toProtoCompactBlock converts type.Block -> proto.Block carrying the header and the transaction hashes
+func toProtoCompactBlock(block *types.Block) *proto.Block {
+	hashes := make([][]byte, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		hashes[i] = tx.Hash.Bytes()
+	}
+
+	return &proto.Block{
+		Compact: &proto.CompactBlock{
+			Header:   block.Header.MarshalRLP(),
+			TxHashes: hashes,
+		},
+	}
+}
+
+// protoBlockSize returns the size of the block data carried by the proto.Block
+func protoBlockSize(protoBlock *proto.Block) int {
+	compact := protoBlock.GetCompact()
+	if compact == nil {
+		return len(protoBlock.Block)
+	}
+
+	size := len(compact.Header)
+	for _, hash := range compact.TxHashes {
+		size += len(hash)
+	}
+
+	return size
+}
+
+// GetBlockTransactions is a gRPC endpoint to return the block transactions missing in the txpool
+// of the peer reconstructing the compact block
+func (s *syncPeerService) GetBlockTransactions(
+	_ context.Context,
+	req *proto.GetBlockTransactionsRequest,
+) (*proto.BlockTransactions, error) {
+	block, ok := s.blockchain.GetBlockByNumber(req.Number, true)
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	txs := make([][]byte, len(req.Indices))
+
+	for i, index := range req.Indices {
+		if int(index) >= len(block.Transactions) {
+			return nil, fmt.Errorf("%w: %d >= %d", errInvalidTxIndex, index, len(block.Transactions))
+		}
+
+		txs[i] = block.Transactions[index].MarshalRLP()
+	}
+
+	return &proto.BlockTransactions{Transactions: txs}, nil
+}
+
+// fromCompactProto reconstructs the block from the compact block, taking the transactions from the txpool
+// and requesting only the missing ones from the peer
+func (m *syncPeerClient) fromCompactProto(
+	ctx context.Context,
+	clt proto.SyncPeerClient,
+	compact *proto.CompactBlock,
+) (*types.Block, error) {
+	if m.txPool == nil {
+		return nil, errUnexpectedCompact
+	}
+
+	header := &types.Header{}
+	if err := header.UnmarshalRLP(compact.Header); err != nil {
+		return nil, err
+	}
+
+	txs := make([]*types.Transaction, len(compact.TxHashes))
+	missing := make([]uint32, 0)
+
+	for i, hash := range compact.TxHashes {
+		if tx, ok := m.txPool.GetPendingTx(types.BytesToHash(hash)); ok {
+			txs[i] = tx.Copy()
+		} else {
+			missing = append(missing, uint32(i))
+		}
+	}
+
+	metrics.IncrCounter([]string{syncerMetrics, "compact_blocks"}, 1)
+	metrics.IncrCounter([]string{syncerMetrics, "compact_block_missing_txs"}, float32(len(missing)))
+
+	if err := m.fetchBlockTransactions(ctx, clt, header.Number, txs, missing); err != nil {
+		return nil, err
+	}
+
+	if buildroot.CalculateTransactionsRoot(txs, header.Number) != header.TxRoot {
+		// the pool transactions don't match the block ones, fall back to fetching all of them
+		all := make([]uint32, len(txs))
+		for i := range all {
+			all[i] = uint32(i)
+		}
+
+		if err := m.fetchBlockTransactions(ctx, clt, header.Number, txs, all); err != nil {
+			return nil, err
+		}
+
+		if buildroot.CalculateTransactionsRoot(txs, header.Number) != header.TxRoot {
+			return nil, errTxRootMismatch
+		}
+	}
+
+	return &types.Block{
+		Header:       header,
+		Transactions: txs,
+	}, nil
+}
+
+// fetchBlockTransactions requests the transactions at the given indices from the peer, filling them in txs
+func (m *syncPeerClient) fetchBlockTransactions(
+	ctx context.Context,
+	clt proto.SyncPeerClient,
+	number uint64,
+	txs []*types.Transaction,
+	indices []uint32,
+) error {
+	if len(indices) == 0 {
+		return nil
+	}
+
+	resp, err := clt.GetBlockTransactions(ctx, &proto.GetBlockTransactionsRequest{
+		Number:  number,
+		Indices: indices,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get block transactions: %w", err)
+	}
+
+	if len(resp.Transactions) != len(indices) {
+		return fmt.Errorf("%w: %d != %d", errTxsCountMismatch, len(resp.Transactions), len(indices))
+	}
+
+	size := 0
+
+	for i, raw := range resp.Transactions {
+		size += len(raw)
+
+		tx := &types.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return err
+		}
+
+		txs[indices[i]] = tx.ComputeHash(number)
+	}
+
+	m.recordIngress(size)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package syncer
 
 import (
 	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -67,3 +68,24 @@ func (m *PeerMap) BestPeer(skipMap map[peer.ID]bool) *NoForkPeer {
 
 	return bestPeer
 }
+
+// PeersWithBlock returns the peers whose latest block height is at least the given number, the best peers first
+func (m *PeerMap) PeersWithBlock(number uint64) []*NoForkPeer {
+	peers := make([]*NoForkPeer, 0)
+
+	m.Range(func(key, value interface{}) bool {
+		peer, _ := value.(*NoForkPeer)
+
+		if peer.Number >= number {
+			peers = append(peers, peer)
+		}
+
+		return true
+	})
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].IsBetter(peers[j])
+	})
+
+	return peers
+}
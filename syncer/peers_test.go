@@ -160,3 +160,13 @@ func TestBestPeer(t *testing.T) {
 		})
 	}
 }
+
+func TestPeersWithBlock(t *testing.T) {
+	t.Parallel()
+
+	peerMap := NewPeerMap(peers)
+
+	assert.Equal(t, []*NoForkPeer{peers[2], peers[1], peers[0]}, peerMap.PeersWithBlock(10))
+	assert.Equal(t, []*NoForkPeer{peers[2], peers[1]}, peerMap.PeersWithBlock(11))
+	assert.Empty(t, peerMap.PeersWithBlock(21))
+}
@@ -0,0 +1,269 @@
+package syncer
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/armon/go-metrics"
+)
+
+// DefaultPipelineBufferSize is the default number of the blocks buffered by each stage of the import pipeline
+const DefaultPipelineBufferSize = 64
+
+var (
+	errInvalidUncleRoot = errors.New("uncle root hash mismatch")
+	errInvalidTxRoot    = errors.New("incorrect tx root")
+)
+
+// PipelineConfig configures the block import pipeline of the bulk sync:
+// download -> verify headers -> verify seals -> execute.
+// The verification stages run by the worker pools, while the blocks are executed and written
+// one by one, as each block is executed on top of the state of its parent
+type PipelineConfig struct {
+	// HeaderWorkers is the number of the workers verifying the transactions and uncles roots of the headers
+	HeaderWorkers int
+
+	// SealWorkers is the number of the workers verifying the transaction signatures and recovering the senders.
+	// The consensus seals are verified along with the execution, as they require the parent to be imported
+	SealWorkers int
+
+	// BufferSize is the number of the blocks buffered by each stage,
+	// the download is paused once the buffers are full
+	BufferSize int
+}
+
+// DefaultPipelineConfig returns the pipeline config with a worker per CPU in each verification stage
+func DefaultPipelineConfig() PipelineConfig {
+	return PipelineConfig{
+		HeaderWorkers: runtime.NumCPU(),
+		SealWorkers:   runtime.NumCPU(),
+		BufferSize:    DefaultPipelineBufferSize,
+	}
+}
+
+// withDefaults returns the config whose unset values are replaced by the defaults
+func (c PipelineConfig) withDefaults() PipelineConfig {
+	defaults := DefaultPipelineConfig()
+
+	if c.HeaderWorkers <= 0 {
+		c.HeaderWorkers = defaults.HeaderWorkers
+	}
+
+	if c.SealWorkers <= 0 {
+		c.SealWorkers = defaults.SealWorkers
+	}
+
+	if c.BufferSize <= 0 {
+		c.BufferSize = defaults.BufferSize
+	}
+
+	return c
+}
+
+// pipelineBlock is the block passing through the import pipeline.
+// The error of any stage is passed through the following stages to the import
+type pipelineBlock struct {
+	block *types.Block
+	err   error
+
+	// done is closed once the block is processed by the stage
+	done chan struct{}
+}
+
+// startImportPipeline starts the download and the verification stages of the blocks received from the peer.
+// The verified blocks are returned in the order they were received. The pipeline is stopped by closing stopCh.
+// The download fails if the next block isn't received within blockTimeout, 0 disables the timeout
+func (s *syncer) startImportPipeline(
+	blockCh <-chan *types.Block,
+	stopCh <-chan struct{},
+	blockTimeout time.Duration,
+) <-chan *pipelineBlock {
+	config := s.pipelineConfig.withDefaults()
+
+	downloaded := downloadStage(blockCh, stopCh, blockTimeout, config.BufferSize)
+	headersVerified := runPipelineStage("verify_headers", downloaded, stopCh, config.HeaderWorkers,
+		config.BufferSize, verifyBlockRoots)
+
+	return runPipelineStage("verify_seals", headersVerified, stopCh, config.SealWorkers,
+		config.BufferSize, s.blockchain.RecoverFromFieldsInBlock)
+}
+
+// downloadStage receives the blocks from the peer, the stage ends with the timeout error
+// if the peer doesn't send the next block in time
+func downloadStage(
+	blockCh <-chan *types.Block,
+	stopCh <-chan struct{},
+	blockTimeout time.Duration,
+	bufferSize int,
+) <-chan *pipelineBlock {
+	outCh := make(chan *pipelineBlock, bufferSize)
+
+	go func() {
+		defer close(outCh)
+
+		emit := func(item *pipelineBlock) bool {
+			select {
+			case outCh <- item:
+				return true
+			case <-stopCh:
+				return false
+			}
+		}
+
+		for {
+			var (
+				timer   *time.Timer
+				timeout <-chan time.Time
+			)
+
+			if blockTimeout > 0 {
+				timer = time.NewTimer(blockTimeout)
+				timeout = timer.C
+			}
+
+			select {
+			case block, ok := <-blockCh:
+				stopTimer(timer)
+
+				if !ok {
+					return
+				}
+
+				// safe check
+				if block.Number() == 0 {
+					continue
+				}
+
+				if !emit(&pipelineBlock{block: block}) {
+					return
+				}
+			case <-timeout:
+				emit(&pipelineBlock{err: errTimeout})
+
+				return
+			case <-stopCh:
+				stopTimer(timer)
+
+				return
+			}
+		}
+	}()
+
+	return outCh
+}
+
+// stopTimer stops the timer if it's set
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// runPipelineStage processes the blocks of the input by the given number of the workers,
+// and emits them in the input order. At most bufferSize blocks are processed ahead of the output
+func runPipelineStage(
+	name string,
+	inCh <-chan *pipelineBlock,
+	stopCh <-chan struct{},
+	workers int,
+	bufferSize int,
+	process func(*types.Block) error,
+) <-chan *pipelineBlock {
+	var (
+		taskCh    = make(chan *pipelineBlock)
+		orderedCh = make(chan *pipelineBlock, bufferSize)
+		outCh     = make(chan *pipelineBlock)
+	)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for item := range taskCh {
+				if item.err == nil {
+					start := time.Now()
+
+					if err := process(item.block); err != nil {
+						item.err = fmt.Errorf("%s stage failed on block %d: %w", name, item.block.Number(), err)
+					}
+
+					metrics.MeasureSince([]string{syncerMetrics, "pipeline", name}, start)
+				}
+
+				close(item.done)
+			}
+		}()
+	}
+
+	// dispatch the blocks to the workers, keeping their order
+	go func() {
+		defer close(taskCh)
+		defer close(orderedCh)
+
+		for {
+			var (
+				in *pipelineBlock
+				ok bool
+			)
+
+			select {
+			case in, ok = <-inCh:
+				if !ok {
+					return
+				}
+			case <-stopCh:
+				return
+			}
+
+			item := &pipelineBlock{block: in.block, err: in.err, done: make(chan struct{})}
+
+			select {
+			case orderedCh <- item:
+			case <-stopCh:
+				return
+			}
+
+			select {
+			case taskCh <- item:
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+
+	// emit the processed blocks in order
+	go func() {
+		defer close(outCh)
+
+		for item := range orderedCh {
+			select {
+			case <-item.done:
+			case <-stopCh:
+				return
+			}
+
+			select {
+			case outCh <- item:
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+
+	return outCh
+}
+
+// verifyBlockRoots verifies the transactions and uncles roots of the header match the block body
+func verifyBlockRoots(block *types.Block) error {
+	if hash := buildroot.CalculateUncleRoot(block.Uncles); hash != block.Header.Sha3Uncles {
+		return fmt.Errorf("%w: have %s, want %s", errInvalidUncleRoot, hash, block.Header.Sha3Uncles)
+	}
+
+	if hash := buildroot.CalculateTransactionsRoot(block.Transactions, block.Number()); hash != block.Header.TxRoot {
+		return fmt.Errorf("%w (expected: %s, actual: %s)", errInvalidTxRoot, block.Header.TxRoot, hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package syncer
+
+import (
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPipelineTestBlocks(count int) []*types.Block {
+	blocks := make([]*types.Block, count)
+
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number:     uint64(i + 1),
+				TxRoot:     types.EmptyRootHash,
+				Sha3Uncles: types.EmptyUncleHash,
+			},
+		}
+	}
+
+	return blocks
+}
+
+func pipelineInput(blocks []*types.Block) <-chan *pipelineBlock {
+	inCh := make(chan *pipelineBlock, len(blocks))
+
+	for _, block := range blocks {
+		inCh <- &pipelineBlock{block: block}
+	}
+
+	close(inCh)
+
+	return inCh
+}
+
+func TestPipelineStage_KeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	blocks := newPipelineTestBlocks(100)
+	stopCh := make(chan struct{})
+
+	defer close(stopCh)
+
+	outCh := runPipelineStage("test", pipelineInput(blocks), stopCh, 8, 4, func(*types.Block) error {
+		time.Sleep(time.Duration(rand.Intn(1000)) * time.Microsecond) //nolint:gosec
+
+		return nil
+	})
+
+	received := make([]*types.Block, 0, len(blocks))
+
+	for item := range outCh {
+		require.NoError(t, item.err)
+
+		received = append(received, item.block)
+	}
+
+	assert.Equal(t, blocks, received)
+}
+
+func TestPipelineStage_PassesErrors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks   = newPipelineTestBlocks(10)
+		stopCh   = make(chan struct{})
+		errStage = errors.New("stage error")
+	)
+
+	defer close(stopCh)
+
+	failing := runPipelineStage("failing", pipelineInput(blocks), stopCh, 4, 4, func(b *types.Block) error {
+		if b.Number() == 5 {
+			return errStage
+		}
+
+		return nil
+	})
+
+	var processed atomic.Int32
+
+	outCh := runPipelineStage("next", failing, stopCh, 4, 4, func(*types.Block) error {
+		processed.Add(1)
+
+		return nil
+	})
+
+	for item := range outCh {
+		if item.block.Number() == 5 {
+			assert.ErrorIs(t, item.err, errStage)
+		} else {
+			assert.NoError(t, item.err)
+		}
+	}
+
+	// the failed block isn't processed by the next stage
+	assert.Equal(t, int32(len(blocks)-1), processed.Load())
+}
+
+func TestPipelineStage_Stop(t *testing.T) {
+	t.Parallel()
+
+	var (
+		inCh   = make(chan *pipelineBlock)
+		stopCh = make(chan struct{})
+	)
+
+	outCh := runPipelineStage("test", inCh, stopCh, 2, 2, func(*types.Block) error {
+		return nil
+	})
+
+	inCh <- &pipelineBlock{block: newPipelineTestBlocks(1)[0]}
+
+	close(stopCh)
+
+	select {
+	case <-waitClosed(outCh):
+	case <-time.After(5 * time.Second):
+		t.Fatal("pipeline stage not stopped")
+	}
+}
+
+func waitClosed(ch <-chan *pipelineBlock) <-chan struct{} {
+	doneCh := make(chan struct{})
+
+	go func() {
+		for range ch {
+		}
+
+		close(doneCh)
+	}()
+
+	return doneCh
+}
+
+func TestVerifyBlockRoots(t *testing.T) {
+	t.Parallel()
+
+	block := newPipelineTestBlocks(1)[0]
+	require.NoError(t, verifyBlockRoots(block))
+
+	block.Header.TxRoot = types.StringToHash("1")
+	require.ErrorIs(t, verifyBlockRoots(block), errInvalidTxRoot)
+
+	block.Header.TxRoot = types.EmptyRootHash
+	block.Header.Sha3Uncles = types.StringToHash("1")
+	require.ErrorIs(t, verifyBlockRoots(block), errInvalidUncleRoot)
+}
+
+func TestPipelineConfig_WithDefaults(t *testing.T) {
+	t.Parallel()
+
+	config := PipelineConfig{SealWorkers: 3}.withDefaults()
+
+	assert.Equal(t, DefaultPipelineConfig().HeaderWorkers, config.HeaderWorkers)
+	assert.Equal(t, 3, config.SealWorkers)
+	assert.Equal(t, DefaultPipelineBufferSize, config.BufferSize)
+}
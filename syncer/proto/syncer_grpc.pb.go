@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.7
+// source: syncer/proto/syncer.proto
 
 package proto
 
@@ -12,6 +16,7 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
 // SyncPeerClient is the client API for SyncPeer service.
@@ -22,6 +27,12 @@ type SyncPeerClient interface {
 	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (SyncPeer_GetBlocksClient, error)
 	// Returns server's status
 	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SyncPeerStatus, error)
+	// Returns the transactions of the block at the specified indices
+	GetBlockTransactions(ctx context.Context, in *GetBlockTransactionsRequest, opts ...grpc.CallOption) (*BlockTransactions, error)
+	// Returns the consecutive headers beginning at the specified height
+	GetHeaders(ctx context.Context, in *GetHeadersRequest, opts ...grpc.CallOption) (*Headers, error)
+	// Returns the bodies of the blocks with the specified hashes
+	GetBodies(ctx context.Context, in *GetBodiesRequest, opts ...grpc.CallOption) (*Bodies, error)
 }
 
 type syncPeerClient struct {
@@ -33,7 +44,7 @@ func NewSyncPeerClient(cc grpc.ClientConnInterface) SyncPeerClient {
 }
 
 func (c *syncPeerClient) GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (SyncPeer_GetBlocksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_SyncPeer_serviceDesc.Streams[0], "/v1.SyncPeer/GetBlocks", opts...)
+	stream, err := c.cc.NewStream(ctx, &SyncPeer_ServiceDesc.Streams[0], "/v1.SyncPeer/GetBlocks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,6 +84,33 @@ func (c *syncPeerClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts
 	return out, nil
 }
 
+func (c *syncPeerClient) GetBlockTransactions(ctx context.Context, in *GetBlockTransactionsRequest, opts ...grpc.CallOption) (*BlockTransactions, error) {
+	out := new(BlockTransactions)
+	err := c.cc.Invoke(ctx, "/v1.SyncPeer/GetBlockTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncPeerClient) GetHeaders(ctx context.Context, in *GetHeadersRequest, opts ...grpc.CallOption) (*Headers, error) {
+	out := new(Headers)
+	err := c.cc.Invoke(ctx, "/v1.SyncPeer/GetHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncPeerClient) GetBodies(ctx context.Context, in *GetBodiesRequest, opts ...grpc.CallOption) (*Bodies, error) {
+	out := new(Bodies)
+	err := c.cc.Invoke(ctx, "/v1.SyncPeer/GetBodies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SyncPeerServer is the server API for SyncPeer service.
 // All implementations must embed UnimplementedSyncPeerServer
 // for forward compatibility
@@ -81,6 +119,12 @@ type SyncPeerServer interface {
 	GetBlocks(*GetBlocksRequest, SyncPeer_GetBlocksServer) error
 	// Returns server's status
 	GetStatus(context.Context, *emptypb.Empty) (*SyncPeerStatus, error)
+	// Returns the transactions of the block at the specified indices
+	GetBlockTransactions(context.Context, *GetBlockTransactionsRequest) (*BlockTransactions, error)
+	// Returns the consecutive headers beginning at the specified height
+	GetHeaders(context.Context, *GetHeadersRequest) (*Headers, error)
+	// Returns the bodies of the blocks with the specified hashes
+	GetBodies(context.Context, *GetBodiesRequest) (*Bodies, error)
 	mustEmbedUnimplementedSyncPeerServer()
 }
 
@@ -94,6 +138,15 @@ func (UnimplementedSyncPeerServer) GetBlocks(*GetBlocksRequest, SyncPeer_GetBloc
 func (UnimplementedSyncPeerServer) GetStatus(context.Context, *emptypb.Empty) (*SyncPeerStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
+func (UnimplementedSyncPeerServer) GetBlockTransactions(context.Context, *GetBlockTransactionsRequest) (*BlockTransactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockTransactions not implemented")
+}
+func (UnimplementedSyncPeerServer) GetHeaders(context.Context, *GetHeadersRequest) (*Headers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeaders not implemented")
+}
+func (UnimplementedSyncPeerServer) GetBodies(context.Context, *GetBodiesRequest) (*Bodies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBodies not implemented")
+}
 func (UnimplementedSyncPeerServer) mustEmbedUnimplementedSyncPeerServer() {}
 
 // UnsafeSyncPeerServer may be embedded to opt out of forward compatibility for this service.
@@ -104,7 +157,7 @@ type UnsafeSyncPeerServer interface {
 }
 
 func RegisterSyncPeerServer(s grpc.ServiceRegistrar, srv SyncPeerServer) {
-	s.RegisterService(&_SyncPeer_serviceDesc, srv)
+	s.RegisterService(&SyncPeer_ServiceDesc, srv)
 }
 
 func _SyncPeer_GetBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
@@ -146,7 +199,64 @@ func _SyncPeer_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _SyncPeer_serviceDesc = grpc.ServiceDesc{
+func _SyncPeer_GetBlockTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncPeerServer).GetBlockTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SyncPeer/GetBlockTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncPeerServer).GetBlockTransactions(ctx, req.(*GetBlockTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncPeer_GetHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncPeerServer).GetHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SyncPeer/GetHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncPeerServer).GetHeaders(ctx, req.(*GetHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncPeer_GetBodies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBodiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncPeerServer).GetBodies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SyncPeer/GetBodies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncPeerServer).GetBodies(ctx, req.(*GetBodiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SyncPeer_ServiceDesc is the grpc.ServiceDesc for SyncPeer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyncPeer_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.SyncPeer",
 	HandlerType: (*SyncPeerServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -154,6 +264,18 @@ var _SyncPeer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _SyncPeer_GetStatus_Handler,
 		},
+		{
+			MethodName: "GetBlockTransactions",
+			Handler:    _SyncPeer_GetBlockTransactions_Handler,
+		},
+		{
+			MethodName: "GetHeaders",
+			Handler:    _SyncPeer_GetHeaders_Handler,
+		},
+		{
+			MethodName: "GetBodies",
+			Handler:    _SyncPeer_GetBodies_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -11,6 +11,13 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
+const (
+	// maxHeadersPerRequest is the max number of the headers returned by GetHeaders
+	maxHeadersPerRequest = 512
+	// maxBodiesPerRequest is the max number of the bodies returned by GetBodies
+	maxBodiesPerRequest = 128
+)
+
 var (
 	ErrBlockNotFound = errors.New("block not found")
 )
@@ -64,8 +71,16 @@ func (s *syncPeerService) GetBlocks(
 			return ErrBlockNotFound
 		}
 
-		resp := toProtoBlock(block)
-		metrics.SetGauge([]string{syncerMetrics, "egress_bytes"}, float32(len(resp.Block)))
+		var resp *proto.Block
+
+		// the latest blocks are sent compact, their transactions are likely in the txpool of the peer
+		if req.Compact && s.blockchain.Header().Number-i < compactBlocksDepth && len(block.Uncles) == 0 {
+			resp = toProtoCompactBlock(block)
+		} else {
+			resp = toProtoBlock(block)
+		}
+
+		metrics.SetGauge([]string{syncerMetrics, "egress_bytes"}, float32(protoBlockSize(resp)))
 
 		// if client closes stream, context.Canceled is given
 		if err := stream.Send(resp); err != nil {
@@ -91,6 +106,73 @@ func (s *syncPeerService) GetStatus(
 	}, nil
 }
 
+// GetHeaders is a gRPC endpoint to return the consecutive headers from the specific height.
+// The headers are returned up to the latest block, and at most maxHeadersPerRequest of them
+func (s *syncPeerService) GetHeaders(
+	_ context.Context,
+	req *proto.GetHeadersRequest,
+) (*proto.Headers, error) {
+	count := req.Count
+	if count > maxHeadersPerRequest {
+		count = maxHeadersPerRequest
+	}
+
+	var (
+		latest  = s.blockchain.Header().Number
+		headers = make([][]byte, 0, count)
+		size    = 0
+	)
+
+	for i := req.From; i < req.From+count && i <= latest; i++ {
+		header, ok := s.blockchain.GetHeaderByNumber(i)
+		if !ok {
+			return nil, ErrBlockNotFound
+		}
+
+		raw := header.MarshalRLP()
+		size += len(raw)
+
+		headers = append(headers, raw)
+	}
+
+	metrics.SetGauge([]string{syncerMetrics, "egress_bytes"}, float32(size))
+
+	return &proto.Headers{Headers: headers}, nil
+}
+
+// GetBodies is a gRPC endpoint to return the bodies of the blocks with the specific hashes.
+// The bodies are returned in the requested order up to the first unknown block, and at most maxBodiesPerRequest of them
+func (s *syncPeerService) GetBodies(
+	_ context.Context,
+	req *proto.GetBodiesRequest,
+) (*proto.Bodies, error) {
+	hashes := req.Hashes
+	if len(hashes) > maxBodiesPerRequest {
+		hashes = hashes[:maxBodiesPerRequest]
+	}
+
+	var (
+		bodies = make([][]byte, 0, len(hashes))
+		size   = 0
+	)
+
+	for _, hash := range hashes {
+		body, ok := s.blockchain.GetBodyByHash(types.BytesToHash(hash))
+		if !ok {
+			break
+		}
+
+		raw := body.MarshalRLPTo(nil)
+		size += len(raw)
+
+		bodies = append(bodies, raw)
+	}
+
+	metrics.SetGauge([]string{syncerMetrics, "egress_bytes"}, float32(size))
+
+	return &proto.Bodies{Bodies: bodies}, nil
+}
+
 // toProtoBlock converts type.Block -> proto.Block
 func toProtoBlock(block *types.Block) *proto.Block {
 	return &proto.Block{
@@ -10,6 +10,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/syncer/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
@@ -154,3 +155,191 @@ func TestGetStatus(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, headerNumber, status.Number)
 }
+
+func Test_syncPeerService_GetCompactBlocks(t *testing.T) {
+	t.Parallel()
+
+	const latest = 10
+
+	blocks := createMockBlocksWithTxs(latest, 2)
+
+	service := &syncPeerService{
+		blockchain: &mockBlockchain{
+			headerHandler: newSimpleHeaderHandler(latest),
+			getBlockByNumberHandler: func(u uint64, _ bool) (*types.Block, bool) {
+				if u == 0 || u > latest {
+					return nil, false
+				}
+
+				return blocks[u-1], true
+			},
+		},
+	}
+
+	client := newMockGrpcClient(t, service)
+
+	stream, err := client.GetBlocks(context.Background(), &proto.GetBlocksRequest{
+		From:    1,
+		Compact: true,
+	})
+	require.NoError(t, err)
+
+	for _, block := range blocks {
+		protoBlock, err := stream.Recv()
+		require.NoError(t, err)
+
+		// only the latest blocks are sent compact
+		if latest-block.Number() >= compactBlocksDepth {
+			assert.Nil(t, protoBlock.Compact)
+			assert.Equal(t, block.MarshalRLP(), protoBlock.Block)
+
+			continue
+		}
+
+		require.NotNil(t, protoBlock.Compact)
+		assert.Empty(t, protoBlock.Block)
+		assert.Equal(t, block.Header.MarshalRLP(), protoBlock.Compact.Header)
+		assert.Equal(t, [][]byte{block.Transactions[0].Hash.Bytes(), block.Transactions[1].Hash.Bytes()},
+			protoBlock.Compact.TxHashes)
+	}
+
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func Test_syncPeerService_GetBlockTransactions(t *testing.T) {
+	t.Parallel()
+
+	block := createMockBlocksWithTxs(1, 3)[0]
+
+	service := &syncPeerService{
+		blockchain: &mockBlockchain{
+			getBlockByNumberHandler: func(u uint64, _ bool) (*types.Block, bool) {
+				return block, u == block.Number()
+			},
+		},
+	}
+
+	client := newMockGrpcClient(t, service)
+
+	resp, err := client.GetBlockTransactions(context.Background(), &proto.GetBlockTransactionsRequest{
+		Number:  block.Number(),
+		Indices: []uint32{2, 0},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{block.Transactions[2].MarshalRLP(), block.Transactions[0].MarshalRLP()},
+		resp.Transactions)
+
+	_, err = client.GetBlockTransactions(context.Background(), &proto.GetBlockTransactionsRequest{
+		Number:  block.Number(),
+		Indices: []uint32{3},
+	})
+	assert.ErrorContains(t, err, errInvalidTxIndex.Error())
+
+	_, err = client.GetBlockTransactions(context.Background(), &proto.GetBlockTransactionsRequest{
+		Number: block.Number() + 1,
+	})
+	assert.ErrorContains(t, err, ErrBlockNotFound.Error())
+}
+
+func Test_syncPeerService_GetHeaders(t *testing.T) {
+	t.Parallel()
+
+	const latest = maxHeadersPerRequest + 10
+
+	blocks := createMockBlocks(latest)
+
+	service := &syncPeerService{
+		blockchain: &mockBlockchain{
+			headerHandler: newSimpleHeaderHandler(latest),
+			getHeaderByNumberHandler: func(u uint64) (*types.Header, bool) {
+				if u == 0 || u > latest {
+					return nil, false
+				}
+
+				return blocks[u-1].Header, true
+			},
+		},
+	}
+
+	client := newMockGrpcClient(t, service)
+
+	tests := []struct {
+		name     string
+		from     uint64
+		count    uint64
+		expected []*types.Block
+	}{
+		{
+			name:     "should return the requested headers",
+			from:     5,
+			count:    3,
+			expected: blocks[4:7],
+		},
+		{
+			name:     "should return the headers up to the latest",
+			from:     latest - 1,
+			count:    5,
+			expected: blocks[latest-2:],
+		},
+		{
+			name:     "should return at most maxHeadersPerRequest headers",
+			from:     1,
+			count:    latest,
+			expected: blocks[:maxHeadersPerRequest],
+		},
+		{
+			name:     "should return no headers above the latest",
+			from:     latest + 1,
+			count:    5,
+			expected: []*types.Block{},
+		},
+	}
+
+	for _, test := range tests {
+		resp, err := client.GetHeaders(context.Background(), &proto.GetHeadersRequest{
+			From:  test.from,
+			Count: test.count,
+		})
+		require.NoError(t, err, test.name)
+		require.Len(t, resp.Headers, len(test.expected), test.name)
+
+		for i, block := range test.expected {
+			assert.Equal(t, block.Header.MarshalRLP(), resp.Headers[i], test.name)
+		}
+	}
+}
+
+func Test_syncPeerService_GetBodies(t *testing.T) {
+	t.Parallel()
+
+	blocks := createMockBlocksWithTxs(3, 2)
+
+	service := &syncPeerService{
+		blockchain: &mockBlockchain{
+			getBodyByHashHandler: func(hash types.Hash) (*types.Body, bool) {
+				for _, block := range blocks {
+					if block.Hash() == hash {
+						return block.Body(), true
+					}
+				}
+
+				return nil, false
+			},
+		},
+	}
+
+	client := newMockGrpcClient(t, service)
+
+	// the bodies are returned up to the first unknown block
+	resp, err := client.GetBodies(context.Background(), &proto.GetBodiesRequest{
+		Hashes: [][]byte{
+			blocks[2].Hash().Bytes(),
+			blocks[0].Hash().Bytes(),
+			types.StringToHash("1").Bytes(),
+			blocks[1].Hash().Bytes(),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{blocks[2].Body().MarshalRLPTo(nil), blocks[0].Body().MarshalRLPTo(nil)}, resp.Bodies)
+}
@@ -0,0 +1,387 @@
+package syncer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// skeletonSyncThreshold is the min number of the blocks the best peer is ahead to sync them by the skeleton,
+	// the closer blocks are streamed from the best peer
+	skeletonSyncThreshold = 1024
+
+	// skeletonRangeSize is the number of the headers requested from a peer at once
+	skeletonRangeSize = 192
+
+	// skeletonWindowRanges is the number of the header ranges fetched in parallel
+	skeletonWindowRanges = 16
+
+	// skeletonBodiesBatchSize is the number of the bodies requested from a peer at once
+	skeletonBodiesBatchSize = 64
+
+	// skeletonBodiesWorkers is the number of the bodies batches fetched in parallel
+	skeletonBodiesWorkers = 8
+
+	// skeletonMaxAttempts is the max number of the peers a header range or a bodies batch is requested from
+	skeletonMaxAttempts = 3
+)
+
+var (
+	errNoSkeletonPeer      = errors.New("no peer left to request from")
+	errHeaderNotLinked     = errors.New("header doesn't link to its parent")
+	errUnexpectedHeader    = errors.New("unexpected header number")
+	errMissingBodies       = errors.New("peer returned fewer bodies than requested")
+	errSkeletonSyncStopped = errors.New("skeleton sync stopped")
+)
+
+// headerRange is the range of the consecutive headers requested from a single peer
+type headerRange struct {
+	from    uint64
+	count   uint64
+	headers []*types.Header
+	err     error
+
+	// peers the range was requested from
+	tried map[peer.ID]bool
+}
+
+// bodiesBatch is the batch of the bodies requested from a single peer, assembled to the blocks
+type bodiesBatch struct {
+	headers []*types.Header
+	blocks  []*types.Block
+	err     error
+
+	// done is closed once the batch is fetched or failed
+	done chan struct{}
+}
+
+// skeletonSync syncs the blocks up to the target from all the peers having them.
+// The headers are fetched in parallel ranges and validated to link into a chain, then the bodies are fetched
+// concurrently and imported in order. The ranges and the batches failing the validation are requested
+// from the other peers. The receipts aren't downloaded, as they are produced by executing the blocks
+func (s *syncer) skeletonSync(target uint64, newBlockCallback func(*types.FullBlock) bool) (uint64, bool, error) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	var (
+		blockCh    = make(chan *types.Block, skeletonBodiesBatchSize)
+		fetchErrCh = make(chan error, 1)
+	)
+
+	go func() {
+		defer close(blockCh)
+
+		fetchErrCh <- s.fetchSkeleton(s.blockchain.Header(), target, blockCh, stopCh)
+	}()
+
+	// the peers are timed out per request, the blocks may arrive in bursts
+	lastNumber, shouldTerminate, err := s.importBlocks(s.startImportPipeline(blockCh, stopCh, 0), newBlockCallback)
+	if err != nil {
+		return lastNumber, shouldTerminate, err
+	}
+
+	if err := <-fetchErrCh; err != nil {
+		return lastNumber, shouldTerminate, err
+	}
+
+	return lastNumber, shouldTerminate, nil
+}
+
+// fetchSkeleton fetches the blocks above the parent up to the target, window by window,
+// and sends them to the block channel in order
+func (s *syncer) fetchSkeleton(
+	parent *types.Header,
+	target uint64,
+	blockCh chan<- *types.Block,
+	stopCh <-chan struct{},
+) error {
+	for parent.Number < target {
+		s.syncProgression.UpdatePhase(progress.SyncPhaseHeaders)
+
+		headers, err := s.fetchHeaderWindow(parent, target)
+		if err != nil {
+			return err
+		}
+
+		s.syncProgression.UpdatePhase(progress.SyncPhaseBodies)
+
+		if err := s.fetchBodies(headers, blockCh, stopCh); err != nil {
+			return err
+		}
+
+		parent = headers[len(headers)-1]
+	}
+
+	// the rest of the fetched blocks are being executed
+	s.syncProgression.UpdatePhase(progress.SyncPhaseState)
+
+	return nil
+}
+
+// fetchHeaderWindow fetches the header ranges above the parent in parallel, spread over the peers,
+// and validates they link into a chain. The range not linking to its parent is requested from another peer
+func (s *syncer) fetchHeaderWindow(parent *types.Header, target uint64) ([]*types.Header, error) {
+	ranges := make([]*headerRange, 0, skeletonWindowRanges)
+
+	for from := parent.Number + 1; from <= target && len(ranges) < skeletonWindowRanges; from += skeletonRangeSize {
+		count := target - from + 1
+		if count > skeletonRangeSize {
+			count = skeletonRangeSize
+		}
+
+		ranges = append(ranges, &headerRange{from: from, count: count, tried: make(map[peer.ID]bool)})
+	}
+
+	var wg sync.WaitGroup
+
+	for i, r := range ranges {
+		i, r := i, r
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			r.headers, r.err = s.fetchHeaderRange(r, i)
+		}()
+	}
+
+	wg.Wait()
+
+	headers := make([]*types.Header, 0, len(ranges)*skeletonRangeSize)
+
+	for i, r := range ranges {
+		for {
+			if r.err == nil {
+				if r.err = validateHeaderRange(parent, r.from, r.headers); r.err == nil {
+					break
+				}
+
+				metrics.IncrCounter([]string{syncerMetrics, "skeleton", "bad_headers"}, 1)
+			}
+
+			s.logger.Debug("failed to fetch headers, retry from another peer", "from", r.from, "err", r.err)
+
+			if len(r.tried) >= skeletonMaxAttempts {
+				return nil, fmt.Errorf("failed to fetch headers from %d: %w", r.from, r.err)
+			}
+
+			headers, err := s.fetchHeaderRange(r, i)
+			if errors.Is(err, errNoSkeletonPeer) {
+				return nil, fmt.Errorf("failed to fetch headers from %d: %w", r.from, r.err)
+			}
+
+			r.headers, r.err = headers, err
+		}
+
+		headers = append(headers, r.headers...)
+		parent = r.headers[len(r.headers)-1]
+	}
+
+	return headers, nil
+}
+
+// fetchHeaderRange requests the range from the peers it wasn't requested from yet,
+// until a peer returns it or the attempts run out
+func (s *syncer) fetchHeaderRange(r *headerRange, index int) ([]*types.Header, error) {
+	err := errNoSkeletonPeer
+
+	for len(r.tried) < skeletonMaxAttempts {
+		syncPeer := s.pickSkeletonPeer(r.from+r.count-1, r.tried, index)
+		if syncPeer == nil {
+			return nil, err
+		}
+
+		r.tried[syncPeer.ID] = true
+
+		var headers []*types.Header
+
+		if headers, err = s.syncPeerClient.GetHeaders(syncPeer.ID, r.from, r.count); err == nil {
+			return headers, nil
+		}
+
+		s.logger.Debug("failed to get headers from peer", "peer", syncPeer.ID, "from", r.from, "err", err)
+	}
+
+	return nil, err
+}
+
+// fetchBodies fetches the bodies of the headers in batches by the concurrent workers,
+// and sends the assembled blocks to the block channel in order
+func (s *syncer) fetchBodies(headers []*types.Header, blockCh chan<- *types.Block, stopCh <-chan struct{}) error {
+	batches := make([]*bodiesBatch, 0, len(headers)/skeletonBodiesBatchSize+1)
+
+	for i := 0; i < len(headers); i += skeletonBodiesBatchSize {
+		end := i + skeletonBodiesBatchSize
+		if end > len(headers) {
+			end = len(headers)
+		}
+
+		batches = append(batches, &bodiesBatch{headers: headers[i:end], done: make(chan struct{})})
+	}
+
+	// abortCh stops dispatching the remaining batches once the fetch ends
+	abortCh := make(chan struct{})
+	defer close(abortCh)
+
+	taskCh := make(chan int)
+
+	go func() {
+		defer close(taskCh)
+
+		for i := range batches {
+			select {
+			case taskCh <- i:
+			case <-abortCh:
+				return
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < skeletonBodiesWorkers; i++ {
+		go func() {
+			for index := range taskCh {
+				batch := batches[index]
+				batch.blocks, batch.err = s.fetchBodiesBatch(batch.headers, index)
+
+				close(batch.done)
+			}
+		}()
+	}
+
+	for _, batch := range batches {
+		select {
+		case <-batch.done:
+		case <-stopCh:
+			return errSkeletonSyncStopped
+		}
+
+		if batch.err != nil {
+			return batch.err
+		}
+
+		for _, block := range batch.blocks {
+			select {
+			case blockCh <- block:
+			case <-stopCh:
+				return errSkeletonSyncStopped
+			}
+		}
+	}
+
+	return nil
+}
+
+// fetchBodiesBatch requests the bodies of the headers from the peers, and assembles them to the blocks.
+// The bodies missing or not matching their headers are requested from another peer
+func (s *syncer) fetchBodiesBatch(headers []*types.Header, index int) ([]*types.Block, error) {
+	var (
+		blocks = make([]*types.Block, 0, len(headers))
+		tried  = make(map[peer.ID]bool)
+		err    = errNoSkeletonPeer
+	)
+
+	for len(tried) < skeletonMaxAttempts {
+		syncPeer := s.pickSkeletonPeer(headers[len(headers)-1].Number, tried, index)
+		if syncPeer == nil {
+			break
+		}
+
+		tried[syncPeer.ID] = true
+
+		pending := headers[len(blocks):]
+
+		hashes := make([]types.Hash, len(pending))
+		for i, header := range pending {
+			hashes[i] = header.Hash
+		}
+
+		var bodies []*types.Body
+
+		if bodies, err = s.syncPeerClient.GetBodies(syncPeer.ID, hashes); err != nil {
+			s.logger.Debug("failed to get bodies from peer", "peer", syncPeer.ID, "err", err)
+
+			continue
+		}
+
+		for i, body := range bodies {
+			block := assembleBlock(pending[i], body)
+
+			if err = verifyBlockRoots(block); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "skeleton", "bad_bodies"}, 1)
+				s.logger.Debug("peer returned invalid body", "peer", syncPeer.ID, "number", block.Number(), "err", err)
+
+				break
+			}
+
+			blocks = append(blocks, block)
+		}
+
+		if len(blocks) == len(headers) {
+			return blocks, nil
+		}
+
+		if err == nil {
+			err = errMissingBodies
+		}
+	}
+
+	return nil, fmt.Errorf("failed to fetch bodies from %d: %w", headers[0].Number, err)
+}
+
+// pickSkeletonPeer returns a peer having the block of the given number, which wasn't tried yet.
+// The requests are spread over the peers by the index of the request
+func (s *syncer) pickSkeletonPeer(number uint64, tried map[peer.ID]bool, index int) *NoForkPeer {
+	candidates := make([]*NoForkPeer, 0)
+
+	for _, syncPeer := range s.peerMap.PeersWithBlock(number) {
+		if !tried[syncPeer.ID] {
+			candidates = append(candidates, syncPeer)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return candidates[index%len(candidates)]
+}
+
+// validateHeaderRange validates the headers are the consecutive blocks from the given number,
+// linked to the parent by their parent hashes
+func validateHeaderRange(parent *types.Header, from uint64, headers []*types.Header) error {
+	for i, header := range headers {
+		if header.Number != from+uint64(i) {
+			return fmt.Errorf("%w: have %d, want %d", errUnexpectedHeader, header.Number, from+uint64(i))
+		}
+
+		if header.ParentHash != parent.Hash {
+			return fmt.Errorf("%w: block %d", errHeaderNotLinked, header.Number)
+		}
+
+		parent = header
+	}
+
+	return nil
+}
+
+// assembleBlock assembles the block from the header and the body received from the peer
+func assembleBlock(header *types.Header, body *types.Body) *types.Block {
+	for _, tx := range body.Transactions {
+		tx.ComputeHash(header.Number)
+	}
+
+	return &types.Block{
+		Header:       header,
+		Transactions: body.Transactions,
+		Uncles:       body.Uncles,
+	}
+}
@@ -0,0 +1,371 @@
+package syncer
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestPeerUnavailable = errors.New("peer unavailable")
+
+// newSkeletonTestChain creates the chain of the linked blocks above the genesis, the genesis is the first block
+func newSkeletonTestChain(count, txsPerBlock int) []*types.Block {
+	blocks := make([]*types.Block, count+1)
+
+	blocks[0] = &types.Block{
+		Header: &types.Header{TxRoot: types.EmptyRootHash, Sha3Uncles: types.EmptyUncleHash},
+	}
+	blocks[0].Header.ComputeHash()
+
+	for i := 1; i <= count; i++ {
+		number := uint64(i)
+		block := &types.Block{
+			Header: &types.Header{
+				Number:     number,
+				ParentHash: blocks[i-1].Hash(),
+				Sha3Uncles: types.EmptyUncleHash,
+			},
+		}
+
+		for j := 0; j < txsPerBlock; j++ {
+			tx := &types.Transaction{
+				Nonce:    number*uint64(txsPerBlock) + uint64(j),
+				GasPrice: big.NewInt(1),
+				Gas:      21000,
+				Value:    big.NewInt(1),
+				V:        big.NewInt(27),
+				R:        big.NewInt(1),
+				S:        big.NewInt(1),
+			}
+
+			block.Transactions = append(block.Transactions, tx.ComputeHash(number))
+		}
+
+		block.Header.TxRoot = buildroot.CalculateTransactionsRoot(block.Transactions, number)
+		block.Header.ComputeHash()
+
+		blocks[i] = block
+	}
+
+	return blocks
+}
+
+// skeletonTestPeer serves the headers and the bodies of the chain, the tampering functions make it misbehave
+type skeletonTestPeer struct {
+	chain         []*types.Block
+	byHash        map[types.Hash]*types.Block
+	tamperHeaders func([]*types.Header) ([]*types.Header, error)
+	tamperBodies  func([]*types.Body) ([]*types.Body, error)
+}
+
+func (p *skeletonTestPeer) getHeaders(from, count uint64) ([]*types.Header, error) {
+	if from+count > uint64(len(p.chain)) {
+		return nil, errUnexpectedHeadersCount
+	}
+
+	headers := make([]*types.Header, count)
+	for i := range headers {
+		headers[i] = p.chain[from+uint64(i)].Header.Copy()
+	}
+
+	if p.tamperHeaders != nil {
+		return p.tamperHeaders(headers)
+	}
+
+	return headers, nil
+}
+
+func (p *skeletonTestPeer) getBodies(hashes []types.Hash) ([]*types.Body, error) {
+	bodies := make([]*types.Body, 0, len(hashes))
+
+	for _, hash := range hashes {
+		block, ok := p.byHash[hash]
+		if !ok {
+			break
+		}
+
+		txs := make([]*types.Transaction, len(block.Transactions))
+		for i, tx := range block.Transactions {
+			txs[i] = tx.Copy()
+		}
+
+		bodies = append(bodies, &types.Body{Transactions: txs})
+	}
+
+	if p.tamperBodies != nil {
+		return p.tamperBodies(bodies)
+	}
+
+	return bodies, nil
+}
+
+// skeletonTestBlockchain is the local chain the synced blocks are written to
+type skeletonTestBlockchain struct {
+	lock   sync.Mutex
+	blocks []*types.Block
+}
+
+func (b *skeletonTestBlockchain) mock() *mockBlockchain {
+	return &mockBlockchain{
+		headerHandler: func() *types.Header {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+
+			return b.blocks[len(b.blocks)-1].Header
+		},
+		verifyFinalizedBlockHandler: func(block *types.Block) (*types.FullBlock, error) {
+			return &types.FullBlock{Block: block}, nil
+		},
+		writeFullBlockHandler: func(fullBlock *types.FullBlock) error {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+
+			b.blocks = append(b.blocks, fullBlock.Block)
+
+			return nil
+		},
+	}
+}
+
+func (b *skeletonTestBlockchain) hashes() []types.Hash {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	hashes := make([]types.Hash, len(b.blocks))
+	for i, block := range b.blocks {
+		hashes[i] = block.Hash()
+	}
+
+	return hashes
+}
+
+func blockHashes(blocks []*types.Block) []types.Hash {
+	hashes := make([]types.Hash, len(blocks))
+	for i, block := range blocks {
+		hashes[i] = block.Hash()
+	}
+
+	return hashes
+}
+
+func newSkeletonTestSyncer(
+	local *skeletonTestBlockchain,
+	peers map[peer.ID]*skeletonTestPeer,
+	getBlocksHandler func(peer.ID, uint64, time.Duration) (<-chan *types.Block, error),
+) *syncer {
+	s := NewTestSyncer(
+		nil,
+		local.mock(),
+		time.Second,
+		&mockSyncPeerClient{
+			getBlocksHandler: getBlocksHandler,
+			getHeadersHandler: func(id peer.ID, from, count uint64) ([]*types.Header, error) {
+				return peers[id].getHeaders(from, count)
+			},
+			getBodiesHandler: func(id peer.ID, hashes []types.Hash) ([]*types.Body, error) {
+				return peers[id].getBodies(hashes)
+			},
+		},
+		&mockProgression{},
+	)
+
+	for id, p := range peers {
+		p.byHash = make(map[types.Hash]*types.Block, len(p.chain))
+		for _, block := range p.chain {
+			p.byHash[block.Hash()] = block
+		}
+
+		s.peerMap.Put(&NoForkPeer{ID: id, Number: uint64(len(p.chain) - 1), Distance: big.NewInt(0)})
+	}
+
+	return s
+}
+
+func TestSkeletonSync(t *testing.T) {
+	t.Parallel()
+
+	// the blocks span multiple windows of the header ranges
+	chain := newSkeletonTestChain(skeletonWindowRanges*skeletonRangeSize+100, 0)
+	target := uint64(len(chain) - 1)
+
+	local := &skeletonTestBlockchain{blocks: []*types.Block{chain[0]}}
+	s := newSkeletonTestSyncer(local, map[peer.ID]*skeletonTestPeer{
+		"A": {chain: chain},
+		"B": {chain: chain},
+	}, nil)
+
+	lastNumber, shouldTerminate, err := s.skeletonSync(target, func(b *types.FullBlock) bool {
+		return b.Block.Number() == target
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, target, lastNumber)
+	assert.True(t, shouldTerminate)
+	assert.Equal(t, blockHashes(chain), local.hashes())
+}
+
+func TestSkeletonSync_RetryFromOtherPeers(t *testing.T) {
+	t.Parallel()
+
+	chain := newSkeletonTestChain(4*skeletonRangeSize, 2)
+	target := uint64(len(chain) - 1)
+
+	local := &skeletonTestBlockchain{blocks: []*types.Block{chain[0]}}
+	s := newSkeletonTestSyncer(local, map[peer.ID]*skeletonTestPeer{
+		"A": {chain: chain},
+		// returns the headers not linked to their parents
+		"B": {
+			chain: chain,
+			tamperHeaders: func(headers []*types.Header) ([]*types.Header, error) {
+				headers[0].ParentHash = types.StringToHash("1")
+				headers[0].ComputeHash()
+
+				return headers, nil
+			},
+		},
+		// returns the bodies not matching their headers
+		"C": {
+			chain: chain,
+			tamperBodies: func(bodies []*types.Body) ([]*types.Body, error) {
+				bodies[len(bodies)/2].Transactions = bodies[len(bodies)/2].Transactions[1:]
+
+				return bodies, nil
+			},
+		},
+	}, nil)
+
+	lastNumber, _, err := s.skeletonSync(target, func(*types.FullBlock) bool { return false })
+
+	require.NoError(t, err)
+	assert.Equal(t, target, lastNumber)
+	assert.Equal(t, blockHashes(chain), local.hashes())
+}
+
+func TestSkeletonSync_NoValidPeer(t *testing.T) {
+	t.Parallel()
+
+	chain := newSkeletonTestChain(2*skeletonRangeSize, 0)
+	target := uint64(len(chain) - 1)
+
+	local := &skeletonTestBlockchain{blocks: []*types.Block{chain[0]}}
+	s := newSkeletonTestSyncer(local, map[peer.ID]*skeletonTestPeer{
+		"A": {
+			chain: chain,
+			tamperHeaders: func([]*types.Header) ([]*types.Header, error) {
+				return nil, errTestPeerUnavailable
+			},
+		},
+		"B": {
+			chain: chain,
+			tamperHeaders: func(headers []*types.Header) ([]*types.Header, error) {
+				headers[len(headers)-1].Number++
+
+				return headers, nil
+			},
+		},
+	}, nil)
+
+	_, _, err := s.skeletonSync(target, func(*types.FullBlock) bool { return false })
+
+	require.Error(t, err)
+	assert.Equal(t, blockHashes(chain[:1]), local.hashes())
+}
+
+func TestSync_SkeletonSync(t *testing.T) {
+	t.Parallel()
+
+	chain := newSkeletonTestChain(skeletonSyncThreshold+skeletonRangeSize, 1)
+	target := uint64(len(chain) - 1)
+
+	local := &skeletonTestBlockchain{blocks: []*types.Block{chain[0]}}
+	s := newSkeletonTestSyncer(local, map[peer.ID]*skeletonTestPeer{
+		"A": {chain: chain},
+	}, func(peer.ID, uint64, time.Duration) (<-chan *types.Block, error) {
+		t.Error("blocks shouldn't be streamed once synced by the skeleton")
+
+		return nil, errTestPeerUnavailable
+	})
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- s.Sync(func(b *types.FullBlock) bool {
+			return b.Block.Number() == target
+		})
+	}()
+
+	s.newStatusCh <- struct{}{}
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("sync not completed")
+	}
+
+	assert.Equal(t, blockHashes(chain), local.hashes())
+}
+
+func TestValidateHeaderRange(t *testing.T) {
+	t.Parallel()
+
+	chain := newSkeletonTestChain(5, 0)
+	headers := []*types.Header{chain[2].Header, chain[3].Header, chain[4].Header}
+
+	require.NoError(t, validateHeaderRange(chain[1].Header, 2, headers))
+	require.ErrorIs(t, validateHeaderRange(chain[0].Header, 2, headers), errHeaderNotLinked)
+	require.ErrorIs(t, validateHeaderRange(chain[1].Header, 3, headers), errUnexpectedHeader)
+}
+
+func TestSync_SkeletonSyncTerminated(t *testing.T) {
+	t.Parallel()
+
+	// the headers of the second window aren't served, the skeleton sync stops after the first one
+	window := uint64(skeletonWindowRanges * skeletonRangeSize)
+	chain := newSkeletonTestChain(int(window)+skeletonRangeSize, 0)
+
+	local := &skeletonTestBlockchain{blocks: []*types.Block{chain[0]}}
+	s := newSkeletonTestSyncer(local, map[peer.ID]*skeletonTestPeer{
+		"A": {
+			chain: chain,
+			tamperHeaders: func(headers []*types.Header) ([]*types.Header, error) {
+				if headers[0].Number > window {
+					return nil, errTestPeerUnavailable
+				}
+
+				return headers, nil
+			},
+		},
+	}, func(peer.ID, uint64, time.Duration) (<-chan *types.Block, error) {
+		t.Error("blocks shouldn't be streamed once the sync is terminated")
+
+		return nil, errTestPeerUnavailable
+	})
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- s.Sync(func(*types.FullBlock) bool {
+			return true
+		})
+	}()
+
+	s.newStatusCh <- struct{}{}
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("sync not terminated")
+	}
+
+	assert.Equal(t, blockHashes(chain[:window+1]), local.hashes())
+}
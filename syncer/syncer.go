@@ -3,6 +3,7 @@ package syncer
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/progress"
@@ -36,25 +37,48 @@ type syncer struct {
 	// Timeout for syncing a block
 	blockTimeout time.Duration
 
+	// Config of the block import pipeline of the bulk sync
+	pipelineConfig PipelineConfig
+
 	// Channel to notify Sync that a new status arrived
 	newStatusCh chan struct{}
+
+	// Timeout of the chain head not advancing while the peers are ahead, 0 disables the stall watchdog
+	stallTimeout time.Duration
+
+	// The peer the blocks were synced from last, rotated out if the chain head stalls
+	lastSyncPeer atomic.Value
+
+	// Whether the peers skipped by Sync are retried, set once the stall is healed
+	resetSkipList atomic.Bool
+
+	// The number of the bytes downloaded before the ongoing sync progression started
+	progressionBaseBytes atomic.Uint64
+
+	closeCh chan struct{}
 }
 
 func NewSyncer(
 	logger hclog.Logger,
 	network Network,
 	blockchain Blockchain,
+	txPool TxPool,
 	blockTimeout time.Duration,
+	stallTimeout time.Duration,
+	pipelineConfig PipelineConfig,
 ) Syncer {
 	return &syncer{
 		logger:          logger.Named(syncerName),
 		blockchain:      blockchain,
 		syncProgression: progress.NewProgressionWrapper(progress.ChainSyncBulk),
 		syncPeerService: NewSyncPeerService(network, blockchain),
-		syncPeerClient:  NewSyncPeerClient(logger, network, blockchain),
+		syncPeerClient:  NewSyncPeerClient(logger, network, blockchain, txPool),
 		blockTimeout:    blockTimeout,
+		pipelineConfig:  pipelineConfig,
 		newStatusCh:     make(chan struct{}),
 		peerMap:         new(PeerMap),
+		stallTimeout:    stallTimeout,
+		closeCh:         make(chan struct{}),
 	}
 }
 
@@ -71,11 +95,16 @@ func (s *syncer) Start() error {
 	go s.startPeerStatusUpdateProcess()
 	go s.startPeerConnectionEventProcess()
 
+	if s.stallTimeout > 0 {
+		go s.startStallWatchdog()
+	}
+
 	return nil
 }
 
 // Close terminates goroutine processes
 func (s *syncer) Close() error {
+	close(s.closeCh)
 	close(s.newStatusCh)
 
 	if err := s.syncPeerService.Close(); err != nil {
@@ -145,9 +174,23 @@ func (s *syncer) notifyNewStatusEvent() {
 	}
 }
 
-// GetSyncProgression returns progression
+// GetSyncProgression returns progression, along with the sync peers and the bytes downloaded in the ongoing sync
 func (s *syncer) GetSyncProgression() *progress.Progression {
-	return s.syncProgression.GetProgression()
+	progression := s.syncProgression.GetProgression()
+	if progression == nil {
+		return nil
+	}
+
+	for _, syncPeer := range s.peerMap.PeersWithBlock(0) {
+		progression.Peers = append(progression.Peers, progress.SyncPeer{
+			ID:     syncPeer.ID.String(),
+			Number: syncPeer.Number,
+		})
+	}
+
+	progression.DownloadedBytes = s.syncPeerClient.DownloadedBytes() - s.progressionBaseBytes.Load()
+
+	return progression
 }
 
 // HasSyncPeer returns whether syncer has the peer to syncs blocks
@@ -164,10 +207,23 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 	localLatest := s.blockchain.Header().Number
 	skipList := make(map[peer.ID]bool)
 
+	// the progression is tracked from the first block fetched until the node catches up with the peer
+	inProgress := false
+
+	defer func() {
+		if inProgress {
+			s.syncProgression.StopProgression()
+		}
+	}()
+
 	for {
 		// Wait for a new event to arrive
 		<-s.newStatusCh
 
+		if s.resetSkipList.CompareAndSwap(true, false) {
+			skipList = make(map[peer.ID]bool)
+		}
+
 		// fetch local latest block
 		if header := s.blockchain.Header(); header != nil {
 			localLatest = header.Number
@@ -187,10 +243,44 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 			continue
 		}
 
-		// fetch block from the peer
-		lastNumber, shouldTerminate, err := s.bulkSyncWithPeer(bestPeer.ID, callback)
-		if err != nil {
-			s.logger.Warn("failed to complete bulk sync with peer, try to next one", "peer ID", "error", bestPeer.ID, err)
+		s.lastSyncPeer.Store(bestPeer.ID)
+
+		if !inProgress {
+			s.progressionBaseBytes.Store(s.syncPeerClient.DownloadedBytes())
+			s.syncProgression.StartProgression(localLatest, s.blockchain.SubscribeEvents())
+
+			inProgress = true
+		}
+
+		s.syncProgression.UpdateHighestProgression(bestPeer.Number)
+
+		var (
+			lastNumber      uint64
+			shouldTerminate bool
+			err             error
+		)
+
+		// fetch the distant blocks from all the peers, the rest is streamed from the best peer
+		if bestPeer.Number-localLatest > skeletonSyncThreshold {
+			lastNumber, shouldTerminate, err = s.skeletonSync(bestPeer.Number, callback)
+			if err != nil {
+				s.logger.Warn("failed to complete skeleton sync, fall back to bulk sync", "last", lastNumber, "error", err)
+			}
+
+			// the callback requested to stop syncing, don't resume with the best peer
+			if shouldTerminate {
+				s.syncProgression.StopProgression()
+
+				break
+			}
+		}
+
+		if lastNumber < bestPeer.Number {
+			// fetch block from the peer
+			lastNumber, shouldTerminate, err = s.bulkSyncWithPeer(bestPeer.ID, callback)
+			if err != nil {
+				s.logger.Warn("failed to complete bulk sync with peer, try to next one", "peer ID", "error", bestPeer.ID, err)
+			}
 		}
 
 		if lastNumber < bestPeer.Number {
@@ -200,6 +290,10 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 			continue
 		}
 
+		s.syncProgression.StopProgression()
+
+		inProgress = false
+
 		if shouldTerminate {
 			break
 		}
@@ -211,7 +305,6 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 // bulkSyncWithPeer syncs block with a given peer
 func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.FullBlock) bool) (uint64, bool, error) {
 	localLatest := s.blockchain.Header().Number
-	shouldTerminate := false
 
 	blockCh, err := s.syncPeerClient.GetBlocks(peerID, localLatest+1, s.blockTimeout)
 	if err != nil {
@@ -225,41 +318,60 @@ func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.F
 		}
 	}()
 
-	var lastReceivedNumber uint64
+	stopCh := make(chan struct{})
+	defer close(stopCh)
 
-	for {
-		select {
-		case block, ok := <-blockCh:
-			if !ok {
-				return lastReceivedNumber, shouldTerminate, nil
-			}
+	s.syncProgression.UpdatePhase(progress.SyncPhaseBlocks)
 
-			// safe check
-			if block.Number() == 0 {
-				continue
-			}
+	return s.importBlocks(s.startImportPipeline(blockCh, stopCh, s.blockTimeout), newBlockCallback)
+}
 
-			fullBlock, err := s.blockchain.VerifyFinalizedBlock(block)
-			if err != nil {
-				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+// importBlocks executes and writes the blocks verified by the import pipeline, in order.
+// It returns the number of the last written block, and whether the callback requested to terminate the sync
+func (s *syncer) importBlocks(
+	items <-chan *pipelineBlock,
+	newBlockCallback func(*types.FullBlock) bool,
+) (uint64, bool, error) {
+	var (
+		lastReceivedNumber uint64
+		shouldTerminate    bool
+	)
+
+	for item := range items {
+		if errors.Is(item.err, errTimeout) {
+			return lastReceivedNumber, shouldTerminate, errTimeout
+		}
 
-				return lastReceivedNumber, false, fmt.Errorf("unable to verify block, %w", err)
-			}
+		if item.err != nil {
+			metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
 
-			if err := s.blockchain.WriteFullBlock(fullBlock, syncerName); err != nil {
-				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+			return lastReceivedNumber, false, fmt.Errorf("unable to verify block, %w", item.err)
+		}
 
-				return lastReceivedNumber, false, fmt.Errorf("failed to write block while bulk syncing: %w", err)
-			}
+		start := time.Now()
 
-			updateMetrics(fullBlock)
-			shouldTerminate = newBlockCallback(fullBlock)
+		fullBlock, err := s.blockchain.VerifyFinalizedBlock(item.block)
+		if err != nil {
+			metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
 
-			lastReceivedNumber = block.Number()
-		case <-time.After(s.blockTimeout):
-			return lastReceivedNumber, shouldTerminate, errTimeout
+			return lastReceivedNumber, false, fmt.Errorf("unable to verify block, %w", err)
 		}
+
+		if err := s.blockchain.WriteFullBlock(fullBlock, syncerName); err != nil {
+			metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+			return lastReceivedNumber, false, fmt.Errorf("failed to write block while bulk syncing: %w", err)
+		}
+
+		metrics.MeasureSince([]string{syncerMetrics, "pipeline", "execute"}, start)
+
+		updateMetrics(fullBlock)
+		shouldTerminate = newBlockCallback(fullBlock)
+
+		lastReceivedNumber = item.block.Number()
 	}
+
+	return lastReceivedNumber, shouldTerminate, nil
 }
 
 func updateMetrics(fullBlock *types.FullBlock) {
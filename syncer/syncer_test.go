@@ -15,11 +15,13 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockProgression struct {
 	startingBlock uint64
 	highestBlock  uint64
+	phase         progress.SyncPhase
 }
 
 func (m *mockProgression) StartProgression(startingBlock uint64, subscription blockchain.Subscription) {
@@ -30,6 +32,10 @@ func (m *mockProgression) UpdateHighestProgression(highestBlock uint64) {
 	m.highestBlock = highestBlock
 }
 
+func (m *mockProgression) UpdatePhase(phase progress.SyncPhase) {
+	m.phase = phase
+}
+
 func (m *mockProgression) GetProgression() *progress.Progression {
 	// Syncer doesn't use this method. It just exports
 	return nil
@@ -39,9 +45,12 @@ type mockBlockchain struct {
 	subscription                blockchain.Subscription
 	headerHandler               func() *types.Header
 	getBlockByNumberHandler     func(uint64, bool) (*types.Block, bool)
+	getHeaderByNumberHandler    func(uint64) (*types.Header, bool)
+	getBodyByHashHandler        func(types.Hash) (*types.Body, bool)
 	verifyFinalizedBlockHandler func(*types.Block) (*types.FullBlock, error)
 	writeBlockHandler           func(*types.Block) error
 	writeFullBlockHandler       func(*types.FullBlock) error
+	recoverFromFieldsHandler    func(*types.Block) error
 }
 
 func (m *mockBlockchain) SubscribeEvents() blockchain.Subscription {
@@ -56,6 +65,14 @@ func (m *mockBlockchain) GetBlockByNumber(number uint64, full bool) (*types.Bloc
 	return m.getBlockByNumberHandler(number, full)
 }
 
+func (m *mockBlockchain) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	return m.getHeaderByNumberHandler(number)
+}
+
+func (m *mockBlockchain) GetBodyByHash(hash types.Hash) (*types.Body, bool) {
+	return m.getBodyByHashHandler(hash)
+}
+
 func (m *mockBlockchain) VerifyFinalizedBlock(b *types.Block) (*types.FullBlock, error) {
 	return m.verifyFinalizedBlockHandler(b)
 }
@@ -68,6 +85,14 @@ func (m *mockBlockchain) WriteFullBlock(b *types.FullBlock, s string) error {
 	return m.writeFullBlockHandler(b)
 }
 
+func (m *mockBlockchain) RecoverFromFieldsInBlock(b *types.Block) error {
+	if m.recoverFromFieldsHandler == nil {
+		return nil
+	}
+
+	return m.recoverFromFieldsHandler(b)
+}
+
 func newSimpleHeaderHandler(num uint64) func() *types.Header {
 	return func() *types.Header {
 		return &types.Header{
@@ -90,8 +115,12 @@ type mockSyncPeerClient struct {
 	getPeerStatusHandler                  func(peer.ID) (*NoForkPeer, error)
 	getConnectedPeerStatusesHandler       func() []*NoForkPeer
 	getBlocksHandler                      func(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	getHeadersHandler                     func(peer.ID, uint64, uint64) ([]*types.Header, error)
+	getBodiesHandler                      func(peer.ID, []types.Hash) ([]*types.Body, error)
 	getPeerStatusUpdateChHandler          func() <-chan *NoForkPeer
 	getPeerConnectionUpdateEventChHandler func() <-chan *event.PeerEvent
+	disconnectedPeers                     []peer.ID
+	downloadedBytes                       uint64
 }
 
 func (m *mockSyncPeerClient) DisablePublishingPeerStatus() {}
@@ -120,6 +149,14 @@ func (m *mockSyncPeerClient) GetBlocks(
 	return m.getBlocksHandler(id, start, timeoutPerBlock)
 }
 
+func (m *mockSyncPeerClient) GetHeaders(id peer.ID, from, count uint64) ([]*types.Header, error) {
+	return m.getHeadersHandler(id, from, count)
+}
+
+func (m *mockSyncPeerClient) GetBodies(id peer.ID, hashes []types.Hash) ([]*types.Body, error) {
+	return m.getBodiesHandler(id, hashes)
+}
+
 func (m *mockSyncPeerClient) GetPeerStatusUpdateCh() <-chan *NoForkPeer {
 	return m.getPeerStatusUpdateChHandler()
 }
@@ -132,6 +169,14 @@ func (m *mockSyncPeerClient) CloseStream(peerID peer.ID) error {
 	return nil
 }
 
+func (m *mockSyncPeerClient) DisconnectPeer(peerID peer.ID, reason string) {
+	m.disconnectedPeers = append(m.disconnectedPeers, peerID)
+}
+
+func (m *mockSyncPeerClient) DownloadedBytes() uint64 {
+	return m.downloadedBytes
+}
+
 func GetAllElementsFromPeerMap(t *testing.T, p *PeerMap) []*NoForkPeer {
 	t.Helper()
 
@@ -173,6 +218,7 @@ func NewTestSyncer(
 		blockTimeout:    blockTimeout,
 		newStatusCh:     make(chan struct{}),
 		peerMap:         new(PeerMap),
+		closeCh:         make(chan struct{}),
 	}
 }
 
@@ -483,7 +529,9 @@ func createMockBlocks(num int) []*types.Block {
 	for i := 0; i < num; i++ {
 		blocks[i] = &types.Block{
 			Header: &types.Header{
-				Number: uint64(i + 1),
+				Number:     uint64(i + 1),
+				TxRoot:     types.EmptyRootHash,
+				Sha3Uncles: types.EmptyUncleHash,
 			},
 		}
 	}
@@ -543,11 +591,9 @@ func TestSync(t *testing.T) {
 					return &types.FullBlock{Block: b}, nil
 				}
 			},
-			blocks: blocks[:10],
-			//nolint:godox
-			// TODO: need to fix implementation? (to be fixed in EVM-529)
+			blocks:             blocks[:10],
 			progressionStart:   0,
-			progressionHighest: 0,
+			progressionHighest: 10,
 			err:                nil,
 		},
 		{
@@ -590,11 +636,9 @@ func TestSync(t *testing.T) {
 					return &types.FullBlock{Block: b}, nil
 				}
 			},
-			blocks: blocks[:10],
-			//nolint:godox
-			// TODO: need to fix implementation? (to be fixed in EVM-529)
+			blocks:             blocks[:10],
 			progressionStart:   0,
-			progressionHighest: 0,
+			progressionHighest: 10,
 			err:                nil,
 		},
 	}
@@ -661,6 +705,35 @@ func TestSync(t *testing.T) {
 	}
 }
 
+func TestGetSyncProgression(t *testing.T) {
+	t.Parallel()
+
+	client := &mockSyncPeerClient{downloadedBytes: 1000}
+	syncer := NewTestSyncer(nil, &mockBlockchain{}, time.Second, client,
+		progress.NewProgressionWrapper(progress.ChainSyncBulk))
+
+	assert.Nil(t, syncer.GetSyncProgression())
+
+	syncer.peerMap.Put(peerStatuses...)
+	syncer.progressionBaseBytes.Store(client.downloadedBytes)
+	syncer.syncProgression.StartProgression(5, blockchain.NewMockSubscription())
+	syncer.syncProgression.UpdateHighestProgression(30)
+
+	client.downloadedBytes = 1500
+
+	progression := syncer.GetSyncProgression()
+	require.NotNil(t, progression)
+	assert.Equal(t, uint64(30), progression.HighestBlock)
+	assert.Equal(t, uint64(500), progression.DownloadedBytes)
+	assert.Equal(t, []progress.SyncPeer{
+		{ID: peer.ID("C").String(), Number: 30},
+		{ID: peer.ID("B").String(), Number: 20},
+		{ID: peer.ID("A").String(), Number: 10},
+	}, progression.Peers)
+
+	syncer.syncProgression.StopProgression()
+}
+
 func Test_bulkSyncWithPeer(t *testing.T) {
 	t.Parallel()
 
@@ -670,11 +743,22 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 	for i := 0; i < blockNum; i++ {
 		blocks[i] = &types.Block{
 			Header: &types.Header{
-				Number: uint64(i + 1),
+				Number:     uint64(i + 1),
+				TxRoot:     types.EmptyRootHash,
+				Sha3Uncles: types.EmptyUncleHash,
 			},
 		}
 	}
 
+	// the block whose transactions root doesn't match its body
+	invalidBlock := &types.Block{
+		Header: &types.Header{
+			Number:     6,
+			TxRoot:     types.StringToHash("1"),
+			Sha3Uncles: types.EmptyUncleHash,
+		},
+	}
+
 	var (
 		// mock errors
 		errPeerNoResponse       = errors.New("peer is not responding")
@@ -795,6 +879,27 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 			shouldTerminate:       false,
 			err:                   errBlockInsertionFailed,
 		},
+		{
+			name:            "should return error if header verification is failed",
+			beginningHeight: 0,
+			blockTimeout:    time.Second,
+			blockCallback: func(b *types.FullBlock) bool {
+				return false
+			},
+			getBlocksHandler: func(id peer.ID, start uint64, _ time.Duration) (<-chan *types.Block, error) {
+				return blocksToCh(append(blocks[:5:5], invalidBlock), 0), nil
+			},
+			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
+				return &types.FullBlock{Block: b}, nil
+			},
+			writeFullBlockHandler: func(b *types.FullBlock) error {
+				return nil
+			},
+			blocks:                blocks[:5],
+			lastSyncedBlockNumber: 5,
+			shouldTerminate:       false,
+			err:                   errInvalidTxRoot,
+		},
 		{
 			name:            "should return error in case of timeout",
 			beginningHeight: 0,
@@ -25,12 +25,24 @@ type Blockchain interface {
 	Header() *types.Header
 	// GetBlockByNumber returns block by number
 	GetBlockByNumber(uint64, bool) (*types.Block, bool)
+	// GetHeaderByNumber returns header by number
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+	// GetBodyByHash returns body by block hash
+	GetBodyByHash(types.Hash) (*types.Body, bool)
 	// VerifyFinalizedBlock verifies finalized block
 	VerifyFinalizedBlock(block *types.Block) (*types.FullBlock, error)
 	// WriteBlock writes a given block to chain
 	WriteBlock(*types.Block, string) error
 	// WriteFullBlock writes a given block to chain and saves its receipts to cache
 	WriteFullBlock(*types.FullBlock, string) error
+	// RecoverFromFieldsInBlock recovers the senders of the block transactions
+	RecoverFromFieldsInBlock(*types.Block) error
+}
+
+// TxPool is the transaction pool the compact blocks are reconstructed from
+type TxPool interface {
+	// GetPendingTx returns the transaction by hash
+	GetPendingTx(txHash types.Hash) (*types.Transaction, bool)
 }
 
 type Network interface {
@@ -48,13 +60,15 @@ type Network interface {
 	// and returns a reference to the connection
 	NewProtoConnection(protocol string, peerID peer.ID) (*rawGrpc.ClientConn, error)
 	// NewTopic Creates New Topic for gossip
-	NewTopic(protoID string, obj proto.Message) (*network.Topic, error)
+	NewTopic(protoID string, obj proto.Message, opts ...network.TopicOption) (*network.Topic, error)
 	// IsConnected returns the node is connecting to the peer associated with the given ID
 	IsConnected(peerID peer.ID) bool
 	// SaveProtocolStream saves stream
 	SaveProtocolStream(protocol string, stream *rawGrpc.ClientConn, peerID peer.ID)
 	// CloseProtocolStream closes stream
 	CloseProtocolStream(protocol string, peerID peer.ID) error
+	// DisconnectFromPeer disconnects the node from the peer
+	DisconnectFromPeer(peerID peer.ID, reason string)
 }
 
 type Syncer interface {
@@ -75,6 +89,8 @@ type Progression interface {
 	StartProgression(startingBlock uint64, subscription blockchain.Subscription)
 	// UpdateHighestProgression updates highest block number
 	UpdateHighestProgression(highestBlock uint64)
+	// UpdatePhase updates the current phase of the sync
+	UpdatePhase(phase progress.SyncPhase)
 	// GetProgression returns Progression
 	GetProgression() *progress.Progression
 	// StopProgression finishes progression
@@ -99,6 +115,10 @@ type SyncPeerClient interface {
 	GetConnectedPeerStatuses() []*NoForkPeer
 	// GetBlocks returns a stream of blocks from given height to peer's latest
 	GetBlocks(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	// GetHeaders returns the given number of the consecutive headers from given height
+	GetHeaders(peer.ID, uint64, uint64) ([]*types.Header, error)
+	// GetBodies returns the bodies of the blocks with given hashes
+	GetBodies(peer.ID, []types.Hash) ([]*types.Body, error)
 	// GetPeerStatusUpdateCh returns a channel of peer's status update
 	GetPeerStatusUpdateCh() <-chan *NoForkPeer
 	// GetPeerConnectionUpdateEventCh returns peer's connection change event
@@ -109,4 +129,8 @@ type SyncPeerClient interface {
 	DisablePublishingPeerStatus()
 	// EnablePublishingPeerStatus enables publishing status in syncer topic
 	EnablePublishingPeerStatus()
+	// DisconnectPeer disconnects the node from the peer
+	DisconnectPeer(peerID peer.ID, reason string)
+	// DownloadedBytes returns the number of the block data bytes received from the peers
+	DownloadedBytes() uint64
 }
@@ -0,0 +1,130 @@
+package syncer
+
+import (
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// stallReason is the reason the stalled sync peer is disconnected with
+	stallReason = "chain head stalled"
+
+	// minStallCheckInterval is the lower bound of the interval the chain head progress is checked at
+	minStallCheckInterval = time.Second
+)
+
+// stallWatchdog detects the chain head stall, i.e. the head not advancing for the stall timeout
+// while the peers report the higher heights
+type stallWatchdog struct {
+	timeout time.Duration
+
+	// the head number and the time it was seen advancing last
+	lastNumber  uint64
+	lastAdvance time.Time
+
+	// whether the stall is ongoing
+	stalled bool
+}
+
+func newStallWatchdog(timeout time.Duration, head uint64, now time.Time) *stallWatchdog {
+	return &stallWatchdog{
+		timeout:     timeout,
+		lastNumber:  head,
+		lastAdvance: now,
+	}
+}
+
+// check checks the chain head progress against the best peer height, returning true
+// once the head hasn't advanced for the timeout. The stall is reported once per timeout,
+// so the healing is retried if the head still doesn't advance
+func (w *stallWatchdog) check(head uint64, bestPeer *NoForkPeer, now time.Time) bool {
+	if head > w.lastNumber || bestPeer == nil || bestPeer.Number <= head {
+		w.lastNumber = head
+		w.lastAdvance = now
+		w.stalled = false
+
+		return false
+	}
+
+	if now.Sub(w.lastAdvance) < w.timeout {
+		return false
+	}
+
+	w.stalled = true
+	w.lastAdvance = now
+
+	return true
+}
+
+// stallCheckInterval returns the interval the chain head progress is checked at
+func stallCheckInterval(timeout time.Duration) time.Duration {
+	if interval := timeout / 4; interval > minStallCheckInterval {
+		return interval
+	}
+
+	return minStallCheckInterval
+}
+
+// startStallWatchdog checks the chain head progress periodically
+// and heals the stall by rotating the sync peer out and resyncing
+func (s *syncer) startStallWatchdog() {
+	ticker := time.NewTicker(stallCheckInterval(s.stallTimeout))
+	defer ticker.Stop()
+
+	watchdog := newStallWatchdog(s.stallTimeout, s.blockchain.Header().Number, time.Now())
+
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case now := <-ticker.C:
+			head := s.blockchain.Header().Number
+			bestPeer := s.peerMap.BestPeer(nil)
+
+			stalled := watchdog.check(head, bestPeer, now)
+
+			metrics.SetGauge([]string{syncerMetrics, "head_stalled"}, boolToFloat(watchdog.stalled))
+
+			if stalled {
+				s.healStall(head, bestPeer)
+			}
+		}
+	}
+}
+
+// healStall disconnects the sync peer the node failed to sync from, if there are other peers,
+// refreshes the peer statuses and resyncs with the best of them
+func (s *syncer) healStall(head uint64, bestPeer *NoForkPeer) {
+	metrics.IncrCounter([]string{syncerMetrics, "head_stalls"}, 1)
+
+	s.logger.Warn("chain head stalled, rotating the sync peer and resyncing",
+		"head", head, "best peer", bestPeer.ID, "best peer height", bestPeer.Number, "timeout", s.stallTimeout)
+
+	s.peerMap.Put(s.syncPeerClient.GetConnectedPeerStatuses()...)
+
+	stalledPeer := bestPeer.ID
+	if syncPeer, ok := s.lastSyncPeer.Load().(peer.ID); ok && syncPeer != "" {
+		stalledPeer = syncPeer
+	}
+
+	if s.peerMap.BestPeer(map[peer.ID]bool{stalledPeer: true}) != nil {
+		metrics.IncrCounter([]string{syncerMetrics, "stall_peer_rotations"}, 1)
+
+		s.peerMap.Remove(stalledPeer)
+		s.syncPeerClient.DisconnectPeer(stalledPeer, stallReason)
+	}
+
+	// the peers skipped by the sync are retried
+	s.resetSkipList.Store(true)
+	s.notifyNewStatusEvent()
+}
+
+func boolToFloat(b bool) float32 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
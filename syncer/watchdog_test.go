@@ -0,0 +1,75 @@
+package syncer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStallWatchdog_Check(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1000, 0)
+	ahead := &NoForkPeer{ID: peer.ID("A"), Number: 20, Distance: big.NewInt(1)}
+
+	watchdog := newStallWatchdog(time.Minute, 10, start)
+
+	// the head doesn't advance, but the timeout isn't reached yet
+	assert.False(t, watchdog.check(10, ahead, start.Add(30*time.Second)))
+
+	// the head doesn't advance for the timeout while the peer is ahead
+	assert.True(t, watchdog.check(10, ahead, start.Add(time.Minute)))
+	assert.True(t, watchdog.stalled)
+
+	// the stall is reported again once the next timeout passes
+	assert.False(t, watchdog.check(10, ahead, start.Add(90*time.Second)))
+	assert.True(t, watchdog.check(10, ahead, start.Add(2*time.Minute)))
+
+	// the advancing head ends the stall
+	assert.False(t, watchdog.check(11, ahead, start.Add(3*time.Minute)))
+	assert.False(t, watchdog.stalled)
+
+	// the head not advancing isn't the stall if no peer is ahead
+	assert.False(t, watchdog.check(11, nil, start.Add(5*time.Minute)))
+	assert.False(t, watchdog.check(11, &NoForkPeer{ID: peer.ID("A"), Number: 11}, start.Add(7*time.Minute)))
+}
+
+func TestSyncer_HealStall(t *testing.T) {
+	t.Parallel()
+
+	statuses := []*NoForkPeer{
+		{ID: peer.ID("A"), Number: 20, Distance: big.NewInt(1)},
+		{ID: peer.ID("B"), Number: 30, Distance: big.NewInt(2)},
+	}
+
+	client := &mockSyncPeerClient{
+		getConnectedPeerStatusesHandler: func() []*NoForkPeer { return statuses },
+	}
+
+	syncer := NewTestSyncer(
+		nil,
+		&mockBlockchain{headerHandler: newSimpleHeaderHandler(10)},
+		time.Second,
+		client,
+		&mockProgression{},
+	)
+
+	syncer.lastSyncPeer.Store(peer.ID("B"))
+	syncer.healStall(10, statuses[1])
+
+	// the peer the node failed to sync from is rotated out
+	assert.Equal(t, []peer.ID{"B"}, client.disconnectedPeers)
+	assert.Equal(t, peer.ID("A"), syncer.peerMap.BestPeer(nil).ID)
+	assert.True(t, syncer.resetSkipList.Load())
+
+	// the only peer isn't disconnected
+	syncer.lastSyncPeer.Store(peer.ID("A"))
+	statuses = statuses[:1]
+
+	syncer.healStall(10, statuses[0])
+
+	assert.Equal(t, []peer.ID{"B"}, client.disconnectedPeers)
+}
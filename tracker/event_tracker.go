@@ -8,7 +8,6 @@ import (
 	hcf "github.com/hashicorp/go-hclog"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/blocktracker"
-	"github.com/umbracle/ethgo/jsonrpc"
 	"github.com/umbracle/ethgo/tracker"
 )
 
@@ -18,9 +17,15 @@ type eventSubscription interface {
 	AddLog(log *ethgo.Log) error
 }
 
+// blockTimeSubscription is implemented by the subscribers which need the time of the block
+// the log was emitted in. The time is zero if the tracker didn't see the block as its head
+type blockTimeSubscription interface {
+	AddLogWithBlockTime(log *ethgo.Log, blockTime time.Time) error
+}
+
 type EventTracker struct {
 	dbPath                string
-	rpcEndpoint           string
+	rpcEndpoints          []string // JSON-RPC endpoints of the tracked chain, the first one is preferred
 	contractAddr          ethgo.Address
 	startBlock            uint64
 	subscriber            eventSubscription
@@ -30,7 +35,7 @@ type EventTracker struct {
 
 func NewEventTracker(
 	dbPath string,
-	rpcEndpoint string,
+	rpcEndpoints []string,
 	contractAddr ethgo.Address,
 	subscriber eventSubscription,
 	numBlockConfirmations uint64,
@@ -39,7 +44,7 @@ func NewEventTracker(
 ) *EventTracker {
 	return &EventTracker{
 		dbPath:                dbPath,
-		rpcEndpoint:           rpcEndpoint,
+		rpcEndpoints:          rpcEndpoints,
 		contractAddr:          contractAddr,
 		subscriber:            subscriber,
 		numBlockConfirmations: numBlockConfirmations,
@@ -51,26 +56,30 @@ func NewEventTracker(
 func (e *EventTracker) Start(ctx context.Context) error {
 	e.logger.Info("Start tracking events",
 		"contract", e.contractAddr,
-		"JSON RPC address", e.rpcEndpoint,
+		"JSON RPC addresses", e.rpcEndpoints,
 		"num block confirmations", e.numBlockConfirmations,
 		"start block", e.startBlock)
 
-	provider, err := jsonrpc.NewClient(e.rpcEndpoint)
+	provider, err := NewFailoverProvider(e.rpcEndpoints, e.logger)
 	if err != nil {
 		return err
 	}
 
 	store, err := NewEventTrackerStore(e.dbPath, e.numBlockConfirmations, e.subscriber, e.logger)
 	if err != nil {
+		provider.close()
+
 		return err
 	}
 
+	go provider.Start(ctx)
+
 	blockMaxBacklog := e.numBlockConfirmations * 2
 	if blockMaxBacklog < minBlockMaxBacklog {
 		blockMaxBacklog = minBlockMaxBacklog
 	}
 
-	blockTracker := blocktracker.NewBlockTracker(provider.Eth(), blocktracker.WithBlockMaxBacklog(blockMaxBacklog))
+	blockTracker := blocktracker.NewBlockTracker(provider, blocktracker.WithBlockMaxBacklog(blockMaxBacklog))
 
 	go func() {
 		<-ctx.Done()
@@ -105,7 +114,7 @@ func (e *EventTracker) Start(ctx context.Context) error {
 		return nil
 	})
 
-	tt, err := tracker.NewTracker(provider.Eth(),
+	tt, err := tracker.NewTracker(provider,
 		tracker.WithBatchSize(10),
 		tracker.WithBlockTracker(blockTracker),
 		tracker.WithStore(store),
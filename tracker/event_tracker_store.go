@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	hcf "github.com/hashicorp/go-hclog"
@@ -31,6 +33,11 @@ type EventTrackerStore struct {
 	numBlockConfirmations uint64
 	subscriber            eventSubscription
 	logger                hcf.Logger
+
+	// blockTimes holds the timestamps of the head blocks which are not finalized yet,
+	// so the subscriber gets the time of the block the finalized log was emitted in
+	blockTimesLock sync.Mutex
+	blockTimes     map[uint64]uint64
 }
 
 // NewEventTrackerStore creates a new EventTrackerStore
@@ -49,6 +56,7 @@ func NewEventTrackerStore(
 		numBlockConfirmations: numBlockConfirmations,
 		subscriber:            subscriber,
 		logger:                logger,
+		blockTimes:            make(map[uint64]uint64),
 	}
 
 	if err := store.setupDB(); err != nil {
@@ -144,6 +152,8 @@ func (b *EventTrackerStore) onNewBlock(filterHash, blockData string) error {
 		return err
 	}
 
+	b.setBlockTime(block.Number, block.Timestamp)
+
 	if block.Number <= b.numBlockConfirmations {
 		return nil // there is nothing to process yet
 	}
@@ -159,16 +169,20 @@ func (b *EventTrackerStore) onNewBlock(filterHash, blockData string) error {
 	}
 
 	if len(logs) == 0 {
+		b.pruneBlockTimes(block.Number - b.numBlockConfirmations)
+
 		return nil // nothing to process
 	}
 
 	// notify subscriber with logs
 	for _, log := range logs {
-		if err := b.subscriber.AddLog(log); err != nil {
+		if err := b.notifyLog(log); err != nil {
 			return err
 		}
 	}
 
+	b.pruneBlockTimes(block.Number - b.numBlockConfirmations)
+
 	// save next to process only if every AddLog finished successfully
 	nextToProcessIdx := common.EncodeBytesToUint64(lastProcessedKey) + 1
 	if err := entry.saveNextToProcessIndx(nextToProcessIdx); err != nil {
@@ -180,6 +194,45 @@ func (b *EventTrackerStore) onNewBlock(filterHash, blockData string) error {
 	return nil
 }
 
+// notifyLog passes the log to the subscriber, along with the time of its block if the subscriber needs it
+func (b *EventTrackerStore) notifyLog(log *ethgo.Log) error {
+	subscriber, ok := b.subscriber.(blockTimeSubscription)
+	if !ok {
+		return b.subscriber.AddLog(log)
+	}
+
+	var blockTime time.Time
+
+	b.blockTimesLock.Lock()
+	if timestamp, ok := b.blockTimes[log.BlockNumber]; ok {
+		blockTime = time.Unix(int64(timestamp), 0).UTC()
+	}
+	b.blockTimesLock.Unlock()
+
+	return subscriber.AddLogWithBlockTime(log, blockTime)
+}
+
+// setBlockTime saves the timestamp of the head block
+func (b *EventTrackerStore) setBlockTime(number, timestamp uint64) {
+	b.blockTimesLock.Lock()
+	defer b.blockTimesLock.Unlock()
+
+	b.blockTimes[number] = timestamp
+}
+
+// pruneBlockTimes removes the timestamps of the blocks up to the given finalized block,
+// as their logs are already notified
+func (b *EventTrackerStore) pruneBlockTimes(finalized uint64) {
+	b.blockTimesLock.Lock()
+	defer b.blockTimesLock.Unlock()
+
+	for number := range b.blockTimes {
+		if number <= finalized {
+			delete(b.blockTimes, number)
+		}
+	}
+}
+
 // GetEntry implements the store interface
 func (b *EventTrackerStore) GetEntry(hash string) (store.Entry, error) {
 	return b.getImplEntry(hash)
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/hashicorp/go-hclog"
@@ -180,3 +181,45 @@ func TestEventTrackerStore_SetLastBlockSubscriberNotified(t *testing.T) {
 		require.NoError(t, entry.(*Entry).saveNextToProcessIndx(0)) //nolint
 	}
 }
+
+type mockBlockTimeSubscriber struct {
+	mockEventSubscriber
+
+	blockTimes []time.Time
+}
+
+func (m *mockBlockTimeSubscriber) AddLogWithBlockTime(log *ethgo.Log, blockTime time.Time) error {
+	m.blockTimes = append(m.blockTimes, blockTime)
+
+	return m.AddLog(log)
+}
+
+func TestEventTrackerStore_BlockTimeSubscriberNotified(t *testing.T) {
+	t.Parallel()
+
+	const hash = "dummy_hash"
+
+	subs := &mockBlockTimeSubscriber{}
+
+	tstore, closeFn := createSetupDB(subs, 1)(t)
+	defer closeFn()
+
+	entry, err := tstore.GetEntry(hash)
+	require.NoError(t, err)
+
+	require.NoError(t, entry.StoreLogs([]*ethgo.Log{{BlockNumber: 1}, {BlockNumber: 2}}))
+
+	// the block 1 is not seen as the head, so its time is unknown
+	for _, number := range []uint64{2, 3} {
+		bytes, err := (&ethgo.Block{Number: number, Timestamp: 100 + number}).MarshalJSON()
+		require.NoError(t, err)
+
+		require.NoError(t, tstore.Set(dbLastBlockPrefix+hash, hex.EncodeToString(bytes)))
+	}
+
+	require.Len(t, subs.logs, 2)
+	require.Equal(t, []time.Time{{}, time.Unix(102, 0).UTC()}, subs.blockTimes)
+
+	// the times of the finalized blocks are pruned
+	require.Len(t, tstore.(*EventTrackerStore).blockTimes, 1) //nolint:forcetypeassert
+}
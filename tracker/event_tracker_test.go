@@ -8,11 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/helper/tests/rootchain"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
-	"github.com/umbracle/ethgo/testutil"
 )
 
 type mockEventSubscriber struct {
@@ -48,40 +49,47 @@ func TestEventTracker_TrackSyncEvents(t *testing.T) {
 		eventsPerStep         = 8
 	)
 
-	server := testutil.DeployTestServer(t, nil)
+	// the blocks are mined only with the transactions, so the number of confirmations is under control
+	config := rootchain.DefaultConfig()
+	config.BlockTime = 0
+
+	server := rootchain.NewTestRootchain(t, config)
 
 	tmpDir, err := os.MkdirTemp("/tmp", "test-event-tracker")
 	defer os.RemoveAll(tmpDir)
 	require.NoError(t, err)
 
-	cc := &testutil.Contract{}
-	cc.AddCallback(func() string {
-		return `
-			event StateSync(uint256 indexed id, address indexed target, bytes data);
+	receipt, err := server.SendTxn(&ethgo.Transaction{Input: contractsapi.StateSender.Bytecode})
+	require.NoError(t, err)
 
-			function emitEvent() public payable {
-				emit StateSync(1, msg.sender, bytes(""));
-			}
-			`
-	})
+	addr := receipt.ContractAddress
 
-	_, addr, err := server.DeployContract(cc)
+	input, err := (&contractsapi.SyncStateStateSenderFn{
+		Receiver: types.BytesToAddress(server.Account(0).Bytes()),
+		Data:     []byte{},
+	}).EncodeAbi()
 	require.NoError(t, err)
 
-	// prefill with eventsPerStep + numBlockConfirmations events
-	for i := 0; i < eventsPerStep+numBlockConfirmations; i++ {
-		receipt, err := server.TxnTo(addr, "emitEvent")
+	emitEvent := func() {
+		t.Helper()
+
+		receipt, err := server.SendTxn(&ethgo.Transaction{To: &addr, Input: input})
 		require.NoError(t, err)
 		require.Equal(t, uint64(types.ReceiptSuccess), receipt.Status)
 	}
 
+	// prefill with eventsPerStep + numBlockConfirmations events
+	for i := 0; i < eventsPerStep+numBlockConfirmations; i++ {
+		emitEvent()
+	}
+
 	sub := &mockEventSubscriber{}
 
 	tracker := &EventTracker{
 		logger:                hclog.NewNullLogger(),
 		subscriber:            sub,
 		dbPath:                path.Join(tmpDir, "test.db"),
-		rpcEndpoint:           server.HTTPAddr(),
+		rpcEndpoints:          []string{server.HTTPAddr()},
 		contractAddr:          addr,
 		numBlockConfirmations: numBlockConfirmations,
 	}
@@ -93,9 +101,7 @@ func TestEventTracker_TrackSyncEvents(t *testing.T) {
 	require.Equal(t, eventsPerStep, sub.len())
 	// send eventsPerStep more events
 	for i := 0; i < eventsPerStep; i++ {
-		receipt, err := server.TxnTo(addr, "emitEvent")
-		require.NoError(t, err)
-		require.Equal(t, uint64(types.ReceiptSuccess), receipt.Status)
+		emitEvent()
 	}
 
 	time.Sleep(2 * time.Second)
@@ -0,0 +1,279 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	hcf "github.com/hashicorp/go-hclog"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/ethgo/tracker"
+)
+
+const (
+	// healthCheckInterval is the interval between two health checks of the JSON-RPC endpoints
+	healthCheckInterval = 10 * time.Second
+	// maxEndpointBlockLag is the number of blocks the endpoint can be behind the most advanced endpoint,
+	// before it is considered unhealthy
+	maxEndpointBlockLag = 16
+)
+
+var errNoEndpoints = errors.New("at least one JSON-RPC endpoint must be provided")
+
+// providerEndpoint is a single JSON-RPC endpoint of the failover provider
+type providerEndpoint struct {
+	url     string
+	client  tracker.Provider
+	closer  func() error
+	healthy bool
+}
+
+// FailoverProvider is a tracker provider over multiple JSON-RPC endpoints of the same chain.
+// Requests are sent to the active endpoint, and in case of an error they are retried on the other endpoints,
+// so the first endpoint which serves the request becomes the active one.
+// Endpoints are health checked periodically, and the unhealthy endpoints
+// (unreachable, or lagging behind the others) are tried only if none of the endpoints is healthy
+type FailoverProvider struct {
+	lock      sync.RWMutex
+	endpoints []*providerEndpoint
+	active    int
+	logger    hcf.Logger
+}
+
+var _ tracker.Provider = (*FailoverProvider)(nil)
+
+// NewFailoverProvider creates a failover provider over the given JSON-RPC endpoints,
+// where the first endpoint is the active one
+func NewFailoverProvider(urls []string, logger hcf.Logger) (*FailoverProvider, error) {
+	if len(urls) == 0 {
+		return nil, errNoEndpoints
+	}
+
+	endpoints := make([]*providerEndpoint, len(urls))
+
+	for i, url := range urls {
+		client, err := jsonrpc.NewClient(url)
+		if err != nil {
+			for _, e := range endpoints[:i] {
+				_ = e.closer()
+			}
+
+			return nil, err
+		}
+
+		endpoints[i] = &providerEndpoint{url: url, client: client.Eth(), closer: client.Close, healthy: true}
+	}
+
+	return newFailoverProvider(endpoints, logger), nil
+}
+
+func newFailoverProvider(endpoints []*providerEndpoint, logger hcf.Logger) *FailoverProvider {
+	return &FailoverProvider{
+		endpoints: endpoints,
+		logger:    logger.Named("failover_provider"),
+	}
+}
+
+// Start runs the health checks of the endpoints until the context is done, and closes the endpoints afterwards
+func (f *FailoverProvider) Start(ctx context.Context) {
+	if len(f.endpoints) == 1 {
+		// nothing to fail over to
+		<-ctx.Done()
+		f.close()
+
+		return
+	}
+
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			f.close()
+
+			return
+		case <-ticker.C:
+			f.checkHealth()
+		}
+	}
+}
+
+// Endpoint returns the url of the active endpoint
+func (f *FailoverProvider) Endpoint() string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.endpoints[f.active].url
+}
+
+// checkHealth queries the latest block of each endpoint, and marks the endpoint as unhealthy if it fails,
+// or if it is more than maxEndpointBlockLag blocks behind the most advanced endpoint.
+// If the active endpoint is unhealthy, the first healthy one becomes active
+func (f *FailoverProvider) checkHealth() {
+	heights := make([]uint64, len(f.endpoints))
+	errs := make([]error, len(f.endpoints))
+	maxHeight := uint64(0)
+
+	for i, e := range f.endpoints {
+		heights[i], errs[i] = e.client.BlockNumber()
+		if errs[i] == nil && heights[i] > maxHeight {
+			maxHeight = heights[i]
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for i, e := range f.endpoints {
+		healthy := errs[i] == nil && heights[i]+maxEndpointBlockLag >= maxHeight
+
+		if healthy != e.healthy {
+			if healthy {
+				f.logger.Info("endpoint is healthy again", "url", e.url, "block", heights[i])
+			} else {
+				f.logger.Warn("endpoint is unhealthy", "url", e.url,
+					"block", heights[i], "max block", maxHeight, "error", errs[i])
+			}
+		}
+
+		e.healthy = healthy
+	}
+
+	if !f.endpoints[f.active].healthy {
+		for i, e := range f.endpoints {
+			if e.healthy {
+				f.setActiveLocked(i)
+
+				break
+			}
+		}
+	}
+}
+
+// call executes the request on the active endpoint, and fails over to the other endpoints on error.
+// Healthy endpoints are tried first, and the last error is returned if none of the endpoints served the request
+func (f *FailoverProvider) call(request func(tracker.Provider) error) error {
+	f.lock.RLock()
+	order := make([]int, 0, len(f.endpoints))
+	unhealthy := make([]int, 0)
+
+	for i := 0; i < len(f.endpoints); i++ {
+		idx := (f.active + i) % len(f.endpoints)
+		if f.endpoints[idx].healthy {
+			order = append(order, idx)
+		} else {
+			unhealthy = append(unhealthy, idx)
+		}
+	}
+	f.lock.RUnlock()
+
+	var err error
+
+	for _, idx := range append(order, unhealthy...) {
+		e := f.endpoints[idx]
+
+		if err = request(e.client); err == nil {
+			f.updateEndpoint(idx, true)
+
+			return nil
+		}
+
+		f.logger.Debug("request failed", "url", e.url, "error", err)
+		f.updateEndpoint(idx, false)
+	}
+
+	return err
+}
+
+// updateEndpoint marks the endpoint as healthy or unhealthy depending on whether it served the request,
+// and the endpoint which served the request becomes the active one
+func (f *FailoverProvider) updateEndpoint(idx int, served bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.endpoints[idx].healthy = served
+
+	if served {
+		f.setActiveLocked(idx)
+	}
+}
+
+func (f *FailoverProvider) setActiveLocked(idx int) {
+	if f.active == idx {
+		return
+	}
+
+	f.logger.Warn("switching JSON-RPC endpoint", "from", f.endpoints[f.active].url, "to", f.endpoints[idx].url)
+	f.active = idx
+}
+
+func (f *FailoverProvider) close() {
+	for _, e := range f.endpoints {
+		if e.closer == nil {
+			continue
+		}
+
+		if err := e.closer(); err != nil {
+			f.logger.Debug("failed to close endpoint", "url", e.url, "error", err)
+		}
+	}
+}
+
+// BlockNumber implements tracker.Provider
+func (f *FailoverProvider) BlockNumber() (number uint64, err error) {
+	err = f.call(func(p tracker.Provider) error {
+		number, err = p.BlockNumber()
+
+		return err
+	})
+
+	return number, err
+}
+
+// GetBlockByHash implements tracker.Provider
+func (f *FailoverProvider) GetBlockByHash(hash ethgo.Hash, full bool) (block *ethgo.Block, err error) {
+	err = f.call(func(p tracker.Provider) error {
+		block, err = p.GetBlockByHash(hash, full)
+
+		return err
+	})
+
+	return block, err
+}
+
+// GetBlockByNumber implements tracker.Provider
+func (f *FailoverProvider) GetBlockByNumber(i ethgo.BlockNumber, full bool) (block *ethgo.Block, err error) {
+	err = f.call(func(p tracker.Provider) error {
+		block, err = p.GetBlockByNumber(i, full)
+
+		return err
+	})
+
+	return block, err
+}
+
+// GetLogs implements tracker.Provider
+func (f *FailoverProvider) GetLogs(filter *ethgo.LogFilter) (logs []*ethgo.Log, err error) {
+	err = f.call(func(p tracker.Provider) error {
+		logs, err = p.GetLogs(filter)
+
+		return err
+	})
+
+	return logs, err
+}
+
+// ChainID implements tracker.Provider
+func (f *FailoverProvider) ChainID() (chainID *big.Int, err error) {
+	err = f.call(func(p tracker.Provider) error {
+		chainID, err = p.ChainID()
+
+		return err
+	})
+
+	return chainID, err
+}
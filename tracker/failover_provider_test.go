@@ -0,0 +1,132 @@
+package tracker
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+)
+
+var errEndpointDown = errors.New("endpoint is down")
+
+type mockProvider struct {
+	blockNumber uint64
+	down        bool
+	calls       int
+}
+
+func (m *mockProvider) BlockNumber() (uint64, error) {
+	m.calls++
+
+	if m.down {
+		return 0, errEndpointDown
+	}
+
+	return m.blockNumber, nil
+}
+
+func (m *mockProvider) GetBlockByHash(hash ethgo.Hash, full bool) (*ethgo.Block, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockProvider) GetBlockByNumber(i ethgo.BlockNumber, full bool) (*ethgo.Block, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockProvider) GetLogs(filter *ethgo.LogFilter) ([]*ethgo.Log, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockProvider) ChainID() (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func newTestFailoverProvider(providers ...*mockProvider) *FailoverProvider {
+	endpoints := make([]*providerEndpoint, len(providers))
+	for i, p := range providers {
+		endpoints[i] = &providerEndpoint{url: string(rune('a' + i)), client: p, healthy: true}
+	}
+
+	return newFailoverProvider(endpoints, hclog.NewNullLogger())
+}
+
+func TestFailoverProvider_NoEndpoints(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFailoverProvider(nil, hclog.NewNullLogger())
+	require.ErrorIs(t, err, errNoEndpoints)
+}
+
+func TestFailoverProvider_Failover(t *testing.T) {
+	t.Parallel()
+
+	first := &mockProvider{blockNumber: 10}
+	second := &mockProvider{blockNumber: 11}
+	provider := newTestFailoverProvider(first, second)
+
+	number, err := provider.BlockNumber()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), number)
+	require.Equal(t, "a", provider.Endpoint())
+
+	// the active endpoint fails, so the request is served by the next one, which becomes active
+	first.down = true
+
+	number, err = provider.BlockNumber()
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), number)
+	require.Equal(t, "b", provider.Endpoint())
+
+	// the first endpoint recovered, but the active one is preferred
+	first.down = false
+	first.calls = 0
+
+	_, err = provider.BlockNumber()
+	require.NoError(t, err)
+	require.Equal(t, "b", provider.Endpoint())
+	require.Equal(t, 0, first.calls)
+
+	// all the endpoints fail
+	first.down = true
+	second.down = true
+
+	_, err = provider.BlockNumber()
+	require.ErrorIs(t, err, errEndpointDown)
+}
+
+func TestFailoverProvider_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	first := &mockProvider{blockNumber: 100}
+	second := &mockProvider{blockNumber: 100 + maxEndpointBlockLag + 2}
+	third := &mockProvider{blockNumber: 100 + maxEndpointBlockLag + 1}
+	provider := newTestFailoverProvider(first, second, third)
+
+	// the first endpoint lags behind, so the first healthy one becomes active
+	provider.checkHealth()
+	require.False(t, provider.endpoints[0].healthy)
+	require.True(t, provider.endpoints[1].healthy)
+	require.True(t, provider.endpoints[2].healthy)
+	require.Equal(t, "b", provider.Endpoint())
+
+	// the active endpoint is unreachable
+	second.down = true
+
+	provider.checkHealth()
+	require.False(t, provider.endpoints[0].healthy)
+	require.False(t, provider.endpoints[1].healthy)
+	require.Equal(t, "c", provider.Endpoint())
+
+	// the unhealthy endpoints are tried last
+	third.down = true
+	first.calls = 0
+
+	number, err := provider.BlockNumber()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), number)
+	require.Equal(t, "a", provider.Endpoint())
+	require.Equal(t, 1, first.calls)
+}
@@ -13,7 +13,7 @@ type accountsMap struct {
 	sync.Map
 
 	count            uint64
-	maxEnqueuedLimit uint64
+	maxEnqueuedLimit uint64 // accessed with atomics
 }
 
 // Initializes an account for the given address.
@@ -22,7 +22,7 @@ func (m *accountsMap) initOnce(addr types.Address, nonce uint64) *account {
 		enqueued:    newAccountQueue(),
 		promoted:    newAccountQueue(),
 		nonceToTx:   newNonceToTxLookup(),
-		maxEnqueued: m.maxEnqueuedLimit,
+		maxEnqueued: atomic.LoadUint64(&m.maxEnqueuedLimit),
 		nextNonce:   nonce,
 	})
 	newAccount := a.(*account) //nolint:forcetypeassert
@@ -35,6 +35,24 @@ func (m *accountsMap) initOnce(addr types.Address, nonce uint64) *account {
 	return newAccount
 }
 
+// setMaxEnqueued sets the max number of enqueued transactions of both the new and the existing accounts.
+func (m *accountsMap) setMaxEnqueued(limit uint64) {
+	atomic.StoreUint64(&m.maxEnqueuedLimit, limit)
+
+	m.Range(func(_, value interface{}) bool {
+		account, ok := value.(*account)
+		if !ok {
+			return false
+		}
+
+		account.enqueued.lock(true)
+		account.maxEnqueued = limit
+		account.enqueued.unlock()
+
+		return true
+	})
+}
+
 // exists checks if an account exists within the map.
 func (m *accountsMap) exists(addr types.Address) bool {
 	_, ok := m.Load(addr)
@@ -194,7 +212,7 @@ type account struct {
 	// the number of consecutive blocks that don't contain account's transaction
 	skips uint64
 
-	//	maximum number of enqueued transactions
+	//	maximum number of enqueued transactions, guarded by the enqueued lock
 	maxEnqueued uint64
 }
 
@@ -0,0 +1,125 @@
+package txpool
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+// admissionWindow is the period over which the ingress rate is measured
+const admissionWindow = time.Second
+
+// DefaultAdmissionMinProbability is the default minimum admission probability of a sender
+// while the admission sampling is active
+const DefaultAdmissionMinProbability = 0.1
+
+// admissionSampler decides whether the incoming transaction is admitted to the pool.
+// While the ingress rate is below the rate limit every transaction is admitted.
+// Once the limit is exceeded, the transactions are sampled: each distinct sender is given
+// an equal share of the rate limit, so the admission probability of a sender is its share
+// divided by the number of its transactions. The probability is never lower than minProbability,
+// which keeps the pool representative of the demand instead of being filled by the fastest flooders.
+type admissionSampler struct {
+	lock sync.Mutex
+
+	// rateLimit is the number of transactions per admission window above which the sampling starts
+	rateLimit uint64
+
+	// minProbability is the guaranteed admission probability of each sender
+	minProbability float64
+
+	// number of transactions received per sender in the current and the previous window
+	windowStart   time.Time
+	current       map[types.Address]uint64
+	currentTotal  uint64
+	previous      map[types.Address]uint64
+	previousTotal uint64
+
+	now    func() time.Time
+	random func() float64
+}
+
+// newAdmissionSampler creates the sampler, nil if the rate limit is not set
+func newAdmissionSampler(rateLimit uint64, minProbability float64) *admissionSampler {
+	if rateLimit == 0 {
+		return nil
+	}
+
+	//nolint:gosec
+	rnd := rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+	return &admissionSampler{
+		rateLimit:      rateLimit,
+		minProbability: minProbability,
+		current:        make(map[types.Address]uint64),
+		previous:       make(map[types.Address]uint64),
+		now:            time.Now,
+		random:         rnd.Float64,
+	}
+}
+
+// admit records the transaction of the sender and returns true if it is admitted to the pool. [thread-safe]
+func (s *admissionSampler) admit(sender types.Address) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.rotate()
+
+	s.current[sender]++
+	s.currentTotal++
+
+	// the sampling is active while the ingress rate of either window exceeds the limit,
+	// so it doesn't switch off at the start of every window
+	if s.currentTotal <= s.rateLimit && s.previousTotal <= s.rateLimit {
+		metrics.SetGauge([]string{txPoolMetrics, "admission_sampling"}, 0)
+
+		return true
+	}
+
+	metrics.SetGauge([]string{txPoolMetrics, "admission_sampling"}, 1)
+
+	return s.random() < s.probability(sender)
+}
+
+// probability returns the admission probability of the sender's transaction
+func (s *admissionSampler) probability(sender types.Address) float64 {
+	senders := common.Max(uint64(len(s.current)), uint64(len(s.previous)))
+	received := common.Max(s.current[sender], s.previous[sender])
+
+	share := float64(s.rateLimit) / float64(senders)
+	probability := share / float64(received)
+
+	if probability < s.minProbability {
+		return s.minProbability
+	}
+
+	if probability > 1 {
+		return 1
+	}
+
+	return probability
+}
+
+// rotate starts the new window once the current one has elapsed
+func (s *admissionSampler) rotate() {
+	now := s.now()
+
+	elapsed := now.Sub(s.windowStart)
+	if elapsed < admissionWindow {
+		return
+	}
+
+	if elapsed < 2*admissionWindow {
+		s.previous, s.previousTotal = s.current, s.currentTotal
+	} else {
+		// no transactions were received in the last window
+		s.previous, s.previousTotal = make(map[types.Address]uint64), 0
+	}
+
+	s.current, s.currentTotal = make(map[types.Address]uint64), 0
+	s.windowStart = now
+}
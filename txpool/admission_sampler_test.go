@@ -0,0 +1,99 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAdmissionSampler(rateLimit uint64, minProbability float64) (*admissionSampler, *time.Time) {
+	now := time.Unix(1000, 0)
+
+	s := newAdmissionSampler(rateLimit, minProbability)
+	s.now = func() time.Time { return now }
+	s.random = func() float64 { return 0.5 }
+
+	return s, &now
+}
+
+func TestAdmissionSampler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, newAdmissionSampler(0, DefaultAdmissionMinProbability))
+}
+
+func TestAdmissionSampler_BelowRateLimit(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestAdmissionSampler(10, DefaultAdmissionMinProbability)
+	s.random = func() float64 { return 0.999 }
+
+	for i := 0; i < 10; i++ {
+		require.True(t, s.admit(addr1))
+	}
+
+	require.False(t, s.admit(addr1))
+}
+
+func TestAdmissionSampler_FairShare(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestAdmissionSampler(10, DefaultAdmissionMinProbability)
+
+	// the flooder exceeds the rate limit on its own
+	for i := 0; i < 40; i++ {
+		s.admit(addr1)
+	}
+
+	s.admit(addr2)
+
+	// each sender is given the half of the rate limit
+	require.InDelta(t, 5.0/40, s.probability(addr1), 1e-9)
+	require.Equal(t, 1.0, s.probability(addr2))
+
+	// the light sender is admitted, the flooder is sampled out
+	require.True(t, s.admit(addr2))
+	require.False(t, s.admit(addr1))
+}
+
+func TestAdmissionSampler_MinProbability(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestAdmissionSampler(1, 0.2)
+
+	for i := 0; i < 100; i++ {
+		s.admit(addr1)
+	}
+
+	require.Equal(t, 0.2, s.probability(addr1))
+
+	s.random = func() float64 { return 0.1 }
+	require.True(t, s.admit(addr1))
+}
+
+func TestAdmissionSampler_WindowRotation(t *testing.T) {
+	t.Parallel()
+
+	s, now := newTestAdmissionSampler(2, DefaultAdmissionMinProbability)
+	s.random = func() float64 { return 0.999 }
+
+	for i := 0; i < 5; i++ {
+		s.admit(addr1)
+	}
+
+	// the sampling stays active in the next window, as the previous one exceeded the limit
+	*now = now.Add(admissionWindow)
+
+	require.False(t, s.admit(addr1))
+	require.True(t, s.admit(types.Address{0x3}))
+	require.Equal(t, uint64(5), s.previousTotal)
+
+	// the sampling stops once both windows are below the limit
+	*now = now.Add(2 * admissionWindow)
+
+	require.True(t, s.admit(addr1))
+	require.True(t, s.admit(addr1))
+	require.Equal(t, uint64(0), s.previousTotal)
+}
@@ -0,0 +1,184 @@
+package txpool
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// autoTuneWindow is the number of blocks observed before the limits are adjusted
+	autoTuneWindow = 10
+
+	// block fullness percentages above which the price limit is raised, and below which it is lowered
+	autoTuneHighFullness = 90
+	autoTuneLowFullness  = 50
+
+	// slots usage percentage below which the max slots are lowered
+	autoTuneLowSlotsUsage = 25
+)
+
+var errInvalidAutoTuneBounds = errors.New("auto-tune min bound must not be greater than the max bound")
+
+// AutoTuneConfig are the operator-set bounds within which the pool limits are auto-tuned
+type AutoTuneConfig struct {
+	MinSlots           uint64
+	MaxSlots           uint64
+	MinAccountEnqueued uint64
+	MaxAccountEnqueued uint64
+	MinPriceLimit      uint64
+	MaxPriceLimit      uint64
+}
+
+// Validate checks that the bounds are consistent
+func (c *AutoTuneConfig) Validate() error {
+	if c.MinSlots > c.MaxSlots || c.MinAccountEnqueued > c.MaxAccountEnqueued || c.MinPriceLimit > c.MaxPriceLimit {
+		return errInvalidAutoTuneBounds
+	}
+
+	if c.MaxSlots == 0 || c.MaxAccountEnqueued == 0 {
+		return errors.New("auto-tune max slots and max account enqueued must be greater than 0")
+	}
+
+	return nil
+}
+
+// autoTuner adjusts the pool limits at the end of each window of blocks:
+//   - the max slots grow if the transactions were rejected because the pool was full,
+//     and shrink if the pool is mostly empty
+//   - the max enqueued transactions per account grow if the transactions were rejected
+//     because of the account limit, and shrink otherwise
+//   - the price limit is raised if the blocks are (almost) full, and lowered if they are mostly empty
+type autoTuner struct {
+	config *AutoTuneConfig
+
+	// rejections in the current window, accessed with atomics
+	poolRejections    uint64
+	accountRejections uint64
+
+	lock     sync.Mutex
+	blocks   uint64
+	gasUsed  uint64
+	gasLimit uint64
+}
+
+func newAutoTuner(config *AutoTuneConfig) *autoTuner {
+	if config == nil {
+		return nil
+	}
+
+	return &autoTuner{config: config}
+}
+
+// recordRejection counts the rejection of the incoming transaction caused by the pool limits
+func (a *autoTuner) recordRejection(err error) {
+	switch {
+	case errors.Is(err, ErrTxPoolOverflow), errors.Is(err, ErrRejectFutureTx):
+		atomic.AddUint64(&a.poolRejections, 1)
+	case errors.Is(err, ErrMaxEnqueuedLimitReached):
+		atomic.AddUint64(&a.accountRejections, 1)
+	}
+}
+
+// observe records the new blocks and tunes the pool limits once the window is complete
+func (a *autoTuner) observe(p *TxPool, headers []*types.Header) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	for _, header := range headers {
+		a.blocks++
+		a.gasUsed += header.GasUsed
+		a.gasLimit += header.GasLimit
+
+		if a.blocks < autoTuneWindow {
+			continue
+		}
+
+		a.tune(p)
+
+		a.blocks, a.gasUsed, a.gasLimit = 0, 0, 0
+	}
+}
+
+// tune adjusts the pool limits according to the observations of the completed window
+func (a *autoTuner) tune(p *TxPool) {
+	poolRejections := atomic.SwapUint64(&a.poolRejections, 0)
+	accountRejections := atomic.SwapUint64(&a.accountRejections, 0)
+
+	fullness := uint64(0)
+	if a.gasLimit > 0 {
+		fullness = a.gasUsed * 100 / a.gasLimit
+	}
+
+	maxSlots := p.gauge.limit()
+	if poolRejections > 0 {
+		maxSlots = grow(maxSlots, 4)
+	} else if p.gauge.read() < maxSlots*autoTuneLowSlotsUsage/100 {
+		maxSlots = shrink(maxSlots, 10)
+	}
+
+	maxAccountEnqueued := atomic.LoadUint64(&p.accounts.maxEnqueuedLimit)
+	if accountRejections > 0 {
+		maxAccountEnqueued = grow(maxAccountEnqueued, 4)
+	} else {
+		maxAccountEnqueued = shrink(maxAccountEnqueued, 10)
+	}
+
+	priceLimit := atomic.LoadUint64(&p.priceLimit)
+	if fullness >= autoTuneHighFullness {
+		priceLimit = grow(priceLimit, 8)
+	} else if fullness < autoTuneLowFullness && poolRejections == 0 {
+		priceLimit = shrink(priceLimit, 8)
+	}
+
+	maxSlots = clamp(maxSlots, a.config.MinSlots, a.config.MaxSlots)
+	maxAccountEnqueued = clamp(maxAccountEnqueued, a.config.MinAccountEnqueued, a.config.MaxAccountEnqueued)
+	priceLimit = clamp(priceLimit, a.config.MinPriceLimit, a.config.MaxPriceLimit)
+
+	p.SetMaxSlots(maxSlots)
+	p.SetMaxAccountEnqueued(maxAccountEnqueued)
+	p.SetPriceLimit(priceLimit)
+
+	metrics.SetGauge([]string{txPoolMetrics, "autotune_max_slots"}, float32(maxSlots))
+	metrics.SetGauge([]string{txPoolMetrics, "autotune_max_account_enqueued"}, float32(maxAccountEnqueued))
+	metrics.SetGauge([]string{txPoolMetrics, "autotune_price_limit"}, float32(priceLimit))
+
+	p.logger.Debug("pool limits auto-tuned",
+		"fullness", fullness,
+		"poolRejections", poolRejections,
+		"accountRejections", accountRejections,
+		"maxSlots", maxSlots,
+		"maxAccountEnqueued", maxAccountEnqueued,
+		"priceLimit", priceLimit,
+	)
+}
+
+// grow increases the value by its 1/divisor, and at least by one
+func grow(value, divisor uint64) uint64 {
+	delta := value / divisor
+	if delta == 0 {
+		delta = 1
+	}
+
+	return value + delta
+}
+
+// shrink decreases the value by its 1/divisor
+func shrink(value, divisor uint64) uint64 {
+	return value - value/divisor
+}
+
+func clamp(value, minValue, maxValue uint64) uint64 {
+	if value < minValue {
+		return minValue
+	}
+
+	if value > maxValue {
+		return maxValue
+	}
+
+	return value
+}
@@ -0,0 +1,94 @@
+package txpool
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestAutoTuneConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	config := &AutoTuneConfig{MinSlots: 10, MaxSlots: 100, MinAccountEnqueued: 1, MaxAccountEnqueued: 10}
+	require.NoError(t, config.Validate())
+
+	config.MinPriceLimit = 2
+	require.ErrorIs(t, config.Validate(), errInvalidAutoTuneBounds)
+
+	config.MinPriceLimit, config.MaxSlots = 0, 0
+	require.Error(t, config.Validate())
+}
+
+func TestAutoTuner_Tune(t *testing.T) {
+	t.Parallel()
+
+	newHeaders := func(gasUsed uint64) []*types.Header {
+		headers := make([]*types.Header, autoTuneWindow)
+		for i := range headers {
+			headers[i] = &types.Header{GasUsed: gasUsed, GasLimit: 100}
+		}
+
+		return headers
+	}
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	pool.SetMaxSlots(100)
+	pool.SetMaxAccountEnqueued(10)
+	pool.SetPriceLimit(100)
+	pool.SetAutoTune(&AutoTuneConfig{
+		MinSlots:           50,
+		MaxSlots:           120,
+		MinAccountEnqueued: 9,
+		MaxAccountEnqueued: 12,
+		MinPriceLimit:      90,
+		MaxPriceLimit:      110,
+	})
+
+	tuner := pool.autoTuner.Load()
+
+	// the limits are not changed before the window is complete
+	tuner.recordRejection(ErrTxPoolOverflow)
+	tuner.recordRejection(ErrMaxEnqueuedLimitReached)
+	tuner.observe(pool, newHeaders(100)[1:])
+
+	require.Equal(t, uint64(100), pool.gauge.limit())
+	require.Equal(t, uint64(10), atomic.LoadUint64(&pool.accounts.maxEnqueuedLimit))
+	require.Equal(t, uint64(100), atomic.LoadUint64(&pool.priceLimit))
+
+	// the pool and account limits grow on the rejections, the price limit grows with the full blocks
+	tuner.observe(pool, newHeaders(100)[:1])
+
+	require.Equal(t, uint64(120), pool.gauge.limit())
+	require.Equal(t, uint64(12), atomic.LoadUint64(&pool.accounts.maxEnqueuedLimit))
+	require.Equal(t, uint64(110), atomic.LoadUint64(&pool.priceLimit))
+
+	// the limits are kept within the bounds
+	tuner.recordRejection(ErrRejectFutureTx)
+	tuner.recordRejection(ErrMaxEnqueuedLimitReached)
+	tuner.observe(pool, newHeaders(95))
+
+	require.Equal(t, uint64(120), pool.gauge.limit())
+	require.Equal(t, uint64(12), atomic.LoadUint64(&pool.accounts.maxEnqueuedLimit))
+	require.Equal(t, uint64(110), atomic.LoadUint64(&pool.priceLimit))
+
+	// the limits shrink without the rejections in the empty pool and mostly empty blocks
+	tuner.recordRejection(ErrUnderpriced)
+	tuner.observe(pool, newHeaders(10))
+
+	require.Equal(t, uint64(108), pool.gauge.limit())
+	require.Equal(t, uint64(11), atomic.LoadUint64(&pool.accounts.maxEnqueuedLimit))
+	require.Equal(t, uint64(97), atomic.LoadUint64(&pool.priceLimit))
+
+	// the price limit is kept with the moderately full blocks
+	tuner.observe(pool, newHeaders(70))
+	require.Equal(t, uint64(97), atomic.LoadUint64(&pool.priceLimit))
+
+	// the auto-tuning is disabled
+	pool.SetAutoTune(nil)
+	require.Nil(t, pool.autoTuner.Load())
+}
@@ -0,0 +1,149 @@
+package txpool
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// selectorLength is the length of the function selector in the transaction calldata
+const selectorLength = 4
+
+// Selector is the function selector (first 4 bytes of the calldata)
+type Selector [selectorLength]byte
+
+// String returns the hex encoded selector
+func (s Selector) String() string {
+	return hex.EncodeToHex(s[:])
+}
+
+// ParseSelector parses the hex encoded function selector
+func ParseSelector(raw string) (Selector, error) {
+	var selector Selector
+
+	buf, err := hex.DecodeHex(raw)
+	if err != nil {
+		return selector, fmt.Errorf("invalid selector %s: %w", raw, err)
+	}
+
+	if len(buf) != selectorLength {
+		return selector, fmt.Errorf("invalid selector %s: expected %d bytes, got %d", raw, selectorLength, len(buf))
+	}
+
+	copy(selector[:], buf)
+
+	return selector, nil
+}
+
+// DenyList holds the senders, recipients and function selectors
+// whose transactions are rejected on the txpool admission
+type DenyList struct {
+	Senders    []types.Address
+	Recipients []types.Address
+	Selectors  []Selector
+}
+
+// denyList is a thread safe set of denied senders, recipients and function selectors
+type denyList struct {
+	sync.RWMutex
+
+	senders    map[types.Address]struct{}
+	recipients map[types.Address]struct{}
+	selectors  map[Selector]struct{}
+}
+
+// newDenyList creates a new deny list populated with the given entries
+func newDenyList(list *DenyList) *denyList {
+	d := &denyList{}
+	d.set(list)
+
+	return d
+}
+
+// set replaces the content of the deny list with the given entries. [thread-safe]
+func (d *denyList) set(list *DenyList) {
+	if list == nil {
+		list = &DenyList{}
+	}
+
+	senders := make(map[types.Address]struct{}, len(list.Senders))
+	for _, sender := range list.Senders {
+		senders[sender] = struct{}{}
+	}
+
+	recipients := make(map[types.Address]struct{}, len(list.Recipients))
+	for _, recipient := range list.Recipients {
+		recipients[recipient] = struct{}{}
+	}
+
+	selectors := make(map[Selector]struct{}, len(list.Selectors))
+	for _, selector := range list.Selectors {
+		selectors[selector] = struct{}{}
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.senders = senders
+	d.recipients = recipients
+	d.selectors = selectors
+}
+
+// get returns the content of the deny list. [thread-safe]
+func (d *denyList) get() *DenyList {
+	d.RLock()
+	defer d.RUnlock()
+
+	list := &DenyList{
+		Senders:    make([]types.Address, 0, len(d.senders)),
+		Recipients: make([]types.Address, 0, len(d.recipients)),
+		Selectors:  make([]Selector, 0, len(d.selectors)),
+	}
+
+	for sender := range d.senders {
+		list.Senders = append(list.Senders, sender)
+	}
+
+	for recipient := range d.recipients {
+		list.Recipients = append(list.Recipients, recipient)
+	}
+
+	for selector := range d.selectors {
+		list.Selectors = append(list.Selectors, selector)
+	}
+
+	return list
+}
+
+// check returns an error if the sender, the recipient or
+// the function selector of the given transaction is denied. [thread-safe]
+func (d *denyList) check(tx *types.Transaction) error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if _, ok := d.senders[tx.From]; ok {
+		return ErrDeniedSender
+	}
+
+	if tx.To == nil {
+		return nil
+	}
+
+	if _, ok := d.recipients[*tx.To]; ok {
+		return ErrDeniedRecipient
+	}
+
+	if len(d.selectors) > 0 && len(tx.Input) >= selectorLength {
+		var selector Selector
+
+		copy(selector[:], tx.Input[:selectorLength])
+
+		if _, ok := d.selectors[selector]; ok {
+			return ErrDeniedSelector
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package txpool
+
+import (
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// deployerLists rejects the contract deployments of the senders which aren't permitted
+// by the contract deployer allow list, or are denied by the contract deployer block list.
+// The roles are read from the latest state, as the lists are managed by their admins on-chain.
+// The deployments are still enforced by the state transition, since the roles may change
+// before the transaction is executed
+type deployerLists struct {
+	store store
+
+	allowList bool
+	blockList bool
+}
+
+// check returns the error if the transaction deploys a contract, and its sender isn't permitted to
+func (d *deployerLists) check(tx *types.Transaction) error {
+	if !tx.IsContractCreation() {
+		return nil
+	}
+
+	// the allow list takes precedence over the block list, same as in the state transition
+	if d.allowList {
+		if !addressListRole(d.store, contracts.AllowListContractsAddr, tx.From).Enabled() {
+			return ErrDeployerNotAllowed
+		}
+
+		return nil
+	}
+
+	if d.blockList && addressListRole(d.store, contracts.BlockListContractsAddr, tx.From) == addresslist.EnabledRole {
+		return ErrDeployerBlocked
+	}
+
+	return nil
+}
+
+// addressListRole returns the role of the address in the address list at the latest state,
+// no role if it can't be read
+func addressListRole(store store, list types.Address, addr types.Address) addresslist.Role {
+	value, err := store.GetStorage(store.Header().StateRoot, list, types.BytesToHash(addr.Bytes()))
+	if err != nil {
+		return addresslist.NoRole
+	}
+
+	return addresslist.Role(value)
+}
@@ -0,0 +1,79 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// addressListsMockStore returns the roles of the addresses in the address lists
+type addressListsMockStore struct {
+	defaultMockStore
+
+	roles map[types.Address]map[types.Address]addresslist.Role
+}
+
+func (m addressListsMockStore) GetStorage(_ types.Hash, addr types.Address, slot types.Hash) (types.Hash, error) {
+	return types.Hash(m.roles[addr][types.BytesToAddress(slot.Bytes())]), nil
+}
+
+func TestDeployerLists_Check(t *testing.T) {
+	t.Parallel()
+
+	store := addressListsMockStore{
+		defaultMockStore: defaultMockStore{DefaultHeader: mockHeader},
+		roles: map[types.Address]map[types.Address]addresslist.Role{
+			contracts.AllowListContractsAddr: {
+				addr1: addresslist.AdminRole,
+				addr2: addresslist.EnabledRole,
+			},
+			contracts.BlockListContractsAddr: {
+				addr3: addresslist.EnabledRole,
+				// the admins of the block list aren't blocked
+				addr4: addresslist.AdminRole,
+			},
+		},
+	}
+
+	deployment := func(from types.Address) *types.Transaction {
+		return &types.Transaction{From: from}
+	}
+
+	call := func(from types.Address) *types.Transaction {
+		return &types.Transaction{From: from, To: &addr5}
+	}
+
+	cases := []struct {
+		name      string
+		allowList bool
+		blockList bool
+		tx        *types.Transaction
+		err       error
+	}{
+		{"disabled", false, false, deployment(addr3), nil},
+		{"allow list admin", true, false, deployment(addr1), nil},
+		{"allow list enabled", true, false, deployment(addr2), nil},
+		{"not in allow list", true, false, deployment(addr3), ErrDeployerNotAllowed},
+		{"call not in allow list", true, false, call(addr3), nil},
+		{"not in block list", false, true, deployment(addr1), nil},
+		{"block list admin", false, true, deployment(addr4), nil},
+		{"in block list", false, true, deployment(addr3), ErrDeployerBlocked},
+		{"call in block list", false, true, call(addr3), nil},
+		{"allow list precedence", true, true, deployment(addr2), nil},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			lists := &deployerLists{store: store, allowList: c.allowList, blockList: c.blockList}
+
+			assert.ErrorIs(t, lists.check(c.tx), c.err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package txpool
+
+import "sync/atomic"
+
+// SetPriceLimit sets the lower threshold for the gas price of the incoming transactions. [thread-safe]
+func (p *TxPool) SetPriceLimit(priceLimit uint64) {
+	atomic.StoreUint64(&p.priceLimit, priceLimit)
+}
+
+// SetMaxSlots sets the max number of slots the pool can occupy. [thread-safe]
+// Transactions already in the pool are kept if the new limit is lower than the current height
+func (p *TxPool) SetMaxSlots(maxSlots uint64) {
+	atomic.StoreUint64(&p.gauge.max, maxSlots)
+}
+
+// SetMaxAccountEnqueued sets the max number of enqueued transactions per account. [thread-safe]
+// Enqueued transactions above the new limit are kept until they are promoted or pruned
+func (p *TxPool) SetMaxAccountEnqueued(maxAccountEnqueued uint64) {
+	p.accounts.setMaxEnqueued(maxAccountEnqueued)
+}
+
+// SetAutoTune sets the bounds of the pool limits auto-tuning, nil disables the auto-tuning. [thread-safe]
+// The observations made so far are discarded
+func (p *TxPool) SetAutoTune(config *AutoTuneConfig) {
+	p.autoTuner.Store(newAutoTuner(config))
+}
+
+// SetAdmission sets the admission sampling parameters, zero rate limit disables the sampling. [thread-safe]
+// The ingress rate measured so far is discarded
+func (p *TxPool) SetAdmission(rateLimit uint64, minProbability float64) {
+	p.admission.Store(newAdmissionSampler(rateLimit, minProbability))
+}
@@ -0,0 +1,52 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxPool_SetLimits(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr1 = types.StringToAddress("1")
+		addr2 = types.StringToAddress("2")
+	)
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	// price limit
+	pool.SetPriceLimit(1000000)
+	require.ErrorIs(t, pool.addTx(local, newTx(addr1, 2, 1)), ErrUnderpriced)
+
+	pool.SetPriceLimit(defaultPriceLimit)
+	require.NoError(t, pool.addTx(local, newTx(addr1, 2, 1)))
+
+	// max enqueued of the existing account
+	pool.SetMaxAccountEnqueued(1)
+	require.Equal(t, uint64(1), pool.accounts.get(addr1).maxEnqueued)
+	require.ErrorIs(t, pool.addTx(local, newTx(addr1, 3, 1)), ErrMaxEnqueuedLimitReached)
+
+	pool.SetMaxAccountEnqueued(defaultMaxAccountEnqueued)
+	require.NoError(t, pool.addTx(local, newTx(addr1, 3, 1)))
+
+	// max slots, lower than the current height
+	pool.SetMaxSlots(1)
+	require.Equal(t, uint64(0), pool.gauge.freeSlots())
+	require.ErrorIs(t, pool.addTx(local, newTx(addr2, 0, 1)), ErrTxPoolOverflow)
+
+	pool.SetMaxSlots(defaultMaxSlots)
+	require.Equal(t, defaultMaxSlots-2, pool.gauge.freeSlots())
+
+	// admission sampling
+	pool.SetAdmission(10, 0.5)
+	require.Equal(t, uint64(10), pool.admission.Load().rateLimit)
+	require.Equal(t, 0.5, pool.admission.Load().minProbability)
+
+	pool.SetAdmission(0, 0.5)
+	require.Nil(t, pool.admission.Load())
+}
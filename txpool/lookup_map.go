@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"sync"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -10,6 +11,9 @@ import (
 type lookupMap struct {
 	sync.RWMutex
 	all map[types.Hash]*types.Transaction
+
+	// addedAt holds the arrival time of each transaction
+	addedAt map[types.Hash]time.Time
 }
 
 // add inserts the given transaction into the map. Returns false
@@ -22,7 +26,12 @@ func (m *lookupMap) add(tx *types.Transaction) bool {
 		return false
 	}
 
+	if m.addedAt == nil {
+		m.addedAt = make(map[types.Hash]time.Time)
+	}
+
 	m.all[tx.Hash] = tx
+	m.addedAt[tx.Hash] = time.Now().UTC()
 
 	return true
 }
@@ -34,6 +43,7 @@ func (m *lookupMap) remove(txs ...*types.Transaction) {
 
 	for _, tx := range txs {
 		delete(m.all, tx.Hash)
+		delete(m.addedAt, tx.Hash)
 	}
 }
 
@@ -49,3 +59,13 @@ func (m *lookupMap) get(hash types.Hash) (*types.Transaction, bool) {
 
 	return tx, true
 }
+
+// arrival returns the time the transaction with the given hash was added to the pool. [thread-safe]
+func (m *lookupMap) arrival(hash types.Hash) (time.Time, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	addedAt, ok := m.addedAt[hash]
+
+	return addedAt, ok
+}
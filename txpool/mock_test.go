@@ -31,6 +31,10 @@ func (m defaultMockStore) GetNonce(types.Hash, types.Address) uint64 {
 	return 0
 }
 
+func (m defaultMockStore) GetStorage(types.Hash, types.Address, types.Hash) (types.Hash, error) {
+	return types.ZeroHash, nil
+}
+
 func (m defaultMockStore) GetBlockByHash(types.Hash, bool) (*types.Block, bool) {
 	return nil, false
 }
@@ -52,6 +56,10 @@ func (fms faultyMockStore) GetNonce(root types.Hash, addr types.Address) uint64
 	return 99999
 }
 
+func (fms faultyMockStore) GetStorage(types.Hash, types.Address, types.Hash) (types.Hash, error) {
+	return types.ZeroHash, fmt.Errorf("unable to fetch storage")
+}
+
 func (fms faultyMockStore) GetBlockByHash(hash types.Hash, b bool) (*types.Block, bool) {
 	return nil, false
 }
@@ -0,0 +1,211 @@
+package txpool
+
+import (
+	"sort"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// gappedTxsSweepInterval is the interval of evicting the gapped transactions
+	// which outlived the future transaction lifetime
+	gappedTxsSweepInterval = 30 * time.Second
+)
+
+// NonceGap is a range of missing nonces [From, To] which prevents
+// the promotion of the account's enqueued transactions
+type NonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// NonceGaps describes the enqueued transactions of an account
+// and the nonce gaps preceding them
+type NonceGaps struct {
+	NextNonce uint64     `json:"nextNonce"`
+	Enqueued  []uint64   `json:"enqueued"`
+	Gaps      []NonceGap `json:"gaps"`
+}
+
+// gappedTx is an enqueued transaction which can't be promoted due to a nonce gap
+type gappedTx struct {
+	account *account
+	tx      *types.Transaction
+	addedAt time.Time
+}
+
+// sortedByNonce returns a copy of the given transactions sorted by nonce (ascending)
+func sortedByNonce(txs []*types.Transaction) []*types.Transaction {
+	sorted := make([]*types.Transaction, len(txs))
+	copy(sorted, txs)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Nonce < sorted[j].Nonce
+	})
+
+	return sorted
+}
+
+// gapped returns the enqueued transactions preceded by a nonce gap, sorted by nonce.
+// The enqueued lock is expected to be held
+func (a *account) gapped() []*types.Transaction {
+	expected := a.getNonce()
+	sorted := sortedByNonce(a.enqueued.queue)
+
+	for i, tx := range sorted {
+		if tx.Nonce > expected {
+			return sorted[i:]
+		}
+
+		expected = tx.Nonce + 1
+	}
+
+	return nil
+}
+
+// nonceGaps returns the enqueued nonces of the account and the gaps preceding them.
+// The enqueued lock is expected to be held
+func (a *account) nonceGaps() NonceGaps {
+	gaps := NonceGaps{
+		NextNonce: a.getNonce(),
+		Enqueued:  make([]uint64, 0, a.enqueued.length()),
+		Gaps:      make([]NonceGap, 0),
+	}
+
+	expected := gaps.NextNonce
+
+	for _, tx := range sortedByNonce(a.enqueued.queue) {
+		gaps.Enqueued = append(gaps.Enqueued, tx.Nonce)
+
+		if tx.Nonce > expected {
+			gaps.Gaps = append(gaps.Gaps, NonceGap{From: expected, To: tx.Nonce - 1})
+		}
+
+		if tx.Nonce >= expected {
+			expected = tx.Nonce + 1
+		}
+	}
+
+	return gaps
+}
+
+// GetNonceGaps returns the enqueued nonces of the given account
+// and the nonce gaps preventing their promotion
+func (p *TxPool) GetNonceGaps(addr types.Address) NonceGaps {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return NonceGaps{
+			NextNonce: p.GetNonce(addr),
+			Enqueued:  []uint64{},
+			Gaps:      []NonceGap{},
+		}
+	}
+
+	account.enqueued.lock(false)
+	defer account.enqueued.unlock()
+
+	return account.nonceGaps()
+}
+
+// gappedTxs returns the gapped transactions of all accounts, the oldest first
+func (p *TxPool) gappedTxs() []gappedTx {
+	var gapped []gappedTx
+
+	p.accounts.Range(
+		func(_, value interface{}) bool {
+			account, _ := value.(*account)
+
+			account.enqueued.lock(false)
+			defer account.enqueued.unlock()
+
+			for _, tx := range account.gapped() {
+				addedAt, _ := p.index.arrival(tx.Hash)
+				gapped = append(gapped, gappedTx{account: account, tx: tx, addedAt: addedAt})
+			}
+
+			return true
+		},
+	)
+
+	sort.SliceStable(gapped, func(i, j int) bool {
+		return gapped[i].addedAt.Before(gapped[j].addedAt)
+	})
+
+	return gapped
+}
+
+// pruneGappedTxs evicts the gapped transactions, the oldest first,
+// as long as the given predicate holds. Returns the number of evicted transactions
+func (p *TxPool) pruneGappedTxs(evict func(gappedTx) bool) int {
+	evicted := 0
+
+	for _, candidate := range p.gappedTxs() {
+		if !evict(candidate) {
+			continue
+		}
+
+		if p.evictGappedTx(candidate) {
+			evicted++
+		}
+	}
+
+	if evicted > 0 {
+		metrics.IncrCounter([]string{txPoolMetrics, "pruned_gapped_txs"}, float32(evicted))
+	}
+
+	return evicted
+}
+
+// evictGappedTx removes the given transaction from the pool
+// unless it was promoted or its nonce gap was closed in the meantime
+func (p *TxPool) evictGappedTx(candidate gappedTx) bool {
+	account := candidate.account
+
+	account.enqueued.lock(true)
+	defer account.enqueued.unlock()
+
+	account.nonceToTx.lock()
+	defer account.nonceToTx.unlock()
+
+	stillGapped := false
+
+	for _, tx := range account.gapped() {
+		if tx == candidate.tx {
+			stillGapped = true
+
+			break
+		}
+	}
+
+	if !stillGapped || !account.enqueued.remove(candidate.tx) {
+		return false
+	}
+
+	account.nonceToTx.remove(candidate.tx)
+	p.index.remove(candidate.tx)
+	p.releaseSlots(candidate.tx)
+
+	p.eventManager.signalEvent(proto.EventType_PRUNED_ENQUEUED, candidate.tx.Hash)
+
+	return true
+}
+
+// pruneAccountsWithNonceHoles evicts the oldest gapped transactions
+// until the pool is no longer under high pressure
+func (p *TxPool) pruneAccountsWithNonceHoles() {
+	p.pruneGappedTxs(func(candidate gappedTx) bool {
+		return p.slotGaugeFor(candidate.tx) == &p.gauge && p.gauge.highPressure()
+	})
+}
+
+// pruneExpiredGappedTxs evicts the gapped transactions older than the future transaction lifetime
+func (p *TxPool) pruneExpiredGappedTxs(lifetime time.Duration) {
+	deadline := time.Now().UTC().Add(-lifetime)
+
+	p.pruneGappedTxs(func(candidate gappedTx) bool {
+		return candidate.addedAt.Before(deadline)
+	})
+}
@@ -0,0 +1,149 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newNonceGapsTestPool(t *testing.T) *TxPool {
+	t.Helper()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+
+	return pool
+}
+
+// setArrival overrides the arrival time of the given transaction
+func setArrival(pool *TxPool, tx *types.Transaction, addedAt time.Time) {
+	pool.index.Lock()
+	defer pool.index.Unlock()
+
+	pool.index.addedAt[tx.Hash] = addedAt
+}
+
+func TestGetNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown account", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newNonceGapsTestPool(t)
+
+		require.Equal(t, NonceGaps{
+			NextNonce: 0,
+			Enqueued:  []uint64{},
+			Gaps:      []NonceGap{},
+		}, pool.GetNonceGaps(addr1))
+	})
+
+	t.Run("gapped account", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newNonceGapsTestPool(t)
+
+		for _, nonce := range []uint64{6, 2, 3} {
+			require.NoError(t, pool.addTx(local, newTx(addr1, nonce, 1)))
+		}
+
+		require.Equal(t, NonceGaps{
+			NextNonce: 0,
+			Enqueued:  []uint64{2, 3, 6},
+			Gaps:      []NonceGap{{From: 0, To: 1}, {From: 4, To: 5}},
+		}, pool.GetNonceGaps(addr1))
+	})
+}
+
+func TestAddTx_EvictHighestEnqueued(t *testing.T) {
+	t.Parallel()
+
+	pool := newNonceGapsTestPool(t)
+	pool.accounts.maxEnqueuedLimit = 2
+
+	highest := newTx(addr1, 5, 1)
+
+	require.NoError(t, pool.addTx(local, newTx(addr1, 3, 1)))
+	require.NoError(t, pool.addTx(local, highest))
+
+	// the tx closing the gap replaces the highest nonce tx
+	require.NoError(t, pool.addTx(local, newTx(addr1, 4, 1)))
+
+	_, exists := pool.index.get(highest.Hash)
+	require.False(t, exists)
+	require.Equal(t, uint64(2), pool.gauge.read())
+	require.Equal(t, []uint64{3, 4}, pool.GetNonceGaps(addr1).Enqueued)
+
+	// a tx further from the account nonce is rejected
+	require.ErrorIs(t, pool.addTx(local, newTx(addr1, 6, 1)), ErrMaxEnqueuedLimitReached)
+	require.Equal(t, uint64(2), pool.gauge.read())
+}
+
+func TestPruneGappedTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("oldest evicted until pressure is relieved", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newNonceGapsTestPool(t)
+
+		older, newer := newTx(addr1, 5, 1), newTx(addr2, 5, 1)
+
+		require.NoError(t, pool.addTx(local, older))
+		require.NoError(t, pool.addTx(local, newer))
+
+		setArrival(pool, older, time.Now().UTC().Add(-time.Minute))
+
+		//	mock high pressure
+		pool.gauge.max = 2
+
+		pool.pruneAccountsWithNonceHoles()
+
+		_, exists := pool.index.get(older.Hash)
+		require.False(t, exists)
+
+		_, exists = pool.index.get(newer.Hash)
+		require.True(t, exists)
+
+		require.Equal(t, uint64(1), pool.gauge.read())
+		require.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+		require.Equal(t, uint64(1), pool.accounts.get(addr2).enqueued.length())
+	})
+
+	t.Run("expired gapped txs evicted", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newNonceGapsTestPool(t)
+
+		expected := newTx(addr1, 0, 1)
+		expired, fresh := newTx(addr2, 3, 1), newTx(addr2, 5, 1)
+
+		require.NoError(t, pool.addTx(local, expected))
+		<-pool.promoteReqCh
+
+		require.NoError(t, pool.addTx(local, expired))
+		require.NoError(t, pool.addTx(local, fresh))
+
+		setArrival(pool, expected, time.Now().UTC().Add(-time.Hour))
+		setArrival(pool, expired, time.Now().UTC().Add(-time.Hour))
+
+		pool.pruneExpiredGappedTxs(time.Minute)
+
+		// the tx with the expected nonce is not gapped
+		_, exists := pool.index.get(expected.Hash)
+		require.True(t, exists)
+
+		_, exists = pool.index.get(expired.Hash)
+		require.False(t, exists)
+
+		_, exists = pool.index.get(fresh.Hash)
+		require.True(t, exists)
+
+		require.Equal(t, uint64(2), pool.gauge.read())
+		require.Equal(t, []uint64{5}, pool.GetNonceGaps(addr2).Enqueued)
+	})
+}
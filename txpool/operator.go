@@ -82,3 +82,92 @@ func (p *TxPool) Subscribe(
 		}
 	}
 }
+
+// SubscribePromoted subscribes to the promoted transactions events.
+// The returned function cancels the subscription
+func (p *TxPool) SubscribePromoted() (<-chan *proto.TxPoolEvent, func()) {
+	subscription := p.eventManager.subscribe([]proto.EventType{proto.EventType_PROMOTED})
+
+	return subscription.subscriptionChannel, func() {
+		p.eventManager.cancelSubscription(subscription.subscriptionID)
+	}
+}
+
+// DenyListGet implements the operator endpoint. Returns the denied senders, recipients and function selectors
+func (p *TxPool) DenyListGet(ctx context.Context, req *empty.Empty) (*proto.DenyList, error) {
+	return toProtoDenyList(p.denyList.get()), nil
+}
+
+// DenyListSet implements the operator endpoint.
+// Replaces the denied senders, recipients and function selectors.
+// Changes are applied to the newly received transactions only
+func (p *TxPool) DenyListSet(ctx context.Context, req *proto.DenyList) (*proto.DenyList, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	list, err := fromProtoDenyList(req)
+	if err != nil {
+		return nil, err
+	}
+
+	p.denyList.set(list)
+
+	p.logger.Info("deny list updated",
+		"senders", len(list.Senders),
+		"recipients", len(list.Recipients),
+		"selectors", len(list.Selectors))
+
+	return toProtoDenyList(p.denyList.get()), nil
+}
+
+// toProtoDenyList converts the deny list to its proto representation
+func toProtoDenyList(list *DenyList) *proto.DenyList {
+	resp := &proto.DenyList{
+		Senders:    make([]string, len(list.Senders)),
+		Recipients: make([]string, len(list.Recipients)),
+		Selectors:  make([]string, len(list.Selectors)),
+	}
+
+	for i, sender := range list.Senders {
+		resp.Senders[i] = sender.String()
+	}
+
+	for i, recipient := range list.Recipients {
+		resp.Recipients[i] = recipient.String()
+	}
+
+	for i, selector := range list.Selectors {
+		resp.Selectors[i] = selector.String()
+	}
+
+	return resp
+}
+
+// fromProtoDenyList converts the proto representation of the deny list
+func fromProtoDenyList(req *proto.DenyList) (*DenyList, error) {
+	list := &DenyList{
+		Senders:    make([]types.Address, len(req.Senders)),
+		Recipients: make([]types.Address, len(req.Recipients)),
+		Selectors:  make([]Selector, len(req.Selectors)),
+	}
+
+	for i, sender := range req.Senders {
+		list.Senders[i] = types.StringToAddress(sender)
+	}
+
+	for i, recipient := range req.Recipients {
+		list.Recipients[i] = types.StringToAddress(recipient)
+	}
+
+	for i, raw := range req.Selectors {
+		selector, err := ParseSelector(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		list.Selectors[i] = selector
+	}
+
+	return list, nil
+}
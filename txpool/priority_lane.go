@@ -0,0 +1,83 @@
+package txpool
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// PriorityLaneConfig holds the recipients of the consensus critical transactions
+// (such as the bridge and the staking system contracts) and the capacity reserved for them
+type PriorityLaneConfig struct {
+	// Recipients are the contracts whose transactions are admitted to the priority lane
+	Recipients []types.Address
+
+	// MaxSlots is the max number of slots occupied by the priority lane transactions,
+	// they are accounted separately from the pool capacity
+	MaxSlots uint64
+}
+
+// priorityLane holds the consensus critical transactions, which bypass the price limit
+// and the admission sampling, are not limited by the pool capacity (but by their own quota)
+// and are executed ahead of the other transactions
+type priorityLane struct {
+	recipients map[types.Address]struct{}
+	gauge      slotGauge
+}
+
+// newPriorityLane creates the priority lane, nil if it is not configured
+func newPriorityLane(config *PriorityLaneConfig) *priorityLane {
+	if config == nil || len(config.Recipients) == 0 || config.MaxSlots == 0 {
+		return nil
+	}
+
+	lane := &priorityLane{
+		recipients: make(map[types.Address]struct{}, len(config.Recipients)),
+		gauge:      slotGauge{max: config.MaxSlots, metric: "priority_slots_used"},
+	}
+
+	for _, recipient := range config.Recipients {
+		lane.recipients[recipient] = struct{}{}
+	}
+
+	return lane
+}
+
+// contains checks if the transaction belongs to the priority lane
+func (l *priorityLane) contains(tx *types.Transaction) bool {
+	if l == nil || tx.To == nil {
+		return false
+	}
+
+	_, ok := l.recipients[*tx.To]
+
+	return ok
+}
+
+// slotGaugeFor returns the gauge the slots of the transaction are accounted in
+func (p *TxPool) slotGaugeFor(tx *types.Transaction) *slotGauge {
+	if p.priorityLane.contains(tx) {
+		return &p.priorityLane.gauge
+	}
+
+	return &p.gauge
+}
+
+// releaseSlots releases the slots of the transactions removed from the pool
+func (p *TxPool) releaseSlots(txs ...*types.Transaction) {
+	var regular, priority uint64
+
+	for _, tx := range txs {
+		if p.priorityLane.contains(tx) {
+			priority += slotsRequired(tx)
+		} else {
+			regular += slotsRequired(tx)
+		}
+	}
+
+	if regular > 0 {
+		p.gauge.decrease(regular)
+	}
+
+	if priority > 0 {
+		p.priorityLane.gauge.decrease(priority)
+	}
+}
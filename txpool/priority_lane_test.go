@@ -0,0 +1,134 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPoolWithPriorityLane(t *testing.T, maxSlots uint64, lane *PriorityLaneConfig) *TxPool {
+	t.Helper()
+
+	pool, err := NewTxPool(
+		hclog.NewNullLogger(),
+		forks.At(0),
+		defaultMockStore{DefaultHeader: mockHeader},
+		nil,
+		nil,
+		&Config{
+			PriceLimit:         defaultPriceLimit,
+			MaxSlots:           maxSlots,
+			MaxAccountEnqueued: defaultMaxAccountEnqueued,
+			PriorityLane:       lane,
+		},
+	)
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+
+	return pool
+}
+
+// newPriorityTx returns a new tx sent to the priority lane recipient
+func newPriorityTx(from types.Address, nonce uint64) *types.Transaction {
+	tx := newTx(from, nonce, 1)
+	tx.To = &addr5
+
+	return tx
+}
+
+func TestNewPriorityLane(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, newPriorityLane(nil))
+	require.Nil(t, newPriorityLane(&PriorityLaneConfig{MaxSlots: 1}))
+	require.Nil(t, newPriorityLane(&PriorityLaneConfig{Recipients: []types.Address{addr5}}))
+
+	lane := newPriorityLane(&PriorityLaneConfig{Recipients: []types.Address{addr5}, MaxSlots: 1})
+	require.NotNil(t, lane)
+
+	require.True(t, lane.contains(newPriorityTx(addr1, 0)))
+	require.False(t, lane.contains(newTx(addr1, 0, 1)))
+
+	// the disabled lane contains no transactions
+	require.False(t, (*priorityLane)(nil).contains(newPriorityTx(addr1, 0)))
+}
+
+func TestPriorityLane_Capacity(t *testing.T) {
+	t.Parallel()
+
+	pool := newTestPoolWithPriorityLane(t, 1, &PriorityLaneConfig{
+		Recipients: []types.Address{addr5},
+		MaxSlots:   2,
+	})
+
+	// fill up the pool capacity
+	require.NoError(t, pool.addTx(local, newTx(addr1, 0, 1)))
+	require.ErrorIs(t, pool.addTx(local, newTx(addr2, 0, 1)), ErrTxPoolOverflow)
+
+	// the priority lane transactions are accounted in their own quota
+	require.NoError(t, pool.addTx(local, newPriorityTx(addr2, 0)))
+	require.NoError(t, pool.addTx(local, newPriorityTx(addr3, 0)))
+	require.ErrorIs(t, pool.addTx(local, newPriorityTx(addr4, 0)), ErrTxPoolOverflow)
+
+	require.Equal(t, uint64(1), pool.gauge.read())
+	require.Equal(t, uint64(2), pool.priorityLane.gauge.read())
+}
+
+func TestPriorityLane_PriceLimit(t *testing.T) {
+	t.Parallel()
+
+	pool := newTestPoolWithPriorityLane(t, defaultMaxSlots, &PriorityLaneConfig{
+		Recipients: []types.Address{addr5},
+		MaxSlots:   1,
+	})
+
+	regularTx := newTx(addr1, 0, 1)
+	regularTx.GasPrice = big.NewInt(0)
+
+	require.ErrorIs(t, pool.addTx(local, regularTx), ErrUnderpriced)
+
+	priorityTx := newPriorityTx(addr2, 0)
+	priorityTx.GasPrice = big.NewInt(0)
+
+	require.NoError(t, pool.addTx(local, priorityTx))
+}
+
+func TestPriorityLane_ExecutablesOrder(t *testing.T) {
+	t.Parallel()
+
+	pool := newTestPoolWithPriorityLane(t, defaultMaxSlots, &PriorityLaneConfig{
+		Recipients: []types.Address{addr5},
+		MaxSlots:   1,
+	})
+
+	regularTx := newTx(addr1, 0, 1)
+	regularTx.GasPrice = big.NewInt(100)
+
+	priorityTx := newPriorityTx(addr2, 0)
+
+	require.NoError(t, pool.addTx(local, regularTx))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	require.NoError(t, pool.addTx(local, priorityTx))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	// the priority lane transaction goes first despite the lower gas price
+	pool.Prepare(0)
+
+	tx := pool.Peek()
+	require.Equal(t, priorityTx, tx)
+	pool.Pop(tx)
+
+	require.Equal(t, uint64(0), pool.priorityLane.gauge.read())
+	require.Equal(t, uint64(1), pool.gauge.read())
+
+	tx = pool.Peek()
+	require.Equal(t, regularTx, tx)
+	pool.Pop(tx)
+
+	require.Equal(t, uint64(0), pool.gauge.read())
+}
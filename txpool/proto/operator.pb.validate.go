@@ -615,3 +615,201 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TxPoolEventValidationError{}
+
+// Validate checks the field values on DenyList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DenyList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DenyList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DenyListMultiError, or nil
+// if none found.
+func (m *DenyList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DenyList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	_DenyList_Senders_Unique := make(map[string]struct{}, len(m.GetSenders()))
+
+	for idx, item := range m.GetSenders() {
+		_, _ = idx, item
+
+		if _, exists := _DenyList_Senders_Unique[item]; exists {
+			err := DenyListValidationError{
+				field:  fmt.Sprintf("Senders[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DenyList_Senders_Unique[item] = struct{}{}
+		}
+
+		if !_DenyList_Senders_Pattern.MatchString(item) {
+			err := DenyListValidationError{
+				field:  fmt.Sprintf("Senders[%v]", idx),
+				reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{40}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	_DenyList_Recipients_Unique := make(map[string]struct{}, len(m.GetRecipients()))
+
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
+
+		if _, exists := _DenyList_Recipients_Unique[item]; exists {
+			err := DenyListValidationError{
+				field:  fmt.Sprintf("Recipients[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DenyList_Recipients_Unique[item] = struct{}{}
+		}
+
+		if !_DenyList_Recipients_Pattern.MatchString(item) {
+			err := DenyListValidationError{
+				field:  fmt.Sprintf("Recipients[%v]", idx),
+				reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{40}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	_DenyList_Selectors_Unique := make(map[string]struct{}, len(m.GetSelectors()))
+
+	for idx, item := range m.GetSelectors() {
+		_, _ = idx, item
+
+		if _, exists := _DenyList_Selectors_Unique[item]; exists {
+			err := DenyListValidationError{
+				field:  fmt.Sprintf("Selectors[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DenyList_Selectors_Unique[item] = struct{}{}
+		}
+
+		if !_DenyList_Selectors_Pattern.MatchString(item) {
+			err := DenyListValidationError{
+				field:  fmt.Sprintf("Selectors[%v]", idx),
+				reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{8}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DenyListMultiError(errors)
+	}
+
+	return nil
+}
+
+// DenyListMultiError is an error wrapping multiple validation errors returned
+// by DenyList.ValidateAll() if the designated constraints aren't met.
+type DenyListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DenyListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DenyListMultiError) AllErrors() []error { return m }
+
+// DenyListValidationError is the validation error returned by
+// DenyList.Validate if the designated constraints aren't met.
+type DenyListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DenyListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DenyListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DenyListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DenyListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DenyListValidationError) ErrorName() string { return "DenyListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DenyListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDenyList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DenyListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DenyListValidationError{}
+
+var _DenyList_Senders_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
+
+var _DenyList_Recipients_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
+
+var _DenyList_Selectors_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{8}$")
@@ -29,6 +29,10 @@ type TxnPoolOperatorClient interface {
 	AddTxn(ctx context.Context, in *AddTxnReq, opts ...grpc.CallOption) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TxnPoolOperator_SubscribeClient, error)
+	// DenyListGet returns the denied senders, recipients and function selectors
+	DenyListGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DenyList, error)
+	// DenyListSet replaces the denied senders, recipients and function selectors
+	DenyListSet(ctx context.Context, in *DenyList, opts ...grpc.CallOption) (*DenyList, error)
 }
 
 type txnPoolOperatorClient struct {
@@ -89,6 +93,24 @@ func (x *txnPoolOperatorSubscribeClient) Recv() (*TxPoolEvent, error) {
 	return m, nil
 }
 
+func (c *txnPoolOperatorClient) DenyListGet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DenyList, error) {
+	out := new(DenyList)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/DenyListGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) DenyListSet(ctx context.Context, in *DenyList, opts ...grpc.CallOption) (*DenyList, error) {
+	out := new(DenyList)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/DenyListSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxnPoolOperatorServer is the server API for TxnPoolOperator service.
 // All implementations must embed UnimplementedTxnPoolOperatorServer
 // for forward compatibility
@@ -99,6 +121,10 @@ type TxnPoolOperatorServer interface {
 	AddTxn(context.Context, *AddTxnReq) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error
+	// DenyListGet returns the denied senders, recipients and function selectors
+	DenyListGet(context.Context, *emptypb.Empty) (*DenyList, error)
+	// DenyListSet replaces the denied senders, recipients and function selectors
+	DenyListSet(context.Context, *DenyList) (*DenyList, error)
 	mustEmbedUnimplementedTxnPoolOperatorServer()
 }
 
@@ -115,6 +141,12 @@ func (UnimplementedTxnPoolOperatorServer) AddTxn(context.Context, *AddTxnReq) (*
 func (UnimplementedTxnPoolOperatorServer) Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedTxnPoolOperatorServer) DenyListGet(context.Context, *emptypb.Empty) (*DenyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyListGet not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) DenyListSet(context.Context, *DenyList) (*DenyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyListSet not implemented")
+}
 func (UnimplementedTxnPoolOperatorServer) mustEmbedUnimplementedTxnPoolOperatorServer() {}
 
 // UnsafeTxnPoolOperatorServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +217,42 @@ func (x *txnPoolOperatorSubscribeServer) Send(m *TxPoolEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TxnPoolOperator_DenyListGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).DenyListGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/DenyListGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).DenyListGet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_DenyListSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).DenyListSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/DenyListSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).DenyListSet(ctx, req.(*DenyList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TxnPoolOperator_ServiceDesc is the grpc.ServiceDesc for TxnPoolOperator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -200,6 +268,14 @@ var TxnPoolOperator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTxn",
 			Handler:    _TxnPoolOperator_AddTxn_Handler,
 		},
+		{
+			MethodName: "DenyListGet",
+			Handler:    _TxnPoolOperator_DenyListGet_Handler,
+		},
+		{
+			MethodName: "DenyListSet",
+			Handler:    _TxnPoolOperator_DenyListSet_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -29,7 +29,7 @@ func (p *TxPool) GetNonce(addr types.Address) uint64 {
 // GetCapacity returns the current number of slots
 // occupied in the pool as well as the max limit
 func (p *TxPool) GetCapacity() (uint64, uint64) {
-	return p.gauge.read(), p.gauge.max
+	return p.gauge.read(), p.gauge.limit()
 }
 
 // GetPendingTx returns the transaction by hash in the TxPool (pending txn) [Thread-safe]
@@ -51,6 +51,27 @@ func (p *TxPool) GetTxs(inclQueued bool) (
 	return
 }
 
+// GetPromotedTxs returns a copy of the promoted transactions of each account, sorted by nonce
+func (p *TxPool) GetPromotedTxs() map[types.Address][]*types.Transaction {
+	promoted := make(map[types.Address][]*types.Transaction)
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		account, _ := value.(*account)
+
+		account.promoted.lock(false)
+		defer account.promoted.unlock()
+
+		if account.promoted.length() != 0 {
+			promoted[addr] = sortedByNonce(account.promoted.queue)
+		}
+
+		return true
+	})
+
+	return promoted
+}
+
 // GetBaseFee returns current base fee
 func (p *TxPool) GetBaseFee() uint64 {
 	return atomic.LoadUint64(&p.baseFee)
@@ -76,6 +76,33 @@ func (q *accountQueue) push(tx *types.Transaction) {
 	heap.Push(&q.queue, tx)
 }
 
+// remove removes the given transaction from the queue.
+// Returns false if the transaction is not in the queue.
+func (q *accountQueue) remove(tx *types.Transaction) bool {
+	for i, x := range q.queue {
+		if x == tx {
+			heap.Remove(&q.queue, i)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// last returns the transaction with the highest nonce without removing it.
+func (q *accountQueue) last() *types.Transaction {
+	var last *types.Transaction
+
+	for _, tx := range q.queue {
+		if last == nil || tx.Nonce > last.Nonce {
+			last = tx
+		}
+	}
+
+	return last
+}
+
 // peek returns the first transaction from the queue without removing it.
 func (q *accountQueue) peek() *types.Transaction {
 	if q.length() == 0 {
@@ -13,9 +13,15 @@ type pricedQueue struct {
 
 // newPricesQueue creates the priced queue with initial transactions and base fee
 func newPricesQueue(baseFee uint64, initialTxs []*types.Transaction) *pricedQueue {
+	return newLanePricesQueue(baseFee, nil, initialTxs)
+}
+
+// newLanePricesQueue creates the priced queue ordering the priority lane transactions first
+func newLanePricesQueue(baseFee uint64, lane *priorityLane, initialTxs []*types.Transaction) *pricedQueue {
 	q := &pricedQueue{
 		queue: &maxPriceQueue{
 			baseFee: new(big.Int).SetUint64(baseFee),
+			lane:    lane,
 			txs:     initialTxs,
 		},
 	}
@@ -50,9 +56,10 @@ func (q *pricedQueue) length() int {
 	return q.queue.Len()
 }
 
-// transactions sorted by gas price (descending)
+// transactions sorted by gas price (descending), the priority lane transactions first
 type maxPriceQueue struct {
 	baseFee *big.Int
+	lane    *priorityLane
 	txs     []*types.Transaction
 }
 
@@ -95,6 +102,10 @@ func (q *maxPriceQueue) Pop() interface{} {
 // @see https://github.com/etclabscore/core-geth/blob/4e2b0e37f89515a4e7b6bafaa40910a296cb38c0/core/txpool/list.go#L458
 // for details why is something implemented like it is
 func (q *maxPriceQueue) Less(i, j int) bool {
+	if priorityI, priorityJ := q.lane.contains(q.txs[i]), q.lane.contains(q.txs[j]); priorityI != priorityJ {
+		return priorityI
+	}
+
 	switch cmp(q.txs[i], q.txs[j], q.baseFee) {
 	case -1:
 		return false
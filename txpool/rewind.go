@@ -0,0 +1,32 @@
+package txpool
+
+import (
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Rewind aligns the pool with the state of the current header once the chain was rewound.
+// The accounts whose next nonce is ahead of the state are cleared, since the nonces
+// of their transactions are not contiguous with the state anymore
+func (p *TxPool) Rewind() {
+	stateRoot := p.store.Header().StateRoot
+
+	var dropped []*types.Transaction
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		account, _ := value.(*account)
+
+		if stateNonce := p.store.GetNonce(stateRoot, addr); stateNonce < account.getNonce() {
+			dropped = append(dropped, p.clearAccount(account, stateNonce)...)
+		}
+
+		return true
+	})
+
+	if len(dropped) > 0 {
+		p.eventManager.signalEvent(proto.EventType_DROPPED, toHash(dropped...)...)
+	}
+
+	p.logger.Info("pool rewound", "dropped", len(dropped))
+}
@@ -0,0 +1,41 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewind(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+
+	// the account is ahead of the rewound state (nonce 0)
+	pool.accounts.initOnce(addr1, 3)
+
+	ahead := newTx(addr1, 3, 1)
+	kept := newTx(addr2, 0, 1)
+
+	require.NoError(t, pool.addTx(local, ahead))
+	require.NoError(t, pool.addTx(local, kept))
+	require.Equal(t, uint64(2), pool.gauge.read())
+
+	pool.Rewind()
+
+	_, exists := pool.index.get(ahead.Hash)
+	require.False(t, exists)
+
+	_, exists = pool.index.get(kept.Hash)
+	require.True(t, exists)
+
+	require.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
+	require.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+	require.Equal(t, uint64(1), pool.gauge.read())
+
+	// the transactions with the state nonce are accepted again
+	require.NoError(t, pool.addTx(local, newTx(addr1, 0, 1)))
+}
@@ -0,0 +1,203 @@
+package txpool
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+// maxScheduledTxs is the maximum number of the scheduled transactions held by the pool
+const maxScheduledTxs = 1024
+
+var (
+	ErrScheduledTxsLimitReached = errors.New("maximum number of scheduled transactions reached")
+	ErrScheduledTxNotFound      = errors.New("scheduled transaction not found")
+)
+
+// scheduledTx is the locally submitted transaction which is not valid before the given block
+type scheduledTx struct {
+	tx *types.Transaction
+	// notBefore is the number of the first block the transaction can be included in
+	notBefore uint64
+}
+
+// scheduledQueue holds the scheduled transactions until the block they are valid from.
+// Scheduled transactions are neither enqueued nor gossiped until they are promoted to the pool
+type scheduledQueue struct {
+	lock sync.Mutex
+	txs  map[types.Hash]*scheduledTx
+}
+
+func newScheduledQueue() *scheduledQueue {
+	return &scheduledQueue{
+		txs: make(map[types.Hash]*scheduledTx),
+	}
+}
+
+// add adds the transaction to the queue
+func (q *scheduledQueue) add(tx *types.Transaction, notBefore uint64) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if _, ok := q.txs[tx.Hash]; ok {
+		return ErrAlreadyKnown
+	}
+
+	if len(q.txs) >= maxScheduledTxs {
+		return ErrScheduledTxsLimitReached
+	}
+
+	q.txs[tx.Hash] = &scheduledTx{tx: tx, notBefore: notBefore}
+
+	metrics.SetGauge([]string{txPoolMetrics, "scheduled_transactions"}, float32(len(q.txs)))
+
+	return nil
+}
+
+// remove removes the transaction from the queue
+func (q *scheduledQueue) remove(hash types.Hash) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if _, ok := q.txs[hash]; !ok {
+		return false
+	}
+
+	delete(q.txs, hash)
+
+	metrics.SetGauge([]string{txPoolMetrics, "scheduled_transactions"}, float32(len(q.txs)))
+
+	return true
+}
+
+// popDue removes and returns the transactions which can be included in the block with the given number,
+// ordered by the block they are valid from, the sender and the nonce
+func (q *scheduledQueue) popDue(number uint64) []*scheduledTx {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	var due []*scheduledTx
+
+	for hash, scheduled := range q.txs {
+		if scheduled.notBefore <= number {
+			delete(q.txs, hash)
+
+			due = append(due, scheduled)
+		}
+	}
+
+	if len(due) == 0 {
+		return nil
+	}
+
+	metrics.SetGauge([]string{txPoolMetrics, "scheduled_transactions"}, float32(len(q.txs)))
+
+	sortScheduledTxs(due)
+
+	return due
+}
+
+// list returns all the scheduled transactions
+func (q *scheduledQueue) list() []*scheduledTx {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	txs := make([]*scheduledTx, 0, len(q.txs))
+	for _, scheduled := range q.txs {
+		txs = append(txs, scheduled)
+	}
+
+	sortScheduledTxs(txs)
+
+	return txs
+}
+
+func sortScheduledTxs(txs []*scheduledTx) {
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].notBefore != txs[j].notBefore {
+			return txs[i].notBefore < txs[j].notBefore
+		}
+
+		if txs[i].tx.From != txs[j].tx.From {
+			return txs[i].tx.From.String() < txs[j].tx.From.String()
+		}
+
+		return txs[i].tx.Nonce < txs[j].tx.Nonce
+	})
+}
+
+// AddScheduledTx adds the locally submitted transaction which can't be included before the given block.
+// The transaction is held in the scheduled queue, and added to the pool (and broadcast)
+// once the block preceding the given one is written. If that block is already written,
+// the transaction is added to the pool right away
+func (p *TxPool) AddScheduledTx(tx *types.Transaction, notBefore uint64) error {
+	head := p.store.Header().Number
+	if notBefore <= head+1 {
+		return p.AddTx(tx)
+	}
+
+	// reject the invalid transactions right away, the transaction is validated again once promoted
+	if err := p.validateTx(tx); err != nil {
+		return err
+	}
+
+	if tx.Type.HasAccessList() {
+		tx.ChainID = p.chainID
+	}
+
+	tx.ComputeHash(head)
+
+	if _, known := p.index.get(tx.Hash); known {
+		return ErrAlreadyKnown
+	}
+
+	if err := p.scheduled.add(tx, notBefore); err != nil {
+		return err
+	}
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("scheduled tx", "hash", tx.Hash, "notBefore", notBefore)
+	}
+
+	return nil
+}
+
+// CancelScheduledTx removes the transaction from the scheduled queue, if it is not yet promoted to the pool
+func (p *TxPool) CancelScheduledTx(hash types.Hash) error {
+	if !p.scheduled.remove(hash) {
+		return ErrScheduledTxNotFound
+	}
+
+	return nil
+}
+
+// GetScheduledTxs returns the transactions which are not yet promoted from the scheduled queue,
+// grouped by the number of the first block they can be included in
+func (p *TxPool) GetScheduledTxs() map[uint64][]*types.Transaction {
+	txs := make(map[uint64][]*types.Transaction)
+
+	for _, scheduled := range p.scheduled.list() {
+		txs[scheduled.notBefore] = append(txs[scheduled.notBefore], scheduled.tx)
+	}
+
+	return txs
+}
+
+// promoteScheduledTxs adds the scheduled transactions which can be included in the block following the head
+// to the pool. Transactions which became invalid in the meantime are discarded
+func (p *TxPool) promoteScheduledTxs(head uint64) {
+	for _, scheduled := range p.scheduled.popDue(head + 1) {
+		if err := p.AddTx(scheduled.tx); err != nil {
+			p.logger.Warn("discarding scheduled tx", "hash", scheduled.tx.Hash, "err", err)
+
+			continue
+		}
+
+		if p.logger.IsDebug() {
+			p.logger.Debug("scheduled tx promoted", "hash", scheduled.tx.Hash, "notBefore", scheduled.notBefore)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddScheduledTx(t *testing.T) {
+	t.Parallel()
+
+	header := &types.Header{GasLimit: mockHeader.GasLimit, Number: 10}
+
+	pool, err := newTestPool(NewDefaultMockStore(header))
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	var (
+		first     = newTx(addr1, 0, 1)
+		second    = newTx(addr1, 1, 1)
+		cancelled = newTx(addr2, 0, 1)
+		immediate = newTx(addr3, 0, 1)
+	)
+
+	// the transaction valid for the next block is added to the pool right away
+	require.NoError(t, pool.AddScheduledTx(immediate, 11))
+
+	_, ok := pool.index.get(immediate.Hash)
+	require.True(t, ok)
+
+	require.NoError(t, pool.AddScheduledTx(second, 13))
+	require.NoError(t, pool.AddScheduledTx(first, 12))
+	require.NoError(t, pool.AddScheduledTx(cancelled, 12))
+	require.ErrorIs(t, pool.AddScheduledTx(first, 12), ErrAlreadyKnown)
+
+	require.Equal(t, map[uint64][]*types.Transaction{
+		12: {first, cancelled},
+		13: {second},
+	}, pool.GetScheduledTxs())
+
+	// the scheduled transactions are not part of the pool
+	for _, tx := range []*types.Transaction{first, second, cancelled} {
+		_, ok := pool.index.get(tx.Hash)
+		require.False(t, ok)
+	}
+
+	require.NoError(t, pool.CancelScheduledTx(cancelled.Hash))
+	require.ErrorIs(t, pool.CancelScheduledTx(cancelled.Hash), ErrScheduledTxNotFound)
+
+	// the transaction is promoted once the block preceding the scheduled one is written
+	header.Number = 11
+
+	pool.ResetWithHeaders()
+
+	_, ok = pool.index.get(first.Hash)
+	require.True(t, ok)
+
+	_, ok = pool.index.get(second.Hash)
+	require.False(t, ok)
+
+	require.Equal(t, map[uint64][]*types.Transaction{13: {second}}, pool.GetScheduledTxs())
+
+	header.Number = 12
+
+	pool.ResetWithHeaders()
+
+	_, ok = pool.index.get(second.Hash)
+	require.True(t, ok)
+
+	_, ok = pool.index.get(cancelled.Hash)
+	require.False(t, ok)
+
+	require.Empty(t, pool.GetScheduledTxs())
+}
+
+func TestAddScheduledTx_Limit(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	for i := uint64(0); i < maxScheduledTxs; i++ {
+		require.NoError(t, pool.AddScheduledTx(newTx(addr1, i, 1), 100))
+	}
+
+	require.ErrorIs(t, pool.AddScheduledTx(newTx(addr1, maxScheduledTxs, 1), 100), ErrScheduledTxsLimitReached)
+
+	// the invalid transaction is rejected right away
+	tx := newTx(addr2, 0, 1)
+	tx.Type = types.StateTx
+
+	require.ErrorIs(t, pool.AddScheduledTx(tx, 100), ErrInvalidTxType)
+}
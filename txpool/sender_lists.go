@@ -0,0 +1,37 @@
+package txpool
+
+import (
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// senderLists rejects the transactions of the senders which aren't permitted to transact
+// by the transactions allow list, or are denied by the transactions block list.
+// The roles are read from the latest state, as the lists are managed by their admins on-chain.
+// The lists are still enforced by the state transition, since the roles may change
+// before the transaction is executed
+type senderLists struct {
+	store store
+
+	allowList bool
+	blockList bool
+}
+
+// check returns the error if the sender of the transaction isn't permitted to transact
+func (s *senderLists) check(tx *types.Transaction) error {
+	// the allow list takes precedence over the block list, same as in the state transition
+	if s.allowList {
+		if !addressListRole(s.store, contracts.AllowListTransactionsAddr, tx.From).Enabled() {
+			return ErrSenderNotAllowed
+		}
+
+		return nil
+	}
+
+	if s.blockList && addressListRole(s.store, contracts.BlockListTransactionsAddr, tx.From) == addresslist.EnabledRole {
+		return ErrSenderBlocked
+	}
+
+	return nil
+}
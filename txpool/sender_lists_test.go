@@ -0,0 +1,58 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSenderLists_Check(t *testing.T) {
+	t.Parallel()
+
+	store := addressListsMockStore{
+		defaultMockStore: defaultMockStore{DefaultHeader: mockHeader},
+		roles: map[types.Address]map[types.Address]addresslist.Role{
+			contracts.AllowListTransactionsAddr: {
+				addr1: addresslist.AdminRole,
+				addr2: addresslist.EnabledRole,
+			},
+			contracts.BlockListTransactionsAddr: {
+				addr3: addresslist.EnabledRole,
+				// the admins of the block list aren't blocked
+				addr4: addresslist.AdminRole,
+			},
+		},
+	}
+
+	cases := []struct {
+		name      string
+		allowList bool
+		blockList bool
+		sender    types.Address
+		err       error
+	}{
+		{"disabled", false, false, addr3, nil},
+		{"allow list admin", true, false, addr1, nil},
+		{"allow list enabled", true, false, addr2, nil},
+		{"not in allow list", true, false, addr3, ErrSenderNotAllowed},
+		{"not in block list", false, true, addr1, nil},
+		{"block list admin", false, true, addr4, nil},
+		{"in block list", false, true, addr3, ErrSenderBlocked},
+		{"allow list precedence", true, true, addr2, nil},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			lists := &senderLists{store: store, allowList: c.allowList, blockList: c.blockList}
+
+			assert.ErrorIs(t, lists.check(&types.Transaction{From: c.sender, To: &addr5}), c.err)
+		})
+	}
+}
@@ -14,7 +14,8 @@ const (
 // Gauge for measuring pool capacity in slots
 type slotGauge struct {
 	height uint64 // amount of slots currently occupying the pool
-	max    uint64 // max limit
+	max    uint64 // max limit, accessed with atomics
+	metric string // name of the slots usage metric, slots_used if not set
 }
 
 // read returns the current height of the gauge.
@@ -25,24 +26,43 @@ func (g *slotGauge) read() uint64 {
 // increase increases the height of the gauge by the specified slots amount.
 func (g *slotGauge) increase(slots uint64) {
 	newHeight := atomic.AddUint64(&g.height, slots)
-	metrics.SetGauge([]string{txPoolMetrics, "slots_used"}, float32(newHeight))
+	metrics.SetGauge([]string{txPoolMetrics, g.metricName()}, float32(newHeight))
 }
 
 // decrease decreases the height of the gauge by the specified slots amount.
 func (g *slotGauge) decrease(slots uint64) {
 	newHeight := atomic.AddUint64(&g.height, ^(slots - 1))
-	metrics.SetGauge([]string{txPoolMetrics, "slots_used"}, float32(newHeight))
+	metrics.SetGauge([]string{txPoolMetrics, g.metricName()}, float32(newHeight))
+}
+
+// metricName returns the name of the slots usage metric
+func (g *slotGauge) metricName() string {
+	if g.metric == "" {
+		return "slots_used"
+	}
+
+	return g.metric
+}
+
+// limit returns the max limit of the gauge.
+func (g *slotGauge) limit() uint64 {
+	return atomic.LoadUint64(&g.max)
 }
 
 // highPressure checks if the gauge level
 // is higher than the 0.8*max threshold
 func (g *slotGauge) highPressure() bool {
-	return g.read() > (highPressureMark*g.max)/100
+	return g.read() > (highPressureMark*g.limit())/100
 }
 
 // free slots returns how many slots are currently available
 func (g *slotGauge) freeSlots() uint64 {
-	return g.max - g.read()
+	limit, height := g.limit(), g.read()
+	if height >= limit {
+		return 0
+	}
+
+	return limit - height
 }
 
 // slotsRequired calculates the number of slots required for given transaction(s).
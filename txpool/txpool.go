@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,7 +10,10 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
@@ -19,6 +23,8 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 )
 
@@ -27,6 +33,9 @@ const (
 	txMaxSize   = 128 * 1024 // 128Kb
 	topicNameV1 = "txpool/0.1"
 
+	// max size of the gossiped transaction, leaving room for the protobuf envelope (type URL)
+	txGossipMaxSize = txMaxSize + 1024
+
 	// maximum allowed number of times an account
 	// was excluded from block building (ibft.writeTransactions)
 	maxAccountDemotions uint64 = 10
@@ -42,29 +51,49 @@ const (
 
 // errors
 var (
-	ErrIntrinsicGas            = errors.New("intrinsic gas too low")
-	ErrBlockLimitExceeded      = errors.New("exceeds block gas limit")
-	ErrNegativeValue           = errors.New("negative value")
-	ErrExtractSignature        = errors.New("cannot extract signature")
-	ErrInvalidSender           = errors.New("invalid sender")
-	ErrTxPoolOverflow          = errors.New("txpool is full")
-	ErrUnderpriced             = errors.New("transaction underpriced")
-	ErrNonceTooLow             = errors.New("nonce too low")
-	ErrInsufficientFunds       = errors.New("insufficient funds for gas * price + value")
-	ErrInvalidAccountState     = errors.New("invalid account state")
-	ErrAlreadyKnown            = errors.New("already known")
-	ErrOversizedData           = errors.New("oversized data")
-	ErrMaxEnqueuedLimitReached = errors.New("maximum number of enqueued transactions reached")
-	ErrRejectFutureTx          = errors.New("rejected future tx due to low slots")
-	ErrInvalidTxType           = errors.New("invalid tx type")
-	ErrTipAboveFeeCap          = errors.New("max priority fee per gas higher than max fee per gas")
-	ErrTipVeryHigh             = errors.New("max priority fee per gas higher than 2^256-1")
-	ErrFeeCapVeryHigh          = errors.New("max fee per gas higher than 2^256-1")
-	ErrNonceExistsInPool       = errors.New("tx with the same nonce is already present")
-	ErrReplacementUnderpriced  = errors.New("replacement tx underpriced")
-	ErrDynamicTxNotAllowed     = errors.New("dynamic tx not allowed currently")
+	ErrIntrinsicGas             = errors.New("intrinsic gas too low")
+	ErrBlockLimitExceeded       = errors.New("exceeds block gas limit")
+	ErrNegativeValue            = errors.New("negative value")
+	ErrExtractSignature         = errors.New("cannot extract signature")
+	ErrInvalidSender            = errors.New("invalid sender")
+	ErrInvalidChainID           = errors.New("invalid chain id")
+	ErrTxPoolOverflow           = errors.New("txpool is full")
+	ErrUnderpriced              = errors.New("transaction underpriced")
+	ErrNonceTooLow              = errors.New("nonce too low")
+	ErrInsufficientFunds        = errors.New("insufficient funds for gas * price + value")
+	ErrInvalidAccountState      = errors.New("invalid account state")
+	ErrAlreadyKnown             = errors.New("already known")
+	ErrOversizedData            = errors.New("oversized data")
+	ErrMaxEnqueuedLimitReached  = errors.New("maximum number of enqueued transactions reached")
+	ErrRejectFutureTx           = errors.New("rejected future tx due to low slots")
+	ErrInvalidTxType            = errors.New("invalid tx type")
+	ErrTipAboveFeeCap           = errors.New("max priority fee per gas higher than max fee per gas")
+	ErrTipVeryHigh              = errors.New("max priority fee per gas higher than 2^256-1")
+	ErrFeeCapVeryHigh           = errors.New("max fee per gas higher than 2^256-1")
+	ErrNonceExistsInPool        = errors.New("tx with the same nonce is already present")
+	ErrReplacementUnderpriced   = errors.New("replacement tx underpriced")
+	ErrDynamicTxNotAllowed      = errors.New("dynamic tx not allowed currently")
+	ErrDeniedSender             = errors.New("sender is denied")
+	ErrDeniedRecipient          = errors.New("recipient is denied")
+	ErrDeniedSelector           = errors.New("function selector is denied")
+	ErrSampledOut               = errors.New("transaction sampled out due to high load")
+	ErrRejectedByExtension      = errors.New("rejected by extension")
+	ErrSponsoredTxNotAllowed    = errors.New("sponsored tx not allowed currently")
+	ErrAccessListNotAllowed     = errors.New("access list not allowed currently")
+	ErrInvalidSponsor           = errors.New("invalid sponsor signature")
+	ErrInsufficientSponsorFunds = errors.New("insufficient sponsor funds for gas * price")
+	ErrDeployerNotAllowed       = errors.New("sender is not in the contract deployer allow list")
+	ErrDeployerBlocked          = errors.New("sender is in the contract deployer block list")
+	ErrSenderNotAllowed         = errors.New("sender is not in the transactions allow list")
+	ErrSenderBlocked            = errors.New("sender is in the transactions block list")
 )
 
+// txGossipTopicOption limits the size of the gossiped transactions
+var txGossipTopicOption = network.WithMaxMessageSize(txGossipMaxSize)
+
+// tracer is the tracer of the transaction admission spans
+var tracer = tracing.Tracer("txpool")
+
 // indicates origin of a transaction
 type txOrigin int
 
@@ -89,6 +118,7 @@ type store interface {
 	Header() *types.Header
 	GetNonce(root types.Hash, addr types.Address) uint64
 	GetBalance(root types.Hash, addr types.Address) (*big.Int, error)
+	GetStorage(root types.Hash, addr types.Address, slot types.Hash) (types.Hash, error)
 	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
 }
 
@@ -101,6 +131,46 @@ type Config struct {
 	MaxSlots           uint64
 	MaxAccountEnqueued uint64
 	ChainID            *big.Int
+	DenyList           *DenyList
+
+	// MaxInitCodeSize is the max size of the contract creation code, zero uses the EIP-3860 default
+	MaxInitCodeSize uint64
+
+	// ContractDeployerAllowList rejects the deployments of the senders not enabled in the contract deployer
+	// allow list of the chain
+	ContractDeployerAllowList bool
+
+	// ContractDeployerBlockList rejects the deployments of the senders enabled in the contract deployer
+	// block list of the chain, ignored if the allow list is enabled
+	ContractDeployerBlockList bool
+
+	// TransactionsAllowList rejects the transactions of the senders not enabled in the transactions
+	// allow list of the chain
+	TransactionsAllowList bool
+
+	// TransactionsBlockList rejects the transactions of the senders enabled in the transactions
+	// block list of the chain, ignored if the allow list is enabled
+	TransactionsBlockList bool
+
+	// AdmissionRateLimit is the number of transactions per second above which the incoming
+	// transactions are sampled, zero disables the sampling
+	AdmissionRateLimit uint64
+
+	// AdmissionMinProbability is the minimum admission probability of each sender while sampling
+	AdmissionMinProbability float64
+
+	// Validators are the transaction validators registered by the extensions
+	Validators []extension.TxValidator
+
+	// AutoTune are the bounds of the pool limits auto-tuning, nil disables the auto-tuning
+	AutoTune *AutoTuneConfig
+
+	// PriorityLane holds the recipients of the consensus critical transactions, nil disables the priority lane
+	PriorityLane *PriorityLaneConfig
+
+	// FutureTxLifetime is the maximum time a transaction preceded by a nonce gap
+	// is kept in the pool, zero keeps it until evicted by the pool pressure
+	FutureTxLifetime time.Duration
 }
 
 /* All requests are passed to the main loop
@@ -162,9 +232,36 @@ type TxPool struct {
 	// gauge for measuring pool capacity
 	gauge slotGauge
 
-	// priceLimit is a lower threshold for gas price
+	// priceLimit is a lower threshold for gas price. This variable is accessed with atomics
 	priceLimit uint64
 
+	// denied senders, recipients and function selectors
+	denyList *denyList
+
+	// contract deployer allow and block lists of the chain
+	deployerLists deployerLists
+
+	// transactions allow and block lists of the chain
+	senderLists senderLists
+
+	// validators are the transaction validators registered by the extensions
+	validators []extension.TxValidator
+
+	// admission samples the incoming transactions under high load, nil if disabled
+	admission atomic.Pointer[admissionSampler]
+
+	// autoTuner adjusts the pool limits to the observed load, nil if disabled
+	autoTuner atomic.Pointer[autoTuner]
+
+	// scheduled holds the local transactions which are not valid before some future block
+	scheduled *scheduledQueue
+
+	// priorityLane holds the consensus critical transactions, nil if disabled
+	priorityLane *priorityLane
+
+	// futureTxLifetime is the maximum lifetime of the gapped transactions, zero if unlimited
+	futureTxLifetime time.Duration
+
 	// channels on which the pool's event loop
 	// does dispatching/handling requests.
 	promoteReqCh chan promoteRequest
@@ -193,6 +290,9 @@ type TxPool struct {
 
 	// chain id
 	chainID *big.Int
+
+	// max size of the contract creation code
+	maxInitCodeSize uint64
 }
 
 // NewTxPool returns a new pool for processing incoming transactions.
@@ -213,20 +313,47 @@ func NewTxPool(
 		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
 		gauge:       slotGauge{height: 0, max: config.MaxSlots},
 		priceLimit:  config.PriceLimit,
+		denyList:    newDenyList(config.DenyList),
+		validators:  config.Validators,
+		scheduled:   newScheduledQueue(),
 		chainID:     config.ChainID,
 
+		maxInitCodeSize: config.MaxInitCodeSize,
+
+		deployerLists: deployerLists{
+			store:     store,
+			allowList: config.ContractDeployerAllowList,
+			blockList: config.ContractDeployerBlockList,
+		},
+		senderLists: senderLists{
+			store:     store,
+			allowList: config.TransactionsAllowList,
+			blockList: config.TransactionsBlockList,
+		},
+
+		priorityLane: newPriorityLane(config.PriorityLane),
+
+		futureTxLifetime: config.FutureTxLifetime,
+
 		//	main loop channels
 		promoteReqCh: make(chan promoteRequest),
 		pruneCh:      make(chan struct{}),
 		shutdownCh:   make(chan struct{}),
 	}
 
+	if pool.maxInitCodeSize == 0 {
+		pool.maxInitCodeSize = state.TxPoolMaxInitCodeSize
+	}
+
+	pool.admission.Store(newAdmissionSampler(config.AdmissionRateLimit, config.AdmissionMinProbability))
+	pool.autoTuner.Store(newAutoTuner(config.AutoTune))
+
 	// Attach the event manager
 	pool.eventManager = newEventManager(pool.logger)
 
 	if network != nil {
 		// subscribe to the gossip protocol
-		topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
+		topic, err := network.NewTopic(topicNameV1, &proto.Txn{}, txGossipTopicOption)
 		if err != nil {
 			return nil, err
 		}
@@ -273,6 +400,23 @@ func (p *TxPool) Start() {
 		}
 	}()
 
+	//	run the handler evicting the gapped transactions which outlived their lifetime
+	if p.futureTxLifetime > 0 {
+		go func() {
+			ticker := time.NewTicker(gappedTxsSweepInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-p.shutdownCh:
+					return
+				case <-ticker.C:
+					p.pruneExpiredGappedTxs(p.futureTxLifetime)
+				}
+			}
+		}()
+	}
+
 	//	run the handler for the tx pipeline
 	go func() {
 		for {
@@ -306,12 +450,25 @@ func (p *TxPool) SetSealing(sealing bool) {
 // AddTx adds a new transaction to the pool (sent from json-RPC/gRPC endpoints)
 // and broadcasts it to the network (if enabled).
 func (p *TxPool) AddTx(tx *types.Transaction) error {
+	return p.AddTxWithTraceID(tx, "")
+}
+
+// AddTxWithTraceID adds a new transaction to the pool same as AddTx, annotating the logs
+// of the transaction with the trace ID of the request which sent it (if given)
+func (p *TxPool) AddTxWithTraceID(tx *types.Transaction, traceID string) error {
+	logger := p.logger
+	if traceID != "" {
+		logger = logger.With("traceID", traceID)
+	}
+
 	if err := p.addTx(local, tx); err != nil {
-		p.logger.Error("failed to add tx", "err", err)
+		logger.Error("failed to add tx", "err", err)
 
 		return err
 	}
 
+	logger.Debug("added local tx", "hash", tx.Hash.String())
+
 	// broadcast the transaction only if a topic
 	// subscription is present
 	if p.topic != nil {
@@ -322,7 +479,7 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 		}
 
 		if err := p.topic.Publish(tx); err != nil {
-			p.logger.Error("failed to topic tx", "err", err)
+			logger.Error("failed to topic tx", "err", err)
 		}
 	}
 
@@ -339,7 +496,7 @@ func (p *TxPool) Prepare(baseFee uint64) {
 	primaries := p.accounts.getPrimaries()
 
 	// create new executables queue with base fee and initial transactions (primaries)
-	p.executables = newPricesQueue(baseFee, primaries)
+	p.executables = newLanePricesQueue(baseFee, p.priorityLane, primaries)
 }
 
 // Peek returns the best-price selected
@@ -380,7 +537,7 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 	account.resetDemotions()
 
 	// update state
-	p.gauge.decrease(slotsRequired(tx))
+	p.releaseSlots(tx)
 
 	// update metrics
 	p.updatePending(-1)
@@ -397,6 +554,24 @@ func (p *TxPool) Drop(tx *types.Transaction) {
 	// fetch associated account
 	account := p.accounts.get(tx.From)
 
+	// rollback nonce
+	nextNonce := tx.Nonce
+	dropped := p.clearAccount(account, nextNonce)
+
+	p.eventManager.signalEvent(proto.EventType_DROPPED, tx.Hash)
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("dropped account txs",
+			"num", len(dropped),
+			"next_nonce", nextNonce,
+			"address", tx.From.String(),
+		)
+	}
+}
+
+// clearAccount drops all transactions of the account and sets its next (expected) nonce.
+// Returns the dropped transactions
+func (p *TxPool) clearAccount(account *account, nextNonce uint64) []*types.Transaction {
 	account.promoted.lock(true)
 	account.enqueued.lock(true)
 	account.nonceToTx.lock()
@@ -407,45 +582,25 @@ func (p *TxPool) Drop(tx *types.Transaction) {
 		account.promoted.unlock()
 	}()
 
-	// num of all txs dropped
-	droppedCount := 0
-
-	// pool resource cleanup
-	clearAccountQueue := func(txs []*types.Transaction) {
-		p.index.remove(txs...)
-		p.gauge.decrease(slotsRequired(txs...))
-
-		// increase counter
-		droppedCount += len(txs)
-	}
-
-	// rollback nonce
-	nextNonce := tx.Nonce
 	account.setNonce(nextNonce)
 
 	// reset accounts nonce map
 	account.nonceToTx.reset()
 
 	// drop promoted
-	dropped := account.promoted.clear()
-	clearAccountQueue(dropped)
+	droppedPromoted := account.promoted.clear()
 
 	// update metrics
-	p.updatePending(-1 * int64(len(dropped)))
+	p.updatePending(-1 * int64(len(droppedPromoted)))
 
 	// drop enqueued
-	dropped = account.enqueued.clear()
-	clearAccountQueue(dropped)
+	dropped := append(droppedPromoted, account.enqueued.clear()...)
 
-	p.eventManager.signalEvent(proto.EventType_DROPPED, tx.Hash)
+	// pool resource cleanup
+	p.index.remove(dropped...)
+	p.releaseSlots(dropped...)
 
-	if p.logger.IsDebug() {
-		p.logger.Debug("dropped account txs",
-			"num", droppedCount,
-			"next_nonce", nextNonce,
-			"address", tx.From.String(),
-		)
-	}
+	return dropped
 }
 
 // Demote excludes an account from being further processed during block building
@@ -539,6 +694,13 @@ func (p *TxPool) processEvent(event *blockchain.Event) {
 		// only non-validator cleanup inactive accounts
 		p.updateAccountSkipsCounts(stateNonces)
 	}
+
+	// the scheduled transactions are validated against the new state
+	p.promoteScheduledTxs(p.store.Header().Number)
+
+	if tuner := p.autoTuner.Load(); tuner != nil {
+		tuner.observe(p, event.NewChain)
+	}
 }
 
 // validateTx ensures the transaction conforms to specific
@@ -551,6 +713,15 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrInvalidTxType
 	}
 
+	// Reject the access lists if the berlin fork is not enabled for the next block,
+	// since the signature of the transaction covers its access list
+	if (tx.Type == types.AccessListTx || len(tx.AccessList) > 0) &&
+		!forkmanager.GetInstance().IsForkEnabled(chain.Berlin, p.store.Header().Number+1) {
+		metrics.IncrCounter([]string{txPoolMetrics, "access_list_not_allowed"}, 1)
+
+		return ErrAccessListNotAllowed
+	}
+
 	// Check the transaction size to overcome DOS Attacks
 	if uint64(len(tx.MarshalRLP())) > txMaxSize {
 		metrics.IncrCounter([]string{txPoolMetrics, "oversized_data_txs"}, 1)
@@ -565,6 +736,13 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrNegativeValue
 	}
 
+	// Check if the transaction is signed for this chain
+	if chainID, protected := crypto.TxChainID(tx); protected && chainID.Cmp(p.chainID) != 0 {
+		metrics.IncrCounter([]string{txPoolMetrics, "invalid_chain_id_txs"}, 1)
+
+		return ErrInvalidChainID
+	}
+
 	// Check if the transaction is signed properly
 
 	// Extract the sender
@@ -589,14 +767,60 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		tx.From = from
 	}
 
+	// Check if the sender, the recipient or the called function is denied by the operator
+	if err := p.denyList.check(tx); err != nil {
+		metrics.IncrCounter([]string{txPoolMetrics, "denied_txs"}, 1)
+
+		return err
+	}
+
+	// Check if the sender is permitted to transact by the transactions lists of the chain
+	if err := p.senderLists.check(tx); err != nil {
+		metrics.IncrCounter([]string{txPoolMetrics, "denied_sender_txs"}, 1)
+
+		return err
+	}
+
+	// Check if the sender is permitted to deploy contracts by the deployer lists of the chain
+	if err := p.deployerLists.check(tx); err != nil {
+		metrics.IncrCounter([]string{txPoolMetrics, "denied_deployment_txs"}, 1)
+
+		return err
+	}
+
+	// Check if the transaction is accepted by the extensions
+	for _, validate := range p.validators {
+		if err := validate(tx); err != nil {
+			metrics.IncrCounter([]string{txPoolMetrics, "extension_rejected_txs"}, 1)
+
+			return fmt.Errorf("%w: %s", ErrRejectedByExtension, err.Error())
+		}
+	}
+
 	// Check if transaction can deploy smart contract
-	if tx.IsContractCreation() && p.forks.EIP158 && len(tx.Input) > state.TxPoolMaxInitCodeSize {
+	if tx.IsContractCreation() && p.forks.EIP158 && uint64(len(tx.Input)) > p.maxInitCodeSize {
 		metrics.IncrCounter([]string{txPoolMetrics, "contract_deploy_too_large_txs"}, 1)
 
 		return runtime.ErrMaxCodeSizeExceeded
 	}
 
-	if tx.Type == types.DynamicFeeTx {
+	if tx.Type == types.SponsoredTx {
+		// Reject sponsored tx if the sponsoredGas fork is not enabled for the next block
+		if !forkmanager.GetInstance().IsForkEnabled(chain.SponsoredGas, p.store.Header().Number+1) {
+			metrics.IncrCounter([]string{txPoolMetrics, "sponsored_tx_not_allowed"}, 1)
+
+			return ErrSponsoredTxNotAllowed
+		}
+
+		// Check if the sponsor agreed to pay the gas of the sender
+		if err := crypto.VerifySponsor(tx, p.chainID.Uint64()); err != nil {
+			metrics.IncrCounter([]string{txPoolMetrics, "invalid_sponsor_txs"}, 1)
+
+			return fmt.Errorf("%w: %s", ErrInvalidSponsor, err.Error())
+		}
+	}
+
+	if tx.Type.HasDynamicFees() {
 		// Reject dynamic fee tx if london hardfork is not enabled
 		if !p.forks.London {
 			metrics.IncrCounter([]string{txPoolMetrics, "invalid_tx_type"}, 1)
@@ -643,9 +867,10 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 
 			return ErrUnderpriced
 		}
-	} else {
-		// Legacy approach to check if the given tx is not underpriced
-		if tx.GetGasPrice(p.GetBaseFee()).Cmp(big.NewInt(0).SetUint64(p.priceLimit)) < 0 {
+	} else if !p.priorityLane.contains(tx) {
+		// Legacy approach to check if the given tx is not underpriced.
+		// The priority lane transactions are not subject to the price limit
+		if tx.GetGasPrice(p.GetBaseFee()).Cmp(big.NewInt(0).SetUint64(atomic.LoadUint64(&p.priceLimit))) < 0 {
 			metrics.IncrCounter([]string{txPoolMetrics, "underpriced_tx"}, 1)
 
 			return ErrUnderpriced
@@ -669,15 +894,35 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		return ErrInvalidAccountState
 	}
 
-	// Check if the sender has enough funds to execute the transaction
-	if accountBalance.Cmp(tx.Cost()) < 0 {
+	if tx.Type == types.SponsoredTx {
+		// The sender pays the value, while the sponsor pays the gas
+		if accountBalance.Cmp(tx.Value) < 0 {
+			metrics.IncrCounter([]string{txPoolMetrics, "insufficient_funds_tx"}, 1)
+
+			return ErrInsufficientFunds
+		}
+
+		sponsorBalance, balanceErr := p.store.GetBalance(stateRoot, *tx.Sponsor)
+		if balanceErr != nil {
+			metrics.IncrCounter([]string{txPoolMetrics, "invalid_account_state_tx"}, 1)
+
+			return ErrInvalidAccountState
+		}
+
+		if sponsorBalance.Cmp(tx.GasCost()) < 0 {
+			metrics.IncrCounter([]string{txPoolMetrics, "insufficient_sponsor_funds_tx"}, 1)
+
+			return ErrInsufficientSponsorFunds
+		}
+	} else if accountBalance.Cmp(tx.Cost()) < 0 {
+		// Check if the sender has enough funds to execute the transaction
 		metrics.IncrCounter([]string{txPoolMetrics, "insufficient_funds_tx"}, 1)
 
 		return ErrInsufficientFunds
 	}
 
 	// Make sure the transaction has more gas than the basic transaction fee
-	intrinsicGas, err := state.TransactionGasCost(tx, p.forks.Homestead, p.forks.Istanbul)
+	intrinsicGas, err := state.TransactionGasCost(tx, p.forks.Homestead, p.forks.Istanbul, p.forks.Berlin)
 	if err != nil {
 		metrics.IncrCounter([]string{txPoolMetrics, "invalid_intrinsic_gas_tx"}, 1)
 
@@ -709,43 +954,21 @@ func (p *TxPool) signalPruning() {
 	}
 }
 
-func (p *TxPool) pruneAccountsWithNonceHoles() {
-	p.accounts.Range(
-		func(_, value interface{}) bool {
-			account, _ := value.(*account)
-
-			account.enqueued.lock(true)
-			defer account.enqueued.unlock()
-
-			account.nonceToTx.lock()
-			defer account.nonceToTx.unlock()
-
-			firstTx := account.enqueued.peek()
-
-			if firstTx == nil {
-				return true
-			}
-
-			if firstTx.Nonce == account.getNonce() {
-				return true
-			}
-
-			removed := account.enqueued.clear()
-
-			account.nonceToTx.remove(removed...)
-			p.index.remove(removed...)
-			p.gauge.decrease(slotsRequired(removed...))
-
-			return true
-		},
-	)
-}
-
 // addTx is the main entry point to the pool
 // for all new transactions. If the call is
 // successful, an account is created for this address
 // (only once) and an enqueueRequest is signaled.
-func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
+func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) (err error) {
+	_, span := tracer.Start(context.Background(), "txpool.addTx",
+		trace.WithAttributes(attribute.String("tx.origin", origin.String())))
+	defer func() {
+		tracing.EndSpan(span, err)
+
+		if tuner := p.autoTuner.Load(); tuner != nil && err != nil {
+			tuner.recordRejection(err)
+		}
+	}()
+
 	if p.logger.IsDebug() {
 		p.logger.Debug("add tx", "origin", origin.String(), "hash", tx.Hash.String())
 	}
@@ -755,18 +978,31 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		return err
 	}
 
-	// add chainID to the tx - only dynamic fee tx
-	if tx.Type == types.DynamicFeeTx {
+	// add chainID to the tx - only typed tx
+	if tx.Type.HasAccessList() {
 		tx.ChainID = p.chainID
 	}
 
 	// calculate tx hash
 	tx.ComputeHash(p.store.Header().Number)
 
+	span.SetAttributes(attribute.String("tx.hash", tx.Hash.String()))
+
+	// sample the transactions once the ingress rate exceeds the limit.
+	// Already known transactions (e.g. gossiped by multiple peers) are not counted
+	if admission := p.admission.Load(); admission != nil && !p.priorityLane.contains(tx) {
+		if _, known := p.index.get(tx.Hash); !known && !admission.admit(tx.From) {
+			metrics.IncrCounter([]string{txPoolMetrics, "sampled_out_txs"}, 1)
+
+			return ErrSampledOut
+		}
+	}
+
 	// initialize account for this address once or retrieve existing one
 	account := p.getOrCreateAccount(tx.From)
-	// populate currently free slots
-	slotsFree := p.gauge.freeSlots()
+	// populate currently free slots, the priority lane transactions are accounted in their own quota
+	gauge := p.slotGaugeFor(tx)
+	slotsFree := gauge.freeSlots()
 
 	account.promoted.lock(true)
 	account.enqueued.lock(true)
@@ -780,8 +1016,11 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 
 	accountNonce := account.getNonce()
 
+	// enqueued transaction evicted in favor of the new one
+	var evicted *types.Transaction
+
 	//	only accept transactions with expected nonce
-	if p.gauge.highPressure() {
+	if gauge.highPressure() {
 		p.signalPruning()
 
 		if tx.Nonce > accountNonce {
@@ -803,16 +1042,27 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 			return ErrUnderpriced
 		}
 
-		slotsFree += slotsRequired(oldTxWithSameNonce) // add old tx slots
-	} else {
-		if account.enqueued.length() == account.maxEnqueued {
-			return ErrMaxEnqueuedLimitReached
+		if p.slotGaugeFor(oldTxWithSameNonce) == gauge {
+			slotsFree += slotsRequired(oldTxWithSameNonce) // add old tx slots
 		}
-
+	} else {
 		// reject low nonce tx
 		if tx.Nonce < accountNonce {
 			return ErrNonceTooLow
 		}
+
+		if account.enqueued.length() >= account.maxEnqueued {
+			// the enqueued queue is full, a transaction closer to the account nonce
+			// replaces the one furthest from it (highest nonce)
+			evicted = account.enqueued.last()
+			if evicted == nil || tx.Nonce >= evicted.Nonce {
+				return ErrMaxEnqueuedLimitReached
+			}
+
+			if p.slotGaugeFor(evicted) == gauge {
+				slotsFree += slotsRequired(evicted)
+			}
+		}
 	}
 
 	// check for overflow
@@ -827,15 +1077,30 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		return ErrAlreadyKnown
 	}
 
+	if evicted != nil {
+		account.enqueued.remove(evicted)
+		account.nonceToTx.remove(evicted)
+		p.index.remove(evicted)
+		p.releaseSlots(evicted)
+
+		metrics.IncrCounter([]string{txPoolMetrics, "evicted_future_tx"}, 1)
+
+		p.eventManager.signalEvent(proto.EventType_PRUNED_ENQUEUED, evicted.Hash)
+	}
+
 	if oldTxWithSameNonce != nil {
 		p.index.remove(oldTxWithSameNonce)
-		p.gauge.decrease(slotsRequired(oldTxWithSameNonce))
+		p.releaseSlots(oldTxWithSameNonce)
 	} else {
 		metrics.SetGauge([]string{txPoolMetrics, "added_tx"}, 1)
 	}
 
+	if p.priorityLane.contains(tx) {
+		metrics.IncrCounter([]string{txPoolMetrics, "priority_txs"}, 1)
+	}
+
 	account.enqueue(tx, oldTxWithSameNonce != nil) // add or replace tx into account
-	p.gauge.increase(slotsRequired(tx))
+	gauge.increase(slotsRequired(tx))
 
 	go p.invokePromotion(tx, tx.Nonce <= accountNonce) // don't signal promotion for higher nonce txs
 
@@ -873,7 +1138,7 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 	}
 
 	p.index.remove(pruned...)
-	p.gauge.decrease(slotsRequired(pruned...))
+	p.releaseSlots(pruned...)
 
 	// update metrics
 	p.updatePending(int64(len(promoted)))
@@ -958,7 +1223,7 @@ func (p *TxPool) resetAccounts(stateNonces map[types.Address]uint64) {
 	// pool cleanup callback
 	cleanup := func(stale []*types.Transaction) {
 		p.index.remove(stale...)
-		p.gauge.decrease(slotsRequired(stale...))
+		p.releaseSlots(stale...)
 	}
 
 	// prune pool state
@@ -14,6 +14,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/extension"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
@@ -94,6 +95,7 @@ func newTestPoolWithSlots(maxSlots uint64, mockStore ...store) (*TxPool, error)
 		nil,
 		nil,
 		&Config{
+			ChainID:            big.NewInt(100),
 			PriceLimit:         defaultPriceLimit,
 			MaxSlots:           maxSlots,
 			MaxAccountEnqueued: defaultMaxAccountEnqueued,
@@ -155,6 +157,94 @@ func TestAddTxErrors(t *testing.T) {
 		)
 	})
 
+	t.Run("ErrSponsoredTxNotAllowed", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		londonSigner := crypto.NewLondonSigner(100, true, poolSigner)
+		pool.SetSigner(londonSigner)
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.Type = types.SponsoredTx
+		tx.GasFeeCap = tx.GasPrice
+		tx.GasTipCap = tx.GasPrice
+		tx.Sponsor = &addr1
+
+		tx, err := londonSigner.SignTx(tx, defaultKey)
+		require.NoError(t, err)
+
+		// the sponsoredGas fork is not enabled
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrSponsoredTxNotAllowed,
+		)
+	})
+
+	t.Run("ErrAccessListNotAllowed", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		londonSigner := crypto.NewLondonSigner(100, true, poolSigner)
+		pool.SetSigner(londonSigner)
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.Type = types.AccessListTx
+		tx.ChainID = big.NewInt(100)
+		tx.AccessList = types.TxAccessList{{Address: addr1}}
+
+		tx, err := londonSigner.SignTx(tx, defaultKey)
+		require.NoError(t, err)
+
+		// the berlin fork is not enabled
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrAccessListNotAllowed,
+		)
+	})
+
+	t.Run("ErrSampledOut", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		admission := newAdmissionSampler(1, 0.1)
+		admission.random = func() float64 { return 0.99 }
+		pool.admission.Store(admission)
+
+		assert.NoError(t, pool.addTx(local, signTx(newTx(defaultAddr, 0, 1))))
+
+		// already known transactions are not sampled
+		assert.ErrorIs(t,
+			pool.addTx(local, signTx(newTx(defaultAddr, 1, 1))),
+			ErrSampledOut,
+		)
+	})
+
+	t.Run("ErrInvalidChainID", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		// legacy transaction signed for another chain
+		tx, err := crypto.NewEIP155Signer(101, true).SignTx(newTx(defaultAddr, 0, 1), defaultKey)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrInvalidChainID,
+		)
+
+		// dynamic fee transaction signed for another chain
+		tx = newTx(defaultAddr, 0, 1)
+		tx.Type = types.DynamicFeeTx
+		tx.GasFeeCap = tx.GasPrice
+		tx.GasTipCap = tx.GasPrice
+		tx.ChainID = big.NewInt(101)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrInvalidChainID,
+		)
+	})
+
 	t.Run("ErrNegativeValue", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
@@ -375,6 +465,124 @@ func TestAddTxErrors(t *testing.T) {
 			ErrInsufficientFunds,
 		)
 	})
+
+	t.Run("ErrDeniedSender", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.denyList.set(&DenyList{Senders: []types.Address{defaultAddr}})
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrDeniedSender,
+		)
+	})
+
+	t.Run("ErrDeniedRecipient", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.denyList.set(&DenyList{Recipients: []types.Address{addr1}})
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = &addr1
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrDeniedRecipient,
+		)
+	})
+
+	t.Run("ErrDeniedSelector", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.denyList.set(&DenyList{Selectors: []Selector{{0xa9, 0x05, 0x9c, 0xbb}}})
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = &addr1
+		tx.Input = []byte{0xa9, 0x05, 0x9c, 0xbb, 0x1}
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrDeniedSelector,
+		)
+
+		// contract creation is not checked against denied selectors
+		tx = newTx(defaultAddr, 0, 1)
+		tx.Input = []byte{0xa9, 0x05, 0x9c, 0xbb, 0x1}
+		tx = signTx(tx)
+
+		assert.NoError(t, pool.addTx(local, tx))
+	})
+	t.Run("ErrRejectedByExtension", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		errCallsDenied := errors.New("contract calls are denied")
+		pool.validators = []extension.TxValidator{func(tx *types.Transaction) error {
+			if tx.To != nil && len(tx.Input) > 0 {
+				return errCallsDenied
+			}
+
+			return nil
+		}}
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = &addr1
+		tx.Input = []byte{0x1}
+		tx = signTx(tx)
+
+		err := pool.addTx(local, tx)
+		assert.ErrorIs(t, err, ErrRejectedByExtension)
+		assert.ErrorContains(t, err, errCallsDenied.Error())
+
+		tx = newTx(defaultAddr, 0, 1)
+		tx = signTx(tx)
+
+		assert.NoError(t, pool.addTx(local, tx))
+	})
+}
+
+func TestDenyListOperator(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	denyList, err := pool.DenyListGet(context.Background(), nil)
+	require.NoError(t, err)
+	require.Empty(t, denyList.Senders)
+	require.Empty(t, denyList.Recipients)
+	require.Empty(t, denyList.Selectors)
+
+	req := &proto.DenyList{
+		Senders:    []string{addr1.String()},
+		Recipients: []string{addr2.String(), addr3.String()},
+		Selectors:  []string{"0xa9059cbb"},
+	}
+
+	denyList, err = pool.DenyListSet(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, req.Senders, denyList.Senders)
+	require.ElementsMatch(t, req.Recipients, denyList.Recipients)
+	require.Equal(t, req.Selectors, denyList.Selectors)
+
+	require.ErrorIs(t, pool.denyList.check(&types.Transaction{From: addr1}), ErrDeniedSender)
+	require.ErrorIs(t, pool.denyList.check(&types.Transaction{From: addr4, To: &addr3}), ErrDeniedRecipient)
+	require.NoError(t, pool.denyList.check(&types.Transaction{From: addr4, To: &addr4}))
+
+	// invalid selector is rejected
+	_, err = pool.DenyListSet(context.Background(), &proto.DenyList{Selectors: []string{"0xa9"}})
+	require.Error(t, err)
+
+	// deny list is cleared
+	denyList, err = pool.DenyListSet(context.Background(), &proto.DenyList{})
+	require.NoError(t, err)
+	require.Empty(t, denyList.Senders)
+	require.NoError(t, pool.denyList.check(&types.Transaction{From: addr1}))
 }
 
 func TestPruneAccountsWithNonceHoles(t *testing.T) {
@@ -459,6 +667,9 @@ func TestPruneAccountsWithNonceHoles(t *testing.T) {
 				pool.accounts.get(addr1).enqueued.peek().Nonce,
 			)
 
+			//	mock high pressure
+			pool.gauge.max = 1
+
 			pool.pruneAccountsWithNonceHoles()
 
 			assert.Equal(t, uint64(0), pool.gauge.read())
@@ -2084,6 +2295,26 @@ func Test_TxPool_validateTx(t *testing.T) {
 		)
 	})
 
+	t.Run("tx input larger than the configured max init code size", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.forks.EIP158 = true
+		pool.maxInitCodeSize = 100
+
+		input := make([]byte, 101)
+		_, err := rand.Read(input)
+		require.NoError(t, err)
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = nil
+		tx.Input = input
+
+		assert.ErrorIs(t,
+			pool.validateTx(signTx(tx)),
+			runtime.ErrMaxCodeSizeExceeded,
+		)
+	})
+
 	t.Run("transaction with eip-1559 fields can pass", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,127 @@
+package txrelayer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/jsonrpc"
+)
+
+const (
+	// MinBumpPercent is the minimal fee bump, which the nodes accept for replacing the pending transaction
+	MinBumpPercent = 10
+	// DefaultBumpPercent is the default fee bump of the stuck transaction
+	DefaultBumpPercent = 20
+
+	txRelayerMetricsPrefix = "txrelayer"
+)
+
+var (
+	errInvalidBumpBlocks  = errors.New("fee bump blocks must be greater than zero")
+	errInvalidBumpPercent = fmt.Errorf("fee bump percent must be at least %d", MinBumpPercent)
+)
+
+// FeeBumpConfig is the configuration of the EIP-1559 fee management of the relayed transactions.
+// The transaction which is not included for BumpBlocks blocks is resubmitted with the same nonce
+// and with the priority fee and the fee cap bumped by BumpPercent, until the fee cap reaches MaxFeePerGas
+type FeeBumpConfig struct {
+	// BumpBlocks is the number of blocks the transaction can stay pending before its fees are bumped
+	BumpBlocks uint64
+
+	// BumpPercent is the percentage the priority fee and the fee cap are bumped by
+	BumpPercent uint64
+
+	// MaxFeePerGas is the cap of the fee per gas, the fees are not bumped above it. Nil means no cap
+	MaxFeePerGas *big.Int
+}
+
+// Validate validates the fee bump configuration
+func (c *FeeBumpConfig) Validate() error {
+	if c.BumpBlocks == 0 {
+		return errInvalidBumpBlocks
+	}
+
+	if c.BumpPercent < MinBumpPercent {
+		return errInvalidBumpPercent
+	}
+
+	return nil
+}
+
+// estimateFees estimates the priority fee and the fee cap of the dynamic fee transaction.
+// The fee cap covers the doubled base fee of the next block, so the transaction stays includable
+// for several blocks of the increasing base fee
+func estimateFees(client *jsonrpc.Client, maxFeePerGas *big.Int) (*big.Int, *big.Int, error) {
+	history, err := client.Eth().FeeHistory(ethgo.Latest, ethgo.Latest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(history.BaseFee) == 0 {
+		return nil, nil, errors.New("fee history has no base fee")
+	}
+
+	// the last base fee in the history is the base fee of the next block
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	var tip ethgo.ArgBig
+	if err := client.Call("eth_maxPriorityFeePerGas", &tip); err != nil {
+		return nil, nil, err
+	}
+
+	return calculateFees(baseFee, (*big.Int)(&tip), maxFeePerGas)
+}
+
+// calculateFees calculates the fee cap from the base fee and the priority fee, capped by the max fee per gas
+func calculateFees(baseFee, tip, maxFeePerGas *big.Int) (*big.Int, *big.Int, error) {
+	feeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+	feeCap.Add(feeCap, tip)
+
+	if maxFeePerGas != nil && feeCap.Cmp(maxFeePerGas) > 0 {
+		if baseFee.Cmp(maxFeePerGas) > 0 {
+			return nil, nil, fmt.Errorf("base fee %s is above the max fee per gas %s", baseFee, maxFeePerGas)
+		}
+
+		feeCap = new(big.Int).Set(maxFeePerGas)
+	}
+
+	return minBig(tip, feeCap), feeCap, nil
+}
+
+// bumpFees bumps the priority fee and the fee cap by the given percent, where the fee cap is capped
+// by the max fee per gas. It returns false if the fee cap is already at the cap, so the fees can't be bumped
+func bumpFees(tip, feeCap *big.Int, percent uint64, maxFeePerGas *big.Int) (*big.Int, *big.Int, bool) {
+	bump := func(value *big.Int) *big.Int {
+		bumped := new(big.Int).Mul(value, new(big.Int).SetUint64(100+percent))
+		bumped.Div(bumped, big.NewInt(100))
+
+		// bump the small values by at least one wei
+		if bumped.Cmp(value) == 0 {
+			bumped.Add(bumped, big.NewInt(1))
+		}
+
+		return bumped
+	}
+
+	newFeeCap := bump(feeCap)
+
+	if maxFeePerGas != nil && newFeeCap.Cmp(maxFeePerGas) > 0 {
+		if feeCap.Cmp(maxFeePerGas) >= 0 {
+			return tip, feeCap, false
+		}
+
+		newFeeCap = new(big.Int).Set(maxFeePerGas)
+	}
+
+	return minBig(bump(tip), newFeeCap), newFeeCap, true
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return new(big.Int).Set(a)
+	}
+
+	return new(big.Int).Set(b)
+}
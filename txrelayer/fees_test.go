@@ -0,0 +1,70 @@
+package txrelayer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeBumpConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (&FeeBumpConfig{BumpBlocks: 3, BumpPercent: DefaultBumpPercent}).Validate())
+	require.ErrorIs(t, (&FeeBumpConfig{BumpPercent: DefaultBumpPercent}).Validate(), errInvalidBumpBlocks)
+	require.ErrorIs(t, (&FeeBumpConfig{BumpBlocks: 3, BumpPercent: MinBumpPercent - 1}).Validate(),
+		errInvalidBumpPercent)
+}
+
+func TestCalculateFees(t *testing.T) {
+	t.Parallel()
+
+	// the fee cap covers the doubled base fee
+	tip, feeCap, err := calculateFees(big.NewInt(100), big.NewInt(10), nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), tip)
+	require.Equal(t, big.NewInt(210), feeCap)
+
+	// the fee cap is capped
+	tip, feeCap, err = calculateFees(big.NewInt(100), big.NewInt(10), big.NewInt(150))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), tip)
+	require.Equal(t, big.NewInt(150), feeCap)
+
+	// the tip doesn't exceed the fee cap
+	tip, feeCap, err = calculateFees(big.NewInt(100), big.NewInt(200), big.NewInt(150))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(150), tip)
+	require.Equal(t, big.NewInt(150), feeCap)
+
+	// the base fee is above the cap
+	_, _, err = calculateFees(big.NewInt(200), big.NewInt(10), big.NewInt(150))
+	require.ErrorContains(t, err, "above the max fee per gas")
+}
+
+func TestBumpFees(t *testing.T) {
+	t.Parallel()
+
+	tip, feeCap, bumped := bumpFees(big.NewInt(10), big.NewInt(200), 20, nil)
+	require.True(t, bumped)
+	require.Equal(t, big.NewInt(12), tip)
+	require.Equal(t, big.NewInt(240), feeCap)
+
+	// the small values are bumped by at least one wei
+	tip, feeCap, bumped = bumpFees(big.NewInt(1), big.NewInt(2), 20, nil)
+	require.True(t, bumped)
+	require.Equal(t, big.NewInt(2), tip)
+	require.Equal(t, big.NewInt(3), feeCap)
+
+	// the fee cap is bumped up to the cap
+	tip, feeCap, bumped = bumpFees(big.NewInt(10), big.NewInt(200), 20, big.NewInt(220))
+	require.True(t, bumped)
+	require.Equal(t, big.NewInt(12), tip)
+	require.Equal(t, big.NewInt(220), feeCap)
+
+	// the fee cap is at the cap, so the fees can't be bumped
+	tip, feeCap, bumped = bumpFees(big.NewInt(12), big.NewInt(220), 20, big.NewInt(220))
+	require.False(t, bumped)
+	require.Equal(t, big.NewInt(12), tip)
+	require.Equal(t, big.NewInt(220), feeCap)
+}
@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/jsonrpc"
 	"github.com/umbracle/ethgo/wallet"
@@ -48,12 +50,17 @@ type TxRelayerImpl struct {
 	lock sync.Mutex
 
 	writer io.Writer
+	logger hclog.Logger
+
+	// feeBump enables the EIP-1559 fees and the bumping of the stuck transactions, nil if disabled
+	feeBump *FeeBumpConfig
 }
 
 func NewTxRelayer(opts ...TxRelayerOption) (TxRelayer, error) {
 	t := &TxRelayerImpl{
 		ipAddress:      DefaultRPCAddress,
 		receiptTimeout: 50 * time.Millisecond,
+		logger:         hclog.NewNullLogger(),
 	}
 	for _, opt := range opts {
 		opt(t)
@@ -84,6 +91,10 @@ func (t *TxRelayerImpl) Call(from ethgo.Address, to ethgo.Address, input []byte)
 
 // SendTransaction signs given transaction by provided key and sends it to the blockchain
 func (t *TxRelayerImpl) SendTransaction(txn *ethgo.Transaction, key ethgo.Key) (*ethgo.Receipt, error) {
+	if t.isFeeBumpingEnabled(txn) {
+		return t.sendWithFeeBumping(txn, key)
+	}
+
 	txnHash, err := t.sendTransactionLocked(txn, key)
 	if err != nil {
 		return nil, err
@@ -112,7 +123,22 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *ethgo.Transaction, key ethgo.
 
 	txn.From = key.Address()
 
-	if txn.GasPrice == 0 {
+	chainID, err := t.client.Eth().ChainID()
+	if err != nil {
+		return ethgo.ZeroHash, err
+	}
+
+	if t.isFeeBumpingEnabled(txn) {
+		tip, feeCap, err := estimateFees(t.client, t.feeBump.MaxFeePerGas)
+		if err != nil {
+			return ethgo.ZeroHash, err
+		}
+
+		txn.Type = ethgo.TransactionDynamicFee
+		txn.ChainID = chainID
+		txn.MaxPriorityFeePerGas = tip
+		txn.MaxFeePerGas = feeCap
+	} else if txn.GasPrice == 0 {
 		gasPrice, err := t.Client().Eth().GasPrice()
 		if err != nil {
 			return ethgo.ZeroHash, err
@@ -130,13 +156,15 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *ethgo.Transaction, key ethgo.
 		txn.Gas = gasLimit + (gasLimit * gasLimitPercent / 100)
 	}
 
-	chainID, err := t.client.Eth().ChainID()
-	if err != nil {
-		return ethgo.ZeroHash, err
-	}
+	return t.signAndSend(txn, key, chainID)
+}
 
+// signAndSend signs the transaction and sends it to the blockchain
+func (t *TxRelayerImpl) signAndSend(txn *ethgo.Transaction, key ethgo.Key, chainID *big.Int) (ethgo.Hash, error) {
 	signer := wallet.NewEIP155Signer(chainID.Uint64())
-	if txn, err = signer.SignTx(txn, key); err != nil {
+
+	txn, err := signer.SignTx(txn, key)
+	if err != nil {
 		return ethgo.ZeroHash, err
 	}
 
@@ -146,14 +174,119 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *ethgo.Transaction, key ethgo.
 	}
 
 	if t.writer != nil {
-		_, _ = t.writer.Write([]byte(
-			fmt.Sprintf("[TxRelayer.SendTransaction]\nFrom = %s \nGas = %d \nGas Price = %d\n",
-				txn.From, txn.Gas, txn.GasPrice)))
+		if txn.Type == ethgo.TransactionDynamicFee {
+			_, _ = t.writer.Write([]byte(
+				fmt.Sprintf("[TxRelayer.SendTransaction]\nFrom = %s \nGas = %d \nMax Fee = %s \nMax Priority Fee = %s\n",
+					txn.From, txn.Gas, txn.MaxFeePerGas, txn.MaxPriorityFeePerGas)))
+		} else {
+			_, _ = t.writer.Write([]byte(
+				fmt.Sprintf("[TxRelayer.SendTransaction]\nFrom = %s \nGas = %d \nGas Price = %d\n",
+					txn.From, txn.Gas, txn.GasPrice)))
+		}
 	}
 
 	return t.client.Eth().SendRawTransaction(data)
 }
 
+// isFeeBumpingEnabled returns true if the transaction is sent with the EIP-1559 fees, bumped if it gets stuck.
+// The transactions with the explicitly set gas price are sent as they are
+func (t *TxRelayerImpl) isFeeBumpingEnabled(txn *ethgo.Transaction) bool {
+	return t.feeBump != nil && txn.GasPrice == 0 && txn.Type == ethgo.TransactionLegacy
+}
+
+// sendWithFeeBumping sends the dynamic fee transaction, and resubmits it with the same nonce and the bumped fees
+// each time it is not included within the configured number of blocks. Once the fee cap is reached,
+// the alert is raised and the transaction is awaited with its last fees
+func (t *TxRelayerImpl) sendWithFeeBumping(txn *ethgo.Transaction, key ethgo.Key) (*ethgo.Receipt, error) {
+	txnHash, err := t.sendTransactionLocked(txn, key)
+	if err != nil {
+		return nil, err
+	}
+
+	sentAt, err := t.client.Eth().BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	// the receipt can belong to any of the submitted replacements
+	hashes := []ethgo.Hash{txnHash}
+	capped := false
+	count := uint(0)
+
+	for {
+		for _, hash := range hashes {
+			receipt, err := t.client.Eth().GetTransactionReceipt(hash)
+			if err != nil && err.Error() != "not found" {
+				return nil, err
+			}
+
+			if receipt != nil {
+				return receipt, nil
+			}
+		}
+
+		if count > numRetries {
+			return nil, fmt.Errorf("timeout while waiting for transaction %s to be processed", hashes[len(hashes)-1])
+		}
+
+		block, err := t.client.Eth().BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+
+		if !capped && block >= sentAt+t.feeBump.BumpBlocks {
+			hash, bumped, err := t.bumpTransactionLocked(txn, key)
+			if err != nil {
+				// the replacement might be rejected if the original transaction has just been included
+				t.logger.Warn("failed to resubmit the transaction with the bumped fees",
+					"hash", hashes[len(hashes)-1], "nonce", txn.Nonce, "error", err)
+			} else if bumped {
+				hashes = append(hashes, hash)
+			}
+
+			if !bumped {
+				capped = true
+
+				metrics.IncrCounter([]string{txRelayerMetricsPrefix, "fee_cap_reached"}, 1)
+				t.logger.Error("transaction is stuck with the fee cap reached",
+					"hash", hashes[len(hashes)-1], "nonce", txn.Nonce,
+					"max fee per gas", txn.MaxFeePerGas, "pending blocks", block-sentAt)
+			}
+
+			sentAt = block
+			count = 0
+		}
+
+		time.Sleep(t.receiptTimeout)
+		count++
+	}
+}
+
+// bumpTransactionLocked resubmits the transaction with the bumped fees.
+// It returns false if the fees can't be bumped, since the fee cap is reached
+func (t *TxRelayerImpl) bumpTransactionLocked(txn *ethgo.Transaction, key ethgo.Key) (ethgo.Hash, bool, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	tip, feeCap, bumped := bumpFees(txn.MaxPriorityFeePerGas, txn.MaxFeePerGas,
+		t.feeBump.BumpPercent, t.feeBump.MaxFeePerGas)
+	if !bumped {
+		return ethgo.ZeroHash, false, nil
+	}
+
+	t.logger.Info("bumping the fees of the stuck transaction", "nonce", txn.Nonce,
+		"max priority fee per gas", tip, "max fee per gas", feeCap)
+
+	txn.MaxPriorityFeePerGas = tip
+	txn.MaxFeePerGas = feeCap
+
+	metrics.IncrCounter([]string{txRelayerMetricsPrefix, "fee_bumps"}, 1)
+
+	hash, err := t.signAndSend(txn, key, txn.ChainID)
+
+	return hash, true, err
+}
+
 // SendTransactionLocal sends non-signed transaction
 // (this function is meant only for testing purposes and is about to be removed at some point)
 func (t *TxRelayerImpl) SendTransactionLocal(txn *ethgo.Transaction) (*ethgo.Receipt, error) {
@@ -240,6 +373,19 @@ func WithReceiptTimeout(receiptTimeout time.Duration) TxRelayerOption {
 	}
 }
 
+// WithFeeBumping enables the EIP-1559 fees and the bumping of the fees of the stuck transactions
+func WithFeeBumping(config *FeeBumpConfig) TxRelayerOption {
+	return func(t *TxRelayerImpl) {
+		t.feeBump = config
+	}
+}
+
+func WithLogger(logger hclog.Logger) TxRelayerOption {
+	return func(t *TxRelayerImpl) {
+		t.logger = logger
+	}
+}
+
 func WithWriter(writer io.Writer) TxRelayerOption {
 	return func(t *TxRelayerImpl) {
 		t.writer = writer
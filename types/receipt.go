@@ -31,6 +31,19 @@ type Receipt struct {
 	TxHash          Hash
 
 	TransactionType TxType
+
+	// ContractCreations are the contracts created by the transaction, including the contracts
+	// created by the other contracts. They are filled by the executor and not persisted
+	ContractCreations []*ContractCreation
+}
+
+// ContractCreation is the creation of a contract, the block fields are set once the block is imported
+type ContractCreation struct {
+	Address     Address
+	Creator     Address
+	TxHash      Hash
+	BlockHash   Hash
+	BlockNumber uint64
 }
 
 func (r *Receipt) IsLegacyTx() bool {
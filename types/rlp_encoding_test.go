@@ -155,6 +155,7 @@ func TestRLPMarshall_And_Unmarshall_TypedTransaction(t *testing.T) {
 	txTypes := []TxType{
 		StateTx,
 		LegacyTx,
+		AccessListTx,
 		DynamicFeeTx,
 	}
 
@@ -175,6 +176,88 @@ func TestRLPMarshall_And_Unmarshall_TypedTransaction(t *testing.T) {
 	}
 }
 
+func TestRLPMarshall_And_Unmarshall_SponsoredTransaction(t *testing.T) {
+	addrTo := StringToAddress("11")
+	sponsor := StringToAddress("33")
+	originalTx := &Transaction{
+		Type:      SponsoredTx,
+		ChainID:   big.NewInt(100),
+		Nonce:     1,
+		GasFeeCap: big.NewInt(12),
+		GasTipCap: big.NewInt(13),
+		Gas:       11,
+		To:        &addrTo,
+		Value:     big.NewInt(1),
+		Input:     []byte{1, 2},
+		V:         big.NewInt(1),
+		S:         big.NewInt(26),
+		R:         big.NewInt(27),
+		Sponsor:   &sponsor,
+		SponsorV:  big.NewInt(0),
+		SponsorR:  big.NewInt(28),
+		SponsorS:  big.NewInt(29),
+	}
+	originalTx.ComputeHash(1)
+
+	unmarshalledTx := new(Transaction)
+	assert.NoError(t, unmarshalledTx.UnmarshalRLP(originalTx.MarshalRLP()))
+
+	unmarshalledTx.ComputeHash(1)
+	assert.Equal(t, originalTx.Hash, unmarshalledTx.Hash)
+	assert.Equal(t, SponsoredTx, unmarshalledTx.Type)
+	assert.Equal(t, sponsor, *unmarshalledTx.Sponsor)
+	assert.Equal(t, sponsor, unmarshalledTx.GasPayer())
+	assert.Equal(t, originalTx.SponsorR, unmarshalledTx.SponsorR)
+	assert.Equal(t, originalTx.SponsorS, unmarshalledTx.SponsorS)
+	assert.Equal(t, originalTx.GasTipCap, unmarshalledTx.GetGasTipCap())
+}
+
+func TestRLPMarshall_And_Unmarshall_AccessList(t *testing.T) {
+	addrTo := StringToAddress("11")
+	originalTx := &Transaction{
+		Type:      DynamicFeeTx,
+		ChainID:   big.NewInt(100),
+		Nonce:     1,
+		GasFeeCap: big.NewInt(12),
+		GasTipCap: big.NewInt(13),
+		Gas:       11,
+		To:        &addrTo,
+		Value:     big.NewInt(1),
+		Input:     []byte{1, 2},
+		V:         big.NewInt(1),
+		S:         big.NewInt(26),
+		R:         big.NewInt(27),
+		AccessList: TxAccessList{
+			{Address: StringToAddress("44"), StorageKeys: []Hash{StringToHash("1"), StringToHash("2")}},
+			{Address: StringToAddress("55"), StorageKeys: []Hash{}},
+		},
+	}
+	originalTx.ComputeHash(1)
+
+	unmarshalledTx := new(Transaction)
+	assert.NoError(t, unmarshalledTx.UnmarshalRLP(originalTx.MarshalRLP()))
+
+	unmarshalledTx.ComputeHash(1)
+	assert.Equal(t, originalTx.Hash, unmarshalledTx.Hash)
+	assert.Equal(t, originalTx.AccessList, unmarshalledTx.AccessList)
+	assert.Equal(t, 2, unmarshalledTx.AccessList.StorageKeys())
+
+	// the access list transaction carries the gas price instead of the dynamic fees
+	originalTx.Type = AccessListTx
+	originalTx.GasPrice = big.NewInt(14)
+	originalTx.ComputeHash(1)
+
+	unmarshalledTx = new(Transaction)
+	assert.NoError(t, unmarshalledTx.UnmarshalRLP(originalTx.MarshalRLP()))
+
+	unmarshalledTx.ComputeHash(1)
+	assert.Equal(t, originalTx.Hash, unmarshalledTx.Hash)
+	assert.Equal(t, AccessListTx, unmarshalledTx.Type)
+	assert.Equal(t, originalTx.ChainID, unmarshalledTx.ChainID)
+	assert.Equal(t, originalTx.GasPrice, unmarshalledTx.GasPrice)
+	assert.Equal(t, originalTx.AccessList, unmarshalledTx.AccessList)
+}
+
 func TestRLPMarshall_Unmarshall_Missing_Data(t *testing.T) {
 	t.Parallel()
 
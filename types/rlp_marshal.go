@@ -174,6 +174,26 @@ func (l *Log) MarshalRLPWith(a *fastrlp.Arena) *fastrlp.Value {
 	return v
 }
 
+// MarshalRLPWith marshals the access list to RLP with a specific fastrlp.Arena
+func (al TxAccessList) MarshalRLPWith(a *fastrlp.Arena) *fastrlp.Value {
+	v := a.NewArray()
+
+	for _, tuple := range al {
+		vv := a.NewArray()
+		vv.Set(a.NewCopyBytes(tuple.Address.Bytes()))
+
+		keys := a.NewArray()
+		for _, key := range tuple.StorageKeys {
+			keys.Set(a.NewCopyBytes(key.Bytes()))
+		}
+
+		vv.Set(keys)
+		v.Set(vv)
+	}
+
+	return v
+}
+
 func (t *Transaction) MarshalRLP() []byte {
 	return t.MarshalRLPTo(nil)
 }
@@ -193,13 +213,13 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv := arena.NewArray()
 
 	// Check Transaction1559Payload there https://eips.ethereum.org/EIPS/eip-1559#specification
-	if t.Type == DynamicFeeTx {
+	if t.Type.HasAccessList() {
 		vv.Set(arena.NewBigInt(t.ChainID))
 	}
 
 	vv.Set(arena.NewUint(t.Nonce))
 
-	if t.Type == DynamicFeeTx {
+	if t.Type.HasDynamicFees() {
 		// Add EIP-1559 related fields.
 		// For non-dynamic-fee-tx gas price is used.
 		vv.Set(arena.NewBigInt(t.GasTipCap))
@@ -221,11 +241,22 @@ func (t *Transaction) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
 	vv.Set(arena.NewCopyBytes(t.Input))
 
 	// Specify access list as per spec.
-	// This is needed to have the same format as other EVM chains do.
-	// There is no access list feature here, so it is always empty just to be compatible.
 	// Check Transaction1559Payload there https://eips.ethereum.org/EIPS/eip-1559#specification
-	if t.Type == DynamicFeeTx {
-		vv.Set(arena.NewArray())
+	if t.Type.HasAccessList() {
+		vv.Set(t.AccessList.MarshalRLPWith(arena))
+	}
+
+	// the sponsor and its signature, the sponsor signature is not covered by the sender signature
+	if t.Type == SponsoredTx {
+		if t.Sponsor != nil {
+			vv.Set(arena.NewCopyBytes(t.Sponsor.Bytes()))
+		} else {
+			vv.Set(arena.NewNull())
+		}
+
+		vv.Set(arena.NewBigInt(t.SponsorV))
+		vv.Set(arena.NewBigInt(t.SponsorR))
+		vv.Set(arena.NewBigInt(t.SponsorS))
 	}
 
 	// signature values
@@ -361,6 +361,56 @@ func (l *Log) unmarshalRLPFrom(_ *fastrlp.Parser, v *fastrlp.Value) error {
 	return nil
 }
 
+func (al *TxAccessList) unmarshalRLPFrom(_ *fastrlp.Parser, v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	if len(elems) == 0 {
+		*al = nil
+
+		return nil
+	}
+
+	result := make(TxAccessList, len(elems))
+
+	for i, elem := range elems {
+		tupleElems, err := elem.GetElems()
+		if err != nil {
+			return err
+		}
+
+		if len(tupleElems) != 2 {
+			return fmt.Errorf("incorrect number of elements to decode access tuple, expected 2 but found %d",
+				len(tupleElems))
+		}
+
+		// address
+		if err = tupleElems[0].GetAddr(result[i].Address[:]); err != nil {
+			return err
+		}
+
+		// storage keys
+		keyElems, err := tupleElems[1].GetElems()
+		if err != nil {
+			return err
+		}
+
+		result[i].StorageKeys = make([]Hash, len(keyElems))
+
+		for j, key := range keyElems {
+			if err = key.GetHash(result[i].StorageKeys[j][:]); err != nil {
+				return err
+			}
+		}
+	}
+
+	*al = result
+
+	return nil
+}
+
 // UnmarshalRLP unmarshals transaction from byte slice
 // Caution: Hash calculation should be done from the outside!
 func (t *Transaction) UnmarshalRLP(input []byte) error {
@@ -407,8 +457,12 @@ func (t *Transaction) unmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		num = 9
 	case StateTx:
 		num = 10
+	case AccessListTx:
+		num = 11
 	case DynamicFeeTx:
 		num = 12
+	case SponsoredTx:
+		num = 16
 	default:
 		return fmt.Errorf("transaction type %d not found", t.Type)
 	}
@@ -417,8 +471,8 @@ func (t *Transaction) unmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		return fmt.Errorf("incorrect number of transaction elements, expected %d but found %d", num, numElems)
 	}
 
-	// Load Chain ID for dynamic transactions
-	if t.Type == DynamicFeeTx {
+	// Load Chain ID for the typed transactions
+	if t.Type.HasAccessList() {
 		t.ChainID = new(big.Int)
 		if err = getElem().GetBigInt(t.ChainID); err != nil {
 			return err
@@ -430,7 +484,7 @@ func (t *Transaction) unmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		return err
 	}
 
-	if t.Type == DynamicFeeTx {
+	if t.Type.HasDynamicFees() {
 		// gasTipCap
 		t.GasTipCap = new(big.Int)
 		if err = getElem().GetBigInt(t.GasTipCap); err != nil {
@@ -476,11 +530,37 @@ func (t *Transaction) unmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) erro
 		return err
 	}
 
-	// Skipping Access List field since we don't support it.
-	// This is needed to be compatible with other EVM chains and have the same format.
-	// Since we don't have access list, just skip it here.
-	if t.Type == DynamicFeeTx {
-		_ = getElem()
+	// access list
+	if t.Type.HasAccessList() {
+		if err = t.AccessList.unmarshalRLPFrom(p, getElem()); err != nil {
+			return err
+		}
+	}
+
+	if t.Type == SponsoredTx {
+		// sponsor
+		if vv, _ := getElem().Bytes(); len(vv) == AddressLength {
+			sponsor := BytesToAddress(vv)
+			t.Sponsor = &sponsor
+		} else {
+			t.Sponsor = nil
+		}
+
+		// sponsor signature
+		t.SponsorV = new(big.Int)
+		if err = getElem().GetBigInt(t.SponsorV); err != nil {
+			return err
+		}
+
+		t.SponsorR = new(big.Int)
+		if err = getElem().GetBigInt(t.SponsorR); err != nil {
+			return err
+		}
+
+		t.SponsorS = new(big.Int)
+		if err = getElem().GetBigInt(t.SponsorS); err != nil {
+			return err
+		}
 	}
 
 	// V
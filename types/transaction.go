@@ -20,14 +20,16 @@ type TxType byte
 const (
 	LegacyTx     TxType = 0x0
 	StateTx      TxType = 0x7f
+	AccessListTx TxType = 0x01
 	DynamicFeeTx TxType = 0x02
+	SponsoredTx  TxType = 0x7e
 )
 
 func txTypeFromByte(b byte) (TxType, error) {
 	tt := TxType(b)
 
 	switch tt {
-	case LegacyTx, StateTx, DynamicFeeTx:
+	case LegacyTx, StateTx, AccessListTx, DynamicFeeTx, SponsoredTx:
 		return tt, nil
 	default:
 		return tt, fmt.Errorf("unknown transaction type: %d", b)
@@ -41,13 +43,27 @@ func (t TxType) String() (s string) {
 		return "LegacyTx"
 	case StateTx:
 		return "StateTx"
+	case AccessListTx:
+		return "AccessListTx"
 	case DynamicFeeTx:
 		return "DynamicFeeTx"
+	case SponsoredTx:
+		return "SponsoredTx"
 	}
 
 	return
 }
 
+// HasDynamicFees returns true if the transaction type carries the EIP-1559 fee fields
+func (t TxType) HasDynamicFees() bool {
+	return t == DynamicFeeTx || t == SponsoredTx
+}
+
+// HasAccessList returns true if the transaction type carries the chain ID and the EIP-2930 access list
+func (t TxType) HasAccessList() bool {
+	return t == AccessListTx || t.HasDynamicFees()
+}
+
 type Transaction struct {
 	Nonce     uint64
 	GasPrice  *big.Int
@@ -65,6 +81,14 @@ type Transaction struct {
 
 	ChainID *big.Int
 
+	// AccessList is the list of the addresses and storage keys the typed transaction plans to access
+	AccessList TxAccessList
+
+	// Sponsor is the account paying the gas of the sponsored transaction,
+	// the sender pays only the value. The sponsor signature covers the sender and the signed transaction
+	Sponsor                      *Address
+	SponsorV, SponsorR, SponsorS *big.Int
+
 	// Cache
 	size atomic.Pointer[uint64]
 }
@@ -115,14 +139,78 @@ func (t *Transaction) Copy() *Transaction {
 		tt.S = big.NewInt(0).SetBits(t.S.Bits())
 	}
 
+	if t.Sponsor != nil {
+		sponsor := *t.Sponsor
+		tt.Sponsor = &sponsor
+	}
+
+	if t.SponsorV != nil {
+		tt.SponsorV = new(big.Int).Set(t.SponsorV)
+	}
+
+	if t.SponsorR != nil {
+		tt.SponsorR = new(big.Int).Set(t.SponsorR)
+	}
+
+	if t.SponsorS != nil {
+		tt.SponsorS = new(big.Int).Set(t.SponsorS)
+	}
+
 	tt.Input = make([]byte, len(t.Input))
 	copy(tt.Input[:], t.Input[:])
 
+	tt.AccessList = t.AccessList.Copy()
+
 	return tt
 }
 
+// AccessTuple is the address and its storage keys the transaction plans to access (EIP-2930)
+type AccessTuple struct {
+	Address     Address `json:"address"`
+	StorageKeys []Hash  `json:"storageKeys"`
+}
+
+// TxAccessList is the list of the addresses and storage keys the transaction plans to access.
+// The access lists are allowed from the berlin fork, and the entries are warm from the beginning
+// of the transaction execution (EIP-2929)
+type TxAccessList []AccessTuple
+
+// StorageKeys returns the total number of the storage keys in the access list
+func (al TxAccessList) StorageKeys() int {
+	count := 0
+	for _, tuple := range al {
+		count += len(tuple.StorageKeys)
+	}
+
+	return count
+}
+
+// Copy returns a deep copy of the access list
+func (al TxAccessList) Copy() TxAccessList {
+	if al == nil {
+		return nil
+	}
+
+	result := make(TxAccessList, len(al))
+	for i, tuple := range al {
+		result[i] = AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append([]Hash{}, tuple.StorageKeys...),
+		}
+	}
+
+	return result
+}
+
 // Cost returns gas * gasPrice + value
 func (t *Transaction) Cost() *big.Int {
+	total := t.GasCost()
+
+	return total.Add(total, t.Value)
+}
+
+// GasCost returns the maximum gas cost of the transaction, gas * gasPrice
+func (t *Transaction) GasCost() *big.Int {
 	var factor *big.Int
 
 	if t.GasFeeCap != nil && t.GasFeeCap.BitLen() > 0 {
@@ -131,10 +219,17 @@ func (t *Transaction) Cost() *big.Int {
 		factor = new(big.Int).Set(t.GasPrice)
 	}
 
-	total := new(big.Int).Mul(factor, new(big.Int).SetUint64(t.Gas))
-	total = total.Add(total, t.Value)
+	return factor.Mul(factor, new(big.Int).SetUint64(t.Gas))
+}
 
-	return total
+// GasPayer returns the account paying the gas of the transaction,
+// which is the sponsor of the sponsored transaction and the sender otherwise
+func (t *Transaction) GasPayer() Address {
+	if t.Type == SponsoredTx && t.Sponsor != nil {
+		return *t.Sponsor
+	}
+
+	return t.From
 }
 
 // GetGasPrice returns gas price if not empty, or calculates one based on
@@ -201,23 +296,21 @@ func (t *Transaction) EffectiveGasTip(baseFee *big.Int) *big.Int {
 // GetGasTipCap gets gas tip cap depending on tx type
 // Spec: https://eips.ethereum.org/EIPS/eip-1559#specification
 func (t *Transaction) GetGasTipCap() *big.Int {
-	switch t.Type {
-	case DynamicFeeTx:
+	if t.Type.HasDynamicFees() {
 		return t.GasTipCap
-	default:
-		return t.GasPrice
 	}
+
+	return t.GasPrice
 }
 
 // GetGasFeeCap gets gas fee cap depending on tx type
 // Spec: https://eips.ethereum.org/EIPS/eip-1559#specification
 func (t *Transaction) GetGasFeeCap() *big.Int {
-	switch t.Type {
-	case DynamicFeeTx:
+	if t.Type.HasDynamicFees() {
 		return t.GasFeeCap
-	default:
-		return t.GasPrice
 	}
+
+	return t.GasPrice
 }
 
 // FindTxByHash returns transaction and its index from a slice of transactions
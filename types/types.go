@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/0xPolygon/polygon-edge/helper/hex"
@@ -193,6 +194,68 @@ type Proof struct {
 	Metadata map[string]interface{}
 }
 
+// EpochValidators is the validator set of the epoch, bound to the header of the epoch ending block
+type EpochValidators struct {
+	Epoch uint64
+	// Header is the epoch ending block header, its extra data commits to the validator set hash.
+	// The extra data is stripped of the seals, so the header hashes to the block hash
+	Header *Header
+	// ValidatorsHash is the hash of the validator set, as committed in the header extra data
+	ValidatorsHash Hash
+	// Root is the merkle root of the validators, in the order of the validator set
+	Root       Hash
+	Validators []*EpochValidator
+}
+
+// EpochValidator is the validator of the epoch, with the merkle proof of its membership.
+// The leaf index of the validator is its position in the validator set
+type EpochValidator struct {
+	Address     Address
+	BlsKey      []byte
+	VotingPower *big.Int
+	// Leaf is the ABI encoded validator, hashed into the merkle tree
+	Leaf  []byte
+	Proof []Hash
+}
+
+// ValidatorQueue is the queue of the staked candidates waiting for a slot in the validator set
+type ValidatorQueue struct {
+	// BlockNumber is the number of the block the stakes are up to date with
+	BlockNumber         uint64
+	Epoch               uint64
+	MaxValidatorSetSize uint64
+	// Validators is the size of the current validator set
+	Validators uint64
+	// OpenSlots is the number of the slots left open by the current validators,
+	// taken by the head of the queue when the epoch ends
+	OpenSlots uint64
+	// Queue is ordered the same way the candidates are promoted
+	Queue []*QueuedValidator
+}
+
+// QueuedValidator is the staked candidate waiting for a slot in the validator set
+type QueuedValidator struct {
+	Address     Address
+	VotingPower *big.Int
+}
+
+// BridgeMessageTimeline is the timeline of the bridge message (state sync),
+// holding the time the message reached each of the bridge stages it went through so far
+type BridgeMessageTimeline struct {
+	ID     uint64             `json:"id"`
+	Stages []*BridgeStageTime `json:"stages"`
+	// Success is the result of the message execution, nil until the message is executed
+	Success *bool `json:"success,omitempty"`
+}
+
+// BridgeStageTime is the time the bridge message reached the stage
+type BridgeStageTime struct {
+	Stage string    `json:"stage"`
+	Time  time.Time `json:"time"`
+	// Latency is the time since the previous stage of the timeline, in milliseconds
+	Latency uint64 `json:"latency"`
+}
+
 type OverrideAccount struct {
 	Nonce     *uint64
 	Code      []byte